@@ -313,7 +313,7 @@ func (s *ChannelTestSuite) setUpgradeTimeoutParam(ctx context.Context, chain ibc
 	s.Require().NotNil(govModuleAddress)
 
 	upgradeTimeout := channeltypes.NewTimeout(channeltypes.DefaultTimeout.Height, timeoutDelta)
-	msg := channeltypes.NewMsgUpdateChannelParams(govModuleAddress.String(), channeltypes.NewParams(upgradeTimeout))
+	msg := channeltypes.NewMsgUpdateChannelParams(govModuleAddress.String(), channeltypes.NewParams(upgradeTimeout, channeltypes.DefaultReceiptRetention))
 	s.ExecuteAndPassGovV1Proposal(ctx, msg, chain, wallet)
 }
 
@@ -24,6 +24,7 @@ const (
 	// The channel ordering
 	flagOrdering              = "ordering"
 	flagRelativePacketTimeout = "relative-packet-timeout"
+	flagPartialExecution      = "partial-execution"
 )
 
 func newRegisterInterchainAccountCmd() *cobra.Command {
@@ -74,7 +75,9 @@ func newSendTxCmd() *cobra.Command {
 		Short: "Send an interchain account tx on the provided connection.",
 		Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed on the host chain 
 and attempts to send the packet. Packet data is provided as json, file or string. An 
-appropriate relative timeoutTimestamp must be provided with flag {relative-packet-timeout}`),
+appropriate relative timeoutTimestamp must be provided with flag {relative-packet-timeout}. The 
+{partial-execution} flag executes each message independently on the host chain, a host chain which does 
+not support partial execution returns an error acknowledgement.`),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -106,13 +109,20 @@ appropriate relative timeoutTimestamp must be provided with flag {relative-packe
 				return err
 			}
 
+			partialExecution, err := cmd.Flags().GetBool(flagPartialExecution)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeoutTimestamp, icaMsgData)
+			msg.PartialExecution = partialExecution
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagPartialExecution, false, "Execute each message independently on the host chain, such that failing messages do not revert the other messages")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -23,6 +23,7 @@ var (
 	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
 	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
 	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.PacketSenderRetriever = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
@@ -365,3 +366,22 @@ func (IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	}
 	return data, nil
 }
+
+// GetPacketSender returns the owner of the interchain account which sent the packet on the provided port and channel.
+// The owner to which the interchain account has been transferred is returned if set, otherwise the owner is derived
+// from the controller portID. This function implements the optional PacketSenderRetriever interface.
+func (im IBCMiddleware) GetPacketSender(ctx sdk.Context, packetData interface{}, portID, channelID string) string {
+	data, ok := packetData.(icatypes.InterchainAccountPacketData)
+	if !ok {
+		return ""
+	}
+
+	connectionID, err := im.keeper.GetConnectionID(ctx, portID, channelID)
+	if err == nil {
+		if owner, found := im.keeper.GetInterchainAccountOwner(ctx, connectionID, portID); found {
+			return owner
+		}
+	}
+
+	return data.GetPacketSender(portID)
+}
@@ -1314,3 +1314,35 @@ func (suite *InterchainAccountsTestSuite) TestPacketDataUnmarshalerInterface() {
 	suite.Require().Error(err)
 	suite.Require().Nil(packetData)
 }
+
+func (suite *InterchainAccountsTestSuite) TestPacketSenderRetrieverInterface() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(types.SubModuleName)
+	suite.Require().True(ok)
+
+	senderRetriever, ok := cbs.(porttypes.PacketSenderRetriever)
+	suite.Require().True(ok)
+
+	sender := senderRetriever.GetPacketSender(suite.chainA.GetContext(), packetData, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(TestOwnerAddress, sender)
+
+	// transfer the ownership of the interchain account
+	newOwner := suite.chainB.SenderAccount.GetAddress().String()
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, newOwner)
+
+	sender = senderRetriever.GetPacketSender(suite.chainA.GetContext(), packetData, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(newOwner, sender)
+
+	// test invalid packet data
+	sender = senderRetriever.GetPacketSender(suite.chainA.GetContext(), "invalid packet data", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Empty(sender)
+}
@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/ibc-go/v8/internal/logging"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -30,7 +31,7 @@ import (
 // by the underlying application. For a full summary of the changes in v6.x.x, please see ADR009.
 // This API will be removed in later releases.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	portID, err := k.GetControllerPortID(ctx, connectionID, owner)
 	if err != nil {
 		return err
 	}
@@ -90,3 +91,73 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID,
 
 	return channelOpenInitResponse.ChannelId, nil
 }
+
+// GetControllerPortID returns the controller port identifier of the interchain account owned by the provided owner on the given connection.
+// If an interchain account has been transferred to the owner, the port identifier of the transferred account is returned. Otherwise the
+// port identifier is generated from the owner. An error is returned if the interchain account associated with the generated port identifier
+// has been transferred to another owner.
+func (k Keeper) GetControllerPortID(ctx sdk.Context, connectionID, owner string) (string, error) {
+	if portID, found := k.GetTransferredPortID(ctx, connectionID, owner); found {
+		return portID, nil
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if newOwner, found := k.GetInterchainAccountOwner(ctx, connectionID, portID); found {
+		return "", errorsmod.Wrapf(types.ErrInterchainAccountTransferred, "interchain account for portID %s on connection %s is owned by %s", portID, connectionID, newOwner)
+	}
+
+	return portID, nil
+}
+
+// transferInterchainAccountOwnership transfers the interchain account owned by the provided owner on the given connection to newOwner,
+// returning the controller port identifier of the interchain account. The port identifier, port and channel capabilities, active channel
+// and interchain account address remain unchanged, only the owner authorized to use them is updated.
+func (k Keeper) transferInterchainAccountOwnership(ctx sdk.Context, connectionID, owner, newOwner string) (string, error) {
+	portID, err := k.GetControllerPortID(ctx, connectionID, owner)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); !found {
+		return "", errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for portID %s on connection %s", portID, connectionID)
+	}
+
+	if k.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return "", errorsmod.Wrapf(icatypes.ErrUnsupported, "interchain account for portID %s on connection %s is controlled by an underlying application", portID, connectionID)
+	}
+
+	if _, found := k.GetTransferredPortID(ctx, connectionID, newOwner); found {
+		return "", errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "an interchain account has already been transferred to %s on connection %s", newOwner, connectionID)
+	}
+
+	newOwnerPortID, err := icatypes.NewControllerPortID(newOwner)
+	if err != nil {
+		return "", err
+	}
+
+	if newOwnerPortID != portID && k.isRegistered(ctx, connectionID, newOwnerPortID) {
+		return "", errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "%s has already registered an interchain account on connection %s", newOwner, connectionID)
+	}
+
+	k.DeleteInterchainAccountOwner(ctx, connectionID, portID)
+
+	// transferring the interchain account back to the owner encoded in the portID does not require a mapping
+	if newOwnerPortID != portID {
+		k.SetInterchainAccountOwner(ctx, connectionID, portID, newOwner)
+	}
+
+	return portID, nil
+}
+
+// isRegistered returns true if an interchain account has been registered, or its registration is in flight, for the provided connectionID and portID
+func (k Keeper) isRegistered(ctx sdk.Context, connectionID, portID string) bool {
+	if _, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); found {
+		return true
+	}
+
+	return k.IsMiddlewareEnabled(ctx, portID, connectionID) || k.IsMiddlewareDisabled(ctx, portID, connectionID)
+}
@@ -29,3 +29,17 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// emitTransferInterchainAccountOwnershipEvent emits an event signalling that the ownership of an interchain account was transferred.
+func emitTransferInterchainAccountOwnershipEvent(ctx sdk.Context, connectionID, portID, owner, newOwner string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeTransferInterchainAccountOwnership,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyOwner, owner),
+			sdk.NewAttribute(icatypes.AttributeKeyNewOwner, newOwner),
+		),
+	)
+}
@@ -40,6 +40,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, owner := range state.Owners {
+		keeper.SetInterchainAccountOwner(ctx, owner.ConnectionId, owner.PortId, owner.Owner)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -50,5 +54,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesi
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
+		keeper.GetAllInterchainAccountOwners(ctx),
 	)
 }
@@ -53,6 +53,13 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		Owners: []genesistypes.InterchainAccountOwner{
+			{
+				ConnectionId: "connection-1",
+				PortId:       "test-port-1",
+				Owner:        TestNewOwnerAddress,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -78,6 +85,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.Require().True(found)
 			suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+			owner, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountOwner(suite.chainA.GetContext(), "connection-1", "test-port-1")
+			suite.Require().True(found)
+			suite.Require().Equal(TestNewOwnerAddress, owner)
+
+			portID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetTransferredPortID(suite.chainA.GetContext(), "connection-1", TestNewOwnerAddress)
+			suite.Require().True(found)
+			suite.Require().Equal("test-port-1", portID)
+
 			expParams := types.NewParams(false)
 			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 			suite.Require().Equal(expParams, params)
@@ -106,6 +121,8 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, TestNewOwnerAddress)
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -117,6 +134,15 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	expOwners := []genesistypes.InterchainAccountOwner{
+		{
+			ConnectionId: path.EndpointA.ConnectionID,
+			PortId:       path.EndpointA.ChannelConfig.PortID,
+			Owner:        TestNewOwnerAddress,
+		},
+	}
+	suite.Require().Equal(expOwners, genesisState.GetOwners())
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
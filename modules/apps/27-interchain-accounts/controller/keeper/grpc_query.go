@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,7 +10,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
-	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -22,8 +22,12 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
+	portID, err := k.GetControllerPortID(ctx, req.ConnectionId, req.Owner)
 	if err != nil {
+		if errors.Is(err, types.ErrInterchainAccountTransferred) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
 	}
 
@@ -6,7 +6,10 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
-	var req *types.QueryInterchainAccountRequest
+	var (
+		path *ibctesting.Path
+		req  *types.QueryInterchainAccountRequest
+	)
 
 	testCases := []struct {
 		name     string
@@ -39,6 +42,21 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"success: interchain account transferred to owner",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, TestNewOwnerAddress)
+				req.Owner = TestNewOwnerAddress
+			},
+			true,
+		},
+		{
+			"interchain account transferred to another owner",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, TestNewOwnerAddress)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -47,7 +65,7 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
 
-			path := NewICAPath(suite.chainA, suite.chainB)
+			path = NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
 			err := SetupICAPath(path, ibctesting.TestAccAddress)
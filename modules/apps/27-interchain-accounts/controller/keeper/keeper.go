@@ -246,6 +246,73 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
 
+// GetInterchainAccountOwner retrieves the owner to which the interchain account associated with the provided connectionID and portID
+// has been transferred. If the interchain account has never been transferred, false is returned and the owner is derived from the portID.
+func (k Keeper) GetInterchainAccountOwner(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyAccountOwner(portID, connectionID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetTransferredPortID retrieves the controller portID of the interchain account which has been transferred to the provided owner on the given connectionID
+func (k Keeper) GetTransferredPortID(ctx sdk.Context, connectionID, owner string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyTransferredPort(owner, connectionID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetAllInterchainAccountOwners returns a list of all interchain accounts owners which differ from the owner encoded in the controller portID
+func (k Keeper) GetAllInterchainAccountOwners(ctx sdk.Context) []genesistypes.InterchainAccountOwner {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AccountOwnerKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var owners []genesistypes.InterchainAccountOwner
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		owner := genesistypes.InterchainAccountOwner{
+			ConnectionId: keySplit[2],
+			PortId:       keySplit[1],
+			Owner:        string(iterator.Value()),
+		}
+
+		owners = append(owners, owner)
+	}
+
+	return owners
+}
+
+// SetInterchainAccountOwner stores the owner of the interchain account associated with the provided connectionID and portID, as well as
+// the reverse mapping from the owner to the portID
+func (k Keeper) SetInterchainAccountOwner(ctx sdk.Context, connectionID, portID, owner string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAccountOwner(portID, connectionID), []byte(owner))
+	store.Set(types.KeyTransferredPort(owner, connectionID), []byte(portID))
+}
+
+// DeleteInterchainAccountOwner deletes the owner stored for the interchain account associated with the provided connectionID and portID
+func (k Keeper) DeleteInterchainAccountOwner(ctx sdk.Context, connectionID, portID string) {
+	owner, found := k.GetInterchainAccountOwner(ctx, connectionID, portID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAccountOwner(portID, connectionID))
+	store.Delete(types.KeyTransferredPort(owner, connectionID))
+}
+
 // IsMiddlewareEnabled returns true if the underlying application callbacks are enabled for given port and connection identifier pair, otherwise false
 func (k Keeper) IsMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
 	store := ctx.KVStore(k.storeKey)
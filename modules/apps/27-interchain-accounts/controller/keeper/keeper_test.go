@@ -21,6 +21,9 @@ var (
 	// TestOwnerAddress defines a reusable bech32 address for testing purposes
 	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
 
+	// TestNewOwnerAddress defines a reusable bech32 address to which interchain accounts are transferred for testing purposes
+	TestNewOwnerAddress = "cosmos15ulrf36d4wdtrtqzkgaan9ylwuhs7k7qz753uk"
+
 	// TestPortID defines a reusable port identifier for testing purposes
 	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
 
@@ -104,6 +107,34 @@ func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) erro
 	return nil
 }
 
+// SetupICAPathWithMsgServer registers an interchain account using the MsgServer and completes the channel handshake
+func SetupICAPathWithMsgServer(path *ibctesting.Path, owner string) error {
+	msgServer := keeper.NewMsgServerImpl(&path.EndpointA.Chain.GetSimApp().ICAControllerKeeper)
+	msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, path.EndpointA.ChannelConfig.Version, path.EndpointA.ChannelConfig.Order)
+
+	res, err := msgServer.RegisterInterchainAccount(path.EndpointA.Chain.GetContext(), msg)
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.PortID = res.PortId
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(KeeperTestSuite))
 }
@@ -297,6 +328,47 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
 }
 
+func (suite *KeeperTestSuite) TestSetAndDeleteInterchainAccountOwner() {
+	suite.SetupTest()
+
+	keeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetInterchainAccountOwner(ctx, ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().False(found)
+
+	keeper.SetInterchainAccountOwner(ctx, ibctesting.FirstConnectionID, TestPortID, TestNewOwnerAddress)
+
+	owner, found := keeper.GetInterchainAccountOwner(ctx, ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(TestNewOwnerAddress, owner)
+
+	portID, found := keeper.GetTransferredPortID(ctx, ibctesting.FirstConnectionID, TestNewOwnerAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(TestPortID, portID)
+
+	expOwners := []genesistypes.InterchainAccountOwner{
+		{
+			ConnectionId: ibctesting.FirstConnectionID,
+			PortId:       TestPortID,
+			Owner:        TestNewOwnerAddress,
+		},
+	}
+	suite.Require().Equal(expOwners, keeper.GetAllInterchainAccountOwners(ctx))
+
+	// the transferred ownership must not be mistaken for registered interchain accounts or bound ports
+	suite.Require().Empty(keeper.GetAllInterchainAccounts(ctx))
+	suite.Require().Empty(keeper.GetAllPorts(ctx))
+
+	keeper.DeleteInterchainAccountOwner(ctx, ibctesting.FirstConnectionID, TestPortID)
+
+	_, found = keeper.GetInterchainAccountOwner(ctx, ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().False(found)
+
+	_, found = keeper.GetTransferredPortID(ctx, ibctesting.FirstConnectionID, TestNewOwnerAddress)
+	suite.Require().False(found)
+}
+
 func (suite *KeeperTestSuite) TestSetAndGetParams() {
 	testCases := []struct {
 		name    string
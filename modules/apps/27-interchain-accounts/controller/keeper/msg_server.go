@@ -28,7 +28,7 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.ConnectionId, msg.Owner)
 	if err != nil {
 		return nil, err
 	}
@@ -57,15 +57,19 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.GetControllerPortID(ctx, msg.ConnectionId, msg.Owner)
 	if err != nil {
 		return nil, err
 	}
 
-	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
-	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
-	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
-	seq, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	packetData := msg.PacketData
+	if msg.PartialExecution {
+		packetData.Type = icatypes.EXECUTE_TX_PARTIAL
+	}
+
+	// the absolute timeout value is resolved by core IBC using the later of the block time and the latest
+	// consensus timestamp of the counterparty client + the relative timeout value
+	seq, err := s.sendTxWithRelativeTimeout(ctx, msg.ConnectionId, portID, packetData, msg.RelativeTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -84,3 +88,24 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// TransferInterchainAccountOwnership defines an rpc handler method for MsgTransferInterchainAccountOwnership. Transfers the ownership of
+// an interchain account to a new owner.
+func (k Keeper) TransferInterchainAccountOwnership(goCtx context.Context, msg *types.MsgTransferInterchainAccountOwnership) (*types.MsgTransferInterchainAccountOwnershipResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := k.transferInterchainAccountOwnership(ctx, msg.ConnectionId, msg.Owner, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("successfully transferred interchain account ownership", "port-id", portID, "connection-id", msg.ConnectionId, "owner", msg.Owner, "new-owner", msg.NewOwner)
+
+	emitTransferInterchainAccountOwnershipEvent(ctx, msg.ConnectionId, portID, msg.Owner, msg.NewOwner)
+
+	return &types.MsgTransferInterchainAccountOwnershipResponse{}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -10,11 +11,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -117,12 +121,30 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 			},
 			true,
 		},
+		{
+			"success - partial execution packet type", func() {
+				msg.PacketData.Type = icatypes.EXECUTE_TX_PARTIAL
+			},
+			true,
+		},
+		{
+			"success - partial execution", func() {
+				msg.PartialExecution = true
+			},
+			true,
+		},
 		{
 			"failure - owner address is empty", func() {
 				msg.Owner = ""
 			},
 			false,
 		},
+		{
+			"failure - packet data type is not supported", func() {
+				msg.PacketData.Type = icatypes.Type(100)
+			},
+			false,
+		},
 		{
 			"failure - active channel does not exist for connection ID", func() {
 				msg.Owner = TestOwnerAddress
@@ -199,6 +221,19 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
+
+				packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+				suite.Require().NoError(err)
+
+				var sentPacketData icatypes.InterchainAccountPacketData
+				err = sentPacketData.UnmarshalJSON(packet.GetData())
+				suite.Require().NoError(err)
+
+				expType := msg.PacketData.Type
+				if msg.PartialExecution {
+					expType = icatypes.EXECUTE_TX_PARTIAL
+				}
+				suite.Require().Equal(expType, sentPacketData.Type)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
@@ -258,3 +293,214 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestTransferInterchainAccountOwnership tests TransferInterchainAccountOwnership rpc handler
+func (suite *KeeperTestSuite) TestTransferInterchainAccountOwnership() {
+	var (
+		path     *ibctesting.Path
+		msg      *types.MsgTransferInterchainAccountOwnership
+		expOwner string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: transfer back to the owner encoded in the port identifier",
+			func() {
+				_, err := suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				msg.Owner, msg.NewOwner = TestNewOwnerAddress, TestOwnerAddress
+				expOwner = TestOwnerAddress
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: interchain account not found",
+			func() {
+				msg.Owner = "unregistered-owner"
+			},
+			icatypes.ErrInterchainAccountNotFound,
+		},
+		{
+			"failure: interchain account has already been transferred",
+			func() {
+				_, err := suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				msg.NewOwner = "another-owner"
+			},
+			types.ErrInterchainAccountTransferred,
+		},
+		{
+			"failure: interchain account is controlled by an underlying application",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			},
+			icatypes.ErrUnsupported,
+		},
+		{
+			"failure: new owner has already registered an interchain account",
+			func() {
+				msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+				_, err := msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestNewOwnerAddress, "", channeltypes.ORDERED))
+				suite.Require().NoError(err)
+			},
+			icatypes.ErrAccountAlreadyExist,
+		},
+		{
+			"failure: an interchain account has already been transferred to the new owner",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountOwner(suite.chainA.GetContext(), path.EndpointA.ConnectionID, "icacontroller-another-owner", TestNewOwnerAddress)
+			},
+			icatypes.ErrAccountAlreadyExist,
+		},
+	}
+
+	for _, ordering := range []channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED} {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(fmt.Sprintf("%s: %s", ordering, tc.name), func() {
+				suite.SetupTest()
+
+				path = NewICAPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.Order = ordering
+				path.EndpointB.ChannelConfig.Order = ordering
+				suite.coordinator.SetupConnections(path)
+
+				err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				expAddress, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg = types.NewMsgTransferInterchainAccountOwnership(suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(), path.EndpointA.ConnectionID, TestOwnerAddress, TestNewOwnerAddress)
+				expOwner = TestNewOwnerAddress
+
+				tc.malleate()
+
+				ctx := suite.chainA.GetContext()
+				res, err := suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(ctx, msg)
+
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+					suite.Require().NotNil(res)
+
+					portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(ctx, path.EndpointA.ConnectionID, expOwner)
+					suite.Require().NoError(err)
+					suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
+
+					channelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(ctx, path.EndpointA.ConnectionID, portID)
+					suite.Require().True(found)
+					suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+
+					address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(ctx, path.EndpointA.ConnectionID, portID)
+					suite.Require().True(found)
+					suite.Require().Equal(expAddress, address)
+
+					_, err = suite.chainA.GetSimApp().ICAControllerKeeper.GetControllerPortID(ctx, path.EndpointA.ConnectionID, msg.Owner)
+					if msg.Owner == TestOwnerAddress {
+						suite.Require().ErrorIs(err, types.ErrInterchainAccountTransferred)
+					} else {
+						suite.Require().NoError(err)
+					}
+
+					expEvent := sdk.NewEvent(
+						icatypes.EventTypeTransferInterchainAccountOwnership,
+						sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+						sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+						sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, portID),
+						sdk.NewAttribute(icatypes.AttributeKeyOwner, msg.Owner),
+						sdk.NewAttribute(icatypes.AttributeKeyNewOwner, msg.NewOwner),
+					)
+					suite.Require().Contains(ctx.EventManager().Events().ToABCIEvents(), abci.Event(expEvent))
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(res)
+				}
+			})
+		}
+	}
+}
+
+// TestTransferInterchainAccountOwnershipSendTx tests that only the new owner may send transactions and reopen the channel of a transferred interchain account
+func (suite *KeeperTestSuite) TestTransferInterchainAccountOwnershipSendTx() {
+	for _, ordering := range []channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED} {
+		suite.Run(ordering.String(), func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = ordering
+			path.EndpointB.ChannelConfig.Order = ordering
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			hostAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := types.NewMsgTransferInterchainAccountOwnership(suite.chainA.GetSimApp().ICAControllerKeeper.GetAuthority(), path.EndpointA.ConnectionID, TestOwnerAddress, TestNewOwnerAddress)
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.TransferInterchainAccountOwnership(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			icaMsg := &banktypes.MsgSend{
+				FromAddress: hostAddress,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{icaMsg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+			_, err = msgServer.SendTx(suite.chainA.GetContext(), types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData))
+			suite.Require().ErrorIs(err, types.ErrInterchainAccountTransferred)
+
+			res, err := msgServer.SendTx(suite.chainA.GetContext(), types.NewMsgSendTx(TestNewOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData))
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.Sequence)
+
+			// close the active channel and reopen it as the new owner
+			err = path.EndpointA.SetChannelState(channeltypes.CLOSED)
+			suite.Require().NoError(err)
+			err = path.EndpointB.SetChannelState(channeltypes.CLOSED)
+			suite.Require().NoError(err)
+
+			_, err = msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion, ordering))
+			suite.Require().ErrorIs(err, types.ErrInterchainAccountTransferred)
+
+			path.EndpointA.ChannelID, path.EndpointB.ChannelID = "", ""
+			path.EndpointA.ChannelConfig.Version, path.EndpointB.ChannelConfig.Version = TestVersion, TestVersion
+			err = SetupICAPathWithMsgServer(path, TestNewOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(TestPortID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().Equal(channeltypes.FormatChannelIdentifier(1), path.EndpointA.ChannelID)
+
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+			suite.Require().Equal(hostAddress, address)
+		})
+	}
+}
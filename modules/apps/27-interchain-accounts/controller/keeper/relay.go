@@ -10,6 +10,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -28,29 +29,40 @@ func (k Keeper) SendTx(ctx sdk.Context, _ *capabilitytypes.Capability, connectio
 }
 
 func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
-	if !k.GetParams(ctx).ControllerEnabled {
-		return 0, types.ErrControllerSubModuleDisabled
+	activeChannelID, chanCap, err := k.getSendTxChannel(ctx, connectionID, portID, icaPacketData)
+	if err != nil {
+		return 0, err
 	}
 
-	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
-	if !found {
-		return 0, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return 0, icatypes.ErrInvalidTimeoutTimestamp
 	}
 
-	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
-	if !found {
-		return 0, errorsmod.Wrapf(capabilitytypes.ErrCapabilityNotFound, "failed to find capability: %s", host.ChannelCapabilityPath(portID, activeChannelID))
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, portID, activeChannelID, clienttypes.ZeroHeight(), timeoutTimestamp, icaPacketData.GetBytes())
+	if err != nil {
+		return 0, err
 	}
 
-	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
-		return 0, icatypes.ErrInvalidTimeoutTimestamp
+	return sequence, nil
+}
+
+// sendTxWithRelativeTimeout sends the packet data on the active channel with a timeout timestamp which is
+// resolved by core IBC relative to the later of the block time and the latest consensus timestamp of the
+// counterparty client. If the ICS4Wrapper does not support relative timeouts, the timeout timestamp is
+// resolved relative to the block time.
+func (k Keeper) sendTxWithRelativeTimeout(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeoutTimestamp uint64) (uint64, error) {
+	relativeTimeoutSender, ok := k.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+	if !ok {
+		return k.sendTx(ctx, connectionID, portID, icaPacketData, uint64(ctx.BlockTime().UnixNano())+relativeTimeoutTimestamp)
 	}
 
-	if err := icaPacketData.ValidateBasic(); err != nil {
-		return 0, errorsmod.Wrap(err, "invalid interchain account packet data")
+	activeChannelID, chanCap, err := k.getSendTxChannel(ctx, connectionID, portID, icaPacketData)
+	if err != nil {
+		return 0, err
 	}
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, portID, activeChannelID, clienttypes.ZeroHeight(), timeoutTimestamp, icaPacketData.GetBytes())
+	relativeTimeout := channeltypes.NewRelativeTimeout(0, relativeTimeoutTimestamp)
+	sequence, _, err := relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, chanCap, portID, activeChannelID, relativeTimeout, icaPacketData.GetBytes())
 	if err != nil {
 		return 0, err
 	}
@@ -58,8 +70,33 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 	return sequence, nil
 }
 
+// getSendTxChannel returns the active channel and its capability which the provided packet data is sent on.
+// An error is returned if the controller submodule is disabled or the packet data is invalid.
+func (k Keeper) getSendTxChannel(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData) (string, *capabilitytypes.Capability, error) {
+	if !k.GetParams(ctx).ControllerEnabled {
+		return "", nil, types.ErrControllerSubModuleDisabled
+	}
+
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return "", nil, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return "", nil, errorsmod.Wrapf(capabilitytypes.ErrCapabilityNotFound, "failed to find capability: %s", host.ChannelCapabilityPath(portID, activeChannelID))
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return "", nil, errorsmod.Wrap(err, "invalid interchain account packet data")
+	}
+
+	return activeChannelID, chanCap, nil
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. The channel end of an ORDERED_ALLOW_TIMEOUT channel remains open and
+// continues to be used as the active channel.
 func (Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	return nil
 }
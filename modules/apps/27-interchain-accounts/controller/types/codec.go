@@ -13,6 +13,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
 		&MsgUpdateParams{},
+		&MsgTransferInterchainAccountOwnership{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -33,6 +33,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			true,
 		},
+		{
+			"success: MsgTransferInterchainAccountOwnership",
+			sdk.MsgTypeURL(&types.MsgTransferInterchainAccountOwnership{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
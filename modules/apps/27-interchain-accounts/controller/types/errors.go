@@ -6,5 +6,6 @@ import (
 
 // ICA Controller sentinel errors
 var (
-	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrControllerSubModuleDisabled  = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrInterchainAccountTransferred = errorsmod.Register(SubModuleName, 3, "interchain account ownership has been transferred")
 )
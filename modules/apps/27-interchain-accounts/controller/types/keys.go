@@ -1,5 +1,9 @@
 package types
 
+import (
+	"fmt"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -10,3 +14,21 @@ const (
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
 )
+
+var (
+	// AccountOwnerKeyPrefix defines the key prefix used to store the owner of an interchain account after an ownership transfer
+	AccountOwnerKeyPrefix = "accountOwner"
+
+	// TransferredPortKeyPrefix defines the key prefix used to store the controller port of an interchain account transferred to an owner
+	TransferredPortKeyPrefix = "transferredPort"
+)
+
+// KeyAccountOwner creates and returns a new key used for storing the owner of the interchain account associated with the provided port and connection
+func KeyAccountOwner(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AccountOwnerKeyPrefix, portID, connectionID))
+}
+
+// KeyTransferredPort creates and returns a new key used for storing the controller port of the interchain account transferred to the provided owner and connection
+func KeyTransferredPort(owner, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", TransferredPortKeyPrefix, owner, connectionID))
+}
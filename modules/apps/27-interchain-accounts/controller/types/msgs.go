@@ -20,10 +20,12 @@ var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgTransferInterchainAccountOwnership)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferInterchainAccountOwnership)(nil)
 )
 
 // NewMsgRegisterInterchainAccount creates a new instance of MsgRegisterInterchainAccount
@@ -42,7 +44,7 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid connection ID")
 	}
 
-	if !slices.Contains([]channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED}, msg.Ordering) {
+	if !slices.Contains([]channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT}, msg.Ordering) {
 		return errorsmod.Wrap(channeltypes.ErrInvalidChannelOrdering, msg.Ordering.String())
 	}
 
@@ -89,6 +91,10 @@ func (msg MsgSendTx) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relative timeout cannot be zero")
 	}
 
+	if msg.PartialExecution && msg.PacketData.Type != icatypes.EXECUTE_TX && msg.PacketData.Type != icatypes.EXECUTE_TX_PARTIAL {
+		return errorsmod.Wrapf(icatypes.ErrInvalidOutgoingData, "partial execution is not supported for packet data type %s", msg.PacketData.Type)
+	}
+
 	return nil
 }
 
@@ -109,3 +115,61 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgTransferInterchainAccountOwnership creates a new MsgTransferInterchainAccountOwnership instance
+func NewMsgTransferInterchainAccountOwnership(signer, connectionID, owner, newOwner string) *MsgTransferInterchainAccountOwnership {
+	return &MsgTransferInterchainAccountOwnership{
+		Signer:       signer,
+		ConnectionId: connectionID,
+		Owner:        owner,
+		NewOwner:     newOwner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferInterchainAccountOwnership) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if err := validateOwner(msg.Owner); err != nil {
+		return err
+	}
+
+	if err := validateOwner(msg.NewOwner); err != nil {
+		return errorsmod.Wrap(err, "invalid new owner")
+	}
+
+	if msg.Owner == msg.NewOwner {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "new owner must differ from the current owner")
+	}
+
+	return nil
+}
+
+// validateOwner returns an error if the provided owner cannot be used to generate a valid controller port identifier
+func validateOwner(owner string) error {
+	if strings.TrimSpace(owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address cannot be used as controller port identifier: %s", err)
+	}
+
+	return nil
+}
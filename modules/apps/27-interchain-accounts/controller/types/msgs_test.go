@@ -51,6 +51,13 @@ func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT ordering",
+			func() {
+				msg.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
 		{
 			"connection id is invalid",
 			func() {
@@ -154,6 +161,13 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"success: partial execution",
+			func() {
+				msg.PartialExecution = true
+			},
+			true,
+		},
 		{
 			"messages array is empty",
 			func() {
@@ -161,6 +175,14 @@ func TestMsgSendTxValidateBasic(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"partial execution with unsupported packet data type",
+			func() {
+				msg.PartialExecution = true
+				msg.PacketData.Type = icatypes.Type(100)
+			},
+			false,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -285,3 +307,65 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 
 	}
 }
+
+// TestMsgTransferInterchainAccountOwnershipValidateBasic tests ValidateBasic for MsgTransferInterchainAccountOwnership
+func TestMsgTransferInterchainAccountOwnershipValidateBasic(t *testing.T) {
+	const (
+		owner    = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+		newOwner = "cosmos15ulrf36d4wdtrtqzkgaan9ylwuhs7k7qz753uk"
+	)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgTransferInterchainAccountOwnership
+		expPass bool
+	}{
+		{"success", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, owner, newOwner), true},
+		{"success: non-address owners", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "owner", "new-owner"), true},
+		{"failure: invalid signer", types.NewMsgTransferInterchainAccountOwnership("invalidAddress", ibctesting.FirstConnectionID, owner, newOwner), false},
+		{"failure: invalid connection ID", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, "invalid|connection", owner, newOwner), false},
+		{"failure: empty owner", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "", newOwner), false},
+		{"failure: empty new owner", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, owner, "   "), false},
+		{"failure: new owner contains invalid characters", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, owner, "new/owner"), false},
+		{"failure: new owner exceeds max length", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, owner, ibctesting.GenerateString(types.MaximumOwnerLength+1)), false},
+		{"failure: new owner equals owner", types.NewMsgTransferInterchainAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, owner, owner), false},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferInterchainAccountOwnershipGetSigners tests GetSigners for MsgTransferInterchainAccountOwnership
+func TestMsgTransferInterchainAccountOwnershipGetSigners(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expPass bool
+	}{
+		{"success: valid address", sdk.AccAddress(ibctesting.TestAccAddress), true},
+		{"failure: nil address", nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg := types.NewMsgTransferInterchainAccountOwnership(tc.address.String(), ibctesting.FirstConnectionID, "owner", "new-owner")
+
+		encodingCfg := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+		signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, tc.address.Bytes(), signers[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -116,9 +116,13 @@ type MsgSendTx struct {
 	Owner        string                             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	ConnectionId string                             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	PacketData   types1.InterchainAccountPacketData `protobuf:"bytes,3,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
-	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
-	// The timeout timestamp must be non-zero.
+	// Relative timeout timestamp provided will be added to the later of the block time and the latest consensus
+	// timestamp of the counterparty client by core IBC during transaction execution. The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// partial_execution sends the packet data with the TYPE_EXECUTE_TX_PARTIAL type, such that each message is executed
+	// independently on the host chain and the acknowledgement contains the result of every message. A host chain which
+	// does not support partial execution returns an error acknowledgement with the unknown data type error.
+	PartialExecution bool `protobuf:"varint,5,opt,name=partial_execution,json=partialExecution,proto3" json:"partial_execution,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -272,6 +276,92 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgTransferInterchainAccountOwnership defines the payload for Msg/TransferInterchainAccountOwnership
+type MsgTransferInterchainAccountOwnership struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// connection identifier of the interchain account
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// current owner of the interchain account
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// owner to which the interchain account is transferred
+	NewOwner string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferInterchainAccountOwnership) Reset()         { *m = MsgTransferInterchainAccountOwnership{} }
+func (m *MsgTransferInterchainAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferInterchainAccountOwnership) ProtoMessage()    {}
+func (*MsgTransferInterchainAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnership.Merge(m, src)
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainAccountOwnership proto.InternalMessageInfo
+
+// MsgTransferInterchainAccountOwnershipResponse defines the response for Msg/TransferInterchainAccountOwnership
+type MsgTransferInterchainAccountOwnershipResponse struct {
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Reset() {
+	*m = MsgTransferInterchainAccountOwnershipResponse{}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgTransferInterchainAccountOwnershipResponse) ProtoMessage() {}
+func (*MsgTransferInterchainAccountOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferInterchainAccountOwnershipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
@@ -279,6 +369,8 @@ func init() {
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgTransferInterchainAccountOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferInterchainAccountOwnership")
+	proto.RegisterType((*MsgTransferInterchainAccountOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferInterchainAccountOwnershipResponse")
 }
 
 func init() {
@@ -286,49 +378,56 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xdf, 0xd4, 0x78, 0x10, 0xf0, 0xd0, 0x98, 0xc6, 0x66, 0xc5, 0x84, 0x78,
-	0x69, 0xa6, 0xb3, 0x93, 0x61, 0xa4, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xbc, 0x19, 0x4f, 0xc6, 0x83,
-	0xf1, 0xe0, 0x07, 0xe0, 0x23, 0x70, 0xf7, 0x03, 0xc8, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0xc0, 0xcd,
-	0xcf, 0x60, 0xf6, 0x4f, 0xb7, 0x28, 0x48, 0xb0, 0x70, 0xdb, 0xdf, 0x6f, 0xe6, 0x79, 0x7e, 0xcf,
-	0xf3, 0xcc, 0xec, 0x80, 0xfb, 0xbc, 0x4d, 0x10, 0x0e, 0x82, 0x0e, 0x27, 0xd8, 0x70, 0x29, 0x34,
-	0xe2, 0xc2, 0x50, 0x45, 0x36, 0x30, 0x17, 0x2d, 0x4c, 0x88, 0xec, 0x0a, 0xa3, 0x11, 0x91, 0xc2,
-	0x28, 0xd9, 0xe9, 0x50, 0x85, 0xc2, 0x0a, 0x32, 0x5b, 0x30, 0x50, 0xd2, 0x48, 0xbb, 0xca, 0xdb,
-	0x04, 0x1e, 0x05, 0xc3, 0x13, 0xc0, 0xb0, 0x0f, 0x86, 0x61, 0xc5, 0x99, 0x66, 0x92, 0xc9, 0x18,
-	0x8e, 0xa2, 0xaf, 0x84, 0xc9, 0xb9, 0x75, 0x26, 0x19, 0x61, 0x05, 0x05, 0x98, 0x6c, 0x52, 0x93,
-	0xa2, 0x56, 0x06, 0x10, 0x7f, 0x44, 0x4d, 0x42, 0x32, 0x43, 0xa4, 0xf6, 0xa5, 0x46, 0xbe, 0x66,
-	0xd1, 0xba, 0xaf, 0x59, 0xba, 0x70, 0x35, 0x62, 0x27, 0x52, 0x51, 0x44, 0x36, 0xb0, 0x10, 0xb4,
-	0x13, 0xc3, 0x93, 0xcf, 0x64, 0x4b, 0xf9, 0xb3, 0x05, 0x2e, 0x37, 0x34, 0x73, 0x29, 0xe3, 0xda,
-	0x50, 0x55, 0xcf, 0xa6, 0x3f, 0x4c, 0x86, 0xdb, 0xd3, 0x60, 0x58, 0xbe, 0x12, 0x54, 0x15, 0xad,
-	0x79, 0x6b, 0x61, 0xcc, 0x4d, 0x0a, 0xfb, 0x1a, 0xf8, 0x97, 0x48, 0x21, 0x28, 0x89, 0x44, 0xb7,
-	0xb8, 0x57, 0xfc, 0x27, 0x5e, 0x9d, 0xe8, 0x37, 0xeb, 0x9e, 0x5d, 0x04, 0x23, 0x21, 0x55, 0x9a,
-	0x4b, 0x51, 0x1c, 0x8a, 0x97, 0x7b, 0xa5, 0x7d, 0x07, 0x8c, 0x4a, 0xe5, 0x51, 0xc5, 0x05, 0x2b,
-	0xe6, 0xe7, 0xad, 0x85, 0xc9, 0xaa, 0x03, 0xa3, 0x93, 0x88, 0xb4, 0xc2, 0x9e, 0xc0, 0xb0, 0x02,
-	0x9f, 0x44, 0x9b, 0xdc, 0x6c, 0x6f, 0x6d, 0xf2, 0xdd, 0xf6, 0x5c, 0xee, 0xed, 0xe1, 0xce, 0x62,
-	0x22, 0xa3, 0xec, 0x81, 0xeb, 0xa7, 0x89, 0x77, 0xa9, 0x0e, 0xa4, 0xd0, 0xd4, 0xbe, 0x02, 0x40,
-	0xca, 0x1a, 0x69, 0x4d, 0x9c, 0x8c, 0xa5, 0x9d, 0xba, 0x67, 0xcf, 0x80, 0x91, 0x40, 0x2a, 0xd3,
-	0xf7, 0x51, 0x88, 0xca, 0xba, 0x57, 0xcb, 0x47, 0xf3, 0xca, 0x3f, 0x2c, 0x30, 0xd6, 0xd0, 0xec,
-	0x29, 0x15, 0xde, 0xda, 0xd6, 0x79, 0x02, 0xd9, 0x04, 0xe3, 0xc9, 0xe9, 0xb7, 0x3c, 0x6c, 0x70,
-	0x1c, 0xca, 0x78, 0x75, 0x15, 0x9e, 0xe9, 0x0e, 0x86, 0x15, 0x78, 0xcc, 0x5f, 0x33, 0x26, 0x5b,
-	0xc5, 0x06, 0x2f, 0xe7, 0x77, 0xbf, 0xcd, 0xe5, 0x5c, 0x10, 0x64, 0x1d, 0xfb, 0x06, 0xf8, 0x4f,
-	0xd1, 0x0e, 0x36, 0x3c, 0xa4, 0x2d, 0xc3, 0x7d, 0x2a, 0xbb, 0x26, 0xce, 0x3a, 0xef, 0x4e, 0xf5,
-	0xfa, 0x6b, 0x49, 0xfb, 0x58, 0xac, 0xb7, 0xc1, 0xff, 0x99, 0xdf, 0x2c, 0x43, 0x07, 0x8c, 0x6a,
-	0xfa, 0xb2, 0x4b, 0x05, 0xa1, 0xb1, 0xf5, 0xbc, 0x9b, 0xd5, 0x69, 0x4e, 0x9f, 0x2c, 0x30, 0xd5,
-	0xd0, 0xec, 0x59, 0xe0, 0x61, 0x43, 0x9b, 0x58, 0x61, 0x5f, 0xdb, 0x97, 0x40, 0x41, 0x73, 0xd6,
-	0x8f, 0x2b, 0xad, 0xec, 0x75, 0x50, 0x08, 0xe2, 0x1d, 0x71, 0x50, 0xe3, 0xd5, 0x1a, 0xfc, 0xfb,
-	0x3f, 0x11, 0x26, 0x33, 0x52, 0xef, 0x29, 0x5f, 0x6d, 0xaa, 0x67, 0x26, 0x1d, 0x55, 0x9e, 0x05,
-	0x33, 0xbf, 0xa9, 0xea, 0x79, 0xaa, 0xbe, 0xcf, 0x83, 0xa1, 0x86, 0x66, 0xf6, 0x17, 0x0b, 0xcc,
-	0xfe, 0xf9, 0x17, 0x68, 0x0e, 0xa2, 0xed, 0xb4, 0x7b, 0xe9, 0xac, 0x5f, 0x34, 0x63, 0x76, 0x4a,
-	0x1f, 0x2c, 0x50, 0x48, 0x2f, 0xea, 0x83, 0x01, 0x87, 0x24, 0x70, 0xe7, 0xd1, 0xb9, 0xe0, 0x99,
-	0xa0, 0x6d, 0x0b, 0x4c, 0xfc, 0x72, 0x23, 0x56, 0x06, 0xe4, 0x3d, 0x4a, 0xe2, 0x3c, 0xbe, 0x00,
-	0x92, 0x9e, 0x44, 0x67, 0xf8, 0xcd, 0xe1, 0xce, 0xa2, 0xb5, 0xfc, 0x62, 0x77, 0xbf, 0x64, 0xed,
-	0xed, 0x97, 0xac, 0xef, 0xfb, 0x25, 0xeb, 0xe3, 0x41, 0x29, 0xb7, 0x77, 0x50, 0xca, 0x7d, 0x3d,
-	0x28, 0xe5, 0x9e, 0x37, 0x19, 0x37, 0x1b, 0xdd, 0x36, 0x24, 0xd2, 0x47, 0xe9, 0x5b, 0xcb, 0xdb,
-	0x64, 0x89, 0x49, 0x14, 0xde, 0x43, 0xbe, 0xf4, 0xba, 0x1d, 0xaa, 0xa3, 0x57, 0x5c, 0xa3, 0xea,
-	0xdd, 0xa5, 0xbe, 0x8e, 0xa5, 0x93, 0x1e, 0x70, 0xf3, 0x3a, 0xa0, 0xba, 0x5d, 0x88, 0x5f, 0xdf,
-	0x9b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x6a, 0x51, 0xfa, 0xbd, 0x06, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbd, 0x6f, 0x13, 0x49,
+	0x14, 0xf7, 0x26, 0xb6, 0x63, 0x4f, 0x72, 0xf9, 0x58, 0x45, 0x17, 0x67, 0xef, 0xce, 0xc9, 0xf9,
+	0xee, 0xa4, 0x5c, 0x4e, 0xde, 0x95, 0x7d, 0x77, 0x80, 0x8c, 0x28, 0xc8, 0x47, 0x61, 0x21, 0x2b,
+	0xd6, 0x12, 0xa4, 0x40, 0x63, 0x8d, 0x67, 0x87, 0xf5, 0x10, 0x7b, 0x66, 0x99, 0x19, 0x6f, 0x42,
+	0x87, 0xa8, 0xa8, 0x10, 0x05, 0x1d, 0x4d, 0x4a, 0xca, 0xf4, 0xfc, 0x01, 0xa4, 0x4c, 0x49, 0x85,
+	0x90, 0x53, 0xa4, 0xe4, 0x5f, 0x40, 0xfb, 0xe9, 0x40, 0x3e, 0x30, 0x4e, 0xe8, 0xf6, 0xbd, 0x37,
+	0xef, 0xf7, 0x7e, 0xef, 0x37, 0x6f, 0xf6, 0x81, 0x9b, 0xa4, 0x89, 0x0c, 0xe8, 0x38, 0x6d, 0x82,
+	0xa0, 0x24, 0x8c, 0x0a, 0x83, 0x50, 0x89, 0x39, 0x6a, 0x41, 0x42, 0x1b, 0x10, 0x21, 0xd6, 0xa5,
+	0x52, 0x18, 0x88, 0x51, 0xc9, 0x59, 0xbb, 0x8d, 0xb9, 0xe1, 0x96, 0x0c, 0xb9, 0xab, 0x3b, 0x9c,
+	0x49, 0xa6, 0x96, 0x49, 0x13, 0xe9, 0x27, 0x93, 0xf5, 0x33, 0x92, 0xf5, 0x7e, 0xb2, 0xee, 0x96,
+	0xb4, 0x59, 0x9b, 0xd9, 0xcc, 0x4f, 0x37, 0xbc, 0xaf, 0x00, 0x49, 0xfb, 0x6f, 0x20, 0x1a, 0x6e,
+	0xc9, 0x70, 0x20, 0xda, 0xc6, 0x32, 0xcc, 0x5a, 0x1d, 0x82, 0x7c, 0xdf, 0x0a, 0x41, 0xe6, 0x10,
+	0x13, 0x1d, 0x26, 0x8c, 0x8e, 0xb0, 0xbd, 0x78, 0x47, 0xd8, 0x61, 0xe0, 0x77, 0x0f, 0x1d, 0x31,
+	0x8e, 0x0d, 0xd4, 0x82, 0x94, 0xe2, 0xb6, 0x9f, 0x1e, 0x7c, 0x06, 0x47, 0x0a, 0x6f, 0x15, 0xf0,
+	0x6b, 0x4d, 0xd8, 0x26, 0xb6, 0x89, 0x90, 0x98, 0x57, 0xe3, 0xea, 0xb7, 0x83, 0xe2, 0xea, 0x2c,
+	0x48, 0xb1, 0x1d, 0x8a, 0x79, 0x4e, 0x59, 0x54, 0x96, 0xb2, 0x66, 0x60, 0xa8, 0x7f, 0x80, 0x9f,
+	0x10, 0xa3, 0x14, 0x23, 0x8f, 0x74, 0x83, 0x58, 0xb9, 0x11, 0x3f, 0x3a, 0xd1, 0x77, 0x56, 0x2d,
+	0x35, 0x07, 0xc6, 0x5c, 0xcc, 0x05, 0x61, 0x34, 0x37, 0xea, 0x87, 0x23, 0x53, 0xbd, 0x06, 0x32,
+	0x8c, 0x5b, 0x98, 0x13, 0x6a, 0xe7, 0x92, 0x8b, 0xca, 0xd2, 0x64, 0x59, 0xd3, 0xbd, 0x9b, 0xf0,
+	0xb8, 0xea, 0x11, 0x41, 0xb7, 0xa4, 0x6f, 0x78, 0x87, 0xcc, 0xf8, 0x6c, 0x65, 0xf2, 0xf9, 0xde,
+	0x42, 0xe2, 0xd9, 0xf1, 0xfe, 0x72, 0x40, 0xa3, 0x60, 0x81, 0x3f, 0x2f, 0x22, 0x6f, 0x62, 0xe1,
+	0x30, 0x2a, 0xb0, 0xfa, 0x1b, 0x00, 0x21, 0xaa, 0xc7, 0x35, 0xe8, 0x24, 0x1b, 0x7a, 0xaa, 0x96,
+	0x3a, 0x07, 0xc6, 0x1c, 0xc6, 0x65, 0xbf, 0x8f, 0xb4, 0x67, 0x56, 0xad, 0x4a, 0xd2, 0xab, 0x57,
+	0x78, 0x3d, 0x02, 0xb2, 0x35, 0x61, 0xdf, 0xc5, 0xd4, 0xda, 0xdc, 0xbd, 0x8c, 0x20, 0xdb, 0x60,
+	0x3c, 0xb8, 0xfd, 0x86, 0x05, 0x25, 0xf4, 0x45, 0x19, 0x2f, 0xaf, 0xe9, 0x03, 0xcd, 0xa0, 0x5b,
+	0xd2, 0x4f, 0xf5, 0x57, 0xf7, 0xc1, 0xd6, 0xa0, 0x84, 0x2b, 0xc9, 0x83, 0x0f, 0x0b, 0x09, 0x13,
+	0x38, 0xb1, 0x47, 0xfd, 0x1b, 0x4c, 0x73, 0xdc, 0x86, 0x92, 0xb8, 0xb8, 0x21, 0x49, 0x07, 0xb3,
+	0xae, 0xf4, 0xb5, 0x4e, 0x9a, 0x53, 0x91, 0x7f, 0x33, 0x70, 0xab, 0xff, 0x80, 0x19, 0x07, 0x72,
+	0x49, 0x60, 0xbb, 0x81, 0x77, 0x31, 0xea, 0x7a, 0x2c, 0x72, 0xa9, 0x45, 0x65, 0x29, 0x63, 0x4e,
+	0x87, 0x81, 0xf5, 0xc8, 0x7f, 0xea, 0x0e, 0xfe, 0x07, 0x33, 0xb1, 0x38, 0xb1, 0xe0, 0x1a, 0xc8,
+	0x08, 0xfc, 0xb8, 0x8b, 0x29, 0xc2, 0xbe, 0x4e, 0x49, 0x33, 0xb6, 0x43, 0x51, 0x5f, 0x29, 0x60,
+	0xaa, 0x26, 0xec, 0x7b, 0x8e, 0x05, 0x25, 0xae, 0x43, 0x0e, 0x3b, 0x42, 0xfd, 0x19, 0xa4, 0x05,
+	0xb1, 0xfb, 0xda, 0x86, 0x96, 0xba, 0x05, 0xd2, 0x8e, 0x7f, 0xc2, 0x57, 0x75, 0xbc, 0x5c, 0xd1,
+	0xbf, 0xff, 0xd9, 0xea, 0x41, 0x8d, 0x50, 0xa8, 0x10, 0xaf, 0x32, 0x15, 0x35, 0x13, 0x96, 0x2a,
+	0xcc, 0x83, 0xb9, 0xaf, 0x58, 0x45, 0x3d, 0x15, 0xde, 0x28, 0xe0, 0xaf, 0x9a, 0xb0, 0x37, 0x39,
+	0xa4, 0xe2, 0xe1, 0x19, 0xd3, 0xb6, 0xe1, 0xc9, 0x21, 0x5a, 0xc4, 0x39, 0xb7, 0x8f, 0x81, 0x86,
+	0x24, 0x9e, 0xaf, 0xd1, 0x93, 0xf3, 0xf5, 0x0b, 0xc8, 0x52, 0xbc, 0xd3, 0x08, 0x22, 0x49, 0x3f,
+	0x92, 0xa1, 0x78, 0xc7, 0xaf, 0x79, 0xba, 0x0b, 0x03, 0x14, 0x07, 0x62, 0x1a, 0xf5, 0x56, 0xfe,
+	0x94, 0x02, 0xa3, 0x35, 0x61, 0xab, 0xef, 0x14, 0x30, 0x7f, 0xfe, 0xbf, 0xa0, 0x3e, 0x8c, 0xee,
+	0x17, 0x3d, 0x50, 0x6d, 0xeb, 0xaa, 0x11, 0xe3, 0x09, 0x7c, 0xa1, 0x80, 0x74, 0xf8, 0x62, 0x6f,
+	0x0d, 0x59, 0x24, 0x48, 0xd7, 0xd6, 0x2f, 0x95, 0x1e, 0x13, 0xda, 0x53, 0xc0, 0xc4, 0x17, 0xd3,
+	0xbe, 0x3a, 0x24, 0xee, 0x49, 0x10, 0xed, 0xce, 0x15, 0x80, 0xc4, 0x14, 0x7b, 0x0a, 0x28, 0x0c,
+	0x30, 0xde, 0xf7, 0x87, 0xac, 0xf9, 0x6d, 0x68, 0x0d, 0xfe, 0x30, 0xe8, 0xa8, 0x49, 0x2d, 0xf5,
+	0xf4, 0x78, 0x7f, 0x59, 0x59, 0x79, 0x74, 0xd0, 0xcb, 0x2b, 0x87, 0xbd, 0xbc, 0xf2, 0xb1, 0x97,
+	0x57, 0x5e, 0x1e, 0xe5, 0x13, 0x87, 0x47, 0xf9, 0xc4, 0xfb, 0xa3, 0x7c, 0xe2, 0x41, 0xdd, 0x26,
+	0xb2, 0xd5, 0x6d, 0xea, 0x88, 0x75, 0x8c, 0x70, 0xb3, 0x92, 0x26, 0x2a, 0xda, 0xcc, 0x70, 0x6f,
+	0x18, 0x1d, 0x66, 0x75, 0xdb, 0x58, 0x78, 0x3b, 0x5b, 0x18, 0xe5, 0xeb, 0xc5, 0x3e, 0xbb, 0xe2,
+	0x59, 0xeb, 0x5a, 0x3e, 0x71, 0xb0, 0x68, 0xa6, 0xfd, 0x5d, 0xfb, 0xef, 0xe7, 0x01, 0x00, 0xe0,
+	0x9c, 0xb5, 0x0f, 0xab, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +448,8 @@ type MsgClient interface {
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// TransferInterchainAccountOwnership defines a rpc handler for MsgTransferInterchainAccountOwnership.
+	TransferInterchainAccountOwnership(ctx context.Context, in *MsgTransferInterchainAccountOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainAccountOwnershipResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +487,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) TransferInterchainAccountOwnership(ctx context.Context, in *MsgTransferInterchainAccountOwnership, opts ...grpc.CallOption) (*MsgTransferInterchainAccountOwnershipResponse, error) {
+	out := new(MsgTransferInterchainAccountOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferInterchainAccountOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
@@ -394,6 +504,8 @@ type MsgServer interface {
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// TransferInterchainAccountOwnership defines a rpc handler for MsgTransferInterchainAccountOwnership.
+	TransferInterchainAccountOwnership(context.Context, *MsgTransferInterchainAccountOwnership) (*MsgTransferInterchainAccountOwnershipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -409,6 +521,9 @@ func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) TransferInterchainAccountOwnership(ctx context.Context, req *MsgTransferInterchainAccountOwnership) (*MsgTransferInterchainAccountOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferInterchainAccountOwnership not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -468,6 +583,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferInterchainAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferInterchainAccountOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferInterchainAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferInterchainAccountOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferInterchainAccountOwnership(ctx, req.(*MsgTransferInterchainAccountOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -484,6 +617,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "TransferInterchainAccountOwnership",
+			Handler:    _Msg_TransferInterchainAccountOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
@@ -595,6 +732,16 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PartialExecution {
+		i--
+		if m.PartialExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -718,6 +865,80 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferInterchainAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -789,6 +1010,9 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
+	if m.PartialExecution {
+		n += 2
+	}
 	return n
 }
 
@@ -828,6 +1052,40 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferInterchainAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferInterchainAccountOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1258,6 +1516,26 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialExecution = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1513,6 +1791,234 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferInterchainAccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferInterchainAccountOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferInterchainAccountOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -40,12 +42,13 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params, owners []InterchainAccountOwner) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Ports:              ports,
 		Params:             controllerParams,
+		Owners:             owners,
 	}
 }
 
@@ -77,6 +80,67 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	registeredAccounts := make(map[string]bool)
+	for _, acc := range gs.InterchainAccounts {
+		registeredAccounts[string(icatypes.KeyOwnerAccount(acc.PortId, acc.ConnectionId))] = true
+	}
+
+	accountKeys := make(map[string]bool)
+	ownerKeys := make(map[string]bool)
+	for _, owner := range gs.Owners {
+		if err := owner.Validate(); err != nil {
+			return err
+		}
+
+		if !registeredAccounts[string(icatypes.KeyOwnerAccount(owner.PortId, owner.ConnectionId))] {
+			return fmt.Errorf("owner %s is set for an interchain account which is not registered on connection ID (%s) port ID (%s)", owner.Owner, owner.ConnectionId, owner.PortId)
+		}
+
+		// the port ID derived from the owner must not be associated with an interchain account of its own
+		ownerPortID, _ := icatypes.NewControllerPortID(owner.Owner)
+		if registeredAccounts[string(icatypes.KeyOwnerAccount(ownerPortID, owner.ConnectionId))] {
+			return fmt.Errorf("owner %s already owns an interchain account on connection ID (%s)", owner.Owner, owner.ConnectionId)
+		}
+
+		accountKey := string(controllertypes.KeyAccountOwner(owner.PortId, owner.ConnectionId))
+		if accountKeys[accountKey] {
+			return fmt.Errorf("duplicate owner for connection ID (%s) port ID (%s)", owner.ConnectionId, owner.PortId)
+		}
+		accountKeys[accountKey] = true
+
+		ownerKey := string(controllertypes.KeyTransferredPort(owner.Owner, owner.ConnectionId))
+		if ownerKeys[ownerKey] {
+			return fmt.Errorf("owner %s is set for more than one interchain account on connection ID (%s)", owner.Owner, owner.ConnectionId)
+		}
+		ownerKeys[ownerKey] = true
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the InterchainAccountOwner
+func (o InterchainAccountOwner) Validate() error {
+	if err := host.ConnectionIdentifierValidator(o.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(o.PortId); err != nil {
+		return err
+	}
+
+	portID, err := icatypes.NewControllerPortID(o.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+
+	if portID == o.PortId {
+		return fmt.Errorf("owner %s must differ from the owner encoded in port ID (%s)", o.Owner, o.PortId)
+	}
+
 	return nil
 }
 
@@ -89,12 +153,14 @@ func DefaultHostGenesis() HostGenesisState {
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params, policies []hosttypes.MessagePolicy, spends []hosttypes.ConstraintSpend) HostGenesisState {
 	return HostGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Port:               port,
 		Params:             hostParams,
+		MessagePolicies:    policies,
+		ConstraintSpends:   spends,
 	}
 }
 
@@ -124,5 +190,31 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	policyKeys := make(map[string]bool)
+	for _, policy := range gs.MessagePolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		key := string(hosttypes.KeyMessagePolicy(policy.ConnectionId, policy.ControllerPortId))
+		if policyKeys[key] {
+			return fmt.Errorf("duplicate message policy for connection ID (%s) controller port ID (%s)", policy.ConnectionId, policy.ControllerPortId)
+		}
+		policyKeys[key] = true
+	}
+
+	spendKeys := make(map[string]bool)
+	for _, spend := range gs.ConstraintSpends {
+		if err := spend.Validate(); err != nil {
+			return err
+		}
+
+		key := string(hosttypes.KeyConstraintSpend(spend.ConnectionId, spend.ControllerPortId, spend.TypeUrl))
+		if spendKeys[key] {
+			return fmt.Errorf("duplicate constraint spend for connection ID (%s) controller port ID (%s) message type (%s)", spend.ConnectionId, spend.ControllerPortId, spend.TypeUrl)
+		}
+		spendKeys[key] = true
+	}
+
 	return gs.Params.Validate()
 }
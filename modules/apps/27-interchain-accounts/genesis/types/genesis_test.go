@@ -5,6 +5,8 @@ import (
 
 	testifysuite "github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -16,6 +18,9 @@ var (
 	// TestOwnerAddress defines a reusable bech32 address for testing purposes
 	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
 
+	// TestNewOwnerAddress defines a reusable bech32 address to which interchain accounts are transferred for testing purposes
+	TestNewOwnerAddress = "cosmos15ulrf36d4wdtrtqzkgaan9ylwuhs7k7qz753uk"
+
 	// TestPortID defines a reusable port identifier for testing purposes
 	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
 )
@@ -106,7 +111,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -120,7 +125,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -141,7 +146,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -162,7 +167,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -183,7 +188,215 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"success with interchain account owners",
+			func() {
+				registeredAccounts := []genesistypes.RegisteredInterchainAccount{
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         TestPortID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+				}
+
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, registeredAccounts, nil, controllertypes.DefaultParams(), owners)
+			},
+			true,
+		},
+		{
+			"failed to validate interchain account owners - interchain account not registered",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - invalid connection identifier",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: "invalid|connection",
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - invalid port identifier",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       "invalid|port",
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - empty owner",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        "",
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - owner contains invalid characters",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        "invalid/owner",
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - owner encoded in port identifier",
+			func() {
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - duplicate interchain account",
+			func() {
+				registeredAccounts := []genesistypes.RegisteredInterchainAccount{
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         TestPortID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+				}
+
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        "cosmos1fjx8p8uzx3h5qszqnwvelulzd659j8ua5qvaep",
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, registeredAccounts, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - duplicate owner",
+			func() {
+				portID, err := icatypes.NewControllerPortID("cosmos1fjx8p8uzx3h5qszqnwvelulzd659j8ua5qvaep")
+				suite.Require().NoError(err)
+
+				registeredAccounts := []genesistypes.RegisteredInterchainAccount{
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         TestPortID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         portID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+				}
+
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       portID,
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, registeredAccounts, nil, controllertypes.DefaultParams(), owners)
+			},
+			false,
+		},
+		{
+			"failed to validate interchain account owners - owner already owns an interchain account",
+			func() {
+				newOwnerPortID, err := icatypes.NewControllerPortID(TestNewOwnerAddress)
+				suite.Require().NoError(err)
+
+				registeredAccounts := []genesistypes.RegisteredInterchainAccount{
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         TestPortID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+					{
+						ConnectionId:   ibctesting.FirstConnectionID,
+						PortId:         newOwnerPortID,
+						AccountAddress: ibctesting.TestAccAddress,
+					},
+				}
+
+				owners := []genesistypes.InterchainAccountOwner{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						Owner:        TestNewOwnerAddress,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, registeredAccounts, nil, controllertypes.DefaultParams(), owners)
 			},
 			false,
 		},
@@ -231,7 +444,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -245,7 +458,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -266,7 +479,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -287,7 +500,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -308,7 +521,71 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil, nil)
+			},
+			false,
+		},
+		{
+			"success with message policies",
+			func() {
+				policies := []hosttypes.MessagePolicy{
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+					hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, nil, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), policies, nil)
+			},
+			true,
+		},
+		{
+			"failed to validate message policies - invalid message policy",
+			func() {
+				policies := []hosttypes.MessagePolicy{
+					hosttypes.NewMessagePolicy("invalid|connection", "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), policies, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate message policies - duplicate message policy",
+			func() {
+				policy := hosttypes.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), []hosttypes.MessagePolicy{policy, policy}, nil)
+			},
+			false,
+		},
+		{
+			"success with constraint spends",
+			func() {
+				spends := []hosttypes.ConstraintSpend{
+					hosttypes.NewConstraintSpend(ibctesting.FirstConnectionID, "", "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(ibctesting.TestCoin)),
+					hosttypes.NewConstraintSpend(ibctesting.FirstConnectionID, TestPortID, "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(ibctesting.TestCoin)),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), nil, spends)
+			},
+			true,
+		},
+		{
+			"failed to validate constraint spends - unsupported message type",
+			func() {
+				spends := []hosttypes.ConstraintSpend{
+					hosttypes.NewConstraintSpend(ibctesting.FirstConnectionID, "", "/cosmos.gov.v1.MsgVote", sdk.NewCoins(ibctesting.TestCoin)),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), nil, spends)
+			},
+			false,
+		},
+		{
+			"failed to validate constraint spends - duplicate constraint spend",
+			func() {
+				spend := hosttypes.NewConstraintSpend(ibctesting.FirstConnectionID, TestPortID, "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(ibctesting.TestCoin))
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), nil, []hosttypes.ConstraintSpend{spend, spend})
 			},
 			false,
 		},
@@ -18,6 +18,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdMessagePolicies(),
+		GetCmdEffectiveMessagePolicy(),
+		GetCmdInterchainAccounts(),
+		GetCmdInterchainAccount(),
+		GetCmdActiveChannel(),
 	)
 
 	return queryCmd
@@ -98,3 +98,176 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMessagePolicies returns the command handler for the Query/MessagePolicies rpc.
+func GetCmdMessagePolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message-policies",
+		Short:   "Query the interchain-accounts host submodule message policies",
+		Long:    "Query the message policies set on the interchain-accounts host submodule for connections and controller ports",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host message-policies", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MessagePolicies(cmd.Context(), &types.QueryMessagePoliciesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "message policies")
+
+	return cmd
+}
+
+// GetCmdEffectiveMessagePolicy returns the command handler for the Query/EffectiveMessagePolicy rpc.
+func GetCmdEffectiveMessagePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "message-policy [address]",
+		Short:   "Query the message policy applied to an interchain account",
+		Long:    "Query the message policy applied to the messages executed by an interchain account on the host chain",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host message-policy cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EffectiveMessagePolicy(cmd.Context(), &types.QueryEffectiveMessagePolicyRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for the Query/InterchainAccounts rpc.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts [connection-id]",
+		Short:   "Query the interchain accounts registered over a connection",
+		Long:    "Query the interchain accounts registered on the host chain over a connection and their associated controller port identifiers",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), &types.QueryInterchainAccountsRequest{
+				ConnectionId: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdInterchainAccount returns the command handler for the Query/InterchainAccount rpc.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [address]",
+		Short:   "Query the connection and controller port of an interchain account",
+		Long:    "Query the host connection identifier and controller port identifier associated with an interchain account address",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdActiveChannel returns the command handler for the Query/ActiveChannel rpc.
+func GetCmdActiveChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channel [address]",
+		Short:   "Query the active channel of an interchain account",
+		Long:    "Query the host port and channel identifiers of the active channel of an interchain account",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host active-channel cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActiveChannel(cmd.Context(), &types.QueryActiveChannelRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,8 +17,9 @@ import (
 )
 
 const (
-	memoFlag     string = "memo"
-	encodingFlag string = "encoding"
+	memoFlag             string = "memo"
+	encodingFlag         string = "encoding"
+	partialExecutionFlag string = "partial-execution"
 )
 
 func generatePacketDataCmd() *cobra.Command {
@@ -29,7 +30,10 @@ func generatePacketDataCmd() *cobra.Command {
 encoding parameter) using protobuf or proto3 JSON into packet data which is outputted to stdout.
 It can be used in conjunction with send-tx which submits pre-built packet data containing messages 
 to be executed on the host chain. The default encoding format is protobuf if none is specified;
-otherwise the encoding flag can be used in combination with either "proto3" or "proto3json".`,
+otherwise the encoding flag can be used in combination with either "proto3" or "proto3json".
+The partial-execution flag sets the packet data type to TYPE_EXECUTE_TX_PARTIAL, in which case each message is
+executed independently on the host chain and the acknowledgement contains the result of every message.
+A host chain which does not support partial execution returns an error acknowledgement.`,
 		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '{
     "@type":"/cosmos.bank.v1beta1.MsgSend",
     "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
@@ -62,7 +66,7 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 		"denom": "stake",
 		"amount": "1000"
 	}
-}]'`, version.AppName, version.AppName),
+}]' --partial-execution`, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -86,7 +90,17 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 				return fmt.Errorf("unsupported encoding type: %s", encoding)
 			}
 
-			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding)
+			partialExecution, err := cmd.Flags().GetBool(partialExecutionFlag)
+			if err != nil {
+				return err
+			}
+
+			packetType := icatypes.EXECUTE_TX
+			if partialExecution {
+				packetType = icatypes.EXECUTE_TX_PARTIAL
+			}
+
+			packetDataBytes, err := generatePacketData(cdc, []byte(args[0]), memo, encoding, packetType)
 			if err != nil {
 				return err
 			}
@@ -99,18 +113,19 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 
 	cmd.Flags().String(memoFlag, "", "optional memo to be included in the interchain accounts packet data")
 	cmd.Flags().String(encodingFlag, "", "optional encoding format of the messages in the interchain accounts packet data")
+	cmd.Flags().Bool(partialExecutionFlag, false, "optionally execute each message independently on the host chain instead of atomically")
 	return cmd
 }
 
-// generatePacketData takes in message bytes and a memo and serializes the message into an
+// generatePacketData takes in message bytes, a memo and a packet type and serializes the message into an
 // instance of InterchainAccountPacketData which is returned as bytes.
-func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string) ([]byte, error) {
+func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string, packetType icatypes.Type) ([]byte, error) {
 	protoMessages, err := convertBytesIntoProtoMessages(cdc, msgBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding)
+	return generateIcaPacketDataFromProtoMessages(cdc, protoMessages, memo, encoding, packetType)
 }
 
 // convertBytesIntoProtoMessages returns a list of proto messages from bytes. The bytes can be in the form of a single
@@ -141,15 +156,15 @@ func convertBytesIntoProtoMessages(cdc *codec.ProtoCodec, msgBytes []byte) ([]pr
 	return sdkMessages, nil
 }
 
-// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages and a memo.
-func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, encoding string) ([]byte, error) {
+// generateIcaPacketDataFromProtoMessages generates ica packet data as bytes from a given set of proto encoded sdk messages, a memo and a packet type.
+func generateIcaPacketDataFromProtoMessages(cdc *codec.ProtoCodec, sdkMessages []proto.Message, memo string, encoding string, packetType icatypes.Type) ([]byte, error) {
 	icaPacketDataBytes, err := icatypes.SerializeCosmosTx(cdc, sdkMessages, encoding)
 	if err != nil {
 		return nil, err
 	}
 
 	icaPacketData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
+		Type: packetType,
 		Data: icaPacketDataBytes,
 		Memo: memo,
 	}
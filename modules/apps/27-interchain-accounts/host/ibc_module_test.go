@@ -394,6 +394,26 @@ func (suite *InterchainAccountsTestSuite) TestOnChanCloseConfirm() {
 	}
 }
 
+// TestChannelForceClose tests that the host OnChanCloseConfirm callback, which is executed when the channel
+// is force closed, does not veto the closure and keeps the channel as the active channel for the account.
+func (suite *InterchainAccountsTestSuite) TestChannelForceClose() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanForceClose()
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+
+	channelID, found := suite.chainB.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointB.ChannelID, channelID)
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	var packetData []byte
 	testCases := []struct {
@@ -29,3 +29,15 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// emitMessagePolicyEvent emits an event signalling that the message policy of a connection or controller port was set or removed.
+func emitMessagePolicyEvent(ctx sdk.Context, eventType, connectionID, controllerPortID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerPortID, controllerPortID),
+		),
+	)
+}
@@ -33,6 +33,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, policy := range state.MessagePolicies {
+		keeper.SetMessagePolicy(ctx, policy)
+	}
+
+	for _, spend := range state.ConstraintSpends {
+		keeper.SetConstraintSpend(ctx, spend)
+	}
+
 	if err := state.Params.Validate(); err != nil {
 		panic(fmt.Errorf("could not set ica host params at genesis: %v", err))
 	}
@@ -46,5 +54,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllMessagePolicies(ctx),
+		keeper.GetAllConstraintSpends(ctx),
 	)
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
@@ -27,6 +29,12 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.HostPortID,
+		MessagePolicies: []types.MessagePolicy{
+			types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+		},
+		ConstraintSpends: []types.ConstraintSpend{
+			types.NewConstraintSpend(ibctesting.FirstConnectionID, TestPortID, "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(ibctesting.TestCoin)),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -43,6 +51,13 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 
+	policy, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessagePolicy(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.MessagePolicies[0], policy)
+
+	spent := suite.chainA.GetSimApp().ICAHostKeeper.GetConstraintSpend(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, "/cosmos.bank.v1beta1.MsgSend")
+	suite.Require().Equal(genesisState.ConstraintSpends[0].Spent, spent)
+
 	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 	suite.Require().True(store.Has(icatypes.KeyPort(icatypes.HostPortID)))
 
@@ -123,6 +138,12 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	expPolicy := types.NewMessagePolicy(path.EndpointB.ConnectionID, "", nil, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+
+	expSpend := types.NewConstraintSpend(path.EndpointB.ConnectionID, "", "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(ibctesting.TestCoin))
+	suite.chainB.GetSimApp().ICAHostKeeper.SetConstraintSpend(suite.chainB.GetContext(), expSpend)
+
 	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
 
 	suite.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -135,4 +156,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
+
+	suite.Require().Equal([]types.MessagePolicy{expPolicy}, genesisState.GetMessagePolicies())
+	suite.Require().Equal([]types.ConstraintSpend{expSpend}, genesisState.GetConstraintSpends())
 }
@@ -3,9 +3,18 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -19,3 +28,139 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// MessagePolicies implements the Query/MessagePolicies gRPC method
+func (k Keeper) MessagePolicies(c context.Context, req *types.QueryMessagePoliciesRequest) (*types.QueryMessagePoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var policies []types.MessagePolicy
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MessagePolicyKeyPrefix))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var policy types.MessagePolicy
+		if err := k.cdc.Unmarshal(value, &policy); err != nil {
+			return err
+		}
+
+		policies = append(policies, policy)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMessagePoliciesResponse{
+		Policies:   policies,
+		Pagination: pagination,
+	}, nil
+}
+
+// EffectiveMessagePolicy implements the Query/EffectiveMessagePolicy gRPC method
+func (k Keeper) EffectiveMessagePolicy(c context.Context, req *types.QueryEffectiveMessagePolicyRequest) (*types.QueryEffectiveMessagePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetRegisteredAccount(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "address %s", req.Address).Error())
+	}
+
+	policy, found := k.GetEffectiveMessagePolicy(ctx, account.ConnectionId, account.ControllerPortId)
+
+	return &types.QueryEffectiveMessagePolicyResponse{
+		Policy:  policy,
+		Default: !found,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accounts []types.RegisteredAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyConnectionAccounts(req.ConnectionId))
+	pagination, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		accounts = append(accounts, types.RegisteredAccount{
+			ConnectionId:     req.ConnectionId,
+			ControllerPortId: string(key),
+			Address:          string(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pagination,
+	}, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (k Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetRegisteredAccount(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "address %s", req.Address).Error())
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Account: account,
+	}, nil
+}
+
+// ActiveChannel implements the Query/ActiveChannel gRPC method
+func (k Keeper) ActiveChannel(c context.Context, req *types.QueryActiveChannelRequest) (*types.QueryActiveChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetRegisteredAccount(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "address %s", req.Address).Error())
+	}
+
+	channelID, found := k.GetActiveChannelID(ctx, account.ConnectionId, account.ControllerPortId)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "address %s", req.Address).Error())
+	}
+
+	return &types.QueryActiveChannelResponse{
+		ChannelId: channelID,
+		PortId:    icatypes.HostPortID,
+	}, nil
+}
@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -10,3 +17,417 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryMessagePolicies() {
+	var (
+		req         *types.QueryMessagePoliciesRequest
+		expPolicies []types.MessagePolicy
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no message policies",
+			func() {
+				expPolicies = nil
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expPolicies = expPolicies[:1]
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			expPolicies = []types.MessagePolicy{
+				types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+				types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, nil, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil),
+			}
+
+			req = &types.QueryMessagePoliciesRequest{}
+
+			tc.malleate()
+
+			for _, policy := range expPolicies {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetMessagePolicy(ctx, policy)
+			}
+
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.MessagePolicies(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPolicies, res.Policies)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEffectiveMessagePolicy() {
+	var (
+		req       *types.QueryEffectiveMessagePolicyRequest
+		expPolicy types.MessagePolicy
+		expCode   codes.Code
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expDefault bool
+	}{
+		{
+			"success: host params apply",
+			func() {
+				expPolicy = types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, nil)
+			},
+			true,
+		},
+		{
+			"success: controller port policy applies",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), expPolicy)
+			},
+			false,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+				expCode = codes.InvalidArgument
+			},
+			false,
+		},
+		{
+			"invalid address",
+			func() {
+				req.Address = "invalid"
+				expCode = codes.InvalidArgument
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.Address = ibctesting.TestAccAddress
+				expCode = codes.NotFound
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			req = &types.QueryEffectiveMessagePolicyRequest{Address: interchainAccAddr}
+			expPolicy = types.NewMessagePolicy(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+			expCode = codes.OK
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.EffectiveMessagePolicy(suite.chainB.GetContext(), req)
+
+			if expCode == codes.OK {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPolicy, res.Policy)
+				suite.Require().Equal(tc.expDefault, res.Default)
+			} else {
+				suite.Require().Equal(expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts []types.RegisteredAccount
+		expCode     codes.Code
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"success: multiple accounts with pagination",
+			func() {
+				for _, portID := range []string{"icacontroller-owner-1", "icacontroller-owner-2"} {
+					address := icatypes.GenerateAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID).String()
+					suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, portID, address)
+					expAccounts = append(expAccounts, types.RegisteredAccount{
+						ConnectionId:     ibctesting.FirstConnectionID,
+						ControllerPortId: portID,
+						Address:          address,
+					})
+				}
+
+				// accounts registered over other connections must not be returned
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), "connection-1", TestPortID, ibctesting.TestAccAddress)
+
+				req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+				expAccounts = expAccounts[:2]
+			},
+		},
+		{
+			"success: no accounts registered over connection",
+			func() {
+				req.ConnectionId = "connection-1"
+				expAccounts = nil
+			},
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+				expCode = codes.InvalidArgument
+			},
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid|connection"
+				expCode = codes.InvalidArgument
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			req = &types.QueryInterchainAccountsRequest{ConnectionId: path.EndpointB.ConnectionID}
+			expAccounts = []types.RegisteredAccount{
+				{
+					ConnectionId:     path.EndpointB.ConnectionID,
+					ControllerPortId: path.EndpointA.ChannelConfig.PortID,
+					Address:          interchainAccAddr,
+				},
+			}
+			expCode = codes.OK
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(suite.chainB.GetContext(), req)
+
+			if expCode == codes.OK {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expAccounts, res.Accounts)
+			} else {
+				suite.Require().Equal(expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		req     *types.QueryInterchainAccountRequest
+		expCode codes.Code
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+				expCode = codes.InvalidArgument
+			},
+		},
+		{
+			"invalid address",
+			func() {
+				req.Address = "invalid"
+				expCode = codes.InvalidArgument
+			},
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.Address = ibctesting.TestAccAddress
+				expCode = codes.NotFound
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			req = &types.QueryInterchainAccountRequest{Address: interchainAccAddr}
+			expCode = codes.OK
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(suite.chainB.GetContext(), req)
+
+			if expCode == codes.OK {
+				expAccount := types.RegisteredAccount{
+					ConnectionId:     path.EndpointB.ConnectionID,
+					ControllerPortId: path.EndpointA.ChannelConfig.PortID,
+					Address:          interchainAccAddr,
+				}
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccount, res.Account)
+			} else {
+				suite.Require().Equal(expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannel() {
+	var (
+		req     *types.QueryActiveChannelRequest
+		expCode codes.Code
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+				expCode = codes.InvalidArgument
+			},
+		},
+		{
+			"invalid address",
+			func() {
+				req.Address = "invalid"
+				expCode = codes.InvalidArgument
+			},
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.Address = ibctesting.TestAccAddress
+				expCode = codes.NotFound
+			},
+		},
+		{
+			"active channel not found",
+			func() {
+				address := icatypes.GenerateAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, "icacontroller-owner").String()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, "icacontroller-owner", address)
+
+				req.Address = address
+				expCode = codes.NotFound
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			req = &types.QueryActiveChannelRequest{Address: interchainAccAddr}
+			expCode = codes.OK
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannel(suite.chainB.GetContext(), req)
+
+			if expCode == codes.OK {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointB.ChannelID, res.ChannelId)
+				suite.Require().Equal(icatypes.HostPortID, res.PortId)
+			} else {
+				suite.Require().Equal(expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
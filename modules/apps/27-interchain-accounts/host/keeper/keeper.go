@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -36,7 +37,8 @@ type Keeper struct {
 
 	scopedKeeper exported.ScopedKeeper
 
-	msgRouter icatypes.MessageRouter
+	msgRouter   icatypes.MessageRouter
+	queryRouter icatypes.QueryRouter
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -48,7 +50,7 @@ func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, legacySubspace icatypes.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
 	accountKeeper icatypes.AccountKeeper, scopedKeeper exported.ScopedKeeper, msgRouter icatypes.MessageRouter,
-	authority string,
+	queryRouter icatypes.QueryRouter, authority string,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -69,6 +71,7 @@ func NewKeeper(
 		accountKeeper:  accountKeeper,
 		scopedKeeper:   scopedKeeper,
 		msgRouter:      msgRouter,
+		queryRouter:    queryRouter,
 		authority:      authority,
 	}
 }
@@ -226,10 +229,39 @@ func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.Registe
 	return interchainAccounts
 }
 
-// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID
+// SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID.
+// The address is additionally indexed by connectionID and by address for the interchain account queries.
 func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
+
+	k.setRegisteredAccount(ctx, types.RegisteredAccount{
+		ConnectionId:     connectionID,
+		ControllerPortId: portID,
+		Address:          address,
+	})
+}
+
+// GetRegisteredAccount retrieves the connectionID and controller portID associated with the provided interchain account address
+func (k Keeper) GetRegisteredAccount(ctx sdk.Context, address string) (types.RegisteredAccount, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRegisteredAccount(address))
+	if bz == nil {
+		return types.RegisteredAccount{}, false
+	}
+
+	var account types.RegisteredAccount
+	k.cdc.MustUnmarshal(bz, &account)
+	return account, true
+}
+
+// setRegisteredAccount indexes the provided interchain account by its connectionID and by its address
+func (k Keeper) setRegisteredAccount(ctx sdk.Context, account types.RegisteredAccount) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyConnectionAccount(account.ConnectionId, account.ControllerPortId), []byte(account.Address))
+
+	bz := k.cdc.MustMarshal(&account)
+	store.Set(types.KeyRegisteredAccount(account.Address), bz)
 }
 
 // GetAuthority returns the 27-interchain-accounts host submodule's authority.
@@ -256,3 +288,181 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	bz := k.cdc.MustMarshal(&params)
 	store.Set([]byte(types.ParamsKey), bz)
 }
+
+// GetMessagePolicy returns the message policy set for the provided connectionID and controller portID.
+// An empty portID returns the policy set for every controller port of the connection.
+func (k Keeper) GetMessagePolicy(ctx sdk.Context, connectionID, portID string) (types.MessagePolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMessagePolicy(connectionID, portID))
+	if bz == nil {
+		return types.MessagePolicy{}, false
+	}
+
+	var policy types.MessagePolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// SetMessagePolicy stores the provided message policy, keyed by its connectionID and controller portID
+func (k Keeper) SetMessagePolicy(ctx sdk.Context, policy types.MessagePolicy) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(types.KeyMessagePolicy(policy.ConnectionId, policy.ControllerPortId), bz)
+}
+
+// DeleteMessagePolicy removes the message policy set for the provided connectionID and controller portID
+func (k Keeper) DeleteMessagePolicy(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMessagePolicy(connectionID, portID))
+}
+
+// GetAllMessagePolicies returns a list of all message policies
+func (k Keeper) GetAllMessagePolicies(ctx sdk.Context) []types.MessagePolicy {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.MessagePolicyKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var policies []types.MessagePolicy
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.MessagePolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// GetConstraintSpend returns the amount spent under the constraint for the provided message typeURL of the message
+// policy set for the provided connectionID and controller portID
+func (k Keeper) GetConstraintSpend(ctx sdk.Context, connectionID, portID, typeURL string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyConstraintSpend(connectionID, portID, typeURL))
+	if bz == nil {
+		return nil
+	}
+
+	var spend types.ConstraintSpend
+	k.cdc.MustUnmarshal(bz, &spend)
+	return spend.Spent
+}
+
+// SetConstraintSpend stores the provided constraint spend, keyed by its connectionID, controller portID and message typeURL
+func (k Keeper) SetConstraintSpend(ctx sdk.Context, spend types.ConstraintSpend) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&spend)
+	store.Set(types.KeyConstraintSpend(spend.ConnectionId, spend.ControllerPortId, spend.TypeUrl), bz)
+}
+
+// DeleteConstraintSpends removes the amounts spent under the constraints of the message policy set for the provided
+// connectionID and controller portID
+func (k Keeper) DeleteConstraintSpends(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPolicyConstraintSpends(connectionID, portID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	// keys are deleted once the iterator is closed, as the store must not be written to while iterating
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllConstraintSpends returns a list of the amounts spent under the constraints of all message policies
+func (k Keeper) GetAllConstraintSpends(ctx sdk.Context) []types.ConstraintSpend {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ConstraintSpendKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var spends []types.ConstraintSpend
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.ConstraintSpend
+		k.cdc.MustUnmarshal(iterator.Value(), &spend)
+
+		spends = append(spends, spend)
+	}
+
+	return spends
+}
+
+// spendConstraints adds the amounts sent by the provided msg, and by the messages it executes on behalf of other
+// accounts, to the amounts spent under the constraints of the effective message policy of the interchain account
+// registered over the provided connectionID and controller portID. An error is returned if the total amount spent
+// under a constraint exceeds its max amount. The amount is accounted to the policy defining the constraint.
+func (k Keeper) spendConstraints(ctx sdk.Context, connectionID, portID string, msg sdk.Msg) error {
+	policy, found := k.GetEffectiveMessagePolicy(ctx, connectionID, portID)
+	if !found {
+		return nil
+	}
+
+	portPolicy, _ := k.GetMessagePolicy(ctx, connectionID, portID)
+
+	msgs, err := types.UnpackExecMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		typeURL := types.ConstraintTypeURL(msg)
+		constraint, found := policy.GetConstraint(typeURL)
+		if !found || constraint.MaxAmount.Empty() {
+			continue
+		}
+
+		amount, _, ok := types.GetConstrainedAmount(msg)
+		if !ok {
+			continue
+		}
+
+		policyPortID := ""
+		if _, found := portPolicy.GetConstraint(typeURL); found {
+			policyPortID = portID
+		}
+
+		spent := k.GetConstraintSpend(ctx, connectionID, policyPortID, typeURL).Add(amount...)
+		if !spent.IsAllLTE(constraint.MaxAmount) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "total amount %s exceeds the maximum amount %s for message type %s", spent, constraint.MaxAmount, typeURL)
+		}
+
+		k.SetConstraintSpend(ctx, types.NewConstraintSpend(connectionID, policyPortID, typeURL, spent))
+	}
+
+	return nil
+}
+
+// GetEffectiveMessagePolicy returns the message policy applied to the interchain account registered over the provided
+// connectionID by the provided controller portID. The policy set for the controller port is merged with the policy set
+// for every controller port of the connection, see types.MergeMessagePolicies. If no policy is set, a policy allowing the
+// allow messages host parameter is returned along with false. If neither policy sets allowed messages, the allowed
+// messages of the returned policy are set to the allow messages host parameter, excluding the allow all wildcard, so
+// that a policy only denying messages never allows more messages than explicitly listed.
+func (k Keeper) GetEffectiveMessagePolicy(ctx sdk.Context, connectionID, portID string) (types.MessagePolicy, bool) {
+	allowMsgs := k.GetParams(ctx).AllowMessages
+
+	connectionPolicy, connectionFound := k.GetMessagePolicy(ctx, connectionID, "")
+
+	var (
+		portPolicy types.MessagePolicy
+		portFound  bool
+	)
+	if portID != "" {
+		portPolicy, portFound = k.GetMessagePolicy(ctx, connectionID, portID)
+	}
+
+	if !connectionFound && !portFound {
+		return types.NewMessagePolicy(connectionID, "", allowMsgs, nil, nil), false
+	}
+
+	policy := types.MergeMessagePolicies(connectionID, connectionPolicy, portPolicy)
+	if len(policy.AllowMessages) == 0 {
+		policy.AllowMessages = slices.DeleteFunc(slices.Clone(allowMsgs), func(typeURL string) bool {
+			return typeURL == types.AllowAllHostMsgs
+		})
+	}
+
+	return policy, true
+}
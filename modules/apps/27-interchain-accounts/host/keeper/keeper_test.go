@@ -6,6 +6,7 @@ import (
 
 	testifysuite "github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
 	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
@@ -147,6 +148,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				suite.chainA.GetSimApp().AccountKeeper,
 				suite.chainA.GetSimApp().ScopedICAHostKeeper,
 				suite.chainA.GetSimApp().MsgServiceRouter(),
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
 				suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(),
 			)
 		}, true},
@@ -161,6 +163,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				authkeeper.AccountKeeper{}, // empty account keeper
 				suite.chainA.GetSimApp().ScopedICAHostKeeper,
 				suite.chainA.GetSimApp().MsgServiceRouter(),
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
 				suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(),
 			)
 		}, false},
@@ -175,6 +178,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				suite.chainA.GetSimApp().AccountKeeper,
 				suite.chainA.GetSimApp().ScopedICAHostKeeper,
 				suite.chainA.GetSimApp().MsgServiceRouter(),
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
 				"", // authority
 			)
 		}, false},
@@ -289,6 +293,154 @@ func (suite *KeeperTestSuite) TestGetAllInterchainAccounts() {
 	suite.Require().Equal(expectedAccounts, interchainAccounts)
 }
 
+func (suite *KeeperTestSuite) TestGetAllMessagePolicies() {
+	suite.SetupTest()
+
+	expectedPolicies := []types.MessagePolicy{
+		types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil),
+		types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, nil, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil),
+	}
+
+	for _, policy := range expectedPolicies {
+		suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+	}
+
+	policies := suite.chainB.GetSimApp().ICAHostKeeper.GetAllMessagePolicies(suite.chainB.GetContext())
+	suite.Require().Equal(expectedPolicies, policies)
+
+	suite.chainB.GetSimApp().ICAHostKeeper.DeleteMessagePolicy(suite.chainB.GetContext(), ibctesting.FirstConnectionID, "")
+
+	policies = suite.chainB.GetSimApp().ICAHostKeeper.GetAllMessagePolicies(suite.chainB.GetContext())
+	suite.Require().Equal(expectedPolicies[1:], policies)
+}
+
+func (suite *KeeperTestSuite) TestGetEffectiveMessagePolicy() {
+	var (
+		connectionPolicy types.MessagePolicy
+		portPolicy       types.MessagePolicy
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPolicy func() types.MessagePolicy
+		expFound  bool
+	}{
+		{
+			"no policy set, host params apply",
+			func() {},
+			func() types.MessagePolicy {
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, nil)
+			},
+			false,
+		},
+		{
+			"connection wide policy",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+			},
+			func() types.MessagePolicy { return connectionPolicy },
+			true,
+		},
+		{
+			"controller port policy is merged with connection wide policy",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+			},
+			func() types.MessagePolicy {
+				// the allowed messages are inherited from the connection wide policy
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+			},
+			true,
+		},
+		{
+			"controller port policy allowed messages take precedence over connection wide policy",
+			func() {
+				portPolicy.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+			},
+			func() types.MessagePolicy {
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.staking.v1beta1.MsgDelegate"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+			},
+			true,
+		},
+		{
+			"denied messages and constraints are merged",
+			func() {
+				sendConstraint := types.NewMessageConstraint("/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil)
+				delegateConstraint := types.NewMessageConstraint("/cosmos.staking.v1beta1.MsgDelegate", nil, []string{"validator"})
+				connectionPolicy.DenyMessages = []string{"/cosmos.staking.v1beta1.MsgUndelegate", "/cosmos.bank.v1beta1.MsgSend"}
+				connectionPolicy.Constraints = []types.MessageConstraint{sendConstraint, delegateConstraint}
+
+				sendConstraint.MaxAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+				portPolicy.Constraints = []types.MessageConstraint{sendConstraint}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+			},
+			func() types.MessagePolicy {
+				constraints := []types.MessageConstraint{portPolicy.Constraints[0], connectionPolicy.Constraints[1]}
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, []string{"/cosmos.staking.v1beta1.MsgUndelegate", "/cosmos.bank.v1beta1.MsgSend"}, constraints)
+			},
+			true,
+		},
+		{
+			"controller port policy only denying messages does not inherit the allow all wildcard of the host params",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+			},
+			func() types.MessagePolicy {
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+			},
+			true,
+		},
+		{
+			"controller port policy only denying messages inherits the explicitly allowed messages of the host params",
+			func() {
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+			},
+			func() types.MessagePolicy {
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+			},
+			true,
+		},
+		{
+			"policy set for a different controller port is not applied",
+			func() {
+				policy := portPolicy
+				policy.ControllerPortId = "icacontroller-other"
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			func() types.MessagePolicy {
+				return types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, nil)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			connectionPolicy = types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+			portPolicy = types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, nil, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+
+			tc.malleate()
+
+			policy, found := suite.chainB.GetSimApp().ICAHostKeeper.GetEffectiveMessagePolicy(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+			suite.Require().Equal(tc.expFound, found)
+			suite.Require().Equal(tc.expPolicy(), policy)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIsActiveChannel() {
 	suite.SetupTest()
 
@@ -313,6 +465,19 @@ func (suite *KeeperTestSuite) TestSetInterchainAccountAddress() {
 	retrievedAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, expectedPortID)
 	suite.Require().True(found)
 	suite.Require().Equal(expectedAccAddr, retrievedAddr)
+
+	expAccount := types.RegisteredAccount{
+		ConnectionId:     ibctesting.FirstConnectionID,
+		ControllerPortId: expectedPortID,
+		Address:          expectedAccAddr,
+	}
+
+	account, found := suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredAccount(suite.chainB.GetContext(), expectedAccAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(expAccount, account)
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredAccount(suite.chainB.GetContext(), "unregistered-acc-addr")
+	suite.Require().False(found)
 }
 
 func (suite *KeeperTestSuite) TestMetadataNotFound() {
@@ -32,3 +32,19 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// MigrateInterchainAccountIndexes indexes the interchain accounts registered on the host submodule by connection
+// and by address.
+func (m Migrator) MigrateInterchainAccountIndexes(ctx sdk.Context) error {
+	if m.keeper != nil {
+		for _, account := range m.keeper.GetAllInterchainAccounts(ctx) {
+			m.keeper.setRegisteredAccount(ctx, types.RegisteredAccount{
+				ConnectionId:     account.ConnectionId,
+				ControllerPortId: account.PortId,
+				Address:          account.AccountAddress,
+			})
+		}
+		m.keeper.Logger(ctx).Info("successfully migrated ica/host submodule to index interchain accounts by connection and address")
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
 func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
@@ -41,3 +42,42 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigratorMigrateInterchainAccountIndexes() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// remove the indexes to simulate an interchain account registered prior to the migration
+	store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(icahosttypes.StoreKey))
+	store.Delete(icahosttypes.KeyConnectionAccount(path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+	store.Delete(icahosttypes.KeyRegisteredAccount(interchainAccAddr))
+
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredAccount(suite.chainB.GetContext(), interchainAccAddr)
+	suite.Require().False(found)
+
+	migrator := icahostkeeper.NewMigrator(&suite.chainB.GetSimApp().ICAHostKeeper)
+	err = migrator.MigrateInterchainAccountIndexes(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+
+	expAccount := icahosttypes.RegisteredAccount{
+		ConnectionId:     path.EndpointB.ConnectionID,
+		ControllerPortId: path.EndpointA.ChannelConfig.PortID,
+		Address:          interchainAccAddr,
+	}
+
+	account, found := suite.chainB.GetSimApp().ICAHostKeeper.GetRegisteredAccount(suite.chainB.GetContext(), interchainAccAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(expAccount, account)
+
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(suite.chainB.GetContext(), &icahosttypes.QueryInterchainAccountsRequest{ConnectionId: path.EndpointB.ConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]icahosttypes.RegisteredAccount{expAccount}, res.Accounts)
+}
@@ -2,12 +2,16 @@ package keeper
 
 import (
 	"context"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
@@ -34,3 +38,74 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ModuleQuerySafe routes the provided queries to the appropriate query handlers and returns the responses.
+// Only query paths present in the host submodule's allow queries parameter may be executed. The queries are
+// executed against the current context, so their gas consumption is charged to the same gas meter used to
+// execute the messages of an interchain account transaction.
+func (m msgServer) ModuleQuerySafe(goCtx context.Context, msg *types.MsgModuleQuerySafe) (*types.MsgModuleQuerySafeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowQueries := m.GetParams(ctx).AllowQueries
+
+	responses := make([][]byte, len(msg.Requests))
+	for i, query := range msg.Requests {
+		if !slices.Contains(allowQueries, query.Path) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "query path not allowed: %s", query.Path)
+		}
+
+		route := m.queryRouter.Route(query.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrInvalidRoute, "no route to query: %s", query.Path)
+		}
+
+		res, err := route(ctx, &abci.RequestQuery{
+			Path: query.Path,
+			Data: query.Data,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "query failed: %s", query.Path)
+		}
+
+		responses[i] = res.Value
+	}
+
+	return &types.MsgModuleQuerySafeResponse{
+		Responses: responses,
+		Height:    uint64(ctx.BlockHeight()),
+	}, nil
+}
+
+// SetMessagePolicy sets the message policy of a connection or controller port.
+func (m msgServer) SetMessagePolicy(goCtx context.Context, msg *types.MsgSetMessagePolicy) (*types.MsgSetMessagePolicyResponse, error) {
+	if m.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", m.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.Keeper.SetMessagePolicy(ctx, msg.Policy)
+	m.DeleteConstraintSpends(ctx, msg.Policy.ConnectionId, msg.Policy.ControllerPortId)
+
+	emitMessagePolicyEvent(ctx, icatypes.EventTypeSetMessagePolicy, msg.Policy.ConnectionId, msg.Policy.ControllerPortId)
+
+	return &types.MsgSetMessagePolicyResponse{}, nil
+}
+
+// RemoveMessagePolicy removes the message policy of a connection or controller port.
+func (m msgServer) RemoveMessagePolicy(goCtx context.Context, msg *types.MsgRemoveMessagePolicy) (*types.MsgRemoveMessagePolicyResponse, error) {
+	if m.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", m.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetMessagePolicy(ctx, msg.ConnectionId, msg.ControllerPortId); !found {
+		return nil, errorsmod.Wrapf(types.ErrMessagePolicyNotFound, "connection ID (%s) controller port ID (%s)", msg.ConnectionId, msg.ControllerPortId)
+	}
+
+	m.DeleteMessagePolicy(ctx, msg.ConnectionId, msg.ControllerPortId)
+	m.DeleteConstraintSpends(ctx, msg.ConnectionId, msg.ControllerPortId)
+
+	emitMessagePolicyEvent(ctx, icatypes.EventTypeRemoveMessagePolicy, msg.ConnectionId, msg.ControllerPortId)
+
+	return &types.MsgRemoveMessagePolicyResponse{}, nil
+}
@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -43,3 +49,211 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestModuleQuerySafe() {
+	var (
+		msg          *types.MsgModuleQuerySafe
+		allowQueries []string
+	)
+
+	balancePath := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple queries",
+			func() {
+				msg.Requests = append(msg.Requests, msg.Requests[0])
+			},
+			nil,
+		},
+		{
+			"failure: query path not allowed",
+			func() {
+				allowQueries = nil
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: no route to query",
+			func() {
+				invalidPath := "/cosmos.invalid.v1beta1.Query/Invalid"
+				allowQueries = append(allowQueries, invalidPath)
+				msg.Requests = append(msg.Requests, types.QueryRequest{Path: invalidPath})
+			},
+			icatypes.ErrInvalidRoute,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			addr := suite.chainA.SenderAccount.GetAddress()
+			balanceQuery := banktypes.NewQueryBalanceRequest(addr, sdk.DefaultBondDenom)
+			queryBz, err := balanceQuery.Marshal()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgModuleQuerySafe(addr.String(), []types.QueryRequest{{Path: balancePath, Data: queryBz}})
+			allowQueries = []string{balancePath}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			params := types.DefaultParams()
+			params.AllowQueries = allowQueries
+			suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.ModuleQuerySafe(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(ctx.BlockHeight()), res.Height)
+				suite.Require().Len(res.Responses, len(msg.Requests))
+
+				var balanceRes banktypes.QueryBalanceResponse
+				err = balanceRes.Unmarshal(res.Responses[0])
+				suite.Require().NoError(err)
+
+				expBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expBalance, *balanceRes.Balance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetMessagePolicy() {
+	policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMessagePolicy
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgSetMessagePolicy(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), policy),
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			types.NewMsgSetMessagePolicy(ibctesting.TestAccAddress, policy),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			spent := sdk.NewCoins(ibctesting.TestCoin)
+			suite.chainA.GetSimApp().ICAHostKeeper.SetConstraintSpend(ctx, types.NewConstraintSpend(policy.ConnectionId, policy.ControllerPortId, "/cosmos.bank.v1beta1.MsgSend", spent))
+			suite.chainA.GetSimApp().ICAHostKeeper.SetConstraintSpend(ctx, types.NewConstraintSpend(policy.ConnectionId, "", "/cosmos.bank.v1beta1.MsgSend", spent))
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.SetMessagePolicy(ctx, tc.msg)
+
+			storedPolicy, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessagePolicy(ctx, policy.ConnectionId, policy.ControllerPortId)
+			portSpent := suite.chainA.GetSimApp().ICAHostKeeper.GetConstraintSpend(ctx, policy.ConnectionId, policy.ControllerPortId, "/cosmos.bank.v1beta1.MsgSend")
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().True(found)
+				suite.Require().Equal(policy, storedPolicy)
+				suite.Require().Empty(portSpent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().False(found)
+				suite.Require().Equal(spent, portSpent)
+			}
+
+			// the amounts spent under the constraints of other policies are not reset
+			suite.Require().Equal(spent, suite.chainA.GetSimApp().ICAHostKeeper.GetConstraintSpend(ctx, policy.ConnectionId, "", "/cosmos.bank.v1beta1.MsgSend"))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveMessagePolicy() {
+	var msg *types.MsgRemoveMessagePolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: message policy not found",
+			func() {
+				msg.ControllerPortId = ""
+			},
+			types.ErrMessagePolicyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, TestPortID, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+			suite.chainA.GetSimApp().ICAHostKeeper.SetMessagePolicy(ctx, policy)
+
+			spent := sdk.NewCoins(ibctesting.TestCoin)
+			suite.chainA.GetSimApp().ICAHostKeeper.SetConstraintSpend(ctx, types.NewConstraintSpend(policy.ConnectionId, policy.ControllerPortId, "/cosmos.bank.v1beta1.MsgSend", spent))
+
+			msg = types.NewMsgRemoveMessagePolicy(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), policy.ConnectionId, policy.ControllerPortId)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.RemoveMessagePolicy(ctx, msg)
+
+			_, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMessagePolicy(ctx, policy.ConnectionId, policy.ControllerPortId)
+			portSpent := suite.chainA.GetSimApp().ICAHostKeeper.GetConstraintSpend(ctx, policy.ConnectionId, policy.ControllerPortId, "/cosmos.bank.v1beta1.MsgSend")
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().False(found)
+				suite.Require().Empty(portSpent)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().True(found)
+				suite.Require().Equal(spent, portSpent)
+			}
+		})
+	}
+}
@@ -8,7 +8,6 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
@@ -41,6 +40,17 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
 		return txResponse, nil
+	case icatypes.EXECUTE_TX_PARTIAL:
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
+		}
+
+		txResponse, err := k.executeTxPartial(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+		}
+		return txResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
@@ -56,7 +66,8 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	connectionID := channel.ConnectionHops[0]
+	if err := k.authenticateTx(ctx, msgs, connectionID, sourcePort); err != nil {
 		return nil, err
 	}
 
@@ -74,6 +85,11 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 			}
 		}
 
+		// the amounts spent under message constraints are recorded in the cached context to be reverted on failure
+		if err := k.spendConstraints(cacheCtx, connectionID, sourcePort, msg); err != nil {
+			return nil, err
+		}
+
 		protoAny, err := k.executeMsg(cacheCtx, msg)
 		if err != nil {
 			return nil, err
@@ -92,18 +108,87 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	return txResponse, nil
 }
 
+// executeTxPartial attempts to execute each message of the provided transaction independently. Every message is
+// authenticated, validated and delivered in its own cached context, which is only written if the message succeeds.
+// A failing message does not revert the state changes of the other messages. The result of each message, containing
+// its response or the deterministic error code on failure, is returned in the marshaled TxMsgResults.
+func (k Keeper) executeTxPartial(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	txMsgResults := &icatypes.TxMsgResults{
+		Results: make([]icatypes.MsgResult, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		protoAny, err := k.executeSingleMsg(ctx, channel.ConnectionHops[0], sourcePort, msg)
+		if err != nil {
+			codespace, code, _ := errorsmod.ABCIInfo(err, false)
+			txMsgResults.Results[i] = icatypes.MsgResult{
+				Codespace: codespace,
+				Code:      code,
+			}
+
+			continue
+		}
+
+		txMsgResults.Results[i] = icatypes.MsgResult{
+			Success:  true,
+			Response: protoAny,
+		}
+	}
+
+	txResponse, err := proto.Marshal(txMsgResults)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx msg results")
+	}
+
+	return txResponse, nil
+}
+
+// executeSingleMsg authenticates, validates and executes the provided msg in a cached context. The state changes,
+// including the amounts spent under message constraints, are only committed if the msg succeeds.
+func (k Keeper) executeSingleMsg(ctx sdk.Context, connectionID, sourcePort string, msg sdk.Msg) (*codectypes.Any, error) {
+	if err := k.authenticateTx(ctx, []sdk.Msg{msg}, connectionID, sourcePort); err != nil {
+		return nil, err
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.spendConstraints(cacheCtx, connectionID, sourcePort, msg); err != nil {
+		return nil, err
+	}
+
+	protoAny, err := k.executeMsg(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	return protoAny, nil
+}
+
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// from state using the provided controller port identifier and are accepted by the effective message policy
+// of the interchain account
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
+	policy, _ := k.GetEffectiveMessagePolicy(ctx, connectionID, portID)
 	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		if err := policy.Accept(msg); err != nil {
+			return err
 		}
 
 		// obtain the message signers using the proto signer annotations
@@ -6,10 +6,13 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -487,6 +490,349 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by a controller port message policy",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, []string{sdk.MsgTypeURL(msg)}, nil, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			nil,
+		},
+		{
+			"unauthorised: banktypes.MsgSend denied by a connection message policy",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, []string{sdk.MsgTypeURL(msg)}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend within message policy constraints",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msg), msg.Amount, []string{msg.ToAddress})
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			nil,
+		},
+		{
+			"unauthorised: banktypes.MsgSend amount exceeds message policy constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(99)))
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msg), maxAmount, nil)
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: banktypes.MsgSend amounts exceed message policy constraint in total",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg, msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msg), maxAmount, nil)
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: banktypes.MsgSend amount exceeds the amount remaining under message policy constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msg), maxAmount, nil)
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+
+				// amount spent by previously executed messages
+				spend := types.NewConstraintSpend(ibctesting.FirstConnectionID, "", sdk.MsgTypeURL(msg), msg.Amount)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetConstraintSpend(suite.chainB.GetContext(), spend)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: banktypes.MsgMultiSend amount exceeds banktypes.MsgSend message policy constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60)))
+				msg := &banktypes.MsgMultiSend{
+					Inputs: []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(interchainAccountAddr), amount.Add(amount...))},
+					Outputs: []banktypes.Output{
+						banktypes.NewOutput(suite.chainB.SenderAccount.GetAddress(), amount),
+						banktypes.NewOutput(suite.chainB.SenderAccount.GetAddress(), amount),
+					},
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL((*banktypes.MsgSend)(nil)), maxAmount, nil)
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: authz.MsgExec executes banktypes.MsgSend exceeding message policy constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msgSend := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+				msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(interchainAccountAddr), []sdk.Msg{msgSend})
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{&msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(99)))
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msgSend), maxAmount, nil)
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(&msg), sdk.MsgTypeURL(msgSend)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: banktypes.MsgSend recipient not allowed by message policy constraint",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				constraint := types.NewMessageConstraint(sdk.MsgTypeURL(msg), nil, []string{interchainAccountAddr})
+				policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(msg)}, nil, []types.MessageConstraint{constraint})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), policy)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"interchain account successfully executes types.MsgModuleQuerySafe",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				balanceQuery := banktypes.NewQueryBalanceRequest(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+				queryBz, err := balanceQuery.Marshal()
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgModuleQuerySafe(interchainAccountAddr, []types.QueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: queryBz,
+					},
+				})
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/Balance"}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"unauthorised: query path not allowed for types.MsgModuleQuerySafe",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				balanceQuery := banktypes.NewQueryBalanceRequest(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.DefaultBondDenom)
+				queryBz, err := balanceQuery.Marshal()
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgModuleQuerySafe(interchainAccountAddr, []types.QueryRequest{
+					{
+						Path: "/cosmos.bank.v1beta1.Query/Balance",
+						Data: queryBz,
+					},
+				})
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
 	}
 
 	for _, encoding := range testedEncodings {
@@ -546,6 +892,197 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketPartialExecution() {
+	testedEncodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+
+	for _, encoding := range testedEncodings {
+		suite.Run(fmt.Sprintf("partial execution with %s encoding", encoding), func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, encoding)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))))
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+
+			msgs := []proto.Message{
+				// succeeds
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   recipient.String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				},
+				// fails with insufficient funds
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   recipient.String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))),
+				},
+				// fails as the message type is not allowed
+				&stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				},
+				// succeeds
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   recipient.String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))),
+				},
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, encoding)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX_PARTIAL,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgSend)(nil))})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			var txMsgResults icatypes.TxMsgResults
+			err = proto.Unmarshal(txResponse, &txMsgResults)
+			suite.Require().NoError(err)
+			suite.Require().Len(txMsgResults.Results, len(msgs))
+
+			expSuccess := []bool{true, false, false, true}
+			for i, result := range txMsgResults.Results {
+				suite.Require().Equal(expSuccess[i], result.Success)
+				if result.Success {
+					suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), result.Response.TypeUrl)
+					suite.Require().Zero(result.Code)
+				} else {
+					suite.Require().Nil(result.Response)
+					suite.Require().NotZero(result.Code)
+				}
+			}
+
+			_, insufficientFundsCode, _ := errorsmod.ABCIInfo(sdkerrors.ErrInsufficientFunds, false)
+			suite.Require().Equal(insufficientFundsCode, txMsgResults.Results[1].Code)
+
+			_, unauthorizedCode, _ := errorsmod.ABCIInfo(ibcerrors.ErrUnauthorized, false)
+			suite.Require().Equal(unauthorizedCode, txMsgResults.Results[2].Code)
+
+			// only the state changes of the successful messages are committed
+			balanceAfter := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore.Amount.AddRaw(300), balanceAfter.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketConstraintSpend() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(250))))
+
+	msgSendTypeURL := sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(400)))
+
+	// the constraint of the controller port policy takes precedence over the constraint of the connection policy
+	connectionPolicy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{msgSendTypeURL}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, maxAmount, nil)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), connectionPolicy)
+
+	portPolicy := types.NewMessagePolicy(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, maxAmount, nil)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetMessagePolicy(suite.chainB.GetContext(), portPolicy)
+
+	newMsgSend := func(amount int64) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(amount))),
+		}
+	}
+
+	msgs := []proto.Message{
+		// succeeds
+		newMsgSend(100),
+		// fails with insufficient funds, the amount is not spent under the constraint
+		newMsgSend(200),
+		// succeeds
+		newMsgSend(100),
+		// fails as the total amount exceeds the constraint
+		newMsgSend(250),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX_PARTIAL,
+		Data: data,
+	}
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		suite.chainA.SenderAccount.GetSequence(),
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		suite.chainB.GetTimeoutHeight(),
+		0,
+	)
+
+	txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+	suite.Require().NoError(err)
+
+	var txMsgResults icatypes.TxMsgResults
+	err = proto.Unmarshal(txResponse, &txMsgResults)
+	suite.Require().NoError(err)
+
+	expSuccess := []bool{true, false, true, false}
+	for i, result := range txMsgResults.Results {
+		suite.Require().Equal(expSuccess[i], result.Success)
+	}
+
+	_, insufficientFundsCode, _ := errorsmod.ABCIInfo(sdkerrors.ErrInsufficientFunds, false)
+	suite.Require().Equal(insufficientFundsCode, txMsgResults.Results[1].Code)
+
+	_, unauthorizedCode, _ := errorsmod.ABCIInfo(ibcerrors.ErrUnauthorized, false)
+	suite.Require().Equal(unauthorizedCode, txMsgResults.Results[3].Code)
+
+	// only the amounts of the successful messages are spent, under the constraint of the controller port policy
+	spent := suite.chainB.GetSimApp().ICAHostKeeper.GetConstraintSpend(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, msgSendTypeURL)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))), spent)
+
+	spent = suite.chainB.GetSimApp().ICAHostKeeper.GetConstraintSpend(suite.chainB.GetContext(), ibctesting.FirstConnectionID, "", msgSendTypeURL)
+	suite.Require().Empty(spent)
+}
+
 func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	var (
 		path       *ibctesting.Path
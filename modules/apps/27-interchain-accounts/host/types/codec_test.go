@@ -23,6 +23,21 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			true,
 		},
+		{
+			"success: MsgModuleQuerySafe",
+			sdk.MsgTypeURL(&types.MsgModuleQuerySafe{}),
+			true,
+		},
+		{
+			"success: MsgSetMessagePolicy",
+			sdk.MsgTypeURL(&types.MsgSetMessagePolicy{}),
+			true,
+		},
+		{
+			"success: MsgRemoveMessagePolicy",
+			sdk.MsgTypeURL(&types.MsgRemoveMessagePolicy{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
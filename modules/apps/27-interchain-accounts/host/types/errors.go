@@ -7,4 +7,5 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrMessagePolicyNotFound = errorsmod.Register(SubModuleName, 3, "message policy not found")
 )
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -29,6 +32,11 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// allow_queries defines a list of fully qualified gRPC query method paths
+	// (e.g. /cosmos.bank.v1beta1.Query/Balance) that may be executed on a host chain
+	// through MsgModuleQuerySafe. Only queries with deterministic results and gas
+	// consumption should be added to this list.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,8 +86,376 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+// QueryRequest defines the parameters for a particular query request
+// by an interchain account.
+type QueryRequest struct {
+	// path defines the path of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the payload of the query request as defined by ADR-021.
+	// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md#custom-query-registration-and-routing
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MessagePolicy defines the message types which the interchain accounts registered over a connection,
+// optionally restricted to a single controller port, are authorized to execute on a host chain. A policy
+// set for a controller port is merged with the policy set for all controller ports of the connection: the denied
+// messages of both policies apply, while the allowed messages and constraints of the controller port policy take
+// precedence.
+type MessagePolicy struct {
+	// connection_id defines the host connection identifier the policy applies to.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller_port_id defines the controller port identifier the policy applies to. If empty, the policy
+	// applies to every controller port of the connection.
+	ControllerPortId string `protobuf:"bytes,2,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed. If empty, the allowed messages of
+	// the connection policy are used, or else the message typeURLs of the allow_messages host parameter excluding the
+	// allow all wildcard.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// deny_messages defines a list of sdk message typeURLs which may not be executed, even if allowed.
+	DenyMessages []string `protobuf:"bytes,4,rep,name=deny_messages,json=denyMessages,proto3" json:"deny_messages,omitempty"`
+	// constraints defines optional restrictions on the fields of allowed messages.
+	Constraints []MessageConstraint `protobuf:"bytes,5,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *MessagePolicy) Reset()         { *m = MessagePolicy{} }
+func (m *MessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MessagePolicy) ProtoMessage()    {}
+func (*MessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *MessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePolicy.Merge(m, src)
+}
+func (m *MessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePolicy proto.InternalMessageInfo
+
+func (m *MessagePolicy) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MessagePolicy) GetControllerPortId() string {
+	if m != nil {
+		return m.ControllerPortId
+	}
+	return ""
+}
+
+func (m *MessagePolicy) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+func (m *MessagePolicy) GetDenyMessages() []string {
+	if m != nil {
+		return m.DenyMessages
+	}
+	return nil
+}
+
+func (m *MessagePolicy) GetConstraints() []MessageConstraint {
+	if m != nil {
+		return m.Constraints
+	}
+	return nil
+}
+
+// MessageConstraint restricts the amount and recipient of a message type. The supported message types are
+// bank MsgSend, staking MsgDelegate and MsgBeginRedelegate and transfer MsgTransfer. A bank MsgSend constraint
+// also applies to bank MsgMultiSend, and constraints apply to the messages executed by an authz MsgExec.
+type MessageConstraint struct {
+	// type_url defines the sdk message typeURL the constraint applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// max_amount defines the maximum total amount which may be sent by the messages of the type executed under the
+	// policy defining the constraint. The amount spent accumulates over every message and interchain account the
+	// policy applies to and is reset when the policy is set or removed. If empty, the amount is not restricted.
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_amount,json=maxAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amount"`
+	// allowed_recipients defines the recipient addresses, validator addresses for staking messages, which may
+	// receive the amount of the message. If empty, the recipient is not restricted.
+	AllowedRecipients []string `protobuf:"bytes,3,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *MessageConstraint) Reset()         { *m = MessageConstraint{} }
+func (m *MessageConstraint) String() string { return proto.CompactTextString(m) }
+func (*MessageConstraint) ProtoMessage()    {}
+func (*MessageConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *MessageConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageConstraint.Merge(m, src)
+}
+func (m *MessageConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageConstraint proto.InternalMessageInfo
+
+func (m *MessageConstraint) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageConstraint) GetMaxAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+// ConstraintSpend contains the total amount spent under the constraint for a message type of the message policy
+// set for a connection and controller port.
+type ConstraintSpend struct {
+	// connection_id defines the host connection identifier of the message policy.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller_port_id defines the controller port identifier of the message policy, empty for a policy which
+	// applies to every controller port of the connection.
+	ControllerPortId string `protobuf:"bytes,2,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty"`
+	// type_url defines the sdk message typeURL of the constraint.
+	TypeUrl string `protobuf:"bytes,3,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// spent defines the total amount spent under the constraint.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *ConstraintSpend) Reset()         { *m = ConstraintSpend{} }
+func (m *ConstraintSpend) String() string { return proto.CompactTextString(m) }
+func (*ConstraintSpend) ProtoMessage()    {}
+func (*ConstraintSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *ConstraintSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConstraintSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConstraintSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConstraintSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstraintSpend.Merge(m, src)
+}
+func (m *ConstraintSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConstraintSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstraintSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstraintSpend proto.InternalMessageInfo
+
+func (m *ConstraintSpend) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConstraintSpend) GetControllerPortId() string {
+	if m != nil {
+		return m.ControllerPortId
+	}
+	return ""
+}
+
+func (m *ConstraintSpend) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *ConstraintSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// RegisteredAccount contains an interchain account address registered on the host chain and the host connection
+// and controller port identifiers it is associated with.
+type RegisteredAccount struct {
+	// connection_id defines the host connection identifier of the interchain account.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller_port_id defines the controller port identifier of the interchain account.
+	ControllerPortId string `protobuf:"bytes,2,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty"`
+	// address defines the interchain account address.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RegisteredAccount) Reset()         { *m = RegisteredAccount{} }
+func (m *RegisteredAccount) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAccount) ProtoMessage()    {}
+func (*RegisteredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{5}
+}
+func (m *RegisteredAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAccount.Merge(m, src)
+}
+func (m *RegisteredAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredAccount proto.InternalMessageInfo
+
+func (m *RegisteredAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetControllerPortId() string {
+	if m != nil {
+		return m.ControllerPortId
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
+	proto.RegisterType((*MessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MessagePolicy")
+	proto.RegisterType((*MessageConstraint)(nil), "ibc.applications.interchain_accounts.host.v1.MessageConstraint")
+	proto.RegisterType((*ConstraintSpend)(nil), "ibc.applications.interchain_accounts.host.v1.ConstraintSpend")
+	proto.RegisterType((*RegisteredAccount)(nil), "ibc.applications.interchain_accounts.host.v1.RegisteredAccount")
 }
 
 func init() {
@@ -87,23 +463,45 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xcf, 0xbf, 0x4a, 0xc4, 0x40,
-	0x10, 0xc7, 0xf1, 0x44, 0xe1, 0xd0, 0xf8, 0xa7, 0x48, 0x75, 0xd5, 0x72, 0x0a, 0xc2, 0x15, 0x66,
-	0x97, 0xd3, 0xe2, 0xac, 0x05, 0x1b, 0x41, 0x90, 0x94, 0x36, 0x61, 0x77, 0xb2, 0x24, 0x0b, 0xbb,
-	0x3b, 0x21, 0xb3, 0x89, 0xf8, 0x16, 0x3e, 0x96, 0xe5, 0x95, 0x96, 0x92, 0xbc, 0x88, 0x24, 0x0a,
-	0x2a, 0x58, 0x0d, 0x7c, 0xe0, 0x07, 0xf3, 0x4d, 0xb6, 0x46, 0x81, 0x90, 0x4d, 0x63, 0x0d, 0xc8,
-	0x60, 0xd0, 0x93, 0x30, 0x3e, 0xe8, 0x16, 0x6a, 0x69, 0x7c, 0x21, 0x01, 0xb0, 0xf3, 0x81, 0x44,
-	0x8d, 0x14, 0x44, 0xbf, 0x99, 0x2f, 0x6f, 0x5a, 0x0c, 0x98, 0x5e, 0x1a, 0x05, 0xfc, 0xf7, 0x90,
-	0xff, 0x33, 0xe4, 0xf3, 0xa0, 0xdf, 0x9c, 0xe7, 0xc9, 0xe2, 0x51, 0xb6, 0xd2, 0x51, 0x7a, 0x96,
-	0x1c, 0x4f, 0x58, 0x68, 0x2f, 0x95, 0xd5, 0xe5, 0x32, 0x5e, 0xc5, 0xeb, 0x83, 0xfc, 0x68, 0xb2,
-	0xbb, 0x2f, 0x4a, 0x2f, 0x92, 0x53, 0x69, 0x2d, 0x3e, 0x17, 0x4e, 0x13, 0xc9, 0x4a, 0xd3, 0x72,
-	0x6f, 0xb5, 0xbf, 0x3e, 0xcc, 0x4f, 0x66, 0x7d, 0xf8, 0xc6, 0xdb, 0xf2, 0x6d, 0x60, 0xf1, 0x6e,
-	0x60, 0xf1, 0xc7, 0xc0, 0xe2, 0xd7, 0x91, 0x45, 0xbb, 0x91, 0x45, 0xef, 0x23, 0x8b, 0x9e, 0xee,
-	0x2b, 0x13, 0xea, 0x4e, 0x71, 0x40, 0x27, 0x00, 0xc9, 0x21, 0x09, 0xa3, 0x20, 0xab, 0x50, 0xf4,
-	0x37, 0xc2, 0x61, 0xd9, 0x59, 0x4d, 0x53, 0x34, 0x89, 0xab, 0x6d, 0xf6, 0xf3, 0x76, 0xf6, 0xb7,
-	0x37, 0xbc, 0x34, 0x9a, 0xd4, 0x62, 0xce, 0xbd, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x51, 0x50,
-	0x78, 0xd7, 0x29, 0x01, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x93, 0xf4, 0x6f, 0x93, 0x02, 0xb5, 0x38, 0xa4, 0x3d, 0xb8, 0x21, 0x08, 0x29, 0x87,
+	0xc6, 0x4b, 0x8a, 0x44, 0xb9, 0xa1, 0xb6, 0xe2, 0x50, 0x24, 0xa4, 0x62, 0xc4, 0x85, 0x8b, 0xb5,
+	0xde, 0x1d, 0x39, 0x0b, 0xf6, 0xae, 0xbb, 0xbb, 0x49, 0x9b, 0x1b, 0x8f, 0xc0, 0x8d, 0x77, 0xe0,
+	0x49, 0xca, 0xad, 0x47, 0x4e, 0x80, 0xd2, 0x17, 0x41, 0xbb, 0x76, 0x9b, 0x56, 0x2d, 0x07, 0x24,
+	0x7a, 0xf2, 0xf8, 0x9b, 0x99, 0x6f, 0xf4, 0x7d, 0x33, 0x5a, 0xb4, 0xc3, 0x13, 0x8a, 0x49, 0x51,
+	0x64, 0x9c, 0x12, 0xc3, 0xa5, 0xd0, 0x98, 0x0b, 0x03, 0x8a, 0x8e, 0x08, 0x17, 0x31, 0xa1, 0x54,
+	0x8e, 0x85, 0xd1, 0x78, 0x24, 0xb5, 0xc1, 0x93, 0xa1, 0xfb, 0x86, 0x85, 0x92, 0x46, 0xfa, 0x5b,
+	0x3c, 0xa1, 0xe1, 0xd5, 0xc6, 0xf0, 0x96, 0xc6, 0xd0, 0x35, 0x4c, 0x86, 0x1b, 0x0f, 0x53, 0x99,
+	0x4a, 0xd7, 0x88, 0x6d, 0x54, 0x72, 0x6c, 0x04, 0x54, 0xea, 0x5c, 0x6a, 0x9c, 0x10, 0x0d, 0x78,
+	0x32, 0x4c, 0xc0, 0x90, 0x21, 0xa6, 0x92, 0x8b, 0x32, 0xdf, 0x3b, 0x46, 0x8b, 0x87, 0x44, 0x91,
+	0x5c, 0xfb, 0x8f, 0x50, 0xdb, 0x52, 0xc5, 0x20, 0x48, 0x92, 0x01, 0xeb, 0x78, 0x5d, 0xaf, 0xbf,
+	0x1c, 0xb5, 0x2c, 0xf6, 0xaa, 0x84, 0xfc, 0x27, 0xe8, 0x1e, 0xc9, 0x32, 0x79, 0x1c, 0xe7, 0xa0,
+	0x35, 0x49, 0x41, 0x77, 0xea, 0xdd, 0x46, 0x7f, 0x25, 0x5a, 0x75, 0xe8, 0x9b, 0x0a, 0xf4, 0x1f,
+	0xa3, 0x12, 0x88, 0x8f, 0xc6, 0xa0, 0x38, 0xe8, 0x4e, 0xc3, 0x55, 0xb5, 0x1d, 0xf8, 0xb6, 0xc4,
+	0x7a, 0xcf, 0x51, 0xdb, 0x86, 0xd3, 0x08, 0x8e, 0xc6, 0xa0, 0x8d, 0xef, 0xa3, 0x66, 0x41, 0xcc,
+	0xc8, 0x8d, 0x5d, 0x89, 0x5c, 0x6c, 0x31, 0x46, 0x0c, 0xe9, 0xd4, 0xbb, 0x5e, 0xbf, 0x1d, 0xb9,
+	0xb8, 0xf7, 0xb5, 0x8e, 0x56, 0xab, 0x49, 0x87, 0x32, 0xe3, 0x74, 0x6a, 0xc7, 0x51, 0x29, 0x04,
+	0x50, 0xeb, 0x51, 0xcc, 0x59, 0x45, 0xd1, 0x9e, 0x83, 0x07, 0xcc, 0xdf, 0x42, 0x3e, 0x95, 0xc2,
+	0x28, 0x99, 0x65, 0xa0, 0xe2, 0x42, 0x2a, 0x63, 0x2b, 0xeb, 0xae, 0xf2, 0xc1, 0x3c, 0x73, 0x28,
+	0x95, 0x39, 0xb8, 0x4d, 0x68, 0xe3, 0x2f, 0x42, 0x19, 0x88, 0xe9, 0xbc, 0xaa, 0x59, 0x0a, 0xb5,
+	0xe0, 0x65, 0x51, 0x8a, 0x5a, 0x54, 0x0a, 0x6d, 0x14, 0xe1, 0xc2, 0xe8, 0xce, 0x42, 0xb7, 0xd1,
+	0x6f, 0x6d, 0xbf, 0x0c, 0xff, 0x65, 0xb7, 0x61, 0x45, 0xb6, 0x7f, 0xc9, 0xb3, 0xd7, 0x3c, 0xfd,
+	0xb9, 0x59, 0x8b, 0xae, 0x32, 0xf7, 0xbe, 0x7b, 0x68, 0xed, 0x46, 0xa1, 0xbf, 0x8e, 0x96, 0xcd,
+	0xb4, 0x80, 0x78, 0xac, 0xb2, 0xca, 0x98, 0x25, 0xfb, 0xff, 0x5e, 0x65, 0xfe, 0x47, 0x84, 0x72,
+	0x72, 0x12, 0x93, 0xdc, 0x0e, 0x73, 0xab, 0x6c, 0x6d, 0xaf, 0x87, 0xe5, 0xc1, 0x84, 0xf6, 0x60,
+	0xc2, 0xea, 0x60, 0xc2, 0x7d, 0xc9, 0xc5, 0xde, 0x53, 0x3b, 0xf2, 0xdb, 0xaf, 0xcd, 0x7e, 0xca,
+	0xcd, 0x68, 0x9c, 0x84, 0x54, 0xe6, 0xb8, 0xba, 0xae, 0xf2, 0x33, 0xd0, 0xec, 0x13, 0xb6, 0xe4,
+	0xda, 0x35, 0xe8, 0x68, 0x25, 0x27, 0x27, 0xbb, 0x8e, 0xdd, 0x1f, 0x20, 0xdf, 0x79, 0x07, 0x2c,
+	0x56, 0x40, 0x79, 0xc1, 0x41, 0x98, 0x0b, 0x57, 0xd7, 0xaa, 0x4c, 0x74, 0x99, 0xe8, 0xcd, 0x3c,
+	0x74, 0x7f, 0x2e, 0xe2, 0x5d, 0x01, 0x82, 0xdd, 0xc5, 0x9e, 0xaf, 0x9a, 0xd3, 0xb8, 0x6e, 0x0e,
+	0x41, 0x0b, 0xba, 0x00, 0x61, 0x3a, 0xcd, 0xff, 0xef, 0x4b, 0xc9, 0xdc, 0xfb, 0xec, 0xa1, 0xb5,
+	0x08, 0x52, 0xae, 0x0d, 0x28, 0x60, 0xbb, 0xe5, 0xd2, 0xef, 0x42, 0x66, 0x07, 0x2d, 0x11, 0xc6,
+	0x14, 0x68, 0x7d, 0xa1, 0xb2, 0xfa, 0xdd, 0x63, 0xa7, 0xb3, 0xc0, 0x3b, 0x9b, 0x05, 0xde, 0xef,
+	0x59, 0xe0, 0x7d, 0x39, 0x0f, 0x6a, 0x67, 0xe7, 0x41, 0xed, 0xc7, 0x79, 0x50, 0xfb, 0xf0, 0xfa,
+	0xa6, 0x1a, 0x9e, 0xd0, 0x41, 0x2a, 0xf1, 0xe4, 0x05, 0xce, 0x25, 0x1b, 0x67, 0xa0, 0xed, 0xab,
+	0xa6, 0xf1, 0xf6, 0xce, 0x60, 0x7e, 0xbb, 0x83, 0xeb, 0x0f, 0x9a, 0x53, 0x9d, 0x2c, 0xba, 0xb7,
+	0xe6, 0xd9, 0x9f, 0x01, 0x00, 0x03, 0x5b, 0x01, 0x72, 0x0a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +524,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -142,48 +549,1230 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for iNdEx := len(m.DenyMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenyMessages[iNdEx])
+			copy(dAtA[i:], m.DenyMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.DenyMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConstraintSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConstraintSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func (m *MessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.DenyMessages) > 0 {
+		for _, s := range m.DenyMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConstraintSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func sovHost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHost(x uint64) (n int) {
+	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenyMessages = append(m.DenyMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, MessageConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HostEnabled {
-		n += 2
-	}
-	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
+func (m *ConstraintSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConstraintSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConstraintSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovHost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHost(x uint64) (n int) {
-	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RegisteredAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -206,17 +1795,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHost
@@ -226,15 +1815,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HostEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -262,7 +1863,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,10 +16,55 @@ const (
 	// ParamsKey is the key to use for the storing params.
 	ParamsKey = "params"
 
+	// MessagePolicyKeyPrefix defines the key prefix used to store message policies
+	MessagePolicyKeyPrefix = "messagePolicy"
+
+	// ConstraintSpendKeyPrefix defines the key prefix used to store the amounts spent under message constraints
+	ConstraintSpendKeyPrefix = "constraintSpend"
+
+	// ConnectionAccountKeyPrefix defines the key prefix used to index interchain accounts by connection
+	ConnectionAccountKeyPrefix = "connectionAccount"
+
+	// RegisteredAccountKeyPrefix defines the key prefix used to index interchain accounts by address
+	RegisteredAccountKeyPrefix = "registeredAccount"
+
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
 )
 
+// KeyMessagePolicy creates and returns a new key used for message policy store operations.
+// The controllerPortID is empty for a policy which applies to every controller port of the connection.
+func KeyMessagePolicy(connectionID, controllerPortID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", MessagePolicyKeyPrefix, connectionID, controllerPortID))
+}
+
+// KeyPolicyConstraintSpends creates and returns the key prefix of the amounts spent under the constraints of the
+// message policy set for the provided connectionID and controllerPortID
+func KeyPolicyConstraintSpends(connectionID, controllerPortID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ConstraintSpendKeyPrefix, connectionID, controllerPortID))
+}
+
+// KeyConstraintSpend creates and returns a new key used for storing the amount spent under the constraint for the
+// provided message typeURL of the message policy set for the provided connectionID and controllerPortID
+func KeyConstraintSpend(connectionID, controllerPortID, typeURL string) []byte {
+	return append(KeyPolicyConstraintSpends(connectionID, controllerPortID), []byte(typeURL)...)
+}
+
+// KeyConnectionAccounts creates and returns the key prefix of the interchain accounts registered over the provided connection
+func KeyConnectionAccounts(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", ConnectionAccountKeyPrefix, connectionID))
+}
+
+// KeyConnectionAccount creates and returns a new key used for indexing interchain accounts by connection
+func KeyConnectionAccount(connectionID, controllerPortID string) []byte {
+	return append(KeyConnectionAccounts(connectionID), []byte(controllerPortID)...)
+}
+
+// KeyRegisteredAccount creates and returns a new key used for indexing interchain accounts by address
+func KeyRegisteredAccount(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", RegisteredAccountKeyPrefix, address))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
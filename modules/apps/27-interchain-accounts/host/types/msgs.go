@@ -1,16 +1,28 @@
 package types
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+
+	_ sdk.Msg              = (*MsgModuleQuerySafe)(nil)
+	_ sdk.HasValidateBasic = (*MsgModuleQuerySafe)(nil)
+
+	_ sdk.Msg              = (*MsgSetMessagePolicy)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetMessagePolicy)(nil)
+
+	_ sdk.Msg              = (*MsgRemoveMessagePolicy)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveMessagePolicy)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -30,3 +42,76 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return msg.Params.Validate()
 }
+
+// NewMsgModuleQuerySafe creates a new MsgModuleQuerySafe instance
+func NewMsgModuleQuerySafe(signer string, requests []QueryRequest) *MsgModuleQuerySafe {
+	return &MsgModuleQuerySafe{
+		Signer:   signer,
+		Requests: requests,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgModuleQuerySafe) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Requests) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "no queries provided")
+	}
+
+	for i, req := range msg.Requests {
+		if strings.TrimSpace(req.Path) == "" {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "query path cannot be empty for request at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgSetMessagePolicy creates a new MsgSetMessagePolicy instance
+func NewMsgSetMessagePolicy(signer string, policy MessagePolicy) *MsgSetMessagePolicy {
+	return &MsgSetMessagePolicy{
+		Signer: signer,
+		Policy: policy,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetMessagePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Policy.Validate()
+}
+
+// NewMsgRemoveMessagePolicy creates a new MsgRemoveMessagePolicy instance
+func NewMsgRemoveMessagePolicy(signer, connectionID, controllerPortID string) *MsgRemoveMessagePolicy {
+	return &MsgRemoveMessagePolicy{
+		Signer:           signer,
+		ConnectionId:     connectionID,
+		ControllerPortId: controllerPortID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveMessagePolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if msg.ControllerPortId != "" {
+		return host.PortIdentifierValidator(msg.ControllerPortId)
+	}
+
+	return nil
+}
@@ -74,3 +74,155 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgModuleQuerySafeValidateBasic(t *testing.T) {
+	queryRequest := types.QueryRequest{
+		Path: "/cosmos.bank.v1beta1.Query/Balance",
+		Data: []byte{},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgModuleQuerySafe
+		expPass bool
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{queryRequest}),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgModuleQuerySafe("signer", []types.QueryRequest{queryRequest}),
+			false,
+		},
+		{
+			"failure: empty query requests",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{}),
+			false,
+		},
+		{
+			"failure: empty query path",
+			types.NewMsgModuleQuerySafe(sdk.AccAddress(ibctesting.TestAccAddress).String(), []types.QueryRequest{{Path: " "}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgModuleQuerySafeGetSigners(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expPass bool
+	}{
+		{"success: valid address", sdk.AccAddress(ibctesting.TestAccAddress), true},
+		{"failure: nil address", nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg := types.NewMsgModuleQuerySafe(tc.address.String(), []types.QueryRequest{})
+		encodingCfg := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+		signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, tc.address.Bytes(), signers[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgSetMessagePolicyValidateBasic(t *testing.T) {
+	policy := types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetMessagePolicy
+		expPass bool
+	}{
+		{
+			"success: valid signer address",
+			types.NewMsgSetMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), policy),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgSetMessagePolicy("signer", policy),
+			false,
+		},
+		{
+			"failure: invalid message policy",
+			types.NewMsgSetMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{""}, nil, nil)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgRemoveMessagePolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveMessagePolicy
+		expPass bool
+	}{
+		{
+			"success: connection wide policy",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, ""),
+			true,
+		},
+		{
+			"success: controller port policy",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, "icacontroller-owner"),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgRemoveMessagePolicy("signer", ibctesting.FirstConnectionID, ""),
+			false,
+		},
+		{
+			"failure: invalid connection ID",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), "invalid|connection", ""),
+			false,
+		},
+		{
+			"failure: invalid controller port ID",
+			types.NewMsgRemoveMessagePolicy(sdk.AccAddress(ibctesting.TestAccAddress).String(), ibctesting.FirstConnectionID, "invalid|port"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -26,7 +26,11 @@ func DefaultParams() Params {
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	return validateAllowQueries(p.AllowQueries)
 }
 
 func validateAllowlist(allowMsgs []string) error {
@@ -42,3 +46,17 @@ func validateAllowlist(allowMsgs []string) error {
 
 	return nil
 }
+
+func validateAllowQueries(allowQueries []string) error {
+	for _, path := range allowQueries {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
+		}
+
+		if path == AllowAllHostMsgs {
+			return fmt.Errorf("allow queries must not contain the wildcard (%s), each query path must be explicitly allowed", AllowAllHostMsgs)
+		}
+	}
+
+	return nil
+}
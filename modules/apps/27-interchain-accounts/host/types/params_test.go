@@ -14,4 +14,7 @@ func TestValidateParams(t *testing.T) {
 	require.Error(t, types.NewParams(true, []string{""}).Validate())
 	require.Error(t, types.NewParams(true, []string{" "}).Validate())
 	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
+	require.NoError(t, types.Params{HostEnabled: true, AllowQueries: []string{"/cosmos.bank.v1beta1.Query/Balance"}}.Validate())
+	require.Error(t, types.Params{HostEnabled: true, AllowQueries: []string{" "}}.Validate())
+	require.Error(t, types.Params{HostEnabled: true, AllowQueries: []string{"*"}}.Validate())
 }
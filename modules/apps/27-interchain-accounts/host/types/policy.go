@@ -0,0 +1,307 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// constrainedMsgTypes are the sdk message typeURLs for which a MessageConstraint may be set
+var constrainedMsgTypes = []string{
+	sdk.MsgTypeURL((*banktypes.MsgSend)(nil)),
+	sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil)),
+	sdk.MsgTypeURL((*stakingtypes.MsgBeginRedelegate)(nil)),
+	sdk.MsgTypeURL((*transfertypes.MsgTransfer)(nil)),
+}
+
+// NewMessagePolicy creates a new MessagePolicy instance
+func NewMessagePolicy(connectionID, controllerPortID string, allowMsgs, denyMsgs []string, constraints []MessageConstraint) MessagePolicy {
+	return MessagePolicy{
+		ConnectionId:     connectionID,
+		ControllerPortId: controllerPortID,
+		AllowMessages:    allowMsgs,
+		DenyMessages:     denyMsgs,
+		Constraints:      constraints,
+	}
+}
+
+// NewMessageConstraint creates a new MessageConstraint instance
+func NewMessageConstraint(typeURL string, maxAmount sdk.Coins, allowedRecipients []string) MessageConstraint {
+	return MessageConstraint{
+		TypeUrl:           typeURL,
+		MaxAmount:         maxAmount,
+		AllowedRecipients: allowedRecipients,
+	}
+}
+
+// NewConstraintSpend creates a new ConstraintSpend instance
+func NewConstraintSpend(connectionID, controllerPortID, typeURL string, spent sdk.Coins) ConstraintSpend {
+	return ConstraintSpend{
+		ConnectionId:     connectionID,
+		ControllerPortId: controllerPortID,
+		TypeUrl:          typeURL,
+		Spent:            spent,
+	}
+}
+
+// Validate performs basic validation of the MessagePolicy
+func (p MessagePolicy) Validate() error {
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return err
+	}
+
+	if p.ControllerPortId != "" {
+		if err := host.PortIdentifierValidator(p.ControllerPortId); err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(p.ControllerPortId, icatypes.ControllerPortPrefix) {
+			return errorsmod.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.ControllerPortPrefix, p.ControllerPortId)
+		}
+	}
+
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(p.DenyMessages); err != nil {
+		return err
+	}
+
+	typeURLs := make(map[string]bool)
+	for _, constraint := range p.Constraints {
+		if typeURLs[constraint.TypeUrl] {
+			return fmt.Errorf("duplicate constraint for message type %s", constraint.TypeUrl)
+		}
+		typeURLs[constraint.TypeUrl] = true
+
+		if err := constraint.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MergeMessagePolicies merges the policy set for every controller port of a connection with the policy set for a
+// single controller port of the connection. Either policy may be empty if not set. The merged policy denies the messages
+// denied by either policy. The messages allowed by the controller port policy take precedence over the messages allowed
+// by the connection policy if set, and the constraints of the controller port policy take precedence over the
+// constraints set for the same message type by the connection policy.
+func MergeMessagePolicies(connectionID string, connectionPolicy, portPolicy MessagePolicy) MessagePolicy {
+	allowMsgs := portPolicy.AllowMessages
+	if len(allowMsgs) == 0 {
+		allowMsgs = connectionPolicy.AllowMessages
+	}
+
+	var denyMsgs []string
+	for _, typeURL := range append(slices.Clone(connectionPolicy.DenyMessages), portPolicy.DenyMessages...) {
+		if !slices.Contains(denyMsgs, typeURL) {
+			denyMsgs = append(denyMsgs, typeURL)
+		}
+	}
+
+	constraints := slices.Clone(portPolicy.Constraints)
+	for _, constraint := range connectionPolicy.Constraints {
+		if !slices.ContainsFunc(portPolicy.Constraints, func(c MessageConstraint) bool { return c.TypeUrl == constraint.TypeUrl }) {
+			constraints = append(constraints, constraint)
+		}
+	}
+
+	return NewMessagePolicy(connectionID, portPolicy.ControllerPortId, allowMsgs, denyMsgs, constraints)
+}
+
+// IsConnectionWide returns true if the policy applies to every controller port of the connection
+func (p MessagePolicy) IsConnectionWide() bool {
+	return p.ControllerPortId == ""
+}
+
+// Accept returns an error if the provided sdk.Msg, or any message executed by it on behalf of another account,
+// may not be executed under the policy
+func (p MessagePolicy) Accept(msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if !ContainsMsgType(p.AllowMessages, msg) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", typeURL)
+	}
+
+	if ContainsMsgType(p.DenyMessages, msg) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type denied: %s", typeURL)
+	}
+
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		execMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "failed to unpack messages of %s: %s", typeURL, err)
+		}
+
+		for _, execMsg := range execMsgs {
+			if err := p.Accept(execMsg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if constraint, found := p.GetConstraint(ConstraintTypeURL(msg)); found {
+		return constraint.Accept(msg)
+	}
+
+	return nil
+}
+
+// GetConstraint returns the constraint of the policy for the provided message typeURL
+func (p MessagePolicy) GetConstraint(typeURL string) (MessageConstraint, bool) {
+	for _, constraint := range p.Constraints {
+		if constraint.TypeUrl == typeURL {
+			return constraint, true
+		}
+	}
+
+	return MessageConstraint{}, false
+}
+
+// ConstraintTypeURL returns the message typeURL of the constraint applied to the provided sdk.Msg. A bank MsgMultiSend
+// is constrained by the constraint set for bank MsgSend.
+func ConstraintTypeURL(msg sdk.Msg) string {
+	if _, ok := msg.(*banktypes.MsgMultiSend); ok {
+		return sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	}
+
+	return sdk.MsgTypeURL(msg)
+}
+
+// UnpackExecMsgs returns the messages executed by the provided sdk.Msg. The messages of an authz MsgExec are
+// unpacked recursively, any other message is returned as is.
+func UnpackExecMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return []sdk.Msg{msg}, nil
+	}
+
+	execMsgs, err := execMsg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []sdk.Msg
+	for _, execMsg := range execMsgs {
+		unpacked, err := UnpackExecMsgs(execMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, unpacked...)
+	}
+
+	return msgs, nil
+}
+
+// GetConstrainedAmount returns the amount and recipients of the provided sdk.Msg restricted by a constraint.
+// False is returned if constraints are not supported for the message type.
+func GetConstrainedAmount(msg sdk.Msg) (sdk.Coins, []string, bool) {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return msg.Amount, []string{msg.ToAddress}, true
+	case *banktypes.MsgMultiSend:
+		var (
+			amount     sdk.Coins
+			recipients []string
+		)
+		for _, output := range msg.Outputs {
+			amount = amount.Add(output.Coins...)
+			recipients = append(recipients, output.Address)
+		}
+
+		return amount, recipients, true
+	case *stakingtypes.MsgDelegate:
+		return sdk.NewCoins(msg.Amount), []string{msg.ValidatorAddress}, true
+	case *stakingtypes.MsgBeginRedelegate:
+		return sdk.NewCoins(msg.Amount), []string{msg.ValidatorDstAddress}, true
+	case *transfertypes.MsgTransfer:
+		return msg.GetCoins(), []string{msg.Receiver}, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// Validate performs basic validation of the MessageConstraint
+func (c MessageConstraint) Validate() error {
+	if !slices.Contains(constrainedMsgTypes, c.TypeUrl) {
+		return fmt.Errorf("constraints are not supported for message type %s", c.TypeUrl)
+	}
+
+	if !c.MaxAmount.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "max amount %s", c.MaxAmount)
+	}
+
+	for i, recipient := range c.AllowedRecipients {
+		if strings.TrimSpace(recipient) == "" {
+			return fmt.Errorf("allowed recipients must not contain empty strings: %s", c.AllowedRecipients)
+		}
+
+		if slices.Contains(c.AllowedRecipients[:i], recipient) {
+			return fmt.Errorf("duplicate allowed recipient %s", recipient)
+		}
+	}
+
+	return nil
+}
+
+// Accept returns an error if the amount or a recipient of the provided sdk.Msg exceed the constraint. The amount
+// spent by previous messages is not taken into account.
+func (c MessageConstraint) Accept(msg sdk.Msg) error {
+	amount, recipients, ok := GetConstrainedAmount(msg)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "constraints are not supported for message type %s", sdk.MsgTypeURL(msg))
+	}
+
+	if !c.MaxAmount.Empty() && !amount.IsAllLTE(c.MaxAmount) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "amount %s exceeds the maximum amount %s for message type %s", amount, c.MaxAmount, c.TypeUrl)
+	}
+
+	if len(c.AllowedRecipients) > 0 {
+		for _, recipient := range recipients {
+			if !slices.Contains(c.AllowedRecipients, recipient) {
+				return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "recipient %s is not allowed for message type %s", recipient, c.TypeUrl)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the ConstraintSpend
+func (s ConstraintSpend) Validate() error {
+	if err := host.ConnectionIdentifierValidator(s.ConnectionId); err != nil {
+		return err
+	}
+
+	if s.ControllerPortId != "" {
+		if err := host.PortIdentifierValidator(s.ControllerPortId); err != nil {
+			return err
+		}
+	}
+
+	if !slices.Contains(constrainedMsgTypes, s.TypeUrl) {
+		return fmt.Errorf("constraints are not supported for message type %s", s.TypeUrl)
+	}
+
+	if !s.Spent.IsValid() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "spent amount %s", s.Spent)
+	}
+
+	return nil
+}
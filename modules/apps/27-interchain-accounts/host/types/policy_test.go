@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var (
+	msgSendTypeURL     = sdk.MsgTypeURL((*banktypes.MsgSend)(nil))
+	msgDelegateTypeURL = sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))
+)
+
+func TestMessagePolicyValidate(t *testing.T) {
+	controllerPortID, err := icatypes.NewControllerPortID(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	maxAmount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	testCases := []struct {
+		name    string
+		policy  types.MessagePolicy
+		expPass bool
+	}{
+		{"success: connection wide policy", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{msgSendTypeURL}, nil, nil), true},
+		{"success: controller port policy", types.NewMessagePolicy(ibctesting.FirstConnectionID, controllerPortID, nil, []string{msgSendTypeURL}, nil), true},
+		{"success: allow all messages", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, []string{msgSendTypeURL}, nil), true},
+		{"success: with constraints", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, maxAmount, []string{ibctesting.TestAccAddress})}), true},
+		{"failure: invalid connection ID", types.NewMessagePolicy("invalid|connection", "", []string{msgSendTypeURL}, nil, nil), false},
+		{"failure: invalid controller port ID", types.NewMessagePolicy(ibctesting.FirstConnectionID, "invalid|port", []string{msgSendTypeURL}, nil, nil), false},
+		{"failure: controller port ID without controller prefix", types.NewMessagePolicy(ibctesting.FirstConnectionID, "transfer", []string{msgSendTypeURL}, nil, nil), false},
+		{"failure: empty allowed message", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{" "}, nil, nil), false},
+		{"failure: empty denied message", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, []string{""}, nil), false},
+		{"failure: wildcard with other allowed messages", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs, msgSendTypeURL}, nil, nil), false},
+		{"failure: duplicate constraints", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, maxAmount, nil), types.NewMessageConstraint(msgSendTypeURL, nil, nil)}), false},
+		{"failure: unsupported constraint message type", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(sdk.MsgTypeURL((*govtypes.MsgVote)(nil)), maxAmount, nil)}), false},
+		{"failure: invalid constraint max amount", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}, nil)}), false},
+		{"failure: empty constraint recipient", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, nil, []string{" "})}), false},
+		{"failure: duplicate constraint recipient", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", nil, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, nil, []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress})}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMessagePolicyAccept(t *testing.T) {
+	validatorAddr := sdk.ValAddress(ibctesting.TestAccAddress).String()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	msgSend := banktypes.NewMsgSend(sdk.AccAddress(ibctesting.TestAccAddress), sdk.AccAddress(ibctesting.TestAccAddress), coins)
+	msgDelegate := stakingtypes.NewMsgDelegate(ibctesting.TestAccAddress, validatorAddr, coins[0])
+	msgTransfer := transfertypes.NewMsgTransfer(ibctesting.MockPort, ibctesting.FirstChannelID, coins[0], ibctesting.TestAccAddress, ibctesting.TestAccAddress, clienttypes.ZeroHeight(), 1, "")
+	msgMultiSend := banktypes.NewMsgMultiSend(banktypes.NewInput(sdk.AccAddress(ibctesting.TestAccAddress), coins.Add(coins...)), []banktypes.Output{
+		banktypes.NewOutput(sdk.AccAddress(ibctesting.TestAccAddress), coins),
+		banktypes.NewOutput(sdk.AccAddress(validatorAddr), coins),
+	})
+	msgExec := authz.NewMsgExec(sdk.AccAddress(ibctesting.TestAccAddress), []sdk.Msg{msgSend})
+
+	testCases := []struct {
+		name    string
+		policy  types.MessagePolicy
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"success: message allowed", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{msgSendTypeURL}, nil, nil), msgSend, true},
+		{"success: all messages allowed", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, nil), msgSend, true},
+		{"success: other message denied", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, []string{msgDelegateTypeURL}, nil), msgSend, true},
+		{"success: amount within constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgDelegateTypeURL, coins, []string{validatorAddr})}), msgDelegate, true},
+		{"success: constraint for other message type", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgDelegateTypeURL, coins.QuoInt(sdkmath.NewInt(2)), nil)}), msgSend, true},
+		{"success: transfer within constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(sdk.MsgTypeURL(msgTransfer), coins, []string{ibctesting.TestAccAddress})}), msgTransfer, true},
+		{"success: multi send within send constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, coins.Add(coins...), nil)}), msgMultiSend, true},
+		{"success: exec message within constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, coins, nil)}), &msgExec, true},
+		{"failure: message not allowed", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{msgDelegateTypeURL}, nil, nil), msgSend, false},
+		{"failure: multi send amount exceeds send constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, coins, nil)}), msgMultiSend, false},
+		{"failure: multi send recipient not allowed by send constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, nil, []string{sdk.AccAddress(ibctesting.TestAccAddress).String()})}), msgMultiSend, false},
+		{"failure: exec message not allowed", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL(&msgExec)}, nil, nil), &msgExec, false},
+		{"failure: exec message denied", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, []string{msgSendTypeURL}, nil), &msgExec, false},
+		{"failure: exec message amount exceeds constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, coins.QuoInt(sdkmath.NewInt(2)), nil)}), &msgExec, false},
+		{"failure: message denied", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, []string{msgSendTypeURL}, nil), msgSend, false},
+		{"failure: amount exceeds constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgDelegateTypeURL, coins.QuoInt(sdkmath.NewInt(2)), nil)}), msgDelegate, false},
+		{"failure: denom not allowed by constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(msgSendTypeURL, sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100))), nil)}), msgSend, false},
+		{"failure: recipient not allowed by constraint", types.NewMessagePolicy(ibctesting.FirstConnectionID, "", []string{types.AllowAllHostMsgs}, nil, []types.MessageConstraint{types.NewMessageConstraint(sdk.MsgTypeURL(msgTransfer), nil, []string{"receiver"})}), msgTransfer, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Accept(tc.msg)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,219 +113,2415 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+// QueryMessagePoliciesRequest is the request type for the Query/MessagePolicies RPC method.
+type QueryMessagePoliciesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/query.proto", fileDescriptor_e6b7e23fc90c353a)
+func (m *QueryMessagePoliciesRequest) Reset()         { *m = QueryMessagePoliciesRequest{} }
+func (m *QueryMessagePoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePoliciesRequest) ProtoMessage()    {}
+func (*QueryMessagePoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryMessagePoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessagePoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePoliciesRequest.Merge(m, src)
+}
+func (m *QueryMessagePoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePoliciesRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0xd0, 0x8e, 0xea, 0x28, 0x0e, 0xea, 0xe8, 0x22, 0xb9, 0x34, 0x5c, 0x03, 0xbd, 0x3c, 0xe9,
-	0x25, 0x77, 0xd0, 0xd5, 0x4f, 0x20, 0xf8, 0x91, 0x5c, 0x5c, 0x84, 0x82, 0x8b, 0xa3, 0xdc, 0xf9,
-	0x41, 0xe4, 0x72, 0x07, 0x5a, 0x14, 0xe1, 0x70, 0x7d, 0xc2, 0xef, 0xf7, 0x7f, 0xfe, 0x4f, 0xf0,
-	0x4c, 0x47, 0x92, 0x0b, 0x6b, 0x97, 0x5a, 0x0a, 0xaf, 0xc1, 0x38, 0xae, 0x8d, 0x57, 0xa9, 0x5c,
-	0x08, 0x6d, 0x6e, 0x85, 0x94, 0x90, 0x19, 0xef, 0xf8, 0x02, 0x9c, 0xe7, 0xf9, 0x88, 0xaf, 0x32,
-	0x95, 0xae, 0x99, 0x4d, 0xc1, 0x03, 0x19, 0xe8, 0x48, 0xb2, 0xaf, 0x24, 0xfb, 0x81, 0x64, 0x15,
-	0xc9, 0xf2, 0x51, 0xef, 0x20, 0x06, 0x88, 0x97, 0x8a, 0x0b, 0xab, 0xb9, 0x30, 0x06, 0x7c, 0xc3,
-	0x04, 0x57, 0x6f, 0xda, 0x6a, 0x8b, 0xe0, 0x0c, 0x60, 0x7f, 0x0f, 0x93, 0xab, 0x6a, 0xa7, 0x4b,
-	0x91, 0x8a, 0xc4, 0x5d, 0xab, 0x55, 0xa6, 0x9c, 0xef, 0x4b, 0xbc, 0xbb, 0x35, 0x75, 0x16, 0x8c,
-	0x53, 0xe4, 0x02, 0x77, 0x6d, 0x98, 0xec, 0xa3, 0x43, 0x74, 0xb4, 0x33, 0x9e, 0xb0, 0x36, 0x15,
-	0x58, 0x63, 0x6b, 0x1c, 0xe3, 0x67, 0x84, 0xff, 0x87, 0x14, 0xf2, 0x88, 0x70, 0xb7, 0x7e, 0x24,
-	0x27, 0xed, 0x94, 0xdf, 0x77, 0xef, 0x9d, 0xfe, 0xc1, 0x50, 0xf7, 0xec, 0x4f, 0xee, 0x5e, 0xde,
-	0x1f, 0xfe, 0x31, 0x32, 0xe0, 0xcd, 0x59, 0x7f, 0x3f, 0x67, 0xdd, 0xe7, 0x6c, 0xfe, 0x54, 0x50,
-	0xb4, 0x29, 0x28, 0x7a, 0x2b, 0x28, 0xba, 0x2f, 0x69, 0x67, 0x53, 0xd2, 0xce, 0x6b, 0x49, 0x3b,
-	0x37, 0xe7, 0xb1, 0xf6, 0x8b, 0x2c, 0x62, 0x12, 0x12, 0x2e, 0xc1, 0x25, 0xe0, 0x2a, 0xf1, 0x30,
-	0x06, 0x9e, 0xcf, 0x78, 0x02, 0xf3, 0x6c, 0xa9, 0x5c, 0x1d, 0x33, 0x9e, 0x0e, 0x3f, 0x93, 0x86,
-	0xdb, 0x49, 0x7e, 0x6d, 0x95, 0x8b, 0xba, 0xe1, 0xdf, 0x8e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x5f, 0x87, 0x7c, 0x78, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryMessagePoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryMessagePoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryMessagePoliciesResponse is the response type for the Query/MessagePolicies RPC method.
+type QueryMessagePoliciesResponse struct {
+	// list of message policies
+	Policies []MessagePolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryMessagePoliciesResponse) Reset()         { *m = QueryMessagePoliciesResponse{} }
+func (m *QueryMessagePoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePoliciesResponse) ProtoMessage()    {}
+func (*QueryMessagePoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryMessagePoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMessagePoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePoliciesResponse.Merge(m, src)
+}
+func (m *QueryMessagePoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePoliciesResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the ICA host submodule.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryMessagePoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryMessagePoliciesResponse) GetPolicies() []MessagePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryMessagePoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryEffectiveMessagePolicyRequest is the request type for the Query/EffectiveMessagePolicy RPC method.
+type QueryEffectiveMessagePolicyRequest struct {
+	// interchain account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEffectiveMessagePolicyRequest) Reset()         { *m = QueryEffectiveMessagePolicyRequest{} }
+func (m *QueryEffectiveMessagePolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMessagePolicyRequest) ProtoMessage()    {}
+func (*QueryEffectiveMessagePolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMessagePolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMessagePolicyRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMessagePolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMessagePolicyRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the ICA host submodule.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryEffectiveMessagePolicyRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveMessagePolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryEffectiveMessagePolicyResponse is the response type for the Query/EffectiveMessagePolicy RPC method.
+type QueryEffectiveMessagePolicyResponse struct {
+	// policy applied to the interchain account. The allow_messages of the policy are resolved from the host
+	// parameters if not set by the policy.
+	Policy MessagePolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// default is true if no message policy is set for the interchain account and only the host parameters apply.
+	Default bool `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryEffectiveMessagePolicyResponse) Reset()         { *m = QueryEffectiveMessagePolicyResponse{} }
+func (m *QueryEffectiveMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMessagePolicyResponse) ProtoMessage()    {}
+func (*QueryEffectiveMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMessagePolicyResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMessagePolicyResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryEffectiveMessagePolicyResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMessagePolicyResponse) GetPolicy() MessagePolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return MessagePolicy{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEffectiveMessagePolicyResponse) GetDefault() bool {
+	if m != nil {
+		return m.Default
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return false
+}
+
+// QueryInterchainAccountsRequest is the request type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsRequest struct {
+	// host connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsRequest) Reset()         { *m = QueryInterchainAccountsRequest{} }
+func (m *QueryInterchainAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{6}
+}
+func (m *QueryInterchainAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/Params",
+}
+func (m *QueryInterchainAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *QueryInterchainAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
+// QueryInterchainAccountsResponse is the response type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsResponse struct {
+	// list of interchain accounts registered over the connection
+	Accounts []RegisteredAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInterchainAccountsResponse) Reset()         { *m = QueryInterchainAccountsResponse{} }
+func (m *QueryInterchainAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{7}
+}
+func (m *QueryInterchainAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInterchainAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInterchainAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsResponse) GetAccounts() []RegisteredAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryInterchainAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryInterchainAccountRequest is the request type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountRequest struct {
+	// interchain account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
+func (m *QueryInterchainAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{8}
+}
+func (m *QueryInterchainAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInterchainAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInterchainAccountRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryInterchainAccountResponse is the response type for the Query/InterchainAccount RPC method.
+type QueryInterchainAccountResponse struct {
+	// interchain account and its associated connection and controller port identifiers
+	Account RegisteredAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *QueryInterchainAccountResponse) Reset()         { *m = QueryInterchainAccountResponse{} }
+func (m *QueryInterchainAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{9}
+}
+func (m *QueryInterchainAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountResponse) GetAccount() RegisteredAccount {
+	if m != nil {
+		return m.Account
+	}
+	return RegisteredAccount{}
+}
+
+// QueryActiveChannelRequest is the request type for the Query/ActiveChannel RPC method.
+type QueryActiveChannelRequest struct {
+	// interchain account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryActiveChannelRequest) Reset()         { *m = QueryActiveChannelRequest{} }
+func (m *QueryActiveChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveChannelRequest) ProtoMessage()    {}
+func (*QueryActiveChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{10}
+}
+func (m *QueryActiveChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveChannelRequest.Merge(m, src)
+}
+func (m *QueryActiveChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveChannelRequest proto.InternalMessageInfo
+
+func (m *QueryActiveChannelRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryActiveChannelResponse is the response type for the Query/ActiveChannel RPC method.
+type QueryActiveChannelResponse struct {
+	// host channel identifier of the active channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// host port identifier of the active channel
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *QueryActiveChannelResponse) Reset()         { *m = QueryActiveChannelResponse{} }
+func (m *QueryActiveChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveChannelResponse) ProtoMessage()    {}
+func (*QueryActiveChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{11}
+}
+func (m *QueryActiveChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveChannelResponse.Merge(m, src)
+}
+func (m *QueryActiveChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveChannelResponse proto.InternalMessageInfo
+
+func (m *QueryActiveChannelResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryActiveChannelResponse) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMessagePoliciesRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryMessagePoliciesRequest")
+	proto.RegisterType((*QueryMessagePoliciesResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryMessagePoliciesResponse")
+	proto.RegisterType((*QueryEffectiveMessagePolicyRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryEffectiveMessagePolicyRequest")
+	proto.RegisterType((*QueryEffectiveMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryEffectiveMessagePolicyResponse")
+	proto.RegisterType((*QueryInterchainAccountsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsRequest")
+	proto.RegisterType((*QueryInterchainAccountsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountsResponse")
+	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountRequest")
+	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*QueryActiveChannelRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryActiveChannelRequest")
+	proto.RegisterType((*QueryActiveChannelResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryActiveChannelResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/query.proto", fileDescriptor_e6b7e23fc90c353a)
+}
+
+var fileDescriptor_e6b7e23fc90c353a = []byte{
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6b, 0xdb, 0x56,
+	0x14, 0xb6, 0xbc, 0xcd, 0x49, 0x4e, 0x16, 0xc6, 0xee, 0xc2, 0x96, 0x69, 0x89, 0x13, 0x14, 0xd8,
+	0xc2, 0x48, 0x74, 0xb1, 0x97, 0x2d, 0xde, 0x06, 0x69, 0x92, 0xd2, 0xa6, 0x4e, 0x1d, 0xea, 0x98,
+	0xbe, 0xb4, 0x50, 0xcc, 0xb5, 0x74, 0x23, 0x0b, 0x6c, 0x5d, 0xc5, 0x57, 0x36, 0x98, 0x90, 0x87,
+	0x16, 0xfa, 0xd8, 0x52, 0xe8, 0x5b, 0xff, 0x94, 0xbe, 0x16, 0x4a, 0x5e, 0x0a, 0x81, 0xbe, 0xf4,
+	0xa9, 0x94, 0xa4, 0x0f, 0x7d, 0x2c, 0xf4, 0x1f, 0x28, 0xba, 0xba, 0xb2, 0xa3, 0xc4, 0xf9, 0x61,
+	0x47, 0x6f, 0xd6, 0x91, 0xce, 0x77, 0xbe, 0xef, 0xd3, 0xf1, 0x77, 0x05, 0x39, 0xbb, 0x62, 0x60,
+	0xe2, 0xba, 0x35, 0xdb, 0x20, 0x9e, 0xcd, 0x1c, 0x8e, 0x6d, 0xc7, 0xa3, 0x0d, 0xa3, 0x4a, 0x6c,
+	0xa7, 0x4c, 0x0c, 0x83, 0x35, 0x1d, 0x8f, 0xe3, 0x2a, 0xe3, 0x1e, 0x6e, 0x65, 0xf0, 0x4e, 0x93,
+	0x36, 0xda, 0xba, 0xdb, 0x60, 0x1e, 0x43, 0xf3, 0x76, 0xc5, 0xd0, 0x8f, 0x77, 0xea, 0x3d, 0x3a,
+	0x75, 0xbf, 0x53, 0x6f, 0x65, 0xd4, 0x71, 0x8b, 0x59, 0x4c, 0x34, 0x62, 0xff, 0x57, 0x80, 0xa1,
+	0x4e, 0x5a, 0x8c, 0x59, 0x35, 0x8a, 0x89, 0x6b, 0x63, 0xe2, 0x38, 0xcc, 0x93, 0x48, 0xc1, 0xdd,
+	0x3f, 0x0d, 0xc6, 0xeb, 0x8c, 0xe3, 0x0a, 0xe1, 0x34, 0x18, 0x8d, 0x5b, 0x99, 0x0a, 0xf5, 0x48,
+	0x06, 0xbb, 0xc4, 0xb2, 0x1d, 0xf1, 0xb0, 0x7c, 0x76, 0xa9, 0x2f, 0x1d, 0x82, 0x95, 0x68, 0xd4,
+	0xc6, 0x01, 0x6d, 0xf9, 0xd0, 0x45, 0xd2, 0x20, 0x75, 0x5e, 0xa2, 0x3b, 0x4d, 0xca, 0x3d, 0xcd,
+	0x80, 0x9f, 0x22, 0x55, 0xee, 0x32, 0x87, 0x53, 0x54, 0x80, 0x94, 0x2b, 0x2a, 0x13, 0xca, 0x8c,
+	0x32, 0x37, 0x9a, 0x5d, 0xd4, 0xfb, 0x31, 0x41, 0x97, 0x68, 0x12, 0x43, 0xa3, 0xf0, 0x9b, 0x18,
+	0xb2, 0x49, 0x39, 0x27, 0x16, 0x2d, 0xb2, 0x9a, 0x6d, 0xd8, 0x34, 0xe4, 0x80, 0x6e, 0x02, 0x74,
+	0x65, 0xca, 0x81, 0xbf, 0xeb, 0x81, 0x27, 0xba, 0xef, 0x89, 0x1e, 0xbc, 0x0e, 0xe9, 0x89, 0x5e,
+	0x24, 0x16, 0x95, 0xbd, 0xa5, 0x63, 0x9d, 0xda, 0x6b, 0x05, 0x26, 0x7b, 0xcf, 0x91, 0xaa, 0x1e,
+	0xc0, 0xb0, 0x2b, 0x6b, 0x13, 0xca, 0xcc, 0x37, 0x73, 0xa3, 0xd9, 0xff, 0xfb, 0xd3, 0x75, 0x1c,
+	0xb8, 0xbd, 0xf6, 0xed, 0xfe, 0xfb, 0xe9, 0x44, 0xa9, 0x03, 0x89, 0xd6, 0x23, 0x3a, 0x92, 0x42,
+	0xc7, 0x1f, 0x17, 0xea, 0x08, 0xb8, 0x45, 0x84, 0x2c, 0x83, 0x26, 0x74, 0xdc, 0xd8, 0xde, 0xa6,
+	0x86, 0x67, 0xb7, 0x68, 0x64, 0x6e, 0x68, 0xdb, 0x04, 0x0c, 0x11, 0xd3, 0x6c, 0x50, 0x1e, 0xbc,
+	0xa4, 0x91, 0x52, 0x78, 0xa9, 0xbd, 0x50, 0x60, 0xf6, 0x5c, 0x00, 0xe9, 0xc7, 0x3d, 0x48, 0x09,
+	0xf2, 0x6d, 0x69, 0x7a, 0x0c, 0x6e, 0x48, 0x40, 0x9f, 0x9c, 0x49, 0xb7, 0x49, 0xb3, 0xe6, 0x09,
+	0x23, 0x86, 0x4b, 0xe1, 0xa5, 0xf6, 0x44, 0x81, 0xb4, 0x20, 0x97, 0xef, 0x40, 0xaf, 0x4a, 0xe4,
+	0x50, 0xd9, 0x2c, 0x8c, 0x19, 0xcc, 0x71, 0x7c, 0xea, 0xcc, 0x29, 0xdb, 0xa6, 0xd4, 0xf7, 0x7d,
+	0xb7, 0x98, 0x37, 0x4f, 0x6c, 0x4d, 0x72, 0xe0, 0xad, 0x79, 0xa3, 0xc0, 0xf4, 0x99, 0x7c, 0xa4,
+	0x51, 0x04, 0x86, 0x43, 0xf5, 0x72, 0x71, 0xae, 0xf5, 0x67, 0x55, 0x89, 0x5a, 0x36, 0xf7, 0x68,
+	0x83, 0x9a, 0x12, 0x3b, 0x5c, 0x9e, 0xf0, 0xc9, 0xf8, 0x96, 0xe7, 0x5f, 0x98, 0xea, 0x2d, 0xe7,
+	0xe2, 0xbd, 0x79, 0x78, 0xe6, 0xab, 0xe9, 0x38, 0x51, 0x86, 0x21, 0x49, 0x59, 0xee, 0x4c, 0x4c,
+	0x46, 0x84, 0xa8, 0xda, 0xdf, 0xf0, 0xab, 0xa0, 0xb0, 0x2a, 0xf6, 0xf6, 0x7a, 0x95, 0x38, 0x0e,
+	0xad, 0x5d, 0x4c, 0xfd, 0x2e, 0xa8, 0xbd, 0xda, 0x24, 0xeb, 0x29, 0x00, 0x23, 0x28, 0x75, 0xb7,
+	0x69, 0x44, 0x56, 0xf2, 0x26, 0xfa, 0x05, 0x86, 0x5c, 0xd6, 0xf0, 0xfc, 0x7b, 0x49, 0x71, 0x2f,
+	0xe5, 0x5f, 0xe6, 0xcd, 0xec, 0xcb, 0x51, 0xf8, 0x4e, 0xc0, 0xa2, 0x57, 0x0a, 0xa4, 0x82, 0x54,
+	0x43, 0x2b, 0xfd, 0x29, 0x3e, 0x1d, 0xba, 0xea, 0xea, 0x15, 0x10, 0x02, 0x45, 0xda, 0xe2, 0xa3,
+	0xb7, 0x1f, 0x9f, 0x27, 0x75, 0x34, 0x8f, 0xe5, 0x79, 0x70, 0xfe, 0x39, 0x10, 0x04, 0x31, 0xfa,
+	0xa4, 0xc0, 0x0f, 0x27, 0xc2, 0x11, 0xe5, 0x07, 0x20, 0xd3, 0x3b, 0xc8, 0xd5, 0x8d, 0x38, 0xa0,
+	0xa4, 0xc0, 0x65, 0x21, 0x30, 0x87, 0xfe, 0xb9, 0x9c, 0xc0, 0x7a, 0x00, 0x53, 0xee, 0x84, 0xf1,
+	0xd3, 0x24, 0xfc, 0xdc, 0x3b, 0xfe, 0x50, 0x71, 0x00, 0x9a, 0xe7, 0x46, 0xb1, 0xba, 0x15, 0x23,
+	0xa2, 0xd4, 0x7f, 0x47, 0xe8, 0xcf, 0xa3, 0xf5, 0xcb, 0xe9, 0xef, 0x14, 0x76, 0xe5, 0x3f, 0x62,
+	0x2f, 0x6a, 0x49, 0x1b, 0x3d, 0x4e, 0x02, 0x3a, 0x1d, 0x71, 0xa8, 0x30, 0x00, 0xf5, 0x33, 0x93,
+	0x5b, 0xdd, 0x8c, 0x09, 0x4d, 0x9a, 0x50, 0x12, 0x26, 0x14, 0xd0, 0xc6, 0xe5, 0x4c, 0xe8, 0x9e,
+	0x0f, 0x1c, 0xef, 0x46, 0x4e, 0x90, 0xbd, 0x8e, 0x41, 0xe8, 0xb3, 0x02, 0x3f, 0x9e, 0x1a, 0x89,
+	0x6e, 0xc7, 0x41, 0x3c, 0x74, 0xa1, 0x10, 0x0f, 0x98, 0x34, 0x61, 0x45, 0x98, 0xf0, 0x1f, 0xca,
+	0x0d, 0xba, 0x09, 0xe8, 0x8b, 0x02, 0x63, 0x91, 0x60, 0x44, 0xeb, 0x03, 0x30, 0xec, 0x95, 0xc8,
+	0xea, 0xad, 0xab, 0x03, 0xc5, 0xb6, 0xf0, 0x44, 0xe0, 0x96, 0x65, 0xae, 0xaf, 0x99, 0xfb, 0x87,
+	0x69, 0xe5, 0xe0, 0x30, 0xad, 0x7c, 0x38, 0x4c, 0x2b, 0xcf, 0x8e, 0xd2, 0x89, 0x83, 0xa3, 0x74,
+	0xe2, 0xdd, 0x51, 0x3a, 0x71, 0x7f, 0xc3, 0xb2, 0xbd, 0x6a, 0xb3, 0xa2, 0x1b, 0xac, 0x8e, 0xe5,
+	0xa7, 0xb7, 0x5d, 0x31, 0x16, 0x2c, 0x86, 0x5b, 0x39, 0x5c, 0x67, 0x66, 0xb3, 0x46, 0x79, 0xc0,
+	0x20, 0xbb, 0xb4, 0xd0, 0x25, 0xb1, 0x10, 0x25, 0xe1, 0xb5, 0x5d, 0xca, 0x2b, 0x29, 0xf1, 0x75,
+	0xfd, 0xd7, 0xd7, 0x01, 0x00, 0x0f, 0x38, 0x0b, 0x87, 0x60, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the ICA host submodule.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MessagePolicies queries all message policies set on the ICA host submodule.
+	MessagePolicies(ctx context.Context, in *QueryMessagePoliciesRequest, opts ...grpc.CallOption) (*QueryMessagePoliciesResponse, error)
+	// EffectiveMessagePolicy queries the message policy applied to the messages executed by an interchain account.
+	EffectiveMessagePolicy(ctx context.Context, in *QueryEffectiveMessagePolicyRequest, opts ...grpc.CallOption) (*QueryEffectiveMessagePolicyResponse, error)
+	// InterchainAccounts queries all interchain accounts registered over a connection.
+	InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error)
+	// InterchainAccount queries the connection and controller port identifiers of an interchain account.
+	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// ActiveChannel queries the active channel of an interchain account.
+	ActiveChannel(ctx context.Context, in *QueryActiveChannelRequest, opts ...grpc.CallOption) (*QueryActiveChannelResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MessagePolicies(ctx context.Context, in *QueryMessagePoliciesRequest, opts ...grpc.CallOption) (*QueryMessagePoliciesResponse, error) {
+	out := new(QueryMessagePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/MessagePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMessagePolicy(ctx context.Context, in *QueryEffectiveMessagePolicyRequest, opts ...grpc.CallOption) (*QueryEffectiveMessagePolicyResponse, error) {
+	out := new(QueryEffectiveMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/EffectiveMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error) {
+	out := new(QueryInterchainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error) {
+	out := new(QueryInterchainAccountResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveChannel(ctx context.Context, in *QueryActiveChannelRequest, opts ...grpc.CallOption) (*QueryActiveChannelResponse, error) {
+	out := new(QueryActiveChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/ActiveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the ICA host submodule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MessagePolicies queries all message policies set on the ICA host submodule.
+	MessagePolicies(context.Context, *QueryMessagePoliciesRequest) (*QueryMessagePoliciesResponse, error)
+	// EffectiveMessagePolicy queries the message policy applied to the messages executed by an interchain account.
+	EffectiveMessagePolicy(context.Context, *QueryEffectiveMessagePolicyRequest) (*QueryEffectiveMessagePolicyResponse, error)
+	// InterchainAccounts queries all interchain accounts registered over a connection.
+	InterchainAccounts(context.Context, *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error)
+	// InterchainAccount queries the connection and controller port identifiers of an interchain account.
+	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// ActiveChannel queries the active channel of an interchain account.
+	ActiveChannel(context.Context, *QueryActiveChannelRequest) (*QueryActiveChannelResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MessagePolicies(ctx context.Context, req *QueryMessagePoliciesRequest) (*QueryMessagePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessagePolicies not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveMessagePolicy(ctx context.Context, req *QueryEffectiveMessagePolicyRequest) (*QueryEffectiveMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMessagePolicy not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccounts(ctx context.Context, req *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccounts not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
+}
+func (*UnimplementedQueryServer) ActiveChannel(ctx context.Context, req *QueryActiveChannelRequest) (*QueryActiveChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveChannel not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MessagePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessagePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessagePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/MessagePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessagePolicies(ctx, req.(*QueryMessagePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMessagePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/EffectiveMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMessagePolicy(ctx, req.(*QueryEffectiveMessagePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccounts(ctx, req.(*QueryInterchainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccount(ctx, req.(*QueryInterchainAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/ActiveChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveChannel(ctx, req.(*QueryActiveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MessagePolicies",
+			Handler:    _Query_MessagePolicies_Handler,
+		},
+		{
+			MethodName: "EffectiveMessagePolicy",
+			Handler:    _Query_EffectiveMessagePolicy_Handler,
+		},
+		{
+			MethodName: "InterchainAccounts",
+			Handler:    _Query_InterchainAccounts_Handler,
+		},
+		{
+			MethodName: "InterchainAccount",
+			Handler:    _Query_InterchainAccount_Handler,
+		},
+		{
+			MethodName: "ActiveChannel",
+			Handler:    _Query_ActiveChannel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Default {
+		i--
+		if m.Default {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessagePoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMessagePoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMessagePolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Default {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActiveChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessagePoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessagePoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessagePoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMessagePoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMessagePoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMessagePoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, MessagePolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMessagePolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMessagePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Default = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, RegisteredAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,12 +2544,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -373,7 +2603,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,17 +2626,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -416,27 +2646,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -109,9 +109,269 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgModuleQuerySafe defines the payload for Msg/ModuleQuerySafe
+type MsgModuleQuerySafe struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// requests defines the module safe queries to execute.
+	Requests []QueryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *MsgModuleQuerySafe) Reset()         { *m = MsgModuleQuerySafe{} }
+func (m *MsgModuleQuerySafe) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleQuerySafe) ProtoMessage()    {}
+func (*MsgModuleQuerySafe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{2}
+}
+func (m *MsgModuleQuerySafe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleQuerySafe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleQuerySafe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModuleQuerySafe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleQuerySafe.Merge(m, src)
+}
+func (m *MsgModuleQuerySafe) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleQuerySafe) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleQuerySafe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleQuerySafe proto.InternalMessageInfo
+
+// MsgModuleQuerySafeResponse defines the response for Msg/ModuleQuerySafe
+type MsgModuleQuerySafeResponse struct {
+	// height at which the responses were queried
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// protobuf encoded responses for each query
+	Responses [][]byte `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *MsgModuleQuerySafeResponse) Reset()         { *m = MsgModuleQuerySafeResponse{} }
+func (m *MsgModuleQuerySafeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleQuerySafeResponse) ProtoMessage()    {}
+func (*MsgModuleQuerySafeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{3}
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleQuerySafeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleQuerySafeResponse.Merge(m, src)
+}
+func (m *MsgModuleQuerySafeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleQuerySafeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleQuerySafeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleQuerySafeResponse proto.InternalMessageInfo
+
+func (m *MsgModuleQuerySafeResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgModuleQuerySafeResponse) GetResponses() [][]byte {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// MsgSetMessagePolicy defines the payload for Msg/SetMessagePolicy
+type MsgSetMessagePolicy struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// policy defines the message policy to set, replacing any policy set for the same connection and controller port.
+	Policy MessagePolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetMessagePolicy) Reset()         { *m = MsgSetMessagePolicy{} }
+func (m *MsgSetMessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessagePolicy) ProtoMessage()    {}
+func (*MsgSetMessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{4}
+}
+func (m *MsgSetMessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessagePolicy.Merge(m, src)
+}
+func (m *MsgSetMessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessagePolicy proto.InternalMessageInfo
+
+// MsgSetMessagePolicyResponse defines the response for Msg/SetMessagePolicy
+type MsgSetMessagePolicyResponse struct {
+}
+
+func (m *MsgSetMessagePolicyResponse) Reset()         { *m = MsgSetMessagePolicyResponse{} }
+func (m *MsgSetMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMessagePolicyResponse) ProtoMessage()    {}
+func (*MsgSetMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{5}
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMessagePolicyResponse.Merge(m, src)
+}
+func (m *MsgSetMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMessagePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMessagePolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveMessagePolicy defines the payload for Msg/RemoveMessagePolicy
+type MsgRemoveMessagePolicy struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// connection identifier of the message policy
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller port identifier of the message policy, empty for a connection wide policy
+	ControllerPortId string `protobuf:"bytes,3,opt,name=controller_port_id,json=controllerPortId,proto3" json:"controller_port_id,omitempty"`
+}
+
+func (m *MsgRemoveMessagePolicy) Reset()         { *m = MsgRemoveMessagePolicy{} }
+func (m *MsgRemoveMessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessagePolicy) ProtoMessage()    {}
+func (*MsgRemoveMessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{6}
+}
+func (m *MsgRemoveMessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessagePolicy.Merge(m, src)
+}
+func (m *MsgRemoveMessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessagePolicy proto.InternalMessageInfo
+
+// MsgRemoveMessagePolicyResponse defines the response for Msg/RemoveMessagePolicy
+type MsgRemoveMessagePolicyResponse struct {
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Reset()         { *m = MsgRemoveMessagePolicyResponse{} }
+func (m *MsgRemoveMessagePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMessagePolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveMessagePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{7}
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMessagePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMessagePolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMessagePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMessagePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMessagePolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgModuleQuerySafe)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe")
+	proto.RegisterType((*MsgModuleQuerySafeResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafeResponse")
+	proto.RegisterType((*MsgSetMessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessagePolicy")
+	proto.RegisterType((*MsgSetMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetMessagePolicyResponse")
+	proto.RegisterType((*MsgRemoveMessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessagePolicy")
+	proto.RegisterType((*MsgRemoveMessagePolicyResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveMessagePolicyResponse")
 }
 
 func init() {
@@ -119,29 +379,46 @@ func init() {
 }
 
 var fileDescriptor_fa437afde7f1e7ae = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xd2, 0xc9, 0x4c, 0x4a, 0xd6, 0x43, 0xd6, 0xa6, 0x87, 0x45, 0x9b, 0x1e, 0x48, 0x9b,
-	0x5e, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0xa3, 0x3e, 0x88, 0x05, 0x31, 0x43,
-	0x4a, 0x3c, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0xb7, 0x38, 0x1d, 0x64, 0x76, 0x6e, 0x71,
-	0x3a, 0x54, 0xc2, 0x9c, 0x24, 0x37, 0x81, 0x2d, 0x01, 0x6b, 0x54, 0xea, 0x63, 0xe4, 0xe2, 0xf7,
-	0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x12,
-	0xe3, 0x62, 0x2b, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82,
-	0xf2, 0x84, 0x82, 0xb8, 0xd8, 0x0a, 0xc0, 0x2a, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x4c,
-	0xf4, 0x48, 0xf1, 0x92, 0x1e, 0xc4, 0x74, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x26,
-	0x59, 0xf1, 0x77, 0x2c, 0x90, 0x67, 0x68, 0x7a, 0xbe, 0x41, 0x0b, 0x6a, 0x89, 0x92, 0x24, 0x97,
-	0x38, 0x9a, 0x7b, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x16, 0x33, 0x72, 0x31,
-	0xfb, 0x16, 0xa7, 0x0b, 0x4d, 0x61, 0xe4, 0xe2, 0x41, 0x71, 0xb0, 0x2d, 0x69, 0x0e, 0x41, 0x33,
-	0x5f, 0xca, 0x95, 0x22, 0xed, 0x30, 0xe7, 0x49, 0xb1, 0x36, 0x3c, 0xdf, 0xa0, 0xc5, 0xe8, 0x94,
-	0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x5e, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x88, 0xcc, 0x4c, 0x4a, 0xd6, 0x4d, 0xcf, 0xd7,
-	0x2f, 0xb3, 0xd0, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x45, 0x62, 0xb1, 0xbe, 0x91,
-	0xb9, 0x2e, 0xc2, 0x05, 0xba, 0xa8, 0xf1, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e,
-	0x3e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xa2, 0x96, 0x3e, 0x8d, 0x02, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x33, 0xbf, 0xb6, 0xe1, 0x97, 0x69, 0xa4, 0x65, 0x2b, 0x6d, 0x5d, 0x75, 0x1b, 0xe2,
+	0x25, 0x94, 0x66, 0x97, 0x46, 0xa5, 0x52, 0x11, 0xb4, 0x28, 0x18, 0x71, 0x21, 0x6e, 0xf1, 0xa0,
+	0x08, 0x61, 0x33, 0x3b, 0x4e, 0x06, 0xb2, 0x3b, 0xeb, 0xcc, 0x24, 0x98, 0x9b, 0x78, 0xf2, 0x24,
+	0x22, 0xbd, 0x88, 0x08, 0xbd, 0x7a, 0xeb, 0xcb, 0xe8, 0xb1, 0x47, 0x4f, 0x22, 0xc9, 0xa1, 0x6f,
+	0x43, 0x76, 0xb2, 0xd9, 0x98, 0x3f, 0x05, 0x97, 0x78, 0xdb, 0x99, 0x79, 0x9e, 0xef, 0xf3, 0x79,
+	0xf6, 0x79, 0x1e, 0x1e, 0x78, 0x9b, 0x36, 0x90, 0xe5, 0x86, 0x61, 0x8b, 0x22, 0x57, 0x52, 0x16,
+	0x08, 0x8b, 0x06, 0x12, 0x73, 0xd4, 0x74, 0x69, 0x50, 0x77, 0x11, 0x62, 0xed, 0x40, 0x0a, 0xab,
+	0xc9, 0x84, 0xb4, 0x3a, 0xbb, 0x96, 0x7c, 0x6b, 0x86, 0x9c, 0x49, 0xa6, 0xed, 0xd0, 0x06, 0x32,
+	0xff, 0x74, 0x33, 0x67, 0xb8, 0x99, 0x91, 0x9b, 0xd9, 0xd9, 0xd5, 0x2f, 0x13, 0x46, 0x98, 0x72,
+	0xb4, 0xa2, 0xaf, 0x81, 0x86, 0xbe, 0x81, 0x98, 0xf0, 0x99, 0xb0, 0x7c, 0x41, 0x22, 0x6d, 0x5f,
+	0x90, 0xf8, 0x61, 0x2f, 0x15, 0x93, 0x0a, 0xa2, 0x1c, 0x8b, 0x1f, 0x01, 0x5c, 0xb1, 0x05, 0x79,
+	0x1e, 0x7a, 0xae, 0xc4, 0x35, 0x97, 0xbb, 0xbe, 0xd0, 0xd6, 0x61, 0x56, 0x50, 0x12, 0x60, 0xbe,
+	0x09, 0x0a, 0xa0, 0x94, 0x73, 0xe2, 0x93, 0xe6, 0xc0, 0x6c, 0xa8, 0x2c, 0x36, 0xff, 0x2b, 0x80,
+	0xd2, 0x72, 0xe5, 0x96, 0x99, 0x26, 0x25, 0x73, 0xa0, 0x7e, 0xb0, 0x78, 0xfa, 0x73, 0x2b, 0xe3,
+	0xc4, 0x4a, 0xfb, 0x2b, 0x1f, 0x8e, 0xb7, 0x32, 0xef, 0xcf, 0x4f, 0xb6, 0xe3, 0x20, 0xc5, 0x2b,
+	0x70, 0x63, 0x82, 0xc7, 0xc1, 0x22, 0x64, 0x81, 0xc0, 0xc5, 0xaf, 0x00, 0x6a, 0xb6, 0x20, 0x36,
+	0xf3, 0xda, 0x2d, 0xfc, 0xac, 0x8d, 0x79, 0xf7, 0xd0, 0x7d, 0x8d, 0x2f, 0xc4, 0x7d, 0x05, 0xff,
+	0xe7, 0xf8, 0x4d, 0x1b, 0x0b, 0x19, 0x01, 0x2f, 0x94, 0x96, 0x2b, 0xfb, 0xe9, 0x80, 0x55, 0x08,
+	0x67, 0x20, 0x11, 0x63, 0x27, 0x8a, 0xd3, 0xe0, 0x0e, 0xd4, 0xa7, 0xe1, 0x86, 0xec, 0x11, 0x64,
+	0x13, 0x53, 0xd2, 0x94, 0x0a, 0x72, 0xd1, 0x89, 0x4f, 0xda, 0x35, 0x98, 0xe3, 0xb1, 0xcd, 0x80,
+	0x32, 0xef, 0x8c, 0x2e, 0x8a, 0x5f, 0x00, 0x5c, 0xb3, 0x05, 0x39, 0xc4, 0xd2, 0xc6, 0x42, 0xb8,
+	0x04, 0xd7, 0x58, 0x8b, 0xa2, 0xee, 0x85, 0x29, 0xbf, 0x80, 0xd9, 0x50, 0x59, 0xc4, 0x15, 0xba,
+	0x9b, 0x2e, 0xe1, 0xb1, 0x20, 0x49, 0xa1, 0xd4, 0x69, 0x3a, 0xdf, 0xeb, 0xf0, 0xea, 0x0c, 0xb4,
+	0xa4, 0x58, 0x47, 0x00, 0xae, 0xdb, 0x82, 0x38, 0xd8, 0x67, 0x1d, 0xfc, 0x77, 0xf4, 0x37, 0xe0,
+	0x25, 0xc4, 0x82, 0x00, 0xa3, 0x88, 0xb4, 0x4e, 0x3d, 0x95, 0x44, 0xce, 0xc9, 0x8f, 0x2e, 0xab,
+	0x9e, 0xb6, 0x03, 0x35, 0xc4, 0x02, 0xc9, 0x59, 0xab, 0x85, 0x79, 0x3d, 0x64, 0x5c, 0x46, 0x96,
+	0x0b, 0xca, 0x72, 0x75, 0xf4, 0x52, 0x63, 0x5c, 0x56, 0xbd, 0x69, 0xea, 0x02, 0x34, 0x66, 0x53,
+	0x0d, 0xc1, 0x2b, 0x9f, 0x97, 0xe0, 0x82, 0x2d, 0x88, 0x76, 0x04, 0x60, 0x7e, 0x6c, 0x2c, 0xee,
+	0xa5, 0xfc, 0x99, 0xe3, 0x5d, 0xac, 0x3f, 0x9a, 0xcb, 0x3d, 0x69, 0xa4, 0x6f, 0xd1, 0xc0, 0x4e,
+	0x4c, 0xc0, 0xfd, 0xd4, 0xd2, 0x13, 0x0a, 0xfa, 0xe3, 0x79, 0x15, 0x12, 0xbe, 0x63, 0x00, 0x57,
+	0xa7, 0xfa, 0xf5, 0x41, 0x6a, 0xf9, 0x49, 0x09, 0xbd, 0x3a, 0xb7, 0x44, 0x82, 0xf8, 0x1d, 0xc0,
+	0xb5, 0x59, 0x7d, 0xf9, 0x30, 0x75, 0x88, 0x19, 0x2a, 0xfa, 0xd3, 0x7f, 0xa1, 0x32, 0x64, 0xd5,
+	0x97, 0xde, 0x9d, 0x9f, 0x6c, 0x83, 0x03, 0xef, 0xb4, 0x67, 0x80, 0xb3, 0x9e, 0x01, 0x7e, 0xf5,
+	0x0c, 0xf0, 0xa9, 0x6f, 0x64, 0xce, 0xfa, 0x46, 0xe6, 0x47, 0xdf, 0xc8, 0xbc, 0x7c, 0x42, 0xa8,
+	0x6c, 0xb6, 0x1b, 0x26, 0x62, 0xbe, 0x15, 0x6f, 0x07, 0xda, 0x40, 0x65, 0xc2, 0xac, 0xce, 0x1d,
+	0xcb, 0x57, 0x45, 0x12, 0xd1, 0x66, 0x10, 0x56, 0x65, 0xaf, 0x3c, 0x02, 0x29, 0x8f, 0x2f, 0x05,
+	0xd9, 0x0d, 0xb1, 0x68, 0x64, 0xd5, 0x4e, 0xb8, 0xf9, 0x7b, 0x00, 0x80, 0xbd, 0x8c, 0x15, 0xe2,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +435,12 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
+	ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error)
+	// SetMessagePolicy defines a rpc handler for MsgSetMessagePolicy.
+	SetMessagePolicy(ctx context.Context, in *MsgSetMessagePolicy, opts ...grpc.CallOption) (*MsgSetMessagePolicyResponse, error)
+	// RemoveMessagePolicy defines a rpc handler for MsgRemoveMessagePolicy.
+	RemoveMessagePolicy(ctx context.Context, in *MsgRemoveMessagePolicy, opts ...grpc.CallOption) (*MsgRemoveMessagePolicyResponse, error)
 }
 
 type msgClient struct {
@@ -177,10 +460,43 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ModuleQuerySafe(ctx context.Context, in *MsgModuleQuerySafe, opts ...grpc.CallOption) (*MsgModuleQuerySafeResponse, error) {
+	out := new(MsgModuleQuerySafeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/ModuleQuerySafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMessagePolicy(ctx context.Context, in *MsgSetMessagePolicy, opts ...grpc.CallOption) (*MsgSetMessagePolicyResponse, error) {
+	out := new(MsgSetMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMessagePolicy(ctx context.Context, in *MsgRemoveMessagePolicy, opts ...grpc.CallOption) (*MsgRemoveMessagePolicyResponse, error) {
+	out := new(MsgRemoveMessagePolicyResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessagePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ModuleQuerySafe defines a rpc handler for MsgModuleQuerySafe.
+	ModuleQuerySafe(context.Context, *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error)
+	// SetMessagePolicy defines a rpc handler for MsgSetMessagePolicy.
+	SetMessagePolicy(context.Context, *MsgSetMessagePolicy) (*MsgSetMessagePolicyResponse, error)
+	// RemoveMessagePolicy defines a rpc handler for MsgRemoveMessagePolicy.
+	RemoveMessagePolicy(context.Context, *MsgRemoveMessagePolicy) (*MsgRemoveMessagePolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -190,6 +506,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ModuleQuerySafe(ctx context.Context, req *MsgModuleQuerySafe) (*MsgModuleQuerySafeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleQuerySafe not implemented")
+}
+func (*UnimplementedMsgServer) SetMessagePolicy(ctx context.Context, req *MsgSetMessagePolicy) (*MsgSetMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMessagePolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveMessagePolicy(ctx context.Context, req *MsgRemoveMessagePolicy) (*MsgRemoveMessagePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMessagePolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -213,6 +538,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModuleQuerySafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModuleQuerySafe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModuleQuerySafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/ModuleQuerySafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModuleQuerySafe(ctx, req.(*MsgModuleQuerySafe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMessagePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/SetMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMessagePolicy(ctx, req.(*MsgSetMessagePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMessagePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMessagePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMessagePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveMessagePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMessagePolicy(ctx, req.(*MsgRemoveMessagePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -221,6 +600,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ModuleQuerySafe",
+			Handler:    _Msg_ModuleQuerySafe_Handler,
+		},
+		{
+			MethodName: "SetMessagePolicy",
+			Handler:    _Msg_SetMessagePolicy_Handler,
+		},
+		{
+			MethodName: "RemoveMessagePolicy",
+			Handler:    _Msg_RemoveMessagePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
@@ -289,42 +680,344 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgModuleQuerySafe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgModuleQuerySafe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgModuleQuerySafe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgModuleQuerySafeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleQuerySafeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleQuerySafeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Responses[iNdEx])
+			copy(dAtA[i:], m.Responses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Responses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControllerPortId) > 0 {
+		i -= len(m.ControllerPortId)
+		copy(dAtA[i:], m.ControllerPortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ControllerPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMessagePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMessagePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModuleQuerySafe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgModuleQuerySafeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Responses) > 0 {
+		for _, b := range m.Responses {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ControllerPortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMessagePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -495,6 +1188,584 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgModuleQuerySafe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleQuerySafeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleQuerySafeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMessagePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMessagePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMessagePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMessagePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMessagePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,10 +2,18 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket              = "ics27_packet"
+	EventTypeSetMessagePolicy    = "set_message_policy"
+	EventTypeRemoveMessagePolicy = "remove_message_policy"
+
+	EventTypeTransferInterchainAccountOwnership = "transfer_interchain_account_ownership"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyControllerPortID    = "controller_port_id"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyNewOwner            = "new_owner"
 )
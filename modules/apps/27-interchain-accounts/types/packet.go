@@ -41,6 +41,10 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
 	}
 
+	if _, ok := Type_name[int32(iapd.Type)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data type %d is not supported", iapd.Type)
+	}
+
 	if len(iapd.Data) == 0 {
 		return errorsmod.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
 	}
@@ -82,6 +86,9 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 //   - The sender address is set by the packet sender and may not have been validated a signature
 //     check if the packet sender isn't the interchain accounts module.
 //   - The sender address must only be used by modules on the sending chain.
+//   - The sender address is the original owner of the interchain account. The owner to which the
+//     interchain account has been transferred is returned by the PacketSenderRetriever interface
+//     implemented by the controller middleware.
 func (InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
 	icaOwner, found := strings.CutPrefix(sourcePortID, ControllerPortPrefix)
 	if !found {
@@ -33,16 +33,22 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute each message of a transaction independently on an interchain accounts host chain. The acknowledgement
+	// contains the result of every message, and the messages which fail do not revert the messages which succeed.
+	// Host chains which do not support this type return an error acknowledgement with the unknown data type error.
+	EXECUTE_TX_PARTIAL Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_EXECUTE_TX_PARTIAL",
 }
 
 var Type_value = map[string]int32{
-	"TYPE_UNSPECIFIED": 0,
-	"TYPE_EXECUTE_TX":  1,
+	"TYPE_UNSPECIFIED":        0,
+	"TYPE_EXECUTE_TX":         1,
+	"TYPE_EXECUTE_TX_PARTIAL": 2,
 }
 
 func (x Type) String() string {
@@ -159,10 +165,132 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// MsgResult contains the result of the execution of a single message of a transaction executed on an interchain
+// accounts host chain with the TYPE_EXECUTE_TX_PARTIAL packet type.
+type MsgResult struct {
+	// success is true if the message was executed successfully and its state changes were committed.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// response contains the message response if the message was executed successfully.
+	Response *types.Any `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// codespace of the error if the message failed.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code of the error if the message failed.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *MsgResult) Reset()         { *m = MsgResult{} }
+func (m *MsgResult) String() string { return proto.CompactTextString(m) }
+func (*MsgResult) ProtoMessage()    {}
+func (*MsgResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *MsgResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResult.Merge(m, src)
+}
+func (m *MsgResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResult proto.InternalMessageInfo
+
+func (m *MsgResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgResult) GetResponse() *types.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *MsgResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *MsgResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+// TxMsgResults is the acknowledgement result of a transaction executed on an interchain accounts host chain with the
+// TYPE_EXECUTE_TX_PARTIAL packet type. It contains the result of each message in the order of execution.
+type TxMsgResults struct {
+	Results []MsgResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *TxMsgResults) Reset()         { *m = TxMsgResults{} }
+func (m *TxMsgResults) String() string { return proto.CompactTextString(m) }
+func (*TxMsgResults) ProtoMessage()    {}
+func (*TxMsgResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *TxMsgResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxMsgResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxMsgResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxMsgResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxMsgResults.Merge(m, src)
+}
+func (m *TxMsgResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxMsgResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxMsgResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxMsgResults proto.InternalMessageInfo
+
+func (m *TxMsgResults) GetResults() []MsgResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*MsgResult)(nil), "ibc.applications.interchain_accounts.v1.MsgResult")
+	proto.RegisterType((*TxMsgResults)(nil), "ibc.applications.interchain_accounts.v1.TxMsgResults")
 }
 
 func init() {
@@ -170,32 +298,39 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0xd0, 0x85, 0x9b, 0xda, 0x46, 0xe8, 0x46, 0x4a, 0x4c, 0xa1, 0xba, 0x09,
-	0x93, 0xe9, 0x98, 0x0e, 0x36, 0x99, 0xd0, 0x99, 0x14, 0xf3, 0x07, 0xa5, 0x2b, 0x7f, 0xa0, 0x2b,
-	0x7f, 0xc6, 0x65, 0x97, 0x2e, 0xa5, 0xfd, 0x11, 0xc9, 0x04, 0xdb, 0x2e, 0x5c, 0xb8, 0x3b, 0x1c,
-	0xee, 0x39, 0xf7, 0x9e, 0x7b, 0xe0, 0x2b, 0x9e, 0x52, 0x4c, 0xca, 0x72, 0xc1, 0x29, 0x51, 0x5c,
-	0x14, 0x12, 0xf3, 0x42, 0xb1, 0x25, 0x9d, 0x13, 0x5e, 0x24, 0x84, 0x52, 0x51, 0x15, 0x4a, 0xe2,
-	0x55, 0x17, 0x97, 0x84, 0x7e, 0x65, 0x0a, 0x95, 0x4b, 0xa1, 0x84, 0xf5, 0x8c, 0xa7, 0x14, 0x9d,
-	0xab, 0xd0, 0x3f, 0x54, 0x68, 0xd5, 0x75, 0x1e, 0x65, 0x42, 0x64, 0x0b, 0x86, 0xb5, 0x2c, 0xad,
-	0xbe, 0x60, 0x52, 0xd4, 0xad, 0x87, 0x73, 0x9b, 0x89, 0x4c, 0x68, 0x88, 0x1b, 0xd4, 0xb2, 0x77,
-	0x6b, 0x00, 0x1f, 0x8f, 0x8e, 0x5e, 0xfd, 0xd6, 0x6a, 0xac, 0x77, 0x0f, 0x89, 0x22, 0x56, 0x1f,
-	0x9a, 0xaa, 0x2e, 0x99, 0x0d, 0x3c, 0xe0, 0x5f, 0xf7, 0x02, 0xf4, 0x9f, 0x87, 0xa0, 0xb8, 0x2e,
-	0x59, 0xa4, 0xa5, 0x96, 0x05, 0xcd, 0x19, 0x51, 0xc4, 0xbe, 0xe7, 0x01, 0xff, 0x61, 0xa4, 0x71,
-	0xc3, 0xe5, 0x2c, 0x17, 0xf6, 0x85, 0x07, 0xfc, 0x07, 0x91, 0xc6, 0x77, 0x6f, 0xe1, 0xe5, 0x40,
-	0xc8, 0x5c, 0xc8, 0xf8, 0x9b, 0xf5, 0x02, 0x5e, 0xe6, 0x4c, 0x4a, 0x92, 0x31, 0x69, 0x03, 0xef,
-	0xc2, 0xbf, 0xea, 0xdd, 0xa2, 0x36, 0x1a, 0xfa, 0x1b, 0x0d, 0xf5, 0x8b, 0x3a, 0x3a, 0x4e, 0x3d,
-	0x9f, 0x42, 0xb3, 0xd9, 0x69, 0x3d, 0x85, 0x9d, 0xf8, 0xd3, 0x38, 0x4c, 0x26, 0x1f, 0x3e, 0x8e,
-	0xc3, 0xc1, 0xe8, 0xfd, 0x28, 0x1c, 0x76, 0x0c, 0xe7, 0x66, 0xb3, 0xf5, 0xae, 0xce, 0x28, 0xeb,
-	0x09, 0xbc, 0xd1, 0x63, 0xe1, 0x34, 0x1c, 0x4c, 0xe2, 0x30, 0x89, 0xa7, 0x1d, 0xe0, 0x5c, 0x6f,
-	0xb6, 0x1e, 0x3c, 0x31, 0x8e, 0xb9, 0xfe, 0xe1, 0x1a, 0xef, 0x92, 0x9f, 0x7b, 0x17, 0xec, 0xf6,
-	0x2e, 0xf8, 0xbd, 0x77, 0xc1, 0xf7, 0x83, 0x6b, 0xec, 0x0e, 0xae, 0xf1, 0xeb, 0xe0, 0x1a, 0x9f,
-	0xc3, 0x8c, 0xab, 0x79, 0x95, 0x22, 0x2a, 0x72, 0x4c, 0xf5, 0xe9, 0x98, 0xa7, 0x34, 0xc8, 0x04,
-	0x5e, 0xbd, 0xc1, 0xb9, 0x98, 0x55, 0x0b, 0x26, 0x9b, 0xb2, 0x25, 0xee, 0xbd, 0x0e, 0x4e, 0x8f,
-	0x0a, 0x8e, 0x3d, 0x37, 0xff, 0x91, 0xe9, 0x7d, 0x1d, 0xe9, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf4, 0xb4, 0x4e, 0xa4, 0x1c, 0x02, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x6a, 0x13, 0x41,
+	0x18, 0xdd, 0x69, 0x17, 0xdb, 0x4c, 0x6b, 0x1b, 0x86, 0xa2, 0xeb, 0x2a, 0xeb, 0x12, 0x11, 0x83,
+	0x90, 0x1d, 0x9b, 0x0a, 0x7a, 0xe1, 0x4d, 0x9a, 0xae, 0x10, 0x50, 0x09, 0xe3, 0x16, 0xaa, 0x37,
+	0x61, 0x76, 0x32, 0x6e, 0x17, 0xb3, 0x3b, 0x4b, 0x66, 0x36, 0x34, 0x6f, 0x50, 0x03, 0x82, 0x2f,
+	0x90, 0x2b, 0x5f, 0xa6, 0x97, 0xbd, 0xf4, 0x4a, 0x24, 0x79, 0x11, 0xd9, 0x49, 0xb3, 0x29, 0xfe,
+	0x40, 0xef, 0xce, 0x9c, 0xfd, 0xbe, 0xb3, 0xe7, 0x7c, 0x33, 0x1f, 0x7c, 0x1e, 0x87, 0x0c, 0xd3,
+	0x2c, 0x1b, 0xc4, 0x8c, 0xaa, 0x58, 0xa4, 0x12, 0xc7, 0xa9, 0xe2, 0x43, 0x76, 0x4a, 0xe3, 0xb4,
+	0x47, 0x19, 0x13, 0x79, 0xaa, 0x24, 0x1e, 0xed, 0xe3, 0x8c, 0xb2, 0xcf, 0x5c, 0x79, 0xd9, 0x50,
+	0x28, 0x81, 0x9e, 0xc4, 0x21, 0xf3, 0xae, 0x77, 0x79, 0xff, 0xe8, 0xf2, 0x46, 0xfb, 0xf6, 0xbd,
+	0x48, 0x88, 0x68, 0xc0, 0xb1, 0x6e, 0x0b, 0xf3, 0x4f, 0x98, 0xa6, 0xe3, 0x85, 0x86, 0xbd, 0x17,
+	0x89, 0x48, 0x68, 0x88, 0x0b, 0xb4, 0x60, 0x6b, 0xe7, 0x00, 0xde, 0xef, 0x94, 0x5a, 0xad, 0x85,
+	0x54, 0x57, 0xff, 0xfb, 0x88, 0x2a, 0x8a, 0x5a, 0xd0, 0x54, 0xe3, 0x8c, 0x5b, 0xc0, 0x05, 0xf5,
+	0x9d, 0x66, 0xc3, 0xbb, 0xa1, 0x11, 0x2f, 0x18, 0x67, 0x9c, 0xe8, 0x56, 0x84, 0xa0, 0xd9, 0xa7,
+	0x8a, 0x5a, 0x6b, 0x2e, 0xa8, 0x6f, 0x13, 0x8d, 0x0b, 0x2e, 0xe1, 0x89, 0xb0, 0xd6, 0x5d, 0x50,
+	0xaf, 0x10, 0x8d, 0x6b, 0xaf, 0xe0, 0x66, 0x5b, 0xc8, 0x44, 0xc8, 0xe0, 0x0c, 0x3d, 0x83, 0x9b,
+	0x09, 0x97, 0x92, 0x46, 0x5c, 0x5a, 0xc0, 0x5d, 0xaf, 0x6f, 0x35, 0xf7, 0xbc, 0x45, 0x34, 0x6f,
+	0x19, 0xcd, 0x6b, 0xa5, 0x63, 0x52, 0x56, 0xd5, 0xbe, 0x00, 0x58, 0x79, 0x2b, 0x23, 0xc2, 0x65,
+	0x3e, 0x50, 0xc8, 0x82, 0x1b, 0x32, 0x67, 0x8c, 0x4b, 0xa9, 0x9d, 0x6f, 0x92, 0xe5, 0xb1, 0x50,
+	0x1e, 0x72, 0x99, 0x89, 0x54, 0x72, 0xed, 0xe8, 0xbf, 0xca, 0xcb, 0x2a, 0xf4, 0x00, 0x56, 0x98,
+	0xe8, 0x73, 0x99, 0x51, 0xc6, 0xaf, 0x0c, 0xaf, 0x88, 0x22, 0x49, 0x71, 0xb0, 0x4c, 0x17, 0xd4,
+	0x6f, 0x13, 0x8d, 0x6b, 0x21, 0xdc, 0x0e, 0xce, 0x4a, 0x33, 0x12, 0x11, 0xb8, 0x31, 0x5c, 0xc0,
+	0xab, 0x30, 0xcd, 0x1b, 0xcf, 0xb1, 0x54, 0x39, 0x34, 0x2f, 0x7e, 0x3e, 0x34, 0xc8, 0x52, 0xe8,
+	0xe9, 0x57, 0x00, 0xcd, 0x62, 0xc8, 0xe8, 0x31, 0xac, 0x06, 0x1f, 0xba, 0x7e, 0xef, 0xf8, 0xdd,
+	0xfb, 0xae, 0xdf, 0xee, 0xbc, 0xee, 0xf8, 0x47, 0x55, 0xc3, 0xde, 0x9d, 0x4c, 0xdd, 0xad, 0x6b,
+	0x14, 0x7a, 0x04, 0x77, 0x75, 0x99, 0x7f, 0xe2, 0xb7, 0x8f, 0x03, 0xbf, 0x17, 0x9c, 0x54, 0x81,
+	0xbd, 0x33, 0x99, 0xba, 0x70, 0xc5, 0xa0, 0x03, 0x78, 0xf7, 0x8f, 0xa2, 0x5e, 0xb7, 0x45, 0x82,
+	0x4e, 0xeb, 0x4d, 0x75, 0xcd, 0xbe, 0x33, 0x99, 0xba, 0xe8, 0xef, 0x2f, 0xb6, 0x79, 0xfe, 0xdd,
+	0x31, 0x0e, 0x7b, 0x17, 0x33, 0x07, 0x5c, 0xce, 0x1c, 0xf0, 0x6b, 0xe6, 0x80, 0x6f, 0x73, 0xc7,
+	0xb8, 0x9c, 0x3b, 0xc6, 0x8f, 0xb9, 0x63, 0x7c, 0xf4, 0xa3, 0x58, 0x9d, 0xe6, 0xa1, 0xc7, 0x44,
+	0x82, 0x99, 0xbe, 0x60, 0x1c, 0x87, 0xac, 0x11, 0x09, 0x3c, 0x7a, 0x89, 0x13, 0xd1, 0xcf, 0x07,
+	0x5c, 0x16, 0x2b, 0x21, 0x71, 0xf3, 0x45, 0x63, 0x35, 0x86, 0x46, 0xb9, 0x0d, 0xc5, 0x2b, 0x92,
+	0xe1, 0x2d, 0x7d, 0x3d, 0x07, 0xbf, 0x07, 0x00, 0xc3, 0xed, 0x6b, 0x14, 0x42, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -277,6 +412,100 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxMsgResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxMsgResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxMsgResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -323,6 +552,44 @@ func (m *CosmosTx) Size() (n int) {
 	return n
 }
 
+func (m *MsgResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *TxMsgResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -548,6 +815,247 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxMsgResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxMsgResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxMsgResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MsgResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30,6 +30,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			true,
 		},
+		{
+			"success, partial execution",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX_PARTIAL,
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			true,
+		},
 		{
 			"type unspecified",
 			types.InterchainAccountPacketData{
@@ -39,6 +48,15 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"type not supported",
+			types.InterchainAccountPacketData{
+				Type: types.Type(100),
+				Data: []byte("data"),
+				Memo: "memo",
+			},
+			false,
+		},
 		{
 			"empty data",
 			types.InterchainAccountPacketData{
@@ -26,6 +26,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdParams(),
+		GetCmdHeldRecvFees(),
+		GetCmdProtocolFees(),
+		GetCmdRelayerStats(),
+		GetCmdChannelRelayerStats(),
+		GetCmdRelayerLeaderboard(),
 	)
 
 	return queryCmd
@@ -45,6 +51,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewPayPacketFeeAsyncBatchTxCmd(),
 	)
 
 	return txCmd
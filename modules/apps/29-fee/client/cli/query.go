@@ -15,6 +15,8 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
+const flagLimit = "limit"
+
 // GetCmdIncentivizedPacket returns the unrelayed incentivized packet for a given packetID
 func GetCmdIncentivizedPacket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -437,3 +439,230 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdParams returns the command handler for the Query/Params rpc.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc-fee parameters",
+		Long:    "Query the current ibc-fee parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdHeldRecvFees returns the command handler for the Query/HeldRecvFees rpc.
+func GetCmdHeldRecvFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "held-recv-fees [forward-relayer]",
+		Short:   "Query the receive fees held in escrow for a forward relayer",
+		Long:    "Query the receive fees held in escrow for a forward relayer until the dispute window has elapsed",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee held-recv-fees cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHeldRecvFeesRequest{
+				ForwardRelayer: args[0],
+				Pagination:     pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HeldRecvFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "held-recv-fees")
+
+	return cmd
+}
+
+// GetCmdProtocolFees returns the command handler for the Query/ProtocolFees rpc.
+func GetCmdProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "protocol-fees",
+		Short:   "Query the total protocol fees",
+		Long:    "Query the total protocol fees sent to the protocol fee recipient",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee protocol-fees", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProtocolFees(cmd.Context(), &types.QueryProtocolFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRelayerStats returns the command handler for the Query/RelayerStats rpc.
+func GetCmdRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-stats [relayer]",
+		Short:   "Query the fee statistics of a relayer",
+		Long:    "Query the fees paid to a relayer and the number of incentivized packets relayed, acknowledged and timed out by the relayer on each channel",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-stats cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerStatsRequest{
+				Relayer:    args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "relayer-stats")
+
+	return cmd
+}
+
+// GetCmdChannelRelayerStats returns the command handler for the Query/ChannelRelayerStats rpc.
+func GetCmdChannelRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-relayer-stats [port-id] [channel-id]",
+		Short:   "Query the fee statistics of all relayers on a channel",
+		Long:    "Query the fees paid to and the number of incentivized packets relayed, acknowledged and timed out by each relayer on a channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee channel-relayer-stats transfer channel-5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelRelayerStatsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelRelayerStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel-relayer-stats")
+
+	return cmd
+}
+
+// GetCmdRelayerLeaderboard returns the command handler for the Query/RelayerLeaderboard rpc.
+func GetCmdRelayerLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relayer-leaderboard [port-id] [channel-id] [denom]",
+		Short:   "Query the relayers on a channel ranked by the fees paid",
+		Long:    "Query the fee statistics of the relayers on a channel in descending order of the fees paid to each relayer in the given denomination",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee relayer-leaderboard transfer channel-5 stake --limit 10", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRelayerLeaderboardRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+				Limit:     limit,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelayerLeaderboard(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of relayers returned, all relayers on the channel are returned if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -98,44 +98,80 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
 
-			recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+			fee, err := parseFeeFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
-			if err != nil {
-				return err
-			}
+			packetFee := types.NewPacketFee(fee, sender, relayers)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
-			ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
-			if err != nil {
-				return err
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
-			if err != nil {
-				return err
-			}
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-			timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+// NewPayPacketFeeAsyncBatchTxCmd returns the command to create a MsgPayPacketFeeAsyncBatch
+func NewPayPacketFeeAsyncBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee-batch [src-port] [src-channel] [sequences]",
+		Short: "Pay a fee to incentivize each of a range or list of existing IBC packets",
+		Long: strings.TrimSpace(`Pay a fee to incentivize each of a range or list of existing IBC packets on a channel.
+The sequences are given either as an inclusive range (e.g. 1-100) or a comma separated list (e.g. 1,5,7).
+Packets which have not been sent or have already been acknowledged or timed out are skipped.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee-batch transfer channel-0 1-100 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+			// NOTE: specifying non-nil relayers is currently unsupported
+			var relayers []string
+
+			sender := clientCtx.GetFromAddress().String()
+
+			fee, err := parseFeeFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			fee := types.Fee{
-				RecvFee:    recvFee,
-				AckFee:     ackFee,
-				TimeoutFee: timeoutFee,
-			}
-
 			packetFee := types.NewPacketFee(fee, sender, relayers)
-			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+			var msg *types.MsgPayPacketFeeAsyncBatch
+			if start, end, isRange := strings.Cut(args[2], "-"); isRange {
+				startSeq, err := strconv.ParseUint(start, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				endSeq, err := strconv.ParseUint(end, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgPayPacketFeeAsyncBatchForRange(args[0], args[1], startSeq, endSeq, packetFee)
+			} else {
+				var sequences []uint64
+				for _, seqStr := range strings.Split(args[2], ",") {
+					seq, err := strconv.ParseUint(strings.TrimSpace(seqStr), 10, 64)
+					if err != nil {
+						return err
+					}
+
+					sequences = append(sequences, seq)
+				}
+
+				msg = types.NewMsgPayPacketFeeAsyncBatch(args[0], args[1], sequences, packetFee)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -148,3 +184,42 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// parseFeeFlags parses the receive, acknowledgement and timeout fee flags into a Fee
+func parseFeeFlags(cmd *cobra.Command) (types.Fee, error) {
+	recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	return types.Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}, nil
+}
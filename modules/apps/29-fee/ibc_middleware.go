@@ -17,9 +17,11 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware                  = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule            = (*IBCMiddleware)(nil)
+	_ porttypes.PacketSenderRetriever       = (*IBCMiddleware)(nil)
+	_ porttypes.RelativeTimeoutPacketSender = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
@@ -179,7 +181,7 @@ func (im IBCMiddleware) OnChanCloseInit(
 		return err
 	}
 
-	if !im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+	if !im.keeper.IsFeeEscrowEnabled(ctx, portID, channelID) {
 		return nil
 	}
 
@@ -200,7 +202,7 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 		return err
 	}
 
-	if !im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+	if !im.keeper.IsFeeEscrowEnabled(ctx, portID, channelID) {
 		return nil
 	}
 
@@ -237,20 +239,30 @@ func (im IBCMiddleware) OnRecvPacket(
 }
 
 // OnAcknowledgementPacket implements the IBCMiddleware interface
-// If fees are not enabled, this callback will default to the ibc-core packet callback
+// If fees are not enabled, this callback will default to the ibc-core packet callback.
+// If only send side fees are enabled, the acknowledgement is not wrapped by the counterparty and
+// the receive fee is paid together with the acknowledgement fee to the payee of the relayer.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	if !im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) {
+	feeEnabled := im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel)
+	if !feeEnabled && !im.keeper.IsSendSideFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) {
 		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	}
 
-	var ack types.IncentivizedAcknowledgement
-	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
-		return errorsmod.Wrapf(err, "cannot unmarshal ICS-29 incentivized packet acknowledgement: %v", ack)
+	appAcknowledgement := acknowledgement
+	var forwardRelayer string
+	if feeEnabled {
+		var ack types.IncentivizedAcknowledgement
+		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+			return errorsmod.Wrapf(err, "cannot unmarshal ICS-29 incentivized packet acknowledgement: %v", ack)
+		}
+
+		appAcknowledgement = ack.AppAcknowledgement
+		forwardRelayer = ack.ForwardRelayerAddress
 	}
 
 	if im.keeper.IsLocked(ctx) {
@@ -262,14 +274,14 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		// for fee enabled channels
 		//
 		// Please see ADR 004 for more information.
-		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+		return im.app.OnAcknowledgementPacket(ctx, packet, appAcknowledgement, relayer)
 	}
 
 	packetID := channeltypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence)
 	feesInEscrow, found := im.keeper.GetFeesInEscrow(ctx, packetID)
 	if !found {
 		// call underlying callback
-		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+		return im.app.OnAcknowledgementPacket(ctx, packet, appAcknowledgement, relayer)
 	}
 
 	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
@@ -282,10 +294,16 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
 	}
 
-	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, payeeAddr, feesInEscrow.PacketFees, packetID)
+	if feeEnabled {
+		im.keeper.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, payeeAddr, feesInEscrow.PacketFees, packetID)
+	} else {
+		// the forward relayer is unknown on send side fee enabled channels, fees are paid on the
+		// sending chain only, therefore the relayer submitting the acknowledgement receives the receive fee
+		im.keeper.DistributeSendSideFeesOnAcknowledgement(ctx, payeeAddr, feesInEscrow.PacketFees, packetID)
+	}
 
 	// call underlying callback
-	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+	return im.app.OnAcknowledgementPacket(ctx, packet, appAcknowledgement, relayer)
 }
 
 // OnTimeoutPacket implements the IBCMiddleware interface
@@ -300,7 +318,7 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	// the fee keeper will be unlocked after manual intervention
 	//
 	// Please see ADR 004 for more information.
-	if !im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) || im.keeper.IsLocked(ctx) {
+	if !im.keeper.IsFeeEscrowEnabled(ctx, packet.SourcePort, packet.SourceChannel) || im.keeper.IsLocked(ctx) {
 		return im.app.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
@@ -311,6 +329,12 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return im.app.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
+	// packets force timed out by the authority are not relayed, all fees are refunded
+	if relayer.Empty() {
+		im.keeper.RefundPacketFees(ctx, feesInEscrow.PacketFees, packetID)
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
 	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
 	if !found {
 		payee = relayer.String()
@@ -463,6 +487,18 @@ func (im IBCMiddleware) SendPacket(
 	return im.keeper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 }
 
+// SendPacketWithRelativeTimeout implements the optional RelativeTimeoutPacketSender interface
+func (im IBCMiddleware) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout channeltypes.RelativeTimeout,
+	data []byte,
+) (uint64, channeltypes.Timeout, error) {
+	return im.keeper.SendPacketWithRelativeTimeout(ctx, chanCap, sourcePort, sourceChannel, relativeTimeout, data)
+}
+
 // WriteAcknowledgement implements the ICS4 Wrapper interface
 func (im IBCMiddleware) WriteAcknowledgement(
 	ctx sdk.Context,
@@ -489,3 +525,21 @@ func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
 
 	return unmarshaler.UnmarshalPacketData(bz)
 }
+
+// GetPacketSender attempts to use the underlying app to retrieve the packet sender.
+// If the underlying app does not support the PacketSenderRetriever interface, the packet
+// sender is derived from the packet data. This function implements the optional
+// PacketSenderRetriever interface.
+func (im IBCMiddleware) GetPacketSender(ctx sdk.Context, packetData interface{}, sourcePortID, sourceChannelID string) string {
+	retriever, ok := im.app.(porttypes.PacketSenderRetriever)
+	if !ok {
+		data, ok := packetData.(exported.PacketData)
+		if !ok {
+			return ""
+		}
+
+		return data.GetPacketSender(sourcePortID)
+	}
+
+	return retriever.GetPacketSender(ctx, packetData, sourcePortID, sourceChannelID)
+}
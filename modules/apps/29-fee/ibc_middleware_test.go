@@ -887,6 +887,24 @@ func (suite *FeeTestSuite) TestOnTimeoutPacket() {
 				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
 			},
 		},
+		{
+			"success: no relayer, all fees refunded",
+			func() {
+				relayerAddr = nil
+
+				refundAccBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom))
+				expRefundAccBalance = refundAccBalance.Add(packetFee.Fee.Total()...)
+			},
+			true,
+			func() {
+				// assert that the packet fees have been refunded
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().False(found)
+
+				refundAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
+			},
+		},
 		{
 			"success: refund (recv_fee + ack_fee) - timeout_fee",
 			func() {
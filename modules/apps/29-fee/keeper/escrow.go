@@ -3,17 +3,23 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
-// escrowPacketFee sends the packet fee to the 29-fee module account to hold in escrow
-func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee) error {
+// escrowPacketFee sends the packet fee for each of the given packets to the 29-fee module account to hold in escrow.
+// The total of the packet fees is escrowed using a single transfer from the refund address.
+func (k Keeper) escrowPacketFee(ctx sdk.Context, packetFee types.PacketFee, packetIDs ...channeltypes.PacketId) error {
 	// check if the refund address is valid
 	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
 	if err != nil {
@@ -25,35 +31,76 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", packetFee.RefundAddress)
 	}
 
-	coins := packetFee.Fee.Total()
+	if err := k.GetParams(ctx).ValidateFeeDenom(packetFee.Fee.Total()); err != nil {
+		return err
+	}
+
+	coins := packetFee.Fee.Total().MulInt(sdkmath.NewInt(int64(len(packetIDs))))
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
 		return err
 	}
 
-	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
-	// retrieve any previous fees stored in escrow for the packet and append them to the list
-	fees := []types.PacketFee{packetFee}
-	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
-		fees = append(fees, feesInEscrow.PacketFees...)
+	for _, packetID := range packetIDs {
+		// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
+		// retrieve any previous fees stored in escrow for the packet and append them to the list
+		fees := []types.PacketFee{packetFee}
+		if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+			fees = append(fees, feesInEscrow.PacketFees...)
+		}
+
+		packetFees := types.NewPacketFees(fees)
+		k.SetFeesInEscrow(ctx, packetID, packetFees)
+
+		emitIncentivizedPacketEvent(ctx, packetID, packetFees)
 	}
 
-	packetFees := types.NewPacketFees(fees)
-	k.SetFeesInEscrow(ctx, packetID, packetFees)
+	return nil
+}
 
-	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
+// validatePacketIncentivizable returns an error if the packet with the given packet identifier may not be incentivized.
+// Only packets which have been sent and have not gone through the packet life cycle may be incentivized.
+func (k Keeper) validatePacketIncentivizable(ctx sdk.Context, packetID channeltypes.PacketId, nextSeqSend uint64) error {
+	// only allow incentivizing of packets which have been sent
+	if packetID.Sequence >= nextSeqSend {
+		return channeltypes.ErrPacketNotSent
+	}
+
+	// only allow incentivizng of packets which have not completed the packet life cycle
+	if bz := k.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence); len(bz) == 0 {
+		return errorsmod.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out")
+	}
 
 	return nil
 }
 
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
+// If the receive fee dispute window is enabled, the receive fees of a valid forward relayer are held in escrow until the dispute window has elapsed.
 func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+	// forward relayer address will be empty if conversion fails
+	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
+
+	holdRecvFees := k.GetParams(ctx).IsDisputeWindowEnabled() && !forwardAddr.Empty() && !k.bankKeeper.BlockedAddr(forwardAddr)
+	k.distributePacketFeesOnAcknowledgement(ctx, forwardAddr, reverseRelayer, packetFees, packetID, holdRecvFees)
+}
+
+// DistributeSendSideFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID to the relayer which
+// submitted the acknowledgement on a send side fee enabled channel while refunding the timeout fees to the refund account.
+// The receive fees are never held in escrow as the relayer is known to this chain.
+func (k Keeper) DistributeSendSideFeesOnAcknowledgement(ctx sdk.Context, relayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+	k.distributePacketFeesOnAcknowledgement(ctx, relayer, relayer, packetFees, packetID, false)
+}
+
+// distributePacketFeesOnAcknowledgement pays all the acknowledgement fees for a given packetID while refunding the timeout fees to the refund account.
+// The receive fees are paid to the forward relayer, or held in escrow for the forward relayer until the dispute window has elapsed if holdRecvFees is true.
+func (k Keeper) distributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardAddr, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId, holdRecvFees bool) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
-	// forward relayer address will be empty if conversion fails
-	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
-
+	var (
+		heldPacketFees            []types.PacketFee
+		recvFeesPaid, ackFeesPaid sdk.Coins
+	)
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -72,9 +119,37 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		recvFeePaid, ackFeePaid := k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee, holdRecvFees)
+		recvFeesPaid = recvFeesPaid.Add(recvFeePaid...)
+		ackFeesPaid = ackFeesPaid.Add(ackFeePaid...)
+
+		if holdRecvFees && !packetFee.Fee.RecvFee.IsZero() {
+			heldPacketFees = append(heldPacketFees, types.NewPacketFee(types.NewFee(packetFee.Fee.RecvFee, nil, nil), packetFee.RefundAddress, nil))
+		}
 	}
 
+	if len(heldPacketFees) > 0 {
+		releaseTime := uint64(cacheCtx.BlockTime().UnixNano()) + k.GetParams(cacheCtx).RecvFeeDisputeWindow
+		if releaseTime < uint64(cacheCtx.BlockTime().UnixNano()) {
+			releaseTime = math.MaxUint64
+		}
+
+		heldRecvFees := types.NewHeldRecvFees(packetID, forwardAddr.String(), heldPacketFees, releaseTime)
+		k.SetHeldRecvFees(cacheCtx, heldRecvFees)
+
+		emitHeldRecvFeesEvent(cacheCtx, types.EventTypeHoldRecvFee, heldRecvFees)
+	}
+
+	k.updateRelayerStats(cacheCtx, forwardAddr, packetID.PortId, packetID.ChannelId, func(relayerStats *types.RelayerStats) {
+		relayerStats.PacketsRelayed++
+		relayerStats.RecvFeesPaid = relayerStats.RecvFeesPaid.Add(recvFeesPaid...)
+	})
+
+	k.updateRelayerStats(cacheCtx, reverseRelayer, packetID.PortId, packetID.ChannelId, func(relayerStats *types.RelayerStats) {
+		relayerStats.PacketsAcknowledged++
+		relayerStats.AckFeesPaid = relayerStats.AckFeesPaid.Add(ackFeesPaid...)
+	})
+
 	// write the cache
 	writeFn()
 
@@ -84,22 +159,30 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
-	// distribute fee to valid forward relayer address otherwise refund the fee
-	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
+// If holdRecvFee is true, the receive fee remains in escrow for the forward relayer.
+// The receive and acknowledgement fees paid to the forward and reverse relayer are returned.
+func (k Keeper) distributePacketFeeOnAcknowledgement(
+	ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee, holdRecvFee bool,
+) (recvFeePaid, ackFeePaid sdk.Coins) {
+	switch {
+	case holdRecvFee:
+		// the receive fee is paid out to the forward relayer once the dispute window has elapsed
+	case !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer):
 		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
-	} else {
+		recvFeePaid = k.distributeRelayerFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+	default:
 		// refund onRecv fee as forward relayer is not valid address
 		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	ackFeePaid = k.distributeRelayerFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
 	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+
+	return recvFeePaid, ackFeePaid
 }
 
 // DistributePacketsFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
@@ -108,6 +191,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var timeoutFeesPaid sdk.Coins
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -126,9 +210,15 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		timeoutFeePaid := k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		timeoutFeesPaid = timeoutFeesPaid.Add(timeoutFeePaid...)
 	}
 
+	k.updateRelayerStats(cacheCtx, timeoutRelayer, packetID.PortId, packetID.ChannelId, func(relayerStats *types.RelayerStats) {
+		relayerStats.PacketsTimedOut++
+		relayerStats.TimeoutFeesPaid = relayerStats.TimeoutFeesPaid.Add(timeoutFeesPaid...)
+	})
+
 	// write the cache
 	writeFn()
 
@@ -137,19 +227,89 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+// The timeout fee paid to the timeout relayer is returned.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) sdk.Coins {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	timeoutFeePaid := k.distributeRelayerFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
 	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+
+	return timeoutFeePaid
+}
+
+// distributeRelayerFee sends the protocol fee of the given relayer fee to the protocol fee recipient
+// and distributes the remainder of the fee to the relayer. The fee paid to the relayer is returned,
+// no fee is returned if the remainder of the fee was refunded.
+func (k Keeper) distributeRelayerFee(ctx sdk.Context, relayer, refundAccAddress sdk.AccAddress, fee sdk.Coins) sdk.Coins {
+	protocolFee := k.distributeProtocolFee(ctx, fee)
+
+	relayerFee := fee.Sub(protocolFee...)
+	if !k.distributeFee(ctx, relayer, refundAccAddress, relayerFee) {
+		return sdk.NewCoins()
+	}
+
+	return relayerFee
+}
+
+// distributeProtocolFee sends the protocol fee of the given relayer fee to the protocol fee recipient module account
+// and returns the protocol fee sent. If the protocol fee recipient is the distribution module account, the protocol
+// fee is used to fund the community pool. If the protocol fee cannot be sent, the state changes are discarded and no
+// protocol fee is returned, the relayer is then paid the full fee.
+func (k Keeper) distributeProtocolFee(ctx sdk.Context, fee sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+
+	protocolFee := params.ProtocolFee(fee)
+	if protocolFee.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	recipient := k.authKeeper.GetModuleAddress(params.ProtocolFeeRecipient)
+	if recipient == nil {
+		k.Logger(ctx).Error("protocol fee recipient module account does not exist", "recipient", params.ProtocolFeeRecipient)
+		return sdk.NewCoins()
+	}
+
+	// cache context before trying to send the protocol fee
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	if err := k.sendProtocolFee(cacheCtx, params.ProtocolFeeRecipient, protocolFee); err != nil {
+		k.Logger(ctx).Error("error distributing protocol fee", "recipient", params.ProtocolFeeRecipient, "fee", protocolFee, "error", err)
+		return sdk.NewCoins()
+	}
+
+	for _, coin := range protocolFee {
+		k.SetProtocolFees(cacheCtx, k.GetProtocolFees(cacheCtx, coin.Denom).Add(coin))
+	}
+
+	emitDistributeProtocolFeeEvent(cacheCtx, recipient.String(), protocolFee)
+
+	// write the cache
+	writeFn()
+
+	return protocolFee
+}
+
+// sendProtocolFee sends the protocol fee from the fee module account to the recipient module account. Protocol fees
+// sent to the distribution module account fund the community pool, a direct transfer to the distribution module
+// account would not be accounted for in the community pool.
+func (k Keeper) sendProtocolFee(ctx sdk.Context, recipient string, protocolFee sdk.Coins) error {
+	if recipient != distrtypes.ModuleName {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient, protocolFee)
+	}
+
+	if k.distrKeeper == nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "distribution keeper is not set, protocol fees cannot fund the community pool")
+	}
+
+	return k.distrKeeper.FundCommunityPool(ctx, protocolFee, k.GetFeeModuleAddress())
 }
 
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded. It returns true if the fee was paid to the receiver.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) bool {
 	// cache context before trying to distribute fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
@@ -157,7 +317,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 	if err != nil {
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return false // if sending to the refund address already failed, then return (no-op)
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -165,7 +325,7 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return false // if sending to the refund address fails, no-op
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
@@ -175,6 +335,39 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 
 	// write the cache
 	writeFn()
+
+	// the fee was refunded if sending to the receiver failed
+	return err == nil
+}
+
+// RefundPacketFees refunds all fees escrowed for a packet to their refund addresses, it is used when the packet
+// is completed without a relayer. If the escrow account has insufficient balance the fee module is locked.
+func (k Keeper) RefundPacketFees(ctx sdk.Context, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+	// cache context before trying to refund fees
+	// if the escrow account has insufficient balance then we want to avoid partially refunding fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, packetFee := range packetFees {
+		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx)
+			return
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		k.distributeFee(cacheCtx, refundAddr, refundAddr, packetFee.Fee.Total())
+	}
+
+	// write the cache
+	writeFn()
+
+	// removing the fee from the store as the fee is now refunded
+	k.DeleteFeesInEscrow(ctx, packetID)
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
@@ -228,3 +421,116 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 
 	return nil
 }
+
+// ReleaseHeldRecvFees pays out the receive fees held in escrow whose dispute window has elapsed to their forward relayers.
+// The number of held receive fees released is bounded by the max released per block parameter. Held receive fees are
+// not released while the fee module is locked. The number of held receive fees released is returned.
+func (k Keeper) ReleaseHeldRecvFees(ctx sdk.Context) uint64 {
+	if k.IsLocked(ctx) {
+		return 0
+	}
+
+	heldKeys := k.dueHeldRecvFeesKeys(ctx, k.GetParams(ctx).MaxReleasedPerBlock)
+	store := ctx.KVStore(k.storeKey)
+
+	var released uint64
+	for _, heldKey := range heldKeys {
+		var heldRecvFees types.HeldRecvFees
+		k.cdc.MustUnmarshal(store.Get(heldKey), &heldRecvFees)
+
+		forwardAddr, err := sdk.AccAddressFromBech32(heldRecvFees.ForwardRelayer)
+		if err != nil {
+			panic(fmt.Errorf("could not parse forward relayer %s to sdk.AccAddress", heldRecvFees.ForwardRelayer))
+		}
+
+		if !k.distributeHeldRecvFees(ctx, forwardAddr, heldRecvFees) {
+			break
+		}
+
+		emitHeldRecvFeesEvent(ctx, types.EventTypeReleaseRecvFee, heldRecvFees)
+		released++
+	}
+
+	if released > 0 {
+		k.Logger(ctx).Info("released held receive fees", "released", released)
+	}
+
+	return released
+}
+
+// dueHeldRecvFeesKeys returns at most limit store keys of the held receive fees due to be released at or before the current block time.
+// The keys are collected before any of them is deleted, as the store must not be written to while it is iterated over.
+func (k Keeper) dueHeldRecvFeesKeys(ctx sdk.Context, limit uint64) [][]byte {
+	// the end key is exclusive, all held receive fees due at or before the current block time are iterated over
+	end := storetypes.PrefixEndBytes(types.HeldRecvFeesQueuePrefixForTime(uint64(ctx.BlockTime().UnixNano())))
+
+	iterator := ctx.KVStore(k.storeKey).Iterator([]byte(types.HeldRecvFeesQueuePrefix+"/"), end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+
+	return keys
+}
+
+// RefundHeldRecvFees refunds the receive fees held in escrow for the forward relayer on the given channel to their refund
+// addresses. The number of held receive fees refunded is returned.
+func (k Keeper) RefundHeldRecvFees(ctx sdk.Context, forwardRelayer, portID, channelID string) uint64 {
+	var refunded uint64
+	for _, heldRecvFees := range k.GetHeldRecvFeesForChannel(ctx, forwardRelayer, portID, channelID) {
+		if !k.distributeHeldRecvFees(ctx, nil, heldRecvFees) {
+			break
+		}
+
+		emitHeldRecvFeesEvent(ctx, types.EventTypeDisputeRecvFee, heldRecvFees)
+		refunded++
+	}
+
+	return refunded
+}
+
+// distributeHeldRecvFees pays the given held receive fees to the receiver, or refunds them to their refund addresses if the receiver
+// is empty, and deletes them from the store. If the escrow account has insufficient balance the fee module is locked and false is returned.
+func (k Keeper) distributeHeldRecvFees(ctx sdk.Context, receiver sdk.AccAddress, heldRecvFees types.HeldRecvFees) bool {
+	// cache context before trying to distribute fees
+	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	if !k.EscrowAccountHasBalance(cacheCtx, heldRecvFees.Total()) {
+		// if the escrow account does not have sufficient funds then there must exist a severe bug
+		// the fee module should be locked until manual intervention fixes the issue
+		// NOTE: we use the uncached context to lock the fee module so that the state changes from
+		// locking the fee module are persisted
+		k.lockFeeModule(ctx)
+		return false
+	}
+
+	var recvFeesPaid sdk.Coins
+	for _, packetFee := range heldRecvFees.PacketFees {
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		if receiver.Empty() {
+			k.distributeFee(cacheCtx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+		} else {
+			recvFeePaid := k.distributeRelayerFee(cacheCtx, receiver, refundAddr, packetFee.Fee.RecvFee)
+			recvFeesPaid = recvFeesPaid.Add(recvFeePaid...)
+		}
+	}
+
+	// the packet was counted as relayed by the forward relayer when the receive fees were held
+	k.updateRelayerStats(cacheCtx, receiver, heldRecvFees.PacketId.PortId, heldRecvFees.PacketId.ChannelId, func(relayerStats *types.RelayerStats) {
+		relayerStats.RecvFeesPaid = relayerStats.RecvFeesPaid.Add(recvFeesPaid...)
+	})
+
+	k.DeleteHeldRecvFees(cacheCtx, heldRecvFees)
+
+	// write the cache
+	writeFn()
+
+	return true
+}
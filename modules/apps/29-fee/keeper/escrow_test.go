@@ -1,15 +1,19 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
@@ -24,6 +28,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 		packetFee         types.PacketFee
 		packetFees        []types.PacketFee
 		fee               types.Fee
+		communityPool     sdk.DecCoins
 	)
 
 	testCases := []struct {
@@ -126,6 +131,217 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				suite.Require().Equal(expectedRefundAccBal, balance)
 			},
 		},
+		{
+			"success: relayer stats updated",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check the forward relayer stats account for the recv fees paid and the packet relayed
+				forwardStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultRecvFee.Add(defaultRecvFee...), forwardStats.RecvFeesPaid)
+				suite.Require().Equal(uint64(1), forwardStats.PacketsRelayed)
+				suite.Require().Zero(forwardStats.PacketsAcknowledged)
+
+				// check the reverse relayer stats account for the ack fees paid and the packet acknowledged
+				reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultAckFee.Add(defaultAckFee...), reverseStats.AckFeesPaid)
+				suite.Require().Equal(uint64(1), reverseStats.PacketsAcknowledged)
+				suite.Require().Zero(reverseStats.PacketsRelayed)
+			},
+		},
+		{
+			"max relayer stats per channel reached: only existing relayer stats updated",
+			func() {
+				params := types.DefaultParams()
+				params.MaxRelayerStatsPerChannel = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				relayerStats := types.NewRelayerStats(reverseRelayer.String(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				relayerStats.AckFeesPaid = defaultAckFee
+				relayerStats.PacketsAcknowledged = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), forwardRelayer, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.Require().False(found)
+
+				reverseStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultAckFee.Add(defaultAckFee...).Add(defaultAckFee...), reverseStats.AckFeesPaid)
+				suite.Require().Equal(uint64(2), reverseStats.PacketsAcknowledged)
+				suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStatsCount(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+			},
+		},
+		{
+			"no fees paid to the relayers: relayer stats not stored",
+			func() {
+				packetFee = types.NewPacketFee(types.NewFee(nil, nil, defaultTimeoutFee), refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllRelayerStats(suite.chainA.GetContext()))
+				suite.Require().Zero(suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStatsCount(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+			},
+		},
+		{
+			"relayer stats disabled: relayer stats not stored",
+			func() {
+				params := types.DefaultParams()
+				params.RelayerStatsEnabled = false
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllRelayerStats(suite.chainA.GetContext()))
+			},
+		},
+		{
+			"success: protocol fee sent to the protocol fee recipient",
+			func() {
+				params := types.DefaultParams()
+				params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 1)
+				params.ProtocolFeeRecipient = mock.ModuleName
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the reverse relayer is paid the ack fee minus the protocol fee
+				expectedReverseAccBal := reverseRelayerBal.AddAmount(sdkmath.NewInt(180)).AddAmount(sdkmath.NewInt(180))
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the forward relayer is paid the recv fee minus the protocol fee
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.AddAmount(sdkmath.NewInt(90)).AddAmount(sdkmath.NewInt(90))
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check the protocol fee recipient is paid and the protocol fees are accumulated
+				expProtocolFees := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))
+				recipient := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(mock.ModuleName)
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom)
+				suite.Require().Equal(expProtocolFees, balance)
+				suite.Require().Equal(sdk.NewCoins(expProtocolFees), suite.chainA.GetSimApp().IBCFeeKeeper.GetAllProtocolFees(suite.chainA.GetContext()))
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
+		{
+			"success: protocol fee funds the community pool",
+			func() {
+				params := types.DefaultParams()
+				params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 1)
+				params.ProtocolFeeRecipient = distrtypes.ModuleName
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				feePool, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				communityPool = feePool.CommunityPool
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check the protocol fees are accounted for in the community pool
+				expProtocolFees := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))
+				feePool, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(expProtocolFees)...), feePool.CommunityPool)
+				suite.Require().Equal(sdk.NewCoins(expProtocolFees), suite.chainA.GetSimApp().IBCFeeKeeper.GetAllProtocolFees(suite.chainA.GetContext()))
+
+				// check the module acc wallet is now empty
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
+		{
+			"protocol fee recipient does not exist: relayers are paid the full fee",
+			func() {
+				params := types.DefaultParams()
+				params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 1)
+				params.ProtocolFeeRecipient = "nonexistent"
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllProtocolFees(suite.chainA.GetContext()).IsZero())
+			},
+		},
+		{
+			"success: recv fees held in escrow when the dispute window is enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(time.Hour.Nanoseconds()), types.DefaultMaxReleasedPerBlock, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""))
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check the forward relayer is not paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(forwardRelayerBal, balance)
+
+				// check the recv fees are held in escrow
+				heldRecvFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHeldRecvFees(suite.chainA.GetContext(), forwardRelayer, packetID)
+				suite.Require().True(found)
+				suite.Require().Len(heldRecvFees.PacketFees, 2)
+				suite.Require().Equal(defaultRecvFee.Add(defaultRecvFee...), heldRecvFees.Total())
+				suite.Require().Equal(uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano()), heldRecvFees.ReleaseTime)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0].Add(defaultRecvFee[0]), balance)
+			},
+		},
+		{
+			"dispute window enabled, invalid forward address: recv fees refunded",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(time.Hour.Nanoseconds()), types.DefaultMaxReleasedPerBlock, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""))
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				forwardRelayer = "invalid address"
+			},
+			func() {
+				// check if the refund acc has been refunded the recvFee
+				expectedRefundAccBal := refundAccBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext()))
+			},
+		},
 		{
 			"escrow account out of balance, fee module becomes locked - no distribution", func() {
 				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
@@ -282,6 +498,39 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
 			},
 		},
+		{
+			"success: relayer stats updated",
+			func() {},
+			func() {
+				// check the timeout relayer stats account for the timeout fees paid and the packet timed out
+				timeoutStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultTimeoutFee.Add(defaultTimeoutFee...), timeoutStats.TimeoutFeesPaid)
+				suite.Require().Equal(uint64(1), timeoutStats.PacketsTimedOut)
+			},
+		},
+		{
+			"success: protocol fee sent to the protocol fee recipient",
+			func() {
+				params := types.DefaultParams()
+				params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 1)
+				params.ProtocolFeeRecipient = mock.ModuleName
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			func() {
+				// check if the timeout relayer is paid the timeout fee minus the protocol fee
+				expectedTimeoutAccBal := timeoutRelayerBal.AddAmount(sdkmath.NewInt(270)).AddAmount(sdkmath.NewInt(270))
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+
+				// check the protocol fee recipient is paid
+				expProtocolFees := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))
+				recipient := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(mock.ModuleName)
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, sdk.DefaultBondDenom)
+				suite.Require().Equal(expProtocolFees, balance)
+				suite.Require().Equal(sdk.NewCoins(expProtocolFees), suite.chainA.GetSimApp().IBCFeeKeeper.GetAllProtocolFees(suite.chainA.GetContext()))
+			},
+		},
 		{
 			"success: refund (recv_fee + ack_fee) - timeout_fee",
 			func() {
@@ -447,7 +696,6 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 
 			// setup
 			refundAcc = suite.chainA.SenderAccount.GetAddress()
-			moduleAcc := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()
 
 			// expected refund balance if the refunds are successful
 			// NOTE: tc.malleate() should transfer from refund balance to correctly set the escrow balance
@@ -475,3 +723,141 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestReleaseHeldRecvFees() {
+	var (
+		forwardRelayer sdk.AccAddress
+		heldRecvFees   types.HeldRecvFees
+		expReleased    uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: multiple held recv fees, bounded by max released per block",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(time.Hour.Nanoseconds()), 1, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""))
+
+				heldRecvFees.PacketId.Sequence = 2
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, heldRecvFees.Total())
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"dispute window has not elapsed",
+			func() {
+				// reschedule the held recv fees to be released after the current block time
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+				heldRecvFees.ReleaseTime = uint64(suite.chainA.GetContext().BlockTime().Add(time.Second).UnixNano())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+				expReleased = 0
+			},
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+				expReleased = 0
+			},
+			false,
+		},
+		{
+			"escrow account out of balance, fee module becomes locked",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), heldRecvFees.Total())
+				suite.Require().NoError(err)
+				expReleased = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			expReleased = 1
+
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), suite.chainA.SenderAccount.GetAddress().String(), nil)
+			heldRecvFees = types.NewHeldRecvFees(packetID, forwardRelayer.String(), []types.PacketFee{packetFee}, 1)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, heldRecvFees.Total())
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			released := suite.chainA.GetSimApp().IBCFeeKeeper.ReleaseHeldRecvFees(suite.chainA.GetContext())
+			suite.Require().Equal(expReleased, released)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardRelayer, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().Equal(defaultRecvFee[0].Amount.MulRaw(int64(released)), balance.Amount)
+
+				held := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext())
+				if heldRecvFees.PacketId.Sequence == 2 {
+					suite.Require().Len(held, 1)
+				} else {
+					suite.Require().Empty(held)
+				}
+			} else {
+				suite.Require().True(balance.IsZero())
+				suite.Require().Len(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext()), 1)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundHeldRecvFees() {
+	forwardRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), refundAcc.String(), nil)
+	for i := uint64(1); i <= 3; i++ {
+		// the last held recv fees are on a different channel and should not be refunded
+		channelID := ibctesting.FirstChannelID
+		if i == 3 {
+			channelID = ibctesting.FirstChannelID + "0"
+		}
+
+		heldRecvFees := types.NewHeldRecvFees(channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, i), forwardRelayer, []types.PacketFee{packetFee}, uint64(time.Hour.Nanoseconds()))
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, heldRecvFees.Total())
+		suite.Require().NoError(err)
+	}
+
+	refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+	refunded := suite.chainA.GetSimApp().IBCFeeKeeper.RefundHeldRecvFees(suite.chainA.GetContext(), forwardRelayer, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().Equal(uint64(2), refunded)
+
+	expectedRefundAccBal := refundAccBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+	suite.Require().Equal(expectedRefundAccBal, balance)
+
+	held := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext())
+	suite.Require().Len(held, 1)
+	suite.Require().Equal(ibctesting.FirstChannelID+"0", held[0].PacketId.ChannelId)
+
+	// the queue entries of the refunded held recv fees are deleted, the remaining held recv fees are released
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	released := suite.chainA.GetSimApp().IBCFeeKeeper.ReleaseHeldRecvFees(suite.chainA.GetContext())
+	suite.Require().Equal(uint64(1), released)
+	suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext()))
+}
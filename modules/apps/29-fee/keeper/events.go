@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -90,3 +91,54 @@ func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 		),
 	})
 }
+
+// emitUpdateSendSideFeeEnabledEvent emits an event containing the updated send side fee enabled flag of a channel
+func emitUpdateSendSideFeeEnabledEvent(ctx sdk.Context, portID, channelID string, enabled bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateSendSideFeeEnabled,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitHeldRecvFeesEvent emits an event of the given type containing information on the receive fees of a packet
+// held in escrow for a forward relayer. It is emitted when the receive fees are held, released or disputed.
+func emitHeldRecvFeesEvent(ctx sdk.Context, eventType string, heldRecvFees types.HeldRecvFees) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, heldRecvFees.PacketId.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, heldRecvFees.PacketId.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(heldRecvFees.PacketId.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyForwardRelayer, heldRecvFees.ForwardRelayer),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, heldRecvFees.Total().String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseTime, fmt.Sprint(heldRecvFees.ReleaseTime)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitDistributeProtocolFeeEvent emits an event containing the protocol fee of a relayer fee and the protocol fee recipient address
+func emitDistributeProtocolFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDistributeProtocolFee,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
@@ -27,6 +27,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, enabledChan := range state.SendSideFeeEnabledChannels {
+		k.SetSendSideFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
+	}
+
+	k.SetParams(ctx, state.Params)
+
+	for _, heldRecvFees := range state.HeldRecvFees {
+		k.SetHeldRecvFees(ctx, heldRecvFees)
+	}
+
+	for _, protocolFee := range state.TotalProtocolFees {
+		k.SetProtocolFees(ctx, protocolFee)
+	}
+
+	for _, relayerStats := range state.RelayerStats {
+		k.SetRelayerStats(ctx, relayerStats)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +55,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		SendSideFeeEnabledChannels:   k.GetAllSendSideFeeEnabledChannels(ctx),
+		Params:                       k.GetParams(ctx),
+		HeldRecvFees:                 k.GetAllHeldRecvFees(ctx),
+		TotalProtocolFees:            k.GetAllProtocolFees(ctx),
+		RelayerStats:                 k.GetAllRelayerStats(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -28,6 +30,12 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId: ibctesting.FirstChannelID,
 			},
 		},
+		SendSideFeeEnabledChannels: []types.FeeEnabledChannel{
+			{
+				PortId:    ibctesting.MockPort,
+				ChannelId: ibctesting.FirstChannelID,
+			},
+		},
 		RegisteredPayees: []types.RegisteredPayee{
 			{
 				Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
@@ -42,6 +50,23 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		Params: types.NewParams(uint64(time.Hour.Nanoseconds()), 10, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""),
+		HeldRecvFees: []types.HeldRecvFees{
+			types.NewHeldRecvFees(
+				channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2), suite.chainB.SenderAccount.GetAddress().String(),
+				[]types.PacketFee{types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), suite.chainA.SenderAccount.GetAddress().String(), nil)}, 1,
+			),
+		},
+		TotalProtocolFees: defaultRecvFee,
+		RelayerStats: []types.RelayerStats{
+			{
+				Relayer:        suite.chainB.SenderAccount.GetAddress().String(),
+				PortId:         ibctesting.MockFeePort,
+				ChannelId:      ibctesting.FirstChannelID,
+				RecvFeesPaid:   defaultRecvFee,
+				PacketsRelayed: 1,
+			},
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -55,6 +80,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	isEnabled := suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
 	suite.Require().True(isEnabled)
 
+	// check send side fee is enabled
+	isEnabled = suite.chainA.GetSimApp().IBCFeeKeeper.IsSendSideFeeEnabled(suite.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID)
+	suite.Require().True(isEnabled)
+
 	// check payee addresses
 	payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
@@ -64,12 +93,31 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check params
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+
+	// check held recv fees
+	heldRecvFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetHeldRecvFees(suite.chainA.GetContext(), genesisState.HeldRecvFees[0].ForwardRelayer, genesisState.HeldRecvFees[0].PacketId)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.HeldRecvFees[0], heldRecvFees)
+
+	// check protocol fees
+	suite.Require().Equal(genesisState.TotalProtocolFees, suite.chainA.GetSimApp().IBCFeeKeeper.GetAllProtocolFees(suite.chainA.GetContext()))
+
+	// check relayer stats
+	relayerStats, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerStats(suite.chainA.GetContext(), genesisState.RelayerStats[0].Relayer, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RelayerStats[0], relayerStats)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set fee enabled
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
 
+	// set send side fee enabled
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), ibctesting.MockPort, ibctesting.FirstChannelID)
+
 	// setup & escrow the packet fee
 	refundAcc := suite.chainA.SenderAccount.GetAddress()
 	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
@@ -97,6 +145,22 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set held recv fees
+	heldRecvFees := types.NewHeldRecvFees(
+		channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2), suite.chainB.SenderAccount.GetAddress().String(),
+		[]types.PacketFee{types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), refundAcc.String(), nil)}, 1,
+	)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+
+	// set protocol fees
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetProtocolFees(suite.chainA.GetContext(), defaultRecvFee[0])
+
+	// set relayer stats
+	relayerStats := types.NewRelayerStats(suite.chainB.SenderAccount.GetAddress().String(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	relayerStats.TimeoutFeesPaid = defaultTimeoutFee
+	relayerStats.PacketsTimedOut = 1
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -104,6 +168,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.FeeEnabledChannels[0].ChannelId)
 	suite.Require().Equal(ibctesting.MockFeePort, genesisState.FeeEnabledChannels[0].PortId)
 
+	// check send side fee enabled
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.SendSideFeeEnabledChannels[0].ChannelId)
+	suite.Require().Equal(ibctesting.MockPort, genesisState.SendSideFeeEnabledChannels[0].PortId)
+
 	// check fee
 	suite.Require().Equal(packetID, genesisState.IdentifiedFees[0].PacketId)
 	suite.Require().Equal(fee, genesisState.IdentifiedFees[0].PacketFees[0].Fee)
@@ -123,4 +191,16 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check params
+	suite.Require().Equal(types.DefaultParams(), genesisState.Params)
+
+	// check held recv fees
+	suite.Require().Equal([]types.HeldRecvFees{heldRecvFees}, genesisState.HeldRecvFees)
+
+	// check protocol fees
+	suite.Require().Equal(defaultRecvFee, genesisState.TotalProtocolFees)
+
+	// check relayer stats
+	suite.Require().Equal([]types.RelayerStats{relayerStats}, genesisState.RelayerStats)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -260,8 +262,204 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	isFeeEnabled := k.IsFeeEnabled(ctx, req.PortId, req.ChannelId)
+	isSendSideFeeEnabled := k.IsSendSideFeeEnabled(ctx, req.PortId, req.ChannelId)
 
 	return &types.QueryFeeEnabledChannelResponse{
-		FeeEnabled: isFeeEnabled,
+		FeeEnabled:         isFeeEnabled,
+		SendSideFeeEnabled: isSendSideFeeEnabled,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the 29-fee module parameters
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// HeldRecvFees implements the Query/HeldRecvFees gRPC method and returns the receive fees held in escrow
+// for the given forward relayer until the dispute window has elapsed
+func (k Keeper) HeldRecvFees(goCtx context.Context, req *types.QueryHeldRecvFeesRequest) (*types.QueryHeldRecvFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ForwardRelayer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		heldRecvFees []types.HeldRecvFees
+		totalHeld    sdk.Coins
+	)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyHeldRecvFeesRelayerPrefix(req.ForwardRelayer))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var held types.HeldRecvFees
+		if err := k.cdc.Unmarshal(value, &held); err != nil {
+			return err
+		}
+
+		heldRecvFees = append(heldRecvFees, held)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the total is computed over all held receive fees of the forward relayer regardless of pagination
+	for _, held := range k.getHeldRecvFeesWithPrefix(ctx, types.KeyHeldRecvFeesRelayerPrefix(req.ForwardRelayer)) {
+		totalHeld = totalHeld.Add(held.Total()...)
+	}
+
+	return &types.QueryHeldRecvFeesResponse{
+		HeldRecvFees:      heldRecvFees,
+		TotalHeldRecvFees: totalHeld,
+		Pagination:        pagination,
+	}, nil
+}
+
+// ProtocolFees implements the Query/ProtocolFees gRPC method and returns the total protocol fees
+// sent to the protocol fee recipient
+func (k Keeper) ProtocolFees(goCtx context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryProtocolFeesResponse{
+		TotalProtocolFees: k.GetAllProtocolFees(ctx),
+	}, nil
+}
+
+// RelayerStats implements the Query/RelayerStats gRPC method and returns the statistics of the given relayer
+// on all channels
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Relayer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var relayerStats []types.RelayerStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsRelayerPrefix(req.Relayer))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stats types.RelayerStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+
+		relayerStats = append(relayerStats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayerStatsResponse{
+		RelayerStats: relayerStats,
+		Pagination:   pagination,
+	}, nil
+}
+
+// ChannelRelayerStats implements the Query/ChannelRelayerStats gRPC method and returns the statistics of all relayers
+// on the given channel
+func (k Keeper) ChannelRelayerStats(goCtx context.Context, req *types.QueryChannelRelayerStatsRequest) (*types.QueryChannelRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var relayerStats []types.RelayerStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsByChannelPrefix(req.PortId, req.ChannelId))
+	pagination, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		stats, found := k.GetRelayerStats(ctx, string(key), req.PortId, req.ChannelId)
+		if !found {
+			return errorsmod.Wrapf(types.ErrRelayerStatsNotFound, "relayer: %s, port: %s, channel: %s", string(key), req.PortId, req.ChannelId)
+		}
+
+		relayerStats = append(relayerStats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChannelRelayerStatsResponse{
+		RelayerStats: relayerStats,
+		Pagination:   pagination,
+	}, nil
+}
+
+// RelayerLeaderboard implements the Query/RelayerLeaderboard gRPC method and returns the statistics of the relayers
+// on the given channel in descending order of the fees paid in the given denomination
+func (k Keeper) RelayerLeaderboard(goCtx context.Context, req *types.QueryRelayerLeaderboardRequest) (*types.QueryRelayerLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the number of relayer statistics stored per channel is bounded by the max relayer stats per channel parameter
+	var relayerStats []types.RelayerStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRelayerStatsByChannelPrefix(req.PortId, req.ChannelId))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		stats, found := k.GetRelayerStats(ctx, string(iterator.Key()), req.PortId, req.ChannelId)
+		if !found {
+			return nil, status.Error(codes.Internal, errorsmod.Wrapf(types.ErrRelayerStatsNotFound, "relayer: %s, port: %s, channel: %s", string(iterator.Key()), req.PortId, req.ChannelId).Error())
+		}
+
+		relayerStats = append(relayerStats, stats)
+	}
+
+	// relayers with equal fees are ordered by address as the statistics are iterated in ascending order of address
+	sort.SliceStable(relayerStats, func(i, j int) bool {
+		return relayerStats[i].TotalFeesPaid().AmountOf(req.Denom).GT(relayerStats[j].TotalFeesPaid().AmountOf(req.Denom))
+	})
+
+	if req.Limit != 0 && uint64(len(relayerStats)) > req.Limit {
+		relayerStats = relayerStats[:req.Limit]
+	}
+
+	return &types.QueryRelayerLeaderboardResponse{
+		RelayerStats: relayerStats,
 	}, nil
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -729,8 +730,9 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
 
 func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 	var (
-		req        *types.QueryFeeEnabledChannelRequest
-		expEnabled bool
+		req                *types.QueryFeeEnabledChannelRequest
+		expEnabled         bool
+		expSendSideEnabled bool
 	)
 
 	testCases := []struct {
@@ -751,6 +753,16 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 			},
 			false,
 		},
+		{
+			"success: send side fee enabled channel",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), req.PortId, req.ChannelId)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), req.PortId, req.ChannelId)
+				expEnabled = false
+				expSendSideEnabled = true
+			},
+			true,
+		},
 		{
 			"fee not enabled on channel",
 			func() {
@@ -768,6 +780,7 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
 			expEnabled = true
+			expSendSideEnabled = false
 
 			suite.coordinator.Setup(suite.path)
 
@@ -784,6 +797,437 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(expEnabled, res.FeeEnabled)
+				suite.Require().Equal(expSendSideEnabled, res.SendSideFeeEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryProtocolFees() {
+	expProtocolFees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(5)))
+	for _, coin := range expProtocolFees {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetProtocolFees(suite.chainA.GetContext(), coin)
+	}
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ProtocolFees(suite.chainA.GetContext(), &types.QueryProtocolFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expProtocolFees, res.TotalProtocolFees)
+
+	_, err = suite.chainA.GetSimApp().IBCFeeKeeper.ProtocolFees(suite.chainA.GetContext(), nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryHeldRecvFees() {
+	var (
+		req             *types.QueryHeldRecvFeesRequest
+		expHeldRecvFees []types.HeldRecvFees
+		expTotal        sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expHeldRecvFees = expHeldRecvFees[:1]
+			},
+			true,
+		},
+		{
+			"success: no recv fees held for the forward relayer",
+			func() {
+				req.ForwardRelayer = suite.chainA.SenderAccount.GetAddress().String()
+
+				expHeldRecvFees = nil
+				expTotal = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid forward relayer address",
+			func() {
+				req.ForwardRelayer = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			forwardRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+			expHeldRecvFees = []types.HeldRecvFees{}
+			expTotal = sdk.NewCoins()
+			for i := uint64(1); i < 3; i++ {
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, i)
+				heldRecvFees := types.NewHeldRecvFees(packetID, forwardRelayer, []types.PacketFee{packetFee, packetFee}, 1)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+
+				expHeldRecvFees = append(expHeldRecvFees, heldRecvFees)
+				expTotal = expTotal.Add(heldRecvFees.Total()...)
+			}
+
+			req = &types.QueryHeldRecvFeesRequest{
+				ForwardRelayer: forwardRelayer,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.HeldRecvFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expHeldRecvFees, res.HeldRecvFees)
+				suite.Require().Equal(expTotal, res.TotalHeldRecvFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerStats() {
+	var (
+		req             *types.QueryRelayerStatsRequest
+		expRelayerStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expRelayerStats = expRelayerStats[:1]
+			},
+			true,
+		},
+		{
+			"success: no relayer stats for the relayer",
+			func() {
+				req.Relayer = suite.chainA.SenderAccount.GetAddress().String()
+
+				expRelayerStats = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				req.Relayer = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+			expRelayerStats = []types.RelayerStats{}
+			for _, channelID := range []string{ibctesting.FirstChannelID, "channel-1"} {
+				relayerStats := types.NewRelayerStats(relayer, ibctesting.MockFeePort, channelID)
+				relayerStats.RecvFeesPaid = defaultRecvFee
+				relayerStats.PacketsRelayed = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+				expRelayerStats = append(expRelayerStats, relayerStats)
+			}
+
+			req = &types.QueryRelayerStatsRequest{
+				Relayer: relayer,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelRelayerStats() {
+	var (
+		req             *types.QueryChannelRelayerStatsRequest
+		expRelayerStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit: 1,
+				}
+
+				expRelayerStats = expRelayerStats[:1]
+			},
+			true,
+		},
+		{
+			"success: no relayer stats for the channel",
+			func() {
+				req.ChannelId = "channel-10"
+
+				expRelayerStats = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expRelayerStats = []types.RelayerStats{}
+			for i := 0; i < 2; i++ {
+				relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				relayerStats := types.NewRelayerStats(relayer, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+				relayerStats.AckFeesPaid = defaultAckFee
+				relayerStats.PacketsAcknowledged = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), relayerStats)
+
+				expRelayerStats = append(expRelayerStats, relayerStats)
+			}
+
+			// relayer stats are returned in the order of the relayer addresses
+			sort.Slice(expRelayerStats, func(i, j int) bool {
+				return expRelayerStats[i].Relayer < expRelayerStats[j].Relayer
+			})
+
+			req = &types.QueryChannelRelayerStatsRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ChannelRelayerStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRelayerLeaderboard() {
+	var (
+		req             *types.QueryRelayerLeaderboardRequest
+		relayerStats    []types.RelayerStats
+		expRelayerStats []types.RelayerStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expRelayerStats = []types.RelayerStats{relayerStats[1], relayerStats[0], relayerStats[2]}
+			},
+			true,
+		},
+		{
+			"success: with limit",
+			func() {
+				req.Limit = 1
+
+				expRelayerStats = []types.RelayerStats{relayerStats[1]}
+			},
+			true,
+		},
+		{
+			"success: ranked by fees paid in another denomination",
+			func() {
+				req.Denom = "atom"
+
+				// relayers with equal fees paid are ordered by address
+				expRelayerStats = []types.RelayerStats{relayerStats[2], relayerStats[0], relayerStats[1]}
+				if relayerStats[1].Relayer < relayerStats[0].Relayer {
+					expRelayerStats = []types.RelayerStats{relayerStats[2], relayerStats[1], relayerStats[0]}
+				}
+			},
+			true,
+		},
+		{
+			"success: no relayer stats for the channel",
+			func() {
+				req.ChannelId = "channel-10"
+
+				expRelayerStats = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fees := []sdk.Coins{
+				defaultAckFee,
+				defaultRecvFee.Add(defaultTimeoutFee...),
+				sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1000))),
+			}
+
+			relayerStats = []types.RelayerStats{}
+			for _, fee := range fees {
+				relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				stats := types.NewRelayerStats(relayer, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+				stats.AckFeesPaid = fee
+				stats.PacketsAcknowledged = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerStats(suite.chainA.GetContext(), stats)
+
+				relayerStats = append(relayerStats, stats)
+			}
+
+			req = &types.QueryRelayerLeaderboardRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RelayerLeaderboard(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayerStats, res.RelayerStats)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+// RegisterInvariants registers all 29-fee invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrowed-fees",
+		TotalEscrowedFeesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the 29-fee module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalEscrowedFeesInvariant(k)(ctx)
+	}
+}
+
+// TotalEscrowedFeesInvariant checks that the balance of the fee module account is not smaller than the
+// total of the packet fees and held receive fees in escrow. Protocol fees are sent out of escrow when
+// a fee is distributed, the fee module account must never hold the accumulated protocol fees.
+func TotalEscrowedFeesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedTotalEscrowed sdk.Coins
+
+		for _, identifiedPacketFees := range k.GetAllIdentifiedPacketFees(ctx) {
+			for _, packetFee := range identifiedPacketFees.PacketFees {
+				expectedTotalEscrowed = expectedTotalEscrowed.Add(packetFee.Fee.Total()...)
+			}
+		}
+
+		for _, heldRecvFees := range k.GetAllHeldRecvFees(ctx) {
+			expectedTotalEscrowed = expectedTotalEscrowed.Add(heldRecvFees.Total()...)
+		}
+
+		actualTotalEscrowed := k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress())
+
+		// the escrowed amount must be greater than or equal to the expected amount for all denominations
+		if !actualTotalEscrowed.IsAllGTE(expectedTotalEscrowed) {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"total escrowed fees invariance",
+				fmt.Sprintf("found denom(s) with escrow amount lower than expected:\nactual total escrowed: %s\nexpected total escrowed: %s\ntotal protocol fees: %s",
+					actualTotalEscrowed, expectedTotalEscrowed, k.GetAllProtocolFees(ctx))), true
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowedFeesInvariant() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: accumulated protocol fees are not expected in escrow",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetProtocolFees(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+			},
+			true,
+		},
+		{
+			"fails with broken invariant",
+			func() {
+				// store a packet fee which is not held by the fee module account
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 2)
+				packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.coordinator.Setup(suite.path)
+
+			// escrow a packet fee on chain A
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msg := types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			tc.malleate()
+
+			out, broken := keeper.TotalEscrowedFeesInvariant(&suite.chainA.GetSimApp().IBCFeeKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+				suite.Require().Empty(out)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
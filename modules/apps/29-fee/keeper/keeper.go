@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"errors"
+	"strings"
+
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -31,6 +35,11 @@ type Keeper struct {
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateSendSideFeeEnabled message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
@@ -38,7 +47,12 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
@@ -47,6 +61,7 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -57,6 +72,17 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithDistributionKeeper sets the DistributionKeeper. This function may be used after
+// the keepers creation to allow the protocol fees to be sent to the community pool.
+func (k *Keeper) WithDistributionKeeper(distrKeeper types.DistributionKeeper) {
+	k.distrKeeper = distrKeeper
+}
+
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
@@ -157,6 +183,55 @@ func (k Keeper) GetAllFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChan
 	return enabledChArr
 }
 
+// SetSendSideFeeEnabled sets a flag to determine if send side fee handling logic should run for the given channel
+// identified by channel and port identifiers.
+func (k Keeper) SetSendSideFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeySendSideFeeEnabled(portID, channelID), []byte{1})
+}
+
+// DeleteSendSideFeeEnabled deletes the send side fee enabled flag for a given portID and channelID
+func (k Keeper) DeleteSendSideFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySendSideFeeEnabled(portID, channelID))
+}
+
+// IsSendSideFeeEnabled returns whether send side fee handling logic should be run for the given port and channel
+// identifiers. Send side fees only take effect on channels which are not fee enabled through the version negotiated
+// with the counterparty.
+func (k Keeper) IsSendSideFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeySendSideFeeEnabled(portID, channelID))
+}
+
+// IsFeeEscrowEnabled returns true if packet fees may be escrowed for the given port and channel identifiers,
+// that is if the channel is either fee enabled or send side fee enabled.
+func (k Keeper) IsFeeEscrowEnabled(ctx sdk.Context, portID, channelID string) bool {
+	return k.IsFeeEnabled(ctx, portID, channelID) || k.IsSendSideFeeEnabled(ctx, portID, channelID)
+}
+
+// GetAllSendSideFeeEnabledChannels returns a list of all send side fee enabled channels containing portID & channelID that are stored in state
+func (k Keeper) GetAllSendSideFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.SendSideFeeEnabledKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var enabledChArr []types.FeeEnabledChannel
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeySendSideFeeEnabled(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		enabledChArr = append(enabledChArr, types.FeeEnabledChannel{
+			PortId:    portID,
+			ChannelId: channelID,
+		})
+	}
+
+	return enabledChArr
+}
+
 // GetPayeeAddress retrieves the fee payee address stored in state given the provided channel identifier and relayer address
 func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, channelID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -377,6 +452,220 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPa
 	return identifiedFees
 }
 
+// GetParams returns the current 29-fee module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("29-fee params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the 29-fee module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
+
+// GetHeldRecvFees returns the receive fees of the given packet held in escrow for the forward relayer
+func (k Keeper) GetHeldRecvFees(ctx sdk.Context, forwardRelayer string, packetID channeltypes.PacketId) (types.HeldRecvFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyHeldRecvFees(forwardRelayer, packetID))
+	if len(bz) == 0 {
+		return types.HeldRecvFees{}, false
+	}
+
+	var heldRecvFees types.HeldRecvFees
+	k.cdc.MustUnmarshal(bz, &heldRecvFees)
+	return heldRecvFees, true
+}
+
+// SetHeldRecvFees stores the given held receive fees and schedules them to be released at their release time
+func (k Keeper) SetHeldRecvFees(ctx sdk.Context, heldRecvFees types.HeldRecvFees) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyHeldRecvFees(heldRecvFees.ForwardRelayer, heldRecvFees.PacketId)
+	store.Set(key, k.cdc.MustMarshal(&heldRecvFees))
+	store.Set(types.HeldRecvFeesQueueKey(heldRecvFees.ReleaseTime, heldRecvFees.ForwardRelayer, heldRecvFees.PacketId), key)
+}
+
+// DeleteHeldRecvFees deletes the given held receive fees along with their entry in the release queue
+func (k Keeper) DeleteHeldRecvFees(ctx sdk.Context, heldRecvFees types.HeldRecvFees) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyHeldRecvFees(heldRecvFees.ForwardRelayer, heldRecvFees.PacketId))
+	store.Delete(types.HeldRecvFeesQueueKey(heldRecvFees.ReleaseTime, heldRecvFees.ForwardRelayer, heldRecvFees.PacketId))
+}
+
+// GetHeldRecvFeesForChannel returns all the receive fees held in escrow for the forward relayer on a given channel
+func (k Keeper) GetHeldRecvFeesForChannel(ctx sdk.Context, forwardRelayer, portID, channelID string) []types.HeldRecvFees {
+	// the trailing separator ensures channel identifiers sharing a common prefix are not included
+	prefix := append(types.KeyHeldRecvFeesChannelPrefix(forwardRelayer, portID, channelID), '/')
+	return k.getHeldRecvFeesWithPrefix(ctx, prefix)
+}
+
+// GetAllHeldRecvFees returns all the receive fees held in escrow that are stored in state
+func (k Keeper) GetAllHeldRecvFees(ctx sdk.Context) []types.HeldRecvFees {
+	return k.getHeldRecvFeesWithPrefix(ctx, []byte(types.HeldRecvFeesPrefix+"/"))
+}
+
+// getHeldRecvFeesWithPrefix returns all the receive fees held in escrow stored under the given key prefix
+func (k Keeper) getHeldRecvFeesWithPrefix(ctx sdk.Context, prefix []byte) []types.HeldRecvFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var heldRecvFees []types.HeldRecvFees
+	for ; iterator.Valid(); iterator.Next() {
+		var held types.HeldRecvFees
+		k.cdc.MustUnmarshal(iterator.Value(), &held)
+
+		heldRecvFees = append(heldRecvFees, held)
+	}
+
+	return heldRecvFees
+}
+
+// GetProtocolFees returns the total protocol fees of the given denomination sent to the protocol fee recipient.
+//
+// NOTE: if there is no value stored in state for the provided denom then a new Coin is returned for the denom with an initial value of zero.
+func (k Keeper) GetProtocolFees(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyProtocolFees(denom))
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	}
+
+	amount := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetProtocolFees stores the total protocol fees of a denomination sent to the protocol fee recipient.
+// Amount is stored in state if and only if it is not equal to zero.
+func (k Keeper) SetProtocolFees(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyProtocolFees(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(key, bz)
+}
+
+// GetAllProtocolFees returns the total protocol fees of all denominations sent to the protocol fee recipient.
+func (k Keeper) GetAllProtocolFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ProtocolFeesPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var protocolFees sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		denom := strings.TrimPrefix(string(iterator.Key()), types.ProtocolFeesPrefix+"/")
+
+		amount := sdk.IntProto{}
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		protocolFees = protocolFees.Add(sdk.NewCoin(denom, amount.Int))
+	}
+
+	return protocolFees
+}
+
+// GetRelayerStats returns the statistics of the given relayer on the given channel
+func (k Keeper) GetRelayerStats(ctx sdk.Context, relayer, portID, channelID string) (types.RelayerStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayerStats(relayer, portID, channelID))
+	if bz == nil {
+		return types.RelayerStats{}, false
+	}
+
+	var relayerStats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &relayerStats)
+
+	return relayerStats, true
+}
+
+// SetRelayerStats stores the statistics of a relayer on a channel and indexes them by channel. The number of relayers
+// whose statistics are stored on the channel is incremented if no statistics were stored for the relayer.
+func (k Keeper) SetRelayerStats(ctx sdk.Context, relayerStats types.RelayerStats) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyRelayerStats(relayerStats.Relayer, relayerStats.PortId, relayerStats.ChannelId)) {
+		count := k.GetRelayerStatsCount(ctx, relayerStats.PortId, relayerStats.ChannelId)
+		store.Set(types.KeyRelayerStatsCount(relayerStats.PortId, relayerStats.ChannelId), sdk.Uint64ToBigEndian(count+1))
+	}
+
+	store.Set(types.KeyRelayerStats(relayerStats.Relayer, relayerStats.PortId, relayerStats.ChannelId), k.cdc.MustMarshal(&relayerStats))
+	store.Set(types.KeyRelayerStatsByChannel(relayerStats.PortId, relayerStats.ChannelId, relayerStats.Relayer), []byte{1})
+}
+
+// GetRelayerStatsCount returns the number of relayers whose statistics are stored on the given channel
+func (k Keeper) GetRelayerStatsCount(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRelayerStatsCount(portID, channelID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllRelayerStats returns the statistics of all relayers on all channels that are stored in state
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) []types.RelayerStats {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RelayerStatsPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var relayerStats []types.RelayerStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		relayerStats = append(relayerStats, stats)
+	}
+
+	return relayerStats
+}
+
+// updateRelayerStats applies the given update to the statistics of the relayer on the given channel.
+// The statistics are not updated if the relayer address is empty or the relayer statistics are disabled.
+// As the forward relayer address is chosen by the counterparty, statistics are only created for a relayer
+// once a fee is paid to the relayer and while the maximum number of relayer statistics on the channel is
+// not reached.
+func (k Keeper) updateRelayerStats(ctx sdk.Context, relayer sdk.AccAddress, portID, channelID string, updateFn func(relayerStats *types.RelayerStats)) {
+	params := k.GetParams(ctx)
+	if relayer.Empty() || !params.RelayerStatsEnabled {
+		return
+	}
+
+	relayerStats, found := k.GetRelayerStats(ctx, relayer.String(), portID, channelID)
+	if !found {
+		relayerStats = types.NewRelayerStats(relayer.String(), portID, channelID)
+	}
+
+	updateFn(&relayerStats)
+
+	if !found {
+		if relayerStats.TotalFeesPaid().IsZero() {
+			return
+		}
+
+		if k.GetRelayerStatsCount(ctx, portID, channelID) >= params.MaxRelayerStatsPerChannel {
+			k.Logger(ctx).Debug("maximum number of relayer statistics reached", "relayer", relayer, "port-id", portID, "channel-id", channelID)
+			return
+		}
+	}
+
+	k.SetRelayerStats(ctx, relayerStats)
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
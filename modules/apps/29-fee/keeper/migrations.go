@@ -45,6 +45,13 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by setting the default module parameters.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
@@ -199,3 +199,16 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	// delete the params set at genesis to mimic a chain running ConsensusVersion 2
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete([]byte(types.ParamsKey))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(types.DefaultParams(), params)
+}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -31,12 +32,12 @@ func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
 	}
 
-	// only register payee address if the channel exists and is fee enabled
+	// only register payee address if the channel exists and is fee enabled or send side fee enabled
 	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+	if !k.IsFeeEscrowEnabled(ctx, msg.PortId, msg.ChannelId) {
 		return nil, types.ErrFeeNotEnabled
 	}
 
@@ -80,7 +81,7 @@ func (k Keeper) RegisterCounterpartyPayee(goCtx context.Context, msg *types.MsgR
 func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.IsFeeEnabled(ctx, msg.SourcePortId, msg.SourceChannelId) {
+	if !k.IsFeeEscrowEnabled(ctx, msg.SourcePortId, msg.SourceChannelId) {
 		// users may not escrow fees on this channel. Must send packets without a fee message
 		return nil, types.ErrFeeNotEnabled
 	}
@@ -111,7 +112,7 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 	packetID := channeltypes.NewPacketID(msg.SourcePortId, msg.SourceChannelId, sequence)
 	packetFee := types.NewPacketFee(msg.Fee, msg.Signer, msg.Relayers)
 
-	if err := k.escrowPacketFee(ctx, packetID, packetFee); err != nil {
+	if err := k.escrowPacketFee(ctx, packetFee, packetID); err != nil {
 		return nil, err
 	}
 
@@ -125,7 +126,7 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacketFeeAsync) (*types.MsgPayPacketFeeAsyncResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.IsFeeEnabled(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId) {
+	if !k.IsFeeEscrowEnabled(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId) {
 		// users may not escrow fees on this channel. Must send packets without a fee message
 		return nil, types.ErrFeeNotEnabled
 	}
@@ -152,19 +153,162 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, errorsmod.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", msg.PacketId.PortId, msg.PacketId.ChannelId)
 	}
 
-	// only allow incentivizing of packets which have been sent
-	if msg.PacketId.Sequence >= nextSeqSend {
-		return nil, channeltypes.ErrPacketNotSent
+	if err := k.validatePacketIncentivizable(ctx, msg.PacketId, nextSeqSend); err != nil {
+		return nil, err
+	}
+
+	if err := k.escrowPacketFee(ctx, msg.PacketFee, msg.PacketId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayPacketFeeAsyncResponse{}, nil
+}
+
+// PayPacketFeeAsyncBatch defines a rpc handler method for MsgPayPacketFeeAsyncBatch
+// PayPacketFeeAsyncBatch is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize the relaying of a range or list of known packets on a channel. Packets which have not been sent or have
+// already gone through the packet life cycle are skipped, and the result for each packet sequence is returned. The packet
+// fees of all incentivized packets are escrowed in a single transfer.
+func (k Keeper) PayPacketFeeAsyncBatch(goCtx context.Context, msg *types.MsgPayPacketFeeAsyncBatch) (*types.MsgPayPacketFeeAsyncBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsFeeEscrowEnabled(ctx, msg.PortId, msg.ChannelId) {
+		// users may not escrow fees on this channel. Must send packets without a fee message
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
 	}
 
-	// only allow incentivizng of packets which have not completed the packet life cycle
-	if bz := k.GetPacketCommitment(ctx, msg.PacketId.PortId, msg.PacketId.ChannelId, msg.PacketId.Sequence); len(bz) == 0 {
-		return nil, errorsmod.Wrapf(channeltypes.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out")
+	refundAcc, err := sdk.AccAddressFromBech32(msg.PacketFee.RefundAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := k.escrowPacketFee(ctx, msg.PacketId, msg.PacketFee); err != nil {
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.PacketFee.Fee.Total()...); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgPayPacketFeeAsyncResponse{}, nil
+	if k.bankKeeper.BlockedAddr(refundAcc) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
+	}
+
+	nextSeqSend, found := k.GetNextSequenceSend(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrSequenceSendNotFound, "channel does not exist, portID: %s, channelID: %s", msg.PortId, msg.ChannelId)
+	}
+
+	var (
+		packetIDs []channeltypes.PacketId
+		results   []types.PayPacketFeeResult
+	)
+	for _, sequence := range msg.PacketSequences() {
+		packetID := channeltypes.NewPacketID(msg.PortId, msg.ChannelId, sequence)
+		if err := k.validatePacketIncentivizable(ctx, packetID, nextSeqSend); err != nil {
+			results = append(results, types.PayPacketFeeResult{Sequence: sequence, Error: err.Error()})
+			continue
+		}
+
+		packetIDs = append(packetIDs, packetID)
+		results = append(results, types.PayPacketFeeResult{Sequence: sequence, Escrowed: true})
+	}
+
+	if len(packetIDs) != 0 {
+		if err := k.escrowPacketFee(ctx, msg.PacketFee, packetIDs...); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgPayPacketFeeAsyncBatchResponse{Results: results}, nil
+}
+
+// UpdateSendSideFeeEnabled defines a rpc handler method for MsgUpdateSendSideFeeEnabled
+// UpdateSendSideFeeEnabled enables or disables send side fees for the given channel. Packet fees escrowed on a send
+// side fee enabled channel are paid out on the sending chain only: the receive and acknowledgement fees are paid to
+// the payee of the relayer submitting the acknowledgement and the timeout fee to the payee of the relayer submitting
+// the timeout. When send side fees are disabled for a channel which is not fee enabled, all fees in escrow for the
+// channel are refunded.
+func (k Keeper) UpdateSendSideFeeEnabled(goCtx context.Context, msg *types.MsgUpdateSendSideFeeEnabled) (*types.MsgUpdateSendSideFeeEnabledResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	if msg.Enabled {
+		k.SetSendSideFeeEnabled(ctx, msg.PortId, msg.ChannelId)
+	} else {
+		if !k.IsSendSideFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+			return nil, errorsmod.Wrapf(types.ErrSendSideFeeNotEnabled, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+		}
+
+		// fees in escrow would no longer be distributed once send side fees are disabled,
+		// unless the channel is fee enabled through its version
+		if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+			if k.IsLocked(ctx) {
+				return nil, types.ErrFeeModuleLocked
+			}
+
+			if err := k.RefundFeesOnChannelClosure(ctx, msg.PortId, msg.ChannelId); err != nil {
+				return nil, err
+			}
+		}
+
+		k.DeleteSendSideFeeEnabled(ctx, msg.PortId, msg.ChannelId)
+	}
+
+	k.Logger(ctx).Info("updated send side fee enabled flag", "port-id", msg.PortId, "channel-id", msg.ChannelId, "enabled", msg.Enabled)
+
+	emitUpdateSendSideFeeEnabledEvent(ctx, msg.PortId, msg.ChannelId, msg.Enabled)
+
+	return &types.MsgUpdateSendSideFeeEnabledResponse{}, nil
+}
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the 29-fee module parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Params.IsProtocolFeeEnabled() && k.authKeeper.GetModuleAddress(msg.Params.ProtocolFeeRecipient) == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "protocol fee recipient module account %s does not exist", msg.Params.ProtocolFeeRecipient)
+	}
+
+	if msg.Params.IsProtocolFeeEnabled() && msg.Params.ProtocolFeeRecipient == distrtypes.ModuleName && k.distrKeeper == nil {
+		return nil, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "protocol fees cannot fund the community pool, the distribution keeper is not set")
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// DisputeHeldRecvFees defines an rpc handler method for MsgDisputeHeldRecvFees. Refunds the receive fees held in escrow
+// for the forward relayer on the given channel to their refund addresses before the dispute window has elapsed.
+func (k Keeper) DisputeHeldRecvFees(goCtx context.Context, msg *types.MsgDisputeHeldRecvFees) (*types.MsgDisputeHeldRecvFeesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	disputed := k.RefundHeldRecvFees(ctx, msg.ForwardRelayer, msg.PortId, msg.ChannelId)
+	if disputed == 0 {
+		return nil, errorsmod.Wrapf(types.ErrHeldRecvFeesNotFound, "forward relayer (%s) port ID (%s) channel ID (%s)", msg.ForwardRelayer, msg.PortId, msg.ChannelId)
+	}
+
+	k.Logger(ctx).Info("disputed held receive fees", "forward-relayer", msg.ForwardRelayer, "port-id", msg.PortId, "channel-id", msg.ChannelId, "disputed", disputed)
+
+	return &types.MsgDisputeHeldRecvFeesResponse{Disputed: disputed}, nil
 }
@@ -1,15 +1,21 @@
 package keeper_test
 
 import (
+	"slices"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -34,6 +40,14 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 				msg.ChannelId = "channel-100" //nolint:goconst
 			},
 		},
+		{
+			"success: channel is send side fee enabled",
+			true,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
 		{
 			"channel is not fee enabled",
 			false,
@@ -130,6 +144,14 @@ func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
 				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
 			},
 		},
+		{
+			"channel is only send side fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -226,6 +248,23 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			true,
 		},
+		{
+			"success: channel is send side fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			true,
+		},
+		{
+			"success: fee paid in the fee denom",
+			func() {
+				params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+				params.FeeDenom = sdk.DefaultBondDenom
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
 		{
 			"fee module is locked",
 			func() {
@@ -233,6 +272,15 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			false,
 		},
+		{
+			"fee not paid in the fee denom",
+			func() {
+				params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+				params.FeeDenom = "atom"
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"fee module disabled on channel",
 			func() {
@@ -554,3 +602,429 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPayPacketFeeAsyncBatch() {
+	var (
+		msg         *types.MsgPayPacketFeeAsyncBatch
+		packets     []channeltypes.Packet
+		expEscrowed []uint64
+		expSkipped  []uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: sequence range",
+			func() {},
+			nil,
+		},
+		{
+			"success: list of sequences",
+			func() {
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, []uint64{3, 1}, msg.PacketFee)
+				expEscrowed = []uint64{3, 1}
+			},
+			nil,
+		},
+		{
+			"success: packets which have not been sent are skipped",
+			func() {
+				msg.EndSequence = 5
+				expSkipped = []uint64{4, 5}
+			},
+			nil,
+		},
+		{
+			"success: packets which have already been acknowledged or timed out are skipped",
+			func() {
+				err := suite.path.RelayPacket(packets[1])
+				suite.Require().NoError(err)
+
+				expEscrowed = []uint64{1, 3}
+				expSkipped = []uint64{2}
+			},
+			nil,
+		},
+		{
+			"success: no packets incentivized",
+			func() {
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, []uint64{10}, msg.PacketFee)
+				expEscrowed = nil
+				expSkipped = []uint64{10}
+			},
+			nil,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: fee not enabled on channel",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			types.ErrFeeNotEnabled,
+		},
+		{
+			"failure: refund account is a blocked address",
+			func() {
+				msg.PacketFee.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: insufficient funds to escrow the total of the packet fees",
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				msg.PacketFee.Fee.RecvFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balance.Amount.QuoRaw(2)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			// send packets to incentivize
+			timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100)
+
+			packets = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := suite.path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, timeoutHeight, 0)
+				packets = append(packets, packet)
+			}
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+			expEscrowed = []uint64{1, 2, 3}
+			expSkipped = nil
+			msg = types.NewMsgPayPacketFeeAsyncBatchForRange(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1, 3, packetFee)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsyncBatch(suite.chainA.GetContext(), msg)
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Results, len(expEscrowed)+len(expSkipped))
+
+				for _, result := range res.Results {
+					packetID := channeltypes.NewPacketID(msg.PortId, msg.ChannelId, result.Sequence)
+					if slices.Contains(expEscrowed, result.Sequence) {
+						suite.Require().True(result.Escrowed)
+						suite.Require().Empty(result.Error)
+
+						feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+						suite.Require().True(found)
+						suite.Require().Equal([]types.PacketFee{msg.PacketFee}, feesInEscrow.PacketFees)
+					} else {
+						suite.Require().Contains(expSkipped, result.Sequence)
+						suite.Require().False(result.Escrowed)
+						suite.Require().NotEmpty(result.Error)
+						suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+					}
+				}
+
+				// the packet fee is escrowed once for each incentivized packet
+				expEscrowBalance := fee.Total().AmountOf(sdk.DefaultBondDenom).MulRaw(int64(len(expEscrowed)))
+				suite.Require().Equal(expEscrowBalance, escrowBalance.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().Equal(sdkmath.NewInt(0), escrowBalance.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateSendSideFeeEnabled() {
+	var (
+		msg         *types.MsgUpdateSendSideFeeEnabled
+		packetID    channeltypes.PacketId
+		expRefunded bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: enable send side fees",
+			func() {},
+			nil,
+		},
+		{
+			"success: disable send side fees refunds fees in escrow",
+			func() {
+				msg.Enabled = false
+				expRefunded = true
+			},
+			nil,
+		},
+		{
+			"success: disable send side fees on fee enabled channel does not refund fees in escrow",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				msg.Enabled = false
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: send side fees are not enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteSendSideFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				msg.Enabled = false
+			},
+			types.ErrSendSideFeeNotEnabled,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+				msg.Enabled = false
+			},
+			types.ErrFeeModuleLocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// use a channel which is not fee enabled
+			suite.path.EndpointA.ChannelConfig.Version = ibcmock.Version
+			suite.path.EndpointB.ChannelConfig.Version = ibcmock.Version
+			suite.coordinator.Setup(suite.path)
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			feeKeeper.SetSendSideFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			// escrow a fee for a packet sent on the channel
+			sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+			_, err = feeKeeper.PayPacketFeeAsync(suite.chainA.GetContext(), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+			suite.Require().NoError(err)
+
+			expRefunded = false
+			msg = types.NewMsgUpdateSendSideFeeEnabled(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, true, feeKeeper.GetAuthority())
+
+			tc.malleate()
+
+			_, err = feeKeeper.UpdateSendSideFeeEnabled(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Enabled, feeKeeper.IsSendSideFeeEnabled(suite.chainA.GetContext(), msg.PortId, msg.ChannelId))
+				suite.Require().Equal(!expRefunded, feeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(feeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	var msg *types.MsgUpdateParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: protocol fee enabled",
+			func() {
+				msg.Params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+				msg.Params.ProtocolFeeRecipient = ibcmock.ModuleName
+			},
+			nil,
+		},
+		{
+			"success: protocol fees fund the community pool",
+			func() {
+				msg.Params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+				msg.Params.ProtocolFeeRecipient = distrtypes.ModuleName
+			},
+			nil,
+		},
+		{
+			"success: fee denom set",
+			func() {
+				msg.Params.FeeDenom = sdk.DefaultBondDenom
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: protocol fee recipient module account does not exist",
+			func() {
+				msg.Params.ProtocolFeePercentage = sdkmath.LegacyNewDecWithPrec(1, 2)
+				msg.Params.ProtocolFeeRecipient = "nonexistent"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			msg = types.NewMsgUpdateParams(feeKeeper.GetAuthority(), types.NewParams(uint64(time.Hour.Nanoseconds()), 10, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""))
+
+			tc.malleate()
+
+			_, err := feeKeeper.UpdateParams(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Params, feeKeeper.GetParams(suite.chainA.GetContext()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Equal(types.DefaultParams(), feeKeeper.GetParams(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDisputeHeldRecvFees() {
+	var (
+		msg          *types.MsgDisputeHeldRecvFees
+		heldRecvFees types.HeldRecvFees
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: no recv fees held for the forward relayer",
+			func() {
+				msg.ForwardRelayer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			types.ErrHeldRecvFeesNotFound,
+		},
+		{
+			"failure: no recv fees held on the channel",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			types.ErrHeldRecvFeesNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, 1)
+			packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), refundAcc.String(), nil)
+			heldRecvFees = types.NewHeldRecvFees(packetID, suite.chainB.SenderAccount.GetAddress().String(), []types.PacketFee{packetFee}, uint64(time.Hour.Nanoseconds()))
+
+			feeKeeper.SetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees)
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, heldRecvFees.Total())
+			suite.Require().NoError(err)
+
+			refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			msg = types.NewMsgDisputeHeldRecvFees(packetID.PortId, packetID.ChannelId, heldRecvFees.ForwardRelayer, feeKeeper.GetAuthority())
+
+			tc.malleate()
+
+			res, err := feeKeeper.DisputeHeldRecvFees(suite.chainA.GetContext(), msg)
+
+			_, found := feeKeeper.GetHeldRecvFees(suite.chainA.GetContext(), heldRecvFees.ForwardRelayer, packetID)
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.Disputed)
+				suite.Require().False(found)
+				suite.Require().Equal(refundAccBal.Add(defaultRecvFee[0]), balance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(found)
+				suite.Require().Equal(refundAccBal, balance)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -27,6 +28,24 @@ func (k Keeper) SendPacket(
 	return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 }
 
+// SendPacketWithRelativeTimeout wraps the ICS4Wrapper SendPacketWithRelativeTimeout function.
+// An error is returned if the ICS4Wrapper does not support relative timeouts.
+func (k Keeper) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout channeltypes.RelativeTimeout,
+	data []byte,
+) (uint64, channeltypes.Timeout, error) {
+	relativeTimeoutSender, ok := k.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+	if !ok {
+		return 0, channeltypes.Timeout{}, errorsmod.Wrapf(porttypes.ErrRelativeTimeoutNotSupported, "ICS4Wrapper %T does not implement RelativeTimeoutPacketSender", k.ics4Wrapper)
+	}
+
+	return relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, chanCap, sourcePort, sourceChannel, relativeTimeout, data)
+}
+
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
 // ICS29 WriteAcknowledgement is used for asynchronous acknowledgements
 func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
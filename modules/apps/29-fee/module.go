@@ -30,7 +30,9 @@ var (
 	_ module.HasName             = (*AppModule)(nil)
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
+	_ module.HasInvariants       = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
 )
 
 // AppModuleBasic is the 29-fee AppModuleBasic
@@ -104,6 +106,11 @@ func NewAppModule(k keeper.Keeper) AppModule {
 	}
 }
 
+// RegisterInvariants implements the AppModule interface
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, &am.keeper)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
@@ -113,6 +120,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Errorf("failed to migrate ibc-fee module from version 1 to 2 (refund leftover fees): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to migrate ibc-fee module from version 2 to 3 (set default params): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-29-fee module. It returns
@@ -131,7 +142,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
+
+// BeginBlock implements the AppModule interface and releases the receive fees held in escrow
+// whose dispute window has elapsed.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.ReleaseHeldRecvFees(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
 
 // AppModuleSimulation functions
 
@@ -1,11 +1,14 @@
 package fee_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -69,6 +72,63 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom)).Sub(originalChainASenderAccountBalance[0]))
 }
 
+// Integration test to ensure the receive fee is held in escrow until the dispute window has elapsed
+func (suite *FeeTestSuite) TestFeeTransferWithDisputeWindow() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+
+	suite.coordinator.Setup(path)
+
+	disputeWindow := time.Hour
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(disputeWindow.Nanoseconds()), types.DefaultMaxReleasedPerBlock, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""))
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil),
+		transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, ""),
+	}
+	res, err := suite.chainA.SendMsgs(msgs...)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// register counterparty address on chainB
+	// relayerAddress is address of sender account on chainB, but we will use it on chainA
+	// to differentiate from the chainA.SenderAccount for checking successful relay payouts
+	relayerAddress := suite.chainB.SenderAccount.GetAddress()
+
+	msgRegister := types.NewMsgRegisterCounterpartyPayee(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), relayerAddress.String())
+	_, err = suite.chainB.SendMsgs(msgRegister)
+	suite.Require().NoError(err) // message committed
+
+	// relay packet
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// the recv fee is held in escrow for the forward relayer
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddress, ibctesting.TestCoin.Denom).IsZero())
+
+	queryRes, err := suite.chainA.GetSimApp().IBCFeeKeeper.HeldRecvFees(suite.chainA.GetContext(), &types.QueryHeldRecvFeesRequest{ForwardRelayer: relayerAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.HeldRecvFees, 1)
+	suite.Require().Equal(fee.RecvFee, queryRes.TotalHeldRecvFees)
+
+	// the recv fee is paid out to the forward relayer once the dispute window has elapsed
+	suite.coordinator.IncrementTimeBy(disputeWindow)
+	suite.chainA.NextBlock()
+
+	suite.Require().Equal(
+		fee.RecvFee,
+		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddress, ibctesting.TestCoin.Denom)),
+	)
+	suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllHeldRecvFees(suite.chainA.GetContext()))
+}
+
 func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 	var path *ibctesting.Path
 
@@ -163,3 +223,94 @@ func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 		})
 	}
 }
+
+// Integration test to ensure send side fees are paid on a transfer channel which is not fee enabled
+func (suite *FeeTestSuite) TestSendSideFeeTransfer() {
+	testCases := []struct {
+		name       string
+		timeout    bool
+		expPayment sdk.Coins
+	}{
+		{
+			"success: receive and acknowledgement fees are paid to the payee of the acknowledgement relayer",
+			false,
+			defaultRecvFee.Add(defaultAckFee...),
+		},
+		{
+			"success: timeout fee is paid to the payee of the timeout relayer",
+			true,
+			defaultTimeoutFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+			path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+			path.EndpointA.ChannelConfig.Version = transfertypes.Version
+			path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+			suite.coordinator.Setup(path)
+
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			suite.Require().False(feeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+			// fees may not be escrowed before send side fees are enabled
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msgPayPacketFee := types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			_, err := feeKeeper.PayPacketFee(suite.chainA.GetContext(), msgPayPacketFee)
+			suite.Require().ErrorIs(err, types.ErrFeeNotEnabled)
+
+			msgEnable := types.NewMsgUpdateSendSideFeeEnabled(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, feeKeeper.GetAuthority())
+			_, err = feeKeeper.UpdateSendSideFeeEnabled(suite.chainA.GetContext(), msgEnable)
+			suite.Require().NoError(err)
+
+			// the relayer of chainA registers chainB.SenderAccount as its payee on chainA to differentiate
+			// the relayer payouts from the chainA.SenderAccount balance
+			payee := suite.chainB.SenderAccount.GetAddress()
+			msgRegister := types.NewMsgRegisterPayee(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), payee.String())
+			_, err = suite.chainA.SendMsgs(msgRegister)
+			suite.Require().NoError(err)
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			msgs := []sdk.Msg{
+				msgPayPacketFee,
+				transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, ""),
+			}
+
+			res, err := suite.chainA.SendMsgs(msgs...)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			feeEscrowAddr := feeKeeper.GetFeeModuleAddress()
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeEscrowAddr, sdk.DefaultBondDenom)
+			suite.Require().Equal(fee.Total().AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+
+			if tc.timeout {
+				// advance chainB past the timeout height
+				suite.coordinator.CommitNBlocks(suite.chainB, 100)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				err = path.EndpointA.TimeoutPacket(packet)
+			} else {
+				err = path.RelayPacket(packet)
+			}
+			suite.Require().NoError(err)
+
+			escrowBalance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeEscrowAddr, sdk.DefaultBondDenom)
+			suite.Require().True(escrowBalance.IsZero())
+
+			payeeBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+			suite.Require().Equal(tc.expPayment, sdk.NewCoins(payeeBalance))
+
+			_, found := feeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), channeltypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+			suite.Require().False(found)
+		})
+	}
+}
@@ -13,8 +13,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFee{}, "cosmos-sdk/MsgPayPacketFee")
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
+	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsyncBatch{}, "cosmos-sdk/MsgPayPacketFeeAsyncBatch")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSendSideFeeEnabled{}, "cosmos-sdk/MsgUpdateSendSideFeeEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/MsgUpdateFeeParams")
+	legacy.RegisterAminoMsg(cdc, &MsgDisputeHeldRecvFees{}, "cosmos-sdk/MsgDisputeHeldRecvFees")
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -24,8 +28,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgPayPacketFee{},
 		&MsgPayPacketFeeAsync{},
+		&MsgPayPacketFeeAsyncBatch{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgUpdateSendSideFeeEnabled{},
+		&MsgUpdateParams{},
+		&MsgDisputeHeldRecvFees{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
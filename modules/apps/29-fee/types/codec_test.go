@@ -28,6 +28,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgPayPacketFeeAsync{}),
 			true,
 		},
+		{
+			"success: MsgPayPacketFeeAsyncBatch",
+			sdk.MsgTypeURL(&types.MsgPayPacketFeeAsyncBatch{}),
+			true,
+		},
 		{
 			"success: MsgRegisterPayee",
 			sdk.MsgTypeURL(&types.MsgRegisterPayee{}),
@@ -17,4 +17,8 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrSendSideFeeNotEnabled         = errorsmod.Register(ModuleName, 13, "send side fees are not enabled for this channel")
+	ErrHeldRecvFeesNotFound          = errorsmod.Register(ModuleName, 14, "no receive fees held in escrow for the given forward relayer")
+	ErrRelayerStatsNotFound          = errorsmod.Register(ModuleName, 15, "relayer statistics not found")
+	ErrInvalidFeeDenom               = errorsmod.Register(ModuleName, 16, "invalid fee denomination")
 )
@@ -6,6 +6,11 @@ const (
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeUpdateSendSideFeeEnabled  = "update_send_side_fee_enabled"
+	EventTypeHoldRecvFee               = "hold_recv_fee"
+	EventTypeReleaseRecvFee            = "release_recv_fee"
+	EventTypeDisputeRecvFee            = "dispute_recv_fee"
+	EventTypeDistributeProtocolFee     = "distribute_protocol_fee"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -16,4 +21,8 @@ const (
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyPortID            = "port_id"
+	AttributeKeyEnabled           = "enabled"
+	AttributeKeyForwardRelayer    = "forward_relayer"
+	AttributeKeyReleaseTime       = "release_time"
 )
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
@@ -91,3 +92,62 @@ func (f Fee) Validate() error {
 
 	return nil
 }
+
+// NewHeldRecvFees creates and returns a new HeldRecvFees struct containing the receive fees of a packet held in escrow
+// for the forward relayer until the given release time
+func NewHeldRecvFees(packetID channeltypes.PacketId, forwardRelayer string, packetFees []PacketFee, releaseTime uint64) HeldRecvFees {
+	return HeldRecvFees{
+		PacketId:       packetID,
+		ForwardRelayer: forwardRelayer,
+		PacketFees:     packetFees,
+		ReleaseTime:    releaseTime,
+	}
+}
+
+// Total returns the total receive fees held in escrow
+func (h HeldRecvFees) Total() sdk.Coins {
+	var total sdk.Coins
+	for _, packetFee := range h.PacketFees {
+		total = total.Add(packetFee.Fee.RecvFee...)
+	}
+
+	return total
+}
+
+// NewRelayerStats creates and returns a new RelayerStats struct for the given relayer on the given channel with no fees paid
+// and no packets relayed
+func NewRelayerStats(relayer, portID, channelID string) RelayerStats {
+	return RelayerStats{
+		Relayer:   relayer,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// TotalFeesPaid returns the total receive, acknowledgement and timeout fees paid to the relayer
+func (r RelayerStats) TotalFeesPaid() sdk.Coins {
+	return r.RecvFeesPaid.Add(r.AckFeesPaid...).Add(r.TimeoutFeesPaid...)
+}
+
+// Validate performs basic validation of the relayer statistics
+func (r RelayerStats) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Relayer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+	}
+
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port identifier: %s", r.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel identifier: %s", r.ChannelId)
+	}
+
+	for _, fees := range []sdk.Coins{r.RecvFeesPaid, r.AckFeesPaid, r.TimeoutFeesPaid} {
+		if err := fees.Validate(); err != nil {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -257,50 +258,371 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// Params defines the set of ICS29 fee middleware parameters.
+type Params struct {
+	// the duration (in nanoseconds) for which the receive fees paid to the forward relayer address encoded in the
+	// acknowledgement by the counterparty are held in escrow, during which they may be disputed by the authority.
+	// Receive fees are paid out when the acknowledgement is received if zero.
+	RecvFeeDisputeWindow uint64 `protobuf:"varint,1,opt,name=recv_fee_dispute_window,json=recvFeeDisputeWindow,proto3" json:"recv_fee_dispute_window,omitempty"`
+	// the maximum number of held receive fees paid out at the beginning of each block.
+	MaxReleasedPerBlock uint64 `protobuf:"varint,2,opt,name=max_released_per_block,json=maxReleasedPerBlock,proto3" json:"max_released_per_block,omitempty"`
+	// the fraction of every fee paid to a relayer which is sent to the protocol fee recipient, must be less than one.
+	ProtocolFeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"protocol_fee_percentage"`
+	// the name of the module account the protocol fees are sent to. If set to the distribution module, the protocol
+	// fees are used to fund the community pool.
+	ProtocolFeeRecipient string `protobuf:"bytes,4,opt,name=protocol_fee_recipient,json=protocolFeeRecipient,proto3" json:"protocol_fee_recipient,omitempty"`
+	// whether the fees paid to and the packets relayed by each relayer are accounted for per channel.
+	RelayerStatsEnabled bool `protobuf:"varint,5,opt,name=relayer_stats_enabled,json=relayerStatsEnabled,proto3" json:"relayer_stats_enabled,omitempty"`
+	// the denomination all packet fees must be paid in, e.g. the native fee denomination of the chain. Packet fees may
+	// be paid in any denomination if empty.
+	FeeDenom string `protobuf:"bytes,6,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// the maximum number of relayers whose statistics are stored per channel. The statistics of a relayer are only stored
+	// once a fee has been paid to the relayer, relayers beyond the maximum are not accounted for.
+	MaxRelayerStatsPerChannel uint64 `protobuf:"varint,7,opt,name=max_relayer_stats_per_channel,json=maxRelayerStatsPerChannel,proto3" json:"max_relayer_stats_per_channel,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRecvFeeDisputeWindow() uint64 {
+	if m != nil {
+		return m.RecvFeeDisputeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReleasedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxReleasedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeRecipient() string {
+	if m != nil {
+		return m.ProtocolFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetRelayerStatsEnabled() bool {
+	if m != nil {
+		return m.RelayerStatsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRelayerStatsPerChannel() uint64 {
+	if m != nil {
+		return m.MaxRelayerStatsPerChannel
+	}
+	return 0
+}
+
+// RelayerStats defines the fees paid to a relayer address and the number of incentivized packets relayed by the relayer
+// on a channel
+type RelayerStats struct {
+	// the relayer address the fees are paid to
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// unique port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the total receive fees paid to the relayer
+	RecvFeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=recv_fees_paid,json=recvFeesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fees_paid"`
+	// the total acknowledgement fees paid to the relayer
+	AckFeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=ack_fees_paid,json=ackFeesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fees_paid"`
+	// the total timeout fees paid to the relayer
+	TimeoutFeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=timeout_fees_paid,json=timeoutFeesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fees_paid"`
+	// the number of incentivized packets relayed to the counterparty by the relayer
+	PacketsRelayed uint64 `protobuf:"varint,7,opt,name=packets_relayed,json=packetsRelayed,proto3" json:"packets_relayed,omitempty"`
+	// the number of incentivized packets acknowledged by the relayer
+	PacketsAcknowledged uint64 `protobuf:"varint,8,opt,name=packets_acknowledged,json=packetsAcknowledged,proto3" json:"packets_acknowledged,omitempty"`
+	// the number of incentivized packets timed out by the relayer
+	PacketsTimedOut uint64 `protobuf:"varint,9,opt,name=packets_timed_out,json=packetsTimedOut,proto3" json:"packets_timed_out,omitempty"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetRecvFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFeesPaid
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetAckFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFeesPaid
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetTimeoutFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFeesPaid
+	}
+	return nil
+}
+
+func (m *RelayerStats) GetPacketsRelayed() uint64 {
+	if m != nil {
+		return m.PacketsRelayed
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetPacketsAcknowledged() uint64 {
+	if m != nil {
+		return m.PacketsAcknowledged
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetPacketsTimedOut() uint64 {
+	if m != nil {
+		return m.PacketsTimedOut
+	}
+	return 0
+}
+
+// HeldRecvFees defines the receive fees of an acknowledged packet which are held in escrow until the dispute window
+// has elapsed.
+type HeldRecvFees struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types1.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the forward relayer address encoded in the acknowledgement by the counterparty
+	ForwardRelayer string `protobuf:"bytes,2,opt,name=forward_relayer,json=forwardRelayer,proto3" json:"forward_relayer,omitempty"`
+	// list of packet fees containing the held receive fees and the addresses they are refunded to if disputed
+	PacketFees []PacketFee `protobuf:"bytes,3,rep,name=packet_fees,json=packetFees,proto3" json:"packet_fees"`
+	// the time (in nanoseconds since the unix epoch) at which the receive fees are paid out to the forward relayer
+	ReleaseTime uint64 `protobuf:"varint,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+}
+
+func (m *HeldRecvFees) Reset()         { *m = HeldRecvFees{} }
+func (m *HeldRecvFees) String() string { return proto.CompactTextString(m) }
+func (*HeldRecvFees) ProtoMessage()    {}
+func (*HeldRecvFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *HeldRecvFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldRecvFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldRecvFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldRecvFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldRecvFees.Merge(m, src)
+}
+func (m *HeldRecvFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldRecvFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldRecvFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldRecvFees proto.InternalMessageInfo
+
+func (m *HeldRecvFees) GetPacketId() types1.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types1.PacketId{}
+}
+
+func (m *HeldRecvFees) GetForwardRelayer() string {
+	if m != nil {
+		return m.ForwardRelayer
+	}
+	return ""
+}
+
+func (m *HeldRecvFees) GetPacketFees() []PacketFee {
+	if m != nil {
+		return m.PacketFees
+	}
+	return nil
+}
+
+func (m *HeldRecvFees) GetReleaseTime() uint64 {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
+	proto.RegisterType((*RelayerStats)(nil), "ibc.applications.fee.v1.RelayerStats")
+	proto.RegisterType((*HeldRecvFees)(nil), "ibc.applications.fee.v1.HeldRecvFees")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x12, 0x30, 0xd1, 0x20, 0x45, 0xca, 0x04, 0xca, 0x82, 0xc4, 0x12,
-	0x9d, 0xcf, 0x2f, 0xee, 0x29, 0xb6, 0xcf, 0xf2, 0xd9, 0x41, 0x19, 0x58, 0xf8, 0x04, 0xac, 0xb0,
-	0xb2, 0x31, 0xf5, 0x63, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x19, 0xfa, 0x05, 0xf8, 0x00, 0xe8, 0x9d,
-	0x0f, 0xab, 0x2a, 0xea, 0xc2, 0xd0, 0xc5, 0x77, 0xef, 0xde, 0xbb, 0xf7, 0xfb, 0xdf, 0xbb, 0xe7,
-	0x23, 0x0f, 0x65, 0x20, 0x18, 0xcf, 0xb2, 0x58, 0x0a, 0x5e, 0x48, 0x95, 0x6a, 0x36, 0x03, 0x60,
-	0x8b, 0x3e, 0x0e, 0x34, 0xcb, 0x55, 0xa1, 0xdc, 0x1d, 0x19, 0x08, 0x7a, 0x31, 0x84, 0xa2, 0x6f,
-	0xd1, 0xdf, 0xbb, 0xc3, 0x13, 0x99, 0x2a, 0x66, 0xbe, 0x55, 0xec, 0x9e, 0x27, 0x94, 0x4e, 0x94,
-	0x66, 0x01, 0xd7, 0x98, 0x25, 0x80, 0x82, 0xf7, 0x99, 0x50, 0x32, 0xb5, 0xfe, 0xed, 0x48, 0x45,
-	0xca, 0x4c, 0x19, 0xce, 0xec, 0xaa, 0x11, 0x21, 0x54, 0x0e, 0x4c, 0x1c, 0xf3, 0x34, 0x85, 0x18,
-	0x05, 0xd8, 0xa9, 0x0d, 0xd9, 0xb1, 0x89, 0x13, 0x1d, 0xa1, 0x33, 0xd1, 0x51, 0xe5, 0xf0, 0x7f,
-	0x37, 0x49, 0x6b, 0x04, 0xe0, 0xbe, 0x27, 0x5b, 0x39, 0x88, 0xc5, 0x74, 0x06, 0xb0, 0xeb, 0x1c,
-	0xb4, 0xba, 0x9d, 0xc1, 0x3d, 0x5a, 0xed, 0xa1, 0x28, 0x86, 0x5a, 0x31, 0xf4, 0x95, 0x92, 0xe9,
-	0xf0, 0xe8, 0xf4, 0xc7, 0x7e, 0xe3, 0xdb, 0xcf, 0xfd, 0x6e, 0x24, 0x8b, 0xe3, 0x32, 0xa0, 0x42,
-	0x25, 0xcc, 0x02, 0xaa, 0xa1, 0xa7, 0xc3, 0x39, 0x2b, 0x96, 0x19, 0x68, 0xb3, 0x41, 0x7f, 0x39,
-	0x3f, 0x39, 0xbc, 0x19, 0x43, 0xc4, 0xc5, 0x72, 0x8a, 0xc7, 0xd1, 0x93, 0x4d, 0xa4, 0x21, 0xb8,
-	0x24, 0x9b, 0x5c, 0xcc, 0x0d, 0xb7, 0x79, 0x0d, 0xdc, 0x0d, 0x2e, 0xe6, 0x88, 0xfd, 0x40, 0x3a,
-	0x85, 0x4c, 0x40, 0x95, 0x85, 0x41, 0xb7, 0xae, 0x01, 0x4d, 0x2c, 0x70, 0x04, 0xe0, 0x7f, 0x76,
-	0x48, 0xfb, 0x0d, 0x17, 0x73, 0x40, 0xcb, 0x7d, 0x42, 0x5a, 0x55, 0xdd, 0x9d, 0x6e, 0x67, 0x70,
-	0x9f, 0x5e, 0xd1, 0x30, 0x74, 0x04, 0x30, 0xbc, 0x81, 0x3a, 0x26, 0x18, 0xee, 0x3e, 0x22, 0xb7,
-	0x73, 0x98, 0x95, 0x69, 0x38, 0xe5, 0x61, 0x98, 0x83, 0xd6, 0xbb, 0xcd, 0x03, 0xa7, 0xdb, 0x9e,
-	0xdc, 0xaa, 0x56, 0x8f, 0xaa, 0x45, 0x77, 0x0f, 0x6f, 0x36, 0xe6, 0x4b, 0xc8, 0xb5, 0x39, 0x66,
-	0x7b, 0x52, 0xdb, 0x2f, 0xee, 0x7e, 0x3c, 0x3f, 0x39, 0xbc, 0x94, 0xc5, 0x7f, 0x4b, 0x48, 0x2d,
-	0x4d, 0xbb, 0x63, 0xd2, 0xc9, 0x8c, 0x85, 0x75, 0xd2, 0xb6, 0x37, 0xfc, 0x2b, 0x35, 0xd6, 0x3b,
-	0xad, 0x52, 0x92, 0xd5, 0xa9, 0xfc, 0xaf, 0x0e, 0xd9, 0x1e, 0x87, 0x90, 0x16, 0x72, 0x26, 0x21,
-	0xbc, 0xc0, 0x78, 0x49, 0xda, 0x96, 0x21, 0x43, 0x5b, 0x85, 0x07, 0x86, 0x80, 0x4d, 0x4d, 0xff,
-	0x76, 0x72, 0x9d, 0x7d, 0x1c, 0xda, 0xe4, 0x5b, 0x99, 0xb5, 0x2f, 0xab, 0x6c, 0xfe, 0xbf, 0xca,
-	0xe1, 0xeb, 0xd3, 0x95, 0xe7, 0x9c, 0xad, 0x3c, 0xe7, 0xd7, 0xca, 0x73, 0x3e, 0xad, 0xbd, 0xc6,
-	0xd9, 0xda, 0x6b, 0x7c, 0x5f, 0x7b, 0x8d, 0x77, 0x4f, 0xff, 0xbd, 0x7b, 0x19, 0x88, 0x5e, 0xa4,
-	0xd8, 0xe2, 0x19, 0x4b, 0x54, 0x58, 0xc6, 0xa0, 0xf1, 0x31, 0xd0, 0x6c, 0xf0, 0xbc, 0x87, 0xef,
-	0x80, 0x69, 0x87, 0x60, 0xc3, 0xfc, 0x69, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x53,
-	0x6e, 0x63, 0x2c, 0x04, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x2d, 0x45, 0xb6, 0x4e, 0x8a, 0x0d, 0x9f, 0x95, 0x88, 0x71, 0x6a, 0xd9, 0x51, 0x51,
+	0x44, 0x30, 0x60, 0x12, 0x76, 0x12, 0xa0, 0xed, 0x14, 0x2b, 0xae, 0x51, 0x01, 0x05, 0x22, 0xb0,
+	0x05, 0x02, 0xb4, 0x03, 0x71, 0xba, 0x7b, 0x92, 0x0f, 0x22, 0x79, 0x04, 0x8f, 0xb2, 0xe2, 0xa1,
+	0x40, 0x91, 0xa9, 0x63, 0xd7, 0x66, 0xed, 0xd6, 0x29, 0x7f, 0x46, 0xc6, 0x8c, 0x45, 0x87, 0xb4,
+	0xb0, 0x07, 0xff, 0x03, 0xdd, 0xba, 0x14, 0xf7, 0x83, 0xaa, 0xea, 0x22, 0x4b, 0x1b, 0x68, 0x91,
+	0x78, 0xef, 0xbb, 0x7b, 0xdf, 0xc7, 0xf7, 0xbe, 0x7b, 0x20, 0xba, 0xc7, 0x07, 0xd4, 0x27, 0x69,
+	0x1a, 0x71, 0x4a, 0x72, 0x2e, 0x12, 0xe9, 0x0f, 0x01, 0xfc, 0xb3, 0x03, 0xf5, 0xe7, 0xa5, 0x99,
+	0xc8, 0x05, 0x6e, 0xf2, 0x01, 0xf5, 0xe6, 0xb7, 0x78, 0x0a, 0x3b, 0x3b, 0xd8, 0xda, 0x20, 0x31,
+	0x4f, 0x84, 0xaf, 0x7f, 0xcd, 0xde, 0xad, 0x16, 0x15, 0x32, 0x16, 0xd2, 0x1f, 0x10, 0xa9, 0xb2,
+	0x0c, 0x20, 0x27, 0x07, 0x3e, 0x15, 0x3c, 0xb1, 0x78, 0x63, 0x24, 0x46, 0x42, 0x3f, 0xfa, 0xea,
+	0xc9, 0x46, 0xb5, 0x08, 0x2a, 0x32, 0xf0, 0xe9, 0x29, 0x49, 0x12, 0x88, 0x94, 0x00, 0xfb, 0x68,
+	0xb7, 0x34, 0x6d, 0xe2, 0x58, 0x8e, 0x14, 0x18, 0xcb, 0x91, 0x01, 0xda, 0x7f, 0x2c, 0xa3, 0xd2,
+	0x09, 0x00, 0x9e, 0xa2, 0xd5, 0x0c, 0xe8, 0x59, 0x38, 0x04, 0x70, 0x9d, 0xdd, 0x52, 0xa7, 0x76,
+	0x78, 0xc7, 0x33, 0x67, 0x3c, 0x25, 0xc6, 0xb3, 0x62, 0xbc, 0x27, 0x82, 0x27, 0xdd, 0xa3, 0xd7,
+	0x6f, 0x77, 0x96, 0x7e, 0xfe, 0x6d, 0xa7, 0x33, 0xe2, 0xf9, 0xe9, 0x64, 0xe0, 0x51, 0x11, 0xfb,
+	0x96, 0xc0, 0xfc, 0xed, 0x4b, 0x36, 0xf6, 0xf3, 0xf3, 0x14, 0xa4, 0x3e, 0x20, 0x5f, 0x5e, 0xbd,
+	0xda, 0xab, 0x47, 0x30, 0x22, 0xf4, 0x3c, 0x54, 0xaf, 0x23, 0x83, 0x15, 0xc5, 0xa6, 0x88, 0x27,
+	0x68, 0x85, 0xd0, 0xb1, 0xe6, 0x5d, 0x5e, 0x00, 0x6f, 0x85, 0xd0, 0xb1, 0xa2, 0xfd, 0x16, 0xd5,
+	0x72, 0x1e, 0x83, 0x98, 0xe4, 0x9a, 0xba, 0xb4, 0x00, 0x6a, 0x64, 0x09, 0x4f, 0x00, 0xda, 0x3f,
+	0x3a, 0xa8, 0xda, 0x27, 0x74, 0x0c, 0x6a, 0x85, 0x1f, 0xa2, 0x92, 0xa9, 0xbb, 0xd3, 0xa9, 0x1d,
+	0x7e, 0xe0, 0xbd, 0xc3, 0x30, 0xde, 0x09, 0x40, 0xb7, 0xac, 0x74, 0x04, 0x6a, 0x3b, 0xfe, 0x08,
+	0xad, 0x65, 0x30, 0x9c, 0x24, 0x2c, 0x24, 0x8c, 0x65, 0x20, 0xa5, 0xbb, 0xbc, 0xeb, 0x74, 0xaa,
+	0xc1, 0x4d, 0x13, 0x3d, 0x32, 0x41, 0xbc, 0xa5, 0x3a, 0x1b, 0x91, 0x73, 0xc8, 0xa4, 0x7e, 0xcd,
+	0x6a, 0x30, 0x5b, 0x7f, 0xba, 0xf9, 0xe2, 0xea, 0xd5, 0xde, 0xb5, 0x2c, 0xed, 0x67, 0x08, 0xcd,
+	0xa4, 0x49, 0xdc, 0x43, 0xb5, 0x54, 0xaf, 0x54, 0x9d, 0xa4, 0xf5, 0x46, 0xfb, 0x9d, 0x1a, 0x67,
+	0x27, 0xad, 0x52, 0x94, 0xce, 0x52, 0xb5, 0x7f, 0x72, 0x50, 0xa3, 0xc7, 0x20, 0xc9, 0xf9, 0x90,
+	0x03, 0x9b, 0xe3, 0x78, 0x8c, 0xaa, 0x96, 0x83, 0x33, 0x5b, 0x85, 0x6d, 0xcd, 0xa0, 0x4c, 0xed,
+	0x15, 0x4e, 0x9e, 0x65, 0xef, 0x31, 0x9b, 0x7c, 0x35, 0xb5, 0xeb, 0xeb, 0x2a, 0x97, 0xff, 0x87,
+	0xca, 0x97, 0x25, 0x54, 0xe9, 0x93, 0x8c, 0xc4, 0x12, 0x3f, 0x42, 0xcd, 0xe2, 0x52, 0x84, 0x8c,
+	0xcb, 0x74, 0x92, 0x43, 0x38, 0xe5, 0x09, 0x13, 0x53, 0xad, 0xb2, 0x1c, 0x34, 0xac, 0x8b, 0x8f,
+	0x0d, 0xf8, 0x4c, 0x63, 0xf8, 0x01, 0xba, 0x1d, 0x93, 0xe7, 0x61, 0x06, 0x11, 0x10, 0x09, 0x2c,
+	0x4c, 0x21, 0x0b, 0x07, 0x91, 0xa0, 0x63, 0xdd, 0xa0, 0x72, 0xb0, 0x19, 0x93, 0xe7, 0x81, 0x05,
+	0xfb, 0x90, 0x75, 0x15, 0x84, 0xbf, 0x41, 0x4d, 0x7d, 0x23, 0xa9, 0x88, 0x34, 0x5f, 0x0a, 0x19,
+	0x85, 0x24, 0x27, 0x23, 0x65, 0x4e, 0xa7, 0x53, 0xed, 0x7e, 0xa8, 0x94, 0xfe, 0xfa, 0x76, 0xe7,
+	0xae, 0xf1, 0x9b, 0x64, 0x63, 0x8f, 0x0b, 0x3f, 0x26, 0xf9, 0xa9, 0xf7, 0x85, 0xb6, 0xd9, 0x31,
+	0xd0, 0xe0, 0x56, 0x91, 0xe3, 0x04, 0xa0, 0x3f, 0xcb, 0x80, 0x1f, 0xa2, 0xdb, 0xff, 0x48, 0x9e,
+	0x01, 0xe5, 0x29, 0x87, 0x24, 0x77, 0xcb, 0xda, 0x32, 0x8d, 0xb9, 0x63, 0x41, 0x81, 0xe1, 0x43,
+	0x74, 0xcb, 0x3a, 0x25, 0x94, 0x39, 0xc9, 0x65, 0x08, 0x09, 0x19, 0x44, 0xc0, 0xdc, 0x1b, 0xbb,
+	0x4e, 0x67, 0x35, 0xd8, 0xb4, 0xe0, 0x97, 0x0a, 0xfb, 0xcc, 0x40, 0xf8, 0x2e, 0xaa, 0xea, 0x6a,
+	0x41, 0x22, 0x62, 0xb7, 0xa2, 0x93, 0xaf, 0x0e, 0x01, 0x8e, 0xd5, 0x1a, 0x3f, 0x46, 0xdb, 0xb6,
+	0x30, 0x73, 0x49, 0x55, 0x75, 0x6c, 0x8b, 0xdd, 0x15, 0x5d, 0x9f, 0x3b, 0xa6, 0x3e, 0xb3, 0xdc,
+	0x7d, 0xc8, 0x9e, 0x98, 0x0d, 0xed, 0x3f, 0xcb, 0xa8, 0x3e, 0x0f, 0x61, 0x17, 0xad, 0xd8, 0x74,
+	0xba, 0x25, 0xd5, 0xa0, 0x58, 0xe2, 0x26, 0x5a, 0x49, 0x45, 0xa6, 0x2d, 0x65, 0xee, 0x45, 0x45,
+	0x2d, 0x7b, 0x0c, 0x6f, 0x23, 0x64, 0xf9, 0x14, 0xa6, 0x8b, 0x1b, 0x54, 0x6d, 0xa4, 0xc7, 0xf0,
+	0x0b, 0x07, 0xad, 0x15, 0x5d, 0x97, 0x61, 0x4a, 0x38, 0x73, 0xcb, 0x0b, 0x98, 0x0e, 0x75, 0x6b,
+	0x25, 0xd9, 0x27, 0x9c, 0xe1, 0xef, 0x1c, 0x74, 0xd3, 0x8e, 0x45, 0xab, 0xe1, 0xc6, 0x02, 0x34,
+	0xd4, 0xcc, 0x70, 0x34, 0x12, 0xbe, 0x77, 0xd0, 0xc6, 0xdc, 0x88, 0xb4, 0x32, 0x2a, 0x0b, 0x90,
+	0xb1, 0xfe, 0xf7, 0xa0, 0x34, 0x52, 0xee, 0xa3, 0x75, 0x73, 0x41, 0xa5, 0xf5, 0x0e, 0xb3, 0x4e,
+	0x59, 0xb3, 0x61, 0x63, 0x09, 0x86, 0x0f, 0x50, 0xa3, 0xd8, 0x48, 0xe8, 0x38, 0x11, 0xd3, 0x08,
+	0xd8, 0x08, 0x98, 0xbb, 0x6a, 0xee, 0x9d, 0xc5, 0x8e, 0xe6, 0x20, 0xbc, 0x87, 0x36, 0x8a, 0x23,
+	0x8a, 0x96, 0x85, 0x62, 0x92, 0xbb, 0x55, 0xbd, 0xbf, 0x20, 0xfd, 0x4a, 0xc5, 0x9f, 0x4e, 0xf2,
+	0xf6, 0x95, 0x83, 0xea, 0x9f, 0x43, 0xc4, 0x02, 0xdb, 0xaa, 0xf7, 0x30, 0xb8, 0xee, 0xa3, 0xf5,
+	0xa1, 0xc8, 0xa6, 0x24, 0x63, 0xc5, 0xb5, 0xb0, 0x6e, 0x5d, 0xb3, 0x61, 0xeb, 0xf6, 0xeb, 0x13,
+	0xae, 0xf4, 0xdf, 0x27, 0x1c, 0xbe, 0x87, 0xea, 0x76, 0x36, 0xe9, 0x57, 0xd6, 0x33, 0xa0, 0x1c,
+	0xd4, 0x6c, 0x4c, 0xbd, 0x6d, 0xf7, 0xe9, 0xeb, 0x8b, 0x96, 0xf3, 0xe6, 0xa2, 0xe5, 0xfc, 0x7e,
+	0xd1, 0x72, 0x7e, 0xb8, 0x6c, 0x2d, 0xbd, 0xb9, 0x6c, 0x2d, 0xfd, 0x72, 0xd9, 0x5a, 0xfa, 0xfa,
+	0xd1, 0xbf, 0xfb, 0xca, 0x07, 0x74, 0x7f, 0x24, 0xfc, 0xb3, 0x8f, 0xfd, 0x58, 0xb0, 0x49, 0x04,
+	0x52, 0x7d, 0x11, 0x49, 0xff, 0xf0, 0x93, 0x7d, 0xf5, 0x31, 0xa4, 0x5b, 0x3d, 0xa8, 0xe8, 0x09,
+	0xf3, 0xe0, 0xaf, 0x01, 0x00, 0x6f, 0x98, 0xc8, 0x98, 0x31, 0x09, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -501,94 +823,429 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFee(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Fee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecvFee) > 0 {
-		for _, e := range m.RecvFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if m.MaxRelayerStatsPerChannel != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxRelayerStatsPerChannel))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.AckFee) > 0 {
-		for _, e := range m.AckFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.TimeoutFee) > 0 {
-		for _, e := range m.TimeoutFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	if m.RelayerStatsEnabled {
+		i--
+		if m.RelayerStatsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *PacketFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovFee(uint64(l))
-	l = len(m.RefundAddress)
-	if l > 0 {
-		n += 1 + l + sovFee(uint64(l))
+	if len(m.ProtocolFeeRecipient) > 0 {
+		i -= len(m.ProtocolFeeRecipient)
+		copy(dAtA[i:], m.ProtocolFeeRecipient)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ProtocolFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovFee(uint64(l))
+	{
+		size := m.ProtocolFeePercentage.Size()
+		i -= size
+		if _, err := m.ProtocolFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *PacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxReleasedPerBlock != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxReleasedPerBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if m.RecvFeeDisputeWindow != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RecvFeeDisputeWindow))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IdentifiedPacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketsTimedOut != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.PacketsTimedOut))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PacketsAcknowledged != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.PacketsAcknowledged))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PacketsRelayed != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.PacketsRelayed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TimeoutFeesPaid) > 0 {
+		for iNdEx := len(m.TimeoutFeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AckFeesPaid) > 0 {
+		for iNdEx := len(m.AckFeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecvFeesPaid) > 0 {
+		for iNdEx := len(m.RecvFeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldRecvFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldRecvFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldRecvFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseTime != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ReleaseTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ForwardRelayer) > 0 {
+		i -= len(m.ForwardRelayer)
+		copy(dAtA[i:], m.ForwardRelayer)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ForwardRelayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedPacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecvFeeDisputeWindow != 0 {
+		n += 1 + sovFee(uint64(m.RecvFeeDisputeWindow))
+	}
+	if m.MaxReleasedPerBlock != 0 {
+		n += 1 + sovFee(uint64(m.MaxReleasedPerBlock))
+	}
+	l = m.ProtocolFeePercentage.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.ProtocolFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.RelayerStatsEnabled {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.MaxRelayerStatsPerChannel != 0 {
+		n += 1 + sovFee(uint64(m.MaxRelayerStatsPerChannel))
+	}
+	return n
+}
+
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.RecvFeesPaid) > 0 {
+		for _, e := range m.RecvFeesPaid {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFeesPaid) > 0 {
+		for _, e := range m.AckFeesPaid {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFeesPaid) > 0 {
+		for _, e := range m.TimeoutFeesPaid {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.PacketsRelayed != 0 {
+		n += 1 + sovFee(uint64(m.PacketsRelayed))
+	}
+	if m.PacketsAcknowledged != 0 {
+		n += 1 + sovFee(uint64(m.PacketsAcknowledged))
+	}
+	if m.PacketsTimedOut != 0 {
+		n += 1 + sovFee(uint64(m.PacketsTimedOut))
+	}
+	return n
+}
+
+func (m *HeldRecvFees) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
 	l = m.PacketId.Size()
 	n += 1 + l + sovFee(uint64(l))
+	l = len(m.ForwardRelayer)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
 	if len(m.PacketFees) > 0 {
 		for _, e := range m.PacketFees {
 			l = e.Size()
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
+	if m.ReleaseTime != 0 {
+		n += 1 + sovFee(uint64(m.ReleaseTime))
+	}
 	return n
 }
 
@@ -660,12 +1317,602 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFeeDisputeWindow", wireType)
+			}
+			m.RecvFeeDisputeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvFeeDisputeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleasedPerBlock", wireType)
+			}
+			m.MaxReleasedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReleasedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStatsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RelayerStatsEnabled = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -675,31 +1922,29 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFee = append(m.AckFee, types.Coin{})
-			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelayerStatsPerChannel", wireType)
 			}
-			var msglen int
+			m.MaxRelayerStatsPerChannel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -709,26 +1954,11 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxRelayerStatsPerChannel |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFee
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFee
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
-			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -750,7 +1980,7 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFee) Unmarshal(dAtA []byte) error {
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -773,17 +2003,17 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -793,28 +2023,27 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -842,11 +2071,11 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -874,61 +2103,79 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFee(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFeesPaid", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthFee
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PacketFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFee
+			m.RecvFeesPaid = append(m.RecvFeesPaid, types.Coin{})
+			if err := m.RecvFeesPaid[len(m.RecvFeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AckFeesPaid = append(m.AckFeesPaid, types.Coin{})
+			if err := m.AckFeesPaid[len(m.AckFeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFeesPaid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -955,11 +2202,68 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeoutFeesPaid = append(m.TimeoutFeesPaid, types.Coin{})
+			if err := m.TimeoutFeesPaid[len(m.TimeoutFeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsRelayed", wireType)
+			}
+			m.PacketsRelayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsRelayed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsAcknowledged", wireType)
+			}
+			m.PacketsAcknowledged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsAcknowledged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsTimedOut", wireType)
+			}
+			m.PacketsTimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsTimedOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -981,7 +2285,7 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+func (m *HeldRecvFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1004,10 +2308,10 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeldRecvFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeldRecvFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1044,6 +2348,38 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
 			}
@@ -1077,6 +2413,25 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			m.ReleaseTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -18,6 +18,11 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	sendSideFeeEnabledChannels []FeeEnabledChannel,
+	params Params,
+	heldRecvFees []HeldRecvFees,
+	totalProtocolFees sdk.Coins,
+	relayerStats []RelayerStats,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +30,11 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		SendSideFeeEnabledChannels:   sendSideFeeEnabledChannels,
+		Params:                       params,
+		HeldRecvFees:                 heldRecvFees,
+		TotalProtocolFees:            totalProtocolFees,
+		RelayerStats:                 relayerStats,
 	}
 }
 
@@ -36,6 +46,11 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		SendSideFeeEnabledChannels:   []FeeEnabledChannel{},
+		Params:                       DefaultParams(),
+		HeldRecvFees:                 []HeldRecvFees{},
+		TotalProtocolFees:            sdk.Coins{},
+		RelayerStats:                 []RelayerStats{},
 	}
 }
 
@@ -65,6 +80,16 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate SendSideFeeEnabledChannels
+	for _, feeCh := range gs.SendSideFeeEnabledChannels {
+		if err := host.PortIdentifierValidator(feeCh.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid source port ID")
+		}
+		if err := host.ChannelIdentifierValidator(feeCh.ChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid source channel ID")
+		}
+	}
+
 	// Validate RegisteredPayees
 	for _, registeredPayee := range gs.RegisteredPayees {
 		if registeredPayee.Relayer == registeredPayee.Payee {
@@ -110,5 +135,37 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Validate HeldRecvFees
+	for _, heldRecvFees := range gs.HeldRecvFees {
+		if _, err := sdk.AccAddressFromBech32(heldRecvFees.ForwardRelayer); err != nil {
+			return errorsmod.Wrap(err, "failed to convert forward relayer address into sdk.AccAddress")
+		}
+
+		if err := heldRecvFees.PacketId.Validate(); err != nil {
+			return err
+		}
+
+		for _, packetFee := range heldRecvFees.PacketFees {
+			if err := packetFee.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := gs.TotalProtocolFees.Validate(); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+	}
+
+	// Validate RelayerStats
+	for _, relayerStats := range gs.RelayerStats {
+		if err := relayerStats.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
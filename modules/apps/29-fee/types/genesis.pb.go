@@ -5,9 +5,11 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	types "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	types1 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -36,6 +38,16 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// list of send side fee enabled channels
+	SendSideFeeEnabledChannels []FeeEnabledChannel `protobuf:"bytes,6,rep,name=send_side_fee_enabled_channels,json=sendSideFeeEnabledChannels,proto3" json:"send_side_fee_enabled_channels"`
+	// the ICS29 fee middleware parameters
+	Params Params `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
+	// list of receive fees held in escrow until the dispute window has elapsed
+	HeldRecvFees []HeldRecvFees `protobuf:"bytes,8,rep,name=held_recv_fees,json=heldRecvFees,proto3" json:"held_recv_fees"`
+	// the total protocol fees sent to the protocol fee recipient
+	TotalProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=total_protocol_fees,json=totalProtocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_protocol_fees"`
+	// list of relayer statistics per relayer and channel
+	RelayerStats []RelayerStats `protobuf:"bytes,10,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +118,41 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetSendSideFeeEnabledChannels() []FeeEnabledChannel {
+	if m != nil {
+		return m.SendSideFeeEnabledChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetHeldRecvFees() []HeldRecvFees {
+	if m != nil {
+		return m.HeldRecvFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalProtocolFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -295,7 +342,7 @@ type ForwardRelayerAddress struct {
 	// the forward relayer address
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// unique packet identifier comprised of the channel ID, port ID and sequence
-	PacketId types.PacketId `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	PacketId types1.PacketId `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
 }
 
 func (m *ForwardRelayerAddress) Reset()         { *m = ForwardRelayerAddress{} }
@@ -338,11 +385,11 @@ func (m *ForwardRelayerAddress) GetAddress() string {
 	return ""
 }
 
-func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
+func (m *ForwardRelayerAddress) GetPacketId() types1.PacketId {
 	if m != nil {
 		return m.PacketId
 	}
-	return types.PacketId{}
+	return types1.PacketId{}
 }
 
 func init() {
@@ -358,42 +405,53 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x48, 0x70, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x1e, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xad, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x34, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xfd, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x17, 0xf8,
-	0x93, 0x88, 0x7c, 0x04, 0x75, 0x0c, 0x20, 0x9f, 0x6f, 0x9c, 0xfd, 0xb8, 0x53, 0x1b, 0xde, 0xf8,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0xf7, 0x2b, 0x25, 0x8e, 0x01, 0x5e, 0x14, 0x9c, 0xa3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x0b, 0xc6, 0x89, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x72, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0x87, 0xff, 0x20, 0x75, 0xb4, 0xc4, 0x5e, 0x96, 0xbd, 0x2d,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x2a, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe2, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0xad, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xce, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0x9b, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0xae, 0xe5, 0x65, 0xfc, 0xfa, 0x6c, 0xee, 0x39, 0xe7, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xbe, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0xa3, 0x94, 0xa9, 0xd3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0xe3, 0x60, 0xcc, 0xe9, 0x64, 0x04, 0x52, 0xff, 0x1f, 0x32, 0xe8, 0x3f, 0xe9, 0xea, 0xaf, 0x43,
-	0xcd, 0x72, 0x90, 0xf1, 0x96, 0xf9, 0x17, 0x1e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x6c,
-	0xb0, 0x1e, 0xb5, 0x04, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0xfb, 0x93, 0x34, 0xd3, 0xd2, 0x36, 0x43, 0x51, 0x4d, 0xa1, 0x6e, 0x89, 0x54, 0x29,
+	0x42, 0x8a, 0x4d, 0x03, 0x48, 0xb0, 0x40, 0x82, 0x56, 0x14, 0x22, 0x16, 0x84, 0x74, 0x07, 0x48,
+	0x66, 0xec, 0x39, 0x4e, 0x46, 0x75, 0x3c, 0xd6, 0xcc, 0x34, 0x28, 0x62, 0xc3, 0x86, 0x3d, 0xcf,
+	0xc1, 0x93, 0x74, 0xd9, 0x25, 0x2b, 0x40, 0xed, 0x86, 0xc7, 0xb8, 0x9a, 0xf1, 0xa4, 0xd7, 0x4d,
+	0xea, 0x7b, 0xaf, 0xba, 0xf2, 0xcc, 0xf9, 0xf9, 0xbe, 0x33, 0x73, 0xce, 0x37, 0x46, 0x27, 0x2c,
+	0x8a, 0x03, 0x92, 0xe7, 0x29, 0x8b, 0x89, 0x62, 0x3c, 0x93, 0x41, 0x02, 0x10, 0x4c, 0x4f, 0x83,
+	0x11, 0x64, 0x20, 0x99, 0xf4, 0x73, 0xc1, 0x15, 0xc7, 0xfb, 0x2c, 0x8a, 0xfd, 0x72, 0x98, 0x9f,
+	0x00, 0xf8, 0xd3, 0xd3, 0x83, 0xbd, 0x11, 0x1f, 0x71, 0x13, 0x13, 0xe8, 0x55, 0x11, 0x7e, 0xe0,
+	0xc5, 0x5c, 0x4e, 0xb8, 0x0c, 0x22, 0x22, 0x35, 0x58, 0x04, 0x8a, 0x9c, 0x06, 0x31, 0x67, 0x99,
+	0xf5, 0x7f, 0x50, 0xc5, 0xaa, 0x51, 0x4b, 0x21, 0x31, 0x17, 0x10, 0xc4, 0x63, 0x92, 0x65, 0x90,
+	0x6a, 0xb7, 0x5d, 0x16, 0x21, 0xed, 0xff, 0x1b, 0x68, 0xeb, 0x9b, 0xa2, 0xcc, 0x4b, 0x45, 0x14,
+	0xe0, 0x9f, 0xd1, 0x0e, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61, 0x02, 0x20, 0x5d, 0xe7, 0x78,
+	0xb5, 0xb3, 0xd9, 0xeb, 0xfa, 0x15, 0xf5, 0xfb, 0xfd, 0x87, 0xf8, 0x01, 0x89, 0xaf, 0x40, 0x5d,
+	0x00, 0xc8, 0xb3, 0xb5, 0x9b, 0x7f, 0x8e, 0x6a, 0xc3, 0xed, 0x97, 0x58, 0xda, 0x8a, 0x23, 0xb4,
+	0x97, 0x00, 0x84, 0x90, 0x91, 0x28, 0x05, 0x1a, 0xda, 0x5a, 0xa4, 0xbb, 0x62, 0x28, 0x3e, 0xac,
+	0xa4, 0xb8, 0x00, 0xf8, 0xba, 0xc8, 0x39, 0x2f, 0x52, 0x2c, 0x3e, 0x4e, 0x16, 0x1d, 0x12, 0xff,
+	0x84, 0x5a, 0x02, 0x46, 0x4c, 0x2a, 0x10, 0x40, 0xc3, 0x9c, 0xcc, 0xf4, 0x19, 0x56, 0x0d, 0x41,
+	0xa7, 0x92, 0x60, 0xf8, 0x90, 0x31, 0xd0, 0x09, 0x16, 0x7e, 0x57, 0x3c, 0x36, 0x4b, 0xfc, 0xbb,
+	0x83, 0xbc, 0x12, 0x7a, 0xcc, 0xaf, 0x33, 0x05, 0x22, 0x27, 0x42, 0xcd, 0xe6, 0x54, 0x6b, 0x86,
+	0xea, 0x93, 0x37, 0xa0, 0x3a, 0x2f, 0x65, 0x97, 0x69, 0xdf, 0x17, 0xd5, 0x21, 0x12, 0x87, 0x68,
+	0x37, 0xe1, 0xe2, 0x57, 0x22, 0x68, 0x28, 0x20, 0x25, 0x33, 0x10, 0xd2, 0x5d, 0x37, 0x9c, 0x7e,
+	0xf5, 0xfd, 0x15, 0x09, 0xc3, 0x22, 0xfe, 0x2b, 0x4a, 0x05, 0xc8, 0x79, 0x8f, 0x76, 0x92, 0x47,
+	0x4e, 0x89, 0x15, 0xf2, 0x24, 0x64, 0x34, 0x94, 0x8c, 0x42, 0xf8, 0x64, 0xbb, 0xea, 0xcf, 0x6c,
+	0xd7, 0x81, 0xc6, 0xbd, 0x64, 0x14, 0x2e, 0x96, 0xdb, 0xf6, 0x05, 0xaa, 0xe7, 0x44, 0x90, 0x89,
+	0x74, 0x1b, 0xc7, 0x4e, 0x67, 0xb3, 0x77, 0x54, 0x89, 0x3e, 0x30, 0x61, 0x16, 0xd2, 0x26, 0xe1,
+	0x1f, 0xd0, 0xf6, 0x18, 0x52, 0x7d, 0x25, 0xf1, 0xb4, 0x18, 0xdb, 0x0d, 0x53, 0xe4, 0x49, 0x25,
+	0xcc, 0xb7, 0x90, 0xd2, 0x21, 0xc4, 0xd3, 0xd2, 0xb8, 0x6e, 0x8d, 0x4b, 0x36, 0xfc, 0x1b, 0x7a,
+	0x5b, 0x71, 0x45, 0xd2, 0xd0, 0x48, 0x25, 0xe6, 0x69, 0x81, 0xdb, 0x34, 0xb8, 0xef, 0xfa, 0x85,
+	0x3e, 0x7d, 0xad, 0x4f, 0xdf, 0xea, 0xd3, 0x3f, 0xe7, 0x2c, 0x3b, 0xfb, 0x48, 0x63, 0xfd, 0xf5,
+	0xef, 0x51, 0x67, 0xc4, 0xd4, 0xf8, 0x3a, 0xf2, 0x63, 0x3e, 0x09, 0xac, 0x98, 0x8b, 0x4f, 0x57,
+	0xd2, 0xab, 0x40, 0xcd, 0x72, 0x90, 0x26, 0x41, 0x0e, 0x5b, 0x86, 0x67, 0x60, 0x69, 0x0c, 0xf9,
+	0x00, 0xbd, 0x65, 0xbb, 0x1b, 0x4a, 0x45, 0x94, 0x74, 0xd1, 0x6b, 0x8e, 0x63, 0xdb, 0xa7, 0x55,
+	0xfc, 0x70, 0x1c, 0x51, 0xb2, 0xb5, 0xbf, 0x43, 0xad, 0xa5, 0x6b, 0xc7, 0xfb, 0xa8, 0x91, 0x73,
+	0xa1, 0x42, 0x46, 0x5d, 0xe7, 0xd8, 0xe9, 0x34, 0x87, 0x75, 0xbd, 0xed, 0x53, 0x7c, 0x88, 0x90,
+	0x6d, 0xb7, 0xf6, 0xad, 0x18, 0x5f, 0xd3, 0x5a, 0xfa, 0xb4, 0xfd, 0x0b, 0xda, 0x59, 0x90, 0xcc,
+	0x42, 0x86, 0xb3, 0x90, 0x81, 0x5d, 0xd4, 0xb0, 0xe5, 0x58, 0xb4, 0xf9, 0x16, 0xef, 0xa1, 0x75,
+	0x23, 0x1d, 0x77, 0xd5, 0xd8, 0x8b, 0x4d, 0xfb, 0x0f, 0x07, 0xbd, 0xf7, 0x0a, 0xa9, 0x3c, 0x9f,
+	0xae, 0x8b, 0xf0, 0xb2, 0x6c, 0x2d, 0x77, 0x2b, 0x5e, 0xe4, 0x69, 0x4b, 0xf4, 0xce, 0x93, 0xea,
+	0xd1, 0x0c, 0xa4, 0x58, 0x5a, 0xf6, 0xf9, 0x16, 0x7f, 0x89, 0x9a, 0xb9, 0x79, 0x09, 0xe7, 0x57,
+	0xb7, 0xd9, 0x3b, 0x34, 0x7d, 0xd3, 0x6f, 0xb1, 0x3f, 0x7f, 0x80, 0xcd, 0x24, 0xeb, 0xa8, 0x3e,
+	0xb5, 0xfd, 0xda, 0xc8, 0xe7, 0xfb, 0xef, 0x6f, 0xee, 0x3c, 0xe7, 0xf6, 0xce, 0x73, 0xfe, 0xbb,
+	0xf3, 0x9c, 0x3f, 0xef, 0xbd, 0xda, 0xed, 0xbd, 0x57, 0xfb, 0xfb, 0xde, 0xab, 0xfd, 0xf8, 0xe9,
+	0xf2, 0x50, 0xb1, 0x28, 0xee, 0x8e, 0x78, 0x30, 0xfd, 0x2c, 0x98, 0x70, 0x7a, 0x9d, 0x82, 0xd4,
+	0xbf, 0x05, 0x19, 0xf4, 0x3e, 0xef, 0xea, 0x3f, 0x82, 0x99, 0xb3, 0xa8, 0x6e, 0x66, 0xf8, 0xe3,
+	0x17, 0x03, 0x00, 0x6f, 0x3a, 0x55, 0xf1, 0xac, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +474,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TotalProtocolFees) > 0 {
+		for iNdEx := len(m.TotalProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.HeldRecvFees) > 0 {
+		for iNdEx := len(m.HeldRecvFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldRecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SendSideFeeEnabledChannels) > 0 {
+		for iNdEx := len(m.SendSideFeeEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendSideFeeEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -701,6 +825,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendSideFeeEnabledChannels) > 0 {
+		for _, e := range m.SendSideFeeEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HeldRecvFees) > 0 {
+		for _, e := range m.HeldRecvFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalProtocolFees) > 0 {
+		for _, e := range m.TotalProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -983,6 +1133,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSideFeeEnabledChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendSideFeeEnabledChannels = append(m.SendSideFeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.SendSideFeeEnabledChannels[len(m.SendSideFeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldRecvFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldRecvFees = append(m.HeldRecvFees, HeldRecvFees{})
+			if err := m.HeldRecvFees[len(m.HeldRecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalProtocolFees = append(m.TotalProtocolFees, types.Coin{})
+			if err := m.TotalProtocolFees[len(m.TotalProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
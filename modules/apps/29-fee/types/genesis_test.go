@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -81,6 +83,20 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid send side fee enabled channel: invalid port ID",
+			func() {
+				genState.SendSideFeeEnabledChannels[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid send side fee enabled channel: invalid channel ID",
+			func() {
+				genState.SendSideFeeEnabledChannels[0].ChannelId = ""
+			},
+			false,
+		},
 		{
 			"invalid registered payee: invalid relayer address",
 			func() {
@@ -130,6 +146,62 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid params",
+			func() {
+				genState.Params.MaxReleasedPerBlock = 0
+			},
+			false,
+		},
+		{
+			"invalid held recv fees: invalid forward relayer",
+			func() {
+				genState.HeldRecvFees[0].ForwardRelayer = ""
+			},
+			false,
+		},
+		{
+			"invalid held recv fees: invalid packet",
+			func() {
+				genState.HeldRecvFees[0].PacketId = channeltypes.PacketId{}
+			},
+			false,
+		},
+		{
+			"invalid total protocol fees",
+			func() {
+				genState.TotalProtocolFees = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}
+			},
+			false,
+		},
+		{
+			"invalid held recv fees: invalid refund address",
+			func() {
+				genState.HeldRecvFees[0].PacketFees[0].RefundAddress = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid relayer",
+			func() {
+				genState.RelayerStats[0].Relayer = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid channel ID",
+			func() {
+				genState.RelayerStats[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer stats: invalid fees paid",
+			func() {
+				genState.RelayerStats[0].AckFeesPaid = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -148,6 +220,12 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			SendSideFeeEnabledChannels: []types.FeeEnabledChannel{
+				{
+					PortId:    ibctesting.MockPort,
+					ChannelId: ibctesting.FirstChannelID,
+				},
+			},
 			RegisteredCounterpartyPayees: []types.RegisteredCounterpartyPayee{
 				{
 					Relayer:           defaultAccAddress,
@@ -168,6 +246,17 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			Params: types.DefaultParams(),
+			HeldRecvFees: []types.HeldRecvFees{
+				types.NewHeldRecvFees(
+					channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2), defaultAccAddress,
+					[]types.PacketFee{types.NewPacketFee(types.NewFee(defaultRecvFee, nil, nil), defaultAccAddress, nil)}, 1,
+				),
+			},
+			TotalProtocolFees: defaultRecvFee,
+			RelayerStats: []types.RelayerStats{
+				types.NewRelayerStats(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID),
+			},
 		}
 
 		tc.malleate()
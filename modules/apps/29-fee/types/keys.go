@@ -7,6 +7,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
@@ -29,6 +31,9 @@ const (
 	// FeeEnabledPrefix is the key prefix for storing fee enabled flag
 	FeeEnabledKeyPrefix = "feeEnabled"
 
+	// SendSideFeeEnabledKeyPrefix is the key prefix for storing the send side fee enabled flag
+	SendSideFeeEnabledKeyPrefix = "sendSideFeeEnabled"
+
 	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
 	PayeeKeyPrefix = "payee"
 
@@ -40,6 +45,27 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// ParamsKey is the store key for the 29-fee module parameters
+	ParamsKey = "params"
+
+	// HeldRecvFeesPrefix is the key prefix for receive fees held in escrow until the dispute window has elapsed
+	HeldRecvFeesPrefix = "heldRecvFees"
+
+	// HeldRecvFeesQueuePrefix is the key prefix for the queue of held receive fees ordered by release time
+	HeldRecvFeesQueuePrefix = "heldRecvFeesQueue"
+
+	// ProtocolFeesPrefix is the key prefix for the total protocol fees sent to the protocol fee recipient
+	ProtocolFeesPrefix = "protocolFees"
+
+	// RelayerStatsPrefix is the key prefix for the statistics of a relayer on a channel
+	RelayerStatsPrefix = "relayerStats"
+
+	// RelayerStatsByChannelPrefix is the key prefix for the index of relayer statistics by channel
+	RelayerStatsByChannelPrefix = "relayerStatsByChannel"
+
+	// RelayerStatsCountPrefix is the key prefix for the number of relayers whose statistics are stored on a channel
+	RelayerStatsCountPrefix = "relayerStatsCount"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -74,6 +100,29 @@ func ParseKeyFeeEnabled(key string) (portID, channelID string, err error) {
 	return portID, channelID, nil
 }
 
+// KeySendSideFeeEnabled returns the key that stores a flag to determine if send side fee logic should
+// be enabled for the given port and channel identifiers.
+func KeySendSideFeeEnabled(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", SendSideFeeEnabledKeyPrefix, portID, channelID))
+}
+
+// ParseKeySendSideFeeEnabled parses the key used to indicate if the send side fee logic should be
+// enabled for the given port and channel identifiers.
+func ParseKeySendSideFeeEnabled(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != SendSideFeeEnabledKeyPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", SendSideFeeEnabledKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
 // KeyPayee returns the key for relayer address -> payee address mapping
 func KeyPayee(relayerAddr, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", PayeeKeyPrefix, relayerAddr, channelID))
@@ -158,3 +207,80 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyHeldRecvFees returns the key for the receive fees of the given packet held in escrow for the forward relayer
+func KeyHeldRecvFees(forwardRelayer string, packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%d", KeyHeldRecvFeesChannelPrefix(forwardRelayer, packetID.PortId, packetID.ChannelId), packetID.Sequence))
+}
+
+// ParseKeyHeldRecvFees parses the key used to store held receive fees and returns the forward relayer address and the packet id
+func ParseKeyHeldRecvFees(key string) (string, channeltypes.PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 5 {
+		return "", channeltypes.PacketId{}, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 5, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != HeldRecvFeesPrefix {
+		return "", channeltypes.PacketId{}, errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", HeldRecvFeesPrefix, keySplit[0])
+	}
+
+	seq, err := strconv.ParseUint(keySplit[4], 10, 64)
+	if err != nil {
+		return "", channeltypes.PacketId{}, err
+	}
+
+	packetID := channeltypes.NewPacketID(keySplit[2], keySplit[3], seq)
+	return keySplit[1], packetID, nil
+}
+
+// KeyHeldRecvFeesRelayerPrefix returns the key prefix for receive fees held in escrow for the given forward relayer
+func KeyHeldRecvFeesRelayerPrefix(forwardRelayer string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", HeldRecvFeesPrefix, forwardRelayer))
+}
+
+// KeyHeldRecvFeesChannelPrefix returns the key prefix for receive fees held in escrow for the given forward relayer on the given channel
+func KeyHeldRecvFeesChannelPrefix(forwardRelayer, portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", HeldRecvFeesPrefix, forwardRelayer, portID, channelID))
+}
+
+// HeldRecvFeesQueuePrefixForTime returns the key prefix of the held receive fees which are released at the given timestamp
+func HeldRecvFeesQueuePrefixForTime(releaseTime uint64) []byte {
+	return append([]byte(HeldRecvFeesQueuePrefix+"/"), sdk.Uint64ToBigEndian(releaseTime)...)
+}
+
+// HeldRecvFeesQueueKey returns the queue key of the held receive fees of the given packet which are released at the given timestamp
+func HeldRecvFeesQueueKey(releaseTime uint64, forwardRelayer string, packetID channeltypes.PacketId) []byte {
+	return append(HeldRecvFeesQueuePrefixForTime(releaseTime), append([]byte("/"), KeyHeldRecvFees(forwardRelayer, packetID)...)...)
+}
+
+// KeyProtocolFees returns the key for the total protocol fees of the given denomination sent to the protocol fee recipient
+func KeyProtocolFees(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ProtocolFeesPrefix, denom))
+}
+
+// KeyRelayerStats returns the key for the statistics of the given relayer on the given channel
+func KeyRelayerStats(relayer, portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s", KeyRelayerStatsRelayerPrefix(relayer), portID, channelID))
+}
+
+// KeyRelayerStatsRelayerPrefix returns the key prefix for the statistics of the given relayer on all channels
+func KeyRelayerStatsRelayerPrefix(relayer string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RelayerStatsPrefix, relayer))
+}
+
+// KeyRelayerStatsByChannel returns the index key for the statistics of the given relayer on the given channel
+func KeyRelayerStatsByChannel(portID, channelID, relayer string) []byte {
+	return []byte(fmt.Sprintf("%s%s", KeyRelayerStatsByChannelPrefix(portID, channelID), relayer))
+}
+
+// KeyRelayerStatsCount returns the key for the number of relayers whose statistics are stored on the given channel
+func KeyRelayerStatsCount(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RelayerStatsCountPrefix, portID, channelID))
+}
+
+// KeyRelayerStatsByChannelPrefix returns the index key prefix for the statistics of all relayers on the given channel
+func KeyRelayerStatsByChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", RelayerStatsByChannelPrefix, portID, channelID))
+}
@@ -106,6 +106,46 @@ func TestParseKeyFeeEnabled(t *testing.T) {
 	}
 }
 
+func TestParseKeySendSideFeeEnabled(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeySendSideFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		portID, channelID, err := types.ParseKeySendSideFeeEnabled(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockPort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
+
 func TestParseKeyFeesInEscrow(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -214,3 +254,48 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyHeldRecvFees(t *testing.T) {
+	forwardRelayer := "cosmos1relayer"
+
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyHeldRecvFees(forwardRelayer, validPacketID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s/%s/%d", types.FeesInEscrowPrefix, forwardRelayer, validPacketID.PortId, validPacketID.ChannelId, validPacketID.Sequence),
+			false,
+		},
+		{
+			"incorrect key - sequence cannot be parsed",
+			fmt.Sprintf("%s/%s", types.KeyHeldRecvFeesChannelPrefix(forwardRelayer, validPacketID.PortId, validPacketID.ChannelId), "sequence"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		relayer, packetID, err := types.ParseKeyHeldRecvFees(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, forwardRelayer, relayer)
+			require.Equal(t, validPacketID, packetID)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -12,18 +12,29 @@ import (
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
-const MaximumCounterpartyPayeeLength = 2048 // maximum length of the counterparty payee in bytes (value chosen arbitrarily)
+const (
+	MaximumCounterpartyPayeeLength = 2048 // maximum length of the counterparty payee in bytes (value chosen arbitrarily)
+	MaximumPayPacketFeeBatchSize   = 5000 // maximum number of packet sequences incentivized by a single MsgPayPacketFeeAsyncBatch (value chosen arbitrarily)
+)
 
 var (
 	_ sdk.Msg = (*MsgRegisterPayee)(nil)
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgPayPacketFeeAsyncBatch)(nil)
+	_ sdk.Msg = (*MsgUpdateSendSideFeeEnabled)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgDisputeHeldRecvFees)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsyncBatch)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateSendSideFeeEnabled)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgDisputeHeldRecvFees)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -147,3 +158,160 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgPayPacketFeeAsyncBatchForRange creates a new instance of MsgPayPacketFeeAsyncBatch incentivizing the packets
+// with sequences in the range from startSequence to endSequence (inclusive)
+func NewMsgPayPacketFeeAsyncBatchForRange(portID, channelID string, startSequence, endSequence uint64, packetFee PacketFee) *MsgPayPacketFeeAsyncBatch {
+	return &MsgPayPacketFeeAsyncBatch{
+		PortId:        portID,
+		ChannelId:     channelID,
+		StartSequence: startSequence,
+		EndSequence:   endSequence,
+		PacketFee:     packetFee,
+	}
+}
+
+// NewMsgPayPacketFeeAsyncBatch creates a new instance of MsgPayPacketFeeAsyncBatch incentivizing the packets
+// with the given sequences
+func NewMsgPayPacketFeeAsyncBatch(portID, channelID string, sequences []uint64, packetFee PacketFee) *MsgPayPacketFeeAsyncBatch {
+	return &MsgPayPacketFeeAsyncBatch{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequences: sequences,
+		PacketFee: packetFee,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFeeAsyncBatch fields
+func (msg MsgPayPacketFeeAsyncBatch) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if len(msg.Sequences) == 0 {
+		if msg.StartSequence == 0 || msg.EndSequence < msg.StartSequence {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidSequence, "invalid sequence range [%d, %d]", msg.StartSequence, msg.EndSequence)
+		}
+
+		if msg.EndSequence-msg.StartSequence >= MaximumPayPacketFeeBatchSize {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "sequence range must not exceed %d packets", MaximumPayPacketFeeBatchSize)
+		}
+	} else {
+		if msg.StartSequence != 0 || msg.EndSequence != 0 {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "sequence range and list of sequences cannot both be provided")
+		}
+
+		if len(msg.Sequences) > MaximumPayPacketFeeBatchSize {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "list of sequences must not exceed %d packets", MaximumPayPacketFeeBatchSize)
+		}
+
+		seen := make(map[uint64]struct{}, len(msg.Sequences))
+		for _, sequence := range msg.Sequences {
+			if sequence == 0 {
+				return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+			}
+
+			if _, found := seen[sequence]; found {
+				return errorsmod.Wrapf(ibcerrors.ErrInvalidSequence, "duplicate packet sequence %d", sequence)
+			}
+
+			seen[sequence] = struct{}{}
+		}
+	}
+
+	return msg.PacketFee.Validate()
+}
+
+// PacketSequences returns the sequences of the packets to incentivize, either the list of sequences
+// or the sequences within the sequence range
+func (msg MsgPayPacketFeeAsyncBatch) PacketSequences() []uint64 {
+	if len(msg.Sequences) != 0 {
+		return msg.Sequences
+	}
+
+	sequences := make([]uint64, 0, msg.EndSequence-msg.StartSequence+1)
+	for sequence := msg.StartSequence; sequence <= msg.EndSequence; sequence++ {
+		sequences = append(sequences, sequence)
+	}
+
+	return sequences
+}
+
+// NewMsgUpdateSendSideFeeEnabled creates a new instance of MsgUpdateSendSideFeeEnabled
+func NewMsgUpdateSendSideFeeEnabled(portID, channelID string, enabled bool, signer string) *MsgUpdateSendSideFeeEnabled {
+	return &MsgUpdateSendSideFeeEnabled{
+		PortId:    portID,
+		ChannelId: channelID,
+		Enabled:   enabled,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateSendSideFeeEnabled fields
+func (msg MsgUpdateSendSideFeeEnabled) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateParams fields
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgDisputeHeldRecvFees creates a new instance of MsgDisputeHeldRecvFees
+func NewMsgDisputeHeldRecvFees(portID, channelID, forwardRelayer, signer string) *MsgDisputeHeldRecvFees {
+	return &MsgDisputeHeldRecvFees{
+		PortId:         portID,
+		ChannelId:      channelID,
+		ForwardRelayer: forwardRelayer,
+		Signer:         signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgDisputeHeldRecvFees fields
+func (msg MsgDisputeHeldRecvFees) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ForwardRelayer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.ForwardRelayer into sdk.AccAddress")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
@@ -398,3 +398,344 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgPayPacketFeeAsyncBatchValidation(t *testing.T) {
+	var msg *types.MsgPayPacketFeeAsyncBatch
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: sequence range",
+			func() {},
+			true,
+		},
+		{
+			"success: single sequence range",
+			func() {
+				msg.EndSequence = msg.StartSequence
+			},
+			true,
+		},
+		{
+			"success: list of sequences",
+			func() {
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, []uint64{1, 5, 7}, msg.PacketFee)
+			},
+			true,
+		},
+		{
+			"success: maximum batch size",
+			func() {
+				msg.EndSequence = msg.StartSequence + types.MaximumPayPacketFeeBatchSize - 1
+			},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence range: zero start sequence",
+			func() {
+				msg.StartSequence = 0
+			},
+			false,
+		},
+		{
+			"invalid sequence range: end sequence lower than start sequence",
+			func() {
+				msg.EndSequence = msg.StartSequence - 1
+			},
+			false,
+		},
+		{
+			"invalid sequence range: exceeds maximum batch size",
+			func() {
+				msg.EndSequence = msg.StartSequence + types.MaximumPayPacketFeeBatchSize
+			},
+			false,
+		},
+		{
+			"both sequence range and list of sequences provided",
+			func() {
+				msg.Sequences = []uint64{1}
+			},
+			false,
+		},
+		{
+			"invalid list of sequences: zero sequence",
+			func() {
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, []uint64{1, 0}, msg.PacketFee)
+			},
+			false,
+		},
+		{
+			"invalid list of sequences: duplicate sequence",
+			func() {
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, []uint64{1, 2, 1}, msg.PacketFee)
+			},
+			false,
+		},
+		{
+			"invalid list of sequences: exceeds maximum batch size",
+			func() {
+				sequences := make([]uint64, types.MaximumPayPacketFeeBatchSize+1)
+				for i := range sequences {
+					sequences[i] = uint64(i + 1)
+				}
+
+				msg = types.NewMsgPayPacketFeeAsyncBatch(msg.PortId, msg.ChannelId, sequences, msg.PacketFee)
+			},
+			false,
+		},
+		{
+			"relayers is not nil",
+			func() {
+				msg.PacketFee.Relayers = []string{defaultAccAddress}
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.PacketFee.RefundAddress = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"should fail with single invalid fee",
+			func() {
+				msg.PacketFee.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		packetFee := types.NewPacketFee(fee, defaultAccAddress, nil)
+
+		msg = types.NewMsgPayPacketFeeAsyncBatchForRange(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, 10, packetFee)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeAsyncBatchPacketSequences(t *testing.T) {
+	packetFee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), defaultAccAddress, nil)
+
+	msg := types.NewMsgPayPacketFeeAsyncBatchForRange(ibctesting.MockFeePort, ibctesting.FirstChannelID, 3, 6, packetFee)
+	require.Equal(t, []uint64{3, 4, 5, 6}, msg.PacketSequences())
+
+	msg = types.NewMsgPayPacketFeeAsyncBatch(ibctesting.MockFeePort, ibctesting.FirstChannelID, []uint64{7, 2}, packetFee)
+	require.Equal(t, []uint64{7, 2}, msg.PacketSequences())
+}
+
+func TestPayPacketFeeAsyncBatchGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+	msg := types.NewMsgPayPacketFeeAsyncBatchForRange(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, 10, packetFee)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, refundAddr.Bytes(), signers[0])
+}
+
+func TestMsgUpdateSendSideFeeEnabledValidation(t *testing.T) {
+	var msg *types.MsgUpdateSendSideFeeEnabled
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: disable send side fees",
+			func() {
+				msg.Enabled = false
+			},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgUpdateSendSideFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID, true, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestUpdateSendSideFeeEnabledGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateSendSideFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID, true, accAddress.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{"success", types.NewMsgUpdateParams(defaultAccAddress, types.DefaultParams()), true},
+		{"invalid signer address", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), false},
+		{"invalid params", types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(0, 0, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, "")), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestUpdateParamsGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateParams(accAddress.String(), types.DefaultParams())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgDisputeHeldRecvFeesValidation(t *testing.T) {
+	var msg *types.MsgDisputeHeldRecvFees
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid forward relayer address",
+			func() {
+				msg.ForwardRelayer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgDisputeHeldRecvFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestDisputeHeldRecvFeesGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgDisputeHeldRecvFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, accAddress.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
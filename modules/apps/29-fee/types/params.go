@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultRecvFeeDisputeWindow is the default dispute window for receive fees (set to zero, receive fees are paid out
+	// when the acknowledgement is received)
+	DefaultRecvFeeDisputeWindow = 0
+	// DefaultMaxReleasedPerBlock is the default maximum number of held receive fees paid out per block
+	DefaultMaxReleasedPerBlock = 100
+	// DefaultRelayerStatsEnabled is the default value for the relayer statistics accounting (set to true)
+	DefaultRelayerStatsEnabled = true
+	// DefaultMaxRelayerStatsPerChannel is the default maximum number of relayers whose statistics are stored per channel
+	DefaultMaxRelayerStatsPerChannel = 100
+)
+
+// DefaultProtocolFeePercentage is the default fraction of relayer fees sent to the protocol fee recipient (set to zero)
+var DefaultProtocolFeePercentage = sdkmath.LegacyZeroDec()
+
+// NewParams creates a new parameter configuration for the 29-fee module
+func NewParams(
+	recvFeeDisputeWindow, maxReleasedPerBlock uint64,
+	protocolFeePercentage sdkmath.LegacyDec, protocolFeeRecipient string,
+	relayerStatsEnabled bool, maxRelayerStatsPerChannel uint64, feeDenom string,
+) Params {
+	return Params{
+		RecvFeeDisputeWindow:      recvFeeDisputeWindow,
+		MaxReleasedPerBlock:       maxReleasedPerBlock,
+		ProtocolFeePercentage:     protocolFeePercentage,
+		ProtocolFeeRecipient:      protocolFeeRecipient,
+		RelayerStatsEnabled:       relayerStatsEnabled,
+		MaxRelayerStatsPerChannel: maxRelayerStatsPerChannel,
+		FeeDenom:                  feeDenom,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the 29-fee module
+func DefaultParams() Params {
+	return NewParams(DefaultRecvFeeDisputeWindow, DefaultMaxReleasedPerBlock, DefaultProtocolFeePercentage, "", DefaultRelayerStatsEnabled, DefaultMaxRelayerStatsPerChannel, "")
+}
+
+// Validate validates all 29-fee module parameters
+func (p Params) Validate() error {
+	// held receive fees may remain in the release queue after the dispute window is disabled,
+	// therefore the release of held receive fees must never be disabled
+	if p.MaxReleasedPerBlock == 0 {
+		return fmt.Errorf("max released per block must be greater than zero")
+	}
+
+	if p.ProtocolFeePercentage.IsNil() || p.ProtocolFeePercentage.IsNegative() || p.ProtocolFeePercentage.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("protocol fee percentage must be in the range [0, 1): %s", p.ProtocolFeePercentage)
+	}
+
+	if p.IsProtocolFeeEnabled() && strings.TrimSpace(p.ProtocolFeeRecipient) == "" {
+		return fmt.Errorf("protocol fee recipient must not be empty when the protocol fee percentage is set")
+	}
+
+	if p.ProtocolFeeRecipient == ModuleName {
+		return fmt.Errorf("protocol fee recipient must not be the %s module account", ModuleName)
+	}
+
+	// the number of stored relayer statistics must be bounded, the forward relayer address is chosen by the counterparty
+	if p.RelayerStatsEnabled && p.MaxRelayerStatsPerChannel == 0 {
+		return fmt.Errorf("max relayer stats per channel must be greater than zero when the relayer statistics are enabled")
+	}
+
+	if p.FeeDenom != "" {
+		if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// IsDisputeWindowEnabled returns true if receive fees are held in escrow until the dispute window has elapsed.
+func (p Params) IsDisputeWindowEnabled() bool {
+	return p.RecvFeeDisputeWindow > 0
+}
+
+// IsProtocolFeeEnabled returns true if a fraction of every fee paid to a relayer is sent to the protocol fee recipient.
+func (p Params) IsProtocolFeeEnabled() bool {
+	return !p.ProtocolFeePercentage.IsNil() && p.ProtocolFeePercentage.IsPositive()
+}
+
+// ValidateFeeDenom returns an error if the given fee contains a coin which is not denominated in the fee denom.
+// Any denomination is accepted if the fee denom is not set.
+func (p Params) ValidateFeeDenom(fee sdk.Coins) error {
+	if p.FeeDenom == "" {
+		return nil
+	}
+
+	for _, coin := range fee {
+		if coin.Denom != p.FeeDenom {
+			return errorsmod.Wrapf(ErrInvalidFeeDenom, "expected %s, got %s", p.FeeDenom, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ProtocolFee returns the protocol fee of the given relayer fee. The amount of each coin is truncated.
+func (p Params) ProtocolFee(fee sdk.Coins) sdk.Coins {
+	if !p.IsProtocolFeeEnabled() {
+		return sdk.NewCoins()
+	}
+
+	var protocolFee sdk.Coins
+	for _, coin := range fee {
+		amount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(p.ProtocolFeePercentage).TruncateInt()
+		protocolFee = protocolFee.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return protocolFee
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"dispute window enabled", types.NewParams(60, 10, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), true},
+		{"dispute window disabled, max released is zero", types.NewParams(0, 0, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"dispute window enabled, max released is zero", types.NewParams(60, 0, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"protocol fee enabled", types.NewParams(0, 10, sdkmath.LegacyNewDecWithPrec(5, 2), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), true},
+		{"protocol fee disabled, recipient set", types.NewParams(0, 10, sdkmath.LegacyZeroDec(), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), true},
+		{"protocol fee percentage is nil", types.NewParams(0, 10, sdkmath.LegacyDec{}, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"protocol fee percentage is negative", types.NewParams(0, 10, sdkmath.LegacyNewDec(-1), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"protocol fee percentage is one", types.NewParams(0, 10, sdkmath.LegacyOneDec(), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"protocol fee enabled, recipient is empty", types.NewParams(0, 10, sdkmath.LegacyNewDecWithPrec(5, 2), "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"protocol fee recipient is the fee module", types.NewParams(0, 10, sdkmath.LegacyNewDecWithPrec(5, 2), types.ModuleName, types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), false},
+		{"relayer stats enabled, max relayer stats per channel is zero", types.NewParams(0, 10, types.DefaultProtocolFeePercentage, "", true, 0, ""), false},
+		{"relayer stats disabled, max relayer stats per channel is zero", types.NewParams(0, 10, types.DefaultProtocolFeePercentage, "", false, 0, ""), true},
+		{"fee denom set", types.NewParams(0, 10, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, sdk.DefaultBondDenom), true},
+		{"invalid fee denom", types.NewParams(0, 10, types.DefaultProtocolFeePercentage, "", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, "1stake"), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateFeeDenom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		feeDenom string
+		fee      sdk.Coins
+		expPass  bool
+	}{
+		{"fee denom not set", "", sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1))), true},
+		{"fee paid in the fee denom", sdk.DefaultBondDenom, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))), true},
+		{"empty fee", sdk.DefaultBondDenom, sdk.NewCoins(), true},
+		{"fee not paid in the fee denom", sdk.DefaultBondDenom, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)), sdk.NewCoin("atom", sdkmath.NewInt(1))), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.FeeDenom = tc.feeDenom
+
+			err := params.ValidateFeeDenom(tc.fee)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidFeeDenom)
+			}
+		})
+	}
+}
+
+func TestProtocolFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(199)), sdk.NewCoin("atom", sdkmath.NewInt(5)))
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		expFee sdk.Coins
+	}{
+		{"protocol fee disabled", types.DefaultParams(), sdk.NewCoins()},
+		{"amounts are truncated", types.NewParams(0, 10, sdkmath.LegacyNewDecWithPrec(1, 1), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(19)))},
+		{"half", types.NewParams(0, 10, sdkmath.LegacyNewDecWithPrec(5, 1), "recipient", types.DefaultRelayerStatsEnabled, types.DefaultMaxRelayerStatsPerChannel, ""), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(99)), sdk.NewCoin("atom", sdkmath.NewInt(2)))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFee, tc.params.ProtocolFee(fee))
+		})
+	}
+}
@@ -1026,6 +1026,8 @@ func (m *QueryFeeEnabledChannelRequest) GetChannelId() string {
 type QueryFeeEnabledChannelResponse struct {
 	// boolean flag representing the fee enabled channel status
 	FeeEnabled bool `protobuf:"varint,1,opt,name=fee_enabled,json=feeEnabled,proto3" json:"fee_enabled,omitempty"`
+	// boolean flag representing the send side fee enabled channel status
+	SendSideFeeEnabled bool `protobuf:"varint,2,opt,name=send_side_fee_enabled,json=sendSideFeeEnabled,proto3" json:"send_side_fee_enabled,omitempty"`
 }
 
 func (m *QueryFeeEnabledChannelResponse) Reset()         { *m = QueryFeeEnabledChannelResponse{} }
@@ -1068,1207 +1070,1514 @@ func (m *QueryFeeEnabledChannelResponse) GetFeeEnabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
-	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
-	proto.RegisterType((*QueryIncentivizedPacketRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketRequest")
-	proto.RegisterType((*QueryIncentivizedPacketResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketResponse")
-	proto.RegisterType((*QueryIncentivizedPacketsForChannelRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsForChannelRequest")
-	proto.RegisterType((*QueryIncentivizedPacketsForChannelResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsForChannelResponse")
-	proto.RegisterType((*QueryTotalRecvFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalRecvFeesRequest")
-	proto.RegisterType((*QueryTotalRecvFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalRecvFeesResponse")
-	proto.RegisterType((*QueryTotalAckFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalAckFeesRequest")
-	proto.RegisterType((*QueryTotalAckFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalAckFeesResponse")
-	proto.RegisterType((*QueryTotalTimeoutFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalTimeoutFeesRequest")
-	proto.RegisterType((*QueryTotalTimeoutFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalTimeoutFeesResponse")
-	proto.RegisterType((*QueryPayeeRequest)(nil), "ibc.applications.fee.v1.QueryPayeeRequest")
-	proto.RegisterType((*QueryPayeeResponse)(nil), "ibc.applications.fee.v1.QueryPayeeResponse")
-	proto.RegisterType((*QueryCounterpartyPayeeRequest)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeRequest")
-	proto.RegisterType((*QueryCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeResponse")
-	proto.RegisterType((*QueryFeeEnabledChannelsRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsRequest")
-	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
-	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
-	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+func (m *QueryFeeEnabledChannelResponse) GetSendSideFeeEnabled() bool {
+	if m != nil {
+		return m.SendSideFeeEnabled
+	}
+	return false
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/fee/v1/query.proto", fileDescriptor_0638a8a78ca2503c)
+// QueryParamsRequest defines the request type for the Params rpc
+type QueryParamsRequest struct {
 }
 
-var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x6f, 0x4f, 0x1c, 0x45,
-	0x18, 0x67, 0xae, 0xb4, 0xc0, 0x03, 0x4d, 0x64, 0x20, 0x29, 0xdd, 0xc0, 0x41, 0xb7, 0xd6, 0x22,
-	0xe6, 0x76, 0xe5, 0x9a, 0x0a, 0xbc, 0x52, 0x40, 0xa9, 0x68, 0xb5, 0x78, 0x92, 0x68, 0x8c, 0xe6,
-	0xba, 0xb7, 0x3b, 0xb7, 0x6c, 0x38, 0x76, 0xb6, 0xbb, 0x7b, 0x17, 0x29, 0xe2, 0xff, 0xaa, 0x89,
-	0x26, 0x35, 0xf1, 0x53, 0x68, 0xe2, 0x07, 0xf0, 0x1b, 0xf4, 0x55, 0x43, 0xd2, 0x17, 0x1a, 0x5f,
-	0xa8, 0x01, 0xe3, 0x67, 0xf0, 0x85, 0x26, 0x66, 0x67, 0x66, 0xef, 0xf6, 0xd8, 0x5d, 0xee, 0x0e,
-	0x0f, 0xfa, 0x8a, 0xdd, 0x99, 0x79, 0x9e, 0xe7, 0xf7, 0xfb, 0xcd, 0xec, 0x3c, 0xbf, 0x03, 0x2e,
-	0x5b, 0x25, 0x5d, 0xd5, 0x1c, 0xa7, 0x62, 0xe9, 0x9a, 0x6f, 0x51, 0xdb, 0x53, 0xcb, 0x84, 0xa8,
-	0xb5, 0x59, 0xf5, 0x4e, 0x95, 0xb8, 0xdb, 0x8a, 0xe3, 0x52, 0x9f, 0xe2, 0x0b, 0x56, 0x49, 0x57,
-	0xa2, 0x8b, 0x94, 0x32, 0x21, 0x4a, 0x6d, 0x56, 0x1a, 0x35, 0xa9, 0x49, 0xd9, 0x1a, 0x35, 0x78,
-	0xe2, 0xcb, 0xa5, 0x71, 0x93, 0x52, 0xb3, 0x42, 0x54, 0xcd, 0xb1, 0x54, 0xcd, 0xb6, 0xa9, 0x2f,
-	0x82, 0xf8, 0x6c, 0x56, 0xa7, 0xde, 0x16, 0xf5, 0xd4, 0x92, 0xe6, 0x05, 0x85, 0x4a, 0xc4, 0xd7,
-	0x66, 0x55, 0x9d, 0x5a, 0xb6, 0x98, 0x9f, 0x89, 0xce, 0x33, 0x14, 0xf5, 0x55, 0x8e, 0x66, 0x5a,
-	0x36, 0x4b, 0x26, 0xd6, 0x5e, 0x4a, 0x43, 0x1f, 0xe0, 0xe3, 0x4b, 0xae, 0xa4, 0x2d, 0x31, 0x89,
-	0x4d, 0x3c, 0xcb, 0x8b, 0x66, 0xd2, 0xa9, 0x4b, 0x54, 0x7d, 0x43, 0xb3, 0x6d, 0x52, 0x09, 0x96,
-	0x88, 0x47, 0xbe, 0x44, 0xfe, 0x06, 0xc1, 0xe4, 0x1b, 0x01, 0x9e, 0x55, 0x5b, 0x27, 0xb6, 0x6f,
-	0xd5, 0xac, 0xbb, 0xc4, 0x58, 0xd3, 0xf4, 0x4d, 0xe2, 0x7b, 0x05, 0x72, 0xa7, 0x4a, 0x3c, 0x1f,
-	0xaf, 0x00, 0x34, 0x40, 0x8e, 0xa1, 0x29, 0x34, 0x3d, 0x98, 0x7f, 0x4a, 0xe1, 0x8c, 0x94, 0x80,
-	0x91, 0xc2, 0x75, 0x15, 0x8c, 0x94, 0x35, 0xcd, 0x24, 0x22, 0xb6, 0x10, 0x89, 0xc4, 0x97, 0x60,
-	0x88, 0x2d, 0x2c, 0x6e, 0x10, 0xcb, 0xdc, 0xf0, 0xc7, 0x32, 0x53, 0x68, 0xba, 0xb7, 0x30, 0xc8,
-	0xc6, 0x5e, 0x66, 0x43, 0xf2, 0x23, 0x04, 0x53, 0xe9, 0x70, 0x3c, 0x87, 0xda, 0x1e, 0xc1, 0x65,
-	0x18, 0xb5, 0x22, 0xd3, 0x45, 0x87, 0xcf, 0x8f, 0xa1, 0xa9, 0x33, 0xd3, 0x83, 0xf9, 0x9c, 0x92,
-	0xb2, 0xb1, 0xca, 0xaa, 0x11, 0xc4, 0x94, 0xad, 0x30, 0xe3, 0x0a, 0x21, 0xde, 0x52, 0xef, 0x83,
-	0xdf, 0x26, 0x7b, 0x0a, 0x23, 0x56, 0xbc, 0x1e, 0xbe, 0xd1, 0xc4, 0x3b, 0xc3, 0x78, 0x5f, 0x6d,
-	0xc9, 0x9b, 0x83, 0x8c, 0x12, 0x97, 0xef, 0x21, 0xc8, 0xa6, 0xb0, 0x0a, 0x35, 0x7e, 0x01, 0x06,
-	0x38, 0x8d, 0xa2, 0x65, 0x08, 0x89, 0x27, 0x18, 0x91, 0x60, 0xfb, 0x94, 0x70, 0xcf, 0x6a, 0x41,
-	0x91, 0x60, 0xd5, 0xaa, 0x21, 0x80, 0xf7, 0x3b, 0xe2, 0xbd, 0x1d, 0x75, 0xbf, 0x4c, 0xdf, 0xec,
-	0xba, 0xb8, 0x06, 0x8c, 0x24, 0x88, 0x2b, 0x20, 0x1d, 0x4b, 0x5b, 0x1c, 0xd7, 0x56, 0x7e, 0x88,
-	0xe0, 0xe9, 0xb4, 0x7d, 0x5e, 0xa1, 0xee, 0x32, 0xe7, 0xdb, 0xed, 0x03, 0x78, 0x01, 0xfa, 0x1c,
-	0xea, 0x32, 0x89, 0x03, 0x75, 0x06, 0x0a, 0xe7, 0x82, 0xd7, 0x55, 0x03, 0x4f, 0x00, 0x08, 0x89,
-	0x83, 0xb9, 0x33, 0x6c, 0x6e, 0x40, 0x8c, 0x24, 0x48, 0xdb, 0x1b, 0x97, 0xf6, 0x67, 0x04, 0x33,
-	0xed, 0x10, 0x12, 0x2a, 0xdf, 0xee, 0xe2, 0x11, 0x3e, 0xe1, 0xc3, 0xfb, 0x1e, 0x5c, 0x64, 0xc4,
-	0xd6, 0xa9, 0xaf, 0x55, 0x0a, 0x44, 0xaf, 0xb1, 0x9a, 0xdd, 0x3a, 0xb6, 0xf2, 0x17, 0x08, 0xa4,
-	0xa4, 0xfc, 0x42, 0xa8, 0x0d, 0x18, 0x70, 0x89, 0x5e, 0x2b, 0x96, 0x09, 0x09, 0xd5, 0xb9, 0xd8,
-	0xc4, 0x22, 0xc4, 0xbf, 0x4c, 0x2d, 0x7b, 0xe9, 0xd9, 0x20, 0xf9, 0x0f, 0xbf, 0x4f, 0x4e, 0x9b,
-	0x96, 0xbf, 0x51, 0x2d, 0x29, 0x3a, 0xdd, 0x52, 0xc5, 0xcd, 0xcb, 0xff, 0xe4, 0x3c, 0x63, 0x53,
-	0xf5, 0xb7, 0x1d, 0xe2, 0xb1, 0x00, 0xaf, 0xd0, 0xef, 0x8a, 0x8a, 0xf2, 0xbb, 0x30, 0xd6, 0xc0,
-	0xb1, 0xa8, 0x6f, 0x76, 0x97, 0xe6, 0x67, 0x28, 0x2a, 0x63, 0x3d, 0x7d, 0xfd, 0x46, 0xeb, 0xd7,
-	0xf4, 0xcd, 0x13, 0x23, 0xd9, 0xa7, 0xf1, 0x7a, 0xf2, 0x6d, 0x18, 0x6f, 0x80, 0x58, 0xb7, 0xb6,
-	0x08, 0xad, 0xfa, 0xdd, 0xe5, 0x79, 0x1f, 0xc1, 0x44, 0x4a, 0x09, 0xc1, 0xd5, 0x86, 0x21, 0x9f,
-	0x0f, 0x9f, 0x18, 0xdf, 0x41, 0xbf, 0x51, 0x57, 0xbe, 0x09, 0xc3, 0x0c, 0xd0, 0x9a, 0xb6, 0x4d,
-	0xc2, 0x5b, 0xe1, 0xd0, 0x07, 0x8f, 0x0e, 0x7f, 0xf0, 0x63, 0xd0, 0xe7, 0x92, 0x8a, 0xb6, 0x4d,
-	0x5c, 0x71, 0x51, 0x84, 0xaf, 0xf2, 0x02, 0xe0, 0x68, 0x36, 0xc1, 0xe9, 0x32, 0x9c, 0x77, 0x82,
-	0x81, 0xa2, 0x66, 0x18, 0x2e, 0xf1, 0x3c, 0x91, 0x71, 0x88, 0x0d, 0x2e, 0xf2, 0x31, 0xf9, 0x6d,
-	0xa1, 0xcc, 0x32, 0xad, 0xda, 0x3e, 0x71, 0x1d, 0xcd, 0xf5, 0xbb, 0x04, 0xea, 0x96, 0x68, 0x2f,
-	0x09, 0x99, 0x05, 0xc0, 0x1c, 0x60, 0x3d, 0x32, 0x59, 0x64, 0xc0, 0x44, 0x89, 0x61, 0xfd, 0x70,
-	0x98, 0xfc, 0x75, 0xd8, 0xb0, 0x56, 0x08, 0x79, 0xc9, 0xd6, 0x4a, 0x15, 0x62, 0x88, 0x1b, 0xec,
-	0x71, 0x98, 0x82, 0x87, 0x61, 0xdb, 0x4a, 0x42, 0x23, 0x08, 0x96, 0x60, 0xb4, 0x4c, 0x48, 0x91,
-	0xf0, 0xe9, 0xa2, 0x50, 0x2d, 0x3c, 0x5d, 0x33, 0xa9, 0x17, 0x6a, 0x2c, 0x65, 0xd8, 0xb4, 0xca,
-	0xb1, 0x5a, 0xdd, 0xbb, 0x52, 0xdf, 0x12, 0x27, 0x21, 0x56, 0x3c, 0x14, 0x37, 0xd2, 0xa8, 0xd0,
-	0x11, 0x8d, 0x2a, 0x73, 0xe8, 0x88, 0xc8, 0x8b, 0x69, 0xdb, 0x56, 0xd7, 0x69, 0x12, 0x06, 0x23,
-	0x3a, 0xb1, 0xec, 0xfd, 0x05, 0x68, 0x90, 0xcd, 0xff, 0x35, 0x0c, 0x67, 0x59, 0x0e, 0xfc, 0x13,
-	0x82, 0x91, 0x84, 0x6e, 0x86, 0xe7, 0x53, 0xc5, 0x6c, 0x61, 0x24, 0xa5, 0x85, 0x63, 0x44, 0x72,
-	0xdc, 0x72, 0xee, 0xd3, 0x47, 0x7f, 0x7e, 0x97, 0xb9, 0x8a, 0xaf, 0xa8, 0xc2, 0xfa, 0xd6, 0x2d,
-	0x6f, 0x52, 0x1f, 0xc5, 0xf7, 0x33, 0x80, 0xe3, 0xe9, 0xf0, 0x5c, 0xa7, 0x00, 0x42, 0xe4, 0xf3,
-	0x9d, 0x07, 0x0a, 0xe0, 0xf7, 0x10, 0x43, 0xfe, 0x11, 0xde, 0x8d, 0x21, 0x0f, 0x0f, 0xa9, 0xba,
-	0x53, 0xbf, 0x74, 0x95, 0xc6, 0xee, 0xee, 0xaa, 0xc1, 0x9e, 0x37, 0x4d, 0x8a, 0x33, 0xb1, 0xab,
-	0x7a, 0x01, 0x2c, 0x5b, 0x27, 0x4d, 0xb3, 0xe1, 0xe0, 0x6e, 0x92, 0x24, 0xf8, 0x5f, 0x04, 0x13,
-	0x47, 0x7a, 0x13, 0xbc, 0xd4, 0xf1, 0xee, 0xc4, 0x9c, 0x9a, 0xb4, 0xfc, 0xbf, 0x72, 0x08, 0xc9,
-	0xde, 0x64, 0x8a, 0xbd, 0x86, 0x5f, 0x3d, 0x42, 0xb1, 0x24, 0x9d, 0x42, 0x75, 0x12, 0x4f, 0xc4,
-	0x3f, 0x08, 0xce, 0x37, 0x59, 0x0c, 0x9c, 0x3f, 0x1a, 0x6b, 0x92, 0xdf, 0x91, 0xae, 0x75, 0x14,
-	0x23, 0xf8, 0x7c, 0xc2, 0x8f, 0xc0, 0x0e, 0xde, 0x3e, 0xbd, 0x23, 0xe0, 0x07, 0x48, 0x8a, 0x75,
-	0xeb, 0x84, 0xff, 0x46, 0x30, 0x14, 0xb5, 0x1e, 0x78, 0xb6, 0x0d, 0x26, 0xcd, 0x2e, 0x48, 0xca,
-	0x77, 0x12, 0x22, 0xb8, 0x7f, 0xcc, 0xb9, 0xdf, 0xc5, 0xef, 0x9f, 0x36, 0xf7, 0xd0, 0x50, 0xe1,
-	0xaf, 0x32, 0xf0, 0xc4, 0x61, 0x37, 0x82, 0xaf, 0xb7, 0xc1, 0x25, 0x6e, 0x90, 0xa4, 0xe7, 0x3a,
-	0x0d, 0x13, 0x32, 0x7c, 0xce, 0x65, 0xf8, 0x10, 0x7f, 0x70, 0xda, 0x32, 0x44, 0xbd, 0x16, 0xfe,
-	0x1e, 0xc1, 0x59, 0xd6, 0xe1, 0xf1, 0xcc, 0xd1, 0x44, 0xa2, 0xbe, 0x44, 0x7a, 0xa6, 0xad, 0xb5,
-	0x82, 0xe9, 0x0d, 0x46, 0x74, 0x11, 0x3f, 0xdf, 0xe6, 0xc7, 0x2b, 0x3c, 0x8c, 0xa7, 0xee, 0x88,
-	0xa7, 0x5d, 0x95, 0x99, 0x13, 0xfc, 0x2b, 0x82, 0xe1, 0x98, 0xa1, 0xc1, 0x2d, 0x36, 0x20, 0xcd,
-	0x5b, 0x49, 0x73, 0x1d, 0xc7, 0x09, 0x3e, 0xeb, 0x8c, 0xcf, 0xeb, 0xf8, 0xe6, 0xf1, 0xf9, 0xc4,
-	0x9d, 0x17, 0xfe, 0x11, 0x01, 0x8e, 0xbb, 0x99, 0x56, 0xfd, 0x29, 0xd5, 0x8d, 0xb5, 0xea, 0x4f,
-	0xe9, 0xc6, 0x49, 0x7e, 0x92, 0xf1, 0xcb, 0xe2, 0xf1, 0x18, 0xbf, 0x88, 0x4f, 0xc0, 0x7b, 0x08,
-	0x86, 0x63, 0x49, 0x5a, 0x6d, 0x46, 0x9a, 0xbd, 0x91, 0xe6, 0x3a, 0x8e, 0x13, 0x60, 0x5f, 0x61,
-	0x60, 0x5f, 0xc4, 0x4b, 0xc7, 0xec, 0x0c, 0x11, 0x4a, 0x4b, 0xb7, 0x1e, 0xec, 0x67, 0xd1, 0xde,
-	0x7e, 0x16, 0xfd, 0xb1, 0x9f, 0x45, 0xdf, 0x1e, 0x64, 0x7b, 0xf6, 0x0e, 0xb2, 0x3d, 0xbf, 0x1c,
-	0x64, 0x7b, 0xde, 0xb9, 0x1e, 0xff, 0xa1, 0x61, 0x95, 0xf4, 0x9c, 0x49, 0xd5, 0xda, 0xbc, 0xba,
-	0x45, 0x8d, 0x6a, 0x85, 0x78, 0xbc, 0x78, 0x7e, 0x21, 0x17, 0xd4, 0x67, 0xbf, 0x3d, 0x4a, 0xe7,
-	0xd8, 0x7f, 0xd4, 0xae, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xe2, 0xa0, 0x5d, 0x7e, 0x14,
-	0x00, 0x00,
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{20}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// IncentivizedPackets returns all incentivized packets and their associated fees
-	IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error)
-	// IncentivizedPacket returns all packet fees for a packet given its identifier
-	IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error)
-	// Gets all incentivized packets for a specific channel
-	IncentivizedPacketsForChannel(ctx context.Context, in *QueryIncentivizedPacketsForChannelRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsForChannelResponse, error)
-	// TotalRecvFees returns the total receive fees for a packet given its identifier
-	TotalRecvFees(ctx context.Context, in *QueryTotalRecvFeesRequest, opts ...grpc.CallOption) (*QueryTotalRecvFeesResponse, error)
-	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
-	TotalAckFees(ctx context.Context, in *QueryTotalAckFeesRequest, opts ...grpc.CallOption) (*QueryTotalAckFeesResponse, error)
-	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
-	TotalTimeoutFees(ctx context.Context, in *QueryTotalTimeoutFeesRequest, opts ...grpc.CallOption) (*QueryTotalTimeoutFeesResponse, error)
-	// Payee returns the registered payee address for a specific channel given the relayer address
-	Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error)
-	// CounterpartyPayee returns the registered counterparty payee for forward relaying
-	CounterpartyPayee(ctx context.Context, in *QueryCounterpartyPayeeRequest, opts ...grpc.CallOption) (*QueryCounterpartyPayeeResponse, error)
-	// FeeEnabledChannels returns a list of all fee enabled channels
-	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
-	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
-	FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error)
+// QueryParamsResponse defines the response type for the Params rpc
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{21}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error) {
-	out := new(QueryIncentivizedPacketsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPackets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error) {
-	out := new(QueryIncentivizedPacketResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPacket", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IncentivizedPacketsForChannel(ctx context.Context, in *QueryIncentivizedPacketsForChannelRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsForChannelResponse, error) {
-	out := new(QueryIncentivizedPacketsForChannelResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPacketsForChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryHeldRecvFeesRequest defines the request type for the HeldRecvFees rpc
+type QueryHeldRecvFeesRequest struct {
+	// the forward relayer address encoded in the acknowledgements by the counterparty
+	ForwardRelayer string `protobuf:"bytes,1,opt,name=forward_relayer,json=forwardRelayer,proto3" json:"forward_relayer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) TotalRecvFees(ctx context.Context, in *QueryTotalRecvFeesRequest, opts ...grpc.CallOption) (*QueryTotalRecvFeesResponse, error) {
-	out := new(QueryTotalRecvFeesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalRecvFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldRecvFeesRequest) Reset()         { *m = QueryHeldRecvFeesRequest{} }
+func (m *QueryHeldRecvFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldRecvFeesRequest) ProtoMessage()    {}
+func (*QueryHeldRecvFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{22}
+}
+func (m *QueryHeldRecvFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldRecvFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldRecvFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeldRecvFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldRecvFeesRequest.Merge(m, src)
+}
+func (m *QueryHeldRecvFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldRecvFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldRecvFeesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalAckFees(ctx context.Context, in *QueryTotalAckFeesRequest, opts ...grpc.CallOption) (*QueryTotalAckFeesResponse, error) {
-	out := new(QueryTotalAckFeesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalAckFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeldRecvFeesRequest proto.InternalMessageInfo
+
+func (m *QueryHeldRecvFeesRequest) GetForwardRelayer() string {
+	if m != nil {
+		return m.ForwardRelayer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TotalTimeoutFees(ctx context.Context, in *QueryTotalTimeoutFeesRequest, opts ...grpc.CallOption) (*QueryTotalTimeoutFeesResponse, error) {
-	out := new(QueryTotalTimeoutFeesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalTimeoutFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldRecvFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error) {
-	out := new(QueryPayeeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Payee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryHeldRecvFeesResponse defines the response type for the HeldRecvFees rpc
+type QueryHeldRecvFeesResponse struct {
+	// list of receive fees held in escrow for the forward relayer
+	HeldRecvFees []HeldRecvFees `protobuf:"bytes,1,rep,name=held_recv_fees,json=heldRecvFees,proto3" json:"held_recv_fees"`
+	// the total receive fees held in escrow for the forward relayer
+	TotalHeldRecvFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_held_recv_fees,json=totalHeldRecvFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_held_recv_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) CounterpartyPayee(ctx context.Context, in *QueryCounterpartyPayeeRequest, opts ...grpc.CallOption) (*QueryCounterpartyPayeeResponse, error) {
-	out := new(QueryCounterpartyPayeeResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/CounterpartyPayee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldRecvFeesResponse) Reset()         { *m = QueryHeldRecvFeesResponse{} }
+func (m *QueryHeldRecvFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldRecvFeesResponse) ProtoMessage()    {}
+func (*QueryHeldRecvFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{23}
+}
+func (m *QueryHeldRecvFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldRecvFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldRecvFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeldRecvFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldRecvFeesResponse.Merge(m, src)
+}
+func (m *QueryHeldRecvFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldRecvFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldRecvFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error) {
-	out := new(QueryFeeEnabledChannelsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannels", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeldRecvFeesResponse proto.InternalMessageInfo
+
+func (m *QueryHeldRecvFeesResponse) GetHeldRecvFees() []HeldRecvFees {
+	if m != nil {
+		return m.HeldRecvFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error) {
-	out := new(QueryFeeEnabledChannelResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldRecvFeesResponse) GetTotalHeldRecvFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalHeldRecvFees
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// IncentivizedPackets returns all incentivized packets and their associated fees
-	IncentivizedPackets(context.Context, *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error)
-	// IncentivizedPacket returns all packet fees for a packet given its identifier
-	IncentivizedPacket(context.Context, *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error)
-	// Gets all incentivized packets for a specific channel
-	IncentivizedPacketsForChannel(context.Context, *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error)
-	// TotalRecvFees returns the total receive fees for a packet given its identifier
-	TotalRecvFees(context.Context, *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error)
-	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
-	TotalAckFees(context.Context, *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error)
-	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
-	TotalTimeoutFees(context.Context, *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error)
-	// Payee returns the registered payee address for a specific channel given the relayer address
-	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
-	// CounterpartyPayee returns the registered counterparty payee for forward relaying
-	CounterpartyPayee(context.Context, *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error)
-	// FeeEnabledChannels returns a list of all fee enabled channels
-	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
-	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
-	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+func (m *QueryHeldRecvFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryProtocolFeesRequest defines the request type for the ProtocolFees rpc
+type QueryProtocolFeesRequest struct {
 }
 
-func (*UnimplementedQueryServer) IncentivizedPackets(ctx context.Context, req *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPackets not implemented")
+func (m *QueryProtocolFeesRequest) Reset()         { *m = QueryProtocolFeesRequest{} }
+func (m *QueryProtocolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesRequest) ProtoMessage()    {}
+func (*QueryProtocolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{24}
 }
-func (*UnimplementedQueryServer) IncentivizedPacket(ctx context.Context, req *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPacket not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IncentivizedPacketsForChannel(ctx context.Context, req *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPacketsForChannel not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TotalRecvFees(ctx context.Context, req *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalRecvFees not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TotalAckFees(ctx context.Context, req *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalAckFees not implemented")
+func (m *QueryProtocolFeesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalTimeoutFees(ctx context.Context, req *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalTimeoutFees not implemented")
+func (m *QueryProtocolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Payee(ctx context.Context, req *QueryPayeeRequest) (*QueryPayeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Payee not implemented")
+
+var xxx_messageInfo_QueryProtocolFeesRequest proto.InternalMessageInfo
+
+// QueryProtocolFeesResponse defines the response type for the ProtocolFees rpc
+type QueryProtocolFeesResponse struct {
+	// the total protocol fees sent to the protocol fee recipient
+	TotalProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_protocol_fees,json=totalProtocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_protocol_fees"`
 }
-func (*UnimplementedQueryServer) CounterpartyPayee(ctx context.Context, req *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CounterpartyPayee not implemented")
+
+func (m *QueryProtocolFeesResponse) Reset()         { *m = QueryProtocolFeesResponse{} }
+func (m *QueryProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesResponse) ProtoMessage()    {}
+func (*QueryProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{25}
 }
-func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannels not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
+func (m *QueryProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesResponse.DiscardUnknown(m)
 }
 
-func _Query_IncentivizedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIncentivizedPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentivizedPackets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPackets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentivizedPackets(ctx, req.(*QueryIncentivizedPacketsRequest))
+var xxx_messageInfo_QueryProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesResponse) GetTotalProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalProtocolFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_IncentivizedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIncentivizedPacketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentivizedPacket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPacket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentivizedPacket(ctx, req.(*QueryIncentivizedPacketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRelayerStatsRequest defines the request type for the RelayerStats rpc
+type QueryRelayerStatsRequest struct {
+	// the relayer address the fees are paid to
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_IncentivizedPacketsForChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIncentivizedPacketsForChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentivizedPacketsForChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPacketsForChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentivizedPacketsForChannel(ctx, req.(*QueryIncentivizedPacketsForChannelRequest))
+func (m *QueryRelayerStatsRequest) Reset()         { *m = QueryRelayerStatsRequest{} }
+func (m *QueryRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{26}
+}
+func (m *QueryRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsRequest.DiscardUnknown(m)
 }
 
-func _Query_TotalRecvFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalRecvFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalRecvFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/TotalRecvFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalRecvFees(ctx, req.(*QueryTotalRecvFeesRequest))
+var xxx_messageInfo_QueryRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_TotalAckFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalAckFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalAckFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/TotalAckFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalAckFees(ctx, req.(*QueryTotalAckFeesRequest))
+func (m *QueryRelayerStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalTimeoutFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalTimeoutFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalTimeoutFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/TotalTimeoutFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalTimeoutFees(ctx, req.(*QueryTotalTimeoutFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRelayerStatsResponse defines the response type for the RelayerStats rpc
+type QueryRelayerStatsResponse struct {
+	// list of statistics of the relayer per channel
+	RelayerStats []RelayerStats `protobuf:"bytes,1,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Payee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPayeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Payee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/Payee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Payee(ctx, req.(*QueryPayeeRequest))
+func (m *QueryRelayerStatsResponse) Reset()         { *m = QueryRelayerStatsResponse{} }
+func (m *QueryRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{27}
+}
+func (m *QueryRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_CounterpartyPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCounterpartyPayeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CounterpartyPayee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/CounterpartyPayee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CounterpartyPayee(ctx, req.(*QueryCounterpartyPayeeRequest))
+var xxx_messageInfo_QueryRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsResponse) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FeeEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeEnabledChannelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeEnabledChannels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/FeeEnabledChannels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeEnabledChannels(ctx, req.(*QueryFeeEnabledChannelsRequest))
+func (m *QueryRelayerStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FeeEnabledChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeEnabledChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeEnabledChannel(ctx, in)
+// QueryChannelRelayerStatsRequest defines the request type for the ChannelRelayerStats rpc
+type QueryChannelRelayerStatsRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelRelayerStatsRequest) Reset()         { *m = QueryChannelRelayerStatsRequest{} }
+func (m *QueryChannelRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryChannelRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{28}
+}
+func (m *QueryChannelRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.fee.v1.Query/FeeEnabledChannel",
+}
+func (m *QueryChannelRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryChannelRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelRelayerStatsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeEnabledChannel(ctx, req.(*QueryFeeEnabledChannelRequest))
+	return ""
+}
+
+func (m *QueryChannelRelayerStatsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "IncentivizedPackets",
-			Handler:    _Query_IncentivizedPackets_Handler,
-		},
-		{
-			MethodName: "IncentivizedPacket",
-			Handler:    _Query_IncentivizedPacket_Handler,
-		},
-		{
-			MethodName: "IncentivizedPacketsForChannel",
-			Handler:    _Query_IncentivizedPacketsForChannel_Handler,
-		},
-		{
-			MethodName: "TotalRecvFees",
-			Handler:    _Query_TotalRecvFees_Handler,
-		},
-		{
-			MethodName: "TotalAckFees",
-			Handler:    _Query_TotalAckFees_Handler,
-		},
-		{
-			MethodName: "TotalTimeoutFees",
-			Handler:    _Query_TotalTimeoutFees_Handler,
-		},
-		{
-			MethodName: "Payee",
-			Handler:    _Query_Payee_Handler,
-		},
-		{
-			MethodName: "CounterpartyPayee",
-			Handler:    _Query_CounterpartyPayee_Handler,
-		},
-		{
-			MethodName: "FeeEnabledChannels",
-			Handler:    _Query_FeeEnabledChannels_Handler,
-		},
-		{
-			MethodName: "FeeEnabledChannel",
-			Handler:    _Query_FeeEnabledChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/fee/v1/query.proto",
-}
-
-func (m *QueryIncentivizedPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryChannelRelayerStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIncentivizedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryChannelRelayerStatsResponse defines the response type for the ChannelRelayerStats rpc
+type QueryChannelRelayerStatsResponse struct {
+	// list of statistics of the relayers on the channel
+	RelayerStats []RelayerStats `protobuf:"bytes,1,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIncentivizedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryChannelRelayerStatsResponse) Reset()         { *m = QueryChannelRelayerStatsResponse{} }
+func (m *QueryChannelRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryChannelRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{29}
+}
+func (m *QueryChannelRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChannelRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryChannelRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRelayerStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIncentivizedPacketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelRelayerStatsResponse) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryChannelRelayerStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IncentivizedPackets) > 0 {
-		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryRelayerLeaderboardRequest defines the request type for the RelayerLeaderboard rpc
+type QueryRelayerLeaderboardRequest struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the denomination of the fees the relayers are ranked by
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the maximum number of relayers returned, all relayers on the channel are returned if zero
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryRelayerLeaderboardRequest) Reset()         { *m = QueryRelayerLeaderboardRequest{} }
+func (m *QueryRelayerLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerLeaderboardRequest) ProtoMessage()    {}
+func (*QueryRelayerLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{30}
+}
+func (m *QueryRelayerLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerLeaderboardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelayerLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerLeaderboardRequest.Merge(m, src)
+}
+func (m *QueryRelayerLeaderboardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerLeaderboardRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIncentivizedPacketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayerLeaderboardRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerLeaderboardRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryIncentivizedPacketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRelayerLeaderboardRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *QueryIncentivizedPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRelayerLeaderboardRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryIncentivizedPacketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRelayerLeaderboardRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryIncentivizedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRelayerLeaderboardResponse defines the response type for the RelayerLeaderboard rpc
+type QueryRelayerLeaderboardResponse struct {
+	// list of statistics of the relayers on the channel in descending order of the fees paid in the given denomination
+	RelayerStats []RelayerStats `protobuf:"bytes,1,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
 }
 
-func (m *QueryIncentivizedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.IncentivizedPacket.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRelayerLeaderboardResponse) Reset()         { *m = QueryRelayerLeaderboardResponse{} }
+func (m *QueryRelayerLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerLeaderboardResponse) ProtoMessage()    {}
+func (*QueryRelayerLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{31}
+}
+func (m *QueryRelayerLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerLeaderboardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelayerLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerLeaderboardResponse.Merge(m, src)
+}
+func (m *QueryRelayerLeaderboardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerLeaderboardResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIncentivizedPacketsForChannelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelayerLeaderboardResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerLeaderboardResponse) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
+	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
+	proto.RegisterType((*QueryIncentivizedPacketRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketRequest")
+	proto.RegisterType((*QueryIncentivizedPacketResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketResponse")
+	proto.RegisterType((*QueryIncentivizedPacketsForChannelRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsForChannelRequest")
+	proto.RegisterType((*QueryIncentivizedPacketsForChannelResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsForChannelResponse")
+	proto.RegisterType((*QueryTotalRecvFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalRecvFeesRequest")
+	proto.RegisterType((*QueryTotalRecvFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalRecvFeesResponse")
+	proto.RegisterType((*QueryTotalAckFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalAckFeesRequest")
+	proto.RegisterType((*QueryTotalAckFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalAckFeesResponse")
+	proto.RegisterType((*QueryTotalTimeoutFeesRequest)(nil), "ibc.applications.fee.v1.QueryTotalTimeoutFeesRequest")
+	proto.RegisterType((*QueryTotalTimeoutFeesResponse)(nil), "ibc.applications.fee.v1.QueryTotalTimeoutFeesResponse")
+	proto.RegisterType((*QueryPayeeRequest)(nil), "ibc.applications.fee.v1.QueryPayeeRequest")
+	proto.RegisterType((*QueryPayeeResponse)(nil), "ibc.applications.fee.v1.QueryPayeeResponse")
+	proto.RegisterType((*QueryCounterpartyPayeeRequest)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeRequest")
+	proto.RegisterType((*QueryCounterpartyPayeeResponse)(nil), "ibc.applications.fee.v1.QueryCounterpartyPayeeResponse")
+	proto.RegisterType((*QueryFeeEnabledChannelsRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsRequest")
+	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
+	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
+	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.fee.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.fee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryHeldRecvFeesRequest)(nil), "ibc.applications.fee.v1.QueryHeldRecvFeesRequest")
+	proto.RegisterType((*QueryHeldRecvFeesResponse)(nil), "ibc.applications.fee.v1.QueryHeldRecvFeesResponse")
+	proto.RegisterType((*QueryProtocolFeesRequest)(nil), "ibc.applications.fee.v1.QueryProtocolFeesRequest")
+	proto.RegisterType((*QueryProtocolFeesResponse)(nil), "ibc.applications.fee.v1.QueryProtocolFeesResponse")
+	proto.RegisterType((*QueryRelayerStatsRequest)(nil), "ibc.applications.fee.v1.QueryRelayerStatsRequest")
+	proto.RegisterType((*QueryRelayerStatsResponse)(nil), "ibc.applications.fee.v1.QueryRelayerStatsResponse")
+	proto.RegisterType((*QueryChannelRelayerStatsRequest)(nil), "ibc.applications.fee.v1.QueryChannelRelayerStatsRequest")
+	proto.RegisterType((*QueryChannelRelayerStatsResponse)(nil), "ibc.applications.fee.v1.QueryChannelRelayerStatsResponse")
+	proto.RegisterType((*QueryRelayerLeaderboardRequest)(nil), "ibc.applications.fee.v1.QueryRelayerLeaderboardRequest")
+	proto.RegisterType((*QueryRelayerLeaderboardResponse)(nil), "ibc.applications.fee.v1.QueryRelayerLeaderboardResponse")
 }
 
-func (m *QueryIncentivizedPacketsForChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("ibc/applications/fee/v1/query.proto", fileDescriptor_0638a8a78ca2503c)
 }
 
-func (m *QueryIncentivizedPacketsForChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_0638a8a78ca2503c = []byte{
+	// 1733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdf, 0x6f, 0x13, 0xc7,
+	0x16, 0xce, 0x18, 0x08, 0xc9, 0x89, 0xe1, 0xde, 0x4c, 0x72, 0x45, 0xb2, 0x22, 0x4e, 0x58, 0x2e,
+	0x10, 0xc2, 0x8d, 0xf7, 0xc6, 0x88, 0x9b, 0xe4, 0xea, 0x4a, 0xb7, 0x24, 0x34, 0x10, 0x9a, 0x42,
+	0x30, 0x48, 0xad, 0xaa, 0x56, 0x66, 0xbd, 0x3b, 0x76, 0x56, 0x71, 0x76, 0xcd, 0xee, 0x26, 0x6d,
+	0x08, 0xe9, 0x4f, 0x68, 0x2b, 0xb5, 0x12, 0x95, 0xfa, 0xd2, 0x97, 0x3e, 0xf4, 0xad, 0xad, 0xc4,
+	0x63, 0x2b, 0xf5, 0xa1, 0xef, 0x3c, 0x21, 0x24, 0x1e, 0x8a, 0x5a, 0xa9, 0xad, 0xa0, 0x7f, 0x44,
+	0x1f, 0x5a, 0xa9, 0xda, 0x99, 0xb3, 0xf6, 0xda, 0xbb, 0x1b, 0xc7, 0xc6, 0xa1, 0x7d, 0xb2, 0x77,
+	0x66, 0xce, 0x99, 0xef, 0xfb, 0xe6, 0xec, 0xcc, 0x7e, 0x03, 0x87, 0x8d, 0xbc, 0xa6, 0xa8, 0xe5,
+	0x72, 0xc9, 0xd0, 0x54, 0xd7, 0xb0, 0x4c, 0x47, 0x29, 0x30, 0xa6, 0xac, 0x4d, 0x28, 0xd7, 0x56,
+	0x99, 0xbd, 0x9e, 0x2e, 0xdb, 0x96, 0x6b, 0xd1, 0x03, 0x46, 0x5e, 0x4b, 0x07, 0x07, 0xa5, 0x0b,
+	0x8c, 0xa5, 0xd7, 0x26, 0xa4, 0xfe, 0xa2, 0x55, 0xb4, 0xf8, 0x18, 0xc5, 0xfb, 0x27, 0x86, 0x4b,
+	0x07, 0x8b, 0x96, 0x55, 0x2c, 0x31, 0x45, 0x2d, 0x1b, 0x8a, 0x6a, 0x9a, 0x96, 0x8b, 0x41, 0xa2,
+	0x37, 0xa5, 0x59, 0xce, 0x8a, 0xe5, 0x28, 0x79, 0xd5, 0xf1, 0x26, 0xca, 0x33, 0x57, 0x9d, 0x50,
+	0x34, 0xcb, 0x30, 0xb1, 0x7f, 0x2c, 0xd8, 0xcf, 0x51, 0x54, 0x46, 0x95, 0xd5, 0xa2, 0x61, 0xf2,
+	0x64, 0x38, 0xf6, 0x50, 0x1c, 0x7a, 0x0f, 0x9f, 0x18, 0x72, 0x24, 0x6e, 0x48, 0x91, 0x99, 0xcc,
+	0x31, 0x9c, 0x60, 0x26, 0xcd, 0xb2, 0x99, 0xa2, 0x2d, 0xa9, 0xa6, 0xc9, 0x4a, 0xde, 0x10, 0xfc,
+	0x2b, 0x86, 0xc8, 0x1f, 0x12, 0x18, 0xbe, 0xe4, 0xe1, 0x99, 0x37, 0x35, 0x66, 0xba, 0xc6, 0x9a,
+	0x71, 0x9d, 0xe9, 0x8b, 0xaa, 0xb6, 0xcc, 0x5c, 0x27, 0xcb, 0xae, 0xad, 0x32, 0xc7, 0xa5, 0x73,
+	0x00, 0x55, 0x90, 0x03, 0x64, 0x84, 0x8c, 0xf6, 0x64, 0x8e, 0xa6, 0x05, 0xa3, 0xb4, 0xc7, 0x28,
+	0x2d, 0x74, 0x45, 0x46, 0xe9, 0x45, 0xb5, 0xc8, 0x30, 0x36, 0x1b, 0x88, 0xa4, 0x87, 0x20, 0xc9,
+	0x07, 0xe6, 0x96, 0x98, 0x51, 0x5c, 0x72, 0x07, 0x12, 0x23, 0x64, 0x74, 0x77, 0xb6, 0x87, 0xb7,
+	0x9d, 0xe3, 0x4d, 0xf2, 0x03, 0x02, 0x23, 0xf1, 0x70, 0x9c, 0xb2, 0x65, 0x3a, 0x8c, 0x16, 0xa0,
+	0xdf, 0x08, 0x74, 0xe7, 0xca, 0xa2, 0x7f, 0x80, 0x8c, 0xec, 0x1a, 0xed, 0xc9, 0x8c, 0xa7, 0x63,
+	0x16, 0x36, 0x3d, 0xaf, 0x7b, 0x31, 0x05, 0xc3, 0xcf, 0x38, 0xc7, 0x98, 0x33, 0xb3, 0xfb, 0xee,
+	0x8f, 0xc3, 0x1d, 0xd9, 0x3e, 0x23, 0x3c, 0x1f, 0x3d, 0x5b, 0xc3, 0x3b, 0xc1, 0x79, 0x1f, 0x6b,
+	0xc8, 0x5b, 0x80, 0x0c, 0x12, 0x97, 0x6f, 0x11, 0x48, 0xc5, 0xb0, 0xf2, 0x35, 0x7e, 0x06, 0xba,
+	0x05, 0x8d, 0x9c, 0xa1, 0xa3, 0xc4, 0x43, 0x9c, 0x88, 0xb7, 0x7c, 0x69, 0x7f, 0xcd, 0xd6, 0xbc,
+	0x49, 0xbc, 0x51, 0xf3, 0x3a, 0x02, 0xef, 0x2a, 0xe3, 0xf3, 0x76, 0xd4, 0x7d, 0x2f, 0x7e, 0xb1,
+	0x2b, 0xe2, 0xea, 0xd0, 0x17, 0x21, 0x2e, 0x42, 0x6a, 0x49, 0x5b, 0x1a, 0xd6, 0x56, 0xbe, 0x47,
+	0xe0, 0x78, 0xdc, 0x3a, 0xcf, 0x59, 0xf6, 0xac, 0xe0, 0xdb, 0xee, 0x02, 0x3c, 0x00, 0x7b, 0xcb,
+	0x96, 0xcd, 0x25, 0xf6, 0xd4, 0xe9, 0xce, 0x76, 0x7a, 0x8f, 0xf3, 0x3a, 0x1d, 0x02, 0x40, 0x89,
+	0xbd, 0xbe, 0x5d, 0xbc, 0xaf, 0x1b, 0x5b, 0x22, 0xa4, 0xdd, 0x1d, 0x96, 0xf6, 0x3b, 0x02, 0x63,
+	0xdb, 0x21, 0x84, 0x2a, 0x5f, 0x6d, 0x63, 0x09, 0xef, 0x70, 0xf1, 0xbe, 0x02, 0x83, 0x9c, 0xd8,
+	0x15, 0xcb, 0x55, 0x4b, 0x59, 0xa6, 0xad, 0xf1, 0x39, 0xdb, 0x55, 0xb6, 0xf2, 0xbb, 0x04, 0xa4,
+	0xa8, 0xfc, 0x28, 0xd4, 0x12, 0x74, 0xdb, 0x4c, 0x5b, 0xcb, 0x15, 0x18, 0xf3, 0xd5, 0x19, 0xac,
+	0x61, 0xe1, 0xe3, 0x9f, 0xb5, 0x0c, 0x73, 0xe6, 0xdf, 0x5e, 0xf2, 0x2f, 0x7f, 0x1a, 0x1e, 0x2d,
+	0x1a, 0xee, 0xd2, 0x6a, 0x3e, 0xad, 0x59, 0x2b, 0x8a, 0x18, 0x8c, 0x3f, 0xe3, 0x8e, 0xbe, 0xac,
+	0xb8, 0xeb, 0x65, 0xe6, 0xf0, 0x00, 0x27, 0xdb, 0x65, 0xe3, 0x8c, 0xf2, 0xcb, 0x30, 0x50, 0xc5,
+	0x71, 0x5a, 0x5b, 0x6e, 0x2f, 0xcd, 0x77, 0x08, 0x0c, 0x46, 0xa4, 0xaf, 0xec, 0x68, 0x5d, 0xaa,
+	0xb6, 0xbc, 0x63, 0x24, 0xf7, 0xaa, 0x62, 0x3e, 0xf9, 0x2a, 0x1c, 0xac, 0x82, 0xb8, 0x62, 0xac,
+	0x30, 0x6b, 0xd5, 0x6d, 0x2f, 0xcf, 0xdb, 0x04, 0x86, 0x62, 0xa6, 0x40, 0xae, 0x26, 0x24, 0x5d,
+	0xd1, 0xbc, 0x63, 0x7c, 0x7b, 0xdc, 0xea, 0xbc, 0xf2, 0x02, 0xf4, 0x72, 0x40, 0x8b, 0xea, 0x3a,
+	0xf3, 0x77, 0x85, 0xba, 0x17, 0x9e, 0xd4, 0xbf, 0xf0, 0x03, 0xb0, 0xd7, 0x66, 0x25, 0x75, 0x9d,
+	0xd9, 0xb8, 0x51, 0xf8, 0x8f, 0xf2, 0x34, 0xd0, 0x60, 0x36, 0xe4, 0x74, 0x18, 0xf6, 0x95, 0xbd,
+	0x86, 0x9c, 0xaa, 0xeb, 0x36, 0x73, 0x1c, 0xcc, 0x98, 0xe4, 0x8d, 0xa7, 0x45, 0x9b, 0xfc, 0x22,
+	0x2a, 0x33, 0x6b, 0xad, 0x9a, 0x2e, 0xb3, 0xcb, 0xaa, 0xed, 0xb6, 0x09, 0xd4, 0x45, 0x48, 0xc5,
+	0x65, 0x46, 0x80, 0xe3, 0x40, 0xb5, 0x40, 0x67, 0x8e, 0x03, 0xc3, 0x29, 0x7a, 0xb5, 0xfa, 0x30,
+	0xf9, 0x03, 0xff, 0xc0, 0x9a, 0x63, 0xec, 0x59, 0x53, 0xcd, 0x97, 0x98, 0x8e, 0x3b, 0xd8, 0x9f,
+	0xf1, 0x51, 0x70, 0xcf, 0x3f, 0xb6, 0xa2, 0xd0, 0x20, 0xc1, 0x3c, 0xf4, 0x17, 0x18, 0xcb, 0x31,
+	0xd1, 0x9d, 0x43, 0xd5, 0xfc, 0xea, 0x1a, 0x8b, 0xdd, 0x50, 0x43, 0x29, 0xfd, 0x43, 0xab, 0x10,
+	0x9a, 0xab, 0x7d, 0x5b, 0xea, 0x0b, 0x58, 0x09, 0xa1, 0xc9, 0x7d, 0x71, 0x03, 0x07, 0x15, 0xd9,
+	0xe2, 0xa0, 0x4a, 0xd4, 0x95, 0x88, 0xec, 0xc6, 0x2d, 0x5b, 0x45, 0xa7, 0x61, 0xe8, 0x09, 0xe8,
+	0xc4, 0xb3, 0x77, 0x65, 0xa1, 0x4a, 0x96, 0x4e, 0xc0, 0x3f, 0x1c, 0x66, 0xea, 0x39, 0xc7, 0xd0,
+	0x59, 0x2e, 0x38, 0x34, 0xc1, 0x87, 0x52, 0xaf, 0xf3, 0xb2, 0xa1, 0xb3, 0xea, 0x14, 0x72, 0x7f,
+	0xe5, 0x9d, 0xb0, 0xd5, 0x15, 0xbf, 0x40, 0xe4, 0x0b, 0xd0, 0x57, 0xd3, 0x8a, 0x00, 0x26, 0xa1,
+	0xb3, 0xcc, 0x5b, 0xb0, 0x66, 0x86, 0x63, 0x97, 0x06, 0x03, 0x71, 0xb8, 0x57, 0x93, 0x62, 0x83,
+	0x3e, 0xc7, 0x4a, 0x7a, 0xfd, 0x39, 0x74, 0x0c, 0xfe, 0x56, 0xb0, 0xec, 0x57, 0x55, 0x5b, 0xcf,
+	0xf9, 0xef, 0x88, 0x10, 0x6e, 0x3f, 0x36, 0x67, 0x45, 0x6b, 0x5d, 0xd9, 0x26, 0x5a, 0x2d, 0x5b,
+	0xf9, 0xab, 0x04, 0x0c, 0x46, 0xa0, 0x41, 0x92, 0x97, 0x60, 0xff, 0x12, 0x2b, 0xe9, 0xb9, 0xfa,
+	0xa3, 0xeb, 0x48, 0x2c, 0xd9, 0x60, 0x1a, 0x2c, 0xc1, 0xe4, 0x52, 0xa0, 0x8d, 0xde, 0x80, 0x7e,
+	0xd7, 0xdb, 0x52, 0x73, 0x75, 0x89, 0x13, 0xed, 0xdf, 0x3e, 0x7b, 0xf9, 0x44, 0x41, 0x44, 0x75,
+	0xa5, 0xbf, 0xab, 0xf5, 0xd2, 0x97, 0x70, 0x11, 0x17, 0x3d, 0xf7, 0xa1, 0x59, 0xa5, 0xc0, 0x22,
+	0xca, 0x9f, 0xf8, 0x67, 0x64, 0x6d, 0x27, 0x6a, 0xba, 0x01, 0x7d, 0x42, 0x80, 0x32, 0xf6, 0xee,
+	0xd8, 0xf1, 0x21, 0xf8, 0x07, 0x41, 0xc8, 0x37, 0x10, 0x36, 0x96, 0xd1, 0x65, 0x57, 0xad, 0xda,
+	0xa3, 0xc0, 0xbe, 0x4c, 0x6a, 0xf6, 0xe5, 0xb6, 0x15, 0xdb, 0xd7, 0xbe, 0x30, 0xb5, 0xd3, 0xa3,
+	0x30, 0x8b, 0xb0, 0x0f, 0x27, 0xcc, 0x39, 0x5e, 0x47, 0xc3, 0x5a, 0x0b, 0x66, 0xf1, 0x6b, 0xcd,
+	0x0e, 0xb4, 0xb5, 0x6f, 0xa3, 0xfb, 0xcc, 0xdf, 0xb9, 0x2b, 0xdb, 0x50, 0x58, 0xbe, 0x16, 0xf7,
+	0x3a, 0x3a, 0x17, 0x51, 0x92, 0xad, 0x88, 0xfb, 0xad, 0x6f, 0x39, 0x23, 0x31, 0xfe, 0xf5, 0x35,
+	0xbe, 0xe9, 0x9f, 0xd5, 0x38, 0xe5, 0x02, 0x53, 0x75, 0x66, 0xe7, 0x2d, 0xbe, 0xe9, 0x3d, 0x99,
+	0xc4, 0xfd, 0xb0, 0x47, 0x67, 0xa6, 0xb5, 0x82, 0x8e, 0x48, 0x3c, 0x78, 0xad, 0x25, 0x63, 0xc5,
+	0xf0, 0x6d, 0x90, 0x78, 0x90, 0x1d, 0x18, 0x8e, 0x45, 0xb1, 0x53, 0x22, 0x66, 0xee, 0x48, 0xb0,
+	0x87, 0xcf, 0x4a, 0xbf, 0x21, 0xd0, 0x17, 0x61, 0xbd, 0xe8, 0x54, 0x6c, 0xf2, 0x06, 0xb7, 0x1e,
+	0xd2, 0x74, 0x0b, 0x91, 0x82, 0xa8, 0x3c, 0xfe, 0xf6, 0x83, 0x5f, 0x3e, 0x4e, 0x1c, 0xa3, 0x47,
+	0x14, 0xbc, 0xa7, 0xa9, 0xdc, 0xcf, 0x44, 0x99, 0x3e, 0x7a, 0x3b, 0x01, 0x34, 0x9c, 0x8e, 0x4e,
+	0x36, 0x0b, 0xc0, 0x47, 0x3e, 0xd5, 0x7c, 0x20, 0x02, 0xbf, 0x45, 0x38, 0xf2, 0x37, 0xe8, 0x66,
+	0x08, 0xb9, 0xff, 0x45, 0xa5, 0x6c, 0x54, 0x1c, 0x42, 0xba, 0x5a, 0x3b, 0x9b, 0x8a, 0x57, 0x51,
+	0x35, 0x9d, 0x58, 0x71, 0x9b, 0x8a, 0xe3, 0xc1, 0x32, 0x35, 0x56, 0xd3, 0xeb, 0x37, 0x6e, 0x46,
+	0x49, 0x42, 0x7f, 0x27, 0x30, 0xb4, 0xa5, 0x91, 0xa6, 0x33, 0x4d, 0xaf, 0x4e, 0xe8, 0x5a, 0x41,
+	0x9a, 0x7d, 0xa2, 0x1c, 0x28, 0xd9, 0x65, 0xae, 0xd8, 0xf3, 0xf4, 0xb9, 0x2d, 0x14, 0x8b, 0xd2,
+	0xc9, 0x57, 0x27, 0xb2, 0x22, 0x7e, 0x23, 0xb0, 0xaf, 0xc6, 0x0f, 0xd3, 0xcc, 0xd6, 0x58, 0xa3,
+	0xcc, 0xb9, 0x74, 0xb2, 0xa9, 0x18, 0xe4, 0xf3, 0x96, 0x28, 0x81, 0x0d, 0xba, 0xfe, 0xf4, 0x4a,
+	0x40, 0x9c, 0xeb, 0x95, 0x6f, 0x1a, 0xfa, 0x2b, 0x81, 0x64, 0xd0, 0x27, 0xd3, 0x89, 0x6d, 0x30,
+	0xa9, 0xb5, 0xec, 0x52, 0xa6, 0x99, 0x10, 0xe4, 0xfe, 0xa6, 0xe0, 0x7e, 0x9d, 0xbe, 0xf6, 0xb4,
+	0xb9, 0xfb, 0xee, 0x9f, 0xbe, 0x9f, 0x80, 0xbf, 0xd7, 0x5b, 0x67, 0x7a, 0x6a, 0x1b, 0x5c, 0xc2,
+	0x6e, 0x5e, 0xfa, 0x4f, 0xb3, 0x61, 0x28, 0xc3, 0x4d, 0x21, 0xc3, 0xeb, 0xf4, 0xc6, 0xd3, 0x96,
+	0x21, 0x78, 0x31, 0x40, 0xbf, 0x20, 0xb0, 0x87, 0xdb, 0x51, 0x3a, 0xb6, 0x35, 0x91, 0xa0, 0x89,
+	0x96, 0x4e, 0x6c, 0x6b, 0x2c, 0x32, 0x3d, 0xcb, 0x89, 0x9e, 0xa6, 0xff, 0xdf, 0xe6, 0xcb, 0x8b,
+	0x87, 0x8f, 0xa3, 0x6c, 0xe0, 0xbf, 0x4d, 0x85, 0x3b, 0x69, 0xfa, 0x3d, 0x81, 0xde, 0x90, 0xfb,
+	0xa6, 0x0d, 0x16, 0x20, 0xee, 0x22, 0x40, 0x9a, 0x6c, 0x3a, 0x0e, 0xf9, 0x5c, 0xe1, 0x7c, 0x2e,
+	0xd0, 0x85, 0xd6, 0xf9, 0x84, 0xaf, 0x09, 0xe8, 0x1d, 0x02, 0x34, 0x6c, 0xbd, 0x1b, 0x9d, 0x4f,
+	0xb1, 0x57, 0x07, 0xd2, 0x54, 0xf3, 0x81, 0xc8, 0xef, 0x9f, 0x9c, 0x5f, 0x8a, 0x1e, 0x0c, 0xf1,
+	0x0b, 0x38, 0x55, 0x7a, 0x9f, 0x40, 0x6f, 0x28, 0x49, 0xa3, 0xc5, 0x88, 0xf3, 0xe2, 0xd2, 0x64,
+	0xd3, 0x71, 0x08, 0xf6, 0x3c, 0x07, 0x7b, 0x86, 0xce, 0xb4, 0x78, 0x32, 0x04, 0x29, 0xdd, 0x24,
+	0xd0, 0x29, 0xfc, 0x30, 0x6d, 0x58, 0xe0, 0x01, 0x13, 0x2e, 0xfd, 0x6b, 0x7b, 0x83, 0x11, 0xf1,
+	0x30, 0x47, 0x3c, 0x48, 0x0f, 0x84, 0x10, 0x0b, 0x0f, 0xee, 0x7d, 0x65, 0x25, 0x6b, 0x7c, 0x61,
+	0x83, 0x8d, 0x39, 0xc2, 0xaa, 0x4b, 0x99, 0x66, 0x42, 0x10, 0xd8, 0x0c, 0x07, 0xf6, 0x3f, 0xfa,
+	0xdf, 0x10, 0xb0, 0x6a, 0x05, 0xd7, 0xf9, 0xff, 0x4d, 0xa5, 0xd6, 0x28, 0xd3, 0x4f, 0x09, 0x24,
+	0x83, 0x9e, 0xae, 0x11, 0xf6, 0x08, 0x87, 0x2a, 0x65, 0x9a, 0x09, 0x41, 0xec, 0x47, 0x39, 0xf6,
+	0x11, 0x9a, 0x0a, 0x8b, 0x1a, 0x34, 0xb2, 0xf4, 0x73, 0x02, 0xc9, 0xe0, 0x07, 0x6f, 0x23, 0x7c,
+	0x11, 0x5e, 0x4a, 0xca, 0x34, 0x13, 0x82, 0xf8, 0x26, 0x38, 0xbe, 0x13, 0xf4, 0xf8, 0x16, 0xda,
+	0x56, 0x34, 0xe5, 0xdf, 0xed, 0xf4, 0x21, 0x81, 0xbe, 0x08, 0xb7, 0xd4, 0xe8, 0x63, 0x3b, 0xde,
+	0x04, 0x4a, 0xd3, 0x2d, 0x44, 0x22, 0xfe, 0x05, 0x8e, 0x7f, 0x8e, 0x9e, 0x69, 0xf1, 0x35, 0xab,
+	0xb1, 0x24, 0xf4, 0x07, 0x02, 0x34, 0x6c, 0x61, 0x1a, 0xed, 0x75, 0xb1, 0xd6, 0x4b, 0x9a, 0x6a,
+	0x3e, 0x10, 0x79, 0x65, 0x39, 0xaf, 0x05, 0x7a, 0xfe, 0x09, 0x79, 0x95, 0xaa, 0xb9, 0x67, 0x2e,
+	0xde, 0x7d, 0x94, 0x22, 0xf7, 0x1f, 0xa5, 0xc8, 0xcf, 0x8f, 0x52, 0xe4, 0xa3, 0xc7, 0xa9, 0x8e,
+	0xfb, 0x8f, 0x53, 0x1d, 0x0f, 0x1f, 0xa7, 0x3a, 0x5e, 0x3a, 0x15, 0xbe, 0x1d, 0x31, 0xf2, 0xda,
+	0x78, 0xd1, 0x52, 0xd6, 0xa6, 0x94, 0x15, 0x4b, 0x5f, 0x2d, 0x31, 0x47, 0x80, 0xc8, 0x4c, 0x8f,
+	0x7b, 0x38, 0xf8, 0x85, 0x49, 0xbe, 0x93, 0xd7, 0xf0, 0xc9, 0x3f, 0x06, 0x00, 0x71, 0x0b, 0x04,
+	0x35, 0x72, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// IncentivizedPackets returns all incentivized packets and their associated fees
+	IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error)
+	// IncentivizedPacket returns all packet fees for a packet given its identifier
+	IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error)
+	// Gets all incentivized packets for a specific channel
+	IncentivizedPacketsForChannel(ctx context.Context, in *QueryIncentivizedPacketsForChannelRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsForChannelResponse, error)
+	// TotalRecvFees returns the total receive fees for a packet given its identifier
+	TotalRecvFees(ctx context.Context, in *QueryTotalRecvFeesRequest, opts ...grpc.CallOption) (*QueryTotalRecvFeesResponse, error)
+	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
+	TotalAckFees(ctx context.Context, in *QueryTotalAckFeesRequest, opts ...grpc.CallOption) (*QueryTotalAckFeesResponse, error)
+	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
+	TotalTimeoutFees(ctx context.Context, in *QueryTotalTimeoutFeesRequest, opts ...grpc.CallOption) (*QueryTotalTimeoutFeesResponse, error)
+	// Payee returns the registered payee address for a specific channel given the relayer address
+	Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error)
+	// CounterpartyPayee returns the registered counterparty payee for forward relaying
+	CounterpartyPayee(ctx context.Context, in *QueryCounterpartyPayeeRequest, opts ...grpc.CallOption) (*QueryCounterpartyPayeeResponse, error)
+	// FeeEnabledChannels returns a list of all fee enabled channels
+	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
+	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
+	FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error)
+	// Params returns the ICS29 fee middleware parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// HeldRecvFees returns the receive fees held in escrow for the given forward relayer until the dispute window has
+	// elapsed
+	HeldRecvFees(ctx context.Context, in *QueryHeldRecvFeesRequest, opts ...grpc.CallOption) (*QueryHeldRecvFeesResponse, error)
+	// ProtocolFees returns the total protocol fees sent to the protocol fee recipient
+	ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error)
+	// RelayerStats returns the statistics of the given relayer on all channels
+	RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error)
+	// ChannelRelayerStats returns the statistics of all relayers on the given channel
+	ChannelRelayerStats(ctx context.Context, in *QueryChannelRelayerStatsRequest, opts ...grpc.CallOption) (*QueryChannelRelayerStatsResponse, error)
+	// RelayerLeaderboard returns the statistics of the relayers on the given channel sorted by the fees paid in the given
+	// denomination
+	RelayerLeaderboard(ctx context.Context, in *QueryRelayerLeaderboardRequest, opts ...grpc.CallOption) (*QueryRelayerLeaderboardResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) IncentivizedPackets(ctx context.Context, in *QueryIncentivizedPacketsRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsResponse, error) {
+	out := new(QueryIncentivizedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPackets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncentivizedPacket(ctx context.Context, in *QueryIncentivizedPacketRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketResponse, error) {
+	out := new(QueryIncentivizedPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IncentivizedPackets) > 0 {
-		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) IncentivizedPacketsForChannel(ctx context.Context, in *QueryIncentivizedPacketsForChannelRequest, opts ...grpc.CallOption) (*QueryIncentivizedPacketsForChannelResponse, error) {
+	out := new(QueryIncentivizedPacketsForChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/IncentivizedPacketsForChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalRecvFees(ctx context.Context, in *QueryTotalRecvFeesRequest, opts ...grpc.CallOption) (*QueryTotalRecvFeesResponse, error) {
+	out := new(QueryTotalRecvFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalRecvFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalAckFees(ctx context.Context, in *QueryTotalAckFeesRequest, opts ...grpc.CallOption) (*QueryTotalAckFeesResponse, error) {
+	out := new(QueryTotalAckFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalAckFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) TotalTimeoutFees(ctx context.Context, in *QueryTotalTimeoutFeesRequest, opts ...grpc.CallOption) (*QueryTotalTimeoutFeesResponse, error) {
+	out := new(QueryTotalTimeoutFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/TotalTimeoutFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error) {
+	out := new(QueryPayeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Payee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CounterpartyPayee(ctx context.Context, in *QueryCounterpartyPayeeRequest, opts ...grpc.CallOption) (*QueryCounterpartyPayeeResponse, error) {
+	out := new(QueryCounterpartyPayeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/CounterpartyPayee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalRecvFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecvFees) > 0 {
-		for iNdEx := len(m.RecvFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error) {
+	out := new(QueryFeeEnabledChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalAckFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error) {
+	out := new(QueryFeeEnabledChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/FeeEnabledChannel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalAckFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalAckFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalAckFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeldRecvFees(ctx context.Context, in *QueryHeldRecvFeesRequest, opts ...grpc.CallOption) (*QueryHeldRecvFeesResponse, error) {
+	out := new(QueryHeldRecvFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/HeldRecvFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalAckFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTotalAckFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AckFees) > 0 {
-		for iNdEx := len(m.AckFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AckFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error) {
+	out := new(QueryProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/ProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalTimeoutFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error) {
+	out := new(QueryRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/RelayerStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalTimeoutFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTotalTimeoutFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ChannelRelayerStats(ctx context.Context, in *QueryChannelRelayerStatsRequest, opts ...grpc.CallOption) (*QueryChannelRelayerStatsResponse, error) {
+	out := new(QueryChannelRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/ChannelRelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTotalTimeoutFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RelayerLeaderboard(ctx context.Context, in *QueryRelayerLeaderboardRequest, opts ...grpc.CallOption) (*QueryRelayerLeaderboardResponse, error) {
+	out := new(QueryRelayerLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/RelayerLeaderboard", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalTimeoutFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// IncentivizedPackets returns all incentivized packets and their associated fees
+	IncentivizedPackets(context.Context, *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error)
+	// IncentivizedPacket returns all packet fees for a packet given its identifier
+	IncentivizedPacket(context.Context, *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error)
+	// Gets all incentivized packets for a specific channel
+	IncentivizedPacketsForChannel(context.Context, *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error)
+	// TotalRecvFees returns the total receive fees for a packet given its identifier
+	TotalRecvFees(context.Context, *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error)
+	// TotalAckFees returns the total acknowledgement fees for a packet given its identifier
+	TotalAckFees(context.Context, *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error)
+	// TotalTimeoutFees returns the total timeout fees for a packet given its identifier
+	TotalTimeoutFees(context.Context, *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error)
+	// Payee returns the registered payee address for a specific channel given the relayer address
+	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
+	// CounterpartyPayee returns the registered counterparty payee for forward relaying
+	CounterpartyPayee(context.Context, *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error)
+	// FeeEnabledChannels returns a list of all fee enabled channels
+	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
+	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
+	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+	// Params returns the ICS29 fee middleware parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// HeldRecvFees returns the receive fees held in escrow for the given forward relayer until the dispute window has
+	// elapsed
+	HeldRecvFees(context.Context, *QueryHeldRecvFeesRequest) (*QueryHeldRecvFeesResponse, error)
+	// ProtocolFees returns the total protocol fees sent to the protocol fee recipient
+	ProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error)
+	// RelayerStats returns the statistics of the given relayer on all channels
+	RelayerStats(context.Context, *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error)
+	// ChannelRelayerStats returns the statistics of all relayers on the given channel
+	ChannelRelayerStats(context.Context, *QueryChannelRelayerStatsRequest) (*QueryChannelRelayerStatsResponse, error)
+	// RelayerLeaderboard returns the statistics of the relayers on the given channel sorted by the fees paid in the given
+	// denomination
+	RelayerLeaderboard(context.Context, *QueryRelayerLeaderboardRequest) (*QueryRelayerLeaderboardResponse, error)
 }
 
-func (m *QueryTotalTimeoutFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TimeoutFees) > 0 {
-		for iNdEx := len(m.TimeoutFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TimeoutFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPayeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) IncentivizedPackets(ctx context.Context, req *QueryIncentivizedPacketsRequest) (*QueryIncentivizedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPackets not implemented")
+}
+func (*UnimplementedQueryServer) IncentivizedPacket(ctx context.Context, req *QueryIncentivizedPacketRequest) (*QueryIncentivizedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPacket not implemented")
+}
+func (*UnimplementedQueryServer) IncentivizedPacketsForChannel(ctx context.Context, req *QueryIncentivizedPacketsForChannelRequest) (*QueryIncentivizedPacketsForChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivizedPacketsForChannel not implemented")
+}
+func (*UnimplementedQueryServer) TotalRecvFees(ctx context.Context, req *QueryTotalRecvFeesRequest) (*QueryTotalRecvFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalRecvFees not implemented")
+}
+func (*UnimplementedQueryServer) TotalAckFees(ctx context.Context, req *QueryTotalAckFeesRequest) (*QueryTotalAckFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalAckFees not implemented")
+}
+func (*UnimplementedQueryServer) TotalTimeoutFees(ctx context.Context, req *QueryTotalTimeoutFeesRequest) (*QueryTotalTimeoutFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalTimeoutFees not implemented")
+}
+func (*UnimplementedQueryServer) Payee(ctx context.Context, req *QueryPayeeRequest) (*QueryPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payee not implemented")
+}
+func (*UnimplementedQueryServer) CounterpartyPayee(ctx context.Context, req *QueryCounterpartyPayeeRequest) (*QueryCounterpartyPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterpartyPayee not implemented")
+}
+func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannels not implemented")
+}
+func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HeldRecvFees(ctx context.Context, req *QueryHeldRecvFeesRequest) (*QueryHeldRecvFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldRecvFees not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFees(ctx context.Context, req *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFees not implemented")
+}
+func (*UnimplementedQueryServer) RelayerStats(ctx context.Context, req *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) ChannelRelayerStats(ctx context.Context, req *QueryChannelRelayerStatsRequest) (*QueryChannelRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) RelayerLeaderboard(ctx context.Context, req *QueryRelayerLeaderboardRequest) (*QueryRelayerLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerLeaderboard not implemented")
 }
 
-func (m *QueryPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x12
+func _Query_IncentivizedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPackets(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPackets(ctx, req.(*QueryIncentivizedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPayeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_IncentivizedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPacketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPacket(ctx, req.(*QueryIncentivizedPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IncentivizedPacketsForChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivizedPacketsForChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivizedPacketsForChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/IncentivizedPacketsForChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivizedPacketsForChannel(ctx, req.(*QueryIncentivizedPacketsForChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PayeeAddress) > 0 {
-		i -= len(m.PayeeAddress)
-		copy(dAtA[i:], m.PayeeAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayeeAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_TotalRecvFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalRecvFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalRecvFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/TotalRecvFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalRecvFees(ctx, req.(*QueryTotalRecvFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalAckFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalAckFeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalAckFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/TotalAckFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalAckFees(ctx, req.(*QueryTotalAckFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TotalTimeoutFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalTimeoutFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalTimeoutFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/TotalTimeoutFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalTimeoutFees(ctx, req.(*QueryTotalTimeoutFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayer) > 0 {
-		i -= len(m.Relayer)
-		copy(dAtA[i:], m.Relayer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
-		i--
-		dAtA[i] = 0x12
+func _Query_Payee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Payee(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Payee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Payee(ctx, req.(*QueryPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CounterpartyPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCounterpartyPayeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CounterpartyPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/CounterpartyPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CounterpartyPayee(ctx, req.(*QueryCounterpartyPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FeeEnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEnabledChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEnabledChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/FeeEnabledChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEnabledChannels(ctx, req.(*QueryFeeEnabledChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyPayee) > 0 {
-		i -= len(m.CounterpartyPayee)
-		copy(dAtA[i:], m.CounterpartyPayee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyPayee)))
-		i--
-		dAtA[i] = 0xa
+func _Query_FeeEnabledChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeEnabledChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).FeeEnabledChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/FeeEnabledChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeEnabledChannel(ctx, req.(*QueryFeeEnabledChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HeldRecvFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldRecvFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldRecvFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/HeldRecvFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldRecvFees(ctx, req.(*QueryHeldRecvFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
-		i--
-		dAtA[i] = 0x10
+func _Query_ProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFees(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/ProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFees(ctx, req.(*QueryProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/RelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerStats(ctx, req.(*QueryRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChannelRelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelRelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/ChannelRelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelRelayerStats(ctx, req.(*QueryChannelRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFeeEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+func _Query_RelayerLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/RelayerLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerLeaderboard(ctx, req.(*QueryRelayerLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IncentivizedPackets",
+			Handler:    _Query_IncentivizedPackets_Handler,
+		},
+		{
+			MethodName: "IncentivizedPacket",
+			Handler:    _Query_IncentivizedPacket_Handler,
+		},
+		{
+			MethodName: "IncentivizedPacketsForChannel",
+			Handler:    _Query_IncentivizedPacketsForChannel_Handler,
+		},
+		{
+			MethodName: "TotalRecvFees",
+			Handler:    _Query_TotalRecvFees_Handler,
+		},
+		{
+			MethodName: "TotalAckFees",
+			Handler:    _Query_TotalAckFees_Handler,
+		},
+		{
+			MethodName: "TotalTimeoutFees",
+			Handler:    _Query_TotalTimeoutFees_Handler,
+		},
+		{
+			MethodName: "Payee",
+			Handler:    _Query_Payee_Handler,
+		},
+		{
+			MethodName: "CounterpartyPayee",
+			Handler:    _Query_CounterpartyPayee_Handler,
+		},
+		{
+			MethodName: "FeeEnabledChannels",
+			Handler:    _Query_FeeEnabledChannels_Handler,
+		},
+		{
+			MethodName: "FeeEnabledChannel",
+			Handler:    _Query_FeeEnabledChannel_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HeldRecvFees",
+			Handler:    _Query_HeldRecvFees_Handler,
+		},
+		{
+			MethodName: "ProtocolFees",
+			Handler:    _Query_ProtocolFees_Handler,
+		},
+		{
+			MethodName: "RelayerStats",
+			Handler:    _Query_RelayerStats_Handler,
+		},
+		{
+			MethodName: "ChannelRelayerStats",
+			Handler:    _Query_ChannelRelayerStats_Handler,
+		},
+		{
+			MethodName: "RelayerLeaderboard",
+			Handler:    _Query_RelayerLeaderboard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/fee/v1/query.proto",
+}
+
+func (m *QueryIncentivizedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivizedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivizedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2281,10 +2590,10 @@ func (m *QueryFeeEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.FeeEnabledChannels) > 0 {
-		for iNdEx := len(m.FeeEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IncentivizedPackets) > 0 {
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2298,7 +2607,7 @@ func (m *QueryFeeEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIncentivizedPacketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2308,34 +2617,35 @@ func (m *QueryFeeEnabledChannelRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFeeEnabledChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIncentivizedPacketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeeEnabledChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIncentivizedPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+	if m.QueryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIncentivizedPacketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2345,352 +2655,2621 @@ func (m *QueryFeeEnabledChannelResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFeeEnabledChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIncentivizedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIncentivizedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FeeEnabled {
-		i--
-		if m.FeeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.IncentivizedPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+func (m *QueryIncentivizedPacketsForChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.IncentivizedPackets) > 0 {
-		for _, e := range m.IncentivizedPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryIncentivizedPacketsForChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIncentivizedPacketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncentivizedPacketsForChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncentivizedPacketsForChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.IncentivizedPacket.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncentivizedPacketsForChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivizedPacketsForChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	if len(m.IncentivizedPackets) > 0 {
+		for iNdEx := len(m.IncentivizedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivizedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivizedPacketsForChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalRecvFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalRecvFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalRecvFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.IncentivizedPackets) > 0 {
-		for _, e := range m.IncentivizedPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalRecvFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalRecvFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalRecvFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalRecvFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalRecvFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.RecvFees) > 0 {
-		for _, e := range m.RecvFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.RecvFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalAckFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalAckFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalAckFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalAckFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalAckFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.AckFees) > 0 {
-		for _, e := range m.AckFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalTimeoutFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalAckFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalTimeoutFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TimeoutFees) > 0 {
-		for _, e := range m.TimeoutFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryTotalAckFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPayeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalAckFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AckFees) > 0 {
+		for iNdEx := len(m.AckFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PayeeAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTotalTimeoutFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCounterpartyPayeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTotalTimeoutFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalTimeoutFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCounterpartyPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalTimeoutFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalTimeoutFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalTimeoutFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TimeoutFees) > 0 {
+		for iNdEx := len(m.TimeoutFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPayeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QueryHeight != 0 {
-		n += 1 + sovQuery(uint64(m.QueryHeight))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeEnabledChannels) > 0 {
-		for _, e := range m.FeeEnabledChannels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PayeeAddress) > 0 {
+		i -= len(m.PayeeAddress)
+		copy(dAtA[i:], m.PayeeAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayeeAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCounterpartyPayeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCounterpartyPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCounterpartyPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCounterpartyPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCounterpartyPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCounterpartyPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FeeEnabled {
-		n += 2
+	if len(m.CounterpartyPayee) > 0 {
+		i -= len(m.CounterpartyPayee)
+		copy(dAtA[i:], m.CounterpartyPayee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyPayee)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryFeeEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEnabledChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEnabledChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEnabledChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEnabledChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeEnabledChannels) > 0 {
+		for iNdEx := len(m.FeeEnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeEnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEnabledChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEnabledChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEnabledChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeEnabledChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeEnabledChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendSideFeeEnabled {
+		i--
+		if m.SendSideFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FeeEnabled {
+		i--
+		if m.FeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldRecvFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldRecvFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldRecvFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardRelayer) > 0 {
+		i -= len(m.ForwardRelayer)
+		copy(dAtA[i:], m.ForwardRelayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ForwardRelayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldRecvFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldRecvFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldRecvFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalHeldRecvFees) > 0 {
+		for iNdEx := len(m.TotalHeldRecvFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalHeldRecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HeldRecvFees) > 0 {
+		for iNdEx := len(m.HeldRecvFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldRecvFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalProtocolFees) > 0 {
+		for iNdEx := len(m.TotalProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerLeaderboardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerLeaderboardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivizedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentivizedPacket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncentivizedPacketsForChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryIncentivizedPacketsForChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivizedPackets) > 0 {
+		for _, e := range m.IncentivizedPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalRecvFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalRecvFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFees) > 0 {
+		for _, e := range m.RecvFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalAckFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalAckFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AckFees) > 0 {
+		for _, e := range m.AckFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalTimeoutFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalTimeoutFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimeoutFees) > 0 {
+		for _, e := range m.TimeoutFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPayeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PayeeAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCounterpartyPayeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCounterpartyPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEnabledChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueryHeight != 0 {
+		n += 1 + sovQuery(uint64(m.QueryHeight))
+	}
+	return n
+}
+
+func (m *QueryFeeEnabledChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeEnabledChannels) > 0 {
+		for _, e := range m.FeeEnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEnabledChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeEnabled {
+		n += 2
+	}
+	if m.SendSideFeeEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldRecvFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardRelayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldRecvFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HeldRecvFees) > 0 {
+		for _, e := range m.HeldRecvFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalHeldRecvFees) > 0 {
+		for _, e := range m.TotalHeldRecvFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalProtocolFees) > 0 {
+		for _, e := range m.TotalProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerLeaderboardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryRelayerLeaderboardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			}
+			m.QueryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
+			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFees = append(m.RecvFees, types1.Coin{})
+			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2713,15 +5292,15 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2748,18 +5327,66 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AckFees = append(m.AckFees, types1.Coin{})
+			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.QueryHeight = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2769,11 +5396,25 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2795,7 +5436,7 @@ func (m *QueryIncentivizedPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,15 +5459,15 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2853,16 +5494,180 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, IdentifiedPacketFees{})
-			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
+			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2872,27 +5677,23 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PayeeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2915,7 +5716,7 @@ func (m *QueryIncentivizedPacketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,17 +5739,17 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2958,30 +5759,29 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			m.QueryHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2991,11 +5791,24 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3017,7 +5830,7 @@ func (m *QueryIncentivizedPacketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3040,17 +5853,17 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPacket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3060,24 +5873,23 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentivizedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3100,7 +5912,7 @@ func (m *QueryIncentivizedPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3123,10 +5935,10 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3166,70 +5978,6 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
 			}
@@ -3269,7 +6017,7 @@ func (m *QueryIncentivizedPacketsForChannelRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3292,15 +6040,15 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivizedPacketsForChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3327,8 +6075,8 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentivizedPackets = append(m.IncentivizedPackets, &IdentifiedPacketFees{})
-			if err := m.IncentivizedPackets[len(m.IncentivizedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
+			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3389,7 +6137,7 @@ func (m *QueryIncentivizedPacketsForChannelResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,17 +6160,17 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3432,80 +6180,29 @@ func (m *QueryTotalRecvFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3515,25 +6212,23 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFees = append(m.RecvFees, types1.Coin{})
-			if err := m.RecvFees[len(m.RecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3556,7 +6251,7 @@ func (m *QueryTotalRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,17 +6274,17 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3599,25 +6294,32 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FeeEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSideFeeEnabled", wireType)
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.SendSideFeeEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3639,7 +6341,7 @@ func (m *QueryTotalAckFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3662,46 +6364,12 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalAckFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFees", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AckFees = append(m.AckFees, types1.Coin{})
-			if err := m.AckFees[len(m.AckFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3723,7 +6391,7 @@ func (m *QueryTotalAckFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3746,15 +6414,15 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3781,7 +6449,10 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3806,7 +6477,7 @@ func (m *QueryTotalTimeoutFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldRecvFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3829,15 +6500,47 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldRecvFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalTimeoutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldRecvFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3864,8 +6567,10 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFees = append(m.TimeoutFees, types1.Coin{})
-			if err := m.TimeoutFees[len(m.TimeoutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3890,7 +6595,7 @@ func (m *QueryTotalTimeoutFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3913,17 +6618,17 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldRecvFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldRecvFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3933,29 +6638,31 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.HeldRecvFees = append(m.HeldRecvFees, HeldRecvFees{})
+			if err := m.HeldRecvFees[len(m.HeldRecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHeldRecvFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3965,23 +6672,61 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.TotalHeldRecvFees = append(m.TotalHeldRecvFees, types1.Coin{})
+			if err := m.TotalHeldRecvFees[len(m.TotalHeldRecvFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4004,7 +6749,57 @@ func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProtocolFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4027,17 +6822,17 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProtocolFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4047,23 +6842,25 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayeeAddress = string(dAtA[iNdEx:postIndex])
+			m.TotalProtocolFees = append(m.TotalProtocolFees, types1.Coin{})
+			if err := m.TotalProtocolFees[len(m.TotalProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4086,7 +6883,7 @@ func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4109,15 +6906,15 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4145,13 +6942,13 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4161,23 +6958,27 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4200,7 +7001,7 @@ func (m *QueryCounterpartyPayeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4223,17 +7024,17 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4243,23 +7044,61 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4282,7 +7121,7 @@ func (m *QueryCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRelayerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4305,17 +7144,17 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRelayerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4325,33 +7164,61 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.QueryHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4361,11 +7228,28 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueryHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4387,7 +7271,7 @@ func (m *QueryFeeEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRelayerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4410,15 +7294,15 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRelayerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabledChannels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4445,8 +7329,8 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeEnabledChannels = append(m.FeeEnabledChannels, FeeEnabledChannel{})
-			if err := m.FeeEnabledChannels[len(m.FeeEnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4507,7 +7391,7 @@ func (m *QueryFeeEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerLeaderboardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4530,10 +7414,10 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerLeaderboardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerLeaderboardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4600,6 +7484,57 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4621,7 +7556,7 @@ func (m *QueryFeeEnabledChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerLeaderboardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4644,17 +7579,17 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerLeaderboardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeEnabledChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerLeaderboardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4664,12 +7599,26 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FeeEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
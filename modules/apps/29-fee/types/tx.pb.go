@@ -360,6 +360,419 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgPayPacketFeeAsyncBatch defines the request type for the PayPacketFeeAsyncBatch rpc
+// This Msg can be used to pay for the relaying of a range or list of known packets on a channel
+type MsgPayPacketFeeAsyncBatch struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the first packet sequence of the range of packets to incentivize, used if no list of sequences is provided
+	StartSequence uint64 `protobuf:"varint,3,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// the last packet sequence (inclusive) of the range of packets to incentivize
+	EndSequence uint64 `protobuf:"varint,4,opt,name=end_sequence,json=endSequence,proto3" json:"end_sequence,omitempty"`
+	// the list of packet sequences to incentivize
+	Sequences []uint64 `protobuf:"varint,5,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+	// the packet fee escrowed for each packet
+	PacketFee PacketFee `protobuf:"bytes,6,opt,name=packet_fee,json=packetFee,proto3" json:"packet_fee"`
+}
+
+func (m *MsgPayPacketFeeAsyncBatch) Reset()         { *m = MsgPayPacketFeeAsyncBatch{} }
+func (m *MsgPayPacketFeeAsyncBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgPayPacketFeeAsyncBatch) ProtoMessage()    {}
+func (*MsgPayPacketFeeAsyncBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgPayPacketFeeAsyncBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayPacketFeeAsyncBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayPacketFeeAsyncBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayPacketFeeAsyncBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayPacketFeeAsyncBatch.Merge(m, src)
+}
+func (m *MsgPayPacketFeeAsyncBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayPacketFeeAsyncBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayPacketFeeAsyncBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayPacketFeeAsyncBatch proto.InternalMessageInfo
+
+// MsgPayPacketFeeAsyncBatchResponse defines the response type for the PayPacketFeeAsyncBatch rpc
+type MsgPayPacketFeeAsyncBatchResponse struct {
+	// the result of incentivizing each packet sequence
+	Results []PayPacketFeeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgPayPacketFeeAsyncBatchResponse) Reset()         { *m = MsgPayPacketFeeAsyncBatchResponse{} }
+func (m *MsgPayPacketFeeAsyncBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayPacketFeeAsyncBatchResponse) ProtoMessage()    {}
+func (*MsgPayPacketFeeAsyncBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayPacketFeeAsyncBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayPacketFeeAsyncBatchResponse.Merge(m, src)
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayPacketFeeAsyncBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayPacketFeeAsyncBatchResponse proto.InternalMessageInfo
+
+func (m *MsgPayPacketFeeAsyncBatchResponse) GetResults() []PayPacketFeeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// PayPacketFeeResult defines the result of incentivizing a packet sequence within a MsgPayPacketFeeAsyncBatch
+type PayPacketFeeResult struct {
+	// the packet sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// whether the packet fee was escrowed for the packet
+	Escrowed bool `protobuf:"varint,2,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// the reason the packet was skipped, empty if the packet fee was escrowed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PayPacketFeeResult) Reset()         { *m = PayPacketFeeResult{} }
+func (m *PayPacketFeeResult) String() string { return proto.CompactTextString(m) }
+func (*PayPacketFeeResult) ProtoMessage()    {}
+func (*PayPacketFeeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *PayPacketFeeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayPacketFeeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayPacketFeeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayPacketFeeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayPacketFeeResult.Merge(m, src)
+}
+func (m *PayPacketFeeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayPacketFeeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayPacketFeeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayPacketFeeResult proto.InternalMessageInfo
+
+func (m *PayPacketFeeResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PayPacketFeeResult) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
+}
+
+func (m *PayPacketFeeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgUpdateSendSideFeeEnabled defines the request type for the UpdateSendSideFeeEnabled rpc
+type MsgUpdateSendSideFeeEnabled struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// boolean flag enabling or disabling send side fees for the channel
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUpdateSendSideFeeEnabled) Reset()         { *m = MsgUpdateSendSideFeeEnabled{} }
+func (m *MsgUpdateSendSideFeeEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendSideFeeEnabled) ProtoMessage()    {}
+func (*MsgUpdateSendSideFeeEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgUpdateSendSideFeeEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendSideFeeEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendSideFeeEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSendSideFeeEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendSideFeeEnabled.Merge(m, src)
+}
+func (m *MsgUpdateSendSideFeeEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendSideFeeEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendSideFeeEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSendSideFeeEnabled proto.InternalMessageInfo
+
+// MsgUpdateSendSideFeeEnabledResponse defines the response type for the UpdateSendSideFeeEnabled rpc
+type MsgUpdateSendSideFeeEnabledResponse struct {
+}
+
+func (m *MsgUpdateSendSideFeeEnabledResponse) Reset()         { *m = MsgUpdateSendSideFeeEnabledResponse{} }
+func (m *MsgUpdateSendSideFeeEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSendSideFeeEnabledResponse) ProtoMessage()    {}
+func (*MsgUpdateSendSideFeeEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgUpdateSendSideFeeEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSendSideFeeEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSendSideFeeEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSendSideFeeEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSendSideFeeEnabledResponse.Merge(m, src)
+}
+func (m *MsgUpdateSendSideFeeEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSendSideFeeEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSendSideFeeEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSendSideFeeEnabledResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the request type for the UpdateParams rpc
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the ICS29 fee middleware parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the response type for the UpdateParams rpc
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgDisputeHeldRecvFees defines the request type for the DisputeHeldRecvFees rpc
+type MsgDisputeHeldRecvFees struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the forward relayer address encoded in the acknowledgements by the counterparty
+	ForwardRelayer string `protobuf:"bytes,3,opt,name=forward_relayer,json=forwardRelayer,proto3" json:"forward_relayer,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgDisputeHeldRecvFees) Reset()         { *m = MsgDisputeHeldRecvFees{} }
+func (m *MsgDisputeHeldRecvFees) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeHeldRecvFees) ProtoMessage()    {}
+func (*MsgDisputeHeldRecvFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{15}
+}
+func (m *MsgDisputeHeldRecvFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeHeldRecvFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeHeldRecvFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeHeldRecvFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeHeldRecvFees.Merge(m, src)
+}
+func (m *MsgDisputeHeldRecvFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeHeldRecvFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeHeldRecvFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeHeldRecvFees proto.InternalMessageInfo
+
+// MsgDisputeHeldRecvFeesResponse defines the response type for the DisputeHeldRecvFees rpc
+type MsgDisputeHeldRecvFeesResponse struct {
+	// the number of packets for which the held receive fees were refunded
+	Disputed uint64 `protobuf:"varint,1,opt,name=disputed,proto3" json:"disputed,omitempty"`
+}
+
+func (m *MsgDisputeHeldRecvFeesResponse) Reset()         { *m = MsgDisputeHeldRecvFeesResponse{} }
+func (m *MsgDisputeHeldRecvFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeHeldRecvFeesResponse) ProtoMessage()    {}
+func (*MsgDisputeHeldRecvFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{16}
+}
+func (m *MsgDisputeHeldRecvFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeHeldRecvFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeHeldRecvFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeHeldRecvFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeHeldRecvFeesResponse.Merge(m, src)
+}
+func (m *MsgDisputeHeldRecvFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeHeldRecvFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeHeldRecvFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeHeldRecvFeesResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeHeldRecvFeesResponse) GetDisputed() uint64 {
+	if m != nil {
+		return m.Disputed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -369,56 +782,90 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgPayPacketFeeAsyncBatch)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncBatch")
+	proto.RegisterType((*MsgPayPacketFeeAsyncBatchResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncBatchResponse")
+	proto.RegisterType((*PayPacketFeeResult)(nil), "ibc.applications.fee.v1.PayPacketFeeResult")
+	proto.RegisterType((*MsgUpdateSendSideFeeEnabled)(nil), "ibc.applications.fee.v1.MsgUpdateSendSideFeeEnabled")
+	proto.RegisterType((*MsgUpdateSendSideFeeEnabledResponse)(nil), "ibc.applications.fee.v1.MsgUpdateSendSideFeeEnabledResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.fee.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.fee.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDisputeHeldRecvFees)(nil), "ibc.applications.fee.v1.MsgDisputeHeldRecvFees")
+	proto.RegisterType((*MsgDisputeHeldRecvFeesResponse)(nil), "ibc.applications.fee.v1.MsgDisputeHeldRecvFeesResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x1b, 0xfa, 0x23, 0xaf, 0x85, 0x12, 0xab, 0x22, 0xa9, 0x69, 0xd3, 0x62, 0x55, 0x50,
-	0x22, 0xc5, 0x6e, 0x82, 0x2a, 0x68, 0x04, 0x03, 0xad, 0xa8, 0x54, 0x89, 0x8a, 0x28, 0x23, 0x4b,
-	0xe5, 0x38, 0xaf, 0xae, 0x69, 0xec, 0xb3, 0x7c, 0x4e, 0x84, 0x37, 0xd4, 0x09, 0x31, 0xc1, 0x7f,
-	0xc0, 0xc8, 0xc0, 0xd0, 0x3f, 0xa3, 0x63, 0x47, 0x16, 0x10, 0x6a, 0x91, 0xfa, 0x1f, 0x30, 0xa3,
-	0x3b, 0x9f, 0x2d, 0x37, 0x69, 0xaa, 0x80, 0xc4, 0x62, 0xf9, 0xde, 0xf7, 0xdd, 0x77, 0xef, 0xfb,
-	0x74, 0x4f, 0x07, 0xcb, 0x76, 0xcb, 0xd4, 0x0d, 0xcf, 0xeb, 0xd8, 0xa6, 0x11, 0xd8, 0xc4, 0xa5,
-	0xfa, 0x3e, 0xa2, 0xde, 0xab, 0xea, 0xc1, 0x5b, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x82, 0xdd, 0x32,
-	0xb5, 0x34, 0x43, 0xdb, 0x47, 0xd4, 0x7a, 0x55, 0x25, 0x6f, 0x38, 0xb6, 0x4b, 0x74, 0xfe, 0x8d,
-	0xb8, 0xca, 0x9c, 0x45, 0x2c, 0xc2, 0x7f, 0x75, 0xf6, 0x27, 0xaa, 0xf7, 0x86, 0x9d, 0xc1, 0x84,
-	0x52, 0x14, 0x93, 0xf8, 0xa8, 0x9b, 0x07, 0x86, 0xeb, 0x62, 0x87, 0xc1, 0xe2, 0x57, 0x50, 0x0a,
-	0x26, 0xa1, 0x0e, 0xa1, 0xba, 0x43, 0x2d, 0x06, 0x3a, 0xd4, 0x8a, 0x00, 0xf5, 0xab, 0x04, 0xb7,
-	0x77, 0xa9, 0xd5, 0x44, 0xcb, 0xa6, 0x01, 0xfa, 0x0d, 0x23, 0x44, 0x94, 0x0b, 0x30, 0xe9, 0x11,
-	0x3f, 0xd8, 0xb3, 0xdb, 0x45, 0x69, 0x59, 0x5a, 0xcd, 0x35, 0x27, 0xd8, 0x72, 0xa7, 0x2d, 0x2f,
-	0x02, 0x08, 0x5d, 0x86, 0x8d, 0x71, 0x2c, 0x27, 0x2a, 0x3b, 0x6d, 0xb9, 0x08, 0x93, 0x3e, 0x76,
-	0x8c, 0x10, 0xfd, 0x62, 0x96, 0x63, 0xf1, 0x52, 0x9e, 0x83, 0x71, 0x8f, 0x49, 0x17, 0x6f, 0xf0,
-	0x7a, 0xb4, 0xa8, 0xaf, 0xbd, 0xff, 0xbc, 0x94, 0x39, 0xba, 0x38, 0x2e, 0xc7, 0xbc, 0x0f, 0x17,
-	0xc7, 0xe5, 0xbb, 0x51, 0xab, 0x15, 0xda, 0x3e, 0xd4, 0xfb, 0x3b, 0x53, 0x15, 0x28, 0xf6, 0xd7,
-	0x9a, 0x48, 0x3d, 0xe2, 0x52, 0x54, 0xbf, 0x4b, 0xb0, 0x90, 0x02, 0xb7, 0x48, 0xd7, 0x0d, 0xd0,
-	0xf7, 0x0c, 0x3f, 0x08, 0xff, 0x97, 0xad, 0x0a, 0xc8, 0x66, 0xea, 0x98, 0xbd, 0xb4, 0xc7, 0xbc,
-	0xd9, 0xdf, 0x40, 0xfd, 0xe9, 0x55, 0x7e, 0x1f, 0x5c, 0xed, 0x77, 0xa0, 0x7d, 0xf5, 0x3e, 0xac,
-	0x5c, 0x87, 0x27, 0x39, 0x1c, 0x8d, 0xc1, 0xec, 0x2e, 0xb5, 0x1a, 0x46, 0xd8, 0x30, 0xcc, 0x43,
-	0x0c, 0xb6, 0x11, 0xe5, 0x0d, 0xc8, 0xee, 0x23, 0x72, 0xdb, 0xd3, 0xb5, 0x05, 0x6d, 0xc8, 0xad,
-	0xd4, 0xb6, 0x11, 0x37, 0x73, 0x27, 0x3f, 0x96, 0x32, 0x5f, 0x2e, 0x8e, 0xcb, 0x52, 0x93, 0xed,
-	0x91, 0x57, 0xe0, 0x16, 0x25, 0x5d, 0xdf, 0xc4, 0xbd, 0x38, 0xbc, 0x28, 0xa0, 0x99, 0xa8, 0xda,
-	0x88, 0x22, 0x2c, 0x43, 0x5e, 0xb0, 0x52, 0x49, 0x46, 0x69, 0xcd, 0x46, 0xc0, 0x56, 0x92, 0xe7,
-	0x1d, 0x98, 0xa0, 0xb6, 0xe5, 0xa2, 0x2f, 0x92, 0x12, 0x2b, 0x59, 0x81, 0x29, 0x91, 0x0b, 0x2d,
-	0x8e, 0x2f, 0x67, 0x57, 0x73, 0xcd, 0x64, 0x5d, 0xd7, 0xe2, 0xe8, 0x04, 0x99, 0x25, 0xa7, 0x5c,
-	0x4e, 0x2e, 0x6d, 0x58, 0x9d, 0x87, 0x42, 0x5f, 0x29, 0xc9, 0xe7, 0x97, 0x04, 0x73, 0x7d, 0xd8,
-	0x73, 0x1a, 0xba, 0xa6, 0xfc, 0x02, 0x72, 0x1e, 0xaf, 0xc4, 0x37, 0x64, 0xba, 0xb6, 0xc8, 0xa3,
-	0x62, 0xb3, 0xa5, 0xc5, 0x03, 0xd5, 0xab, 0x6a, 0xd1, 0xbe, 0x9d, 0x76, 0x3a, 0xab, 0x29, 0x4f,
-	0x14, 0xe5, 0x97, 0x00, 0x42, 0x86, 0x45, 0x3e, 0xc6, 0x75, 0xd4, 0xa1, 0x91, 0x27, 0x3d, 0xa4,
-	0xc5, 0x44, 0x1f, 0xdb, 0x88, 0xf5, 0xc7, 0xb1, 0xf1, 0x94, 0x28, 0x33, 0xbf, 0x34, 0xdc, 0x3c,
-	0x77, 0xa3, 0x96, 0xf8, 0x34, 0x0c, 0xd4, 0xe3, 0x18, 0x6a, 0xbf, 0xb3, 0x90, 0xdd, 0xa5, 0x96,
-	0xec, 0xc0, 0xcd, 0xcb, 0xd3, 0xff, 0x70, 0x68, 0xaf, 0xfd, 0xa3, 0xa7, 0x54, 0x47, 0xa6, 0xc6,
-	0xc7, 0xca, 0x9f, 0x24, 0x98, 0x1f, 0x3e, 0xa2, 0xeb, 0xa3, 0x08, 0x0e, 0x6c, 0x53, 0x9e, 0xfd,
-	0xd3, 0xb6, 0xa4, 0xa7, 0x37, 0x30, 0x73, 0x69, 0x5a, 0x56, 0xaf, 0x93, 0x4b, 0x33, 0x95, 0xb5,
-	0x51, 0x99, 0xc9, 0x59, 0x21, 0xe4, 0x07, 0x6f, 0x5e, 0x65, 0x54, 0x19, 0x4e, 0x57, 0xd6, 0xff,
-	0x8a, 0x1e, 0x1f, 0xad, 0x8c, 0xbf, 0x63, 0x97, 0x6b, 0xf3, 0xd5, 0xc9, 0x59, 0x49, 0x3a, 0x3d,
-	0x2b, 0x49, 0x3f, 0xcf, 0x4a, 0xd2, 0xc7, 0xf3, 0x52, 0xe6, 0xf4, 0xbc, 0x94, 0xf9, 0x76, 0x5e,
-	0xca, 0xbc, 0x5e, 0xb7, 0xec, 0xe0, 0xa0, 0xdb, 0xd2, 0x4c, 0xe2, 0xe8, 0xe2, 0xc1, 0xb0, 0x5b,
-	0x66, 0xc5, 0x22, 0x7a, 0xef, 0x89, 0xee, 0x90, 0x76, 0xb7, 0x83, 0x94, 0xbd, 0x45, 0x54, 0xaf,
-	0x6d, 0x54, 0xd8, 0x33, 0x14, 0x84, 0x1e, 0xd2, 0xd6, 0x04, 0x7f, 0x4a, 0x1e, 0xfd, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x92, 0x13, 0xd2, 0x0f, 0x07, 0x00, 0x00,
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x4f, 0xdc, 0xc6,
+	0x17, 0xc7, 0xfc, 0xde, 0x07, 0x81, 0x2f, 0xfe, 0x22, 0x58, 0x1c, 0x58, 0xc0, 0x25, 0x09, 0xa5,
+	0xc2, 0x86, 0x6d, 0x51, 0x9a, 0x15, 0x39, 0x94, 0x34, 0xab, 0xa2, 0x16, 0x15, 0x39, 0xea, 0xa5,
+	0x17, 0xe4, 0xb5, 0x1f, 0xc6, 0xcd, 0xae, 0xed, 0x7a, 0xbc, 0x9b, 0xee, 0xa9, 0x55, 0x4e, 0x51,
+	0x0f, 0x55, 0x7b, 0xee, 0xa5, 0xc7, 0x1e, 0x7a, 0xe0, 0x7f, 0xe8, 0xa1, 0x1c, 0x73, 0xec, 0xa5,
+	0x55, 0x05, 0x95, 0xf8, 0x33, 0x5a, 0xcd, 0x78, 0x3c, 0xf1, 0x2e, 0xf6, 0x66, 0x21, 0xea, 0x65,
+	0xb5, 0xef, 0xbd, 0xcf, 0x7b, 0xf3, 0x79, 0x9f, 0x99, 0x79, 0xb6, 0x61, 0xc5, 0xad, 0x59, 0xba,
+	0x19, 0x04, 0x75, 0xd7, 0x32, 0x23, 0xd7, 0xf7, 0x88, 0x7e, 0x8c, 0xa8, 0xb7, 0xb6, 0xf5, 0xe8,
+	0x2b, 0x2d, 0x08, 0xfd, 0xc8, 0x97, 0xe7, 0xdd, 0x9a, 0xa5, 0xa5, 0x11, 0xda, 0x31, 0xa2, 0xd6,
+	0xda, 0x56, 0x66, 0xcc, 0x86, 0xeb, 0xf9, 0x3a, 0xfb, 0x8d, 0xb1, 0xca, 0xac, 0xe3, 0x3b, 0x3e,
+	0xfb, 0xab, 0xd3, 0x7f, 0xdc, 0xbb, 0x9a, 0xb7, 0x06, 0x2d, 0x94, 0x82, 0x58, 0x7e, 0x88, 0xba,
+	0x75, 0x62, 0x7a, 0x1e, 0xd6, 0x69, 0x98, 0xff, 0xe5, 0x90, 0x79, 0xcb, 0x27, 0x0d, 0x9f, 0xe8,
+	0x0d, 0xe2, 0xd0, 0x60, 0x83, 0x38, 0x71, 0x40, 0xfd, 0x45, 0x82, 0xff, 0x1d, 0x10, 0xc7, 0x40,
+	0xc7, 0x25, 0x11, 0x86, 0x87, 0x66, 0x1b, 0x51, 0x9e, 0x87, 0xb1, 0xc0, 0x0f, 0xa3, 0x23, 0xd7,
+	0x2e, 0x4a, 0x2b, 0xd2, 0x7a, 0xc1, 0x18, 0xa5, 0xe6, 0xbe, 0x2d, 0x2f, 0x01, 0xf0, 0xba, 0x34,
+	0x36, 0xc8, 0x62, 0x05, 0xee, 0xd9, 0xb7, 0xe5, 0x22, 0x8c, 0x85, 0x58, 0x37, 0xdb, 0x18, 0x16,
+	0x87, 0x58, 0x2c, 0x31, 0xe5, 0x59, 0x18, 0x09, 0x68, 0xe9, 0xe2, 0x30, 0xf3, 0xc7, 0x46, 0x65,
+	0xeb, 0xc5, 0x4f, 0xcb, 0x03, 0xcf, 0x2f, 0x4f, 0x37, 0x12, 0xdc, 0xb7, 0x97, 0xa7, 0x1b, 0xb7,
+	0x63, 0xaa, 0x9b, 0xc4, 0x7e, 0xaa, 0x77, 0x33, 0x53, 0x15, 0x28, 0x76, 0xfb, 0x0c, 0x24, 0x81,
+	0xef, 0x11, 0x54, 0xff, 0x90, 0x60, 0x31, 0x15, 0x7c, 0xe4, 0x37, 0xbd, 0x08, 0xc3, 0xc0, 0x0c,
+	0xa3, 0xf6, 0x7f, 0xd5, 0xd6, 0x26, 0xc8, 0x56, 0x6a, 0x99, 0xa3, 0x74, 0x8f, 0x33, 0x56, 0x37,
+	0x81, 0xca, 0x6e, 0x56, 0xbf, 0xf7, 0xb2, 0xfb, 0xbd, 0x42, 0x5f, 0xbd, 0x0b, 0x6b, 0xbd, 0xe2,
+	0x42, 0x87, 0xe7, 0x83, 0x30, 0x7d, 0x40, 0x9c, 0x43, 0xb3, 0x7d, 0x68, 0x5a, 0x4f, 0x31, 0xaa,
+	0x22, 0xca, 0x0f, 0x60, 0xe8, 0x18, 0x91, 0xb5, 0x3d, 0x51, 0x5e, 0xd4, 0x72, 0x4e, 0xa5, 0x56,
+	0x45, 0xdc, 0x2b, 0x9c, 0xfd, 0xb9, 0x3c, 0xf0, 0xf3, 0xe5, 0xe9, 0x86, 0x64, 0xd0, 0x1c, 0x79,
+	0x0d, 0xa6, 0x88, 0xdf, 0x0c, 0x2d, 0x3c, 0x4a, 0xc4, 0x8b, 0x05, 0x9a, 0x8c, 0xbd, 0x87, 0xb1,
+	0x84, 0x1b, 0x30, 0xc3, 0x51, 0x29, 0x25, 0x63, 0xb5, 0xa6, 0xe3, 0xc0, 0x23, 0xa1, 0xe7, 0x1c,
+	0x8c, 0x12, 0xd7, 0xf1, 0x30, 0xe4, 0x4a, 0x71, 0x4b, 0x56, 0x60, 0x9c, 0xeb, 0x42, 0x8a, 0x23,
+	0x2b, 0x43, 0xeb, 0x05, 0x43, 0xd8, 0x15, 0x2d, 0x91, 0x8e, 0x83, 0xa9, 0x72, 0x4a, 0xa7, 0x72,
+	0xe9, 0x86, 0xd5, 0x05, 0x98, 0xef, 0x72, 0x09, 0x7d, 0xfe, 0x96, 0x60, 0xb6, 0x2b, 0xf6, 0x01,
+	0x69, 0x7b, 0x96, 0xfc, 0x18, 0x0a, 0x01, 0xf3, 0x24, 0x27, 0x64, 0xa2, 0xbc, 0xc4, 0xa4, 0xa2,
+	0x77, 0x4b, 0x4b, 0x2e, 0x54, 0x6b, 0x5b, 0x8b, 0xf3, 0xf6, 0xed, 0xb4, 0x56, 0xe3, 0x01, 0x77,
+	0xca, 0x9f, 0x00, 0xf0, 0x32, 0x54, 0xf2, 0x41, 0x56, 0x47, 0xcd, 0x95, 0x5c, 0x70, 0x48, 0x17,
+	0xe3, 0x3c, 0xaa, 0x88, 0x95, 0xfb, 0x49, 0xe3, 0xa9, 0xa2, 0xb4, 0xf9, 0xe5, 0xfc, 0xe6, 0x59,
+	0x37, 0x6a, 0x09, 0x16, 0xb3, 0xfc, 0x42, 0x86, 0xdf, 0x06, 0x61, 0x21, 0x0b, 0xb0, 0x67, 0x46,
+	0xd6, 0xc9, 0x8d, 0xef, 0xca, 0x1d, 0x98, 0x22, 0x91, 0x19, 0x46, 0x47, 0x04, 0xbf, 0x6c, 0xa2,
+	0x67, 0x21, 0x3b, 0x04, 0xc3, 0xc6, 0x2d, 0xe6, 0x7d, 0xc2, 0x9d, 0xf2, 0x2a, 0x4c, 0xa2, 0x67,
+	0xbf, 0x02, 0x0d, 0x33, 0xd0, 0x04, 0x7a, 0xb6, 0x80, 0x2c, 0x42, 0x21, 0x09, 0xc7, 0xc7, 0x61,
+	0xd8, 0x78, 0xe5, 0xe8, 0x12, 0x79, 0xf4, 0x0d, 0x45, 0xde, 0xcd, 0x11, 0x79, 0xed, 0x35, 0x22,
+	0x33, 0xad, 0xd4, 0x00, 0x56, 0x73, 0x83, 0x89, 0xdc, 0xf2, 0xc7, 0x74, 0x88, 0x90, 0x66, 0x3d,
+	0x22, 0x45, 0x69, 0x65, 0x68, 0x7d, 0xa2, 0xfc, 0x4e, 0x0f, 0xb6, 0x1d, 0xa7, 0xb6, 0x59, 0x8f,
+	0xf6, 0x86, 0x29, 0x6d, 0x23, 0xa9, 0xa0, 0xd6, 0x40, 0xbe, 0x0a, 0xa2, 0xf7, 0x47, 0x08, 0x2a,
+	0x31, 0x41, 0x85, 0x4d, 0x63, 0x48, 0xac, 0xd0, 0x7f, 0x86, 0xf1, 0xa6, 0x8d, 0x1b, 0xc2, 0xa6,
+	0xc3, 0x19, 0xc3, 0xd0, 0x4f, 0xa6, 0x5b, 0x6c, 0xa8, 0xbf, 0x4a, 0x70, 0xfb, 0x80, 0x38, 0x9f,
+	0x05, 0xb6, 0x19, 0xe1, 0x13, 0xba, 0x33, 0xae, 0x8d, 0x55, 0xc4, 0xc7, 0x9e, 0x59, 0xab, 0xa3,
+	0xfd, 0x26, 0xd3, 0x14, 0xe3, 0x12, 0x6c, 0xbd, 0x71, 0x23, 0x31, 0xf3, 0xe6, 0x42, 0xa5, 0x92,
+	0x71, 0xf7, 0xef, 0x76, 0xee, 0x4c, 0x1e, 0x4b, 0xf5, 0x0e, 0xbc, 0xd5, 0x23, 0x2c, 0x2e, 0xc3,
+	0x8f, 0x12, 0x4c, 0x0b, 0xdc, 0xa1, 0x19, 0x9a, 0x0d, 0x92, 0xa2, 0x23, 0x75, 0x8c, 0xa9, 0x87,
+	0x30, 0x1a, 0x30, 0x04, 0xbf, 0xdb, 0xcb, 0x3d, 0x36, 0x92, 0xc2, 0xf8, 0xe6, 0xf1, 0xa4, 0xca,
+	0x76, 0x46, 0x37, 0x4b, 0x59, 0xdd, 0x54, 0x91, 0x33, 0xe1, 0xc3, 0x2c, 0x4d, 0x4e, 0x10, 0x3f,
+	0x93, 0x60, 0xee, 0x80, 0x38, 0x1f, 0xba, 0x24, 0x68, 0x46, 0xf8, 0x11, 0xd6, 0x6d, 0x03, 0xad,
+	0x56, 0x15, 0x91, 0xdc, 0x78, 0x83, 0xee, 0xc1, 0xf4, 0xb1, 0x1f, 0x3e, 0x33, 0x43, 0xfb, 0xa8,
+	0xf3, 0xb1, 0x37, 0xc5, 0xdd, 0x46, 0xec, 0xcd, 0xdd, 0xaf, 0x9d, 0x8c, 0x0e, 0x57, 0x3b, 0x3b,
+	0xcc, 0xe0, 0xab, 0xee, 0x42, 0x29, 0x3b, 0x22, 0xee, 0x90, 0x02, 0xe3, 0x76, 0x1c, 0xb6, 0x93,
+	0x03, 0x9e, 0xd8, 0xe5, 0x7f, 0xc6, 0x60, 0xe8, 0x80, 0x38, 0x72, 0x03, 0x6e, 0x75, 0xbe, 0xcc,
+	0xbc, 0x9d, 0xbb, 0x3d, 0xdd, 0x6f, 0x12, 0xca, 0x76, 0xdf, 0x50, 0x41, 0xe9, 0x07, 0x09, 0x16,
+	0xf2, 0xdf, 0x38, 0x76, 0xfa, 0x29, 0x78, 0x25, 0x4d, 0x79, 0x78, 0xa3, 0x34, 0xc1, 0xe9, 0x0b,
+	0x98, 0xec, 0x78, 0xf8, 0xaf, 0xf7, 0x2a, 0x97, 0x46, 0x2a, 0x5b, 0xfd, 0x22, 0xc5, 0x5a, 0x6d,
+	0x98, 0xb9, 0xfa, 0x20, 0xdd, 0xec, 0xb7, 0x0c, 0x83, 0x2b, 0x3b, 0xd7, 0x82, 0x8b, 0xa5, 0x5f,
+	0x48, 0x30, 0x97, 0xf3, 0xf4, 0x2a, 0x5f, 0xab, 0x22, 0xcb, 0x51, 0x2a, 0xd7, 0xcf, 0x11, 0x54,
+	0xbe, 0x93, 0xa0, 0x98, 0x3b, 0x28, 0xdf, 0xeb, 0x55, 0x38, 0x2f, 0x4b, 0xd9, 0xbd, 0x49, 0x56,
+	0xfa, 0x08, 0x74, 0xcc, 0xb2, 0xf5, 0xd7, 0x57, 0x8b, 0x91, 0xca, 0x56, 0xbf, 0x48, 0xb1, 0xd6,
+	0xd7, 0xf0, 0xff, 0xac, 0xf1, 0xa3, 0xf7, 0x2a, 0x94, 0x91, 0xa0, 0xdc, 0xbf, 0x66, 0x42, 0x42,
+	0x40, 0x19, 0xf9, 0x86, 0x3e, 0xcf, 0xf7, 0x3e, 0x3d, 0x3b, 0x2f, 0x49, 0x2f, 0xcf, 0x4b, 0xd2,
+	0x5f, 0xe7, 0x25, 0xe9, 0xfb, 0x8b, 0xd2, 0xc0, 0xcb, 0x8b, 0xd2, 0xc0, 0xef, 0x17, 0xa5, 0x81,
+	0xcf, 0x77, 0x1c, 0x37, 0x3a, 0x69, 0xd6, 0x34, 0xcb, 0x6f, 0xe8, 0xfc, 0x43, 0xc8, 0xad, 0x59,
+	0x9b, 0x8e, 0xaf, 0xb7, 0xde, 0xd7, 0x1b, 0xbe, 0xdd, 0xac, 0x23, 0xa1, 0xdf, 0x58, 0x44, 0x2f,
+	0x3f, 0xd8, 0xa4, 0x9f, 0x57, 0x51, 0x3b, 0x40, 0x52, 0x1b, 0x65, 0x9f, 0x48, 0xef, 0xfe, 0x3b,
+	0x00, 0xe4, 0xdd, 0x88, 0x21, 0xe7, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +902,24 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// PayPacketFeeAsyncBatch defines a rpc handler method for MsgPayPacketFeeAsyncBatch
+	// PayPacketFeeAsyncBatch is an open callback that may be called by any module/user that wishes to escrow funds in
+	// order to incentivize the relaying of a range or list of known packets on a channel with the same packet fee.
+	// Packets which may not be incentivized are skipped.
+	PayPacketFeeAsyncBatch(ctx context.Context, in *MsgPayPacketFeeAsyncBatch, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncBatchResponse, error)
+	// UpdateSendSideFeeEnabled defines a rpc handler method for MsgUpdateSendSideFeeEnabled
+	// UpdateSendSideFeeEnabled is called by the authority to enable or disable send side fees for a channel whose
+	// version is not wrapped in the ICS29 fee version metadata. On a send side fee enabled channel, packet fees may be
+	// escrowed and are paid out only on the sending chain to the relayer submitting the acknowledgement or timeout.
+	UpdateSendSideFeeEnabled(ctx context.Context, in *MsgUpdateSendSideFeeEnabled, opts ...grpc.CallOption) (*MsgUpdateSendSideFeeEnabledResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	// UpdateParams is called by the authority to update the ICS29 fee middleware parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DisputeHeldRecvFees defines a rpc handler method for MsgDisputeHeldRecvFees
+	// DisputeHeldRecvFees is called by the authority to refund the receive fees held in escrow for the given forward
+	// relayer on a channel before the dispute window has elapsed, in case the counterparty encoded a forward relayer
+	// address in the acknowledgement which did not relay the packet.
+	DisputeHeldRecvFees(ctx context.Context, in *MsgDisputeHeldRecvFees, opts ...grpc.CallOption) (*MsgDisputeHeldRecvFeesResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +966,42 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) PayPacketFeeAsyncBatch(ctx context.Context, in *MsgPayPacketFeeAsyncBatch, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncBatchResponse, error) {
+	out := new(MsgPayPacketFeeAsyncBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayPacketFeeAsyncBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSendSideFeeEnabled(ctx context.Context, in *MsgUpdateSendSideFeeEnabled, opts ...grpc.CallOption) (*MsgUpdateSendSideFeeEnabledResponse, error) {
+	out := new(MsgUpdateSendSideFeeEnabledResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateSendSideFeeEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeHeldRecvFees(ctx context.Context, in *MsgDisputeHeldRecvFees, opts ...grpc.CallOption) (*MsgDisputeHeldRecvFeesResponse, error) {
+	out := new(MsgDisputeHeldRecvFeesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/DisputeHeldRecvFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -525,6 +1026,24 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// PayPacketFeeAsyncBatch defines a rpc handler method for MsgPayPacketFeeAsyncBatch
+	// PayPacketFeeAsyncBatch is an open callback that may be called by any module/user that wishes to escrow funds in
+	// order to incentivize the relaying of a range or list of known packets on a channel with the same packet fee.
+	// Packets which may not be incentivized are skipped.
+	PayPacketFeeAsyncBatch(context.Context, *MsgPayPacketFeeAsyncBatch) (*MsgPayPacketFeeAsyncBatchResponse, error)
+	// UpdateSendSideFeeEnabled defines a rpc handler method for MsgUpdateSendSideFeeEnabled
+	// UpdateSendSideFeeEnabled is called by the authority to enable or disable send side fees for a channel whose
+	// version is not wrapped in the ICS29 fee version metadata. On a send side fee enabled channel, packet fees may be
+	// escrowed and are paid out only on the sending chain to the relayer submitting the acknowledgement or timeout.
+	UpdateSendSideFeeEnabled(context.Context, *MsgUpdateSendSideFeeEnabled) (*MsgUpdateSendSideFeeEnabledResponse, error)
+	// UpdateParams defines a rpc handler method for MsgUpdateParams
+	// UpdateParams is called by the authority to update the ICS29 fee middleware parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DisputeHeldRecvFees defines a rpc handler method for MsgDisputeHeldRecvFees
+	// DisputeHeldRecvFees is called by the authority to refund the receive fees held in escrow for the given forward
+	// relayer on a channel before the dispute window has elapsed, in case the counterparty encoded a forward relayer
+	// address in the acknowledgement which did not relay the packet.
+	DisputeHeldRecvFees(context.Context, *MsgDisputeHeldRecvFees) (*MsgDisputeHeldRecvFeesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -543,6 +1062,18 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) PayPacketFeeAsyncBatch(ctx context.Context, req *MsgPayPacketFeeAsyncBatch) (*MsgPayPacketFeeAsyncBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsyncBatch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSendSideFeeEnabled(ctx context.Context, req *MsgUpdateSendSideFeeEnabled) (*MsgUpdateSendSideFeeEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSendSideFeeEnabled not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) DisputeHeldRecvFees(ctx context.Context, req *MsgDisputeHeldRecvFees) (*MsgDisputeHeldRecvFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeHeldRecvFees not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -620,15 +1151,87 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterPayee",
-			Handler:    _Msg_RegisterPayee_Handler,
-		},
-		{
+func _Msg_PayPacketFeeAsyncBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayPacketFeeAsyncBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayPacketFeeAsyncBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayPacketFeeAsyncBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayPacketFeeAsyncBatch(ctx, req.(*MsgPayPacketFeeAsyncBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSendSideFeeEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSendSideFeeEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSendSideFeeEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateSendSideFeeEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSendSideFeeEnabled(ctx, req.(*MsgUpdateSendSideFeeEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeHeldRecvFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeHeldRecvFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeHeldRecvFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/DisputeHeldRecvFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeHeldRecvFees(ctx, req.(*MsgDisputeHeldRecvFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPayee",
+			Handler:    _Msg_RegisterPayee_Handler,
+		},
+		{
 			MethodName: "RegisterCounterpartyPayee",
 			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
 		},
@@ -640,6 +1243,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PayPacketFeeAsync",
 			Handler:    _Msg_PayPacketFeeAsync_Handler,
 		},
+		{
+			MethodName: "PayPacketFeeAsyncBatch",
+			Handler:    _Msg_PayPacketFeeAsyncBatch_Handler,
+		},
+		{
+			MethodName: "UpdateSendSideFeeEnabled",
+			Handler:    _Msg_UpdateSendSideFeeEnabled_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "DisputeHeldRecvFees",
+			Handler:    _Msg_DisputeHeldRecvFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/tx.proto",
@@ -945,152 +1564,1571 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPayPacketFeeAsyncBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPayPacketFeeAsyncBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFeeAsyncBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.PacketFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Sequences) > 0 {
+		dAtA6 := make([]byte, len(m.Sequences)*10)
+		var j5 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EndSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndSequence))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartSequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPayPacketFeeAsyncBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPayPacketFeeAsyncBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayPacketFeeAsyncBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PayPacketFeeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPayPacketFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PayPacketFeeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayPacketFeeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SourcePortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPayPacketFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateSendSideFeeEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPayPacketFeeAsync) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PacketFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgUpdateSendSideFeeEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateSendSideFeeEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSendSideFeeEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSendSideFeeEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSendSideFeeEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeHeldRecvFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeHeldRecvFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeHeldRecvFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ForwardRelayer) > 0 {
+		i -= len(m.ForwardRelayer)
+		copy(dAtA[i:], m.ForwardRelayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ForwardRelayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeHeldRecvFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeHeldRecvFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeHeldRecvFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disputed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Disputed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgPayPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SourcePortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPayPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFeeAsync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PacketFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartSequence != 0 {
+		n += 1 + sovTx(uint64(m.StartSequence))
+	}
+	if m.EndSequence != 0 {
+		n += 1 + sovTx(uint64(m.EndSequence))
+	}
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.PacketFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayPacketFeeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.Escrowed {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSendSideFeeEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSendSideFeeEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeHeldRecvFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ForwardRelayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeHeldRecvFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Disputed != 0 {
+		n += 1 + sovTx(uint64(m.Disputed))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgPayPacketFeeAsyncBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1113,10 +3151,10 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1184,10 +3222,124 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+			}
+			m.StartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSequence", wireType)
+			}
+			m.EndSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1197,29 +3349,80 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsyncBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1229,23 +3432,25 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, PayPacketFeeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1268,7 +3473,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *PayPacketFeeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,12 +3496,83 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayPacketFeeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayPacketFeeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1318,7 +3594,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendSideFeeEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,10 +3617,10 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendSideFeeEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendSideFeeEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1412,10 +3688,10 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1425,27 +3701,15 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1473,7 +3737,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +3760,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSendSideFeeEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,10 +3783,10 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSendSideFeeEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSendSideFeeEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1546,7 +3810,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,110 +3833,13 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1704,11 +3871,11 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1718,23 +3885,24 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1757,7 +3925,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +3948,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1807,7 +3975,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeHeldRecvFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +3998,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeHeldRecvFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeHeldRecvFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1850,30 +4018,29 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1883,24 +4050,87 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1923,7 +4153,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeHeldRecvFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,12 +4176,31 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeHeldRecvFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeHeldRecvFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			m.Disputed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Disputed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
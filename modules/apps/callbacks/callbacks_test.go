@@ -296,7 +296,7 @@ func (s *CallbacksTestSuite) AssertHasExecutedExpectedCallbackWithFee(
 
 // GetExpectedEvent returns the expected event for a callback.
 func GetExpectedEvent(
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler, remainingGas uint64, data []byte, srcPortID,
+	ctx sdk.Context, packetDataUnmarshaler porttypes.PacketDataUnmarshaler, remainingGas uint64, data []byte, srcPortID, srcChannelID,
 	eventPortID, eventChannelID string, seq uint64, callbackType types.CallbackType, expError error,
 ) (abci.Event, bool) {
 	var (
@@ -304,9 +304,9 @@ func GetExpectedEvent(
 		err          error
 	)
 	if callbackType == types.CallbackTypeReceivePacket {
-		callbackData, err = types.GetDestCallbackData(packetDataUnmarshaler, data, srcPortID, remainingGas, maxCallbackGas)
+		callbackData, err = types.GetDestCallbackData(ctx, packetDataUnmarshaler, data, srcPortID, srcChannelID, remainingGas, maxCallbackGas)
 	} else {
-		callbackData, err = types.GetSourceCallbackData(packetDataUnmarshaler, data, srcPortID, remainingGas, maxCallbackGas)
+		callbackData, err = types.GetSourceCallbackData(ctx, packetDataUnmarshaler, data, srcPortID, srcChannelID, remainingGas, maxCallbackGas)
 	}
 	if err != nil {
 		return abci.Event{}, false
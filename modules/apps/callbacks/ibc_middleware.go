@@ -18,9 +18,11 @@ import (
 )
 
 var (
-	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
-	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
-	_ porttypes.UpgradableModule      = (*IBCMiddleware)(nil)
+	_ porttypes.Middleware                  = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCMiddleware)(nil)
+	_ porttypes.PacketSenderRetriever       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule            = (*IBCMiddleware)(nil)
+	_ porttypes.RelativeTimeoutPacketSender = (*IBCMiddleware)(nil)
 )
 
 // IBCMiddleware implements the ICS26 callbacks for the ibc-callbacks middleware given
@@ -94,10 +96,57 @@ func (im IBCMiddleware) SendPacket(
 		return 0, err
 	}
 
-	callbackData, err := types.GetSourceCallbackData(im.app, data, sourcePort, ctx.GasMeter().GasRemaining(), im.maxCallbackGas)
+	if err := im.sendPacketCallback(ctx, sourcePort, sourceChannel, seq, timeoutHeight, timeoutTimestamp, data); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// SendPacketWithRelativeTimeout implements source callbacks for sending packets with a relative timeout.
+// It defers to the underlying application and then calls the contract callback with the resolved timeout.
+// If the contract callback returns an error, panics, or runs out of gas, then the packet send is rejected.
+// An error is returned if the underlying ICS4Wrapper does not support relative timeouts.
+func (im IBCMiddleware) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout channeltypes.RelativeTimeout,
+	data []byte,
+) (uint64, channeltypes.Timeout, error) {
+	relativeTimeoutSender, ok := im.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+	if !ok {
+		return 0, channeltypes.Timeout{}, errorsmod.Wrapf(porttypes.ErrRelativeTimeoutNotSupported, "ICS4Wrapper %T does not implement RelativeTimeoutPacketSender", im.ics4Wrapper)
+	}
+
+	seq, timeout, err := relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, chanCap, sourcePort, sourceChannel, relativeTimeout, data)
+	if err != nil {
+		return 0, channeltypes.Timeout{}, err
+	}
+
+	if err := im.sendPacketCallback(ctx, sourcePort, sourceChannel, seq, timeout.Height, timeout.Timestamp, data); err != nil {
+		return 0, channeltypes.Timeout{}, err
+	}
+
+	return seq, timeout, nil
+}
+
+// sendPacketCallback calls the contract callback for a sent packet if the packet opts-in to callbacks.
+// An error is returned if the contract rejects the packet send.
+func (im IBCMiddleware) sendPacketCallback(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	seq uint64,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) error {
+	callbackData, err := types.GetSourceCallbackData(ctx, im.app, data, sourcePort, sourceChannel, ctx.GasMeter().GasRemaining(), im.maxCallbackGas)
 	// SendPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
-		return seq, nil
+		return nil
 	}
 
 	callbackExecutor := func(cachedCtx sdk.Context) error {
@@ -109,11 +158,11 @@ func (im IBCMiddleware) SendPacket(
 	err = im.processCallback(ctx, types.CallbackTypeSendPacket, callbackData, callbackExecutor)
 	// contract keeper is allowed to reject the packet send.
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	types.EmitCallbackEvent(ctx, sourcePort, sourceChannel, seq, types.CallbackTypeSendPacket, callbackData, nil)
-	return seq, nil
+	return nil
 }
 
 // OnAcknowledgementPacket implements source callbacks for acknowledgement packets.
@@ -133,7 +182,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	}
 
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		ctx, im.app, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
 	// OnAcknowledgementPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -167,7 +216,7 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 	}
 
 	callbackData, err := types.GetSourceCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		ctx, im.app, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
 	// OnTimeoutPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -203,7 +252,7 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		ctx, im.app, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
 	// OnRecvPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -241,7 +290,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	}
 
 	callbackData, err := types.GetDestCallbackData(
-		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
+		ctx, im.app, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
 	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -425,3 +474,20 @@ func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string)
 func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	return im.app.UnmarshalPacketData(bz)
 }
+
+// GetPacketSender defers to the underlying app to retrieve the packet sender if it
+// supports the PacketSenderRetriever interface, otherwise the packet sender is derived
+// from the packet data. This function implements the optional PacketSenderRetriever interface.
+func (im IBCMiddleware) GetPacketSender(ctx sdk.Context, packetData interface{}, sourcePortID, sourceChannelID string) string {
+	retriever, ok := im.app.(porttypes.PacketSenderRetriever)
+	if !ok {
+		data, ok := packetData.(ibcexported.PacketData)
+		if !ok {
+			return ""
+		}
+
+		return data.GetPacketSender(sourcePortID)
+	}
+
+	return retriever.GetPacketSender(ctx, packetData, sourcePortID, sourceChannelID)
+}
@@ -16,6 +16,7 @@ import (
 	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -141,6 +142,26 @@ func (s *CallbacksTestSuite) TestICATimeoutCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestICACallbacksOwnershipTransfer() {
+	icaAddr := s.SetupICATest()
+
+	// transfer the ownership of the interchain account
+	newOwner := s.chainB.SenderAccount.GetAddress().String()
+	GetSimApp(s.chainA).ICAControllerKeeper.SetInterchainAccountOwner(s.chainA.GetContext(), s.path.EndpointA.ConnectionID, s.path.EndpointA.ChannelConfig.PortID, newOwner)
+
+	icaPacketData := s.buildICAMsgDelegatePacketData(icaAddr, fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract))
+
+	icaControllerStack, ok := s.chainA.App.GetIBCKeeper().Router.GetRoute(icacontrollertypes.SubModuleName)
+	s.Require().True(ok)
+
+	callbackData, err := types.GetSourceCallbackData(
+		s.chainA.GetContext(), icaControllerStack.(porttypes.PacketDataUnmarshaler), icaPacketData.GetBytes(),
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, 1_000_000, 1_000_000,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(newOwner, callbackData.SenderAddress)
+}
+
 // ExecuteICATx executes a stakingtypes.MsgDelegate on chainB by sending a packet containing the msg to chainB
 func (s *CallbacksTestSuite) ExecuteICATx(icaAddress, memo string) {
 	timeoutTimestamp := uint64(s.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
@@ -6,6 +6,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
@@ -67,18 +69,18 @@ type CallbackData struct {
 
 // GetSourceCallbackData parses the packet data and returns the source callback data.
 func GetSourceCallbackData(
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
-	data []byte, srcPortID string, remainingGas uint64, maxGas uint64,
+	ctx sdk.Context, packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	data []byte, srcPortID, srcChannelID string, remainingGas uint64, maxGas uint64,
 ) (CallbackData, error) {
-	return getCallbackData(packetDataUnmarshaler, data, srcPortID, remainingGas, maxGas, SourceCallbackKey)
+	return getCallbackData(ctx, packetDataUnmarshaler, data, srcPortID, srcChannelID, remainingGas, maxGas, SourceCallbackKey)
 }
 
 // GetDestCallbackData parses the packet data and returns the destination callback data.
 func GetDestCallbackData(
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
-	data []byte, srcPortID string, remainingGas, maxGas uint64,
+	ctx sdk.Context, packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	data []byte, srcPortID, srcChannelID string, remainingGas, maxGas uint64,
 ) (CallbackData, error) {
-	return getCallbackData(packetDataUnmarshaler, data, srcPortID, remainingGas, maxGas, DestinationCallbackKey)
+	return getCallbackData(ctx, packetDataUnmarshaler, data, srcPortID, srcChannelID, remainingGas, maxGas, DestinationCallbackKey)
 }
 
 // getCallbackData parses the packet data and returns the callback data.
@@ -86,8 +88,8 @@ func GetDestCallbackData(
 // The addressGetter and gasLimitGetter functions are used to retrieve the callback
 // address and gas limit from the callback data.
 func getCallbackData(
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
-	data []byte, srcPortID string, remainingGas,
+	ctx sdk.Context, packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	data []byte, srcPortID, srcChannelID string, remainingGas,
 	maxGas uint64, callbackKey string,
 ) (CallbackData, error) {
 	// unmarshal packet data
@@ -112,11 +114,12 @@ func getCallbackData(
 		return CallbackData{}, ErrCallbackAddressNotFound
 	}
 
-	// retrieve packet sender from packet data if possible and if needed
+	// retrieve packet sender from the underlying app or the packet data if possible and if needed
 	var packetSender string
 	if callbackKey == SourceCallbackKey {
-		packetData, ok := packetData.(ibcexported.PacketData)
-		if ok {
+		if packetSenderRetriever, ok := packetDataUnmarshaler.(porttypes.PacketSenderRetriever); ok {
+			packetSender = packetSenderRetriever.GetPacketSender(ctx, packetData, srcPortID, srcChannelID)
+		} else if packetData, ok := packetData.(ibcexported.PacketData); ok {
 			packetSender = packetData.GetPacketSender(srcPortID)
 		}
 	}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 )
 
@@ -10,11 +12,11 @@ import (
 
 // GetCallbackData is a wrapper around getCallbackData to allow the function to be directly called in tests.
 func GetCallbackData(
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
-	packetData []byte, srcPortID string, remainingGas,
+	ctx sdk.Context, packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packetData []byte, srcPortID, srcChannelID string, remainingGas,
 	maxGas uint64, callbackKey string,
 ) (CallbackData, error) {
-	return getCallbackData(packetDataUnmarshaler, packetData, srcPortID, remainingGas, maxGas, callbackKey)
+	return getCallbackData(ctx, packetDataUnmarshaler, packetData, srcPortID, srcChannelID, remainingGas, maxGas, callbackKey)
 }
 
 // GetCallbackAddress is a wrapper around getCallbackAddress to allow the function to be directly called in tests.
@@ -0,0 +1,11 @@
+/*
+Package memorouter implements an IBC middleware which routes the top-level keys of
+the memo of received ICS-20 packets to the memo handlers registered for them.
+Handlers are run in registration order after the underlying transfer application
+has successfully received the packet, and their results are returned in a structured
+acknowledgement. Memo keys without a registered handler are treated according to a
+configurable policy. The middleware is meant to wrap the transfer application and
+follows the middleware pattern specified in the ICS 30 specification
+(https://github.com/cosmos/ibc/tree/main/spec/app/ics-030-middleware).
+*/
+package memorouter
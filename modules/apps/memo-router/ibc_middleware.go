@@ -0,0 +1,368 @@
+package memorouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/memo-router/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware                  = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler       = (*IBCMiddleware)(nil)
+	_ porttypes.UpgradableModule            = (*IBCMiddleware)(nil)
+	_ porttypes.RelativeTimeoutPacketSender = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the memo router middleware given
+// the underlying transfer application, the ICS4Wrapper and the memo router.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	router           *types.Router
+	unknownKeyPolicy types.UnknownKeyPolicy
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, the
+// ICS4Wrapper, the memo router and the policy applied to memo keys without a registered
+// handler. The router is sealed if it has not been sealed yet.
+//
+// NOTE: when using types.UnknownKeyPolicyReject, memo keys consumed by other middleware
+// in the stack (e.g. the callbacks middleware) must be registered on the router as well.
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	router *types.Router,
+	unknownKeyPolicy types.UnknownKeyPolicy,
+) IBCMiddleware {
+	if app == nil {
+		panic(errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "underlying application cannot be nil"))
+	}
+	if ics4Wrapper == nil {
+		panic(errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "ICS4Wrapper cannot be nil"))
+	}
+	if router == nil {
+		panic(errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "memo router cannot be nil"))
+	}
+
+	if !router.Sealed() {
+		router.Seal()
+	}
+
+	return IBCMiddleware{
+		app:              app,
+		ics4Wrapper:      ics4Wrapper,
+		router:           router,
+		unknownKeyPolicy: unknownKeyPolicy,
+	}
+}
+
+// OnChanOpenInit defers to the underlying application
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry defers to the underlying application
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck defers to the underlying application
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit defers to the underlying application
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface.
+// The underlying application is called first. If it returns a successful synchronous
+// acknowledgement, the top-level keys of the packet memo are routed to their registered
+// memo handlers in registration order. An error acknowledgement is returned if any handler
+// fails, or if the memo contains a key without a registered handler and the unknown key
+// policy is types.UnknownKeyPolicyReject, in which case all state changes are reverted.
+// If at least one handler was run, the acknowledgement result contains the underlying
+// application acknowledgement result along with the results of the handlers.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	appAck := im.app.OnRecvPacket(ctx, packet, relayer)
+	if appAck == nil || !appAck.Success() {
+		return appAck
+	}
+
+	ics20Version, found := im.ics4Wrapper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return appAck
+	}
+
+	data, err := transfertypes.UnmarshalPacketDataBytes(packet.GetData(), ics20Version)
+	if err != nil {
+		return appAck
+	}
+
+	memo, ok := parseMemo(data.Memo)
+	if !ok {
+		return appAck
+	}
+
+	if im.unknownKeyPolicy == types.UnknownKeyPolicyReject {
+		if unknownKeys := im.unknownKeys(memo); len(unknownKeys) > 0 {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrUnknownMemoKey, "%s", strings.Join(unknownKeys, ", ")))
+		}
+	}
+
+	var results []types.HandlerResult
+	for _, key := range im.router.Keys() {
+		value, found := memo[key]
+		if !found {
+			continue
+		}
+
+		handler, _ := im.router.GetRoute(key)
+		result, err := handler.OnRecvPacket(ctx, packet, data, value, relayer)
+		emitMemoHandlerEvent(ctx, key, err)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrMemoHandler, "memo key %s: %s", key, err))
+		}
+
+		results = append(results, types.NewHandlerResult(key, result))
+	}
+
+	if len(results) == 0 {
+		return appAck
+	}
+
+	// the result of a successful channel acknowledgement is the underlying application result
+	var appAckResult []byte
+	if channelAck, ok := appAck.(channeltypes.Acknowledgement); ok {
+		appAckResult = channelAck.GetResult()
+	} else {
+		appAckResult = appAck.Acknowledgement()
+	}
+
+	ack := types.NewAcknowledgement(appAckResult, results)
+	return channeltypes.NewResultAcknowledgement(ack.Acknowledgement())
+}
+
+// OnAcknowledgementPacket defers to the underlying application
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket defers to the underlying application
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// OnChanUpgradeInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the IBCModule interface
+func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface
+func (im IBCMiddleware) OnChanUpgradeRestore(ctx sdk.Context, portID, channelID string) {
+	cbs, ok := im.app.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+
+	cbs.OnChanUpgradeRestore(ctx, portID, channelID)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// SendPacketWithRelativeTimeout implements the optional RelativeTimeoutPacketSender interface.
+// An error is returned if the underlying ICS4Wrapper does not support relative timeouts.
+func (im IBCMiddleware) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout channeltypes.RelativeTimeout,
+	data []byte,
+) (uint64, channeltypes.Timeout, error) {
+	relativeTimeoutSender, ok := im.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+	if !ok {
+		return 0, channeltypes.Timeout{}, errorsmod.Wrapf(porttypes.ErrRelativeTimeoutNotSupported, "ICS4Wrapper %T does not implement RelativeTimeoutPacketSender", im.ics4Wrapper)
+	}
+
+	return relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, chanCap, sourcePort, sourceChannel, relativeTimeout, data)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData attempts to use the underlying app to unmarshal the packet data.
+// If the underlying app does not support the PacketDataUnmarshaler interface, an error is returned.
+// This function implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "underlying app does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(bz)
+}
+
+// unknownKeys returns the top-level keys of the memo without a registered memo handler, in sorted order.
+func (im IBCMiddleware) unknownKeys(memo map[string]json.RawMessage) []string {
+	var unknownKeys []string
+	for key := range memo {
+		if !im.router.HasRoute(key) {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	sort.Strings(unknownKeys)
+	return unknownKeys
+}
+
+// parseMemo parses the given memo into its top-level keys. It returns false if the
+// memo is empty or is not a JSON object, in which case no memo handlers are run.
+func parseMemo(memo string) (map[string]json.RawMessage, bool) {
+	if strings.TrimSpace(memo) == "" {
+		return nil, false
+	}
+
+	var jsonObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil || jsonObject == nil {
+		return nil, false
+	}
+
+	return jsonObject, true
+}
+
+// emitMemoHandlerEvent emits an event containing the outcome of the memo handler for the given key
+func emitMemoHandlerEvent(ctx sdk.Context, key string, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyMemoKey, key),
+		sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprintf("%t", err == nil)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMemoHandler, attributes...))
+}
@@ -0,0 +1,229 @@
+package memorouter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	memorouter "github.com/cosmos/ibc-go/v8/modules/apps/memo-router"
+	"github.com/cosmos/ibc-go/v8/modules/apps/memo-router/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// mockHandler is a memo handler which records the values it is called with
+type mockHandler struct {
+	key    string
+	calls  *[]string
+	values map[string]json.RawMessage
+	err    error
+}
+
+func (h mockHandler) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ transfertypes.FungibleTokenPacketDataV2, value json.RawMessage, _ sdk.AccAddress) ([]byte, error) {
+	*h.calls = append(*h.calls, h.key)
+	h.values[h.key] = value
+
+	if h.err != nil {
+		return nil, h.err
+	}
+
+	return []byte(h.key + "-result"), nil
+}
+
+type MemoRouterTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *MemoRouterTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestMemoRouterTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MemoRouterTestSuite))
+}
+
+// sendTransfer sends a transfer with the given memo from chainA to chainB and returns the packet sent.
+func (suite *MemoRouterTestSuite) sendTransfer(memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, ibctesting.TestCoin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+func (suite *MemoRouterTestSuite) TestOnRecvPacket() {
+	var (
+		packet     channeltypes.Packet
+		policy     types.UnknownKeyPolicy
+		handlerErr error
+	)
+
+	testCases := []struct {
+		name        string
+		memo        string
+		malleate    func()
+		expCalls    []string
+		expResult   bool
+		expAckError error
+	}{
+		{
+			"success: handlers run in registration order",
+			`{"second": {"value": 2}, "first": "value"}`,
+			func() {},
+			[]string{"first", "second"},
+			true,
+			nil,
+		},
+		{
+			"success: only handlers for present keys are run",
+			`{"first": "value"}`,
+			func() {},
+			[]string{"first"},
+			true,
+			nil,
+		},
+		{
+			"success: unknown key ignored",
+			`{"first": "value", "dest_callback": {}}`,
+			func() {},
+			[]string{"first"},
+			true,
+			nil,
+		},
+		{
+			"success: empty memo",
+			"",
+			func() {},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"success: memo is not a JSON object",
+			"hello world",
+			func() {},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"success: no registered keys in memo",
+			`{"dest_callback": {}}`,
+			func() {},
+			nil,
+			false,
+			nil,
+		},
+		{
+			"failure: unknown key rejected",
+			`{"first": "value", "dest_callback": {}}`,
+			func() {
+				policy = types.UnknownKeyPolicyReject
+			},
+			nil,
+			false,
+			types.ErrUnknownMemoKey,
+		},
+		{
+			"failure: handler returns error",
+			`{"first": "value", "second": {}}`,
+			func() {
+				handlerErr = errors.New("handler failed")
+			},
+			[]string{"first"},
+			false,
+			types.ErrMemoHandler,
+		},
+		{
+			"failure: underlying application returns error acknowledgement",
+			`{"first": "value"}`,
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			nil,
+			false,
+			ibcerrors.ErrInvalidType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			policy = types.UnknownKeyPolicyIgnore
+			handlerErr = nil
+
+			var calls []string
+			values := make(map[string]json.RawMessage)
+
+			packet = suite.sendTransfer(tc.memo)
+
+			tc.malleate()
+
+			router := types.NewRouter().
+				AddRoute("first", mockHandler{key: "first", calls: &calls, values: values, err: handlerErr}).
+				AddRoute("second", mockHandler{key: "second", calls: &calls, values: values})
+
+			app := transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper)
+			middleware := memorouter.NewIBCMiddleware(app, suite.chainB.App.GetIBCKeeper().ChannelKeeper, router, policy)
+
+			ack := middleware.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().NotNil(ack)
+			suite.Require().Equal(tc.expCalls, calls)
+
+			switch {
+			case tc.expResult:
+				suite.Require().True(ack.Success())
+
+				channelAck, ok := ack.(channeltypes.Acknowledgement)
+				suite.Require().True(ok)
+
+				var routerAck types.Acknowledgement
+				suite.Require().NoError(json.Unmarshal(channelAck.GetResult(), &routerAck))
+				suite.Require().Equal([]byte{byte(1)}, routerAck.AppAcknowledgement)
+				suite.Require().Len(routerAck.HandlerResults, len(tc.expCalls))
+
+				for i, key := range tc.expCalls {
+					suite.Require().Equal(types.NewHandlerResult(key, []byte(key+"-result")), routerAck.HandlerResults[i])
+				}
+
+				suite.Require().JSONEq(`"value"`, string(values["first"]))
+			case tc.expAckError != nil:
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expAckError), ack)
+			default:
+				// the underlying application acknowledgement is returned unchanged
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
+			}
+		})
+	}
+}
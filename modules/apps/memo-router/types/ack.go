@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// NewAcknowledgement creates a new instance of Acknowledgement
+func NewAcknowledgement(appAcknowledgement []byte, handlerResults []HandlerResult) Acknowledgement {
+	return Acknowledgement{
+		AppAcknowledgement: appAcknowledgement,
+		HandlerResults:     handlerResults,
+	}
+}
+
+// NewHandlerResult creates a new instance of HandlerResult
+func NewHandlerResult(key string, result []byte) HandlerResult {
+	return HandlerResult{
+		Key:    key,
+		Result: result,
+	}
+}
+
+// Acknowledgement returns the JSON encoded bytes of the memo router acknowledgement
+func (ack Acknowledgement) Acknowledgement() []byte {
+	bz, err := json.Marshal(&ack)
+	if err != nil {
+		panic(errors.New("cannot marshal acknowledgement into json"))
+	}
+
+	return bz
+}
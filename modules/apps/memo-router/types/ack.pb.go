@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/memo_router/v1/ack.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Acknowledgement is the acknowledgement result returned by the memo router middleware
+// for received packets whose memo was handled by at least one memo handler
+type Acknowledgement struct {
+	// the underlying app acknowledgement result bytes
+	AppAcknowledgement []byte `protobuf:"bytes,1,opt,name=app_acknowledgement,json=appAcknowledgement,proto3" json:"app_acknowledgement,omitempty"`
+	// the results of the memo handlers, in the order in which the handlers were run
+	HandlerResults []HandlerResult `protobuf:"bytes,2,rep,name=handler_results,json=handlerResults,proto3" json:"handler_results"`
+}
+
+func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
+func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
+func (*Acknowledgement) ProtoMessage()    {}
+func (*Acknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6a905d3c997a96, []int{0}
+}
+func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Acknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Acknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Acknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Acknowledgement.Merge(m, src)
+}
+func (m *Acknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Acknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Acknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Acknowledgement proto.InternalMessageInfo
+
+func (m *Acknowledgement) GetAppAcknowledgement() []byte {
+	if m != nil {
+		return m.AppAcknowledgement
+	}
+	return nil
+}
+
+func (m *Acknowledgement) GetHandlerResults() []HandlerResult {
+	if m != nil {
+		return m.HandlerResults
+	}
+	return nil
+}
+
+// HandlerResult contains the result returned by the memo handler registered for a memo key
+type HandlerResult struct {
+	// the top-level memo key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// the result bytes returned by the memo handler
+	Result []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *HandlerResult) Reset()         { *m = HandlerResult{} }
+func (m *HandlerResult) String() string { return proto.CompactTextString(m) }
+func (*HandlerResult) ProtoMessage()    {}
+func (*HandlerResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6a905d3c997a96, []int{1}
+}
+func (m *HandlerResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandlerResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandlerResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandlerResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandlerResult.Merge(m, src)
+}
+func (m *HandlerResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandlerResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandlerResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandlerResult proto.InternalMessageInfo
+
+func (m *HandlerResult) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HandlerResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Acknowledgement)(nil), "ibc.applications.memo_router.v1.Acknowledgement")
+	proto.RegisterType((*HandlerResult)(nil), "ibc.applications.memo_router.v1.HandlerResult")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/memo_router/v1/ack.proto", fileDescriptor_ef6a905d3c997a96)
+}
+
+var fileDescriptor_ef6a905d3c997a96 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x18, 0x85, 0xe3, 0xf6, 0x53, 0xa5, 0xcf, 0xfc, 0x14, 0x05, 0x84, 0x2a, 0x06, 0xb7, 0xea, 0x54,
+	0x86, 0xda, 0x2a, 0x2c, 0xc0, 0x46, 0x27, 0xe6, 0x88, 0x09, 0x09, 0x55, 0x8e, 0x6b, 0xa5, 0x56,
+	0xe3, 0xbc, 0x56, 0xec, 0x04, 0xf5, 0x2e, 0xb8, 0x05, 0xee, 0xa6, 0x63, 0x47, 0x26, 0x84, 0x92,
+	0x1b, 0x41, 0x49, 0x3a, 0xa4, 0x2c, 0x6c, 0xaf, 0x8f, 0xce, 0x23, 0x1f, 0x3d, 0xf8, 0x5a, 0x85,
+	0x82, 0x71, 0x63, 0x62, 0x25, 0xb8, 0x53, 0x90, 0x58, 0xa6, 0xa5, 0x86, 0x45, 0x0a, 0x99, 0x93,
+	0x29, 0xcb, 0x67, 0x8c, 0x8b, 0x35, 0x35, 0x29, 0x38, 0xf0, 0x87, 0x2a, 0x14, 0xb4, 0x5d, 0xa5,
+	0xad, 0x2a, 0xcd, 0x67, 0x57, 0x17, 0x11, 0x44, 0x50, 0x77, 0x59, 0x75, 0x35, 0xd8, 0xf8, 0x03,
+	0xe1, 0xfe, 0xa3, 0x58, 0x27, 0xf0, 0x16, 0xcb, 0x65, 0x24, 0xb5, 0x4c, 0x9c, 0xcf, 0xf0, 0x39,
+	0x37, 0x66, 0xc1, 0x0f, 0xe3, 0x01, 0x1a, 0xa1, 0xc9, 0x71, 0xe0, 0x73, 0x63, 0x7e, 0x03, 0xaf,
+	0xb8, 0xbf, 0xe2, 0xc9, 0x32, 0x96, 0xe9, 0x22, 0x95, 0x36, 0x8b, 0x9d, 0x1d, 0x74, 0x46, 0xdd,
+	0xc9, 0xd1, 0x0d, 0xa5, 0x7f, 0xac, 0xa2, 0x4f, 0x0d, 0x17, 0xd4, 0xd8, 0xfc, 0xdf, 0xf6, 0x6b,
+	0xe8, 0x05, 0xa7, 0xab, 0x76, 0x68, 0xc7, 0xf7, 0xf8, 0xe4, 0xa0, 0xe6, 0x9f, 0xe1, 0xee, 0x5a,
+	0x6e, 0xea, 0x41, 0xff, 0x83, 0xea, 0xf4, 0x2f, 0x71, 0xaf, 0xf9, 0x79, 0xd0, 0xa9, 0x57, 0xee,
+	0x5f, 0xf3, 0xe7, 0x6d, 0x41, 0xd0, 0xae, 0x20, 0xe8, 0xbb, 0x20, 0xe8, 0xbd, 0x24, 0xde, 0xae,
+	0x24, 0xde, 0x67, 0x49, 0xbc, 0x97, 0x87, 0x48, 0xb9, 0x55, 0x16, 0x52, 0x01, 0x9a, 0x09, 0xb0,
+	0x1a, 0x2c, 0x53, 0xa1, 0x98, 0x46, 0xc0, 0xf2, 0x3b, 0xa6, 0x61, 0x99, 0xc5, 0xd2, 0x56, 0xea,
+	0x1b, 0xe5, 0xd3, 0xbd, 0x72, 0xb7, 0x31, 0xd2, 0x86, 0xbd, 0xda, 0xdd, 0xed, 0xcf, 0x00, 0x0e,
+	0xf3, 0xed, 0xa2, 0x9f, 0x01, 0x00, 0x00,
+}
+
+func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Acknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Acknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HandlerResults) > 0 {
+		for iNdEx := len(m.HandlerResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandlerResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAck(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AppAcknowledgement) > 0 {
+		i -= len(m.AppAcknowledgement)
+		copy(dAtA[i:], m.AppAcknowledgement)
+		i = encodeVarintAck(dAtA, i, uint64(len(m.AppAcknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandlerResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandlerResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintAck(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAck(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAck(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAck(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Acknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAcknowledgement)
+	if l > 0 {
+		n += 1 + l + sovAck(uint64(l))
+	}
+	if len(m.HandlerResults) > 0 {
+		for _, e := range m.HandlerResults {
+			l = e.Size()
+			n += 1 + l + sovAck(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HandlerResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAck(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovAck(uint64(l))
+	}
+	return n
+}
+
+func sovAck(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAck(x uint64) (n int) {
+	return sovAck(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAcknowledgement = append(m.AppAcknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAcknowledgement == nil {
+				m.AppAcknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandlerResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandlerResults = append(m.HandlerResults, HandlerResult{})
+			if err := m.HandlerResults[len(m.HandlerResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAck(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAck
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAck
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAck
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAck
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAck        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAck          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAck = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// memo router sentinel errors
+var (
+	ErrUnknownMemoKey = errorsmod.Register(ModuleName, 2, "unknown memo key")
+	ErrMemoHandler    = errorsmod.Register(ModuleName, 3, "memo handler failed")
+)
@@ -0,0 +1,10 @@
+package types
+
+// memo router events
+const (
+	EventTypeMemoHandler = "memo_handler"
+
+	AttributeKeyMemoKey = "memo_key"
+	AttributeKeySuccess = "success"
+	AttributeKeyError   = "error"
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MemoHandler defines the interface which must be implemented by modules handling
+// the value of a top-level key of the memo of received ICS-20 packets.
+type MemoHandler interface {
+	// OnRecvPacket is called after the underlying transfer application has successfully
+	// received the packet, with the raw JSON value of the key the handler is registered for.
+	// The returned bytes are included in the acknowledgement of the packet. If an error is
+	// returned, an error acknowledgement is written and all state changes are reverted.
+	OnRecvPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data transfertypes.FungibleTokenPacketDataV2,
+		value json.RawMessage,
+		relayer sdk.AccAddress,
+	) ([]byte, error)
+}
+
+// UnknownKeyPolicy defines how top-level memo keys without a registered handler are treated.
+type UnknownKeyPolicy int
+
+const (
+	// UnknownKeyPolicyIgnore ignores memo keys without a registered handler. This allows
+	// memo keys consumed by other middleware to be passed through untouched.
+	UnknownKeyPolicyIgnore UnknownKeyPolicy = iota
+	// UnknownKeyPolicyReject returns an error acknowledgement for packets whose memo
+	// contains a key without a registered handler.
+	UnknownKeyPolicyReject
+)
+
+// String implements the Stringer interface.
+func (p UnknownKeyPolicy) String() string {
+	switch p {
+	case UnknownKeyPolicyIgnore:
+		return "ignore"
+	case UnknownKeyPolicyReject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
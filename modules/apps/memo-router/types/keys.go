@@ -0,0 +1,6 @@
+package types
+
+const (
+	// ModuleName defines the memo router middleware name
+	ModuleName = "memorouter"
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Router maps top-level memo keys to the memo handlers registered for them. The
+// handlers are run in the order in which they were registered.
+type Router struct {
+	routes map[string]MemoHandler
+	keys   []string
+	sealed bool
+}
+
+// NewRouter creates a new, empty Router.
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[string]MemoHandler),
+	}
+}
+
+// Seal prevents the Router from any subsequent route handlers to be registered.
+// Seal will panic if called more than once.
+func (rtr *Router) Seal() {
+	if rtr.sealed {
+		panic(errors.New("router already sealed"))
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the Router is sealed or not.
+func (rtr Router) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute adds a memo handler for the given top-level memo key. It returns the Router
+// so AddRoute calls can be linked. It will panic if the Router is sealed, if the key
+// is empty or if a handler has already been registered for the key.
+func (rtr *Router) AddRoute(key string, handler MemoHandler) *Router {
+	if rtr.sealed {
+		panic(fmt.Errorf("router sealed; cannot register %s memo handler", key))
+	}
+	if strings.TrimSpace(key) == "" {
+		panic(errors.New("memo key cannot be blank"))
+	}
+	if handler == nil {
+		panic(fmt.Errorf("memo handler for key %s cannot be nil", key))
+	}
+	if rtr.HasRoute(key) {
+		panic(fmt.Errorf("memo handler already registered for key %s", key))
+	}
+
+	rtr.routes[key] = handler
+	rtr.keys = append(rtr.keys, key)
+
+	return rtr
+}
+
+// HasRoute returns true if the Router has a memo handler registered for the given key.
+func (rtr *Router) HasRoute(key string) bool {
+	_, ok := rtr.routes[key]
+	return ok
+}
+
+// GetRoute returns the memo handler registered for the given key.
+func (rtr *Router) GetRoute(key string) (MemoHandler, bool) {
+	handler, ok := rtr.routes[key]
+	return handler, ok
+}
+
+// Keys returns the memo keys with a registered handler, in registration order.
+func (rtr *Router) Keys() []string {
+	keys := make([]string, len(rtr.keys))
+	copy(keys, rtr.keys)
+	return keys
+}
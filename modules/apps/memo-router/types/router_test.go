@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/memo-router/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+type noopHandler struct{}
+
+func (noopHandler) OnRecvPacket(sdk.Context, channeltypes.Packet, transfertypes.FungibleTokenPacketDataV2, json.RawMessage, sdk.AccAddress) ([]byte, error) {
+	return nil, nil
+}
+
+func TestRouter(t *testing.T) {
+	var router *types.Router
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				router.AddRoute("wasm", noopHandler{})
+			},
+			true,
+		},
+		{
+			"success: key with underscore",
+			func() {
+				router.AddRoute("src_callback", noopHandler{})
+			},
+			true,
+		},
+		{
+			"failure: router sealed",
+			func() {
+				router.Seal()
+				router.AddRoute("wasm", noopHandler{})
+			},
+			false,
+		},
+		{
+			"failure: blank key",
+			func() {
+				router.AddRoute(" ", noopHandler{})
+			},
+			false,
+		},
+		{
+			"failure: nil handler",
+			func() {
+				router.AddRoute("wasm", nil)
+			},
+			false,
+		},
+		{
+			"failure: duplicate key",
+			func() {
+				router.AddRoute("wasm", noopHandler{})
+				router.AddRoute("wasm", noopHandler{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			router = types.NewRouter()
+
+			if tc.expPass {
+				require.NotPanics(t, tc.malleate)
+			} else {
+				require.Panics(t, tc.malleate)
+			}
+		})
+	}
+}
+
+func TestRouterKeysOrder(t *testing.T) {
+	router := types.NewRouter().
+		AddRoute("wasm", noopHandler{}).
+		AddRoute("autopilot", noopHandler{}).
+		AddRoute("callback", noopHandler{})
+
+	require.Equal(t, []string{"wasm", "autopilot", "callback"}, router.Keys())
+	require.True(t, router.HasRoute("autopilot"))
+	require.False(t, router.HasRoute("forward"))
+
+	_, found := router.GetRoute("forward")
+	require.False(t, found)
+
+	router.Seal()
+	require.True(t, router.Sealed())
+	require.Panics(t, router.Seal)
+}
@@ -0,0 +1,22 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the rate limiting module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "rate-limiting",
+		Short:                      "IBC rate limiting query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdRateLimits(),
+		GetCmdRateLimit(),
+	)
+
+	return queryCmd
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+)
+
+// GetCmdRateLimits returns the command handler for the rate limits query
+func GetCmdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all of the rate limits and their current flows.",
+		Long:    "Query all of the rate limits and their current flows.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query rate-limiting rate-limits", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
+
+	return cmd
+}
+
+// GetCmdRateLimit returns the command handler for the rate limit query
+func GetCmdRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [denom] [channel-id]",
+		Short:   "Query the rate limit of a denomination on a channel.",
+		Long:    "Query the rate limit and current flow of a denomination, as represented on this chain, on a channel.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query rate-limiting rate-limit uatom channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitRequest{
+				Denom:     args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
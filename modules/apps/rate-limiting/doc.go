@@ -0,0 +1,12 @@
+/*
+Package ratelimiting implements an IBC middleware which rate limits the flow of
+ICS-20 fungible tokens. The net inflow and outflow of a denomination over a channel
+is capped within a rolling window to a percentage of the channel value, which is the
+total supply of the denomination, or the total amount in escrow for native denominations.
+The flow of the previous window is
+weighted by the fraction of the rolling window which it overlaps.
+The middleware is meant to wrap the transfer application and follows the middleware
+pattern specified in the ICS 30 specification
+(https://github.com/cosmos/ibc/tree/main/spec/app/ics-030-middleware).
+*/
+package ratelimiting
@@ -0,0 +1,185 @@
+package ratelimiting_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var defaultAmount = sdkmath.NewInt(1000)
+
+type RateLimitingTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+// SetupTest opens a transfer channel between chainA and chainB and sends an initial amount of
+// chainA's native tokens to chainB, so that the channel value is non-zero on both chains.
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+
+	packet := suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, defaultAmount))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RateLimitingTestSuite))
+}
+
+// sendTransfer sends the given coin from the sender account of the chain over the given endpoint
+// and returns the packet sent.
+func (suite *RateLimitingTestSuite) sendTransfer(chain *ibctesting.TestChain, endpoint *ibctesting.Endpoint, coin sdk.Coin) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		chain.SenderAccount.GetAddress().String(), endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.Chain.GetTimeoutHeight(), 0, "",
+	)
+
+	res, err := chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// setRateLimit sets a rate limit for the given denomination on the channel of the given endpoint.
+func (suite *RateLimitingTestSuite) setRateLimit(chain *ibctesting.TestChain, endpoint *ibctesting.Endpoint, denom string, quota types.Quota) {
+	rateLimitingKeeper := chain.GetSimApp().RateLimitingKeeper
+
+	msg := types.NewMsgUpdateRateLimit(rateLimitingKeeper.GetAuthority(), types.NewPath(denom, endpoint.ChannelID), quota)
+	_, err := rateLimitingKeeper.UpdateRateLimit(chain.GetContext(), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacketRateLimited() {
+	// the send quota is 10% of the amount in escrow
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+
+	packet := suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60)))
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+
+	// the outflow exceeds the send quota
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().ErrorContains(err, types.ErrQuotaExceeded.Error())
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(60), rateLimit.Flow.Outflow)
+
+	// the pending send packet is removed once the packet is acknowledged
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+
+	rateLimit, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(60), rateLimit.Flow.Outflow)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacketTimeout() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+
+	packet := suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60)))
+
+	// advance chainB past the timeout height
+	suite.coordinator.CommitNBlocks(suite.chainB, 100)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	err := suite.path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// the outflow is reverted as the tokens are refunded
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitingTestSuite) TestRecvPacketRateLimited() {
+	// the receive quota on chainB is 10% of the voucher supply
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.setRateLimit(suite.chainB, suite.path.EndpointB, voucherDenom, types.NewQuota(10, 10, time.Hour))
+
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	// the inflow is within the receive quota
+	packet := suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	_, ack, err := suite.path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	// the inflow exceeds the receive quota
+	packet = suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	_, ack, err = suite.path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrQuotaExceeded).Acknowledgement(), ack)
+
+	rateLimit, found := suite.chainB.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Inflow)
+
+	// the rejected tokens are refunded to the sender
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance.SubAmount(sdkmath.NewInt(100)), balance)
+}
+
+func (suite *RateLimitingTestSuite) TestWindowReset() {
+	suite.setRateLimit(suite.chainA, suite.path.EndpointA, sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+
+	suite.sendTransfer(suite.chainA, suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// a new window starts at the beginning of the first block after the window expires, carrying
+	// over the outflow of the expired window
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	rateLimit, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.PreviousOutflow)
+	suite.Require().Equal(defaultAmount.Add(sdkmath.NewInt(100)), rateLimit.Flow.ChannelValue)
+}
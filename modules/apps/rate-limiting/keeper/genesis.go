@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+)
+
+// InitGenesis initializes the rate limiting middleware state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingPacket.PacketId, pendingPacket.SendTime)
+	}
+}
+
+// ExportGenesis returns the rate limiting middleware exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		RateLimits:         k.GetAllRateLimits(ctx),
+		PendingSendPackets: k.GetAllPendingSendPackets(ctx),
+	}
+}
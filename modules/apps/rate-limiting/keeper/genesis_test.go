@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	genesisState := types.NewGenesisState(
+		[]types.RateLimit{newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime().UTC())},
+		[]types.PendingSendPacket{
+			{
+				PacketId: channeltypes.NewPacketID(transfertypes.PortID, ibctesting.FirstChannelID, 1),
+				SendTime: ctx.BlockTime().UTC(),
+			},
+		},
+	)
+
+	rateLimitingKeeper.InitGenesis(ctx, *genesisState)
+
+	exportedGenesis := rateLimitingKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genesisState, exportedGenesis)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.RateLimitKeyPrefix))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pagination,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewPath(req.Denom, req.ChannelId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", req.Denom, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty",
+			func() {
+				expRateLimits = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				for _, channelID := range []string{"channel-0", "channel-1"} {
+					rateLimit := newRateLimit(sdkmath.NewInt(1000), suite.chainA.GetContext().BlockTime())
+					rateLimit.Path.ChannelId = channelID
+
+					suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+					expRateLimits = append(expRateLimits, rateLimit)
+				}
+			},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				for _, channelID := range []string{"channel-0", "channel-1"} {
+					rateLimit := newRateLimit(sdkmath.NewInt(1000), suite.chainA.GetContext().BlockTime())
+					rateLimit.Path.ChannelId = channelID
+
+					suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+					expRateLimits = append(expRateLimits, rateLimit)
+				}
+
+				// only the first rate limit is returned
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expRateLimits = expRateLimits[:1]
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryRateLimitsRequest{}
+			expRateLimits = nil
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimits(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req          *types.QueryRateLimitRequest
+		expRateLimit types.RateLimit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = ""
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req.ChannelId = "channel-1"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expRateLimit = newRateLimit(sdkmath.NewInt(1000), suite.chainA.GetContext().BlockTime())
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), expRateLimit)
+
+			req = &types.QueryRateLimitRequest{
+				Denom:     sdk.DefaultBondDenom,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimit(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
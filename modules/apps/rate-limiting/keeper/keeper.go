@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Keeper defines the IBC rate limiting middleware keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    porttypes.ICS4Wrapper
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+
+	// the address capable of executing a MsgUpdateRateLimit or MsgRemoveRateLimit message.
+	// Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate limiting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper, authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		ics4Wrapper:    ics4Wrapper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		authority:      authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the rate limiting middleware's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetChannelValue returns the value of the given denomination against which the quotas are enforced.
+// The total amount in escrow is used for native denominations and the total supply is used otherwise.
+func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) sdkmath.Int {
+	if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return k.transferKeeper.GetTotalEscrowForDenom(ctx, denom).Amount
+	}
+
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
+// GetRateLimit returns the rate limit of the given channel and denomination.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the given rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom), bz)
+}
+
+// DeleteRateLimit deletes the rate limit of the given channel and denomination.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// GetAllRateLimits returns all the rate limits stored in state.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over the rate limits stored in state and performs a callback function.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RateLimitKeyPrefix))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetPendingSendPacket returns the time at which the packet with the given identifier was sent,
+// if its outflow has been counted and it has not been acknowledged or timed out yet.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, packetID channeltypes.PacketId) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(packetID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	var sendTime time.Time
+	if err := sendTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+
+	return sendTime, true
+}
+
+// SetPendingSendPacket stores the time at which the packet with the given identifier was sent.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packetID channeltypes.PacketId, sendTime time.Time) {
+	bz, err := sendTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPendingSendPacket(packetID), bz)
+}
+
+// DeletePendingSendPacket deletes the pending send packet with the given identifier.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, packetID channeltypes.PacketId) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(packetID))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets stored in state.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix))
+
+	var pendingPackets []types.PendingSendPacket
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		packetID, err := types.ParseKeyPendingSendPacket(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var sendTime time.Time
+		if err := sendTime.UnmarshalBinary(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		pendingPackets = append(pendingPackets, types.PendingSendPacket{PacketId: packetID, SendTime: sendTime})
+	}
+
+	return pendingPackets
+}
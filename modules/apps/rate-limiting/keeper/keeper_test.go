@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// newRateLimit returns a rate limit for the staking denomination on the first channel with the given channel value.
+func newRateLimit(channelValue sdkmath.Int, windowStart time.Time) types.RateLimit {
+	return types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewQuota(10, 10, time.Hour),
+		types.NewFlow(channelValue, windowStart),
+	)
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	suite.Require().Panics(func() {
+		keeper.NewKeeper(
+			suite.chainA.GetSimApp().AppCodec(),
+			suite.chainA.GetSimApp().GetKey(types.StoreKey),
+			suite.chainA.GetSimApp().IBCFeeKeeper,
+			suite.chainA.GetSimApp().BankKeeper,
+			suite.chainA.GetSimApp().TransferKeeper,
+			"", // authority
+		)
+	}, "empty authority")
+}
+
+func (suite *KeeperTestSuite) TestGetChannelValue() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	// native denominations are valued by the total amount in escrow, not by their total supply
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	suite.Require().True(supply.IsPositive())
+	suite.Require().True(rateLimitingKeeper.GetChannelValue(ctx, sdk.DefaultBondDenom).IsZero())
+
+	escrow := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, escrow)
+	suite.Require().Equal(escrow.Amount, rateLimitingKeeper.GetChannelValue(ctx, sdk.DefaultBondDenom))
+
+	// vouchers are valued by their total supply
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, ibctesting.FirstChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, sdkmath.NewInt(500))
+	suite.Require().True(rateLimitingKeeper.GetChannelValue(ctx, voucherDenom).IsZero())
+
+	err := suite.chainA.GetSimApp().BankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(voucher))
+	suite.Require().NoError(err)
+	suite.Require().Equal(voucher.Amount, rateLimitingKeeper.GetChannelValue(ctx, voucherDenom))
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteRateLimit() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	rateLimit := newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime())
+
+	_, found := rateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	rateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+
+	storedRateLimit, found := rateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, storedRateLimit)
+
+	// the same denomination on another channel is not rate limited
+	_, found = rateLimitingKeeper.GetRateLimit(ctx, "channel-1", sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	rateLimitingKeeper.DeleteRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+
+	_, found = rateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAllRateLimits() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	var expRateLimits []types.RateLimit
+	for _, channelID := range []string{"channel-0", "channel-1", "channel-2"} {
+		rateLimit := newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime())
+		rateLimit.Path.ChannelId = channelID
+
+		rateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+		expRateLimits = append(expRateLimits, rateLimit)
+	}
+
+	suite.Require().Equal(expRateLimits, rateLimitingKeeper.GetAllRateLimits(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeletePendingSendPacket() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, ibctesting.FirstChannelID, 1)
+
+	_, found := rateLimitingKeeper.GetPendingSendPacket(ctx, packetID)
+	suite.Require().False(found)
+
+	rateLimitingKeeper.SetPendingSendPacket(ctx, packetID, ctx.BlockTime())
+
+	sendTime, found := rateLimitingKeeper.GetPendingSendPacket(ctx, packetID)
+	suite.Require().True(found)
+	suite.Require().True(ctx.BlockTime().Equal(sendTime))
+
+	pendingPackets := rateLimitingKeeper.GetAllPendingSendPackets(ctx)
+	suite.Require().Len(pendingPackets, 1)
+	suite.Require().Equal(packetID, pendingPackets[0].PacketId)
+
+	rateLimitingKeeper.DeletePendingSendPacket(ctx, packetID)
+
+	_, found = rateLimitingKeeper.GetPendingSendPacket(ctx, packetID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestResetExpiredWindows() {
+	ctx := suite.chainA.GetContext()
+	rateLimitingKeeper := suite.chainA.GetSimApp().RateLimitingKeeper
+
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(2000)))
+
+	// the window of the first rate limit has just expired, the window of the second expired more than
+	// a window ago and the window of the third has not expired
+	expiredRateLimit := newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime().Add(-time.Hour))
+	expiredRateLimit.Flow.Outflow = sdkmath.NewInt(100)
+	rateLimitingKeeper.SetRateLimit(ctx, expiredRateLimit)
+
+	staleRateLimit := newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime().Add(-2*time.Hour))
+	staleRateLimit.Path.ChannelId = "channel-2"
+	staleRateLimit.Flow.Outflow = sdkmath.NewInt(100)
+	rateLimitingKeeper.SetRateLimit(ctx, staleRateLimit)
+
+	activeRateLimit := newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime().Add(-time.Minute))
+	activeRateLimit.Path.ChannelId = "channel-1"
+	activeRateLimit.Flow.Outflow = sdkmath.NewInt(100)
+	rateLimitingKeeper.SetRateLimit(ctx, activeRateLimit)
+
+	rateLimitingKeeper.ResetExpiredWindows(ctx)
+
+	// the outflow of the expired window is kept as the previous outflow
+	expFlow := types.NewFlow(sdkmath.NewInt(2000), ctx.BlockTime())
+	expFlow.PreviousOutflow = sdkmath.NewInt(100)
+
+	rateLimit, found := rateLimitingKeeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expFlow, rateLimit.Flow)
+	suite.Require().Equal(expiredRateLimit.Quota, rateLimit.Quota)
+
+	rateLimit, found = rateLimitingKeeper.GetRateLimit(ctx, "channel-2", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFlow(sdkmath.NewInt(2000), ctx.BlockTime()), rateLimit.Flow)
+
+	rateLimit, found = rateLimitingKeeper.GetRateLimit(ctx, "channel-1", sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(activeRateLimit, rateLimit)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// UpdateRateLimit defines a rpc handler method for MsgUpdateRateLimit. It adds or replaces the quota
+// of the given path and starts a new window, resetting any flow previously counted.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetChannelValue(ctx, msg.Path.Denom).IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrZeroChannelValue, "denom %s", msg.Path.Denom)
+	}
+
+	rateLimit := k.resetRateLimit(ctx, msg.Path, msg.Quota, ctx.BlockTime())
+
+	k.Logger(ctx).Info("rate limit set", "denom", msg.Path.Denom, "channel-id", msg.Path.ChannelId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitSet,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a rpc handler method for MsgRemoveRateLimit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, msg.Path.ChannelId, msg.Path.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", msg.Path.Denom, msg.Path.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, msg.Path.ChannelId, msg.Path.Denom)
+
+	k.Logger(ctx).Info("rate limit removed", "denom", msg.Path.Denom, "channel-id", msg.Path.ChannelId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitRemoved,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.Path.ChannelId),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: existing rate limit is replaced",
+			func() {
+				rateLimit := newRateLimit(sdkmath.NewInt(500), suite.chainA.GetContext().BlockTime().Add(-time.Minute))
+				rateLimit.Flow.Outflow = sdkmath.NewInt(10)
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: zero channel value",
+			func() {
+				msg.Path.Denom = "atom"
+			},
+			types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+			msg = types.NewMsgUpdateRateLimit(
+				suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority(),
+				types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+				types.NewQuota(10, 20, time.Hour),
+			)
+
+			tc.malleate()
+
+			ctx = suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.UpdateRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, msg.Path.ChannelId, msg.Path.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewRateLimit(msg.Path, msg.Quota, types.NewFlow(sdkmath.NewInt(1000), ctx.BlockTime())), rateLimit)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Path.ChannelId = "channel-1"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, newRateLimit(sdkmath.NewInt(1000), ctx.BlockTime()))
+
+			msg = types.NewMsgRemoveRateLimit(
+				suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority(),
+				types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RemoveRateLimit(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, msg.Path.ChannelId, msg.Path.Denom)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// SendPacket wraps the ICS4Wrapper SendPacket function. The outflow of every token
+// in the packet is counted against the rate limit of its denomination on the source
+// channel, and the packet is rejected if any quota is exceeded.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	rateLimited, err := k.checkSendPacket(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// the send time is stored so that the outflow can be reverted if the packet fails
+	// within the same window
+	if rateLimited {
+		k.SetPendingSendPacket(ctx, channeltypes.NewPacketID(sourcePort, sourceChannel, sequence), ctx.BlockTime())
+	}
+
+	return sequence, nil
+}
+
+// SendPacketWithRelativeTimeout wraps the ICS4Wrapper SendPacketWithRelativeTimeout function. The
+// outflow of the packet is rate limited in the same way as SendPacket. An error is returned if the
+// ICS4Wrapper does not support relative timeouts.
+func (k Keeper) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout channeltypes.RelativeTimeout,
+	data []byte,
+) (uint64, channeltypes.Timeout, error) {
+	relativeTimeoutSender, ok := k.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+	if !ok {
+		return 0, channeltypes.Timeout{}, errorsmod.Wrapf(porttypes.ErrRelativeTimeoutNotSupported, "ICS4Wrapper %T does not implement RelativeTimeoutPacketSender", k.ics4Wrapper)
+	}
+
+	rateLimited, err := k.checkSendPacket(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, channeltypes.Timeout{}, err
+	}
+
+	sequence, timeout, err := relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, chanCap, sourcePort, sourceChannel, relativeTimeout, data)
+	if err != nil {
+		return 0, channeltypes.Timeout{}, err
+	}
+
+	if rateLimited {
+		k.SetPendingSendPacket(ctx, channeltypes.NewPacketID(sourcePort, sourceChannel, sequence), ctx.BlockTime())
+	}
+
+	return sequence, timeout, nil
+}
+
+// WriteAcknowledgement wraps the ICS4Wrapper WriteAcknowledgement function.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion wraps the ICS4Wrapper GetAppVersion function. The rate limiting middleware
+// has no version of its own, so the call is deferred to the underlying application.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnRecvPacket counts the inflow of every token in the received packet against the rate limit
+// of its denomination, as represented on this chain, on the destination channel. An error is
+// returned if any quota is exceeded.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	data, err := k.getPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		// packets which are not ICS-20 packets are not rate limited
+		return nil
+	}
+
+	for _, token := range data.Tokens {
+		denom := receivedDenom(packet, token.Denom)
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetDestChannel(), denom)
+		if !found {
+			continue
+		}
+
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		}
+
+		if err := rateLimit.AddInflow(amount, ctx.BlockTime()); err != nil {
+			emitQuotaExceededEvent(ctx, rateLimit, types.AttributeValueRecv, amount)
+			return err
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket reverts the outflow counted for the packet if the acknowledgement failed,
+// as the tokens are refunded to the sender.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ackSuccess bool) error {
+	if ackSuccess {
+		k.DeletePendingSendPacket(ctx, channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+		return nil
+	}
+
+	return k.undoSendPacket(ctx, packet)
+}
+
+// OnTimeoutPacket reverts the outflow counted for the packet, as the tokens are refunded to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.undoSendPacket(ctx, packet)
+}
+
+// checkSendPacket counts the outflow of every token in the packet data against the rate limit of its
+// denomination on the source channel. It returns true if any of the tokens is rate limited.
+func (k Keeper) checkSendPacket(ctx sdk.Context, sourcePort, sourceChannel string, bz []byte) (bool, error) {
+	data, err := k.getPacketData(ctx, sourcePort, sourceChannel, bz)
+	if err != nil {
+		// packets which are not ICS-20 packets are not rate limited
+		return false, nil
+	}
+
+	var rateLimited bool
+	for _, token := range data.Tokens {
+		// the denomination in the packet data is the full denomination path on this chain
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+
+		rateLimit, found := k.GetRateLimit(ctx, sourceChannel, denom)
+		if !found {
+			continue
+		}
+
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return false, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		}
+
+		if err := rateLimit.AddOutflow(amount, ctx.BlockTime()); err != nil {
+			emitQuotaExceededEvent(ctx, rateLimit, types.AttributeValueSend, amount)
+			return false, err
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+		rateLimited = true
+	}
+
+	return rateLimited, nil
+}
+
+// undoSendPacket reverts the outflow counted for the given packet. The outflow of a token is only
+// reverted if the packet was sent within the current or the previous window of its rate limit.
+func (k Keeper) undoSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	sendTime, found := k.GetPendingSendPacket(ctx, packetID)
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendPacket(ctx, packetID)
+
+	data, err := k.getPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		denom := transfertypes.ParseDenomTrace(token.Denom).IBCDenom()
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+		if !found {
+			continue
+		}
+
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		}
+
+		rateLimit.UndoOutflow(amount, sendTime)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// ResetExpiredWindows starts a new window for every rate limit whose window has expired
+// at the current block time. The flow of the expired window is kept as the flow of the
+// previous window and the channel value is updated.
+func (k Keeper) ResetExpiredWindows(ctx sdk.Context) {
+	var expired []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+			expired = append(expired, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range expired {
+		k.SetRateLimit(ctx, rateLimit.NextWindow(k.GetChannelValue(ctx, rateLimit.Path.Denom), ctx.BlockTime()))
+	}
+}
+
+// resetRateLimit stores the rate limit of the given path with a new window starting at the given time.
+func (k Keeper) resetRateLimit(ctx sdk.Context, path types.Path, quota types.Quota, windowStart time.Time) types.RateLimit {
+	flow := types.NewFlow(k.GetChannelValue(ctx, path.Denom), windowStart)
+	rateLimit := types.NewRateLimit(path, quota, flow)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return rateLimit
+}
+
+// getPacketData unmarshals the ICS-20 packet data using the version of the given channel.
+func (k Keeper) getPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, error) {
+	version, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketDataBytes(bz, version)
+}
+
+// receivedDenom returns the denomination of the received token as represented on this chain.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// emitQuotaExceededEvent emits an event for a flow which was rejected by the given rate limit.
+func emitQuotaExceededEvent(ctx sdk.Context, rateLimit types.RateLimit, direction string, amount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+}
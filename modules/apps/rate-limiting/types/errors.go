@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// rate limiting sentinel errors
+var (
+	ErrRateLimitNotFound = errorsmod.Register(ModuleName, 2, "rate limit not found")
+	ErrQuotaExceeded     = errorsmod.Register(ModuleName, 3, "quota exceeded")
+	ErrInvalidQuota      = errorsmod.Register(ModuleName, 4, "invalid quota")
+	ErrInvalidPath       = errorsmod.Register(ModuleName, 5, "invalid rate limit path")
+	ErrZeroChannelValue  = errorsmod.Register(ModuleName, 6, "channel value is zero")
+)
@@ -0,0 +1,17 @@
+package types
+
+// rate limiting events
+const (
+	EventTypeRateLimitSet     = "rate_limit_set"
+	EventTypeRateLimitRemoved = "rate_limit_removed"
+	EventTypeQuotaExceeded    = "rate_limit_quota_exceeded"
+
+	AttributeKeyDenom        = "denom"
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeyDirection    = "direction"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyChannelValue = "channel_value"
+
+	AttributeValueSend = "send"
+	AttributeValueRecv = "recv"
+)
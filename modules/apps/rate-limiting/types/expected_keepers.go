@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TransferKeeper defines the expected transfer keeper
+type TransferKeeper interface {
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+}
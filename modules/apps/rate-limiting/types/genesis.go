@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limiting middleware GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seenPaths := make(map[Path]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		if seenPaths[rateLimit.Path] {
+			return fmt.Errorf("duplicate rate limit for denom %s on channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		seenPaths[rateLimit.Path] = true
+	}
+
+	for _, pendingPacket := range gs.PendingSendPackets {
+		if err := pendingPacket.PacketId.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
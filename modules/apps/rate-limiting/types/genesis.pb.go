@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/rate_limiting/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the ibc rate limiting middleware genesis state
+type GenesisState struct {
+	// rate limits of all the paths
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// packets sent within the current window of their rate limit, whose outflow
+	// is reverted if the packet fails
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,2,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f0dbc611075e553, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
+// PendingSendPacket defines a packet sent over a rate limited path whose
+// acknowledgement or timeout has not been processed yet.
+type PendingSendPacket struct {
+	// unique packet identifier
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// block time at which the packet was sent. The outflow of the packet is only
+	// reverted if the window of the rate limit has not been reset since.
+	SendTime time.Time `protobuf:"bytes,2,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+}
+
+func (m *PendingSendPacket) Reset()         { *m = PendingSendPacket{} }
+func (m *PendingSendPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingSendPacket) ProtoMessage()    {}
+func (*PendingSendPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f0dbc611075e553, []int{1}
+}
+func (m *PendingSendPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSendPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSendPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSendPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSendPacket.Merge(m, src)
+}
+func (m *PendingSendPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSendPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSendPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSendPacket proto.InternalMessageInfo
+
+func (m *PendingSendPacket) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *PendingSendPacket) GetSendTime() time.Time {
+	if m != nil {
+		return m.SendTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.rate_limiting.v1.GenesisState")
+	proto.RegisterType((*PendingSendPacket)(nil), "ibc.applications.rate_limiting.v1.PendingSendPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/rate_limiting/v1/genesis.proto", fileDescriptor_0f0dbc611075e553)
+}
+
+var fileDescriptor_0f0dbc611075e553 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0xe3, 0x82, 0x50, 0xeb, 0xb2, 0x10, 0x75, 0x88, 0x2a, 0x91, 0x96, 0x4e, 0x1d, 0xa8,
+	0xad, 0x16, 0x06, 0x06, 0x06, 0xe8, 0x82, 0x90, 0x18, 0xaa, 0x16, 0x31, 0xb0, 0x44, 0x8e, 0x63,
+	0x5c, 0x8b, 0xc4, 0xb6, 0x62, 0xb7, 0x12, 0x6f, 0xd1, 0x91, 0x47, 0xea, 0x58, 0x36, 0x26, 0xb8,
+	0x6a, 0x5f, 0xe4, 0xca, 0x4e, 0x72, 0x7b, 0xff, 0x0c, 0xbd, 0x9b, 0x8f, 0xfd, 0xfd, 0xce, 0x77,
+	0xec, 0xcf, 0x10, 0x8b, 0x94, 0x62, 0xa2, 0x75, 0x2e, 0x28, 0xb1, 0x42, 0x49, 0x83, 0x4b, 0x62,
+	0x59, 0x92, 0x8b, 0x42, 0x58, 0x21, 0x39, 0xde, 0x4e, 0x31, 0x67, 0x92, 0x19, 0x61, 0x90, 0x2e,
+	0x95, 0x55, 0xe1, 0x2b, 0x91, 0x52, 0x74, 0x1b, 0x40, 0x77, 0x00, 0xb4, 0x9d, 0xf6, 0x7b, 0x5c,
+	0x71, 0xe5, 0xd5, 0xd8, 0xad, 0x2a, 0xb0, 0x3f, 0xe0, 0x4a, 0xf1, 0x9c, 0x61, 0x5f, 0xa5, 0x9b,
+	0x1f, 0xd8, 0x8a, 0x82, 0x19, 0x4b, 0x0a, 0x5d, 0x0b, 0x66, 0x97, 0x47, 0x39, 0x6f, 0xd4, 0x8c,
+	0x9b, 0x06, 0x53, 0x55, 0x32, 0x4c, 0xd7, 0x44, 0x4a, 0x96, 0x3b, 0x55, 0xbd, 0xac, 0x24, 0xa3,
+	0x3f, 0x00, 0x3e, 0xff, 0x54, 0x5d, 0x61, 0x65, 0x89, 0x65, 0xe1, 0x0a, 0x76, 0xcf, 0x7d, 0x4c,
+	0x04, 0x86, 0x4f, 0xc6, 0xdd, 0xd9, 0x6b, 0x74, 0xf1, 0x5e, 0x68, 0x49, 0x2c, 0xfb, 0xe2, 0xea,
+	0xf9, 0xd3, 0xfd, 0xbf, 0x41, 0xb0, 0x84, 0x65, 0xb3, 0x61, 0xc2, 0x1c, 0xf6, 0x34, 0x93, 0x99,
+	0x90, 0x3c, 0x31, 0x4c, 0x66, 0x89, 0x26, 0xf4, 0x27, 0xb3, 0x26, 0x6a, 0xf9, 0xee, 0x6f, 0x1f,
+	0xd1, 0x7d, 0x51, 0xe1, 0x2b, 0x26, 0xb3, 0x85, 0x87, 0x6b, 0x97, 0x50, 0xdf, 0x3f, 0x30, 0xa3,
+	0xdf, 0x00, 0xbe, 0x78, 0xa0, 0x0f, 0x3f, 0xc0, 0x4e, 0x65, 0x9b, 0x88, 0x2c, 0x02, 0x43, 0x30,
+	0xee, 0xce, 0x5e, 0x7a, 0x63, 0xf7, 0x40, 0xa8, 0x79, 0x15, 0x67, 0xe5, 0x55, 0x9f, 0xb3, 0xda,
+	0xa1, 0xad, 0xeb, 0x3a, 0xfc, 0x08, 0x3b, 0x7e, 0x7a, 0x17, 0x4d, 0xd4, 0xf2, 0x1d, 0xfa, 0xa8,
+	0xca, 0x0d, 0x35, 0xb9, 0xa1, 0xaf, 0x4d, 0x6e, 0xf3, 0xb6, 0xc3, 0x77, 0xff, 0x07, 0x60, 0xd9,
+	0x76, 0x98, 0x3b, 0x98, 0x7f, 0xdb, 0x1f, 0x63, 0x70, 0x38, 0xc6, 0xe0, 0xea, 0x18, 0x83, 0xdd,
+	0x29, 0x0e, 0x0e, 0xa7, 0x38, 0xf8, 0x7b, 0x8a, 0x83, 0xef, 0xef, 0xb9, 0xb0, 0xeb, 0x4d, 0x8a,
+	0xa8, 0x2a, 0x30, 0x55, 0xa6, 0x50, 0xc6, 0x7d, 0xbe, 0x09, 0x57, 0x78, 0xfb, 0x0e, 0x17, 0x2a,
+	0xdb, 0xe4, 0xcc, 0xb8, 0xfc, 0xab, 0xdc, 0x27, 0x37, 0xb9, 0xdb, 0x5f, 0x9a, 0x99, 0xf4, 0x99,
+	0xf7, 0x7f, 0x73, 0x3d, 0x00, 0x8b, 0xca, 0xab, 0x53, 0xb1, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSendPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSendPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSendPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingSendPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSendPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSendPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSendPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - valid genesis",
+			func() {},
+			true,
+		},
+		{
+			"success - same denom on different channels",
+			func() {
+				rateLimit := genState.RateLimits[0]
+				rateLimit.Path.ChannelId = "channel-1"
+				genState.RateLimits = append(genState.RateLimits, rateLimit)
+			},
+			true,
+		},
+		{
+			"invalid rate limit",
+			func() {
+				genState.RateLimits[0].Quota.Duration = 0
+			},
+			false,
+		},
+		{
+			"duplicate rate limit",
+			func() {
+				genState.RateLimits = append(genState.RateLimits, genState.RateLimits[0])
+			},
+			false,
+		},
+		{
+			"invalid pending send packet ID",
+			func() {
+				genState.PendingSendPackets[0].PacketId = channeltypes.NewPacketID(transfertypes.PortID, ibctesting.FirstChannelID, 0)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		genState = types.NewGenesisState(
+			[]types.RateLimit{
+				types.NewRateLimit(
+					types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+					types.NewQuota(10, 10, time.Hour),
+					types.NewFlow(sdkmath.NewInt(1000), time.Now()),
+				),
+			},
+			[]types.PendingSendPacket{
+				{PacketId: channeltypes.NewPacketID(transfertypes.PortID, ibctesting.FirstChannelID, 1), SendTime: time.Now()},
+			},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
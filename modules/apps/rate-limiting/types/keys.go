@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for the rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the packets sent within the current window of their rate limit
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of the given channel and denomination is stored.
+// The channel identifier precedes the denomination as the latter may contain slashes.
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyPendingSendPacket returns the key under which the pending send packet with the given identifier is stored.
+func KeyPendingSendPacket(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingSendPacketKeyPrefix, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
+
+// ParseKeyPendingSendPacket parses the key used to store a pending send packet and returns the packet id
+func ParseKeyPendingSendPacket(key string) (channeltypes.PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 {
+		return channeltypes.PacketId{}, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	seq, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	return channeltypes.NewPacketID(keySplit[1], keySplit[2], seq), nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgUpdateRateLimit)(nil)
+	_ sdk.Msg              = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+)
+
+// NewMsgUpdateRateLimit creates a new MsgUpdateRateLimit instance
+func NewMsgUpdateRateLimit(signer string, path Path, quota Quota) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Signer: signer,
+		Path:   path,
+		Quota:  quota,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Path.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer string, path Path) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer: signer,
+		Path:   path,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Path.Validate()
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func TestMsgUpdateRateLimitValidation(t *testing.T) {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid path",
+			func() {
+				msg.Path.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid quota",
+			func() {
+				msg.Quota.MaxPercentSend = types.MaxPercent + 1
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgUpdateRateLimit(authority, types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID), types.NewQuota(10, 10, time.Hour))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveRateLimitValidation(t *testing.T) {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid path",
+			func() {
+				msg.Path.Denom = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgRemoveRateLimit(authority, types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxPercent is the maximum percentage of the channel value which may be set in a quota
+const MaxPercent = 100
+
+// NewPath creates a new Path instance
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the Path fields
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPath, "invalid denomination: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPath, "invalid channel ID: %s", err)
+	}
+
+	return nil
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv uint64, duration time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// Validate performs a basic validation of the Quota fields
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > MaxPercent || q.MaxPercentRecv > MaxPercent {
+		return errorsmod.Wrapf(ErrInvalidQuota, "percentages must not exceed %d", MaxPercent)
+	}
+
+	if q.Duration <= 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "duration must be positive")
+	}
+
+	return nil
+}
+
+// NewFlow creates a new Flow instance with no inflow and outflow for a window starting at the given time
+func NewFlow(channelValue sdkmath.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:          sdkmath.ZeroInt(),
+		Outflow:         sdkmath.ZeroInt(),
+		ChannelValue:    channelValue,
+		WindowStart:     windowStart,
+		PreviousInflow:  sdkmath.ZeroInt(),
+		PreviousOutflow: sdkmath.ZeroInt(),
+	}
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(path Path, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  flow,
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	if rl.Flow.Inflow.IsNil() || rl.Flow.Inflow.IsNegative() ||
+		rl.Flow.Outflow.IsNil() || rl.Flow.Outflow.IsNegative() ||
+		rl.Flow.ChannelValue.IsNil() || rl.Flow.ChannelValue.IsNegative() ||
+		rl.Flow.PreviousInflow.IsNil() || rl.Flow.PreviousInflow.IsNegative() ||
+		rl.Flow.PreviousOutflow.IsNil() || rl.Flow.PreviousOutflow.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidQuota, "flow amounts must not be negative")
+	}
+
+	return nil
+}
+
+// IsWindowExpired returns true if the current window of the rate limit has ended at the given time.
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.Flow.WindowStart.Add(rl.Quota.Duration))
+}
+
+// NextWindow returns the rate limit with a new window starting once the current window has expired
+// at the given block time. The flow of the current window becomes the flow of the previous window,
+// unless no block was processed within the following window, in which case the new window starts
+// at the block time without any previous flow.
+func (rl RateLimit) NextWindow(channelValue sdkmath.Int, blockTime time.Time) RateLimit {
+	windowEnd := rl.Flow.WindowStart.Add(rl.Quota.Duration)
+	if !blockTime.Before(windowEnd.Add(rl.Quota.Duration)) {
+		return NewRateLimit(rl.Path, rl.Quota, NewFlow(channelValue, blockTime))
+	}
+
+	flow := NewFlow(channelValue, windowEnd)
+	flow.PreviousInflow = rl.Flow.Inflow
+	flow.PreviousOutflow = rl.Flow.Outflow
+
+	return NewRateLimit(rl.Path, rl.Quota, flow)
+}
+
+// AddOutflow adds the given amount to the outflow of the rate limit. An error is returned
+// if the resulting net outflow over the rolling window ending at the given block time
+// exceeds the send quota.
+func (rl *RateLimit) AddOutflow(amount sdkmath.Int, blockTime time.Time) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+	netOutflow := rl.netFlow(outflow.Sub(rl.Flow.Inflow), rl.Flow.PreviousOutflow.Sub(rl.Flow.PreviousInflow), blockTime)
+
+	if netOutflow.GT(threshold(rl.Flow.ChannelValue, rl.Quota.MaxPercentSend)) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "net outflow %s exceeds %d%% of channel value %s for denom %s on channel %s",
+			netOutflow, rl.Quota.MaxPercentSend, rl.Flow.ChannelValue, rl.Path.Denom, rl.Path.ChannelId)
+	}
+
+	rl.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the given amount to the inflow of the rate limit. An error is returned
+// if the resulting net inflow over the rolling window ending at the given block time
+// exceeds the receive quota.
+func (rl *RateLimit) AddInflow(amount sdkmath.Int, blockTime time.Time) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+	netInflow := rl.netFlow(inflow.Sub(rl.Flow.Outflow), rl.Flow.PreviousInflow.Sub(rl.Flow.PreviousOutflow), blockTime)
+
+	if netInflow.GT(threshold(rl.Flow.ChannelValue, rl.Quota.MaxPercentRecv)) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "net inflow %s exceeds %d%% of channel value %s for denom %s on channel %s",
+			netInflow, rl.Quota.MaxPercentRecv, rl.Flow.ChannelValue, rl.Path.Denom, rl.Path.ChannelId)
+	}
+
+	rl.Flow.Inflow = inflow
+	return nil
+}
+
+// UndoOutflow removes the given amount from the outflow of the window within which the packet
+// was sent at the given time. It is used when a sent packet fails and the tokens are refunded
+// to the sender. Nothing is removed if the packet was sent before the previous window.
+func (rl *RateLimit) UndoOutflow(amount sdkmath.Int, sendTime time.Time) {
+	switch {
+	case !sendTime.Before(rl.Flow.WindowStart):
+		rl.Flow.Outflow = subFloorZero(rl.Flow.Outflow, amount)
+	case !sendTime.Before(rl.Flow.WindowStart.Add(-rl.Quota.Duration)):
+		rl.Flow.PreviousOutflow = subFloorZero(rl.Flow.PreviousOutflow, amount)
+	}
+}
+
+// netFlow returns the net flow over the rolling window ending at the given block time. The net
+// flow of the previous window is weighted by the fraction of the rolling window which it overlaps.
+func (rl RateLimit) netFlow(current, previous sdkmath.Int, blockTime time.Time) sdkmath.Int {
+	elapsed := blockTime.Sub(rl.Flow.WindowStart)
+	if elapsed >= rl.Quota.Duration {
+		return current
+	}
+
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	weighted := sdkmath.LegacyNewDecFromInt(previous).MulInt64(int64(rl.Quota.Duration - elapsed)).QuoInt64(int64(rl.Quota.Duration))
+	return current.Add(weighted.TruncateInt())
+}
+
+// subFloorZero subtracts b from a, returning zero if b is greater than a
+func subFloorZero(a, b sdkmath.Int) sdkmath.Int {
+	if b.GT(a) {
+		return sdkmath.ZeroInt()
+	}
+
+	return a.Sub(b)
+}
+
+// threshold returns the given percentage of the channel value
+func threshold(channelValue sdkmath.Int, percent uint64) sdkmath.Int {
+	return channelValue.Mul(sdkmath.NewIntFromUint64(percent)).Quo(sdkmath.NewInt(MaxPercent))
+}
@@ -0,0 +1,203 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	var rateLimit types.RateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: zero percentages",
+			func() {
+				rateLimit.Quota.MaxPercentSend = 0
+				rateLimit.Quota.MaxPercentRecv = 0
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				rateLimit.Path.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				rateLimit.Path.ChannelId = "channel"
+			},
+			false,
+		},
+		{
+			"send percentage exceeds maximum",
+			func() {
+				rateLimit.Quota.MaxPercentSend = types.MaxPercent + 1
+			},
+			false,
+		},
+		{
+			"recv percentage exceeds maximum",
+			func() {
+				rateLimit.Quota.MaxPercentRecv = types.MaxPercent + 1
+			},
+			false,
+		},
+		{
+			"zero duration",
+			func() {
+				rateLimit.Quota.Duration = 0
+			},
+			false,
+		},
+		{
+			"negative outflow",
+			func() {
+				rateLimit.Flow.Outflow = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"nil inflow",
+			func() {
+				rateLimit.Flow.Inflow = sdkmath.Int{}
+			},
+			false,
+		},
+		{
+			"negative previous outflow",
+			func() {
+				rateLimit.Flow.PreviousOutflow = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"nil previous inflow",
+			func() {
+				rateLimit.Flow.PreviousInflow = sdkmath.Int{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		rateLimit = types.NewRateLimit(
+			types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+			types.NewQuota(10, 20, time.Hour),
+			types.NewFlow(sdkmath.NewInt(1000), time.Now()),
+		)
+
+		tc.malleate()
+
+		err := rateLimit.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRateLimitFlows(t *testing.T) {
+	now := time.Now()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewQuota(10, 20, time.Hour),
+		types.NewFlow(sdkmath.NewInt(1000), now),
+	)
+
+	// send quota is 100
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(60), now))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(40), now))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1), now), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// inflow is netted against the outflow, recv quota is 200
+	require.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(300), now))
+	require.ErrorIs(t, rateLimit.AddInflow(sdkmath.NewInt(1), now), types.ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(300), rateLimit.Flow.Inflow)
+
+	// the net inflow allows further outflow
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(300), now))
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(100), now)
+	require.Equal(t, sdkmath.NewInt(300), rateLimit.Flow.Outflow)
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(1000), now)
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+}
+
+func TestRateLimitRollingWindow(t *testing.T) {
+	windowStart := time.Now()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewQuota(10, 10, time.Hour),
+		types.NewFlow(sdkmath.NewInt(1000), windowStart),
+	)
+
+	// the whole send quota is used at the end of the window
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(100), windowStart.Add(59*time.Minute)))
+
+	// the next window starts at the end of the current window, and the outflow is carried over
+	rateLimit = rateLimit.NextWindow(sdkmath.NewInt(1000), windowStart.Add(time.Hour+time.Minute))
+	require.Equal(t, windowStart.Add(time.Hour), rateLimit.Flow.WindowStart)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.PreviousOutflow)
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	// a quarter of the rolling window has elapsed, so 75% of the previous outflow is counted
+	quarter := windowStart.Add(time.Hour + 15*time.Minute)
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(25), quarter))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1), quarter), types.ErrQuotaExceeded)
+
+	// the previous outflow is no longer counted once the rolling window has passed it
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(75), windowStart.Add(2*time.Hour-time.Nanosecond)))
+
+	// outflow sent within the previous window is undone from the previous outflow
+	rateLimit.UndoOutflow(sdkmath.NewInt(40), windowStart.Add(30*time.Minute))
+	require.Equal(t, sdkmath.NewInt(60), rateLimit.Flow.PreviousOutflow)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// outflow sent before the previous window is not undone
+	rateLimit.UndoOutflow(sdkmath.NewInt(40), windowStart.Add(-time.Minute))
+	require.Equal(t, sdkmath.NewInt(60), rateLimit.Flow.PreviousOutflow)
+
+	// the previous flow is discarded if no block was processed within the following window
+	rateLimit = rateLimit.NextWindow(sdkmath.NewInt(1000), windowStart.Add(4*time.Hour))
+	require.Equal(t, types.NewFlow(sdkmath.NewInt(1000), windowStart.Add(4*time.Hour)), rateLimit.Flow)
+}
+
+func TestIsWindowExpired(t *testing.T) {
+	windowStart := time.Now()
+	rateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, ibctesting.FirstChannelID),
+		types.NewQuota(10, 20, time.Hour),
+		types.NewFlow(sdkmath.NewInt(1000), windowStart),
+	)
+
+	require.False(t, rateLimit.IsWindowExpired(windowStart))
+	require.False(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour-time.Nanosecond)))
+	require.True(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour)))
+}
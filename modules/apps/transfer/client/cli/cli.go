@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryTransferStatuses(),
 	)
 
 	return queryCmd
@@ -201,3 +201,32 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTransferStatuses defines the command to query the transfer statuses of channels and denominations.
+func GetCmdQueryTransferStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-statuses",
+		Short:   "Query the transfer statuses of channels and denominations",
+		Long:    "Query the channels and denominations for which sending or receiving tokens is disabled",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-statuses", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferStatuses(cmd.Context(), &types.QueryTransferStatusesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,10 +1,8 @@
 package cli
 
 import (
-	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,10 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	clientutils "github.com/cosmos/ibc-go/v8/modules/core/02-client/client/utils"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	channelutils "github.com/cosmos/ibc-go/v8/modules/core/04-channel/client/utils"
-	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 const (
@@ -26,20 +22,23 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagForwarding             = "forwarding"
+	flagForwardingTimeout      = "forwarding-timeout"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
-		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified
+		Use:   "transfer [src-port] [src-channel] [receiver] [coins]",
+		Short: "Transfer fungible token(s) through IBC",
+		Long: strings.TrimSpace(`Transfer fungible token(s) through IBC. Multiple tokens can be transferred
+in a single packet on ics20-2 channels by passing a comma separated list of coins. Timeouts can be specified
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
-in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
-height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
-is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
-		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
+in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeouts are resolved by the chain when
+the packet is sent: the relative timeout height is added to the latest height of the counterparty client and the relative
+timeout timestamp is added to the greater value of the block time and the latest consensus timestamp of the counterparty
+client. Any timeout set to 0 is disabled.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [coins]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -51,14 +50,16 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					denomTrace := types.ParseDenomTrace(coin.Denom)
+					coins[i].Denom = denomTrace.IBCDenom()
+				}
 			}
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
@@ -85,71 +86,49 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
-			// if the timeouts are not absolute, retrieve latest block height and block timestamp
-			// for the consensus state connected to the destination port/channel.
-			// localhost clients must rely solely on local clock time in order to use relative timestamps.
-			if !absoluteTimeouts {
-				clientRes, err := channelutils.QueryChannelClientState(clientCtx, srcPort, srcChannel, false)
-				if err != nil {
-					return err
-				}
-
-				var clientState exported.ClientState
-				if err := clientCtx.InterfaceRegistry.UnpackAny(clientRes.IdentifiedClientState.ClientState, &clientState); err != nil {
-					return err
-				}
+			forwardingHops, err := cmd.Flags().GetStringSlice(flagForwarding)
+			if err != nil {
+				return err
+			}
 
-				clientHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
-				if !ok {
-					return fmt.Errorf("invalid height type. expected type: %T, got: %T", clienttypes.Height{}, clientState.GetLatestHeight())
-				}
+			forwarding, err := parseForwarding(forwardingHops)
+			if err != nil {
+				return err
+			}
 
-				var consensusState exported.ConsensusState
-				if clientState.ClientType() != exported.Localhost {
-					consensusStateRes, err := clientutils.QueryConsensusState(clientCtx, clientRes.IdentifiedClientState.ClientId, clientHeight, false, true)
-					if err != nil {
-						return err
-					}
+			forwarding.RelativeTimeout, err = cmd.Flags().GetUint64(flagForwardingTimeout)
+			if err != nil {
+				return err
+			}
 
-					if err := clientCtx.InterfaceRegistry.UnpackAny(consensusStateRes.ConsensusState, &consensusState); err != nil {
-						return err
-					}
+			// relative timeouts are resolved by core IBC when the packet is sent, the relative timeout height
+			// is added to the latest height of the counterparty client and the relative timeout timestamp is
+			// added to the later of the block time and the latest consensus timestamp of the counterparty client
+			var relativeTimeout channeltypes.RelativeTimeout
+			if !absoluteTimeouts {
+				if timeoutHeight.RevisionNumber != 0 {
+					return fmt.Errorf("relative timeout height cannot change the revision number, got %s", timeoutHeight)
 				}
 
-				if !timeoutHeight.IsZero() {
-					absoluteHeight := clientHeight
-					absoluteHeight.RevisionNumber += timeoutHeight.RevisionNumber
-					absoluteHeight.RevisionHeight += timeoutHeight.RevisionHeight
-					timeoutHeight = absoluteHeight
-				}
+				relativeTimeout = channeltypes.NewRelativeTimeout(timeoutHeight.RevisionHeight, timeoutTimestamp)
+				timeoutHeight, timeoutTimestamp = clienttypes.ZeroHeight(), 0
+			}
 
-				// use local clock time as reference time if it is later than the
-				// consensus state timestamp of the counterparty chain, otherwise
-				// still use consensus state timestamp as reference.
-				// for localhost clients local clock time is always used.
-				if timeoutTimestamp != 0 {
-					var consensusStateTimestamp uint64
-					if consensusState != nil {
-						consensusStateTimestamp = consensusState.GetTimestamp()
-					}
-
-					now := time.Now().UnixNano()
-					if now > 0 {
-						now := uint64(now)
-						if now > consensusStateTimestamp {
-							timeoutTimestamp = now + timeoutTimestamp
-						} else {
-							timeoutTimestamp = consensusStateTimestamp + timeoutTimestamp
-						}
-					} else {
-						return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
-					}
-				}
+			var msg *types.MsgTransfer
+			if len(coins) == 1 {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			} else {
+				msg = types.NewMsgTransferWithTokens(
+					srcPort, srcChannel, coins.Sort(), sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
-			)
+			msg.Forwarding = forwarding
+			msg.RelativeTimeoutHeight = relativeTimeout.RevisionHeight
+			msg.RelativeTimeoutTimestamp = relativeTimeout.Timestamp
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -158,7 +137,25 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().StringSlice(flagForwarding, []string{}, "Forwarding hops the tokens are sent through after reaching the destination chain, in the form {port-id}/{channel-id}. Only supported on ics20-2 channels.")
+	cmd.Flags().Uint64(flagForwardingTimeout, 0, "Timeout in nanoseconds, relative to the block time of each intermediate chain, of the packets sent to the next forwarding hop. The default forwarding timeout is used when set to 0.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseForwarding parses the given hops, each in the form {port-id}/{channel-id},
+// into the forwarding information of a transfer.
+func parseForwarding(hops []string) (types.Forwarding, error) {
+	forwarding := types.NewForwarding()
+	for _, hop := range hops {
+		portID, channelID, found := strings.Cut(hop, "/")
+		if !found {
+			return types.Forwarding{}, fmt.Errorf("expected forwarding hop to be in the form {port-id}/{channel-id}, got %s", hop)
+		}
+
+		forwarding.Hops = append(forwarding.Hops, types.NewHop(portID, channelID))
+	}
+
+	return forwarding, nil
+}
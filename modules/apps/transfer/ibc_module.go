@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -38,8 +39,8 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
-// supported version. Only 2^32 channels are allowed to be created.
+// channel must be UNORDERED and use the correct port (by default 'transfer'). Only 2^32 channels
+// are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx sdk.Context,
 	transferkeeper keeper.Keeper,
@@ -88,8 +89,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -115,8 +116,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -124,7 +125,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -135,8 +136,8 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 	return nil
 }
@@ -180,9 +181,9 @@ func (im IBCModule) OnRecvPacket(
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
 	var ackErr error
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, ics20Version, err := im.getICS20PacketData(ctx, packet.GetData(), packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
 		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
@@ -205,11 +206,12 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+	}
+	eventAttributes = append(eventAttributes, tokenEventAttributes(ics20Version, data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens)...)
+	eventAttributes = append(eventAttributes,
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
-	}
+	)
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -222,6 +224,12 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	// the acknowledgement of a forwarded packet is written asynchronously once
+	// the packet sent to the next hop is acknowledged or timed out
+	if ack.Success() && data.HasForwarding() {
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -237,8 +245,8 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, ics20Version, err := im.getICS20PacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
@@ -246,16 +254,21 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokenEventAttributes(ics20Version, data.Tokens, types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -285,8 +298,8 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, ics20Version, err := im.getICS20PacketData(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
@@ -294,14 +307,17 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
+	}
+	eventAttributes = append(eventAttributes, tokenEventAttributes(ics20Version, data.Tokens, types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount, types.AttributeKeyRefundTokens)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			eventAttributes...,
 		),
 	)
 
@@ -314,8 +330,8 @@ func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	if !types.IsSupportedVersion(proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -327,8 +343,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
@@ -336,8 +352,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return nil
@@ -351,13 +367,65 @@ func (IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, pr
 func (IBCModule) OnChanUpgradeRestore(ctx sdk.Context, portID, channelID string) {}
 
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes
-// into a FungibleTokenPacketData. This function implements the optional
-// PacketDataUnmarshaler interface required for ADR 008 support.
+// into a FungibleTokenPacketData. If the bytes cannot be decoded as ics20-1
+// packet data, they are decoded into a FungibleTokenPacketDataV2. This function
+// implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
 func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	var packetData types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetData); err == nil {
+		return packetData, nil
+	}
+
+	var packetDataV2 types.FungibleTokenPacketDataV2
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &packetDataV2); err != nil {
 		return nil, err
 	}
 
-	return packetData, nil
+	return packetDataV2, nil
+}
+
+// getICS20PacketData unmarshals the packet data bytes using the encoding of the ics20 version
+// negotiated on the provided channel end. The negotiated ics20 version is returned alongside the packet data.
+func (im IBCModule) getICS20PacketData(ctx sdk.Context, packetData []byte, portID, channelID string) (types.FungibleTokenPacketDataV2, string, error) {
+	ics20Version, found := im.keeper.GetICS4Wrapper().GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return types.FungibleTokenPacketDataV2{}, "", errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", portID, channelID)
+	}
+
+	data, err := types.UnmarshalPacketDataBytes(packetData, ics20Version)
+	if err != nil {
+		return types.FungibleTokenPacketDataV2{}, ics20Version, err
+	}
+
+	return data, ics20Version, nil
+}
+
+// tokenEventAttributes returns the event attributes describing the tokens of a packet. The denom and
+// amount attributes are kept for ics20-1 packets, which carry a single token, while the tokens are
+// serialised into the tokens attribute for ics20-2 packets.
+func tokenEventAttributes(ics20Version string, tokens []types.Token, denomKey, amountKey, tokensKey string) []sdk.Attribute {
+	if ics20Version != types.V2 {
+		var denom, amount string
+		if len(tokens) == 1 {
+			denom, amount = tokens[0].Denom, tokens[0].Amount
+		}
+
+		return []sdk.Attribute{
+			sdk.NewAttribute(denomKey, denom),
+			sdk.NewAttribute(amountKey, amount),
+		}
+	}
+
+	return []sdk.Attribute{sdk.NewAttribute(tokensKey, mustMarshalJSON(tokens))}
+}
+
+// mustMarshalJSON marshals the provided value to JSON, panicking on failure.
+// It is used to serialise token lists into event attributes.
+func mustMarshalJSON(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
 }
@@ -44,6 +44,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: ics20-2 version", func() {
+				channel.Version = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -102,8 +107,13 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			)
 
 			if tc.expPass {
+				expVersion := channel.Version
+				if expVersion == "" {
+					expVersion = types.Version
+				}
+
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -264,6 +274,14 @@ func (suite *TransferTestSuite) TestOnChanUpgradeInit() {
 			func() {}, // successful happy path for a standalone transfer app is swapping out the underlying connection
 			nil,
 		},
+		{
+			"success: upgrade to ics20-2",
+			func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+			},
+			nil,
+		},
 		{
 			"invalid upgrade connection",
 			func() {
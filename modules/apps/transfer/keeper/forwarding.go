@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// defaultForwardPacketTimeout is the timeout, relative to the current block time, used
+// for packets sent to the next hop when the forwarding information does not specify one.
+// The timeout of the received packet cannot be reused as it refers to the clock of the
+// previous chain.
+const defaultForwardPacketTimeout = 12 * time.Hour
+
+// forwardPacket sends the coins received in the given packet to the next hop contained
+// in the packet forwarding information. The received packet is stored so that its
+// acknowledgement can be written once the forwarded packet is acknowledged or timed out.
+// The forwarded coins are subject to the same send checks as a MsgTransfer sent by the
+// forward address.
+func (k Keeper) forwardPacket(ctx sdk.Context, data types.FungibleTokenPacketDataV2, packet channeltypes.Packet, receivedCoins sdk.Coins) error {
+	forwardAddress := types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	if err := k.validateSend(ctx, forwardAddress, receivedCoins); err != nil {
+		return err
+	}
+
+	nextHop := data.Forwarding.Hops[0]
+	forwarding := types.NewForwarding(data.Forwarding.Hops[1:]...)
+	forwarding.RelativeTimeout = data.Forwarding.RelativeTimeout
+
+	relativeTimeout := defaultForwardPacketTimeout
+	if data.Forwarding.RelativeTimeout != 0 {
+		relativeTimeout = time.Duration(data.Forwarding.RelativeTimeout)
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(relativeTimeout).UnixNano())
+
+	sequence, err := k.sendTransfer(
+		ctx, nextHop.PortId, nextHop.ChannelId, receivedCoins, forwardAddress, data.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, channeltypes.RelativeTimeout{}, data.Forwarding.DestinationMemo, forwarding,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to forward packet to port ID (%s) channel ID (%s)", nextHop.PortId, nextHop.ChannelId)
+	}
+
+	k.SetForwardedPacket(ctx, nextHop.PortId, nextHop.ChannelId, sequence, packet)
+
+	k.Logger(ctx).Info("forwarded ICS-20 packet", "sequence", packet.Sequence, "next-port-id", nextHop.PortId, "next-channel-id", nextHop.ChannelId, "next-sequence", sequence)
+
+	return nil
+}
+
+// onForwardedPacketSuccess writes the successful acknowledgement of the forwarded
+// packet as the acknowledgement of the previously received packet.
+func (k Keeper) onForwardedPacketSuccess(ctx sdk.Context, packet, prevPacket channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	k.deleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return k.writeForwardedPacketAck(ctx, prevPacket, ack)
+}
+
+// onForwardedPacketFailure reverts the receipt of the previously received packet
+// and writes an error acknowledgement for it, so that the tokens are refunded
+// along the path back to the original sender. The tokens of the forwarded packet
+// must have already been refunded to the forward address.
+func (k Keeper) onForwardedPacketFailure(ctx sdk.Context, packet, prevPacket channeltypes.Packet, failure error) error {
+	k.deleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if err := k.revertForwardedPacket(ctx, prevPacket); err != nil {
+		return err
+	}
+
+	ackErr := errorsmod.Wrapf(failure, "port ID (%s) channel ID (%s) sequence (%d)", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	return k.writeForwardedPacketAck(ctx, prevPacket, channeltypes.NewErrorAcknowledgement(ackErr))
+}
+
+// revertForwardedPacket reverts the receipt of the tokens of the previously received packet
+// which are held by the forward address. Tokens which were unescrowed upon receipt are escrowed
+// again and vouchers which were minted upon receipt are burned.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, prevPacket channeltypes.Packet) error {
+	// packets may only be forwarded on ics20-2 channels
+	data, err := types.UnmarshalPacketDataBytes(prevPacket.GetData(), types.V2)
+	if err != nil {
+		return err
+	}
+
+	forwardAddress := types.GetForwardAddress(prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+	escrowAddress := types.GetEscrowAddress(prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+
+	for _, token := range data.Tokens {
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		}
+
+		if types.ReceiverChainIsSource(prevPacket.GetSourcePort(), prevPacket.GetSourceChannel(), token.Denom) {
+			// the tokens were unescrowed upon receipt, escrow them again
+			voucherPrefix := types.GetDenomPrefix(prevPacket.GetSourcePort(), prevPacket.GetSourceChannel())
+			denomTrace := types.ParseDenomTrace(token.Denom[len(voucherPrefix):])
+			coin := sdk.NewCoin(denomTrace.IBCDenom(), transferAmount)
+
+			if err := k.escrowToken(ctx, forwardAddress, escrowAddress, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// vouchers were minted upon receipt, burn them
+		prefixedDenom := types.GetPrefixedDenom(prevPacket.GetDestPort(), prevPacket.GetDestChannel(), token.Denom)
+		voucher := sdk.NewCoin(types.ParseDenomTrace(prefixedDenom).IBCDenom(), transferAmount)
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, types.ModuleName, sdk.NewCoins(voucher)); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(voucher)); err != nil {
+			// NOTE: should not happen as the module account was
+			// retrieved on the step above and it has enough balance
+			// to burn.
+			panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+		}
+	}
+
+	return nil
+}
+
+// writeForwardedPacketAck writes the given acknowledgement for the previously received packet.
+func (k Keeper) writeForwardedPacketAck(ctx sdk.Context, prevPacket channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(prevPacket.GetDestPort(), prevPacket.GetDestChannel()))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, prevPacket, ack)
+}
@@ -35,14 +35,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, forwardedPacket := range state.ForwardedPackets {
+		forwardKey := forwardedPacket.ForwardKey
+		k.SetForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardedPacket.Packet)
+	}
+
+	for _, status := range state.ChannelStatuses {
+		k.SetChannelTransferStatus(ctx, status)
+	}
+
+	for _, status := range state.DenomStatuses {
+		k.SetDenomTransferStatus(ctx, status)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:           k.GetPort(ctx),
+		DenomTraces:      k.GetAllDenomTraces(ctx),
+		Params:           k.GetParams(ctx),
+		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets: k.GetAllForwardedPackets(ctx),
+		ChannelStatuses:  k.GetAllChannelTransferStatuses(ctx),
+		DenomStatuses:    k.GetAllDenomTransferStatuses(ctx),
 	}
 }
@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -45,11 +48,26 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denom, amount))
 	}
 
+	forwardedPacket := types.ForwardedPacket{
+		ForwardKey: channeltypes.NewPacketID(types.PortID, "channel-1", 1),
+		Packet:     channeltypes.NewPacket(ibctesting.MockPacketData, 1, types.PortID, "channel-0", types.PortID, "channel-2", clienttypes.NewHeight(1, 100), 0),
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), forwardedPacket.ForwardKey.PortId, forwardedPacket.ForwardKey.ChannelId, forwardedPacket.ForwardKey.Sequence, forwardedPacket.Packet)
+
+	channelStatus := types.NewChannelTransferStatus("channel-1", false, true)
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainA.GetContext(), channelStatus)
+
+	denomStatus := types.NewDenomTransferStatus("uatom", true, false)
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainA.GetContext(), denomStatus)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denomTraces.Sort(), genesis.DenomTraces)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.ForwardedPacket{forwardedPacket}, genesis.ForwardedPackets)
+	suite.Require().Equal([]types.ChannelTransferStatus{channelStatus}, genesis.ChannelStatuses)
+	suite.Require().Equal([]types.DenomTransferStatus{denomStatus}, genesis.DenomStatuses)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -142,3 +142,17 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// TransferStatuses implements the TransferStatuses gRPC method.
+func (k Keeper) TransferStatuses(c context.Context, req *types.QueryTransferStatusesRequest) (*types.QueryTransferStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTransferStatusesResponse{
+		ChannelStatuses: k.GetAllChannelTransferStatuses(ctx),
+		DenomStatuses:   k.GetAllDenomTransferStatuses(ctx),
+	}, nil
+}
@@ -364,3 +364,23 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryTransferStatuses() {
+	ctx := suite.chainA.GetContext()
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.TransferStatuses(ctx, &types.QueryTransferStatusesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ChannelStatuses)
+	suite.Require().Empty(res.DenomStatuses)
+
+	channelStatus := types.NewChannelTransferStatus(ibctesting.FirstChannelID, false, true)
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(ctx, channelStatus)
+
+	denomStatus := types.NewDenomTransferStatus(sdk.DefaultBondDenom, true, false)
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(ctx, denomStatus)
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.TransferStatuses(ctx, &types.QueryTransferStatusesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ChannelTransferStatus{channelStatus}, res.ChannelStatuses)
+	suite.Require().Equal([]types.DenomTransferStatus{denomStatus}, res.DenomStatuses)
+}
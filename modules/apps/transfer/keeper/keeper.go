@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/log"
@@ -18,6 +19,7 @@ import (
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -84,6 +86,11 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
 // GetAuthority returns the transfer module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -287,6 +294,173 @@ func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, storeprefix []byte, cb fu
 	}
 }
 
+// SetForwardedPacket stores the packet which has been received and forwarded to the next hop,
+// keyed by the port ID, channel ID and sequence of the packet sent to the next hop.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.PacketForwardKey(portID, channelID, sequence), bz)
+}
+
+// GetForwardedPacket gets the packet which was forwarded with the given port ID, channel ID and sequence.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketForwardKey(portID, channelID, sequence))
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var storedPacket channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &storedPacket)
+
+	return storedPacket, true
+}
+
+// deleteForwardedPacket deletes the packet which was forwarded with the given port ID, channel ID and sequence.
+func (k Keeper) deleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketForwardKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedPackets gets all the packets awaiting the acknowledgement of their forwarded packet.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	var packets []types.ForwardedPacket
+	k.IterateForwardedPackets(ctx, func(packet types.ForwardedPacket) bool {
+		packets = append(packets, packet)
+		return false
+	})
+
+	return packets
+}
+
+// IterateForwardedPackets iterates over the forwarded packets in the store and performs a callback function.
+// Entries for which the key cannot be parsed are skipped.
+func (k Keeper) IterateForwardedPackets(ctx sdk.Context, cb func(packet types.ForwardedPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyForwardedPacketPrefix))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		if len(keySplit) != 4 {
+			continue // key is not of the expected format
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[3], 10, 64)
+		if err != nil {
+			continue // sequence cannot be parsed
+		}
+
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		forwardedPacket := types.ForwardedPacket{
+			ForwardKey: channeltypes.NewPacketID(keySplit[1], keySplit[2], sequence),
+			Packet:     packet,
+		}
+
+		if cb(forwardedPacket) {
+			break
+		}
+	}
+}
+
+// GetChannelTransferStatus gets the transfer status of the given channel. If there is no status
+// stored for the channel, a status enabling both sending and receiving is returned.
+func (k Keeper) GetChannelTransferStatus(ctx sdk.Context, channelID string) types.ChannelTransferStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelTransferStatusKey(channelID))
+	if bz == nil {
+		return types.NewChannelTransferStatus(channelID, true, true)
+	}
+
+	var status types.ChannelTransferStatus
+	k.cdc.MustUnmarshal(bz, &status)
+
+	return status
+}
+
+// SetChannelTransferStatus stores the transfer status of a channel. The status is stored if and
+// only if it disables sending or receiving, otherwise any stored status for the channel is deleted.
+func (k Keeper) SetChannelTransferStatus(ctx sdk.Context, status types.ChannelTransferStatus) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChannelTransferStatusKey(status.ChannelId)
+
+	if status.IsDefault() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(key, bz)
+}
+
+// GetAllChannelTransferStatuses gets the transfer statuses of all the channels for which
+// sending or receiving is disabled.
+func (k Keeper) GetAllChannelTransferStatuses(ctx sdk.Context) []types.ChannelTransferStatus {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyChannelTransferStatusPrefix))
+
+	var statuses []types.ChannelTransferStatus
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.ChannelTransferStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// GetDenomTransferStatus gets the transfer status of the given denomination. If there is no status
+// stored for the denomination, a status enabling both sending and receiving is returned.
+func (k Keeper) GetDenomTransferStatus(ctx sdk.Context, denom string) types.DenomTransferStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomTransferStatusKey(denom))
+	if bz == nil {
+		return types.NewDenomTransferStatus(denom, true, true)
+	}
+
+	var status types.DenomTransferStatus
+	k.cdc.MustUnmarshal(bz, &status)
+
+	return status
+}
+
+// SetDenomTransferStatus stores the transfer status of a denomination. The status is stored if and
+// only if it disables sending or receiving, otherwise any stored status for the denomination is deleted.
+func (k Keeper) SetDenomTransferStatus(ctx sdk.Context, status types.DenomTransferStatus) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DenomTransferStatusKey(status.Denom)
+
+	if status.IsDefault() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(key, bz)
+}
+
+// GetAllDenomTransferStatuses gets the transfer statuses of all the denominations for which
+// sending or receiving is disabled.
+func (k Keeper) GetAllDenomTransferStatuses(ctx sdk.Context) []types.DenomTransferStatus {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyDenomTransferStatusPrefix))
+
+	var statuses []types.DenomTransferStatus
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.DenomTransferStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -17,40 +18,47 @@ var _ types.MsgServer = (*Keeper)(nil)
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.GetParams(ctx).SendEnabled {
-		return nil, types.ErrSendDisabled
-	}
-
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, msg.Token) {
-		return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", msg.Token.Denom)
-	}
+	coins := msg.GetCoins()
 
-	if k.bankKeeper.BlockedAddr(sender) {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
+	if err := k.validateSend(ctx, sender, coins); err != nil {
+		return nil, err
 	}
 
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		msg.GetRelativeTimeout(), msg.Memo, msg.Forwarding)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+	}
+
+	// ics20-1 transfers carry a single coin and keep the denom and amount attributes
+	if appVersion, _ := k.ics4Wrapper.GetAppVersion(ctx, msg.SourcePort, msg.SourceChannel); appVersion == types.V2 {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyTokens, coins.String()))
+	} else {
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributeKeyAmount, coins[0].Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, coins[0].Denom),
+		)
+	}
+
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Token.Denom),
-			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+			eventAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -61,6 +69,26 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
+// validateSend checks that the given coins can be sent by the sender: sending must be
+// enabled in the module and bank parameters and the sender must not be a blocked address.
+func (k Keeper) validateSend(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	if !k.GetParams(ctx).SendEnabled {
+		return types.ErrSendDisabled
+	}
+
+	for _, coin := range coins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	if k.bankKeeper.BlockedAddr(sender) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
+	}
+
+	return nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -72,3 +100,43 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateTransferStatuses defines an rpc handler method for MsgUpdateTransferStatuses. Updates the transfer
+// statuses of the given channels and denominations.
+func (k Keeper) UpdateTransferStatuses(goCtx context.Context, msg *types.MsgUpdateTransferStatuses) (*types.MsgUpdateTransferStatusesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, status := range msg.ChannelStatuses {
+		k.SetChannelTransferStatus(ctx, status)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferStatusUpdated,
+				sdk.NewAttribute(types.AttributeKeyChannelID, status.ChannelId),
+				sdk.NewAttribute(types.AttributeKeySendEnabled, strconv.FormatBool(status.SendEnabled)),
+				sdk.NewAttribute(types.AttributeKeyReceiveEnabled, strconv.FormatBool(status.ReceiveEnabled)),
+			),
+		)
+	}
+
+	for _, status := range msg.DenomStatuses {
+		k.SetDenomTransferStatus(ctx, status)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferStatusUpdated,
+				sdk.NewAttribute(types.AttributeKeyDenom, status.Denom),
+				sdk.NewAttribute(types.AttributeKeySendEnabled, strconv.FormatBool(status.SendEnabled)),
+				sdk.NewAttribute(types.AttributeKeyReceiveEnabled, strconv.FormatBool(status.ReceiveEnabled)),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("transfer statuses updated", "channels", len(msg.ChannelStatuses), "denoms", len(msg.DenomStatuses))
+
+	return &types.MsgUpdateTransferStatusesResponse{}, nil
+}
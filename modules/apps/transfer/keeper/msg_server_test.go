@@ -7,6 +7,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -80,6 +81,21 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"multiple tokens on ics20-1 channel",
+			func() {
+				msg.Token = sdk.Coin{}
+				msg.Tokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(100)))
+			},
+			false,
+		},
+		{
+			"forwarding on ics20-1 channel",
+			func() {
+				msg.Forwarding = types.NewForwarding(types.NewHop(types.PortID, "channel-1"))
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -131,6 +147,49 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 	}
 }
 
+// TestMsgTransferICS20V2Events tests that the Transfer rpc handler emits the tokens attribute on ics20-2 channels
+func (suite *KeeperTestSuite) TestMsgTransferICS20V2Events() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(path)
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	msg := types.NewMsgTransferWithTokens(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		coins, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, // only use timeout height
+		"memo",
+	)
+
+	ctx := suite.chainA.GetContext()
+	res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	expEvents := ibctesting.EventsMap{
+		"ibc_transfer": {
+			"sender":   suite.chainA.SenderAccount.GetAddress().String(),
+			"receiver": suite.chainB.SenderAccount.GetAddress().String(),
+			"tokens":   coins.String(),
+			"memo":     "memo",
+		},
+	}
+	ibctesting.AssertEventsLegacy(&suite.Suite, expEvents, ctx.EventManager().Events().ToABCIEvents())
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTransfer {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			suite.Require().NotEqual(types.AttributeKeyDenom, attr.Key)
+			suite.Require().NotEqual(types.AttributeKeyAmount, attr.Key)
+		}
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -179,3 +238,71 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateTransferStatuses() {
+	var msg *types.MsgUpdateTransferStatuses
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: disable channel and denom",
+			func() {},
+			nil,
+		},
+		{
+			"success: re-enable channel and denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainA.GetContext(), types.NewChannelTransferStatus(ibctesting.FirstChannelID, false, false))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(sdk.DefaultBondDenom, false, false))
+
+				msg.ChannelStatuses[0] = types.NewChannelTransferStatus(ibctesting.FirstChannelID, true, true)
+				msg.DenomStatuses[0] = types.NewDenomTransferStatus(sdk.DefaultBondDenom, true, true)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgUpdateTransferStatuses(
+				suite.chainA.GetSimApp().TransferKeeper.GetAuthority(),
+				[]types.ChannelTransferStatus{types.NewChannelTransferStatus(ibctesting.FirstChannelID, false, true)},
+				[]types.DenomTransferStatus{types.NewDenomTransferStatus(sdk.DefaultBondDenom, true, false)},
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateTransferStatuses(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(msg.ChannelStatuses[0], suite.chainA.GetSimApp().TransferKeeper.GetChannelTransferStatus(ctx, ibctesting.FirstChannelID))
+				suite.Require().Equal(msg.DenomStatuses[0], suite.chainA.GetSimApp().TransferKeeper.GetDenomTransferStatus(ctx, sdk.DefaultBondDenom))
+
+				// statuses enabling both sending and receiving are not stored
+				var expChannelStatuses []types.ChannelTransferStatus
+				if !msg.ChannelStatuses[0].IsDefault() {
+					expChannelStatuses = msg.ChannelStatuses
+				}
+				suite.Require().Equal(expChannelStatuses, suite.chainA.GetSimApp().TransferKeeper.GetAllChannelTransferStatuses(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
@@ -56,18 +57,38 @@ func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	coins sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	relativeTimeout channeltypes.RelativeTimeout,
 	memo string,
+	forwarding types.Forwarding,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	if appVersion == types.V1 && len(coins) != 1 {
+		// ics20-1 only supports a single coin, so if that is the current version, we must only process a single coin.
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot transfer multiple coins with %s", types.V1)
+	}
+
+	if appVersion == types.V1 && forwarding.HasHops() {
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot forward coins with %s", types.V1)
+	}
+
+	if err := k.checkChannelTransferStatus(ctx, sourceChannel, types.AttributeValueSend); err != nil {
+		return 0, err
+	}
+
 	destinationPort := channel.GetCounterparty().GetPortID()
 	destinationChannel := channel.GetCounterparty().GetChannelID()
 
@@ -78,20 +99,79 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	tokens := make([]types.Token, 0, len(coins))
+	for _, coin := range coins {
+		fullDenomPath, err := k.sendCoin(ctx, sourcePort, sourceChannel, destinationPort, destinationChannel, coin, sender)
+		if err != nil {
+			return 0, err
+		}
+
+		tokens = append(tokens, types.NewToken(fullDenomPath, coin.Amount.String()))
+	}
+
+	// the memo is only consumed by the final destination chain, so it is
+	// carried in the forwarding packet data when hops are present
+	var forwardingPacketData types.ForwardingPacketData
+	if forwarding.HasHops() {
+		forwardingPacketData = types.NewForwardingPacketData(memo, forwarding.Hops...)
+		forwardingPacketData.RelativeTimeout = forwarding.RelativeTimeout
+		memo = ""
+	}
+
+	packetDataBytes := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, forwardingPacketData)
+
+	if relativeTimeout.IsValid() {
+		relativeTimeoutSender, ok := k.ics4Wrapper.(porttypes.RelativeTimeoutPacketSender)
+		if !ok {
+			return 0, errorsmod.Wrapf(porttypes.ErrRelativeTimeoutNotSupported, "ICS4Wrapper %T does not implement RelativeTimeoutPacketSender", k.ics4Wrapper)
+		}
+
+		sequence, _, err := relativeTimeoutSender.SendPacketWithRelativeTimeout(ctx, channelCap, sourcePort, sourceChannel, relativeTimeout, packetDataBytes)
+		if err != nil {
+			return 0, err
+		}
+
+		return sequence, nil
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// sendCoin escrows or burns the given coin on the sending chain depending on whether
+// the sending chain is acting as the source or the sink zone for the coin's denomination.
+// The full denomination path of the coin, as it must be included in the packet data, is returned.
+func (k Keeper) sendCoin(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	destinationPort,
+	destinationChannel string,
+	coin sdk.Coin,
+	sender sdk.AccAddress,
+) (string, error) {
 	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
-	fullDenomPath := token.Denom
+	fullDenomPath := coin.Denom
 
 	var err error
 
 	// deconstruct the token denomination into the denomination trace info
 	// to determine if the sender is the source chain
-	if strings.HasPrefix(token.Denom, "ibc/") {
-		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
+	if strings.HasPrefix(coin.Denom, "ibc/") {
+		fullDenomPath, err = k.DenomPathFromHash(ctx, coin.Denom)
 		if err != nil {
-			return 0, err
+			return "", err
 		}
 	}
 
+	if err := k.checkDenomTransferStatus(ctx, sourceChannel, types.ParseDenomTrace(fullDenomPath), types.AttributeValueSend); err != nil {
+		return "", err
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -106,8 +186,8 @@ func (k Keeper) sendTransfer(
 
 		// obtain the escrow address for the source channel end
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
-			return 0, err
+		if err := k.escrowToken(ctx, sender, escrowAddress, coin); err != nil {
+			return "", err
 		}
 
 	} else {
@@ -115,13 +195,13 @@ func (k Keeper) sendTransfer(
 
 		// transfer the coins to the module account and burn them
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
+			ctx, sender, types.ModuleName, sdk.NewCoins(coin),
 		); err != nil {
-			return 0, err
+			return "", err
 		}
 
 		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
+			ctx, types.ModuleName, sdk.NewCoins(coin),
 		); err != nil {
 			// NOTE: should not happen as the module account was
 			// retrieved on the step above and it has enough balance
@@ -130,20 +210,11 @@ func (k Keeper) sendTransfer(
 		}
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
-	)
-
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
-	if err != nil {
-		return 0, err
-	}
-
 	defer func() {
-		if token.Amount.IsInt64() {
+		if coin.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
 				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
+				float32(coin.Amount.Int64()),
 				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
 			)
 		}
@@ -155,7 +226,26 @@ func (k Keeper) sendTransfer(
 		)
 	}()
 
-	return sequence, nil
+	return fullDenomPath, nil
+}
+
+// createPacketDataBytesFromVersion creates the packet data bytes to be sent based on the application version.
+func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens []types.Token, forwarding types.ForwardingPacketData) []byte {
+	switch appVersion {
+	case types.V1:
+		// Sanity check, tokens must always be of length 1 if using app version V1.
+		if len(tokens) != 1 {
+			panic(fmt.Errorf("length of tokens must be equal to 1 if using %s version", types.V1))
+		}
+
+		packetData := types.NewFungibleTokenPacketData(tokens[0].Denom, tokens[0].Amount, sender, receiver, memo)
+		return packetData.GetBytes()
+	case types.V2:
+		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, memo, forwarding)
+		return packetData.GetBytes()
+	default:
+		panic(fmt.Errorf("app version must be one of %s", types.SupportedVersions))
+	}
 }
 
 // OnRecvPacket processes a cross chain fungible token transfer. If the
@@ -163,7 +253,16 @@ func (k Keeper) sendTransfer(
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+//
+// All tokens contained in the packet data are received atomically: if the
+// receipt of any token fails an error is returned and the state changes
+// applied for the other tokens are discarded along with the error acknowledgement.
+//
+// If the packet data contains forwarding hops, the tokens are received by the
+// forward address of the destination channel and sent to the next hop. The
+// acknowledgement of the received packet must then be written asynchronously
+// once the acknowledgement of the forwarded packet is received.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
@@ -173,16 +272,51 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+	if err := k.checkChannelTransferStatus(ctx, packet.GetDestChannel(), types.AttributeValueRecv); err != nil {
+		return err
+	}
+
+	var (
+		receiver sdk.AccAddress
+		err      error
+	)
+
+	if data.HasForwarding() {
+		// the tokens are held by the forward address until they are sent to the next hop
+		receiver = types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	} else {
+		// decode the receiver address
+		receiver, err = sdk.AccAddressFromBech32(data.Receiver)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		}
+	}
+
+	receivedCoins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := k.receiveToken(ctx, packet, token, receiver)
+		if err != nil {
+			return err
+		}
+
+		receivedCoins = receivedCoins.Add(coin)
 	}
 
+	if data.HasForwarding() {
+		return k.forwardPacket(ctx, data, packet, receivedCoins)
+	}
+
+	return nil
+}
+
+// receiveToken unescrows or mints the given token to the receiver. The tokens are
+// unescrowed if this chain is the source of the token's denomination, otherwise
+// vouchers are minted. The coin received, as represented on this chain, is returned.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, receiver sdk.AccAddress) (sdk.Coin, error) {
 	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
+	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
 	}
 
 	labels := []metrics.Label{
@@ -197,12 +331,12 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
 	// chain would have prefixed with DestPort and DestChannel when originally
 	// receiving this coin as seen in the "sender chain is the source" condition.
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// sender chain is not the source, unescrow tokens
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		unprefixedDenom := token.Denom[len(voucherPrefix):]
 
 		// coin denomination used in sending from the escrow address
 		denom := unprefixedDenom
@@ -213,15 +347,19 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		if !denomTrace.IsNativeDenom() {
 			denom = denomTrace.IBCDenom()
 		}
-		token := sdk.NewCoin(denom, transferAmount)
+		coin := sdk.NewCoin(denom, transferAmount)
+
+		if err := k.checkDenomTransferStatus(ctx, packet.GetDestChannel(), denomTrace, types.AttributeValueRecv); err != nil {
+			return sdk.Coin{}, err
+		}
 
 		if k.bankKeeper.BlockedAddr(receiver) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+			return sdk.Coin{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
-			return err
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, coin); err != nil {
+			return sdk.Coin{}, err
 		}
 
 		defer func() {
@@ -242,17 +380,21 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return coin, nil
 	}
 
 	// sender chain is the source, mint vouchers
 
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
-	prefixedDenom := types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	prefixedDenom := types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), token.Denom)
 
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
 
+	if err := k.checkDenomTransferStatus(ctx, packet.GetDestChannel(), denomTrace, types.AttributeValueRecv); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
@@ -276,14 +418,14 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrap(err, "failed to mint IBC tokens")
+		return sdk.Coin{}, errorsmod.Wrap(err, "failed to mint IBC tokens")
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 	}
 
 	defer func() {
@@ -291,7 +433,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			telemetry.SetGaugeWithLabels(
 				[]string{"ibc", types.ModuleName, "packet", "receive"},
 				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, token.Denom)},
 			)
 		}
 
@@ -304,18 +446,35 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucher, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
-func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+// the sender is refunded their tokens using the refundPacketTokens function.
+//
+// If the packet was sent to forward a previously received packet, the
+// acknowledgement of the previously received packet is written as well.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
+	prevPacket, isForwarded := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+			return err
+		}
+
+		if isForwarded {
+			return k.onForwardedPacketFailure(ctx, packet, prevPacket, types.ErrForwardedPacketFailed)
+		}
+
+		return nil
 	default:
+		if isForwarded {
+			return k.onForwardedPacketSuccess(ctx, packet, prevPacket, ack)
+		}
+
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
 		return nil
@@ -323,48 +482,65 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+// never received and has been timed out. If the packet was sent to forward
+// a previously received packet, an error acknowledgement is written for the
+// previously received packet.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		return err
+	}
+
+	prevPacket, isForwarded := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if isForwarded {
+		return k.onForwardedPacketFailure(ctx, packet, prevPacket, types.ErrForwardedPacketTimedOut)
+	}
+
+	return nil
 }
 
-// refundPacketToken will unescrow and send back the tokens back to sender
+// refundPacketTokens will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// the sending address. All tokens in the packet data are refunded.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
 
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+	for _, token := range data.Tokens {
+		if err := k.refundToken(ctx, packet, token, sender); err != nil {
+			return err
+		}
 	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	return nil
+}
+
+// refundToken refunds a single token of a packet to the sender.
+func (k Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, token types.Token, sender sdk.AccAddress) error {
+	coin, err := token.ToCoin()
 	if err != nil {
 		return err
 	}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
+		return k.unescrowToken(ctx, escrowAddress, sender, coin)
 	}
 
 	// mint vouchers back to sender
 	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(token),
+		ctx, types.ModuleName, sdk.NewCoins(coin),
 	); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(token)); err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin)); err != nil {
 		panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 	}
 
@@ -13,9 +13,16 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
+// ics4WrapperWithoutRelativeTimeouts hides the optional RelativeTimeoutPacketSender
+// interface of the wrapped ICS4Wrapper.
+type ics4WrapperWithoutRelativeTimeouts struct {
+	porttypes.ICS4Wrapper
+}
+
 // test sending from chainA to chainB using both coin that originate on
 // chainA and coin that originate on chainB
 func (suite *KeeperTestSuite) TestSendTransfer() {
@@ -24,6 +31,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 		path            *ibctesting.Path
 		sender          sdk.AccAddress
 		timeoutHeight   clienttypes.Height
+		relativeTimeout channeltypes.RelativeTimeout
 		memo            string
 		expEscrowAmount sdkmath.Int // total amount in escrow for denom on receiving chain
 	)
@@ -61,6 +69,23 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				memo = "memo"
 			}, true,
 		},
+		{
+			"successful transfer with relative timeout",
+			func() {
+				timeoutHeight = clienttypes.ZeroHeight()
+				relativeTimeout = channeltypes.NewRelativeTimeout(100, 0)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true,
+		},
+		{
+			"relative timeout not supported by ICS4Wrapper",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.WithICS4Wrapper(ics4WrapperWithoutRelativeTimeouts{suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper})
+				timeoutHeight = clienttypes.ZeroHeight()
+				relativeTimeout = channeltypes.NewRelativeTimeout(100, 0)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, false,
+		},
 		{
 			"source channel not found",
 			func() {
@@ -108,6 +133,39 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				expEscrowAmount = sdkmath.NewInt(100)
 			}, false,
 		},
+		{
+			"successful transfer with receiving disabled for channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainA.GetContext(), types.NewChannelTransferStatus(path.EndpointA.ChannelID, true, false))
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true,
+		},
+		{
+			"sending disabled for channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainA.GetContext(), types.NewChannelTransferStatus(path.EndpointA.ChannelID, false, true))
+			}, false,
+		},
+		{
+			"sending disabled for native denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(coin.Denom, false, true))
+			}, false,
+		},
+		{
+			"sending disabled for IBC denom",
+			func() {
+				coin = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom, coin.Amount)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(coin.Denom, false, true))
+			}, false,
+		},
+		{
+			"sending disabled for base denom of IBC token",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(coin.Denom, false, true))
+				coin = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom, coin.Amount)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -123,6 +181,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			sender = suite.chainA.SenderAccount.GetAddress()
 			memo = ""
 			timeoutHeight = suite.chainB.GetTimeoutHeight()
+			relativeTimeout = channeltypes.RelativeTimeout{}
 			expEscrowAmount = sdkmath.ZeroInt()
 
 			// create IBC token on chainA
@@ -145,6 +204,8 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				timeoutHeight, 0, // only use timeout height
 				memo,
 			)
+			msg.RelativeTimeoutHeight = relativeTimeout.RevisionHeight
+			msg.RelativeTimeoutTimestamp = relativeTimeout.Timestamp
 
 			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
 
@@ -337,6 +398,33 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				expEscrowAmount = sdkmath.NewInt(100)
 			}, true, false,
 		},
+
+		// - transfer statuses on chainB
+		{
+			"success receive with sending disabled for channel",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainB.GetContext(), types.NewChannelTransferStatus(ibctesting.FirstChannelID, false, true))
+			}, false, true,
+		},
+		{
+			"failure: receiving disabled for channel",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelTransferStatus(suite.chainB.GetContext(), types.NewChannelTransferStatus(ibctesting.FirstChannelID, true, false))
+			}, false, false,
+		},
+		{
+			"failure: receiving disabled for base denom of voucher",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainB.GetContext(), types.NewDenomTransferStatus(sdk.DefaultBondDenom, true, false))
+			}, false, false,
+		},
+		{
+			"failure: receiving disabled for native denom on source chain",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTransferStatus(suite.chainB.GetContext(), types.NewDenomTransferStatus(sdk.DefaultBondDenom, true, false))
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -403,7 +491,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, memo)
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			// check total amount in escrow of received token denom on receiving chain
 			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
@@ -512,7 +600,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
 	// execute onRecvPacket, when chaninB receives the source token the escrow amount should decrease
-	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on receiving chain
@@ -602,7 +690,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data), tc.ack)
 
 			// check total amount in escrow of sent token denom on sending chain
 			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
@@ -703,7 +791,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, data, ack)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data), ack)
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
@@ -803,7 +891,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
@@ -897,7 +985,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
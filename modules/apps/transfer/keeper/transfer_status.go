@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// checkChannelTransferStatus returns an error if transfers in the given direction are
+// disabled for the channel by its transfer status.
+func (k Keeper) checkChannelTransferStatus(ctx sdk.Context, channelID, direction string) error {
+	status := k.GetChannelTransferStatus(ctx, channelID)
+	if isTransferEnabled(direction, status.SendEnabled, status.ReceiveEnabled) {
+		return nil
+	}
+
+	emitTransferBlockedEvent(ctx, channelID, "", direction)
+
+	return errorsmod.Wrapf(transferDisabledError(direction), "transfers over channel %s are currently disabled", channelID)
+}
+
+// checkDenomTransferStatus returns an error if transfers in the given direction are disabled
+// for the token with the given denomination trace, as represented on this chain, by the transfer
+// status of either its IBC denomination or its base denomination.
+func (k Keeper) checkDenomTransferStatus(ctx sdk.Context, channelID string, denomTrace types.DenomTrace, direction string) error {
+	denoms := []string{denomTrace.IBCDenom()}
+	if !denomTrace.IsNativeDenom() {
+		denoms = append(denoms, denomTrace.BaseDenom)
+	}
+
+	for _, denom := range denoms {
+		status := k.GetDenomTransferStatus(ctx, denom)
+		if isTransferEnabled(direction, status.SendEnabled, status.ReceiveEnabled) {
+			continue
+		}
+
+		emitTransferBlockedEvent(ctx, channelID, denom, direction)
+
+		return errorsmod.Wrapf(transferDisabledError(direction), "%s transfers are currently disabled", denom)
+	}
+
+	return nil
+}
+
+// isTransferEnabled returns the enabled flag of a transfer status matching the given direction.
+func isTransferEnabled(direction string, sendEnabled, receiveEnabled bool) bool {
+	if direction == types.AttributeValueSend {
+		return sendEnabled
+	}
+
+	return receiveEnabled
+}
+
+// transferDisabledError returns the sentinel error for disabled transfers in the given direction.
+func transferDisabledError(direction string) error {
+	if direction == types.AttributeValueSend {
+		return types.ErrSendDisabled
+	}
+
+	return types.ErrReceiveDisabled
+}
+
+// emitTransferBlockedEvent emits an event for a transfer which was blocked by a transfer status.
+// The denomination is empty if the transfer was blocked by the status of the channel.
+func emitTransferBlockedEvent(ctx sdk.Context, channelID, denom, direction string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferBlocked,
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+		),
+	)
+}
@@ -2,6 +2,7 @@ package transfer_test
 
 import (
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -143,6 +145,386 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// Constructs the following sends over an ics20-2 channel
+// 1 - from chainB to chainA, creating vouchers of chainB's native token on chainA
+// 2 - from chainA to chainB, sending chainA's native token and the vouchers in a single packet
+// 3 - from chainA to chainB, sending native tokens in a packet which times out
+func (suite *TransferTestSuite) TestHandleMultiDenomTransfer() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(path)
+
+	amount := sdkmath.NewInt(100)
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+
+	// send native tokens from chainB to chainA
+	coinToSendToA := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinToSendToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	var packetData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Len(packetData.Tokens, 1)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	voucher := types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, amount)
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().Equal(voucher, balance)
+
+	// send native tokens and vouchers from chainA to chainB in a single packet
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount), voucher)
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	msg = types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coins, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Len(packetData.Tokens, 2)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// vouchers are burned on chainA and native tokens are escrowed
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().True(balance.IsZero())
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(amount, balance.Amount)
+
+	// chainB's native tokens are unescrowed and vouchers for chainA's native tokens are minted
+	escrowAddress = types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().True(balance.IsZero())
+
+	voucherOnB := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherOnB.Denom)
+	suite.Require().Equal(voucherOnB, balance)
+
+	// send native tokens from chainA to chainB and time out the packet
+	msg = types.NewMsgTransferWithTokens(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// advance chainB past the timeout height
+	suite.coordinator.CommitNBlocks(suite.chainB, 100)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// all tokens are refunded to the sender
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance.SubAmount(amount), balance)
+}
+
+// TestHandleForwardedTransfer tests forwarding tokens from chainA to chainC through chainB
+// in a single transfer, with the acknowledgement of the packet received on chainB being
+// written once the packet forwarded to chainC is acknowledged.
+func (suite *TransferTestSuite) TestHandleForwardedTransfer() {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathBtoC)
+
+	amount := sdkmath.NewInt(100)
+	coinToSend := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	msg := types.NewMsgTransfer(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coinToSend, suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "destination memo")
+	msg.Forwarding = types.NewForwarding(types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+	msg.Forwarding.RelativeTimeout = uint64(time.Hour.Nanoseconds())
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// receive the packet on chainB which forwards it to chainC
+	suite.Require().NoError(pathAtoB.EndpointB.UpdateClient())
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is not written until the forwarded packet is acknowledged
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	var forwardedPacketData types.FungibleTokenPacketDataV2
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(forwardedPacket.GetData(), &forwardedPacketData))
+	suite.Require().Equal("destination memo", forwardedPacketData.Memo)
+	suite.Require().False(forwardedPacketData.HasForwarding())
+
+	// the forwarded packet times out relative to the block time of chainB
+	expTimeout := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	suite.Require().LessOrEqual(forwardedPacket.TimeoutTimestamp, expTimeout)
+	suite.Require().Greater(forwardedPacket.TimeoutTimestamp, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+
+	storedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(packet, storedPacket)
+
+	// relay the forwarded packet to chainC and its acknowledgement back to chainB
+	_, ack, err := pathBtoC.RelayPacketWithResults(forwardedPacket)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().False(found)
+
+	// relay the acknowledgement written on chainB to chainA
+	suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, ack))
+
+	// tokens are escrowed on chainA
+	escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID)
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coinToSend, balance)
+
+	// vouchers are escrowed on chainB and the forward address holds no tokens
+	voucherOnB := types.GetTransferCoin(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	escrowAddress = types.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, voucherOnB.Denom)
+	suite.Require().Equal(voucherOnB, balance)
+
+	forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+
+	// the receiver on chainC holds the vouchers
+	fullDenomPath := types.GetPrefixedDenom(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	voucherOnC := sdk.NewCoin(types.ParseDenomTrace(fullDenomPath).IBCDenom(), amount)
+	balance = suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherOnC.Denom)
+	suite.Require().Equal(voucherOnC, balance)
+}
+
+// TestHandleForwardedTransferFailure tests that the tokens are refunded to the original
+// sender on chainA when the packet forwarded to chainC by chainB fails.
+func (suite *TransferTestSuite) TestHandleForwardedTransferFailure() {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathBtoC)
+
+	amount := sdkmath.NewInt(100)
+	coinToSend := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	// the receiver address is invalid, so the packet will fail on chainC
+	msg := types.NewMsgTransfer(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coinToSend, suite.chainA.SenderAccount.GetAddress().String(), "invalid address", suite.chainB.GetTimeoutHeight(), 0, "")
+	msg.Forwarding = types.NewForwarding(types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathAtoB.EndpointB.UpdateClient())
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	_, ack, err := pathBtoC.RelayPacketWithResults(forwardedPacket)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(ack), "error")
+
+	// the vouchers received on chainB are burned
+	voucherOnB := types.GetTransferCoin(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherOnB.Denom)
+	suite.Require().True(supply.IsZero())
+
+	// relay the error acknowledgement written on chainB to chainA
+	errorAck := channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketFailed)
+	suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, errorAck.Acknowledgement()))
+
+	// the original sender is refunded
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+// TestHandleForwardedTransferSendDisabled tests that chainB writes an error acknowledgement,
+// refunding the original sender on chainA, when the received tokens cannot be sent to the next hop.
+func (suite *TransferTestSuite) TestHandleForwardedTransferSendDisabled() {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathAtoB)
+
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	suite.coordinator.Setup(pathBtoC)
+
+	amount := sdkmath.NewInt(100)
+	coinToSend := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	// disable bank sends of the vouchers on chainB
+	voucherOnB := types.GetTransferCoin(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	suite.chainB.GetSimApp().BankKeeper.SetSendEnabled(suite.chainB.GetContext(), voucherOnB.Denom, false)
+
+	msg := types.NewMsgTransfer(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coinToSend, suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	msg.Forwarding = types.NewForwarding(types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pathAtoB.EndpointB.UpdateClient())
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// no packet is forwarded and the error acknowledgement is written synchronously
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(ack), "error")
+
+	supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherOnB.Denom)
+	suite.Require().True(supply.IsZero())
+
+	suite.Require().NoError(pathAtoB.EndpointA.UpdateClient())
+	suite.Require().NoError(pathAtoB.EndpointA.AcknowledgePacket(packet, ack))
+
+	// the original sender is refunded
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+// TestHandleTransferAfterConnectionMigration migrates a transfer channel between chainA and chainB onto a new
+// connection while a transfer is in flight and asserts that the vouchers minted over the channel keep their
+// denomination and can be sent back to the source chain over the migrated channel.
+func (suite *TransferTestSuite) TestHandleTransferAfterConnectionMigration() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	coinToSendToB := ibctesting.TestCoin
+
+	// send from chainA to chainB, the packet is relayed during the migration
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	connectionPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(connectionPath)
+
+	err = path.MigrateConnection(connectionPath, packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(connectionPath.EndpointA.ConnectionID, path.EndpointA.GetChannel().ConnectionHops[0])
+
+	// check that the voucher denomination is unchanged by the migration
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	voucher := sdk.NewCoin(voucherDenomTrace.IBCDenom(), coinToSendToB.Amount)
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().Equal(voucher, balance)
+
+	// send the voucher back from chainB to chainA over the migrated channel
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.Denom)
+	suite.Require().Zero(balance.Amount.Int64())
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+}
+
+// TestRefundAfterChannelForceClose tests that tokens escrowed for a packet which can never be relayed
+// are refunded to the sender once the channel is force closed and the packet force timed out.
+func (suite *TransferTestSuite) TestRefundAfterChannelForceClose() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	coinToSendToB := ibctesting.TestCoin
+
+	// send from chainA to chainB, the packet is never relayed
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(coinToSendToB, escrowBalance)
+
+	// packets cannot be force timed out while the channel is OPEN
+	err = path.EndpointA.ForceTimeoutPackets(packet)
+	suite.Require().Error(err)
+
+	err = path.EndpointA.ChanForceClose()
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	err = path.EndpointA.ForceTimeoutPackets(packet)
+	suite.Require().NoError(err)
+
+	escrowBalance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Zero(escrowBalance.Amount.Int64())
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance, balance)
+
+	// the packet cannot be refunded twice
+	err = path.EndpointA.ForceTimeoutPackets(packet)
+	suite.Require().Error(err)
+}
+
+// TestChannelForceClose tests that the transfer OnChanCloseConfirm callback, which is executed before
+// the channel is force closed, does not veto the closure of a transfer channel.
+func (suite *TransferTestSuite) TestChannelForceClose() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	err := path.EndpointA.ChanForceClose()
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	// the counterparty channel is unaffected
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointB.GetChannel().State)
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TransferTestSuite))
 }
@@ -16,4 +16,8 @@ var (
 	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 9, "max transfer channels")
 	ErrInvalidAuthorization    = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
 	ErrInvalidMemo             = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
+	ErrForwardedPacketFailed   = errorsmod.Register(ModuleName, 13, "forwarded packet failed")
+	ErrForwardedPacketTimedOut = errorsmod.Register(ModuleName, 14, "forwarded packet timed out")
+	ErrInvalidTransferStatus   = errorsmod.Register(ModuleName, 15, "invalid transfer status")
 )
@@ -8,15 +8,27 @@ const (
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
 
+	EventTypeTransferStatusUpdated = "transfer_status_updated"
+	EventTypeTransferBlocked       = "transfer_blocked"
+
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyTokens         = "tokens"
 	AttributeKeyRefundReceiver = "refund_receiver"
 	AttributeKeyRefundDenom    = "refund_denom"
 	AttributeKeyRefundAmount   = "refund_amount"
+	AttributeKeyRefundTokens   = "refund_tokens"
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeySendEnabled    = "send_enabled"
+	AttributeKeyReceiveEnabled = "receive_enabled"
+	AttributeKeyDirection      = "direction"
+
+	AttributeValueSend = "send"
+	AttributeValueRecv = "recv"
 )
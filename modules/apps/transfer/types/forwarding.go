@@ -0,0 +1,106 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaximumNumberOfForwardingHops denotes the maximum number of forwarding hops allowed
+const MaximumNumberOfForwardingHops = 8
+
+// NewForwarding creates a new Forwarding instance given a variable number of hops.
+func NewForwarding(hops ...Hop) Forwarding {
+	return Forwarding{
+		Hops: hops,
+	}
+}
+
+// NewHop creates a Hop with the given port ID and channel ID.
+func NewHop(portID, channelID string) Hop {
+	return Hop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the Forwarding fields.
+func (f Forwarding) Validate() error {
+	if err := validateHops(f.Hops); err != nil {
+		return err
+	}
+
+	if !f.HasHops() && f.RelativeTimeout != 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "relative timeout specified without hops")
+	}
+
+	return nil
+}
+
+// HasHops returns true if the forwarding contains at least one hop.
+func (f Forwarding) HasHops() bool {
+	return len(f.Hops) > 0
+}
+
+// NewForwardingPacketData creates a new ForwardingPacketData instance given a memo and a variable number of hops.
+func NewForwardingPacketData(destinationMemo string, hops ...Hop) ForwardingPacketData {
+	return ForwardingPacketData{
+		DestinationMemo: destinationMemo,
+		Hops:            hops,
+	}
+}
+
+// Validate performs a basic validation of the ForwardingPacketData fields.
+func (fpd ForwardingPacketData) Validate() error {
+	if err := validateHops(fpd.Hops); err != nil {
+		return err
+	}
+
+	if len(fpd.DestinationMemo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if !fpd.HasHops() && fpd.DestinationMemo != "" {
+		return errorsmod.Wrap(ErrInvalidForwarding, "memo specified without hops")
+	}
+
+	if !fpd.HasHops() && fpd.RelativeTimeout != 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "relative timeout specified without hops")
+	}
+
+	return nil
+}
+
+// HasHops returns true if the forwarding packet data contains at least one hop.
+func (fpd ForwardingPacketData) HasHops() bool {
+	return len(fpd.Hops) > 0
+}
+
+// Validate performs a basic validation of the Hop fields, checking that
+// the port ID and channel ID are valid identifiers.
+func (h Hop) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source port ID %s", h.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source channel ID %s", h.ChannelId)
+	}
+
+	return nil
+}
+
+// validateHops performs a basic validation of the hops.
+// It checks that the number of hops does not exceed the maximum allowed and that each hop is valid.
+func validateHops(hops []Hop) error {
+	if len(hops) > MaximumNumberOfForwardingHops {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hops cannot exceed %d", MaximumNumberOfForwardingHops)
+	}
+
+	for _, hop := range hops {
+		if err := hop.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -35,5 +35,25 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+
+	if err := validateTransferStatuses(gs.ChannelStatuses, gs.DenomStatuses); err != nil {
+		return err
+	}
+
+	for _, forwardedPacket := range gs.ForwardedPackets {
+		if err := host.PortIdentifierValidator(forwardedPacket.ForwardKey.PortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(forwardedPacket.ForwardKey.ChannelId); err != nil {
+			return err
+		}
+		if err := forwardedPacket.Packet.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
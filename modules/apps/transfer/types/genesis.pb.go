@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +34,13 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// forwarded_packets contains the packets received which are awaiting the
+	// acknowledgement of the packet sent to the next hop
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// channel_statuses contains the transfer statuses of channels
+	ChannelStatuses []ChannelTransferStatus `protobuf:"bytes,6,rep,name=channel_statuses,json=channelStatuses,proto3" json:"channel_statuses"`
+	// denom_statuses contains the transfer statuses of denominations
+	DenomStatuses []DenomTransferStatus `protobuf:"bytes,7,rep,name=denom_statuses,json=denomStatuses,proto3" json:"denom_statuses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,8 +104,84 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelStatuses() []ChannelTransferStatus {
+	if m != nil {
+		return m.ChannelStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomStatuses() []DenomTransferStatus {
+	if m != nil {
+		return m.DenomStatuses
+	}
+	return nil
+}
+
+// ForwardedPacket defines a packet which has been received and forwarded to the
+// next hop, keyed by the identifiers of the packet sent to the next hop.
+type ForwardedPacket struct {
+	ForwardKey types1.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
+	Packet     types1.Packet   `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *ForwardedPacket) Reset()         { *m = ForwardedPacket{} }
+func (m *ForwardedPacket) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacket) ProtoMessage()    {}
+func (*ForwardedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{1}
+}
+func (m *ForwardedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacket.Merge(m, src)
+}
+func (m *ForwardedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedPacket proto.InternalMessageInfo
+
+func (m *ForwardedPacket) GetForwardKey() types1.PacketId {
+	if m != nil {
+		return m.ForwardKey
+	}
+	return types1.PacketId{}
+}
+
+func (m *ForwardedPacket) GetPacket() types1.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types1.Packet{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
+	proto.RegisterType((*ForwardedPacket)(nil), "ibc.applications.transfer.v1.ForwardedPacket")
 }
 
 func init() {
@@ -105,31 +189,41 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x08, 0x27, 0xa4, 0xb0, 0x90, 0x30, 0x11, 0x72, 0x22, 0x44, 0x61, 0x81,
-	0xb2, 0x8b, 0x43, 0x01, 0xb5, 0x01, 0x21, 0x3a, 0x30, 0x54, 0x50, 0x44, 0xeb, 0xf5, 0x62, 0x56,
-	0xc4, 0x1e, 0x6b, 0x67, 0x63, 0x74, 0x7f, 0x71, 0xdf, 0x71, 0xdf, 0x70, 0x1f, 0x90, 0x32, 0xe5,
-	0x55, 0x77, 0xa7, 0xe4, 0x47, 0x4e, 0x5e, 0xfb, 0xa2, 0x48, 0x27, 0xa5, 0xf2, 0x8c, 0xe7, 0xbd,
-	0x37, 0xb3, 0xef, 0xb9, 0xaf, 0x65, 0xca, 0x29, 0xab, 0xaa, 0x95, 0xe4, 0x4c, 0x4b, 0x28, 0x91,
-	0x6a, 0xc5, 0x4a, 0xfc, 0x23, 0x14, 0xad, 0x23, 0x9a, 0x8b, 0x52, 0xa0, 0x44, 0x52, 0x29, 0xd0,
-	0xe0, 0xbd, 0x90, 0x29, 0x27, 0xc7, 0x58, 0x72, 0x8f, 0x25, 0x75, 0x34, 0x79, 0x73, 0x52, 0xe9,
-	0x80, 0x34, 0x52, 0x93, 0x80, 0x03, 0x16, 0x80, 0x34, 0x65, 0x28, 0x68, 0x1d, 0xa5, 0x42, 0xb3,
-	0x88, 0x72, 0x90, 0x65, 0x37, 0x7f, 0x9a, 0x43, 0x0e, 0xa6, 0xa4, 0x4d, 0xd5, 0xfe, 0x7d, 0x79,
-	0xd9, 0x73, 0x47, 0x5f, 0xda, 0x93, 0x7e, 0x68, 0xa6, 0x85, 0xf7, 0xcc, 0x7d, 0x54, 0x81, 0xd2,
-	0x4b, 0x99, 0xf9, 0xf6, 0xcc, 0x0e, 0x1f, 0x27, 0x4e, 0xd3, 0x7e, 0xcd, 0xbc, 0xdf, 0xee, 0x28,
-	0x13, 0x25, 0x14, 0x4b, 0xad, 0x18, 0x17, 0xe8, 0xf7, 0x66, 0xfd, 0x70, 0xb8, 0x08, 0xc9, 0xa9,
-	0x17, 0x90, 0x4f, 0x0d, 0xe3, 0x67, 0x43, 0x88, 0xc7, 0x9b, 0xeb, 0xa9, 0x75, 0x71, 0x33, 0x75,
-	0x4c, 0x8b, 0xc9, 0x30, 0x3b, 0xcc, 0xd0, 0x8b, 0x5d, 0xa7, 0x62, 0x8a, 0x15, 0xe8, 0xf7, 0x67,
-	0x76, 0x38, 0x5c, 0xbc, 0x3a, 0x2d, 0xfb, 0xcd, 0x60, 0xe3, 0x41, 0x23, 0x99, 0x74, 0x4c, 0x4f,
-	0xb9, 0x63, 0x0d, 0x9a, 0xad, 0x96, 0x02, 0xb9, 0x82, 0xff, 0x22, 0xf3, 0x07, 0xe6, 0xc4, 0xe7,
-	0xa4, 0x75, 0x86, 0x34, 0xce, 0x90, 0xce, 0x19, 0xf2, 0x11, 0x64, 0x19, 0xbf, 0xed, 0x6e, 0x0a,
-	0x73, 0xa9, 0xff, 0xae, 0x53, 0xc2, 0xa1, 0xa0, 0x9d, 0x8d, 0xed, 0x67, 0x8e, 0xd9, 0x3f, 0xaa,
-	0xcf, 0x2a, 0x81, 0x86, 0x80, 0xc9, 0x13, 0xb3, 0xe2, 0x73, 0xb7, 0x21, 0xfe, 0xbe, 0xd9, 0x05,
-	0xf6, 0x76, 0x17, 0xd8, 0xb7, 0xbb, 0xc0, 0x3e, 0xdf, 0x07, 0xd6, 0x76, 0x1f, 0x58, 0x57, 0xfb,
-	0xc0, 0xfa, 0xf5, 0xfe, 0xa1, 0xa4, 0x4c, 0xf9, 0x3c, 0x07, 0x5a, 0x7f, 0xa0, 0x05, 0x64, 0xeb,
-	0x95, 0xc0, 0x26, 0xdb, 0xa3, 0x4c, 0xcd, 0x9e, 0xd4, 0x31, 0xc1, 0xbc, 0xbb, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xfe, 0xf0, 0xde, 0x54, 0x47, 0x02, 0x00, 0x00,
+	// 538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xce, 0xb6, 0x21, 0x15, 0x4e, 0x9b, 0x96, 0x15, 0x12, 0x4b, 0x81, 0x6d, 0xa8, 0x38, 0x44,
+	0xa0, 0xd8, 0x24, 0x3d, 0x00, 0xd7, 0xb4, 0x80, 0x2a, 0x2e, 0x25, 0xe9, 0x09, 0x24, 0x16, 0xaf,
+	0xed, 0xa4, 0xab, 0x24, 0xeb, 0x95, 0xc7, 0x49, 0x95, 0xb7, 0x40, 0x3c, 0x06, 0x4f, 0xd2, 0x63,
+	0x8f, 0x9c, 0x00, 0x25, 0x3c, 0x08, 0xb2, 0xd7, 0x09, 0x85, 0x4a, 0xab, 0x9e, 0xfc, 0x37, 0xdf,
+	0xf7, 0x79, 0x66, 0xbe, 0x41, 0x4f, 0x93, 0x98, 0x11, 0x9a, 0x65, 0xa3, 0x84, 0x51, 0x9d, 0xc8,
+	0x14, 0x88, 0x56, 0x34, 0x85, 0xbe, 0x50, 0x64, 0xda, 0x22, 0x03, 0x91, 0x0a, 0x48, 0x00, 0x67,
+	0x4a, 0x6a, 0xe9, 0x3f, 0x4c, 0x62, 0x86, 0xaf, 0xc6, 0xe2, 0x65, 0x2c, 0x9e, 0xb6, 0x76, 0x9f,
+	0x15, 0x32, 0xad, 0x22, 0x2d, 0xd5, 0x6e, 0xc8, 0x24, 0x8c, 0x25, 0x90, 0x98, 0x82, 0x20, 0xd3,
+	0x56, 0x2c, 0x34, 0x6d, 0x11, 0x26, 0x93, 0xd4, 0xbd, 0xdf, 0x1d, 0xc8, 0x81, 0xb4, 0x5b, 0x62,
+	0x76, 0xee, 0xf6, 0xb1, 0x91, 0x60, 0x52, 0x09, 0xc2, 0xce, 0x68, 0x9a, 0x8a, 0x91, 0x61, 0x76,
+	0xdb, 0x3c, 0x64, 0xff, 0x77, 0x19, 0x6d, 0xbe, 0xcd, 0x7f, 0xdd, 0xd3, 0x54, 0x0b, 0xff, 0x1e,
+	0xda, 0xc8, 0xa4, 0xd2, 0x51, 0xc2, 0x03, 0xaf, 0xee, 0x35, 0x6e, 0x77, 0x2b, 0xe6, 0x78, 0xcc,
+	0xfd, 0x8f, 0x68, 0x93, 0x8b, 0x54, 0x8e, 0x23, 0xad, 0x28, 0x13, 0x10, 0xac, 0xd5, 0xd7, 0x1b,
+	0xd5, 0x76, 0x03, 0x17, 0x25, 0x89, 0x8f, 0x0c, 0xe2, 0xd4, 0x00, 0x3a, 0xb5, 0x8b, 0x1f, 0x7b,
+	0xa5, 0x6f, 0x3f, 0xf7, 0x2a, 0xf6, 0x08, 0xdd, 0x2a, 0x5f, 0xbd, 0x81, 0xdf, 0x41, 0x95, 0x8c,
+	0x2a, 0x3a, 0x86, 0x60, 0xbd, 0xee, 0x35, 0xaa, 0xed, 0x27, 0xc5, 0xb4, 0x27, 0x36, 0xb6, 0x53,
+	0x36, 0x94, 0x5d, 0x87, 0xf4, 0x15, 0xaa, 0x69, 0xa9, 0xe9, 0x28, 0x12, 0xc0, 0x94, 0x3c, 0x17,
+	0x3c, 0x28, 0xdb, 0x2f, 0xde, 0xc7, 0x79, 0xf1, 0xb0, 0x29, 0x1e, 0x76, 0xc5, 0xc3, 0x87, 0x32,
+	0x49, 0x3b, 0xcf, 0xdd, 0x9f, 0x1a, 0x83, 0x44, 0x9f, 0x4d, 0x62, 0xcc, 0xe4, 0x98, 0xb8, 0x4a,
+	0xe7, 0x4b, 0x13, 0xf8, 0x90, 0xe8, 0x59, 0x26, 0xc0, 0x02, 0xa0, 0xbb, 0x65, 0x25, 0x5e, 0x3b,
+	0x05, 0xff, 0x33, 0xba, 0xd3, 0x97, 0xea, 0x9c, 0x2a, 0x2e, 0x78, 0x94, 0x51, 0x36, 0x14, 0x1a,
+	0x82, 0x5b, 0x56, 0xb6, 0x59, 0x9c, 0xc2, 0x9b, 0x25, 0xec, 0xc4, 0xa2, 0x5c, 0x2e, 0x3b, 0xfd,
+	0x7f, 0xaf, 0xc1, 0xe7, 0x68, 0xc7, 0x75, 0x2c, 0x02, 0x4d, 0xf5, 0x04, 0x04, 0x04, 0x15, 0x2b,
+	0x70, 0x50, 0x2c, 0x70, 0x98, 0xa3, 0x4e, 0xdd, 0x55, 0xcf, 0x82, 0x9d, 0xcc, 0xb6, 0xa3, 0xec,
+	0x39, 0x46, 0xff, 0x13, 0xaa, 0xe5, 0xcd, 0x5d, 0x69, 0x6c, 0x58, 0x8d, 0xd6, 0xcd, 0xda, 0x7b,
+	0x5d, 0x61, 0xcb, 0xd2, 0x2d, 0xf9, 0xf7, 0xbf, 0x7a, 0x68, 0xfb, 0xbf, 0x8c, 0xfd, 0x23, 0x54,
+	0x75, 0xd9, 0x46, 0x43, 0x31, 0xb3, 0x6e, 0xab, 0xb6, 0x1f, 0x59, 0x41, 0xe3, 0x59, 0xbc, 0x34,
+	0xaa, 0xed, 0xb7, 0x41, 0x1c, 0x73, 0x47, 0x8e, 0x1c, 0xee, 0x9d, 0x98, 0xf9, 0xaf, 0x8c, 0x73,
+	0xcc, 0x6b, 0xb0, 0x66, 0x09, 0x1e, 0x14, 0x10, 0xfc, 0x35, 0x8c, 0x3d, 0xbd, 0xbf, 0x98, 0x87,
+	0xde, 0xe5, 0x3c, 0xf4, 0x7e, 0xcd, 0x43, 0xef, 0xcb, 0x22, 0x2c, 0x5d, 0x2e, 0xc2, 0xd2, 0xf7,
+	0x45, 0x58, 0xfa, 0xf0, 0xe2, 0xba, 0x1f, 0x92, 0x98, 0x35, 0x07, 0x92, 0x4c, 0x5f, 0x92, 0xb1,
+	0xe4, 0x93, 0x91, 0x00, 0x33, 0xbb, 0x57, 0x66, 0xd6, 0x9a, 0x24, 0xae, 0xd8, 0xa9, 0x3a, 0xf8,
+	0x33, 0x00, 0xaa, 0x59, 0x37, 0xf4, 0x27, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +246,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomStatuses) > 0 {
+		for iNdEx := len(m.DenomStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChannelStatuses) > 0 {
+		for iNdEx := len(m.ChannelStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -200,6 +336,49 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ForwardKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -235,6 +414,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelStatuses) > 0 {
+		for _, e := range m.ChannelStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomStatuses) > 0 {
+		for _, e := range m.DenomStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwardKey.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -406,6 +616,224 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStatuses = append(m.ChannelStatuses, ChannelTransferStatus{})
+			if err := m.ChannelStatuses[len(m.ChannelStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomStatuses = append(m.DenomStatuses, DenomTransferStatus{})
+			if err := m.DenomStatuses[len(m.DenomStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -33,6 +33,34 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid transfer statuses",
+			&types.GenesisState{
+				PortId:          "portidone",
+				ChannelStatuses: []types.ChannelTransferStatus{types.NewChannelTransferStatus("channel-0", false, true)},
+				DenomStatuses:   []types.DenomTransferStatus{types.NewDenomTransferStatus("uatom", true, false)},
+			},
+			true,
+		},
+		{
+			"duplicate channel transfer status",
+			&types.GenesisState{
+				PortId: "portidone",
+				ChannelStatuses: []types.ChannelTransferStatus{
+					types.NewChannelTransferStatus("channel-0", false, true),
+					types.NewChannelTransferStatus("channel-0", true, false),
+				},
+			},
+			false,
+		},
+		{
+			"invalid denom transfer status",
+			&types.GenesisState{
+				PortId:        "portidone",
+				DenomStatuses: []types.DenomTransferStatus{types.NewDenomTransferStatus("", true, false)},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
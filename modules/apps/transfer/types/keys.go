@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,9 +12,16 @@ const (
 	// ModuleName defines the IBC transfer name
 	ModuleName = "transfer"
 
-	// Version defines the current version the IBC transfer
-	// module supports
-	Version = "ics20-1"
+	// V1 defines the first version of the IBC transfer module
+	V1 = "ics20-1"
+
+	// V2 defines the version of the IBC transfer module which supports
+	// the transfer of multiple tokens in a single packet
+	V2 = "ics20-2"
+
+	// Version defines the version the IBC transfer module proposes
+	// when no version is specified during the channel handshake
+	Version = V1
 
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
@@ -35,10 +43,25 @@ const (
 
 	KeyTotalEscrowPrefix = "totalEscrowForDenom"
 
+	// KeyForwardedPacketPrefix defines the key prefix under which packets awaiting
+	// the acknowledgement of their forwarded packet are stored
+	KeyForwardedPacketPrefix = "forwardedPacket"
+
+	// KeyChannelTransferStatusPrefix defines the key prefix under which the transfer
+	// statuses of channels are stored
+	KeyChannelTransferStatusPrefix = "channelTransferStatus"
+
+	// KeyDenomTransferStatusPrefix defines the key prefix under which the transfer
+	// statuses of denominations are stored
+	KeyDenomTransferStatusPrefix = "denomTransferStatus"
+
 	ParamsKey = "params"
 )
 
 var (
+	// SupportedVersions defines all versions that are supported by the module
+	SupportedVersions = []string{V2, V1}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
@@ -54,15 +77,54 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
 	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	// NOTE: the escrow address is derived using V1 for all channel versions
+	// so that escrowed funds are not affected by version upgrades
+	preImage := []byte(V1)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// GetForwardAddress returns the address which temporarily holds the tokens received on
+// the specified channel that must be forwarded to the next hop. The forward address
+// is derived in the same way as the escrow address, but uses a separate domain so that
+// the forwarded tokens are not mixed with the escrowed tokens.
+func GetForwardAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(fmt.Sprintf("%s-forward", V1))
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
 
+// IsSupportedVersion returns true if the provided version is supported by the transfer module.
+func IsSupportedVersion(version string) bool {
+	return slices.Contains(SupportedVersions, version)
+}
+
 // TotalEscrowForDenomKey returns the store key of under which the total amount of
 // source chain tokens in escrow is stored.
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// PacketForwardKey returns the store key under which the packet which was forwarded
+// with the given port ID, channel ID and sequence is stored.
+func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyForwardedPacketPrefix, portID, channelID, sequence))
+}
+
+// ChannelTransferStatusKey returns the store key under which the transfer status
+// of the given channel is stored.
+func ChannelTransferStatusKey(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyChannelTransferStatusPrefix, channelID))
+}
+
+// DenomTransferStatusKey returns the store key under which the transfer status
+// of the given denomination is stored.
+func DenomTransferStatusKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyDenomTransferStatusPrefix, denom))
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
@@ -15,13 +16,16 @@ import (
 const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgUpdateTransferStatuses)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateTransferStatuses)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -61,6 +65,26 @@ func NewMsgTransfer(
 	}
 }
 
+// NewMsgTransferWithTokens creates a new MsgTransfer instance which transfers
+// multiple tokens in a single packet. It may only be used on ics20-2 channels.
+func NewMsgTransferWithTokens(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
 // ValidateBasic performs a basic check of the MsgTransfer fields.
 // NOTE: timeout height or timestamp values can be 0 to disable the timeout.
 // NOTE: The recipient addresses format is not validated as the format defined by
@@ -72,11 +96,22 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return errorsmod.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+	if len(msg.Tokens) > 0 && !msg.Token.IsNil() && !msg.Token.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "cannot fill both token and tokens")
 	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	coins := msg.GetCoins()
+	if len(coins) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "no tokens to transfer")
+	}
+	if !coins.IsValid() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coins.String())
+	}
+	if !coins.IsAllPositive() {
+		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, coins.String())
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -92,5 +127,59 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+
+	for _, coin := range coins {
+		if err := ValidateIBCDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	if err := msg.Forwarding.Validate(); err != nil {
+		return err
+	}
+
+	if msg.GetRelativeTimeout().IsValid() && (!msg.TimeoutHeight.IsZero() || msg.TimeoutTimestamp != 0) {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "relative timeouts cannot be combined with absolute timeouts")
+	}
+
+	return nil
+}
+
+// GetRelativeTimeout returns the timeout of the packet relative to the counterparty client, which is resolved
+// by core IBC when the packet is sent.
+func (msg MsgTransfer) GetRelativeTimeout() channeltypes.RelativeTimeout {
+	return channeltypes.NewRelativeTimeout(msg.RelativeTimeoutHeight, msg.RelativeTimeoutTimestamp)
+}
+
+// GetCoins returns the tokens to be transferred. The single token field is used
+// if set, otherwise the tokens field is returned.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if !msg.Token.IsNil() && !msg.Token.IsZero() {
+		return sdk.Coins{msg.Token}
+	}
+
+	return msg.Tokens
+}
+
+// NewMsgUpdateTransferStatuses creates a new MsgUpdateTransferStatuses instance
+func NewMsgUpdateTransferStatuses(signer string, channelStatuses []ChannelTransferStatus, denomStatuses []DenomTransferStatus) *MsgUpdateTransferStatuses {
+	return &MsgUpdateTransferStatuses{
+		Signer:          signer,
+		ChannelStatuses: channelStatuses,
+		DenomStatuses:   denomStatuses,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateTransferStatuses) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.ChannelStatuses) == 0 && len(msg.DenomStatuses) == 0 {
+		return errorsmod.Wrap(ErrInvalidTransferStatus, "at least one transfer status must be provided")
+	}
+
+	return validateTransferStatuses(msg.ChannelStatuses, msg.DenomStatuses)
 }
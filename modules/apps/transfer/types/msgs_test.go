@@ -70,6 +70,16 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, ""), false},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid msg with multiple tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.NewCoins(coin, ibcCoin), sender, receiver, timeoutHeight, 0, ""), true},
+		{"multiple tokens with invalid ibc denom", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{invalidIBCCoin, coin}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"unsorted tokens", types.NewMsgTransferWithTokens(validPort, validChannel, sdk.Coins{ibcCoin, coin}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"both token and tokens are set", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Tokens: sdk.NewCoins(ibcCoin), Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight}, false},
+		{"valid msg with forwarding", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight, Forwarding: types.NewForwarding(types.NewHop(validPort, validChannel))}, true},
+		{"relative forwarding timeout without hops", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight, Forwarding: types.Forwarding{RelativeTimeout: 1}}, false},
+		{"invalid forwarding hop", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight, Forwarding: types.NewForwarding(types.NewHop(validPort, invalidChannel))}, false},
+		{"valid msg with relative timeout", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, RelativeTimeoutHeight: 100}, true},
+		{"relative timeout combined with absolute timeout", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight, RelativeTimeoutTimestamp: 100}, false},
+		{"too many forwarding hops", &types.MsgTransfer{SourcePort: validPort, SourceChannel: validChannel, Token: coin, Sender: sender, Receiver: receiver, TimeoutHeight: timeoutHeight, Forwarding: types.NewForwarding(make([]types.Hop, types.MaximumNumberOfForwardingHops+1)...)}, false},
 	}
 
 	for i, tc := range testCases {
@@ -120,6 +130,38 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 }
 
 // TestMsgUpdateParamsGetSigners tests GetSigners for MsgUpdateParams
+func TestMsgUpdateTransferStatusesValidateBasic(t *testing.T) {
+	channelStatuses := []types.ChannelTransferStatus{types.NewChannelTransferStatus(validChannel, false, true)}
+	denomStatuses := []types.DenomTransferStatus{types.NewDenomTransferStatus(coin.Denom, true, false)}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateTransferStatuses
+		expPass bool
+	}{
+		{"success: valid signer and statuses", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, channelStatuses, denomStatuses), true},
+		{"success: channel statuses only", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, channelStatuses, nil), true},
+		{"success: IBC denom status", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, nil, []types.DenomTransferStatus{types.NewDenomTransferStatus(ibcCoin.Denom, false, false)}), true},
+		{"failure: invalid signer", types.NewMsgUpdateTransferStatuses(invalidAddress, channelStatuses, denomStatuses), false},
+		{"failure: no statuses", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, nil, nil), false},
+		{"failure: invalid channel ID", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, []types.ChannelTransferStatus{types.NewChannelTransferStatus(invalidChannel, false, false)}, nil), false},
+		{"failure: invalid IBC denom", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, nil, []types.DenomTransferStatus{types.NewDenomTransferStatus("ibc/xyz", false, false)}), false},
+		{"failure: duplicate channel", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, append(channelStatuses, channelStatuses...), nil), false},
+		{"failure: duplicate denom", types.NewMsgUpdateTransferStatuses(ibctesting.TestAccAddress, nil, append(denomStatuses, denomStatuses...)), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 func TestMsgUpdateParamsGetSigners(t *testing.T) {
 	testCases := []struct {
 		name    string
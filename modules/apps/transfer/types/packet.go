@@ -17,6 +17,8 @@ import (
 var (
 	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketData         = (*FungibleTokenPacketDataV2)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketDataV2)(nil)
 )
 
 var (
@@ -104,3 +106,140 @@ func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) interface{}
 
 	return memoData
 }
+
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+	forwarding ForwardingPacketData,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:     tokens,
+		Sender:     sender,
+		Receiver:   receiver,
+		Memo:       memo,
+		Forwarding: forwarding,
+	}
+}
+
+// PacketDataV1ToV2 converts a v1 packet data to the v2 packet data representation,
+// which is used internally by the transfer keeper for all channel versions.
+func PacketDataV1ToV2(data FungibleTokenPacketData) FungibleTokenPacketDataV2 {
+	return NewFungibleTokenPacketDataV2(
+		[]Token{NewToken(data.Denom, data.Amount)},
+		data.Sender, data.Receiver, data.Memo, ForwardingPacketData{},
+	)
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return errorsmod.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+	if len(ftpd.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ErrInvalidAmount, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seenDenoms := make(map[string]bool, len(ftpd.Tokens))
+	for _, token := range ftpd.Tokens {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[token.Denom] {
+			return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "duplicate denomination: %s", token.Denom)
+		}
+		seenDenoms[token.Denom] = true
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if len(ftpd.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if err := ftpd.Forwarding.Validate(); err != nil {
+		return err
+	}
+
+	// the memo is only consumed by the final destination chain, so it
+	// must be carried in the forwarding information when hops are present
+	if ftpd.HasForwarding() && ftpd.Memo != "" {
+		return errorsmod.Wrap(ErrInvalidMemo, "memo must be empty if forwarding path hops is not empty")
+	}
+
+	return nil
+}
+
+// HasForwarding determines if the packet should be forwarded to the next hop.
+func (ftpd FungibleTokenPacketDataV2) HasForwarding() bool {
+	return ftpd.Forwarding.HasHops()
+}
+
+// GetBytes is a helper for serialising
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&ftpd))
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketDataV2) GetCustomPacketData(key string) interface{} {
+	if len(ftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	err := json.Unmarshal([]byte(ftpd.Memo), &jsonObject)
+	if err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// UnmarshalPacketDataBytes unmarshals the provided packet data bytes into the
+// v2 packet data representation, using the encoding defined by the given ics20 version.
+func UnmarshalPacketDataBytes(bz []byte, ics20Version string) (FungibleTokenPacketDataV2, error) {
+	switch ics20Version {
+	case V1:
+		var datav1 FungibleTokenPacketData
+		if err := ModuleCdc.UnmarshalJSON(bz, &datav1); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return PacketDataV1ToV2(datav1), nil
+	case V2:
+		var datav2 FungibleTokenPacketDataV2
+		if err := ModuleCdc.UnmarshalJSON(bz, &datav2); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return datav2, nil
+	default:
+		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ErrInvalidVersion, "unsupported ics20 version: %s", ics20Version)
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -106,8 +107,161 @@ func (m *FungibleTokenPacketData) GetMemo() string {
 	return ""
 }
 
+// FungibleTokenPacketDataV2 defines a struct for the packet payload of
+// ics20-2 channels, which allows for multiple tokens to be transferred
+// atomically in a single packet.
+type FungibleTokenPacketDataV2 struct {
+	// the tokens to be transferred
+	Tokens []Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// the sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	// optional forwarding information
+	Forwarding ForwardingPacketData `protobuf:"bytes,5,opt,name=forwarding,proto3" json:"forwarding"`
+}
+
+func (m *FungibleTokenPacketDataV2) Reset()         { *m = FungibleTokenPacketDataV2{} }
+func (m *FungibleTokenPacketDataV2) String() string { return proto.CompactTextString(m) }
+func (*FungibleTokenPacketDataV2) ProtoMessage()    {}
+func (*FungibleTokenPacketDataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{1}
+}
+func (m *FungibleTokenPacketDataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FungibleTokenPacketDataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FungibleTokenPacketDataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FungibleTokenPacketDataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FungibleTokenPacketDataV2.Merge(m, src)
+}
+func (m *FungibleTokenPacketDataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *FungibleTokenPacketDataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_FungibleTokenPacketDataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FungibleTokenPacketDataV2 proto.InternalMessageInfo
+
+func (m *FungibleTokenPacketDataV2) GetTokens() []Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *FungibleTokenPacketDataV2) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketDataV2) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketDataV2) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketDataV2) GetForwarding() ForwardingPacketData {
+	if m != nil {
+		return m.Forwarding
+	}
+	return ForwardingPacketData{}
+}
+
+// ForwardingPacketData defines a list of port ID, channel ID pairs determining the path
+// through which a packet must be forwarded, and the memo for the final hop.
+type ForwardingPacketData struct {
+	// optional memo consumed by final destination chain
+	DestinationMemo string `protobuf:"bytes,1,opt,name=destination_memo,json=destinationMemo,proto3" json:"destination_memo,omitempty"`
+	// optional intermediate path through which packet will be forwarded.
+	Hops []Hop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+	// optional timeout in nanoseconds, relative to the block time of each intermediate
+	// chain, of the packets sent to the next hop. The default forwarding timeout is used
+	// when set to 0.
+	RelativeTimeout uint64 `protobuf:"varint,3,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+}
+
+func (m *ForwardingPacketData) Reset()         { *m = ForwardingPacketData{} }
+func (m *ForwardingPacketData) String() string { return proto.CompactTextString(m) }
+func (*ForwardingPacketData) ProtoMessage()    {}
+func (*ForwardingPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{2}
+}
+func (m *ForwardingPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingPacketData.Merge(m, src)
+}
+func (m *ForwardingPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingPacketData proto.InternalMessageInfo
+
+func (m *ForwardingPacketData) GetDestinationMemo() string {
+	if m != nil {
+		return m.DestinationMemo
+	}
+	return ""
+}
+
+func (m *ForwardingPacketData) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *ForwardingPacketData) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
+	proto.RegisterType((*FungibleTokenPacketDataV2)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketDataV2")
+	proto.RegisterType((*ForwardingPacketData)(nil), "ibc.applications.transfer.v2.ForwardingPacketData")
 }
 
 func init() {
@@ -115,23 +269,35 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4a, 0x34, 0x31,
-	0x14, 0x46, 0x27, 0xff, 0xbf, 0xbb, 0x68, 0xca, 0x20, 0x3a, 0x88, 0x04, 0xb1, 0xd2, 0xc2, 0x09,
-	0xac, 0x85, 0xd6, 0x22, 0xd6, 0x2a, 0x56, 0x76, 0x49, 0xe6, 0x3a, 0x86, 0x9d, 0xe4, 0x86, 0x24,
-	0x33, 0xe0, 0x53, 0xe8, 0x63, 0x59, 0x6e, 0x69, 0x29, 0x33, 0x2f, 0x22, 0x9b, 0x51, 0xd9, 0x2e,
-	0xe7, 0xe4, 0xbb, 0xcd, 0xa1, 0x67, 0x46, 0x69, 0x21, 0xbd, 0x6f, 0x8d, 0x96, 0xc9, 0xa0, 0x8b,
-	0x22, 0x05, 0xe9, 0xe2, 0x33, 0x04, 0xd1, 0x2f, 0x85, 0x97, 0x7a, 0x05, 0xa9, 0xf2, 0x01, 0x13,
-	0xb2, 0x23, 0xa3, 0x74, 0xb5, 0x3d, 0xad, 0x7e, 0xa7, 0x55, 0xbf, 0x3c, 0x79, 0x23, 0xf4, 0xe0,
-	0xb6, 0x73, 0x8d, 0x51, 0x2d, 0x3c, 0xe2, 0x0a, 0xdc, 0x5d, 0xbe, 0xbd, 0x91, 0x49, 0xb2, 0x3d,
-	0x3a, 0xaf, 0xc1, 0xa1, 0x2d, 0xc9, 0x31, 0x39, 0xdd, 0x7d, 0x98, 0x80, 0xed, 0xd3, 0x85, 0xb4,
-	0xd8, 0xb9, 0x54, 0xfe, 0xcb, 0xfa, 0x87, 0x36, 0x3e, 0x82, 0xab, 0x21, 0x94, 0xff, 0x27, 0x3f,
-	0x11, 0x3b, 0xa4, 0x3b, 0x01, 0x34, 0x98, 0x1e, 0x42, 0x39, 0xcb, 0x3f, 0x7f, 0xcc, 0x18, 0x9d,
-	0x59, 0xb0, 0x58, 0xce, 0xb3, 0xcf, 0xef, 0xeb, 0xfb, 0x8f, 0x81, 0x93, 0xf5, 0xc0, 0xc9, 0xd7,
-	0xc0, 0xc9, 0xfb, 0xc8, 0x8b, 0xf5, 0xc8, 0x8b, 0xcf, 0x91, 0x17, 0x4f, 0x97, 0x8d, 0x49, 0x2f,
-	0x9d, 0xaa, 0x34, 0x5a, 0xa1, 0x31, 0x5a, 0x8c, 0xc2, 0x28, 0x7d, 0xde, 0xa0, 0xe8, 0xaf, 0x84,
-	0xc5, 0xba, 0x6b, 0x21, 0x6e, 0xa2, 0x6c, 0xc5, 0x48, 0xaf, 0x1e, 0xa2, 0x5a, 0xe4, 0x12, 0x17,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0x3d, 0xc6, 0x36, 0x36, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x36, 0xab, 0xc0, 0x3b, 0x0c, 0x59, 0x15, 0x84, 0x0a, 0x85, 0x51, 0x2e, 0x9d,
+	0x10, 0xb1, 0x16, 0x0e, 0x20, 0x71, 0x62, 0x42, 0x13, 0x17, 0x24, 0xa8, 0x26, 0x84, 0xb8, 0x4c,
+	0x4e, 0xf2, 0x96, 0x59, 0xab, 0xfd, 0x22, 0xdb, 0x09, 0xe2, 0xc6, 0x37, 0x80, 0xaf, 0xc1, 0x37,
+	0xd9, 0x71, 0x47, 0x4e, 0x08, 0xb5, 0x5f, 0x04, 0xc5, 0x49, 0xd7, 0x1c, 0x68, 0x6e, 0x7e, 0xff,
+	0xfc, 0xfd, 0xf7, 0xef, 0xbd, 0x3c, 0x72, 0x24, 0x92, 0x94, 0xf1, 0xa2, 0x58, 0x8a, 0x94, 0x5b,
+	0x81, 0xca, 0x30, 0xab, 0xb9, 0x32, 0x17, 0xa0, 0x59, 0x15, 0xb3, 0x82, 0xa7, 0x57, 0x60, 0xa3,
+	0x42, 0xa3, 0x45, 0xfa, 0x48, 0x24, 0x69, 0xd4, 0xb5, 0x46, 0x1b, 0x6b, 0x54, 0xc5, 0xd3, 0x49,
+	0x8e, 0x39, 0x3a, 0x23, 0xab, 0x4f, 0xcd, 0x9d, 0xe9, 0xbc, 0x27, 0xfe, 0x98, 0x59, 0xbc, 0x02,
+	0xd5, 0x3a, 0x9f, 0xf5, 0x3b, 0x37, 0x2f, 0x39, 0xf3, 0xec, 0x87, 0x47, 0x1e, 0x9c, 0x96, 0x2a,
+	0x17, 0xc9, 0x12, 0xce, 0xea, 0x90, 0x0f, 0x0e, 0xf4, 0x2d, 0xb7, 0x9c, 0x4e, 0xc8, 0x5e, 0x06,
+	0x0a, 0x65, 0xe0, 0x1d, 0x7a, 0xf3, 0xbb, 0x8b, 0xa6, 0xa0, 0xf7, 0xc9, 0x98, 0x4b, 0x2c, 0x95,
+	0x0d, 0x86, 0x4e, 0x6e, 0xab, 0x5a, 0x37, 0xa0, 0x32, 0xd0, 0xc1, 0xa8, 0xd1, 0x9b, 0x8a, 0x4e,
+	0xc9, 0x1d, 0x0d, 0x29, 0x88, 0x0a, 0x74, 0xe0, 0xbb, 0x2f, 0xb7, 0x35, 0xa5, 0xc4, 0x97, 0x20,
+	0x31, 0xd8, 0x73, 0xba, 0x3b, 0xcf, 0xbe, 0x0f, 0xc9, 0xc3, 0x1d, 0x44, 0x9f, 0x62, 0xfa, 0x86,
+	0x8c, 0x5d, 0xaf, 0x26, 0xf0, 0x0e, 0x47, 0xf3, 0xfd, 0xf8, 0x69, 0xd4, 0x33, 0xcb, 0xe3, 0xc8,
+	0x05, 0x9c, 0xf8, 0xd7, 0x7f, 0x1e, 0x0f, 0x16, 0xed, 0xc5, 0x0e, 0xe8, 0x70, 0x27, 0xe8, 0x68,
+	0x07, 0xa8, 0xbf, 0x05, 0xa5, 0x9f, 0x09, 0xb9, 0x40, 0xfd, 0x95, 0xeb, 0x4c, 0xa8, 0xdc, 0xb5,
+	0xb0, 0x1f, 0xc7, 0x7d, 0x38, 0x71, 0x74, 0x7a, 0xeb, 0xdf, 0x36, 0xd5, 0xd2, 0x75, 0xb2, 0x66,
+	0xbf, 0x3c, 0x32, 0xf9, 0x9f, 0x95, 0x1e, 0x91, 0x7b, 0x19, 0x18, 0x2b, 0x94, 0xcb, 0x3e, 0x77,
+	0x48, 0xcd, 0xcf, 0x39, 0xe8, 0xe8, 0xef, 0x6b, 0xba, 0xd7, 0xc4, 0xbf, 0xc4, 0xc2, 0x04, 0x43,
+	0x37, 0xa6, 0x27, 0xfd, 0x63, 0x7a, 0x87, 0x45, 0x8b, 0xe1, 0x2e, 0xd5, 0xef, 0x68, 0x58, 0x72,
+	0x2b, 0x2a, 0x38, 0xb7, 0x42, 0x02, 0x96, 0xd6, 0x8d, 0xc4, 0x5f, 0x1c, 0x6c, 0xf4, 0xb3, 0x46,
+	0x3e, 0xf9, 0x78, 0xbd, 0x0a, 0xbd, 0x9b, 0x55, 0xe8, 0xfd, 0x5d, 0x85, 0xde, 0xcf, 0x75, 0x38,
+	0xb8, 0x59, 0x87, 0x83, 0xdf, 0xeb, 0x70, 0xf0, 0xe5, 0x65, 0x2e, 0xec, 0x65, 0x99, 0x44, 0x29,
+	0x4a, 0x96, 0xa2, 0x91, 0x68, 0x98, 0x48, 0xd2, 0xe7, 0x39, 0xb2, 0xea, 0x15, 0x93, 0x98, 0x95,
+	0x4b, 0x30, 0xf5, 0x9e, 0x76, 0xf6, 0xd3, 0x7e, 0x2b, 0xc0, 0x24, 0x63, 0xb7, 0x9a, 0x2f, 0xfe,
+	0x0d, 0x00, 0xb2, 0xc0, 0xf4, 0x9c, 0x52, 0x03, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -192,6 +358,123 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *FungibleTokenPacketDataV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FungibleTokenPacketDataV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FungibleTokenPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardingPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DestinationMemo) > 0 {
+		i -= len(m.DestinationMemo)
+		copy(dAtA[i:], m.DestinationMemo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DestinationMemo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -232,6 +515,57 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	return n
 }
 
+func (m *FungibleTokenPacketDataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.Forwarding.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func (m *ForwardingPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationMemo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovPacket(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -448,6 +782,354 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardingPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationMemo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationMemo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
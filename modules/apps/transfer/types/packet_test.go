@@ -47,6 +47,41 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 	}
 }
 
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "", types.ForwardingPacketData{}), true},
+		{"valid packet with multiple tokens", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount), types.NewToken("atom", largeAmount)}, sender, receiver, "memo", types.ForwardingPacketData{}), true},
+		{"empty tokens", types.NewFungibleTokenPacketDataV2(nil, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"duplicate denoms", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount), types.NewToken(denom, amount)}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid denom", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken("", amount)}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid zero amount", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, "0")}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"invalid large amount", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, invalidLargeAmount)}, sender, receiver, "", types.ForwardingPacketData{}), false},
+		{"missing sender address", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, emptyAddr, receiver, "", types.ForwardingPacketData{}), false},
+		{"missing recipient address", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, emptyAddr, "", types.ForwardingPacketData{}), false},
+		{"valid packet with forwarding", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "", types.NewForwardingPacketData("memo", types.NewHop(types.PortID, "channel-1"))), true},
+		{"memo set with forwarding", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "memo", types.NewForwardingPacketData("", types.NewHop(types.PortID, "channel-1"))), false},
+		{"destination memo set without forwarding hops", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "", types.NewForwardingPacketData("memo")), false},
+		{"relative timeout set without forwarding hops", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "", types.ForwardingPacketData{RelativeTimeout: 1}), false},
+		{"invalid forwarding hop", types.NewFungibleTokenPacketDataV2([]types.Token{types.NewToken(denom, amount)}, sender, receiver, "", types.NewForwardingPacketData("", types.NewHop(types.PortID, "invalid channel"))), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestGetPacketSender() {
 	packetData := types.FungibleTokenPacketData{
 		Denom:    denom,
@@ -596,6 +596,98 @@ func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryTransferStatusesRequest is the request type for TransferStatuses RPC method.
+type QueryTransferStatusesRequest struct {
+}
+
+func (m *QueryTransferStatusesRequest) Reset()         { *m = QueryTransferStatusesRequest{} }
+func (m *QueryTransferStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferStatusesRequest) ProtoMessage()    {}
+func (*QueryTransferStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{12}
+}
+func (m *QueryTransferStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferStatusesRequest.Merge(m, src)
+}
+func (m *QueryTransferStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferStatusesRequest proto.InternalMessageInfo
+
+// QueryTransferStatusesResponse is the response type for TransferStatuses RPC method.
+type QueryTransferStatusesResponse struct {
+	// channel_statuses contains the transfer statuses of channels
+	ChannelStatuses []ChannelTransferStatus `protobuf:"bytes,1,rep,name=channel_statuses,json=channelStatuses,proto3" json:"channel_statuses"`
+	// denom_statuses contains the transfer statuses of denominations
+	DenomStatuses []DenomTransferStatus `protobuf:"bytes,2,rep,name=denom_statuses,json=denomStatuses,proto3" json:"denom_statuses"`
+}
+
+func (m *QueryTransferStatusesResponse) Reset()         { *m = QueryTransferStatusesResponse{} }
+func (m *QueryTransferStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferStatusesResponse) ProtoMessage()    {}
+func (*QueryTransferStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{13}
+}
+func (m *QueryTransferStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferStatusesResponse.Merge(m, src)
+}
+func (m *QueryTransferStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryTransferStatusesResponse) GetChannelStatuses() []ChannelTransferStatus {
+	if m != nil {
+		return m.ChannelStatuses
+	}
+	return nil
+}
+
+func (m *QueryTransferStatusesResponse) GetDenomStatuses() []DenomTransferStatus {
+	if m != nil {
+		return m.DenomStatuses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
 	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
@@ -609,6 +701,8 @@ func init() {
 	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
 	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
 	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+	proto.RegisterType((*QueryTransferStatusesRequest)(nil), "ibc.applications.transfer.v1.QueryTransferStatusesRequest")
+	proto.RegisterType((*QueryTransferStatusesResponse)(nil), "ibc.applications.transfer.v1.QueryTransferStatusesResponse")
 }
 
 func init() {
@@ -616,59 +710,66 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x29, 0xa4, 0xcd, 0x4b, 0xe1, 0x30, 0xd0, 0x02, 0x16, 0x35, 0xc8, 0xa2, 0x2d, 0x4a,
-	0xc1, 0xd3, 0x40, 0x20, 0x1c, 0xa0, 0x52, 0xa1, 0xa5, 0xa5, 0xea, 0x01, 0x02, 0xa7, 0x72, 0x88,
-	0x26, 0xf6, 0xd4, 0xb1, 0x94, 0x78, 0x8c, 0xc7, 0x49, 0x85, 0x22, 0x2e, 0xfd, 0x04, 0x95, 0xf8,
-	0x12, 0x55, 0xa5, 0x6a, 0xbf, 0xc2, 0x1e, 0x39, 0xa2, 0x5d, 0x69, 0xc5, 0x69, 0x77, 0x05, 0xfb,
-	0x41, 0x56, 0x1e, 0x8f, 0x13, 0x7b, 0x09, 0x21, 0xd9, 0x53, 0x3c, 0xf3, 0xfe, 0xfd, 0x7e, 0xbf,
-	0x37, 0xef, 0x29, 0xb0, 0xe2, 0xd4, 0x4c, 0x4c, 0x3c, 0xaf, 0xe1, 0x98, 0x24, 0x70, 0x98, 0xcb,
-	0x71, 0xe0, 0x13, 0x97, 0xff, 0x49, 0x7d, 0xdc, 0x2e, 0xe2, 0xf3, 0x16, 0xf5, 0x2f, 0x0c, 0xcf,
-	0x67, 0x01, 0x43, 0x0b, 0x4e, 0xcd, 0x34, 0x92, 0x9e, 0x46, 0xec, 0x69, 0xb4, 0x8b, 0xea, 0x8c,
-	0xcd, 0x6c, 0x26, 0x1c, 0x71, 0xf8, 0x15, 0xc5, 0xa8, 0x9a, 0xc9, 0x78, 0x93, 0x71, 0x5c, 0x23,
-	0x9c, 0xe2, 0x76, 0xb1, 0x46, 0x03, 0x52, 0xc4, 0x26, 0x73, 0x5c, 0x69, 0x2f, 0x24, 0xed, 0xa2,
-	0x58, 0xd7, 0xcb, 0x23, 0xb6, 0xe3, 0x8a, 0x42, 0xd2, 0xf7, 0xbb, 0x81, 0x48, 0xbb, 0x58, 0x22,
-	0xe7, 0x05, 0x9b, 0x31, 0xbb, 0x41, 0x31, 0xf1, 0x1c, 0x4c, 0x5c, 0x97, 0x05, 0x12, 0xb2, 0xb0,
-	0xea, 0xab, 0xf0, 0xe5, 0x71, 0x58, 0xec, 0x27, 0xea, 0xb2, 0xe6, 0xa9, 0x4f, 0x4c, 0x5a, 0xa1,
-	0xe7, 0x2d, 0xca, 0x03, 0x84, 0x60, 0xbc, 0x4e, 0x78, 0x7d, 0x4e, 0x59, 0x52, 0x56, 0x72, 0x15,
-	0xf1, 0xad, 0x5b, 0x30, 0xfb, 0xc0, 0x9b, 0x7b, 0xcc, 0xe5, 0x14, 0x1d, 0x42, 0xde, 0x0a, 0x6f,
-	0xab, 0x41, 0x78, 0x2d, 0xa2, 0xf2, 0xeb, 0x2b, 0xc6, 0x20, 0xa5, 0x8c, 0x44, 0x1a, 0xb0, 0xba,
-	0xdf, 0x3a, 0x79, 0x50, 0x85, 0xc7, 0xa0, 0x0e, 0x00, 0x7a, 0x6a, 0xc8, 0x22, 0xdf, 0x18, 0x91,
-	0x74, 0x46, 0x28, 0x9d, 0x11, 0xf5, 0x49, 0x4a, 0x67, 0x1c, 0x11, 0x3b, 0x26, 0x54, 0x49, 0x44,
-	0xea, 0xcf, 0x15, 0x98, 0x7b, 0x58, 0x43, 0x52, 0x39, 0x83, 0xcf, 0x13, 0x54, 0xf8, 0x9c, 0xb2,
-	0xf4, 0xc9, 0x28, 0x5c, 0xf6, 0xa6, 0xae, 0x5f, 0x2f, 0x66, 0xfe, 0x7b, 0xb3, 0x98, 0x95, 0x79,
-	0xf3, 0x3d, 0x6e, 0x1c, 0xfd, 0x92, 0x62, 0x30, 0x26, 0x18, 0x7c, 0xfb, 0x24, 0x83, 0x08, 0x59,
-	0x8a, 0xc2, 0x0c, 0x20, 0xc1, 0xe0, 0x88, 0xf8, 0xa4, 0x19, 0x0b, 0xa4, 0x9f, 0xc0, 0x74, 0xea,
-	0x56, 0x52, 0xda, 0x81, 0xac, 0x27, 0x6e, 0xa4, 0x66, 0xcb, 0x83, 0xc9, 0xc8, 0x68, 0x19, 0xa3,
-	0xaf, 0xc1, 0x17, 0x3d, 0xb1, 0x7e, 0x25, 0xbc, 0x1e, 0xb7, 0x63, 0x06, 0x26, 0x7a, 0xed, 0xce,
-	0x55, 0xa2, 0x43, 0xfa, 0x4d, 0x45, 0xee, 0x12, 0x46, 0xbf, 0x37, 0x75, 0x02, 0xf3, 0xc2, 0xfb,
-	0x67, 0x6e, 0xfa, 0xec, 0xaf, 0x1f, 0x2d, 0xcb, 0xa7, 0xbc, 0xdb, 0xef, 0x59, 0xf8, 0xd4, 0x63,
-	0x7e, 0x50, 0x75, 0x2c, 0x19, 0x93, 0x0d, 0x8f, 0x87, 0x16, 0xfa, 0x0a, 0xc0, 0xac, 0x13, 0xd7,
-	0xa5, 0x8d, 0xd0, 0x36, 0x26, 0x6c, 0x39, 0x79, 0x73, 0x68, 0xe9, 0xfb, 0xa0, 0xf6, 0x4b, 0x2a,
-	0x61, 0x7c, 0x0d, 0x53, 0x54, 0x18, 0xaa, 0x24, 0xb2, 0xc8, 0xe4, 0x93, 0x34, 0xe9, 0xae, 0x97,
-	0x61, 0x51, 0x24, 0x39, 0x65, 0x01, 0x69, 0x44, 0x99, 0x0e, 0x98, 0x2f, 0x58, 0x25, 0x04, 0x10,
-	0xcd, 0x8d, 0x05, 0x10, 0x07, 0xfd, 0x0c, 0x96, 0x1e, 0x0f, 0x94, 0x18, 0xca, 0x90, 0x25, 0x4d,
-	0xd6, 0x72, 0x03, 0xd9, 0x91, 0xf9, 0xd4, 0x1b, 0x88, 0xbb, 0xbf, 0xcf, 0x1c, 0x77, 0x6f, 0x3c,
-	0x7c, 0x4f, 0x15, 0xe9, 0xbe, 0x7e, 0xfb, 0x19, 0x4c, 0x88, 0xec, 0xe8, 0x5f, 0x05, 0xf2, 0x89,
-	0xf7, 0x8b, 0x36, 0x07, 0x37, 0xf5, 0x91, 0x99, 0x52, 0xb7, 0x46, 0x0d, 0x8b, 0x18, 0xe8, 0x85,
-	0xbf, 0x5f, 0xbe, 0xbb, 0x1a, 0x5b, 0x46, 0x3a, 0x96, 0xeb, 0x28, 0xbd, 0x86, 0x92, 0x23, 0x84,
-	0x9e, 0x29, 0x00, 0xbd, 0x1c, 0xa8, 0x34, 0x52, 0xc9, 0x18, 0xe8, 0xe6, 0x88, 0x51, 0x12, 0x67,
-	0x49, 0xe0, 0x34, 0xd0, 0xea, 0xd3, 0x38, 0x71, 0x27, 0x7c, 0x92, 0xbb, 0x85, 0xc2, 0x25, 0xba,
-	0x52, 0x20, 0x1b, 0x8d, 0x01, 0xfa, 0x7e, 0x88, 0xba, 0xa9, 0x29, 0x54, 0x8b, 0x23, 0x44, 0x48,
-	0x94, 0xcb, 0x02, 0xa5, 0x86, 0x16, 0xfa, 0xa3, 0x8c, 0x26, 0x11, 0xfd, 0xaf, 0x40, 0xae, 0x3b,
-	0x56, 0x68, 0x63, 0x58, 0x41, 0x12, 0x33, 0xab, 0x96, 0x46, 0x0b, 0x92, 0xf0, 0x36, 0x05, 0x3c,
-	0x8c, 0xd6, 0x06, 0x89, 0x18, 0x8a, 0x17, 0x8a, 0x28, 0xc4, 0x14, 0x2a, 0xbe, 0x52, 0x60, 0x32,
-	0x35, 0x83, 0xa8, 0x3c, 0x44, 0xf9, 0x7e, 0xab, 0x40, 0xdd, 0x1e, 0x3d, 0x50, 0x62, 0xaf, 0x08,
-	0xec, 0xbf, 0xa3, 0xdf, 0xfa, 0x63, 0x97, 0x5b, 0x83, 0xe3, 0x4e, 0x6f, 0xa3, 0x5c, 0xe2, 0x70,
-	0xcf, 0x70, 0xdc, 0x91, 0xdb, 0xe7, 0x12, 0xa7, 0x17, 0x06, 0x7a, 0xa1, 0xc0, 0x74, 0x9f, 0xf1,
-	0x46, 0xbb, 0x43, 0xa0, 0x7c, 0x7c, 0x9f, 0xa8, 0x3f, 0x7c, 0x6c, 0xb8, 0xa4, 0xba, 0x23, 0xa8,
-	0x6e, 0xa1, 0xd2, 0x80, 0x36, 0x71, 0xdc, 0x11, 0xbf, 0x61, 0x83, 0x70, 0x10, 0x26, 0xab, 0x46,
-	0xe4, 0xf6, 0x8e, 0xaf, 0xef, 0x34, 0xe5, 0xe6, 0x4e, 0x53, 0xde, 0xde, 0x69, 0xca, 0x3f, 0xf7,
-	0x5a, 0xe6, 0xe6, 0x5e, 0xcb, 0xdc, 0xde, 0x6b, 0x99, 0x3f, 0xca, 0xb6, 0x13, 0xd4, 0x5b, 0x35,
-	0xc3, 0x64, 0x4d, 0x2c, 0xff, 0xa8, 0x38, 0x35, 0x73, 0xcd, 0x66, 0xb8, 0xbd, 0x8d, 0x9b, 0xcc,
-	0x6a, 0x35, 0x28, 0xff, 0xa0, 0x5c, 0x70, 0xe1, 0x51, 0x5e, 0xcb, 0x8a, 0xbf, 0x19, 0x1b, 0xef,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x97, 0xb6, 0x42, 0x5d, 0x09, 0x00, 0x00,
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x8e, 0x43, 0xbb, 0x28, 0x6f, 0x48, 0xa8, 0xa6, 0x81, 0xa6, 0x56, 0xea, 0x44, 0x56, 0xa0,
+	0x21, 0x34, 0x1e, 0xb6, 0x49, 0x9a, 0x0a, 0x5a, 0x24, 0x12, 0x28, 0x04, 0x71, 0x68, 0x37, 0x3d,
+	0x51, 0x89, 0x68, 0xd6, 0x1e, 0xbc, 0x96, 0x76, 0x3d, 0xae, 0xc7, 0xbb, 0xa8, 0x5a, 0xe5, 0xc2,
+	0x2f, 0x40, 0xea, 0x81, 0xbf, 0x80, 0x90, 0x10, 0x7f, 0x80, 0x03, 0xc7, 0x1e, 0x2b, 0x90, 0x10,
+	0x27, 0x40, 0x09, 0xfc, 0x0f, 0xe4, 0x99, 0xd7, 0x5e, 0xbb, 0xeb, 0xdd, 0xee, 0xe6, 0xb4, 0xe3,
+	0x99, 0xf7, 0xe3, 0x79, 0x9e, 0x77, 0xe6, 0xd1, 0xc2, 0x46, 0xd0, 0x74, 0x29, 0x8b, 0xa2, 0x76,
+	0xe0, 0xb2, 0x24, 0x10, 0xa1, 0xa4, 0x49, 0xcc, 0x42, 0xf9, 0x35, 0x8f, 0x69, 0xaf, 0x4e, 0x1f,
+	0x77, 0x79, 0xfc, 0xc4, 0x89, 0x62, 0x91, 0x08, 0xb2, 0x12, 0x34, 0x5d, 0xa7, 0x18, 0xe9, 0x64,
+	0x91, 0x4e, 0xaf, 0x6e, 0x2e, 0xf9, 0xc2, 0x17, 0x2a, 0x90, 0xa6, 0x2b, 0x9d, 0x63, 0x5a, 0xae,
+	0x90, 0x1d, 0x21, 0x69, 0x93, 0x49, 0x4e, 0x7b, 0xf5, 0x26, 0x4f, 0x58, 0x9d, 0xba, 0x22, 0x08,
+	0xf1, 0x7c, 0xb3, 0x78, 0xae, 0x9a, 0xe5, 0x51, 0x11, 0xf3, 0x83, 0x50, 0x35, 0xc2, 0xd8, 0x77,
+	0xc7, 0x22, 0xcd, 0xb1, 0xe8, 0xe0, 0x15, 0x5f, 0x08, 0xbf, 0xcd, 0x29, 0x8b, 0x02, 0xca, 0xc2,
+	0x50, 0x24, 0x08, 0x59, 0x9d, 0xda, 0x37, 0xe0, 0xcd, 0x07, 0x69, 0xb3, 0x8f, 0x79, 0x28, 0x3a,
+	0x0f, 0x63, 0xe6, 0xf2, 0x06, 0x7f, 0xdc, 0xe5, 0x32, 0x21, 0x04, 0x2e, 0xb4, 0x98, 0x6c, 0x2d,
+	0x1b, 0x6b, 0xc6, 0xc6, 0x5c, 0x43, 0xad, 0x6d, 0x0f, 0xae, 0x0c, 0x45, 0xcb, 0x48, 0x84, 0x92,
+	0x93, 0x43, 0x98, 0xf7, 0xd2, 0xdd, 0xe3, 0x24, 0xdd, 0x56, 0x59, 0xf3, 0x37, 0x37, 0x9c, 0x71,
+	0x4a, 0x39, 0x85, 0x32, 0xe0, 0xe5, 0x6b, 0x9b, 0x0d, 0x75, 0x91, 0x19, 0xa8, 0x7b, 0x00, 0x03,
+	0x35, 0xb0, 0xc9, 0xdb, 0x8e, 0x96, 0xce, 0x49, 0xa5, 0x73, 0xf4, 0x9c, 0x50, 0x3a, 0xe7, 0x3e,
+	0xf3, 0x33, 0x42, 0x8d, 0x42, 0xa6, 0xfd, 0xab, 0x01, 0xcb, 0xc3, 0x3d, 0x90, 0xca, 0x23, 0x78,
+	0xad, 0x40, 0x45, 0x2e, 0x1b, 0x6b, 0xaf, 0x4c, 0xc3, 0x65, 0x7f, 0xf1, 0xd9, 0x5f, 0xab, 0x33,
+	0x3f, 0xfe, 0xbd, 0x5a, 0xc3, 0xba, 0xf3, 0x03, 0x6e, 0x92, 0x7c, 0x5a, 0x62, 0x30, 0xab, 0x18,
+	0x5c, 0x7f, 0x29, 0x03, 0x8d, 0xac, 0x44, 0x61, 0x09, 0x88, 0x62, 0x70, 0x9f, 0xc5, 0xac, 0x93,
+	0x09, 0x64, 0x1f, 0xc1, 0xe5, 0xd2, 0x2e, 0x52, 0xba, 0x03, 0xb5, 0x48, 0xed, 0xa0, 0x66, 0xeb,
+	0xe3, 0xc9, 0x60, 0x36, 0xe6, 0xd8, 0x5b, 0xf0, 0xc6, 0x40, 0xac, 0xcf, 0x98, 0x6c, 0x65, 0xe3,
+	0x58, 0x82, 0x8b, 0x83, 0x71, 0xcf, 0x35, 0xf4, 0x47, 0xf9, 0x4e, 0xe9, 0x70, 0x84, 0x51, 0x75,
+	0xa7, 0x8e, 0xe0, 0xaa, 0x8a, 0xfe, 0x44, 0xba, 0xb1, 0xf8, 0xe6, 0x23, 0xcf, 0x8b, 0xb9, 0xcc,
+	0xe7, 0x7d, 0x05, 0x5e, 0x8d, 0x44, 0x9c, 0x1c, 0x07, 0x1e, 0xe6, 0xd4, 0xd2, 0xcf, 0x43, 0x8f,
+	0x5c, 0x03, 0x70, 0x5b, 0x2c, 0x0c, 0x79, 0x3b, 0x3d, 0x9b, 0x55, 0x67, 0x73, 0xb8, 0x73, 0xe8,
+	0xd9, 0x07, 0x60, 0x56, 0x15, 0x45, 0x18, 0x6f, 0xc1, 0x22, 0x57, 0x07, 0xc7, 0x4c, 0x9f, 0x60,
+	0xf1, 0x05, 0x5e, 0x0c, 0xb7, 0xf7, 0x60, 0x55, 0x15, 0x79, 0x28, 0x12, 0xd6, 0xd6, 0x95, 0xee,
+	0x89, 0x58, 0xb1, 0x2a, 0x08, 0xa0, 0x86, 0x9b, 0x09, 0xa0, 0x3e, 0xec, 0x47, 0xb0, 0x36, 0x3a,
+	0x11, 0x31, 0xec, 0x41, 0x8d, 0x75, 0x44, 0x37, 0x4c, 0x70, 0x22, 0x57, 0x4b, 0x77, 0x20, 0x9b,
+	0xfe, 0x81, 0x08, 0xc2, 0xfd, 0x0b, 0xe9, 0x7d, 0x6a, 0x60, 0xb8, 0x6d, 0xc1, 0x8a, 0x2e, 0x8e,
+	0xf3, 0x3a, 0x4a, 0x58, 0xd2, 0x95, 0xf9, 0x13, 0xb1, 0xff, 0x33, 0xe0, 0xda, 0x88, 0x00, 0x6c,
+	0xed, 0xc1, 0xa5, 0x4c, 0x3b, 0x89, 0x67, 0x78, 0xc7, 0xb7, 0xc7, 0x5f, 0x8b, 0x03, 0x9d, 0x55,
+	0x2e, 0x8c, 0xf0, 0x5e, 0xc7, 0x92, 0x59, 0x37, 0xf2, 0x15, 0x2c, 0xea, 0x57, 0x94, 0xf7, 0x98,
+	0x55, 0x3d, 0xea, 0x93, 0xbd, 0xa3, 0xe1, 0x0e, 0x0b, 0xaa, 0x5c, 0x56, 0xff, 0xe6, 0xf7, 0x00,
+	0x17, 0x15, 0x4f, 0xf2, 0x83, 0x01, 0xf3, 0x85, 0x77, 0x4c, 0x76, 0xc7, 0x77, 0x18, 0xe1, 0x2d,
+	0xe6, 0xad, 0x69, 0xd3, 0xb4, 0x9c, 0xf6, 0xe6, 0xb7, 0xbf, 0xff, 0xfb, 0x74, 0x76, 0x9d, 0xd8,
+	0x14, 0x6d, 0xb9, 0x6c, 0xc7, 0x45, 0x2b, 0x21, 0x3f, 0x1b, 0x00, 0x83, 0x1a, 0x64, 0x67, 0xaa,
+	0x96, 0x19, 0xd0, 0xdd, 0x29, 0xb3, 0x10, 0xe7, 0x8e, 0xc2, 0xe9, 0x90, 0x1b, 0x2f, 0xc7, 0x49,
+	0xfb, 0xe9, 0xd3, 0xbc, 0xbb, 0xb9, 0x79, 0x42, 0x9e, 0x1a, 0x50, 0xd3, 0x76, 0x40, 0xde, 0x9b,
+	0xa0, 0x6f, 0xc9, 0x8d, 0xcc, 0xfa, 0x14, 0x19, 0x88, 0x72, 0x5d, 0xa1, 0xb4, 0xc8, 0x4a, 0x35,
+	0x4a, 0xed, 0x48, 0xe4, 0x27, 0x03, 0xe6, 0x72, 0x7b, 0x21, 0xdb, 0x93, 0x0a, 0x52, 0xf0, 0x2e,
+	0x73, 0x67, 0xba, 0x24, 0x84, 0xb7, 0xab, 0xe0, 0x51, 0xb2, 0x35, 0x4e, 0xc4, 0x54, 0xbc, 0x54,
+	0x44, 0x25, 0xa6, 0x52, 0xf1, 0x0f, 0x03, 0x16, 0x4a, 0x5e, 0x44, 0xf6, 0x26, 0x68, 0x5f, 0x65,
+	0x89, 0xe6, 0xed, 0xe9, 0x13, 0x11, 0x7b, 0x43, 0x61, 0xff, 0x82, 0x7c, 0x5e, 0x8d, 0x1d, 0x1f,
+	0xb0, 0xa4, 0xfd, 0x81, 0xb3, 0x9e, 0xd0, 0xd4, 0x6f, 0x25, 0xed, 0xa3, 0x0b, 0x9f, 0xd0, 0xb2,
+	0x71, 0x92, 0xdf, 0x0c, 0xb8, 0x5c, 0x61, 0x73, 0xe4, 0xee, 0x04, 0x28, 0x47, 0xfb, 0xaa, 0xf9,
+	0xe1, 0x79, 0xd3, 0x91, 0xea, 0x1d, 0x45, 0xf5, 0x16, 0xd9, 0x19, 0x33, 0x26, 0x49, 0xfb, 0xea,
+	0x37, 0x1d, 0x10, 0x4d, 0xd2, 0x62, 0xc7, 0x9a, 0x1c, 0xf9, 0xc5, 0x80, 0x4b, 0x2f, 0xba, 0x27,
+	0x79, 0x7f, 0x12, 0x48, 0xd5, 0x9e, 0x6c, 0x7e, 0x70, 0xae, 0x5c, 0xe4, 0x42, 0x15, 0x97, 0x77,
+	0xc8, 0xf5, 0x6a, 0x2e, 0xd9, 0x3a, 0xf7, 0xd9, 0xfd, 0x07, 0xcf, 0x4e, 0x2d, 0xe3, 0xf9, 0xa9,
+	0x65, 0xfc, 0x73, 0x6a, 0x19, 0xdf, 0x9d, 0x59, 0x33, 0xcf, 0xcf, 0xac, 0x99, 0x3f, 0xcf, 0xac,
+	0x99, 0x2f, 0xf7, 0xfc, 0x20, 0x69, 0x75, 0x9b, 0x8e, 0x2b, 0x3a, 0x14, 0xff, 0x6f, 0x06, 0x4d,
+	0x77, 0xcb, 0x17, 0xb4, 0x77, 0x9b, 0x76, 0x84, 0xd7, 0x6d, 0x73, 0xf9, 0x42, 0x87, 0xe4, 0x49,
+	0xc4, 0x65, 0xb3, 0xa6, 0xfe, 0x2d, 0x6e, 0xff, 0x3f, 0x00, 0xce, 0xa3, 0x0f, 0xac, 0x24, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -695,6 +796,8 @@ type QueryClient interface {
 	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferStatuses returns the transfer statuses of channels and denominations.
+	TransferStatuses(ctx context.Context, in *QueryTransferStatusesRequest, opts ...grpc.CallOption) (*QueryTransferStatusesResponse, error)
 }
 
 type queryClient struct {
@@ -759,6 +862,15 @@ func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEsc
 	return out, nil
 }
 
+func (c *queryClient) TransferStatuses(ctx context.Context, in *QueryTransferStatusesRequest, opts ...grpc.CallOption) (*QueryTransferStatusesResponse, error) {
+	out := new(QueryTransferStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TransferStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// DenomTraces queries all denomination traces.
@@ -773,6 +885,8 @@ type QueryServer interface {
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+	// TransferStatuses returns the transfer statuses of channels and denominations.
+	TransferStatuses(context.Context, *QueryTransferStatusesRequest) (*QueryTransferStatusesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -797,6 +911,9 @@ func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEs
 func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
 }
+func (*UnimplementedQueryServer) TransferStatuses(ctx context.Context, req *QueryTransferStatusesRequest) (*QueryTransferStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStatuses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -910,6 +1027,24 @@ func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TransferStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TransferStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferStatuses(ctx, req.(*QueryTransferStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -938,6 +1073,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalEscrowForDenom",
 			Handler:    _Query_TotalEscrowForDenom_Handler,
 		},
+		{
+			MethodName: "TransferStatuses",
+			Handler:    _Query_TransferStatuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -1340,6 +1479,80 @@ func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTransferStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomStatuses) > 0 {
+		for iNdEx := len(m.DenomStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelStatuses) > 0 {
+		for iNdEx := len(m.ChannelStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1511,6 +1724,36 @@ func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTransferStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTransferStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelStatuses) > 0 {
+		for _, e := range m.ChannelStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomStatuses) > 0 {
+		for _, e := range m.DenomStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2552,6 +2795,174 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTransferStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStatuses = append(m.ChannelStatuses, ChannelTransferStatus{})
+			if err := m.ChannelStatuses[len(m.ChannelStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomStatuses = append(m.DenomStatuses, DenomTransferStatus{})
+			if err := m.DenomStatuses[len(m.DenomStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewToken constructs a new Token instance
+func NewToken(denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate validates a token denomination and amount.
+func (t Token) Validate() error {
+	amount, ok := sdkmath.NewIntFromString(t.Amount)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// ToCoin converts a Token to an sdk.Coin using the local representation of the
+// token's denomination. The denomination is the IBC denom hash if the token is
+// not native to this chain.
+func (t Token) ToCoin() (sdk.Coin, error) {
+	transferAmount, ok := sdkmath.NewIntFromString(t.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+
+	return sdk.NewCoin(ParseDenomTrace(t.Denom).IBCDenom(), transferAmount), nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -139,9 +140,259 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+// Forwarding defines a list of port ID, channel ID pairs determining the path
+// through which a packet must be forwarded before reaching its final destination.
+type Forwarding struct {
+	// optional intermediate path through which packet will be forwarded
+	Hops []Hop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	// optional timeout in nanoseconds, relative to the block time of each intermediate
+	// chain, of the packets sent to the next hop. The default forwarding timeout is used
+	// when set to 0.
+	RelativeTimeout uint64 `protobuf:"varint,2,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+}
+
+func (m *Forwarding) Reset()         { *m = Forwarding{} }
+func (m *Forwarding) String() string { return proto.CompactTextString(m) }
+func (*Forwarding) ProtoMessage()    {}
+func (*Forwarding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *Forwarding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Forwarding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Forwarding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Forwarding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Forwarding.Merge(m, src)
+}
+func (m *Forwarding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Forwarding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Forwarding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Forwarding proto.InternalMessageInfo
+
+func (m *Forwarding) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *Forwarding) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+// Hop defines a port ID, channel ID pair specifying where tokens must be forwarded
+// next in a multihop transfer.
+type Hop struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(m, src)
+}
+func (m *Hop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *Hop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// ChannelTransferStatus defines whether cross-chain token transfers are enabled
+// over a channel. Transfers over a channel without a status are enabled, subject
+// to the module parameters.
+type ChannelTransferStatus struct {
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send_enabled enables or disables token transfers from this chain over the channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables token transfers to this chain over the channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *ChannelTransferStatus) Reset()         { *m = ChannelTransferStatus{} }
+func (m *ChannelTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferStatus) ProtoMessage()    {}
+func (*ChannelTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *ChannelTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferStatus.Merge(m, src)
+}
+func (m *ChannelTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransferStatus proto.InternalMessageInfo
+
+func (m *ChannelTransferStatus) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelTransferStatus) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelTransferStatus) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+// DenomTransferStatus defines whether cross-chain token transfers are enabled
+// for a denomination. The denomination is either a base denomination, applying
+// to every token with that base denomination, or an IBC denomination of the form
+// ibc/{hash}. Transfers of a denomination without a status are enabled, subject
+// to the module parameters.
+type DenomTransferStatus struct {
+	// base denomination or IBC denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// send_enabled enables or disables token transfers of the denomination from this chain.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables token transfers of the denomination to this chain.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+}
+
+func (m *DenomTransferStatus) Reset()         { *m = DenomTransferStatus{} }
+func (m *DenomTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferStatus) ProtoMessage()    {}
+func (*DenomTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{5}
+}
+func (m *DenomTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferStatus.Merge(m, src)
+}
+func (m *DenomTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferStatus proto.InternalMessageInfo
+
+func (m *DenomTransferStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTransferStatus) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *DenomTransferStatus) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*Forwarding)(nil), "ibc.applications.transfer.v1.Forwarding")
+	proto.RegisterType((*Hop)(nil), "ibc.applications.transfer.v1.Hop")
+	proto.RegisterType((*ChannelTransferStatus)(nil), "ibc.applications.transfer.v1.ChannelTransferStatus")
+	proto.RegisterType((*DenomTransferStatus)(nil), "ibc.applications.transfer.v1.DenomTransferStatus")
 }
 
 func init() {
@@ -149,23 +400,34 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0x87, 0x9b, 0x22, 0xc5, 0x46, 0x51, 0xc8, 0xd4, 0x41, 0x83, 0x76, 0x51, 0x10, 0x2f, 0x14,
-	0x07, 0xdd, 0x04, 0xd1, 0x5d, 0x4b, 0x27, 0x97, 0x92, 0xe4, 0x5e, 0xdb, 0xc0, 0xe5, 0x0f, 0x79,
-	0xd3, 0x03, 0xbf, 0x85, 0x1f, 0xcb, 0xb1, 0xa3, 0xa3, 0xdc, 0x7d, 0x11, 0xb9, 0x58, 0x8e, 0x6e,
-	0x3f, 0x9e, 0x3c, 0x79, 0x87, 0x87, 0xde, 0x18, 0xa5, 0x85, 0x0c, 0xa1, 0x32, 0x5a, 0x26, 0xe3,
-	0x1d, 0x8a, 0x14, 0xa5, 0xc3, 0x0f, 0x88, 0xa2, 0x9e, 0xf5, 0xbb, 0x08, 0xd1, 0x27, 0xcf, 0xce,
-	0x8c, 0xd2, 0xc5, 0xbe, 0x5c, 0xf4, 0x42, 0x3d, 0x9b, 0x3e, 0x52, 0xfa, 0x0c, 0xce, 0xdb, 0x45,
-	0x94, 0x1a, 0x18, 0xa3, 0x07, 0x41, 0xa6, 0xf5, 0x84, 0x5c, 0x90, 0xeb, 0xf1, 0x3c, 0x6f, 0x76,
-	0x4e, 0xa9, 0x92, 0x08, 0xcb, 0xb2, 0xd3, 0x26, 0xc3, 0xfc, 0x32, 0xee, 0x48, 0xfe, 0x37, 0x5d,
-	0xd0, 0xd1, 0xab, 0x8c, 0xd2, 0x22, 0xbb, 0xa4, 0xc7, 0x08, 0xae, 0x5c, 0x82, 0x93, 0xaa, 0x82,
-	0x32, 0x1f, 0x39, 0x9c, 0x1f, 0x75, 0xec, 0xe5, 0x1f, 0xb1, 0x2b, 0x7a, 0x1a, 0x41, 0x83, 0xa9,
-	0xa1, 0xb7, 0x86, 0xd9, 0x3a, 0xd9, 0xe1, 0x9d, 0xf8, 0xf4, 0xf6, 0xdd, 0x70, 0xb2, 0x6d, 0x38,
-	0xf9, 0x6d, 0x38, 0xf9, 0x6a, 0xf9, 0x60, 0xdb, 0xf2, 0xc1, 0x4f, 0xcb, 0x07, 0xef, 0xf7, 0x2b,
-	0x93, 0xd6, 0x1b, 0x55, 0x68, 0x6f, 0x85, 0xf6, 0x68, 0x3d, 0x0a, 0xa3, 0xf4, 0xed, 0xca, 0x8b,
-	0xfa, 0x41, 0x58, 0x5f, 0x6e, 0x2a, 0xc0, 0xae, 0xcd, 0x5e, 0x93, 0xf4, 0x19, 0x00, 0xd5, 0x28,
-	0xe7, 0xb8, 0xfb, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x24, 0x39, 0xe1, 0x5f, 0x3d, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0xcd, 0x26, 0x21, 0xd0, 0x29, 0xa2, 0xc8, 0x14, 0x11, 0x21, 0x58, 0xda, 0xbd, 0x50, 0x84,
+	0x58, 0xab, 0x70, 0x00, 0x09, 0x21, 0xa4, 0xf2, 0xa1, 0xf6, 0x06, 0x21, 0x27, 0x2e, 0x91, 0xd7,
+	0x36, 0x1b, 0x4b, 0xbb, 0x1e, 0xcb, 0x76, 0x52, 0x71, 0xe5, 0x17, 0xf0, 0xb3, 0x7a, 0xec, 0x91,
+	0x13, 0x42, 0xc9, 0x1f, 0x41, 0xf6, 0x6e, 0xa3, 0x28, 0x20, 0xc4, 0xa1, 0xb7, 0x99, 0xe7, 0xf7,
+	0xf6, 0xcd, 0xbe, 0x19, 0x78, 0xac, 0x0a, 0x4e, 0x99, 0x31, 0x95, 0xe2, 0xcc, 0x2b, 0xd4, 0x8e,
+	0x7a, 0xcb, 0xb4, 0xfb, 0x22, 0x2d, 0x9d, 0x1f, 0xae, 0xea, 0xdc, 0x58, 0xf4, 0x48, 0xee, 0xa9,
+	0x82, 0xe7, 0xeb, 0xe4, 0x7c, 0x45, 0x98, 0x1f, 0xde, 0xdd, 0x2d, 0xb1, 0xc4, 0x48, 0xa4, 0xa1,
+	0x6a, 0x34, 0xd9, 0x6b, 0x80, 0xb7, 0x52, 0x63, 0x3d, 0xb6, 0x8c, 0x4b, 0x42, 0xa0, 0x6f, 0x98,
+	0x9f, 0x0e, 0x93, 0xbd, 0xe4, 0x60, 0x6b, 0x14, 0x6b, 0x72, 0x1f, 0xa0, 0x60, 0x4e, 0x4e, 0x44,
+	0xa0, 0x0d, 0xbb, 0xf1, 0x65, 0x2b, 0x20, 0x51, 0x97, 0x8d, 0x61, 0xf0, 0x81, 0x59, 0x56, 0x3b,
+	0xb2, 0x0f, 0xd7, 0x9d, 0xd4, 0x62, 0x22, 0x35, 0x2b, 0x2a, 0x29, 0xe2, 0x47, 0xae, 0x8d, 0xb6,
+	0x03, 0xf6, 0xae, 0x81, 0xc8, 0x43, 0xd8, 0xb1, 0x92, 0x4b, 0x35, 0x97, 0x2b, 0x56, 0x37, 0xb2,
+	0x6e, 0xb4, 0x70, 0x4b, 0xcc, 0x3c, 0xc0, 0x7b, 0xb4, 0xa7, 0xcc, 0x0a, 0xa5, 0x4b, 0xf2, 0x12,
+	0xfa, 0x53, 0x34, 0x6e, 0x98, 0xec, 0xf5, 0x0e, 0xb6, 0x9f, 0xee, 0xe7, 0xff, 0xfa, 0xcf, 0xfc,
+	0x18, 0xcd, 0x51, 0xff, 0xec, 0xe7, 0x83, 0xce, 0x28, 0x8a, 0xc8, 0x23, 0xb8, 0x69, 0x65, 0xc5,
+	0x7c, 0x30, 0xf5, 0xaa, 0x96, 0x38, 0xf3, 0xd1, 0xb4, 0x3f, 0xda, 0xb9, 0xc0, 0xc7, 0x0d, 0x9c,
+	0xbd, 0x82, 0xde, 0x31, 0x1a, 0x72, 0x07, 0xae, 0x1a, 0xb4, 0x7e, 0xa2, 0x44, 0x1b, 0xc4, 0x20,
+	0xb4, 0x27, 0x22, 0x44, 0xc1, 0xa7, 0x4c, 0x6b, 0x59, 0x85, 0xb7, 0x36, 0x8a, 0x16, 0x39, 0x11,
+	0xd9, 0xb7, 0x04, 0x6e, 0xbf, 0x69, 0xba, 0x71, 0x3b, 0xd0, 0x27, 0xcf, 0xfc, 0xcc, 0x6d, 0x08,
+	0x93, 0x0d, 0xe1, 0x1f, 0xc9, 0x75, 0xff, 0x2b, 0xb9, 0xde, 0x5f, 0x93, 0x3b, 0x85, 0x5b, 0x17,
+	0x0b, 0x5d, 0x9f, 0x60, 0x17, 0xae, 0x34, 0x0b, 0x6c, 0xcc, 0x9b, 0xe6, 0x32, 0x8d, 0x8f, 0x3e,
+	0x9e, 0x2d, 0xd2, 0xe4, 0x7c, 0x91, 0x26, 0xbf, 0x16, 0x69, 0xf2, 0x7d, 0x99, 0x76, 0xce, 0x97,
+	0x69, 0xe7, 0xc7, 0x32, 0xed, 0x7c, 0x7e, 0x5e, 0x2a, 0x3f, 0x9d, 0x15, 0x39, 0xc7, 0x9a, 0x72,
+	0x74, 0x35, 0x3a, 0xaa, 0x0a, 0xfe, 0xa4, 0x44, 0x3a, 0x7f, 0x41, 0x6b, 0x14, 0xb3, 0x4a, 0xba,
+	0x70, 0xe4, 0x6b, 0xc7, 0xed, 0xbf, 0x1a, 0xe9, 0x8a, 0x41, 0xbc, 0xd1, 0x67, 0xbf, 0x07, 0x00,
+	0x13, 0xb7, 0x68, 0x74, 0x06, 0x03, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -242,62 +504,621 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTransfer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DenomTrace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
-	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTransfer(uint64(l))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Forwarding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Forwarding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Forwarding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovTransfer(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
+func (m *Hop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Forwarding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Forwarding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Forwarding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SendEnabled {
-		n += 2
-	}
-	if m.ReceiveEnabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTransfer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTransfer(x uint64) (n int) {
-	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+func (m *Hop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -320,15 +1141,15 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -356,11 +1177,11 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -388,7 +1209,7 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -411,7 +1232,7 @@ func (m *DenomTrace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ChannelTransferStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -434,13 +1255,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelTransferStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
@@ -460,7 +1313,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
 			}
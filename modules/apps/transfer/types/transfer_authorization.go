@@ -58,14 +58,23 @@ func (a TransferAuthorization) Accept(ctx context.Context, msg proto.Message) (a
 			return authz.AcceptResponse{}, err
 		}
 
-		// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
-		if allocation.SpendLimit.AmountOf(msgTransfer.Token.Denom).Equal(UnboundedSpendLimit()) {
-			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
+		limitLeft := allocation.SpendLimit
+		for _, coin := range msgTransfer.GetCoins() {
+			// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
+			if allocation.SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+				continue
+			}
+
+			var isNegative bool
+			limitLeft, isNegative = limitLeft.SafeSub(coin)
+			if isNegative {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", coin.Denom)
+			}
 		}
 
-		limitLeft, isNegative := allocation.SpendLimit.SafeSub(msgTransfer.Token)
-		if isNegative {
-			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		if limitLeft.Equal(allocation.SpendLimit) {
+			// all transferred coins have unbounded spend limits
+			return authz.AcceptResponse{Accept: true, Delete: false, Updated: nil}, nil
 		}
 
 		if limitLeft.IsZero() {
@@ -197,6 +197,42 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().True(sdkmath.NewInt(100).Equal(remainder))
 			},
 		},
+		{
+			"success: multiple tokens in a single transfer",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = transferAuthz.Allocations[0].SpendLimit.Add(sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				updatedTransferAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				remainder := updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf(sdk.DefaultBondDenom)
+				suite.Require().True(sdkmath.NewInt(50).Equal(remainder))
+
+				remainder = updatedTransferAuthz.Allocations[0].SpendLimit.AmountOf("test-denom")
+				suite.Require().True(remainder.IsZero())
+			},
+		},
+		{
+			"multiple tokens in a single transfer exceed the spend limit",
+			func() {
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = sdk.NewCoins(
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().Error(err)
+			},
+		},
 		{
 			"no spend limit set for MsgTransfer port/channel",
 			func() {
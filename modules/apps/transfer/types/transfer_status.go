@@ -0,0 +1,83 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewChannelTransferStatus creates a new ChannelTransferStatus instance.
+func NewChannelTransferStatus(channelID string, sendEnabled, receiveEnabled bool) ChannelTransferStatus {
+	return ChannelTransferStatus{
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the ChannelTransferStatus fields.
+func (cts ChannelTransferStatus) Validate() error {
+	return host.ChannelIdentifierValidator(cts.ChannelId)
+}
+
+// IsDefault returns true if both sending and receiving are enabled, which is
+// equivalent to the channel not having a transfer status.
+func (cts ChannelTransferStatus) IsDefault() bool {
+	return cts.SendEnabled && cts.ReceiveEnabled
+}
+
+// NewDenomTransferStatus creates a new DenomTransferStatus instance.
+func NewDenomTransferStatus(denom string, sendEnabled, receiveEnabled bool) DenomTransferStatus {
+	return DenomTransferStatus{
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the DenomTransferStatus fields. The
+// denomination must be either a base denomination or an IBC denomination of the
+// form ibc/{hash}.
+func (dts DenomTransferStatus) Validate() error {
+	if err := ValidateIBCDenom(dts.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "invalid denomination %s: %s", dts.Denom, err)
+	}
+
+	return nil
+}
+
+// IsDefault returns true if both sending and receiving are enabled, which is
+// equivalent to the denomination not having a transfer status.
+func (dts DenomTransferStatus) IsDefault() bool {
+	return dts.SendEnabled && dts.ReceiveEnabled
+}
+
+// validateTransferStatuses performs a basic validation of the given transfer statuses,
+// checking that each status is valid and that no channel or denomination appears twice.
+func validateTransferStatuses(channelStatuses []ChannelTransferStatus, denomStatuses []DenomTransferStatus) error {
+	seenChannels := make(map[string]bool, len(channelStatuses))
+	for _, status := range channelStatuses {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+
+		if seenChannels[status.ChannelId] {
+			return errorsmod.Wrapf(ErrInvalidTransferStatus, "duplicate transfer status for channel %s", status.ChannelId)
+		}
+		seenChannels[status.ChannelId] = true
+	}
+
+	seenDenoms := make(map[string]bool, len(denomStatuses))
+	for _, status := range denomStatuses {
+		if err := status.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[status.Denom] {
+			return errorsmod.Wrapf(ErrInvalidTransferStatus, "duplicate transfer status for denomination %s", status.Denom)
+		}
+		seenDenoms[status.Denom] = true
+	}
+
+	return nil
+}
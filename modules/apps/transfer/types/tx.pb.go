@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -54,6 +55,23 @@ type MsgTransfer struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// tokens to be transferred. It may only be set on channels negotiated with
+	// the ics20-2 version, in which case all tokens are transferred atomically
+	// in a single packet. If set, token must be left empty.
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// optional forwarding information. If set, the tokens are forwarded through
+	// the given hops after being received on the destination chain. It may only
+	// be set on channels negotiated with the ics20-2 version.
+	Forwarding Forwarding `protobuf:"bytes,10,opt,name=forwarding,proto3" json:"forwarding"`
+	// Timeout height offset added by core IBC to the latest height of the counterparty client
+	// when the packet is sent. It may not be combined with timeout_height or timeout_timestamp.
+	// The relative timeout height is disabled when set to 0.
+	RelativeTimeoutHeight uint64 `protobuf:"varint,11,opt,name=relative_timeout_height,json=relativeTimeoutHeight,proto3" json:"relative_timeout_height,omitempty"`
+	// Timeout timestamp offset in nanoseconds added by core IBC to the later of the block time and
+	// the latest consensus timestamp of the counterparty client when the packet is sent. It may not
+	// be combined with timeout_height or timeout_timestamp. The relative timeout timestamp is disabled
+	// when set to 0.
+	RelativeTimeoutTimestamp uint64 `protobuf:"varint,12,opt,name=relative_timeout_timestamp,json=relativeTimeoutTimestamp,proto3" json:"relative_timeout_timestamp,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -209,11 +227,96 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateTransferStatuses is the Msg/UpdateTransferStatuses request type.
+type MsgUpdateTransferStatuses struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// channel_statuses defines the transfer statuses of the channels to update.
+	// A status enabling both sending and receiving removes the channel from the list.
+	ChannelStatuses []ChannelTransferStatus `protobuf:"bytes,2,rep,name=channel_statuses,json=channelStatuses,proto3" json:"channel_statuses"`
+	// denom_statuses defines the transfer statuses of the denominations to update.
+	// A status enabling both sending and receiving removes the denomination from the list.
+	DenomStatuses []DenomTransferStatus `protobuf:"bytes,3,rep,name=denom_statuses,json=denomStatuses,proto3" json:"denom_statuses"`
+}
+
+func (m *MsgUpdateTransferStatuses) Reset()         { *m = MsgUpdateTransferStatuses{} }
+func (m *MsgUpdateTransferStatuses) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTransferStatuses) ProtoMessage()    {}
+func (*MsgUpdateTransferStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
+}
+func (m *MsgUpdateTransferStatuses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTransferStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTransferStatuses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTransferStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTransferStatuses.Merge(m, src)
+}
+func (m *MsgUpdateTransferStatuses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTransferStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTransferStatuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTransferStatuses proto.InternalMessageInfo
+
+// MsgUpdateTransferStatusesResponse defines the response structure for executing a
+// MsgUpdateTransferStatuses message.
+type MsgUpdateTransferStatusesResponse struct {
+}
+
+func (m *MsgUpdateTransferStatusesResponse) Reset()         { *m = MsgUpdateTransferStatusesResponse{} }
+func (m *MsgUpdateTransferStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTransferStatusesResponse) ProtoMessage()    {}
+func (*MsgUpdateTransferStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *MsgUpdateTransferStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTransferStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTransferStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTransferStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTransferStatusesResponse.Merge(m, src)
+}
+func (m *MsgUpdateTransferStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTransferStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTransferStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTransferStatusesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateTransferStatuses)(nil), "ibc.applications.transfer.v1.MsgUpdateTransferStatuses")
+	proto.RegisterType((*MsgUpdateTransferStatusesResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateTransferStatusesResponse")
 }
 
 func init() {
@@ -221,46 +324,58 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x7e, 0x29, 0xfd, 0xc2, 0x54, 0x40, 0x56, 0x03, 0xcb, 0xc6, 0x6c, 0x49, 0x23, 0x09,
-	0x96, 0x30, 0x93, 0x62, 0x0c, 0xa6, 0xc7, 0x72, 0xf1, 0x20, 0x09, 0x36, 0x78, 0xf1, 0x42, 0x76,
-	0xa7, 0xcf, 0xed, 0x84, 0xee, 0xcc, 0x3a, 0x33, 0x6d, 0xf4, 0x62, 0x88, 0x27, 0xe3, 0xc9, 0x3f,
-	0xc1, 0xa3, 0x47, 0xfe, 0x0c, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x07, 0x2e, 0xfe, 0x11, 0x66, 0x66,
-	0xa7, 0x75, 0xf5, 0x50, 0xf5, 0xb2, 0xfb, 0x7e, 0x7c, 0xde, 0xaf, 0xcf, 0x9b, 0x87, 0xb6, 0x58,
-	0x42, 0x49, 0x9c, 0xe7, 0x43, 0x46, 0x63, 0xcd, 0x04, 0x57, 0x44, 0xcb, 0x98, 0xab, 0x97, 0x20,
-	0xc9, 0xb8, 0x4d, 0xf4, 0x6b, 0x9c, 0x4b, 0xa1, 0x85, 0x7f, 0x8f, 0x25, 0x14, 0x97, 0x61, 0x78,
-	0x02, 0xc3, 0xe3, 0x76, 0xb8, 0x1a, 0x67, 0x8c, 0x0b, 0x62, 0xbf, 0x45, 0x40, 0x78, 0x37, 0x15,
-	0xa9, 0xb0, 0x22, 0x31, 0x92, 0xb3, 0xae, 0x53, 0xa1, 0x32, 0xa1, 0x48, 0xa6, 0x52, 0x93, 0x3e,
-	0x53, 0xa9, 0x73, 0x44, 0xce, 0x91, 0xc4, 0x0a, 0xc8, 0xb8, 0x9d, 0x80, 0x8e, 0xdb, 0x84, 0x0a,
-	0xc6, 0x9d, 0xbf, 0x61, 0xda, 0xa4, 0x42, 0x02, 0xa1, 0x43, 0x06, 0x5c, 0x9b, 0xe8, 0x42, 0x72,
-	0x80, 0x9d, 0xd9, 0x73, 0x4c, 0x9a, 0xb5, 0xe0, 0xe6, 0xd9, 0x1c, 0xaa, 0x1f, 0xaa, 0xf4, 0xd8,
-	0x59, 0xfd, 0x06, 0xaa, 0x2b, 0x31, 0x92, 0x14, 0x4e, 0x72, 0x21, 0x75, 0xe0, 0x6d, 0x7a, 0xdb,
-	0x8b, 0x3d, 0x54, 0x98, 0x8e, 0x84, 0xd4, 0xfe, 0x16, 0x5a, 0x76, 0x00, 0x3a, 0x88, 0x39, 0x87,
-	0x61, 0xf0, 0x9f, 0xc5, 0x2c, 0x15, 0xd6, 0x83, 0xc2, 0xe8, 0x77, 0xd0, 0xbc, 0x16, 0xa7, 0xc0,
-	0x83, 0xb9, 0x4d, 0x6f, 0xbb, 0xbe, 0xb7, 0x81, 0x8b, 0xa9, 0xb0, 0x99, 0x0a, 0xbb, 0xa9, 0xf0,
-	0x81, 0x60, 0xbc, 0xbb, 0x78, 0xf1, 0xb5, 0x51, 0xf9, 0x7c, 0x73, 0xde, 0xf2, 0x7a, 0x45, 0x88,
-	0xbf, 0x86, 0x6a, 0x0a, 0x78, 0x1f, 0x64, 0x50, 0xb5, 0xa9, 0x9d, 0xe6, 0x87, 0x68, 0x41, 0x02,
-	0x05, 0x36, 0x06, 0x19, 0xcc, 0x5b, 0xcf, 0x54, 0xf7, 0x9f, 0xa2, 0x65, 0xcd, 0x32, 0x10, 0x23,
-	0x7d, 0x32, 0x00, 0x96, 0x0e, 0x74, 0x50, 0xb3, 0x85, 0x43, 0x6c, 0xd6, 0x65, 0xe8, 0xc2, 0x8e,
-	0xa4, 0x71, 0x1b, 0x3f, 0xb1, 0x88, 0x72, 0xe5, 0x25, 0x17, 0x5c, 0x78, 0xfc, 0x1d, 0xb4, 0x3a,
-	0xc9, 0x66, 0xfe, 0x4a, 0xc7, 0x59, 0x1e, 0xfc, 0xbf, 0xe9, 0x6d, 0x57, 0x7b, 0xb7, 0x9d, 0xe3,
-	0x78, 0x62, 0xf7, 0x7d, 0x54, 0xcd, 0x20, 0x13, 0xc1, 0x82, 0x6d, 0xc9, 0xca, 0x9d, 0xd6, 0xfb,
-	0x4f, 0x8d, 0xca, 0xbb, 0x9b, 0xf3, 0x96, 0xeb, 0xfd, 0xc3, 0xcd, 0x79, 0x6b, 0xad, 0xa0, 0x60,
-	0x57, 0xf5, 0x4f, 0x49, 0x89, 0xf2, 0xe6, 0x3e, 0xba, 0x53, 0x52, 0x7b, 0xa0, 0x72, 0xc1, 0x15,
-	0x98, 0x69, 0x15, 0xbc, 0x1a, 0x01, 0xa7, 0x60, 0xd7, 0x50, 0xed, 0x4d, 0xf5, 0x4e, 0xd5, 0xa4,
-	0x6f, 0xbe, 0x45, 0x2b, 0x87, 0x2a, 0x7d, 0x9e, 0xf7, 0x63, 0x0d, 0x47, 0xb1, 0x8c, 0x33, 0x65,
-	0xa9, 0x63, 0x29, 0x07, 0xe9, 0x36, 0xe7, 0x34, 0xbf, 0x8b, 0x6a, 0xb9, 0x45, 0xd8, 0x6d, 0xd5,
-	0xf7, 0xee, 0xe3, 0x59, 0xaf, 0x18, 0x17, 0xd9, 0xba, 0x55, 0x43, 0x50, 0xcf, 0x45, 0x76, 0x56,
-	0x7e, 0xce, 0x64, 0x93, 0x36, 0x37, 0xd0, 0xfa, 0x6f, 0xf5, 0x27, 0xcd, 0xef, 0x7d, 0xf7, 0xd0,
-	0xdc, 0xa1, 0x4a, 0xfd, 0x01, 0x5a, 0x98, 0x3e, 0xad, 0x07, 0xb3, 0x6b, 0x96, 0x38, 0x08, 0xdb,
-	0x7f, 0x0d, 0x9d, 0xd2, 0xa5, 0xd1, 0xad, 0x5f, 0x98, 0xd8, 0xfd, 0x63, 0x8a, 0x32, 0x3c, 0x7c,
-	0xf4, 0x4f, 0xf0, 0x49, 0xd5, 0x70, 0xfe, 0xcc, 0x3c, 0x9f, 0xee, 0xb3, 0x8b, 0xab, 0xc8, 0xbb,
-	0xbc, 0x8a, 0xbc, 0x6f, 0x57, 0x91, 0xf7, 0xf1, 0x3a, 0xaa, 0x5c, 0x5e, 0x47, 0x95, 0x2f, 0xd7,
-	0x51, 0xe5, 0xc5, 0x7e, 0xca, 0xf4, 0x60, 0x94, 0x60, 0x2a, 0x32, 0xe2, 0x0e, 0x9b, 0x25, 0x74,
-	0x37, 0x15, 0x64, 0xfc, 0x98, 0x64, 0xa2, 0x3f, 0x1a, 0x82, 0x32, 0xc7, 0x5a, 0x3a, 0x52, 0xfd,
-	0x26, 0x07, 0x95, 0xd4, 0xec, 0x7d, 0x3e, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xda, 0xd1,
-	0xc3, 0x96, 0x04, 0x00, 0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0xd3, 0x48,
+	0x14, 0x8e, 0x9b, 0x1f, 0xdb, 0x4e, 0xfa, 0xd3, 0xbb, 0xdb, 0xba, 0xd6, 0x2a, 0xc9, 0x66, 0xb7,
+	0x52, 0x36, 0x55, 0x6d, 0xa5, 0x55, 0xb7, 0xab, 0x68, 0x25, 0xa4, 0x14, 0x21, 0x0e, 0x14, 0x95,
+	0x50, 0x2e, 0x1c, 0x88, 0x1c, 0x67, 0xea, 0x8c, 0x1a, 0xcf, 0x98, 0x99, 0x49, 0x80, 0x0b, 0x02,
+	0x4e, 0xa8, 0x27, 0xc4, 0x5f, 0xc0, 0x11, 0x71, 0xca, 0x9f, 0xd1, 0x63, 0x8f, 0x9c, 0x00, 0xb5,
+	0x87, 0xfe, 0x1b, 0x68, 0xc6, 0x63, 0xd7, 0x4d, 0x69, 0x28, 0x5c, 0x92, 0x99, 0x79, 0xdf, 0xfb,
+	0xde, 0x7b, 0x9f, 0x3f, 0x8f, 0xc1, 0x0a, 0x6a, 0xbb, 0xb6, 0x13, 0x04, 0x3d, 0xe4, 0x3a, 0x1c,
+	0x11, 0xcc, 0x6c, 0x4e, 0x1d, 0xcc, 0xf6, 0x21, 0xb5, 0x07, 0x35, 0x9b, 0x3f, 0xb5, 0x02, 0x4a,
+	0x38, 0xd1, 0xff, 0x40, 0x6d, 0xd7, 0x4a, 0xc2, 0xac, 0x08, 0x66, 0x0d, 0x6a, 0xe6, 0x82, 0xe3,
+	0x23, 0x4c, 0x6c, 0xf9, 0x1b, 0x26, 0x98, 0xbf, 0x79, 0xc4, 0x23, 0x72, 0x69, 0x8b, 0x95, 0x3a,
+	0x5d, 0x72, 0x09, 0xf3, 0x09, 0xb3, 0x7d, 0xe6, 0x09, 0x7a, 0x9f, 0x79, 0x2a, 0x50, 0x50, 0x81,
+	0xb6, 0xc3, 0xa0, 0x3d, 0xa8, 0xb5, 0x21, 0x77, 0x6a, 0xb6, 0x4b, 0x10, 0x56, 0xf1, 0xa2, 0x68,
+	0xd3, 0x25, 0x14, 0xda, 0x6e, 0x0f, 0x41, 0xcc, 0x45, 0x76, 0xb8, 0x52, 0x80, 0xd5, 0xf1, 0x73,
+	0x44, 0xcd, 0x4a, 0x70, 0x79, 0x98, 0x05, 0xf9, 0x1d, 0xe6, 0xed, 0xa9, 0x53, 0xbd, 0x08, 0xf2,
+	0x8c, 0xf4, 0xa9, 0x0b, 0x5b, 0x01, 0xa1, 0xdc, 0xd0, 0x4a, 0x5a, 0x65, 0xaa, 0x09, 0xc2, 0xa3,
+	0x5d, 0x42, 0xb9, 0xbe, 0x02, 0x66, 0x15, 0xc0, 0xed, 0x3a, 0x18, 0xc3, 0x9e, 0x31, 0x21, 0x31,
+	0x33, 0xe1, 0xe9, 0x76, 0x78, 0xa8, 0xd7, 0x41, 0x96, 0x93, 0x03, 0x88, 0x8d, 0x74, 0x49, 0xab,
+	0xe4, 0xd7, 0x97, 0xad, 0x70, 0x2a, 0x4b, 0x4c, 0x65, 0xa9, 0xa9, 0xac, 0x6d, 0x82, 0x70, 0x63,
+	0xea, 0xe8, 0x53, 0x31, 0xf5, 0xfe, 0x6c, 0x58, 0xd5, 0x9a, 0x61, 0x8a, 0xbe, 0x08, 0x72, 0x0c,
+	0xe2, 0x0e, 0xa4, 0x46, 0x46, 0x52, 0xab, 0x9d, 0x6e, 0x82, 0x49, 0x0a, 0x5d, 0x88, 0x06, 0x90,
+	0x1a, 0x59, 0x19, 0x89, 0xf7, 0xfa, 0x1d, 0x30, 0xcb, 0x91, 0x0f, 0x49, 0x9f, 0xb7, 0xba, 0x10,
+	0x79, 0x5d, 0x6e, 0xe4, 0x64, 0x61, 0xd3, 0x12, 0x8f, 0x4b, 0xc8, 0x65, 0x29, 0x91, 0x06, 0x35,
+	0xeb, 0xb6, 0x44, 0x24, 0x2b, 0xcf, 0xa8, 0xe4, 0x30, 0xa2, 0xaf, 0x82, 0x85, 0x88, 0x4d, 0xfc,
+	0x33, 0xee, 0xf8, 0x81, 0xf1, 0x4b, 0x49, 0xab, 0x64, 0x9a, 0xf3, 0x2a, 0xb0, 0x17, 0x9d, 0xeb,
+	0x3a, 0xc8, 0xf8, 0xd0, 0x27, 0xc6, 0xa4, 0x6c, 0x49, 0xae, 0xf5, 0x2e, 0xc8, 0xc9, 0x59, 0x98,
+	0x31, 0x55, 0x4a, 0x8f, 0x9f, 0x7f, 0x53, 0x74, 0xf1, 0xe1, 0x73, 0xb1, 0xe2, 0x21, 0xde, 0xed,
+	0xb7, 0x2d, 0x97, 0xf8, 0xb6, 0xb2, 0x40, 0xf8, 0xb7, 0xc6, 0x3a, 0x07, 0x36, 0x7f, 0x16, 0x40,
+	0x26, 0x13, 0x58, 0xd8, 0xb1, 0xe2, 0xd7, 0xef, 0x02, 0xb0, 0x4f, 0xe8, 0x13, 0x87, 0x76, 0x10,
+	0xf6, 0x0c, 0x20, 0x87, 0xae, 0x58, 0xe3, 0x3c, 0x6a, 0xdd, 0x8a, 0xf1, 0x8d, 0x8c, 0x28, 0xde,
+	0x4c, 0x30, 0xe8, 0xff, 0x82, 0x25, 0x0a, 0x7b, 0x0e, 0x47, 0x03, 0xd8, 0x1a, 0x51, 0x34, 0x2f,
+	0x05, 0xf8, 0x3d, 0x0a, 0xef, 0x5d, 0x90, 0xec, 0x7f, 0x60, 0x5e, 0xca, 0x3b, 0xd7, 0x6e, 0x5a,
+	0xa6, 0x1a, 0x23, 0xa9, 0xb1, 0x86, 0xf5, 0xea, 0xeb, 0x77, 0xc5, 0xd4, 0xab, 0xb3, 0x61, 0x55,
+	0x3d, 0xeb, 0xc3, 0xb3, 0x61, 0x75, 0x31, 0x31, 0x7e, 0xc2, 0xa2, 0xe5, 0x2d, 0xf0, 0x6b, 0x62,
+	0xdb, 0x84, 0x2c, 0x20, 0x98, 0x41, 0xe1, 0x0e, 0x06, 0x1f, 0xf7, 0x21, 0x76, 0xa1, 0xb4, 0x6d,
+	0xa6, 0x19, 0xef, 0xeb, 0x19, 0x41, 0x5f, 0x7e, 0x0e, 0xe6, 0x76, 0x98, 0xf7, 0x20, 0xe8, 0x38,
+	0x1c, 0xee, 0x3a, 0xd4, 0xf1, 0x99, 0xb4, 0x1a, 0xf2, 0x30, 0xa4, 0xca, 0xe9, 0x6a, 0xa7, 0x37,
+	0x40, 0x2e, 0x90, 0x08, 0xe9, 0xee, 0xfc, 0xfa, 0xdf, 0xe3, 0x15, 0x0d, 0xd9, 0x94, 0x9a, 0x2a,
+	0xb3, 0x3e, 0x77, 0x3e, 0x93, 0x24, 0x2d, 0x2f, 0x83, 0xa5, 0x91, 0xfa, 0x51, 0xf3, 0xe5, 0xc3,
+	0x09, 0xb0, 0x1c, 0xc7, 0xa2, 0xd1, 0xee, 0x73, 0x87, 0xf7, 0x19, 0xbc, 0xba, 0xcb, 0x0e, 0x98,
+	0x57, 0x2f, 0x61, 0x8b, 0x29, 0xac, 0x31, 0x21, 0xfd, 0xb6, 0x31, 0xbe, 0x5f, 0xf5, 0x96, 0x5e,
+	0x2c, 0xa4, 0xda, 0x9f, 0x53, 0x94, 0x71, 0xf5, 0x47, 0x60, 0xb6, 0x03, 0x31, 0xf1, 0xcf, 0x6b,
+	0xa4, 0x65, 0x8d, 0xda, 0xf8, 0x1a, 0x37, 0x45, 0xce, 0x37, 0x2b, 0xcc, 0x48, 0xba, 0x88, 0xff,
+	0xb2, 0x4e, 0x7f, 0x81, 0x3f, 0xaf, 0xd4, 0x22, 0x52, 0x6c, 0xfd, 0x65, 0x1a, 0xa4, 0x77, 0x98,
+	0xa7, 0x77, 0xc1, 0x64, 0x7c, 0x79, 0xfd, 0x33, 0xbe, 0xa3, 0x84, 0x6b, 0xcc, 0xda, 0xb5, 0xa1,
+	0xb1, 0xc1, 0x38, 0x98, 0xbe, 0xe0, 0x9d, 0xb5, 0xef, 0x52, 0x24, 0xe1, 0xe6, 0xe6, 0x0f, 0xc1,
+	0xe3, 0xaa, 0x6f, 0x35, 0xb0, 0x78, 0x85, 0x2d, 0xb6, 0xae, 0xc9, 0x38, 0x9a, 0x68, 0xde, 0xf8,
+	0xc9, 0xc4, 0xa8, 0x29, 0x33, 0xfb, 0x42, 0xdc, 0x41, 0x8d, 0x7b, 0x47, 0x27, 0x05, 0xed, 0xf8,
+	0xa4, 0xa0, 0x7d, 0x39, 0x29, 0x68, 0x6f, 0x4e, 0x0b, 0xa9, 0xe3, 0xd3, 0x42, 0xea, 0xe3, 0x69,
+	0x21, 0xf5, 0x70, 0xeb, 0xf2, 0x65, 0x86, 0xda, 0xee, 0x9a, 0x47, 0xec, 0xc1, 0x7f, 0xb6, 0x4f,
+	0x3a, 0xfd, 0x1e, 0x64, 0xe2, 0x1b, 0x95, 0xf8, 0x36, 0xc9, 0x1b, 0xae, 0x9d, 0x93, 0x9f, 0xa5,
+	0x8d, 0xaf, 0x03, 0x00, 0x86, 0x9e, 0x90, 0xfb, 0x8d, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +394,8 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateTransferStatuses defines a rpc handler for MsgUpdateTransferStatuses.
+	UpdateTransferStatuses(ctx context.Context, in *MsgUpdateTransferStatuses, opts ...grpc.CallOption) (*MsgUpdateTransferStatusesResponse, error)
 }
 
 type msgClient struct {
@@ -307,12 +424,23 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateTransferStatuses(ctx context.Context, in *MsgUpdateTransferStatuses, opts ...grpc.CallOption) (*MsgUpdateTransferStatusesResponse, error) {
+	out := new(MsgUpdateTransferStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/UpdateTransferStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateTransferStatuses defines a rpc handler for MsgUpdateTransferStatuses.
+	UpdateTransferStatuses(context.Context, *MsgUpdateTransferStatuses) (*MsgUpdateTransferStatusesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +453,9 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateTransferStatuses(ctx context.Context, req *MsgUpdateTransferStatuses) (*MsgUpdateTransferStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransferStatuses not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +497,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTransferStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTransferStatuses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTransferStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/UpdateTransferStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTransferStatuses(ctx, req.(*MsgUpdateTransferStatuses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +527,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateTransferStatuses",
+			Handler:    _Msg_UpdateTransferStatuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -403,6 +556,40 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RelativeTimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RelativeTimeoutHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeoutHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -557,6 +744,87 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateTransferStatuses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTransferStatuses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTransferStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomStatuses) > 0 {
+		for iNdEx := len(m.DenomStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelStatuses) > 0 {
+		for iNdEx := len(m.ChannelStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTransferStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTransferStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTransferStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -601,6 +869,20 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Forwarding.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RelativeTimeoutHeight != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeoutHeight))
+	}
+	if m.RelativeTimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RelativeTimeoutTimestamp))
+	}
 	return n
 }
 
@@ -640,6 +922,40 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateTransferStatuses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChannelStatuses) > 0 {
+		for _, e := range m.ChannelStatuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DenomStatuses) > 0 {
+		for _, e := range m.DenomStatuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTransferStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -920,6 +1236,111 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeoutHeight", wireType)
+			}
+			m.RelativeTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeoutTimestamp", wireType)
+			}
+			m.RelativeTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1175,6 +1596,206 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateTransferStatuses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTransferStatuses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTransferStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStatuses = append(m.ChannelStatuses, ChannelTransferStatus{})
+			if err := m.ChannelStatuses[len(m.ChannelStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomStatuses = append(m.DenomStatuses, DenomTransferStatus{})
+			if err := m.DenomStatuses[len(m.DenomStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTransferStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTransferStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTransferStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -111,3 +111,28 @@ func ValidateClientType(clientType string) error {
 
 	return nil
 }
+
+// chainIDClientState is implemented by client states which expose the identifier of the chain they track.
+type chainIDClientState interface {
+	GetChainID() string
+}
+
+// ValidateSameChain returns an error unless both client states track the same chain. Both client states must
+// expose the identifier of the chain they track, as clients which do not cannot be shown to track the same chain.
+func ValidateSameChain(clientState, otherClientState exported.ClientState) error {
+	chainIDClient, ok := clientState.(chainIDClientState)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidClientType, "client of type %s does not expose the chain ID it tracks", clientState.ClientType())
+	}
+
+	otherChainIDClient, ok := otherClientState.(chainIDClientState)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidClientType, "client of type %s does not expose the chain ID it tracks", otherClientState.ClientType())
+	}
+
+	if chainIDClient.GetChainID() != otherChainIDClient.GetChainID() {
+		return errorsmod.Wrapf(ErrInvalidClient, "clients track different chains: expected chain ID %s, got %s", chainIDClient.GetChainID(), otherChainIDClient.GetChainID())
+	}
+
+	return nil
+}
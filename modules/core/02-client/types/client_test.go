@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -84,3 +86,59 @@ func TestValidateClientType(t *testing.T) {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateSameChain() {
+	var otherClientState exported.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: clients track the same chain",
+			func() {},
+			nil,
+		},
+		{
+			"failure: clients track different chains",
+			func() {
+				tmClientState, ok := otherClientState.(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.ChainId = ibctesting.InvalidID
+			},
+			types.ErrInvalidClient,
+		},
+		{
+			"failure: client does not expose a chain ID",
+			func() {
+				otherClientState = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1).ClientState()
+			},
+			types.ErrInvalidClientType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			clientState := path.EndpointA.GetClientState()
+			otherClientState = path.EndpointA.GetClientState()
+
+			tc.malleate()
+
+			err := types.ValidateSameChain(clientState, otherClientState)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
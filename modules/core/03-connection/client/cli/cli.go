@@ -20,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryConnection(),
 		GetCmdQueryClientConnections(),
 		GetCmdConnectionParams(),
+		GetCmdQueryConnectionUpgrade(),
+		GetCmdQueryConnectionUpgradeError(),
 	)
 
 	return queryCmd
@@ -118,6 +118,70 @@ func GetCmdQueryClientConnections() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryConnectionUpgrade defines the command to query the proposed upgrade of a connection end
+func GetCmdQueryConnectionUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade [connection-id]",
+		Short:   "Query the proposed upgrade of a connection end",
+		Long:    "Query the proposed upgrade of a connection end",
+		Example: fmt.Sprintf("%s query %s %s upgrade [connection-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			connectionID := args[0]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			upgradeRes, err := utils.QueryConnectionUpgrade(clientCtx, connectionID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(upgradeRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(upgradeRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConnectionUpgradeError defines the command to query the upgrade error receipt of a connection end
+func GetCmdQueryConnectionUpgradeError() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-error [connection-id]",
+		Short:   "Query the upgrade error receipt of a connection end",
+		Long:    "Query the upgrade error receipt of a connection end",
+		Example: fmt.Sprintf("%s query %s %s upgrade-error [connection-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			connectionID := args[0]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			errorReceiptRes, err := utils.QueryConnectionUpgradeError(clientCtx, connectionID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(errorReceiptRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(errorReceiptRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdConnectionParams returns the command handler for ibc connection parameter querying.
 func GetCmdConnectionParams() *cobra.Command {
 	cmd := &cobra.Command{
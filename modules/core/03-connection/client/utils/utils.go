@@ -100,6 +100,88 @@ func queryClientConnectionsABCI(clientCtx client.Context, clientID string) (*typ
 	return types.NewQueryClientConnectionsResponse(paths, proofBz, proofHeight), nil
 }
 
+// QueryConnectionUpgrade returns the proposed upgrade of a connection end.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryConnectionUpgrade(
+	clientCtx client.Context, connectionID string, prove bool,
+) (*types.QueryConnectionUpgradeResponse, error) {
+	if prove {
+		return queryConnectionUpgradeABCI(clientCtx, connectionID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryConnectionUpgradeRequest{
+		ConnectionId: connectionID,
+	}
+
+	return queryClient.ConnectionUpgrade(context.Background(), req)
+}
+
+func queryConnectionUpgradeABCI(clientCtx client.Context, connectionID string) (*types.QueryConnectionUpgradeResponse, error) {
+	key := host.ConnectionUpgradeKey(connectionID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if upgrade exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection-id: %s", connectionID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var upgrade types.Upgrade
+	if err := cdc.Unmarshal(value, &upgrade); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryConnectionUpgradeResponse(upgrade, proofBz, proofHeight), nil
+}
+
+// QueryConnectionUpgradeError returns the upgrade error receipt of a connection end.
+// If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
+// it uses the gRPC query client.
+func QueryConnectionUpgradeError(
+	clientCtx client.Context, connectionID string, prove bool,
+) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	if prove {
+		return queryConnectionUpgradeErrorABCI(clientCtx, connectionID)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	req := &types.QueryConnectionUpgradeErrorRequest{
+		ConnectionId: connectionID,
+	}
+
+	return queryClient.ConnectionUpgradeError(context.Background(), req)
+}
+
+func queryConnectionUpgradeErrorABCI(clientCtx client.Context, connectionID string) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	key := host.ConnectionUpgradeErrorKey(connectionID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if error receipt exists
+	if len(value) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrUpgradeErrorNotFound, "connection-id: %s", connectionID)
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	var errorReceipt types.ErrorReceipt
+	if err := cdc.Unmarshal(value, &errorReceipt); err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryConnectionUpgradeErrorResponse(errorReceipt, proofBz, proofHeight), nil
+}
+
 // QueryConnectionClientState returns the ClientState of a connection end. If
 // prove is true, it performs an ABCI store query in order to retrieve the
 // merkle proof. Otherwise, it uses the gRPC query client.
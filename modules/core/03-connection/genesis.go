@@ -12,11 +12,21 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, connection := range gs.Connections {
 		conn := types.NewConnectionEnd(connection.State, connection.ClientId, connection.Counterparty, connection.Versions, connection.DelayPeriod)
+		conn.UpgradeSequence = connection.UpgradeSequence
 		k.SetConnection(ctx, connection.Id, conn)
 	}
 	for _, connPaths := range gs.ClientConnectionPaths {
 		k.SetClientConnectionPaths(ctx, connPaths.ClientId, connPaths.Paths)
 	}
+	for _, upgrade := range gs.Upgrades {
+		k.SetUpgrade(ctx, upgrade.ConnectionId, upgrade.Upgrade)
+	}
+	for _, upgrade := range gs.CounterpartyUpgrades {
+		k.SetCounterpartyUpgrade(ctx, upgrade.ConnectionId, upgrade.Upgrade)
+	}
+	for _, errorReceipt := range gs.ErrorReceipts {
+		k.SetUpgradeErrorReceipt(ctx, errorReceipt.ConnectionId, errorReceipt.ErrorReceipt)
+	}
 	k.SetNextConnectionSequence(ctx, gs.NextConnectionSequence)
 	k.SetParams(ctx, gs.Params)
 
@@ -30,5 +40,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		ClientConnectionPaths:  k.GetAllClientConnectionPaths(ctx),
 		NextConnectionSequence: k.GetNextConnectionSequence(ctx),
 		Params:                 k.GetParams(ctx),
+		Upgrades:               k.GetAllUpgrades(ctx),
+		CounterpartyUpgrades:   k.GetAllCounterpartyUpgrades(ctx),
+		ErrorReceipts:          k.GetAllUpgradeErrorReceipts(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
@@ -72,3 +74,138 @@ func emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 		),
 	})
 }
+
+// emitConnectionUpgradeInitEvent emits a connection upgrade init event
+func emitConnectionUpgradeInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeInit,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeClientID, upgrade.Fields.ClientId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersions, upgrade.Fields.Versions[0].String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", upgrade.Fields.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeTryEvent emits a connection upgrade try event
+func emitConnectionUpgradeTryEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeTry,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeClientID, upgrade.Fields.ClientId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersions, upgrade.Fields.Versions[0].String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", upgrade.Fields.DelayPeriod)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, fmt.Sprintf("%d", upgrade.TimeoutTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeAckEvent emits a connection upgrade ack event, the connection end is the upgraded connection end
+func emitConnectionUpgradeAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeAck,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeConfirmEvent emits a connection upgrade confirm event, the connection end is the upgraded connection end
+func emitConnectionUpgradeConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeConfirm,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeTimeoutEvent emits a connection upgrade timeout event
+func emitConnectionUpgradeTimeoutEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.Upgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeTimeout,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, fmt.Sprintf("%d", upgrade.TimeoutTimestamp)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitConnectionUpgradeCancelEvent emits a connection upgrade cancelled event
+func emitConnectionUpgradeCancelEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeCancel,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitErrorReceiptEvent emits a connection upgrade error event
+func emitErrorReceiptEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, err error) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeError,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeErrorReceipt, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
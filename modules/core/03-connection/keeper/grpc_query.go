@@ -187,3 +187,63 @@ func (k Keeper) ConnectionParams(c context.Context, req *types.QueryConnectionPa
 		Params: &params,
 	}, nil
 }
+
+// ConnectionUpgradeError implements the Query/ConnectionUpgradeError gRPC method.
+func (k Keeper) ConnectionUpgradeError(c context.Context, req *types.QueryConnectionUpgradeErrorRequest) (*types.QueryConnectionUpgradeErrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasConnection(ctx, req.ConnectionId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrConnectionNotFound, req.ConnectionId).Error(),
+		)
+	}
+
+	receipt, found := k.GetUpgradeErrorReceipt(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeErrorNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryConnectionUpgradeErrorResponse(receipt, nil, selfHeight), nil
+}
+
+// ConnectionUpgrade implements the Query/ConnectionUpgrade gRPC method.
+func (k Keeper) ConnectionUpgrade(c context.Context, req *types.QueryConnectionUpgradeRequest) (*types.QueryConnectionUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasConnection(ctx, req.ConnectionId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrConnectionNotFound, req.ConnectionId).Error(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryConnectionUpgradeResponse(upgrade, nil, selfHeight), nil
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -219,6 +222,149 @@ func (k Keeper) addConnectionToClient(ctx sdk.Context, clientID, connectionID st
 	return nil
 }
 
+// removeConnectionFromClient is used to remove a connection identifier from the set of
+// connections associated with a client.
+func (k Keeper) removeConnectionFromClient(ctx sdk.Context, clientID, connectionID string) {
+	conns, found := k.GetClientConnectionPaths(ctx, clientID)
+	if !found {
+		return
+	}
+
+	conns = slices.DeleteFunc(conns, func(id string) bool { return id == connectionID })
+	k.SetClientConnectionPaths(ctx, clientID, conns)
+}
+
+// GetUpgradeErrorReceipt returns the upgrade error receipt for the provided connection identifier.
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, connectionID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeErrorKey(connectionID))
+	if bz == nil {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+
+	return errorReceipt, true
+}
+
+// SetUpgradeErrorReceipt sets the provided error receipt in store using the connection identifier.
+func (k Keeper) SetUpgradeErrorReceipt(ctx sdk.Context, connectionID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ConnectionUpgradeErrorKey(connectionID), bz)
+}
+
+// GetUpgrade returns the proposed upgrade for the provided connection identifier.
+func (k Keeper) GetUpgrade(ctx sdk.Context, connectionID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionUpgradeKey(connectionID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetUpgrade sets the proposed upgrade using the provided connection identifier.
+func (k Keeper) SetUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ConnectionUpgradeKey(connectionID), bz)
+}
+
+// deleteUpgrade deletes the upgrade for the provided connection identifier.
+func (k Keeper) deleteUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionUpgradeKey(connectionID))
+}
+
+// GetCounterpartyUpgrade gets the counterparty upgrade from the store.
+func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, connectionID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ConnectionCounterpartyUpgradeKey(connectionID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+
+	return upgrade, true
+}
+
+// SetCounterpartyUpgrade sets the counterparty upgrade in the store.
+func (k Keeper) SetCounterpartyUpgrade(ctx sdk.Context, connectionID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ConnectionCounterpartyUpgradeKey(connectionID), bz)
+}
+
+// deleteCounterpartyUpgrade deletes the counterparty upgrade in the store.
+func (k Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ConnectionCounterpartyUpgradeKey(connectionID))
+}
+
+// deleteUpgradeInfo deletes all auxiliary upgrade information.
+func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, connectionID string) {
+	k.deleteUpgrade(ctx, connectionID)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+}
+
+// GetAllUpgrades returns all upgrades proposed for connection ends on this chain.
+func (k Keeper) GetAllUpgrades(ctx sdk.Context) []types.IdentifiedUpgrade {
+	return k.getAllUpgrades(ctx, host.KeyUpgradePrefix)
+}
+
+// GetAllCounterpartyUpgrades returns all counterparty upgrades accepted in the TRY step.
+func (k Keeper) GetAllCounterpartyUpgrades(ctx sdk.Context) []types.IdentifiedUpgrade {
+	return k.getAllUpgrades(ctx, host.KeyCounterpartyUpgrade)
+}
+
+// GetAllUpgradeErrorReceipts returns all connection upgrade error receipts.
+func (k Keeper) GetAllUpgradeErrorReceipts(ctx sdk.Context) []types.IdentifiedErrorReceipt {
+	var errorReceipts []types.IdentifiedErrorReceipt
+	k.iterateUpgradeState(ctx, host.KeyUpgradeErrorPrefix, func(connectionID string, bz []byte) {
+		var errorReceipt types.ErrorReceipt
+		k.cdc.MustUnmarshal(bz, &errorReceipt)
+
+		errorReceipts = append(errorReceipts, types.NewIdentifiedErrorReceipt(connectionID, errorReceipt))
+	})
+
+	return errorReceipts
+}
+
+// getAllUpgrades returns all upgrades stored under the provided connection upgrade key.
+func (k Keeper) getAllUpgrades(ctx sdk.Context, key string) []types.IdentifiedUpgrade {
+	var upgrades []types.IdentifiedUpgrade
+	k.iterateUpgradeState(ctx, key, func(connectionID string, bz []byte) {
+		var upgrade types.Upgrade
+		k.cdc.MustUnmarshal(bz, &upgrade)
+
+		upgrades = append(upgrades, types.NewIdentifiedUpgrade(connectionID, upgrade))
+	})
+
+	return upgrades
+}
+
+// iterateUpgradeState iterates over the connection upgrade state stored under the provided key,
+// calling cb with the connection identifier and the stored value of each entry.
+func (k Keeper) iterateUpgradeState(ctx sdk.Context, key string, cb func(connectionID string, bz []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := fmt.Sprintf("%s/%s/", host.KeyConnectionUpgradePrefix, key)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(keyPrefix))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		connectionID := host.MustParseConnectionPath(strings.TrimPrefix(string(iterator.Key()), keyPrefix))
+		cb(connectionID, iterator.Value())
+	}
+}
+
 // GetParams returns the total set of ibc-connection parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
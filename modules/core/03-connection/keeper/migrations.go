@@ -30,6 +30,7 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	var params types.Params
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
+
 	if err := params.Validate(); err != nil {
 		return err
 	}
@@ -38,3 +39,17 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated connection to self-manage params")
 	return nil
 }
+
+// MigrateUpgradeTimeout migrates from consensus version 6 to 7.
+// This migration sets the connection upgrade timeout parameter to its default value.
+func (m Migrator) MigrateUpgradeTimeout(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.UpgradeTimeout = uint64(types.DefaultUpgradeTimeout)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated connection upgrade timeout param")
+	return nil
+}
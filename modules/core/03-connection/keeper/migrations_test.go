@@ -20,7 +20,7 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 				subspace := suite.chainA.GetSimApp().GetSubspace(ibcexported.ModuleName)
 				subspace.SetParamSet(suite.chainA.GetContext(), &params)
 			},
-			types.DefaultParams(),
+			types.NewParams(uint64(types.DefaultTimePerBlock)),
 		},
 	}
 
@@ -0,0 +1,696 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ConnUpgradeInit is called to initiate a connection upgrade handshake with the counterparty chain.
+// The proposed upgrade fields define the client, versions and delay period the connection end on this
+// chain will use once the upgrade has completed.
+//
+// NOTE: the connection remains OPEN throughout the upgrade handshake, packets may continue to be
+// relayed on the channels built on top of it.
+func (k Keeper) ConnUpgradeInit(ctx sdk.Context, connectionID string, upgradeFields types.UpgradeFields) (types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if upgrade, found := k.GetUpgrade(ctx, connectionID); found && upgrade.TimeoutTimestamp != 0 {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "upgrade at sequence %d has already been accepted by the counterparty", connection.UpgradeSequence)
+	}
+
+	currentFields := extractUpgradeFields(connection)
+	if reflect.DeepEqual(upgradeFields, currentFields) {
+		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "existing connection end is identical to proposed upgrade connection end: got %s", upgradeFields.String())
+	}
+
+	if err := k.validateSelfUpgradeFields(ctx, connection, upgradeFields); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	// NOTE: the Upgrade returned here is intentionally not fully populated. The timeout remains unset
+	// until the counterparty calls ConnUpgradeTry.
+	return types.NewUpgrade(upgradeFields, 0), nil
+}
+
+// WriteUpgradeInitConnection writes a connection which has successfully passed the UpgradeInit handshake step.
+// The upgrade sequence is incremented and an event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitConnection(ctx sdk.Context, connectionID string, upgrade types.Upgrade) (types.ConnectionEnd, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-init")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when updating connection state in successful ConnUpgradeInit step, connectionID: %s", connectionID))
+	}
+
+	connection.UpgradeSequence++
+
+	k.SetConnection(ctx, connectionID, connection)
+	k.SetUpgrade(ctx, connectionID, upgrade)
+
+	k.Logger(ctx).Info("connection upgrade initialized", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	emitConnectionUpgradeInitEvent(ctx, connectionID, connection, upgrade)
+
+	return connection, upgrade
+}
+
+// ConnUpgradeTry is called to accept the first step of a connection upgrade handshake initiated on the
+// counterparty chain. If an upgrade has already been initialised on this chain by the authority (crossing
+// hellos), its own upgrade fields are used and must be compatible with the counterparty upgrade fields.
+// Otherwise, the counterparty upgrade is only accepted if it leaves the versions and delay period of the
+// connection unchanged, as changes to these fields must be authorized on this chain.
+// If the counterparty upgrade moves the counterparty connection end to a new client, the provided client
+// state must be a valid client of this chain and is verified against the counterparty state.
+// If the counterparty upgrade sequence is outdated an UpgradeError is returned, which is expected to be
+// written as an error receipt.
+func (k Keeper) ConnUpgradeTry(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgradeFields types.UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	counterpartyClient exported.ClientState,
+	connectionProof,
+	upgradeProof,
+	clientProof []byte,
+	proofHeight exported.Height,
+) (types.ConnectionEnd, types.Upgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, isCrossingHello := k.GetUpgrade(ctx, connectionID)
+	if isCrossingHello && upgrade.TimeoutTimestamp != 0 {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "upgrade at sequence %d has already been accepted", connection.UpgradeSequence)
+	}
+
+	// construct expected counterparty connection from information in state
+	// only the counterpartyUpgradeSequence is provided by the relayer
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	counterpartyConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, connection.Versions, connection.DelayPeriod)
+	counterpartyConnection.UpgradeSequence = counterpartyUpgradeSequence // provided by the relayer
+
+	// verify the counterparty connection state containing the upgrade sequence
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	if counterpartyUpgradeSequence < connection.UpgradeSequence {
+		// In this case, the counterparty upgrade is outdated. We want to force the counterparty
+		// to abort their upgrade and come back to sync with our own upgrade sequence.
+		var upgradeSequence uint64
+		if isCrossingHello {
+			// In the crossing hello case, we already have an upgrade but it is at a higher sequence than the counterparty.
+			// Thus, our upgrade should take priority. We force the counterparty to abort their upgrade by invalidating all counterparty
+			// upgrade attempts below our own sequence by setting errorReceipt to upgradeSequence - 1.
+			upgradeSequence = connection.UpgradeSequence - 1
+		} else {
+			// In the non-crossing hello case, the sequence on both sides should move to a fresh sequence on the next
+			// upgrade attempt. Thus, we write an error receipt with our own upgrade sequence which will cause the
+			// counterparty to cancel their upgrade and move to the same sequence.
+			upgradeSequence = connection.UpgradeSequence
+		}
+
+		return connection, upgrade, types.NewUpgradeError(upgradeSequence, errorsmod.Wrapf(
+			types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence < current upgrade sequence (%d < %d)", counterpartyUpgradeSequence, connection.UpgradeSequence,
+		))
+	}
+
+	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof, connection.Counterparty.ConnectionId,
+		types.NewUpgrade(counterpartyUpgradeFields, 0),
+	); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	if err := k.validateCounterpartyUpgradeClient(ctx, connection, counterpartyUpgradeFields.ClientId, counterpartyClient, clientProof, proofHeight); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, err
+	}
+
+	// NOTE: if an upgrade exists (crossing hellos) then use existing upgrade fields. Otherwise, the counterparty
+	// upgrade may only be accepted without authorization on this chain if it keeps the current versions and delay period.
+	if isCrossingHello {
+		if err := k.validateSelfUpgradeFields(ctx, connection, upgrade.Fields); err != nil {
+			return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to validate upgrade")
+		}
+	} else {
+		currentFields := extractUpgradeFields(connection)
+		if err := checkForUpgradeCompatibility(currentFields, counterpartyUpgradeFields); err != nil {
+			return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(types.ErrUpgradeNotFound, "an upgrade changing the connection versions or delay period must be initialised by the authority before it can be accepted")
+		}
+
+		if err := k.validateSelfUpgradeFields(ctx, connection, currentFields); err != nil {
+			return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to initialize upgrade")
+		}
+
+		connection, upgrade = k.WriteUpgradeInitConnection(ctx, connectionID, types.NewUpgrade(currentFields, 0))
+	}
+
+	if err := checkForUpgradeCompatibility(upgrade.Fields, counterpartyUpgradeFields); err != nil {
+		return types.ConnectionEnd{}, types.Upgrade{}, errorsmod.Wrap(err, "failed upgrade compatibility check")
+	}
+
+	// if the counterparty sequence is greater than the current sequence, we fast-forward to the counterparty sequence.
+	if counterpartyUpgradeSequence > connection.UpgradeSequence {
+		connection.UpgradeSequence = counterpartyUpgradeSequence
+		k.SetConnection(ctx, connectionID, connection)
+	}
+
+	return connection, upgrade, nil
+}
+
+// WriteUpgradeTryConnection writes the upgrade, with its timeout set, and the counterparty upgrade to state after
+// successfully passing the UpgradeTry handshake step. An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTryConnection(ctx sdk.Context, connectionID string, upgrade types.Upgrade, counterpartyUpgradeFields types.UpgradeFields) (types.ConnectionEnd, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-try")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when updating connection state in successful ConnUpgradeTry step, connectionID: %s", connectionID))
+	}
+
+	upgrade.TimeoutTimestamp = k.getAbsoluteUpgradeTimeout(ctx)
+
+	k.SetUpgrade(ctx, connectionID, upgrade)
+	k.SetCounterpartyUpgrade(ctx, connectionID, types.NewUpgrade(counterpartyUpgradeFields, 0))
+
+	k.Logger(ctx).Info("connection upgrade accepted", "connection-id", connectionID, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	emitConnectionUpgradeTryEvent(ctx, connectionID, connection, upgrade)
+
+	return connection, upgrade
+}
+
+// ConnUpgradeAck is called to accept the ACKUPGRADE handshake step of the connection upgrade protocol.
+// It verifies that the counterparty has called ConnUpgradeTry and that the upgrade it accepted is
+// compatible with the upgrade proposed on this chain. An UpgradeError is returned if the upgrades are
+// incompatible or if the counterparty upgrade timeout has elapsed, in which case the upgrade is expected
+// to be aborted.
+func (k Keeper) ConnUpgradeAck(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgrade types.Upgrade,
+	counterpartyClient exported.ClientState,
+	connectionProof,
+	upgradeProof,
+	clientProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	// the counterparty connection has not been upgraded yet and must be at the same upgrade sequence
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	counterpartyConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, connection.Versions, connection.DelayPeriod)
+	counterpartyConnection.UpgradeSequence = connection.UpgradeSequence
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
+	if err := k.VerifyConnectionUpgrade(
+		ctx, connection, proofHeight, upgradeProof, connection.Counterparty.ConnectionId, counterpartyUpgrade,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	if err := k.validateCounterpartyUpgradeClient(ctx, connection, counterpartyUpgrade.Fields.ClientId, counterpartyClient, clientProof, proofHeight); err != nil {
+		return err
+	}
+
+	// if upgrades are not compatible by ACK step, then we restore the connection
+	if err := checkForUpgradeCompatibility(upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
+		return types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	selfTimestamp := uint64(ctx.BlockTime().UnixNano())
+	if counterpartyUpgrade.IsTimedOut(selfTimestamp) {
+		return types.NewUpgradeError(connection.UpgradeSequence, errorsmod.Wrapf(
+			types.ErrUpgradeTimeout, "counterparty upgrade timeout elapsed: current timestamp: %d, timeout timestamp %d", selfTimestamp, counterpartyUpgrade.TimeoutTimestamp,
+		))
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckConnection upgrades a connection which has successfully passed the UpgradeAck handshake step.
+// The connection end is updated with the upgrade fields and auxiliary upgrade state is deleted.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeAckConnection(ctx sdk.Context, connectionID string, counterpartyUpgrade types.Upgrade) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-ack")
+
+	connection := k.upgradeConnection(ctx, connectionID, counterpartyUpgrade)
+
+	emitConnectionUpgradeAckEvent(ctx, connectionID, connection)
+
+	return connection
+}
+
+// ConnUpgradeConfirm is called on the chain which accepted the upgrade in ConnUpgradeTry once the counterparty
+// has upgraded its connection end in ConnUpgradeAck. The counterparty connection end is verified to have been
+// upgraded to the agreed upon upgrade fields.
+func (k Keeper) ConnUpgradeConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	if upgrade.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "cannot confirm an upgrade which has not been accepted in the TRY step")
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve counterparty connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	// the counterparty connection has already been upgraded and must be at the same upgrade sequence
+	expectedCounterparty := types.NewCounterparty(upgrade.Fields.ClientId, connectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	counterpartyConnection := types.NewConnectionEnd(types.OPEN, counterpartyUpgrade.Fields.ClientId, expectedCounterparty, upgrade.Fields.Versions, upgrade.Fields.DelayPeriod)
+	counterpartyConnection.UpgradeSequence = connection.UpgradeSequence
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmConnection upgrades a connection which has successfully passed the UpgradeConfirm handshake step.
+// The connection end is updated with the upgrade fields and auxiliary upgrade state is deleted.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmConnection(ctx sdk.Context, connectionID string) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-confirm")
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing counterparty upgrade when updating connection state in successful ConnUpgradeConfirm step, connectionID: %s", connectionID))
+	}
+
+	connection := k.upgradeConnection(ctx, connectionID, counterpartyUpgrade)
+
+	emitConnectionUpgradeConfirmEvent(ctx, connectionID, connection)
+
+	return connection
+}
+
+// ConnUpgradeTimeout times out an outstanding upgrade which has been accepted in ConnUpgradeTry.
+// This should be used when the counterparty chain has not upgraded its connection end within the
+// timeout set in the TRY step.
+func (k Keeper) ConnUpgradeTimeout(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyConnection types.ConnectionEnd,
+	counterpartyConnectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	if upgrade.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "cannot time out an upgrade before the TRY step")
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve counterparty connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	proofTimestamp, err := k.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	// proof must be from a height after timeout has elapsed
+	if !upgrade.IsTimedOut(proofTimestamp) {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeoutFailed, "upgrade timeout not reached: proof timestamp: %d, timeout timestamp %d", proofTimestamp, upgrade.TimeoutTimestamp)
+	}
+
+	// counterparty connection must be proved to still be in OPEN state
+	if counterpartyConnection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidCounterparty, "expected %s, got %s", types.OPEN, counterpartyConnection.State)
+	}
+
+	upgradeAlreadyComplete := counterpartyConnection.ClientId == counterpartyUpgrade.Fields.ClientId &&
+		counterpartyConnection.Counterparty.ClientId == upgrade.Fields.ClientId &&
+		counterpartyConnection.DelayPeriod == upgrade.Fields.DelayPeriod &&
+		reflect.DeepEqual(counterpartyConnection.Versions, upgrade.Fields.Versions)
+	if upgradeAlreadyComplete {
+		// counterparty has already successfully upgraded so we cannot timeout
+		return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "counterparty connection is already upgraded")
+	}
+
+	if counterpartyConnection.UpgradeSequence < connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty connection upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)", counterpartyConnection.UpgradeSequence, connection.UpgradeSequence)
+	}
+
+	// verify the counterparty connection state
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, counterpartyConnectionProof, connection.Counterparty.ConnectionId, counterpartyConnection,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty connection state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutConnection restores the connection end to its pre-upgrade state in the event that the
+// counterparty chain has not upgraded its connection end before the timeout set in ConnUpgradeTry.
+// Auxiliary upgrade state is deleted and an error receipt is written.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTimeoutConnection(ctx sdk.Context, connectionID string) (types.ConnectionEnd, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-timeout")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when updating connection state in successful ConnUpgradeTimeout step, connectionID: %s", connectionID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing upgrade when timing out connection upgrade, connectionID: %s", connectionID))
+	}
+
+	connection = k.restoreConnection(ctx, connectionID, connection.UpgradeSequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(connection.UpgradeSequence, types.ErrUpgradeTimeout))
+
+	k.Logger(ctx).Info("connection upgrade timed out", "connection-id", connectionID)
+
+	emitConnectionUpgradeTimeoutEvent(ctx, connectionID, connection, upgrade)
+
+	return connection, upgrade
+}
+
+// ConnUpgradeCancel is called to prove that an error receipt was written on the counterparty which constitutes
+// a valid situation where the upgrade should be cancelled. An error is returned if sufficient evidence for
+// cancelling the upgrade has not been provided.
+func (k Keeper) ConnUpgradeCancel(
+	ctx sdk.Context,
+	connectionID string,
+	errorReceipt types.ErrorReceipt,
+	errorReceiptProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", connectionID)
+	}
+
+	// an error receipt proof must be provided.
+	if len(errorReceiptProof) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof unless the sender is authorized to cancel upgrades AND the upgrade has not been accepted in the TRY step")
+	}
+
+	// REPLAY PROTECTION: The error receipt MUST have a sequence greater than or equal to the current upgrade sequence,
+	// except when the upgrade has been accepted in the TRY step, in which case the sequences MUST match. This is required
+	// to guarantee that when a counterparty successfully completes an upgrade, this connection cannot cancel its upgrade
+	// by proof of an error receipt written for a subsequent upgrade attempt of the counterparty.
+	if upgrade.TimeoutTimestamp != 0 && errorReceipt.Sequence != connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be equal to current upgrade sequence (%d) once the upgrade has been accepted", errorReceipt.Sequence, connection.UpgradeSequence)
+	}
+	if errorReceipt.Sequence < connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to current upgrade sequence (%d)", errorReceipt.Sequence, connection.UpgradeSequence)
+	}
+
+	if err := k.VerifyConnectionUpgradeError(
+		ctx, connection, proofHeight, errorReceiptProof, connection.Counterparty.ConnectionId, errorReceipt,
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty error receipt")
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelConnection restores the connection end to its pre-upgrade state at the provided upgrade
+// sequence. Auxiliary upgrade state is deleted and an error receipt is written.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeCancelConnection(ctx sdk.Context, connectionID string, sequence uint64) types.ConnectionEnd {
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-cancel")
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when updating connection state, connectionID: %s", connectionID))
+	}
+
+	connection = k.restoreConnection(ctx, connectionID, sequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	k.Logger(ctx).Info("connection upgrade cancelled", "connection-id", connectionID)
+
+	emitConnectionUpgradeCancelEvent(ctx, connectionID, connection)
+
+	return connection
+}
+
+// MustAbortUpgrade will restore the connection end to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+// This function is expected to always succeed, a panic will occur if an error occurs.
+func (k Keeper) MustAbortUpgrade(ctx sdk.Context, connectionID string, err error) {
+	if err := k.abortUpgrade(ctx, connectionID, err); err != nil {
+		panic(err)
+	}
+}
+
+// abortUpgrade will restore the connection end to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+func (k Keeper) abortUpgrade(ctx sdk.Context, connectionID string, err error) error {
+	if err == nil {
+		return errorsmod.Wrap(types.ErrInvalidUpgradeError, "cannot abort upgrade handshake with nil error")
+	}
+
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	upgradeError, ok := err.(*types.UpgradeError)
+	if !ok {
+		upgradeError = types.NewUpgradeError(connection.UpgradeSequence, err)
+	}
+
+	k.restoreConnection(ctx, connectionID, connection.UpgradeSequence, connection)
+	k.WriteErrorReceipt(ctx, connectionID, upgradeError)
+
+	return nil
+}
+
+// restoreConnection will restore the connection end to its pre-upgrade state so that upgrade is aborted.
+func (k Keeper) restoreConnection(ctx sdk.Context, connectionID string, upgradeSequence uint64, connection types.ConnectionEnd) types.ConnectionEnd {
+	connection.UpgradeSequence = upgradeSequence
+
+	k.SetConnection(ctx, connectionID, connection)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, connectionID)
+
+	return connection
+}
+
+// WriteErrorReceipt will write an error receipt from the provided UpgradeError.
+func (k Keeper) WriteErrorReceipt(ctx sdk.Context, connectionID string, upgradeError *types.UpgradeError) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(errorsmod.Wrap(types.ErrConnectionNotFound, connectionID))
+	}
+
+	errorReceiptToWrite := upgradeError.GetErrorReceipt()
+
+	existingErrorReceipt, found := k.GetUpgradeErrorReceipt(ctx, connectionID)
+	if found && existingErrorReceipt.Sequence >= errorReceiptToWrite.Sequence {
+		panic(errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than existing error receipt sequence (%d)", errorReceiptToWrite.Sequence, existingErrorReceipt.Sequence))
+	}
+
+	k.SetUpgradeErrorReceipt(ctx, connectionID, errorReceiptToWrite)
+	emitErrorReceiptEvent(ctx, connectionID, connection, upgradeError)
+}
+
+// upgradeConnection sets the upgrade fields on the connection end and moves the connection to the
+// client it is upgraded to. The counterparty client identifier is taken from the counterparty upgrade.
+// Auxiliary upgrade state is deleted.
+func (k Keeper) upgradeConnection(ctx sdk.Context, connectionID string, counterpartyUpgrade types.Upgrade) types.ConnectionEnd {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing connection when upgrading connection, connectionID: %s", connectionID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, connectionID)
+	if !found {
+		panic(fmt.Errorf("could not find existing upgrade when upgrading connection, connectionID: %s", connectionID))
+	}
+
+	previousClientID := connection.ClientId
+	if previousClientID != upgrade.Fields.ClientId {
+		k.removeConnectionFromClient(ctx, previousClientID, connectionID)
+		if err := k.addConnectionToClient(ctx, upgrade.Fields.ClientId, connectionID); err != nil {
+			panic(err)
+		}
+	}
+
+	connection.ClientId = upgrade.Fields.ClientId
+	connection.Versions = upgrade.Fields.Versions
+	connection.DelayPeriod = upgrade.Fields.DelayPeriod
+	connection.Counterparty.ClientId = counterpartyUpgrade.Fields.ClientId
+
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteUpgradeInfo(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgraded", "connection-id", connectionID, "previous-client-id", previousClientID, "client-id", connection.ClientId, "upgrade-sequence", fmt.Sprintf("%d", connection.UpgradeSequence))
+
+	return connection
+}
+
+// getAbsoluteUpgradeTimeout returns the absolute timeout timestamp for an upgrade accepted in the current block.
+func (k Keeper) getAbsoluteUpgradeTimeout(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().UnixNano()) + k.GetParams(ctx).GetUpgradeTimeoutOrDefault()
+}
+
+// validateSelfUpgradeFields validates the proposed upgrade fields for the connection end on this chain.
+// It returns an error if the following constraints are not met:
+// - the proposed client exists and is active
+// - the proposed client tracks the same chain as the client the connection is currently built on
+// - the proposed version is supported by this chain
+func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, connection types.ConnectionEnd, proposedUpgrade types.UpgradeFields) error {
+	clientState, found := k.clientKeeper.GetClientState(ctx, proposedUpgrade.ClientId)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID (%s)", proposedUpgrade.ClientId)
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, proposedUpgrade.ClientId); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", proposedUpgrade.ClientId, status)
+	}
+
+	if proposedUpgrade.ClientId != connection.ClientId {
+		currentClientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+		if !found {
+			return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "clientID (%s)", connection.ClientId)
+		}
+
+		if err := clienttypes.ValidateSameChain(currentClientState, clientState); err != nil {
+			return errorsmod.Wrap(err, "upgrade client must track the same chain as the current client")
+		}
+	}
+
+	for _, version := range proposedUpgrade.Versions {
+		if !types.IsSupportedVersion(types.GetCompatibleVersions(), version) {
+			return errorsmod.Wrapf(types.ErrInvalidVersion, "version %s is not supported", version)
+		}
+	}
+
+	return nil
+}
+
+// validateCounterpartyUpgradeClient validates the client the counterparty connection end is upgraded to.
+// No validation is performed if the counterparty upgrade keeps its current client. Otherwise, the provided
+// client state must be a valid client of this chain and must be proven to be stored on the counterparty
+// under the proposed client identifier.
+func (k Keeper) validateCounterpartyUpgradeClient(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	counterpartyClientID string,
+	counterpartyClient exported.ClientState,
+	clientProof []byte,
+	proofHeight exported.Height,
+) error {
+	if counterpartyClientID == connection.Counterparty.ClientId {
+		return nil
+	}
+
+	if counterpartyClient == nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "client state must be provided for counterparty upgrade client (%s)", counterpartyClientID)
+	}
+
+	if err := k.clientKeeper.ValidateSelfClient(ctx, counterpartyClient); err != nil {
+		return errorsmod.Wrapf(err, "counterparty upgrade client (%s) is not a valid client of this chain", counterpartyClientID)
+	}
+
+	if err := k.VerifyUpgradeClientState(ctx, connection, proofHeight, clientProof, counterpartyClientID, counterpartyClient); err != nil {
+		return errorsmod.Wrap(err, "failed to verify counterparty upgrade client state")
+	}
+
+	return nil
+}
+
+// checkForUpgradeCompatibility checks that the upgrade fields proposed on both ends agree on the versions
+// and delay period of the upgraded connection.
+func checkForUpgradeCompatibility(upgradeFields, counterpartyUpgradeFields types.UpgradeFields) error {
+	if !reflect.DeepEqual(upgradeFields.Versions, counterpartyUpgradeFields.Versions) {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade versions (%s) to match counterparty upgrade versions (%s)", upgradeFields.Versions, counterpartyUpgradeFields.Versions)
+	}
+
+	if upgradeFields.DelayPeriod != counterpartyUpgradeFields.DelayPeriod {
+		return errorsmod.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade delay period (%d) to match counterparty upgrade delay period (%d)", upgradeFields.DelayPeriod, counterpartyUpgradeFields.DelayPeriod)
+	}
+
+	return nil
+}
+
+// extractUpgradeFields returns the upgrade fields from the provided connection end.
+func extractUpgradeFields(connection types.ConnectionEnd) types.UpgradeFields {
+	return types.NewUpgradeFields(connection.ClientId, connection.Versions, connection.DelayPeriod)
+}
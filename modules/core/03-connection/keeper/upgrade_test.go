@@ -0,0 +1,816 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// createUpgradeClient creates a new client on chainA tracking chainB which a connection on chainA may be upgraded to.
+func (suite *KeeperTestSuite) createUpgradeClient() string {
+	clientPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.Require().NoError(clientPath.EndpointA.CreateClient())
+
+	return clientPath.EndpointA.ClientID
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeInit() {
+	var (
+		path          *ibctesting.Path
+		upgradeFields types.UpgradeFields
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: delay period change only",
+			func() {
+				upgradeFields.ClientId = path.EndpointA.ClientID
+				upgradeFields.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade fields are identical to connection end",
+			func() {
+				upgradeFields.ClientId = path.EndpointA.ClientID
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"upgrade already accepted by counterparty",
+			func() {
+				upgrade := types.NewUpgrade(upgradeFields, uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"client not found",
+			func() {
+				upgradeFields.ClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"upgrade client tracks a different chain",
+			func() {
+				clientState := suite.chainA.GetClientState(upgradeFields.ClientId)
+				tmClientState, ok := clientState.(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.ChainId = ibctesting.InvalidID
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), upgradeFields.ClientId, tmClientState)
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"unsupported version",
+			func() {
+				upgradeFields.Versions = []*types.Version{types.NewVersion("2", []string{"ORDER_ORDERED"})}
+			},
+			types.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			connection := path.EndpointA.GetConnection()
+			upgradeFields = types.NewUpgradeFields(suite.createUpgradeClient(), connection.Versions, connection.DelayPeriod)
+
+			tc.malleate()
+
+			upgrade, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeFields)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(upgradeFields, upgrade.Fields)
+				suite.Require().Zero(upgrade.TimeoutTimestamp)
+
+				connection, upgrade := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeInitConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+				suite.Require().Equal(path.EndpointA.ClientID, connection.ClientId, "connection end must not be modified until the upgrade completes")
+
+				storedUpgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(upgrade, storedUpgrade)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTry() {
+	var (
+		path                        *ibctesting.Path
+		counterpartyUpgradeFields   types.UpgradeFields
+		counterpartyUpgradeSequence uint64
+		counterpartyClient          exported.ClientState
+		clientProof                 []byte
+	)
+
+	// setCounterpartyDelayPeriod modifies the delay period of the upgrade initialised on chainA
+	setCounterpartyDelayPeriod := func(delayPeriod uint64) {
+		counterpartyUpgradeFields.DelayPeriod = delayPeriod
+		suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, types.NewUpgrade(counterpartyUpgradeFields, 0))
+		suite.coordinator.CommitBlock(suite.chainA)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: unset upgrade timeout param falls back to the default",
+			func() {
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(uint64(types.DefaultTimePerBlock)))
+			},
+			nil,
+		},
+		{
+			"success: crossing hellos",
+			func() {
+				setCounterpartyDelayPeriod(uint64(time.Hour.Nanoseconds()))
+
+				path.EndpointB.ConnectionConfig.ProposedUpgrade.DelayPeriod = counterpartyUpgradeFields.DelayPeriod
+				suite.Require().NoError(path.EndpointB.ConnUpgradeInit())
+			},
+			nil,
+		},
+		{
+			"counterparty changes delay period without an upgrade initialised by the authority",
+			func() {
+				setCounterpartyDelayPeriod(uint64(time.Hour.Nanoseconds()))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"success: counterparty upgrade sequence is fast-forwarded",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.UpgradeSequence = 0
+				path.EndpointB.SetConnection(connection)
+
+				connection = path.EndpointA.GetConnection()
+				connection.UpgradeSequence = 5
+				path.EndpointA.SetConnection(connection)
+				counterpartyUpgradeSequence = 5
+
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade already accepted",
+			func() {
+				upgrade := types.NewUpgrade(counterpartyUpgradeFields, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"counterparty connection verification failed",
+			func() {
+				counterpartyUpgradeSequence++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"counterparty upgrade sequence is outdated",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.UpgradeSequence = 5
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"counterparty upgrade verification failed",
+			func() {
+				counterpartyUpgradeFields.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"crossing hellos: incompatible upgrade",
+			func() {
+				setCounterpartyDelayPeriod(uint64(time.Hour.Nanoseconds()))
+
+				path.EndpointB.ConnectionConfig.ProposedUpgrade.DelayPeriod = counterpartyUpgradeFields.DelayPeriod + 1
+				suite.Require().NoError(path.EndpointB.ConnUpgradeInit())
+			},
+			types.ErrIncompatibleCounterpartyUpgrade,
+		},
+		{
+			"counterparty client state not provided",
+			func() {
+				counterpartyClient = nil
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"counterparty client is not a valid client of this chain",
+			func() {
+				tmClientState, ok := counterpartyClient.(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.ChainId = ibctesting.InvalidID
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"counterparty client state verification failed",
+			func() {
+				tmClientState, ok := counterpartyClient.(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.MaxClockDrift++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: suite.createUpgradeClient()}
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+
+			counterpartyUpgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+			suite.Require().True(found)
+
+			counterpartyUpgradeFields = counterpartyUpgrade.Fields
+			counterpartyUpgradeSequence = path.EndpointA.GetConnection().UpgradeSequence
+			counterpartyClient = suite.chainA.GetClientState(counterpartyUpgradeFields.ClientId)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			connectionProof, upgradeProof, proofHeight := path.EndpointA.QueryConnectionUpgradeProof()
+			_, clientProof = path.EndpointA.QueryConnectionUpgradeClientProof(counterpartyUpgradeFields.ClientId, proofHeight)
+
+			connection, upgrade, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgradeFields, counterpartyUpgradeSequence,
+				counterpartyClient, connectionProof, upgradeProof, clientProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(counterpartyUpgradeSequence, connection.UpgradeSequence)
+				suite.Require().Equal(path.EndpointB.ClientID, upgrade.Fields.ClientId)
+				suite.Require().Equal(counterpartyUpgradeFields.DelayPeriod, upgrade.Fields.DelayPeriod)
+
+				_, upgrade = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeTryConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade, counterpartyUpgradeFields)
+
+				upgradeTimeout := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainB.GetContext()).GetUpgradeTimeoutOrDefault()
+				suite.Require().Equal(uint64(suite.chainB.GetContext().BlockTime().UnixNano())+upgradeTimeout, upgrade.TimeoutTimestamp)
+
+				storedCounterpartyUpgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(counterpartyUpgradeFields, storedCounterpartyUpgrade.Fields)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeAck() {
+	var (
+		path                *ibctesting.Path
+		counterpartyUpgrade types.Upgrade
+		counterpartyClient  exported.ClientState
+	)
+
+	// upgradeCounterpartyClient moves the upgrade accepted on chainB to a new client tracking chainA
+	upgradeCounterpartyClient := func() {
+		clientPath := ibctesting.NewPath(suite.chainB, suite.chainA)
+		suite.Require().NoError(clientPath.EndpointA.CreateClient())
+
+		counterpartyUpgrade.Fields.ClientId = clientPath.EndpointA.ClientID
+		suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgrade)
+		counterpartyClient = suite.chainB.GetClientState(counterpartyUpgrade.Fields.ClientId)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: counterparty upgrades to a new client",
+			func() {
+				upgradeCounterpartyClient()
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"counterparty client state not provided",
+			func() {
+				upgradeCounterpartyClient()
+				counterpartyClient = nil
+			},
+			clienttypes.ErrInvalidClient,
+		},
+		{
+			"counterparty client state verification failed",
+			func() {
+				upgradeCounterpartyClient()
+
+				tmClientState, ok := counterpartyClient.(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.MaxClockDrift++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey("ibc"))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointA.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty upgrade verification failed",
+			func() {
+				counterpartyUpgrade.TimeoutTimestamp++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"incompatible upgrade",
+			func() {
+				upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.Fields.DelayPeriod++
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+			},
+			types.ErrIncompatibleCounterpartyUpgrade,
+		},
+		{
+			"counterparty upgrade timeout elapsed",
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+			},
+			types.ErrUpgradeTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			upgradeClientID := suite.createUpgradeClient()
+			path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: upgradeClientID}
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			var found bool
+			counterpartyUpgrade, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+			counterpartyClient = nil
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			connectionProof, upgradeProof, proofHeight := path.EndpointB.QueryConnectionUpgradeProof()
+			_, clientProof := path.EndpointB.QueryConnectionUpgradeClientProof(counterpartyUpgrade.Fields.ClientId, proofHeight)
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeAck(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade, counterpartyClient,
+				connectionProof, upgradeProof, clientProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeAckConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgrade)
+				suite.Require().Equal(upgradeClientID, connection.ClientId)
+				suite.Require().Equal(counterpartyUpgrade.Fields.ClientId, connection.Counterparty.ClientId)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+
+				connectionPaths, _ := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetClientConnectionPaths(suite.chainA.GetContext(), upgradeClientID)
+				suite.Require().Contains(connectionPaths, path.EndpointA.ConnectionID)
+
+				connectionPaths, _ = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetClientConnectionPaths(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().NotContains(connectionPaths, path.EndpointA.ConnectionID)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				if types.IsUpgradeError(err) {
+					suite.chainA.App.GetIBCKeeper().ConnectionKeeper.MustAbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, err)
+
+					errorReceipt, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+					suite.Require().True(found)
+					suite.Require().Equal(path.EndpointA.GetConnection().UpgradeSequence, errorReceipt.Sequence)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"upgrade not accepted in TRY step",
+			func() {
+				upgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = 0
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"counterparty connection not upgraded",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = path.EndpointA.ClientID
+				path.EndpointA.SetConnection(connection)
+
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			upgradeClientID := suite.createUpgradeClient()
+			path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: upgradeClientID}
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			connectionProof, proofHeight := path.EndpointA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeConfirmConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().Equal(upgradeClientID, connection.Counterparty.ClientId)
+				suite.Require().Equal(path.EndpointB.ClientID, connection.ClientId)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTimeout() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey("ibc"))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointB.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"upgrade not accepted in TRY step",
+			func() {
+				upgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = 0
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"timeout not reached",
+			func() {
+				upgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgrade)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"counterparty connection already upgraded",
+			func() {
+				counterpartyUpgrade, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+
+				connection := path.EndpointA.GetConnection()
+				connection.ClientId = counterpartyUpgrade.Fields.ClientId
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"counterparty upgrade sequence is outdated",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.UpgradeSequence = 0
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: suite.createUpgradeClient()}
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			suite.coordinator.IncrementTimeBy(time.Hour)
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			connectionProof, proofHeight := path.EndpointA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTimeout(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.GetConnection(), connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection, _ := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeTimeoutConnection(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().False(found)
+
+				errorReceipt, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(connection.UpgradeSequence, errorReceipt.Sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeCancel() {
+	var (
+		path         *ibctesting.Path
+		errorReceipt types.ErrorReceipt
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"upgrade not found",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey("ibc"))
+				store.Delete(host.ConnectionUpgradeKey(path.EndpointA.ConnectionID))
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"error receipt sequence is outdated",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.UpgradeSequence++
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt sequence must match once the upgrade has been accepted",
+			func() {
+				upgrade, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().True(found)
+
+				upgrade.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgrade)
+
+				errorReceipt.Sequence++
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"error receipt verification failed",
+			func() {
+				errorReceipt.Message = "invalid error receipt"
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: suite.createUpgradeClient()}
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+
+			// time out the upgrade on chainB to write an error receipt
+			suite.coordinator.IncrementTimeBy(time.Hour)
+			suite.coordinator.CommitBlock(suite.chainA)
+			suite.Require().NoError(path.EndpointB.ConnUpgradeTimeout())
+
+			var found bool
+			errorReceipt, found = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+			suite.Require().True(found)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			errorReceiptProof, proofHeight := path.EndpointB.QueryProof(host.ConnectionUpgradeErrorKey(path.EndpointB.ConnectionID))
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, errorReceipt, errorReceiptProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.WriteUpgradeCancelConnection(suite.chainA.GetContext(), path.EndpointA.ConnectionID, errorReceipt.Sequence)
+				suite.Require().Equal(errorReceipt.Sequence, connection.UpgradeSequence)
+				suite.Require().Equal(path.EndpointA.ClientID, connection.ClientId)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestConnectionUpgrade performs the full connection upgrade handshake, moving the connection on chainA
+// to a new client, and asserts that packets can be relayed over the upgraded connection.
+func (suite *KeeperTestSuite) TestConnectionUpgrade() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	upgradeClientID := suite.createUpgradeClient()
+	delayPeriod := uint64(time.Second.Nanoseconds())
+	path.EndpointA.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{ClientId: upgradeClientID, DelayPeriod: delayPeriod}
+	path.EndpointB.ConnectionConfig.ProposedUpgrade = types.UpgradeFields{DelayPeriod: delayPeriod}
+
+	// the delay period change must be authorized on both chains
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+
+	path.EndpointA.ClientID = upgradeClientID
+	suite.Require().NoError(path.EndpointB.ConnUpgradeConfirm())
+
+	connectionA, connectionB := path.EndpointA.GetConnection(), path.EndpointB.GetConnection()
+	suite.Require().Equal(upgradeClientID, connectionA.ClientId)
+	suite.Require().Equal(upgradeClientID, connectionB.Counterparty.ClientId)
+	suite.Require().Equal(delayPeriod, connectionA.DelayPeriod)
+	suite.Require().Equal(delayPeriod, connectionB.DelayPeriod)
+	suite.Require().Equal(uint64(1), connectionA.UpgradeSequence)
+	suite.Require().Equal(uint64(1), connectionB.UpgradeSequence)
+
+	// relay a packet over the upgraded connection
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+	timeoutHeight.RevisionHeight += 100
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	// wait for the upgraded delay period to pass before relaying
+	suite.coordinator.IncrementTimeBy(time.Second)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.RelayPacket(packet))
+}
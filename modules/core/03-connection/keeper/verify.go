@@ -25,6 +25,32 @@ func (k Keeper) VerifyClientState(
 	height exported.Height,
 	proof []byte,
 	clientState exported.ClientState,
+) error {
+	return k.verifyClientState(ctx, connection, height, proof, connection.GetCounterparty().GetClientID(), clientState)
+}
+
+// VerifyUpgradeClientState verifies a proof of a client state of the running machine stored on the
+// target machine under the client identifier the counterparty connection end is upgraded to.
+func (k Keeper) VerifyUpgradeClientState(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	counterpartyClientID string,
+	clientState exported.ClientState,
+) error {
+	return k.verifyClientState(ctx, connection, height, proof, counterpartyClientID, clientState)
+}
+
+// verifyClientState verifies a proof of a client state of the running machine stored on the
+// target machine under the provided counterparty client identifier.
+func (k Keeper) verifyClientState(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	counterpartyClientID string,
+	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
 	targetClient, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
@@ -36,7 +62,7 @@ func (k Keeper) VerifyClientState(
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
-	merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(connection.GetCounterparty().GetClientID()))
+	merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(counterpartyClientID))
 	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
 	if err != nil {
 		return err
@@ -324,6 +350,240 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketTimeoutReceipt verifies a proof of the timeout receipt written for an
+// incoming packet which timed out on an ORDERED_ALLOW_TIMEOUT channel at the specified
+// port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketTimeoutReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := clientState.VerifyMembership(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		proof, merklePath, channeltypes.TimeoutReceipt,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet timeout receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitments verifies many outgoing packet commitments at the specified port and
+// specified channel. The commitment at each index is verified for the sequence with the same index,
+// either against a single batch proof of all commitments or against the proof with the same index.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proofs [][]byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	commitments [][]byte,
+) error {
+	if len(sequences) != len(commitments) {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of sequences (%d) must equal number of commitments (%d)", len(sequences), len(commitments))
+	}
+
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePaths, err := k.packetMerklePaths(connection, sequences, func(sequence uint64) string {
+		return host.PacketCommitmentPath(portID, channelID, sequence)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMembershipBatch(
+		ctx, clientState, clientStore, height,
+		timeDelay, blockDelay,
+		proofs, merklePaths, commitments,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitments verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies many incoming packet acknowledgements at the specified port
+// and specified channel. The acknowledgement at each index is verified for the sequence with the same
+// index, either against a single batch proof of all acknowledgements or against the proof with the same index.
+func (k Keeper) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proofs [][]byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	acknowledgements [][]byte,
+) error {
+	if len(sequences) != len(acknowledgements) {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of sequences (%d) must equal number of acknowledgements (%d)", len(sequences), len(acknowledgements))
+	}
+
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePaths, err := k.packetMerklePaths(connection, sequences, func(sequence uint64) string {
+		return host.PacketAcknowledgementPath(portID, channelID, sequence)
+	})
+	if err != nil {
+		return err
+	}
+
+	ackCommitments := make([][]byte, len(acknowledgements))
+	for i, acknowledgement := range acknowledgements {
+		ackCommitments[i] = channeltypes.CommitAcknowledgement(acknowledgement)
+	}
+
+	if err := k.verifyMembershipBatch(
+		ctx, clientState, clientStore, height,
+		timeDelay, blockDelay,
+		proofs, merklePaths, ackCommitments,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgements verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketReceiptAbsences verifies the absence of many incoming packet receipts at the specified
+// port and specified channel. The absence of the receipt of each sequence is verified either against a
+// single batch proof of all absences or against the proof with the same index.
+func (k Keeper) VerifyPacketReceiptAbsences(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proofs [][]byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePaths, err := k.packetMerklePaths(connection, sequences, func(sequence uint64) string {
+		return host.PacketReceiptPath(portID, channelID, sequence)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyNonMembershipBatch(
+		ctx, clientState, clientStore, height,
+		timeDelay, blockDelay,
+		proofs, merklePaths,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt absences verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitmentAbsences verifies the absence of many outgoing packet commitments at the specified
+// port and specified channel. The absence of the commitment of each sequence is verified either against a
+// single batch proof of all absences or against the proof with the same index.
+func (k Keeper) VerifyPacketCommitmentAbsences(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proofs [][]byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePaths, err := k.packetMerklePaths(connection, sequences, func(sequence uint64) string {
+		return host.PacketCommitmentPath(portID, channelID, sequence)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyNonMembershipBatch(
+		ctx, clientState, clientStore, height,
+		timeDelay, blockDelay,
+		proofs, merklePaths,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet commitment absences verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k Keeper) VerifyNextSequenceRecv(
@@ -450,6 +710,88 @@ func (k Keeper) VerifyChannelUpgrade(
 	return nil
 }
 
+// VerifyConnectionUpgradeError verifies a proof of the provided connection upgrade error receipt.
+func (k Keeper) VerifyConnectionUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	counterpartyConnectionID string,
+	errorReceipt connectiontypes.ErrorReceipt,
+) error {
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradeErrorPath(counterpartyConnectionID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if err := clientState.VerifyMembership(
+		ctx, clientStore, k.cdc, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed connection upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyConnectionUpgrade verifies the proof that a particular proposed connection upgrade has been stored in the upgrade path.
+func (k Keeper) VerifyConnectionUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	proofHeight exported.Height,
+	upgradeProof []byte,
+	counterpartyConnectionID string,
+	upgrade connectiontypes.Upgrade,
+) error {
+	clientID := connection.GetClientID()
+	clientState, clientStore, err := k.getClientStateAndVerificationStore(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionUpgradePath(counterpartyConnectionID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&upgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := clientState.VerifyMembership(
+		ctx, clientStore, k.cdc, proofHeight,
+		0, 0, // skip delay period checks for non-packet processing verification
+		upgradeProof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed upgrade verification for client (%s) on connection (%s)", clientID, counterpartyConnectionID)
+	}
+
+	return nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
@@ -480,3 +822,110 @@ func (k Keeper) getClientStateAndVerificationStore(ctx sdk.Context, clientID str
 
 	return clientState, store, nil
 }
+
+// packetMerklePaths returns the merkle path, prefixed with the counterparty commitment prefix of the
+// connection, of each of the given sequences using the provided packet path function.
+func (Keeper) packetMerklePaths(connection exported.ConnectionI, sequences []uint64, packetPath func(sequence uint64) string) ([]exported.Path, error) {
+	if len(sequences) == 0 {
+		return nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "sequences cannot be empty")
+	}
+
+	merklePaths := make([]exported.Path, len(sequences))
+	for i, sequence := range sequences {
+		merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), commitmenttypes.NewMerklePath(packetPath(sequence)))
+		if err != nil {
+			return nil, err
+		}
+
+		merklePaths[i] = merklePath
+	}
+
+	return merklePaths, nil
+}
+
+// verifyMembershipBatch verifies the membership of each value at the path with the same index. A single proof
+// of all paths is verified using the batch verification of the client, while a proof for each path is verified
+// individually against the path with the same index, which is supported by every client.
+func (k Keeper) verifyMembershipBatch(
+	ctx sdk.Context,
+	clientState exported.ClientState,
+	clientStore storetypes.KVStore,
+	height exported.Height,
+	timeDelay, blockDelay uint64,
+	proofs [][]byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	batchVerifier, err := batchProofVerifier(clientState, proofs, paths)
+	if err != nil {
+		return err
+	}
+
+	if batchVerifier != nil {
+		return batchVerifier.VerifyMembershipBatch(ctx, clientStore, k.cdc, height, timeDelay, blockDelay, proofs[0], paths, values)
+	}
+
+	for i, path := range paths {
+		if err := clientState.VerifyMembership(ctx, clientStore, k.cdc, height, timeDelay, blockDelay, proofs[i], path, values[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify membership at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyNonMembershipBatch verifies the absence of each path. A single proof of all paths is verified using the
+// batch verification of the client, while a proof for each path is verified individually against the path with
+// the same index, which is supported by every client.
+func (k Keeper) verifyNonMembershipBatch(
+	ctx sdk.Context,
+	clientState exported.ClientState,
+	clientStore storetypes.KVStore,
+	height exported.Height,
+	timeDelay, blockDelay uint64,
+	proofs [][]byte,
+	paths []exported.Path,
+) error {
+	batchVerifier, err := batchProofVerifier(clientState, proofs, paths)
+	if err != nil {
+		return err
+	}
+
+	if batchVerifier != nil {
+		return batchVerifier.VerifyNonMembershipBatch(ctx, clientStore, k.cdc, height, timeDelay, blockDelay, proofs[0], paths)
+	}
+
+	for i, path := range paths {
+		if err := clientState.VerifyNonMembership(ctx, clientStore, k.cdc, height, timeDelay, blockDelay, proofs[i], path); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify non-membership at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// batchProofVerifier returns the batch verifier of the client if a single proof is provided for the given paths
+// and the client implements the MembershipBatchVerifier interface. Nil is returned if each path is to be verified
+// against the proof with the same index. An error is returned if a single proof of many paths is provided to a
+// client which does not implement batch verification, or if the number of proofs does not match the number of paths.
+func batchProofVerifier(clientState exported.ClientState, proofs [][]byte, paths []exported.Path) (exported.MembershipBatchVerifier, error) {
+	if len(proofs) == 1 {
+		if batchVerifier, ok := clientState.(exported.MembershipBatchVerifier); ok {
+			return batchVerifier, nil
+		}
+
+		if len(paths) != 1 {
+			return nil, errorsmod.Wrapf(
+				ibcerrors.ErrInvalidRequest,
+				"client type %s does not support batch proof verification, a proof must be provided for each of the %d paths",
+				clientState.ClientType(), len(paths),
+			)
+		}
+	}
+
+	if len(proofs) != len(paths) {
+		return nil, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs (%d) must be one or equal number of paths (%d)", len(proofs), len(paths))
+	}
+
+	return nil, nil
+}
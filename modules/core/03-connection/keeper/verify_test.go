@@ -7,8 +7,11 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
@@ -582,6 +585,396 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+// TestVerifyPacketCommitments has chainB verify a single batch proof of many packet
+// commitments on channelA. The channels on chainA and chainB are fully opened and
+// packets are sent from chainA to chainB.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitments() {
+	var (
+		path        *ibctesting.Path
+		packets     []channeltypes.Packet
+		sequences   []uint64
+		commitments [][]byte
+		heightDiff  uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: subset of batch", func() {
+			sequences = sequences[1:]
+			commitments = commitments[1:]
+		}, true},
+		{"client state not found - changed client ID", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointB.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - changed packet commitment state", func() {
+			packets[1].Data = []byte(ibctesting.InvalidID)
+			commitments[1] = channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packets[1])
+		}, false},
+		{"verification failed - packet not in batch", func() {
+			sequences[1] = 100
+		}, false},
+		{"number of sequences does not match number of commitments", func() {
+			commitments = commitments[1:]
+		}, false},
+		{"empty sequences", func() {
+			sequences = nil
+			commitments = nil
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, sequences, commitments = nil, nil, nil
+			var keys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				packets = append(packets, packet)
+				sequences = append(sequences, sequence)
+				commitments = append(commitments, channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet))
+				keys = append(keys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			proof, proofHeight := path.EndpointA.QueryBatchProof(keys...)
+
+			// reset variables
+			heightDiff = 0
+			tc.malleate()
+
+			connection := path.EndpointB.GetConnection()
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitments(
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), [][]byte{proof},
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketCommitmentsWithoutBatchVerifier has chainB verify packet commitments using a solo
+// machine client, which does not implement batch proof verification. A solo machine proof covers a
+// single path, so a proof must be provided for each packet of a batch.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentsWithoutBatchVerifier() {
+	var (
+		solo        *ibctesting.Solomachine
+		sequences   []uint64
+		commitments [][]byte
+		proofs      [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"verification success: proof of each packet", func() {}, nil},
+		{"verification success: batch of a single packet", func() {
+			sequences, commitments, proofs = sequences[:1], commitments[:1], proofs[:1]
+		}, nil},
+		{"single proof of many packets", func() {
+			proofs = proofs[:1]
+		}, ibcerrors.ErrInvalidRequest},
+		{"number of proofs does not match number of packets", func() {
+			proofs = append(proofs, proofs[0])
+		}, commitmenttypes.ErrInvalidProof},
+		{"verification failed - proofs in wrong order", func() {
+			proofs[0], proofs[1] = proofs[1], proofs[0]
+		}, solomachine.ErrSignatureVerificationFailed},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// swap client with solo machine
+			solo = ibctesting.NewSolomachine(suite.T(), suite.chainB.Codec, "solomachinesingle", "testing", 1)
+			path.EndpointB.ClientID = clienttypes.FormatClientIdentifier(exported.Solomachine, 10)
+			path.EndpointB.SetClientState(solo.ClientState())
+			connection := path.EndpointB.GetConnection()
+			connection.ClientId = path.EndpointB.ClientID
+			path.EndpointB.SetConnection(connection)
+
+			sequences, commitments, proofs = nil, nil, nil
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				sequences = append(sequences, sequence)
+				commitments = append(commitments, channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet))
+				proofs = append(proofs, solo.GenerateCommitmentProof(packet))
+			}
+
+			tc.malleate()
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitments(
+				suite.chainB.GetContext(), connection, clienttypes.ZeroHeight(), proofs,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, commitments,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketAcknowledgements has chainA verify a single batch proof of many
+// acknowledgements on channelB. The channels on chainA and chainB are fully opened and
+// packets are sent from chainA to chainB and received.
+func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgements() {
+	var (
+		path      *ibctesting.Path
+		sequences []uint64
+		acks      [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification failed - changed acknowledgement", func() {
+			acks[1] = ibcmock.MockFailAcknowledgement.Acknowledgement()
+		}, false},
+		{"number of sequences does not match number of acknowledgements", func() {
+			acks = acks[1:]
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequences, acks = nil, nil
+			var keys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				sequences = append(sequences, sequence)
+				acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+				keys = append(keys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+
+			tc.malleate()
+
+			connection := path.EndpointA.GetConnection()
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketAcknowledgements(
+				suite.chainA.GetContext(), connection, proofHeight, [][]byte{proof},
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequences, acks,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketReceiptAbsences has chainA verify a single batch proof of the absence
+// of many receipts on channelB. The channels on chainA and chainB are fully opened and
+// packets are sent from chainA to chainB and not received.
+func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsences() {
+	var (
+		path      *ibctesting.Path
+		packets   []channeltypes.Packet
+		sequences []uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification failed - packet was received", func() {
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, sequences = nil, nil
+			var keys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				packets = append(packets, packet)
+				sequences = append(sequences, sequence)
+				keys = append(keys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			tc.malleate()
+
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			if clientState.FrozenHeight.IsZero() {
+				// need to update height to prove absence or receipt
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			}
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+
+			connection := path.EndpointA.GetConnection()
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceiptAbsences(
+				suite.chainA.GetContext(), connection, proofHeight, [][]byte{proof},
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequences,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketCommitmentAbsences has chainB verify a single batch proof of the absence
+// of many packet commitments on channelA. The channels on chainA and chainB are fully opened
+// and packets are sent from chainA to chainB, received and acknowledged.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentAbsences() {
+	var (
+		path      *ibctesting.Path
+		sequences []uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification failed - packet was not acknowledged", func() {
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			sequences = append(sequences, sequence)
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequences = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				sequences = append(sequences, sequence)
+			}
+
+			tc.malleate()
+
+			clientState := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			if clientState.FrozenHeight.IsZero() {
+				// need to update height to prove absence of commitments
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+			}
+
+			var keys [][]byte
+			for _, sequence := range sequences {
+				keys = append(keys, host.PacketCommitmentKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+			}
+			proof, proofHeight := path.EndpointA.QueryBatchProof(keys...)
+
+			connection := path.EndpointB.GetConnection()
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitmentAbsences(
+				suite.chainB.GetContext(), connection, proofHeight, [][]byte{proof},
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyNextSequenceRecv has chainA verify the next sequence receive on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
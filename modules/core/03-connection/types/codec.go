@@ -29,6 +29,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConnectionOpenAck{},
 		&MsgConnectionOpenConfirm{},
 		&MsgUpdateParams{},
+		&MsgConnectionUpgradeInit{},
+		&MsgConnectionUpgradeTry{},
+		&MsgConnectionUpgradeAck{},
+		&MsgConnectionUpgradeConfirm{},
+		&MsgConnectionUpgradeTimeout{},
+		&MsgConnectionUpgradeCancel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -110,12 +110,13 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedConnection creates a new IdentifiedConnection instance
 func NewIdentifiedConnection(connectionID string, conn ConnectionEnd) IdentifiedConnection {
 	return IdentifiedConnection{
-		Id:           connectionID,
-		ClientId:     conn.ClientId,
-		Versions:     conn.Versions,
-		State:        conn.State,
-		Counterparty: conn.Counterparty,
-		DelayPeriod:  conn.DelayPeriod,
+		Id:              connectionID,
+		ClientId:        conn.ClientId,
+		Versions:        conn.Versions,
+		State:           conn.State,
+		Counterparty:    conn.Counterparty,
+		DelayPeriod:     conn.DelayPeriod,
+		UpgradeSequence: conn.UpgradeSequence,
 	}
 }
 
@@ -80,6 +80,9 @@ type ConnectionEnd struct {
 	// packet-verification NOTE: delay period logic is only implemented by some
 	// clients.
 	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// upgrade sequence indicates the latest upgrade attempt performed by this connection
+	// the value of 0 indicates the connection has never been upgraded
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *ConnectionEnd) Reset()         { *m = ConnectionEnd{} }
@@ -131,6 +134,9 @@ type IdentifiedConnection struct {
 	Counterparty Counterparty `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty"`
 	// delay period associated with this connection.
 	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// upgrade sequence indicates the latest upgrade attempt performed by this connection
+	// the value of 0 indicates the connection has never been upgraded
+	UpgradeSequence uint64 `protobuf:"varint,7,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
 func (m *IdentifiedConnection) Reset()         { *m = IdentifiedConnection{} }
@@ -360,6 +366,9 @@ type Params struct {
 	// largest amount of time that the chain might reasonably take to produce the next block under normal operating
 	// conditions. A safe choice is 3-5x the expected time per block.
 	MaxExpectedTimePerBlock uint64 `protobuf:"varint,1,opt,name=max_expected_time_per_block,json=maxExpectedTimePerBlock,proto3" json:"max_expected_time_per_block,omitempty"`
+	// the relative timeout (in nanoseconds) after which connection upgrades will time out.
+	// If unset, a default of 10 minutes is used.
+	UpgradeTimeout uint64 `protobuf:"varint,2,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -402,6 +411,13 @@ func (m *Params) GetMaxExpectedTimePerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetUpgradeTimeout() uint64 {
+	if m != nil {
+		return m.UpgradeTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.connection.v1.State", State_name, State_value)
 	proto.RegisterType((*ConnectionEnd)(nil), "ibc.core.connection.v1.ConnectionEnd")
@@ -418,49 +434,52 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x4b, 0xdb, 0x50,
-	0x18, 0x4e, 0xd2, 0xb4, 0xd6, 0xd3, 0xd6, 0xb9, 0x83, 0x6c, 0x21, 0xb2, 0x34, 0xd3, 0xc1, 0xca,
-	0xc0, 0x66, 0x2a, 0x8c, 0xb1, 0x79, 0x63, 0x6b, 0x84, 0xb0, 0xad, 0x0b, 0xb1, 0x0a, 0xf3, 0x26,
-	0xa4, 0xc9, 0xb1, 0x1e, 0x6c, 0x72, 0x42, 0x72, 0x5a, 0xea, 0x3f, 0x10, 0xaf, 0x76, 0x37, 0x36,
-	0x10, 0x06, 0xbb, 0xdf, 0xef, 0xf0, 0xd2, 0xcb, 0x5d, 0x8d, 0xa1, 0x7f, 0x64, 0xe4, 0xc3, 0x26,
-	0xca, 0xec, 0xc5, 0xb6, 0xbb, 0xf7, 0xe3, 0x79, 0x9e, 0xbc, 0x79, 0xde, 0x97, 0x03, 0x9e, 0xe2,
-	0x9e, 0xad, 0xd8, 0x24, 0x40, 0x8a, 0x4d, 0x3c, 0x0f, 0xd9, 0x14, 0x13, 0x4f, 0x19, 0xad, 0xe6,
-	0xb2, 0xa6, 0x1f, 0x10, 0x4a, 0xe0, 0x03, 0xdc, 0xb3, 0x9b, 0x11, 0xb0, 0x99, 0x6b, 0x8d, 0x56,
-	0xc5, 0x85, 0x3e, 0xe9, 0x93, 0x18, 0xa2, 0x44, 0x51, 0x82, 0x16, 0xf3, 0xb2, 0xae, 0x8b, 0xa9,
-	0x8b, 0x3c, 0x9a, 0xc8, 0x5e, 0x67, 0x09, 0x70, 0xe9, 0x13, 0x07, 0x6a, 0xed, 0x89, 0xa0, 0xea,
-	0x39, 0x70, 0x11, 0xcc, 0xda, 0x03, 0x8c, 0x3c, 0x6a, 0x62, 0x47, 0x60, 0x65, 0xb6, 0x31, 0x6b,
-	0x94, 0x93, 0x82, 0xe6, 0xc0, 0xd7, 0xa0, 0x3c, 0x42, 0x41, 0x88, 0x89, 0x17, 0x0a, 0x9c, 0x5c,
-	0x68, 0x54, 0xd6, 0xea, 0xcd, 0x3f, 0x0f, 0xd6, 0xdc, 0x4b, 0x70, 0xc6, 0x84, 0x00, 0xd7, 0x41,
-	0x31, 0xa4, 0x16, 0x45, 0x42, 0x41, 0x66, 0x1b, 0x73, 0x6b, 0x8f, 0xee, 0x62, 0xee, 0x44, 0x20,
-	0x23, 0xc1, 0xc2, 0x0e, 0xa8, 0xda, 0x64, 0xe8, 0x51, 0x14, 0xf8, 0x56, 0x40, 0x8f, 0x05, 0x5e,
-	0x66, 0x1b, 0x95, 0xb5, 0x27, 0x77, 0x71, 0xdb, 0x39, 0x6c, 0x8b, 0x3f, 0xff, 0x59, 0x67, 0x8c,
-	0x1b, 0x7c, 0xf8, 0x18, 0x54, 0x1d, 0x34, 0xb0, 0x8e, 0x4d, 0x1f, 0x05, 0x98, 0x38, 0x42, 0x51,
-	0x66, 0x1b, 0xbc, 0x51, 0x89, 0x6b, 0x7a, 0x5c, 0x7a, 0xc5, 0x9f, 0x7c, 0xad, 0x33, 0x4b, 0xdf,
-	0x39, 0xb0, 0xa0, 0x39, 0xc8, 0xa3, 0xf8, 0x00, 0x23, 0x27, 0xf3, 0x08, 0xce, 0x01, 0x6e, 0xe2,
-	0x0c, 0x87, 0x6f, 0x19, 0xc6, 0x4d, 0x31, 0xac, 0xf0, 0xd7, 0x86, 0xf1, 0xff, 0x60, 0x58, 0xf1,
-	0x3f, 0x1b, 0x56, 0xba, 0xcb, 0xb0, 0x2f, 0x2c, 0xa8, 0xe6, 0xd5, 0xa6, 0x5f, 0xd2, 0x32, 0xa8,
-	0x65, 0x83, 0x64, 0xce, 0x55, 0xb3, 0xa2, 0xe6, 0xc0, 0x16, 0x28, 0xf9, 0x01, 0x3a, 0xc0, 0xe3,
-	0xf8, 0x64, 0x6e, 0xfd, 0xc5, 0xe4, 0x92, 0x47, 0xab, 0xcd, 0x77, 0x28, 0x38, 0x1a, 0x20, 0x3d,
-	0xc6, 0xa6, 0x7f, 0x91, 0x32, 0xd3, 0xe1, 0x96, 0x41, 0xa5, 0x1d, 0x7f, 0x5a, 0xb7, 0xe8, 0x61,
-	0x08, 0x17, 0x40, 0xd1, 0x8f, 0x02, 0x81, 0x95, 0x0b, 0x8d, 0x59, 0x23, 0x49, 0x96, 0xb6, 0xc0,
-	0xbd, 0x6c, 0xcf, 0x09, 0x70, 0xea, 0x3f, 0x4c, 0x54, 0xb8, 0xbc, 0xca, 0x1b, 0x30, 0x93, 0xae,
-	0x12, 0x4a, 0x00, 0xe0, 0xeb, 0x13, 0x0a, 0x52, 0x7a, 0xae, 0x02, 0x45, 0x50, 0x3e, 0x40, 0x16,
-	0x1d, 0x06, 0xe8, 0x5a, 0x63, 0x92, 0xa7, 0x73, 0x6f, 0x83, 0x92, 0x6e, 0x05, 0x96, 0x1b, 0xc2,
-	0x0d, 0xb0, 0xe8, 0x5a, 0x63, 0x13, 0x8d, 0x7d, 0x64, 0x53, 0xe4, 0x98, 0x14, 0xbb, 0x28, 0xda,
-	0x89, 0xd9, 0x1b, 0x10, 0xfb, 0x28, 0x16, 0xe7, 0x8d, 0x87, 0xae, 0x35, 0x56, 0x53, 0x44, 0x17,
-	0xbb, 0x48, 0x47, 0x41, 0x2b, 0x6a, 0x3f, 0xfb, 0xcc, 0x82, 0x62, 0x7c, 0x26, 0xf0, 0x05, 0xa8,
-	0xef, 0x74, 0x37, 0xbb, 0xaa, 0xb9, 0xdb, 0xd1, 0x3a, 0x5a, 0x57, 0xdb, 0x7c, 0xab, 0xed, 0xab,
-	0x5b, 0xe6, 0x6e, 0x67, 0x47, 0x57, 0xdb, 0xda, 0xb6, 0xa6, 0x6e, 0xcd, 0x33, 0xe2, 0xfd, 0xd3,
-	0x33, 0xb9, 0x76, 0x03, 0x00, 0x05, 0x00, 0x12, 0x5e, 0x54, 0x9c, 0x67, 0xc5, 0xf2, 0xe9, 0x99,
-	0xcc, 0x47, 0x31, 0x94, 0x40, 0x2d, 0xe9, 0x74, 0x8d, 0x0f, 0xef, 0x75, 0xb5, 0x33, 0xcf, 0x89,
-	0x95, 0xd3, 0x33, 0x79, 0x26, 0x4d, 0x33, 0x66, 0xdc, 0x2c, 0x24, 0xcc, 0x28, 0x16, 0xf9, 0x93,
-	0x6f, 0x12, 0xd3, 0xda, 0x3b, 0xbf, 0x94, 0xd8, 0x8b, 0x4b, 0x89, 0xfd, 0x75, 0x29, 0xb1, 0x1f,
-	0xaf, 0x24, 0xe6, 0xe2, 0x4a, 0x62, 0x7e, 0x5c, 0x49, 0xcc, 0xfe, 0x46, 0x1f, 0xd3, 0xc3, 0x61,
-	0x2f, 0x5a, 0xb6, 0x62, 0x93, 0xd0, 0x25, 0xa1, 0x82, 0x7b, 0xf6, 0x4a, 0x9f, 0x28, 0xa3, 0x97,
-	0x8a, 0x4b, 0x9c, 0xe1, 0x00, 0x85, 0xc9, 0x33, 0xf7, 0x7c, 0x7d, 0x25, 0xf7, 0x80, 0xd2, 0x63,
-	0x1f, 0x85, 0xbd, 0x52, 0xfc, 0xc4, 0xad, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xb8, 0x93,
-	0xa8, 0x64, 0x05, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x1d, 0x27, 0x4d, 0x27, 0x49, 0x9b, 0x37, 0xaa, 0xde, 0xb3, 0x52, 0x3d, 0xc7, 0xb4,
+	0x48, 0x0d, 0x48, 0x8d, 0x69, 0x2b, 0x21, 0x04, 0xdd, 0x34, 0xa9, 0x91, 0x2c, 0x20, 0x58, 0x4e,
+	0x5a, 0x89, 0x6e, 0x2c, 0xc7, 0x9e, 0xa6, 0xa3, 0xc6, 0x1e, 0x63, 0x8f, 0xa3, 0xf4, 0x1f, 0x54,
+	0x5d, 0xb1, 0x05, 0xa9, 0x12, 0x12, 0xff, 0x84, 0x55, 0x97, 0x5d, 0xc2, 0x06, 0xa1, 0xf6, 0x8f,
+	0x20, 0x7f, 0x24, 0x71, 0x81, 0x46, 0x08, 0xd8, 0xdd, 0x7b, 0xee, 0x39, 0xd7, 0x77, 0xce, 0x5c,
+	0x0f, 0x58, 0xc3, 0x3d, 0x53, 0x32, 0x89, 0x87, 0x24, 0x93, 0x38, 0x0e, 0x32, 0x29, 0x26, 0x8e,
+	0x34, 0xdc, 0x48, 0x65, 0x0d, 0xd7, 0x23, 0x94, 0xc0, 0x7f, 0x71, 0xcf, 0x6c, 0x84, 0xc4, 0x46,
+	0xaa, 0x34, 0xdc, 0xa8, 0x2e, 0xf5, 0x49, 0x9f, 0x44, 0x14, 0x29, 0x8c, 0x62, 0x76, 0x35, 0xdd,
+	0xd6, 0xb6, 0x31, 0xb5, 0x91, 0x43, 0xe3, 0xb6, 0xe3, 0x2c, 0x26, 0xae, 0x7c, 0x64, 0x41, 0xb9,
+	0x35, 0x69, 0x28, 0x3b, 0x16, 0x5c, 0x06, 0xf3, 0xe6, 0x00, 0x23, 0x87, 0xea, 0xd8, 0xe2, 0x19,
+	0x91, 0xa9, 0xcf, 0x6b, 0x85, 0x18, 0x50, 0x2c, 0xf8, 0x04, 0x14, 0x86, 0xc8, 0xf3, 0x31, 0x71,
+	0x7c, 0x9e, 0x15, 0xb3, 0xf5, 0xe2, 0x66, 0xad, 0xf1, 0xf3, 0xc1, 0x1a, 0xfb, 0x31, 0x4f, 0x9b,
+	0x08, 0xe0, 0x16, 0xc8, 0xf9, 0xd4, 0xa0, 0x88, 0xcf, 0x8a, 0x4c, 0x7d, 0x61, 0xf3, 0xff, 0xdb,
+	0x94, 0x9d, 0x90, 0xa4, 0xc5, 0x5c, 0xd8, 0x06, 0x25, 0x93, 0x04, 0x0e, 0x45, 0x9e, 0x6b, 0x78,
+	0xf4, 0x84, 0xe7, 0x44, 0xa6, 0x5e, 0xdc, 0xbc, 0x7b, 0x9b, 0xb6, 0x95, 0xe2, 0x36, 0xb9, 0x8b,
+	0x2f, 0xb5, 0x8c, 0x76, 0x43, 0x0f, 0xef, 0x80, 0x92, 0x85, 0x06, 0xc6, 0x89, 0xee, 0x22, 0x0f,
+	0x13, 0x8b, 0xcf, 0x89, 0x4c, 0x9d, 0xd3, 0x8a, 0x11, 0xa6, 0x46, 0x10, 0xbc, 0x07, 0x2a, 0x81,
+	0xdb, 0xf7, 0x0c, 0x0b, 0xe9, 0x3e, 0x7a, 0x1d, 0x20, 0xc7, 0x44, 0x7c, 0x3e, 0xa2, 0x2d, 0x26,
+	0x78, 0x27, 0x81, 0x1f, 0x73, 0xa7, 0xef, 0x6b, 0x99, 0x95, 0xcf, 0x2c, 0x58, 0x52, 0x2c, 0xe4,
+	0x50, 0x7c, 0x88, 0x91, 0x35, 0xb5, 0x13, 0x2e, 0x00, 0x76, 0x62, 0x22, 0x8b, 0xbf, 0xf3, 0x96,
+	0x9d, 0xe1, 0x6d, 0xf6, 0xb7, 0xbd, 0xe5, 0xfe, 0xc0, 0xdb, 0xdc, 0x5f, 0xf6, 0x36, 0xff, 0x6b,
+	0xde, 0xce, 0xcd, 0xf2, 0xf6, 0x1d, 0x03, 0x4a, 0xe9, 0x0f, 0xcf, 0xde, 0xcf, 0x55, 0x50, 0x9e,
+	0xce, 0x3c, 0x35, 0xb9, 0x34, 0x05, 0x15, 0x0b, 0x36, 0x41, 0xde, 0xf5, 0xd0, 0x21, 0x1e, 0xf1,
+	0xd9, 0x1f, 0x0f, 0x3c, 0xf9, 0x3f, 0x86, 0x1b, 0x8d, 0x17, 0xc8, 0x3b, 0x1e, 0x20, 0x35, 0xe2,
+	0x26, 0x07, 0x4e, 0x94, 0xc9, 0x70, 0xab, 0xa0, 0xd8, 0x8a, 0x3e, 0xad, 0x1a, 0xf4, 0xc8, 0x87,
+	0x4b, 0x20, 0xe7, 0x86, 0x01, 0xcf, 0x88, 0xd9, 0xfa, 0xbc, 0x16, 0x27, 0x2b, 0xbb, 0x60, 0x71,
+	0xba, 0x12, 0x31, 0x71, 0xe6, 0x19, 0x26, 0x5d, 0xd8, 0x74, 0x97, 0x67, 0x60, 0x2e, 0xb9, 0x75,
+	0x28, 0x00, 0x80, 0xc7, 0xdb, 0xe6, 0x25, 0xf2, 0x14, 0x02, 0xab, 0xa0, 0x70, 0x88, 0x0c, 0x1a,
+	0x78, 0x68, 0xdc, 0x63, 0x92, 0x27, 0x73, 0x13, 0x90, 0x57, 0x0d, 0xcf, 0xb0, 0x7d, 0xb8, 0x0d,
+	0x96, 0x6d, 0x63, 0xa4, 0xa3, 0x91, 0x8b, 0x4c, 0x8a, 0x2c, 0x9d, 0x62, 0x1b, 0x85, 0xd7, 0xa7,
+	0xf7, 0x06, 0xc4, 0x3c, 0x8e, 0x9a, 0x73, 0xda, 0x7f, 0xb6, 0x31, 0x92, 0x13, 0x46, 0x17, 0xdb,
+	0x48, 0x45, 0x5e, 0x33, 0x2c, 0xc3, 0x35, 0x30, 0xbe, 0xb5, 0x48, 0x48, 0x02, 0x1a, 0x19, 0xce,
+	0x69, 0x0b, 0x09, 0xdc, 0x8d, 0xd1, 0xfb, 0x6f, 0x19, 0x90, 0x8b, 0x56, 0x0f, 0x3e, 0x04, 0xb5,
+	0x4e, 0x77, 0xa7, 0x2b, 0xeb, 0x7b, 0x6d, 0xa5, 0xad, 0x74, 0x95, 0x9d, 0xe7, 0xca, 0x81, 0xbc,
+	0xab, 0xef, 0xb5, 0x3b, 0xaa, 0xdc, 0x52, 0x9e, 0x2a, 0xf2, 0x6e, 0x25, 0x53, 0xfd, 0xe7, 0xec,
+	0x5c, 0x2c, 0xdf, 0x20, 0x40, 0x1e, 0x80, 0x58, 0x17, 0x82, 0x15, 0xa6, 0x5a, 0x38, 0x3b, 0x17,
+	0xb9, 0x30, 0x86, 0x02, 0x28, 0xc7, 0x95, 0xae, 0xf6, 0xea, 0xa5, 0x2a, 0xb7, 0x2b, 0x6c, 0xb5,
+	0x78, 0x76, 0x2e, 0xce, 0x25, 0xe9, 0x54, 0x19, 0x15, 0xb3, 0xb1, 0x32, 0x8c, 0xab, 0xdc, 0xe9,
+	0x07, 0x21, 0xd3, 0xdc, 0xbf, 0xb8, 0x12, 0x98, 0xcb, 0x2b, 0x81, 0xf9, 0x7a, 0x25, 0x30, 0x6f,
+	0xae, 0x85, 0xcc, 0xe5, 0xb5, 0x90, 0xf9, 0x74, 0x2d, 0x64, 0x0e, 0xb6, 0xfb, 0x98, 0x1e, 0x05,
+	0xbd, 0x70, 0x2b, 0x24, 0x93, 0xf8, 0x36, 0xf1, 0x25, 0xdc, 0x33, 0xd7, 0xfb, 0x44, 0x1a, 0x3e,
+	0x92, 0x6c, 0x62, 0x05, 0x03, 0xe4, 0xc7, 0xaf, 0xec, 0x83, 0xad, 0xf5, 0xd4, 0xfb, 0x4d, 0x4f,
+	0x5c, 0xe4, 0xf7, 0xf2, 0xd1, 0x0b, 0xbb, 0xf5, 0x6d, 0x00, 0xe6, 0x5a, 0x27, 0x1b, 0xe3, 0x05,
+	0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -483,6 +502,11 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -547,6 +571,11 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -775,6 +804,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeTimeout != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxExpectedTimePerBlock != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.MaxExpectedTimePerBlock))
 		i--
@@ -818,6 +852,9 @@ func (m *ConnectionEnd) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -849,6 +886,9 @@ func (m *IdentifiedConnection) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -933,6 +973,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxExpectedTimePerBlock != 0 {
 		n += 1 + sovConnection(uint64(m.MaxExpectedTimePerBlock))
 	}
+	if m.UpgradeTimeout != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeTimeout))
+	}
 	return n
 }
 
@@ -1108,6 +1151,25 @@ func (m *ConnectionEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1327,6 +1389,25 @@ func (m *IdentifiedConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1853,6 +1934,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeout", wireType)
+			}
+			m.UpgradeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -16,4 +16,13 @@ var (
 	ErrInvalidVersion                = errorsmod.Register(SubModuleName, 9, "invalid connection version")
 	ErrVersionNegotiationFailed      = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
 	ErrInvalidConnectionIdentifier   = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+
+	ErrInvalidUpgrade                  = errorsmod.Register(SubModuleName, 12, "invalid connection upgrade")
+	ErrUpgradeNotFound                 = errorsmod.Register(SubModuleName, 13, "connection upgrade not found")
+	ErrUpgradeErrorNotFound            = errorsmod.Register(SubModuleName, 14, "connection upgrade error receipt not found")
+	ErrInvalidUpgradeSequence          = errorsmod.Register(SubModuleName, 15, "invalid connection upgrade sequence")
+	ErrIncompatibleCounterpartyUpgrade = errorsmod.Register(SubModuleName, 16, "incompatible counterparty connection upgrade")
+	ErrInvalidUpgradeError             = errorsmod.Register(SubModuleName, 17, "invalid connection upgrade error")
+	ErrUpgradeTimeout                  = errorsmod.Register(SubModuleName, 18, "connection upgrade timed-out")
+	ErrUpgradeTimeoutFailed            = errorsmod.Register(SubModuleName, 19, "connection upgrade timeout failed")
 )
@@ -12,6 +12,12 @@ const (
 	AttributeKeyClientID                 = "client_id"
 	AttributeKeyCounterpartyClientID     = "counterparty_client_id"
 	AttributeKeyCounterpartyConnectionID = "counterparty_connection_id"
+	AttributeKeyUpgradeSequence          = "upgrade_sequence"
+	AttributeKeyUpgradeClientID          = "upgrade_client_id"
+	AttributeKeyUpgradeVersions          = "upgrade_versions"
+	AttributeKeyUpgradeDelayPeriod       = "upgrade_delay_period"
+	AttributeKeyUpgradeTimeoutTimestamp  = "upgrade_timeout_timestamp"
+	AttributeKeyUpgradeErrorReceipt      = "upgrade_error_receipt"
 )
 
 // IBC connection events vars
@@ -21,5 +27,13 @@ var (
 	EventTypeConnectionOpenAck     = "connection_open_ack"
 	EventTypeConnectionOpenConfirm = "connection_open_confirm"
 
+	EventTypeConnectionUpgradeInit    = "connection_upgrade_init"
+	EventTypeConnectionUpgradeTry     = "connection_upgrade_try"
+	EventTypeConnectionUpgradeAck     = "connection_upgrade_ack"
+	EventTypeConnectionUpgradeConfirm = "connection_upgrade_confirm"
+	EventTypeConnectionUpgradeTimeout = "connection_upgrade_timeout"
+	EventTypeConnectionUpgradeCancel  = "connection_upgrade_cancelled"
+	EventTypeConnectionUpgradeError   = "connection_upgrade_error"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -14,6 +16,44 @@ func NewConnectionPaths(id string, paths []string) ConnectionPaths {
 	}
 }
 
+// NewIdentifiedUpgrade creates a new IdentifiedUpgrade instance.
+func NewIdentifiedUpgrade(connectionID string, upgrade Upgrade) IdentifiedUpgrade {
+	return IdentifiedUpgrade{
+		ConnectionId: connectionID,
+		Upgrade:      upgrade,
+	}
+}
+
+// Validate performs basic validation of the connection identifier and upgrade fields.
+func (iu IdentifiedUpgrade) Validate() error {
+	if err := host.ConnectionIdentifierValidator(iu.ConnectionId); err != nil {
+		return err
+	}
+
+	return iu.Upgrade.Fields.ValidateBasic()
+}
+
+// NewIdentifiedErrorReceipt creates a new IdentifiedErrorReceipt instance.
+func NewIdentifiedErrorReceipt(connectionID string, errorReceipt ErrorReceipt) IdentifiedErrorReceipt {
+	return IdentifiedErrorReceipt{
+		ConnectionId: connectionID,
+		ErrorReceipt: errorReceipt,
+	}
+}
+
+// Validate performs basic validation of the connection identifier and error receipt.
+func (ier IdentifiedErrorReceipt) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ier.ConnectionId); err != nil {
+		return err
+	}
+
+	if ier.ErrorReceipt.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "error receipt sequence cannot be 0")
+	}
+
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	connections []IdentifiedConnection, connPaths []ConnectionPaths,
@@ -34,6 +74,9 @@ func DefaultGenesisState() GenesisState {
 		ClientConnectionPaths:  []ConnectionPaths{},
 		NextConnectionSequence: 0,
 		Params:                 DefaultParams(),
+		Upgrades:               []IdentifiedUpgrade{},
+		CounterpartyUpgrades:   []IdentifiedUpgrade{},
+		ErrorReceipts:          []IdentifiedErrorReceipt{},
 	}
 }
 
@@ -44,6 +87,7 @@ func (gs GenesisState) Validate() error {
 	// the next sequence used in creating connection identifiers.
 	var maxSequence uint64
 
+	connections := make(map[string]IdentifiedConnection, len(gs.Connections))
 	for i, conn := range gs.Connections {
 		sequence, err := ParseConnectionSequence(conn.Id)
 		if err != nil {
@@ -57,6 +101,43 @@ func (gs GenesisState) Validate() error {
 		if err := conn.ValidateBasic(); err != nil {
 			return fmt.Errorf("invalid connection %v index %d: %w", conn, i, err)
 		}
+
+		connections[conn.Id] = conn
+	}
+
+	for i, upgrade := range gs.Upgrades {
+		if err := upgrade.Validate(); err != nil {
+			return fmt.Errorf("invalid connection upgrade %d: %w", i, err)
+		}
+
+		if _, found := connections[upgrade.ConnectionId]; !found {
+			return fmt.Errorf("connection upgrade %d references unknown connection %s", i, upgrade.ConnectionId)
+		}
+	}
+
+	for i, upgrade := range gs.CounterpartyUpgrades {
+		if err := upgrade.Validate(); err != nil {
+			return fmt.Errorf("invalid counterparty connection upgrade %d: %w", i, err)
+		}
+
+		if _, found := connections[upgrade.ConnectionId]; !found {
+			return fmt.Errorf("counterparty connection upgrade %d references unknown connection %s", i, upgrade.ConnectionId)
+		}
+	}
+
+	for i, errorReceipt := range gs.ErrorReceipts {
+		if err := errorReceipt.Validate(); err != nil {
+			return fmt.Errorf("invalid connection upgrade error receipt %d: %w", i, err)
+		}
+
+		conn, found := connections[errorReceipt.ConnectionId]
+		if !found {
+			return fmt.Errorf("connection upgrade error receipt %d references unknown connection %s", i, errorReceipt.ConnectionId)
+		}
+
+		if errorReceipt.ErrorReceipt.Sequence > conn.UpgradeSequence {
+			return fmt.Errorf("connection upgrade error receipt %d sequence (%d) cannot be greater than the connection upgrade sequence (%d)", i, errorReceipt.ErrorReceipt.Sequence, conn.UpgradeSequence)
+		}
 	}
 
 	for i, conPaths := range gs.ClientConnectionPaths {
@@ -30,6 +30,12 @@ type GenesisState struct {
 	// the sequence for the next generated connection identifier
 	NextConnectionSequence uint64 `protobuf:"varint,3,opt,name=next_connection_sequence,json=nextConnectionSequence,proto3" json:"next_connection_sequence,omitempty"`
 	Params                 Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// upgrades proposed for connection ends on this chain
+	Upgrades []IdentifiedUpgrade `protobuf:"bytes,5,rep,name=upgrades,proto3" json:"upgrades"`
+	// counterparty upgrades accepted in the TRY step
+	CounterpartyUpgrades []IdentifiedUpgrade `protobuf:"bytes,6,rep,name=counterparty_upgrades,json=counterpartyUpgrades,proto3" json:"counterparty_upgrades"`
+	// error receipts written for aborted connection upgrades
+	ErrorReceipts []IdentifiedErrorReceipt `protobuf:"bytes,7,rep,name=error_receipts,json=errorReceipts,proto3" json:"error_receipts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,8 +99,138 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetUpgrades() []IdentifiedUpgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCounterpartyUpgrades() []IdentifiedUpgrade {
+	if m != nil {
+		return m.CounterpartyUpgrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErrorReceipts() []IdentifiedErrorReceipt {
+	if m != nil {
+		return m.ErrorReceipts
+	}
+	return nil
+}
+
+// IdentifiedUpgrade defines a connection upgrade along with the identifier of the connection it applies to.
+type IdentifiedUpgrade struct {
+	ConnectionId string  `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Upgrade      Upgrade `protobuf:"bytes,2,opt,name=upgrade,proto3" json:"upgrade"`
+}
+
+func (m *IdentifiedUpgrade) Reset()         { *m = IdentifiedUpgrade{} }
+func (m *IdentifiedUpgrade) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedUpgrade) ProtoMessage()    {}
+func (*IdentifiedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1879d34bc6ac3cd7, []int{1}
+}
+func (m *IdentifiedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedUpgrade.Merge(m, src)
+}
+func (m *IdentifiedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedUpgrade proto.InternalMessageInfo
+
+func (m *IdentifiedUpgrade) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IdentifiedUpgrade) GetUpgrade() Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return Upgrade{}
+}
+
+// IdentifiedErrorReceipt defines a connection upgrade error receipt along with the identifier of the
+// connection it was written for.
+type IdentifiedErrorReceipt struct {
+	ConnectionId string       `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ErrorReceipt ErrorReceipt `protobuf:"bytes,2,opt,name=error_receipt,json=errorReceipt,proto3" json:"error_receipt"`
+}
+
+func (m *IdentifiedErrorReceipt) Reset()         { *m = IdentifiedErrorReceipt{} }
+func (m *IdentifiedErrorReceipt) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedErrorReceipt) ProtoMessage()    {}
+func (*IdentifiedErrorReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1879d34bc6ac3cd7, []int{2}
+}
+func (m *IdentifiedErrorReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedErrorReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedErrorReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedErrorReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedErrorReceipt.Merge(m, src)
+}
+func (m *IdentifiedErrorReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedErrorReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedErrorReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedErrorReceipt proto.InternalMessageInfo
+
+func (m *IdentifiedErrorReceipt) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *IdentifiedErrorReceipt) GetErrorReceipt() ErrorReceipt {
+	if m != nil {
+		return m.ErrorReceipt
+	}
+	return ErrorReceipt{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.connection.v1.GenesisState")
+	proto.RegisterType((*IdentifiedUpgrade)(nil), "ibc.core.connection.v1.IdentifiedUpgrade")
+	proto.RegisterType((*IdentifiedErrorReceipt)(nil), "ibc.core.connection.v1.IdentifiedErrorReceipt")
 }
 
 func init() {
@@ -102,28 +238,38 @@ func init() {
 }
 
 var fileDescriptor_1879d34bc6ac3cd7 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0x32, 0x41,
-	0x14, 0xc5, 0x77, 0x81, 0x50, 0x0c, 0x5f, 0xb5, 0xf9, 0xc4, 0x0d, 0xc5, 0x48, 0x8c, 0x09, 0x14,
-	0x32, 0x23, 0xd0, 0x50, 0x50, 0x61, 0x61, 0xec, 0x08, 0x18, 0x0b, 0x1b, 0xb2, 0x3b, 0x5c, 0x97,
-	0x49, 0xd8, 0xb9, 0x2b, 0x33, 0x10, 0x7d, 0x0b, 0xdf, 0xc9, 0x86, 0x92, 0xd2, 0xca, 0x18, 0x78,
-	0x11, 0xb3, 0x7f, 0xe2, 0xa2, 0x71, 0xbb, 0xc9, 0x9c, 0xdf, 0x39, 0xe7, 0x26, 0x87, 0x5c, 0x48,
-	0x5f, 0x70, 0x81, 0x2b, 0xe0, 0x02, 0x95, 0x02, 0x61, 0x24, 0x2a, 0xbe, 0xe9, 0xf2, 0x00, 0x14,
-	0x68, 0xa9, 0x59, 0xb4, 0x42, 0x83, 0x4e, 0x5d, 0xfa, 0x82, 0xc5, 0x14, 0xcb, 0x29, 0xb6, 0xe9,
-	0x36, 0xfe, 0x07, 0x18, 0x60, 0x82, 0xf0, 0xf8, 0x95, 0xd2, 0x8d, 0x56, 0x41, 0xe6, 0x91, 0x37,
-	0x01, 0xcf, 0xdf, 0x4a, 0xe4, 0xdf, 0x4d, 0x5a, 0x34, 0x35, 0x9e, 0x01, 0xe7, 0x8e, 0xd4, 0x72,
-	0x48, 0xbb, 0x76, 0xb3, 0xdc, 0xae, 0xf5, 0x2e, 0xd9, 0xdf, 0xed, 0xec, 0x76, 0x0e, 0xca, 0xc8,
-	0x47, 0x09, 0xf3, 0xeb, 0xef, 0xff, 0x51, 0x65, 0xfb, 0x71, 0x66, 0x4d, 0x8e, 0x63, 0x1c, 0x20,
-	0xa7, 0x62, 0x29, 0x41, 0x99, 0x59, 0xfe, 0x3b, 0x8b, 0x3c, 0xb3, 0xd0, 0x6e, 0x29, 0x69, 0x68,
-	0x15, 0x35, 0xe4, 0xb9, 0xe3, 0x18, 0xcf, 0xc2, 0x4f, 0xd2, 0xb4, 0x5f, 0xa2, 0x33, 0x20, 0xae,
-	0x82, 0xe7, 0x1f, 0x25, 0x1a, 0x9e, 0xd6, 0xa0, 0x04, 0xb8, 0xe5, 0xa6, 0xdd, 0xae, 0x4c, 0xea,
-	0xb1, 0x9e, 0xdb, 0xa6, 0x99, 0xea, 0x0c, 0x49, 0x35, 0xf2, 0x56, 0x5e, 0xa8, 0xdd, 0x4a, 0xd3,
-	0x6e, 0xd7, 0x7a, 0xb4, 0xe8, 0x9e, 0x71, 0x42, 0x65, 0x67, 0x64, 0x9e, 0xd1, 0xfd, 0x76, 0x4f,
-	0xed, 0xdd, 0x9e, 0xda, 0x9f, 0x7b, 0x6a, 0xbf, 0x1e, 0xa8, 0xb5, 0x3b, 0x50, 0xeb, 0xfd, 0x40,
-	0xad, 0x87, 0x61, 0x20, 0xcd, 0x62, 0xed, 0x33, 0x81, 0x21, 0x17, 0xa8, 0x43, 0xd4, 0x5c, 0xfa,
-	0xa2, 0x13, 0x20, 0xdf, 0x0c, 0x78, 0x88, 0xf3, 0xf5, 0x12, 0x74, 0x3a, 0xd4, 0x55, 0xbf, 0x73,
-	0xb4, 0x95, 0x79, 0x89, 0x40, 0xfb, 0xd5, 0x64, 0xa4, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x91, 0x43, 0x38, 0x00, 0x23, 0x02, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6b, 0x13, 0x41,
+	0x1c, 0xc5, 0xb3, 0x6d, 0x4c, 0x75, 0x92, 0x08, 0x0e, 0x6d, 0x1c, 0x7a, 0xd8, 0x86, 0x58, 0x68,
+	0x04, 0xbb, 0x63, 0xdb, 0x4b, 0x0f, 0x05, 0xa1, 0x22, 0x52, 0x3c, 0x58, 0x52, 0xeb, 0x41, 0x0f,
+	0x61, 0x77, 0xf6, 0xef, 0x76, 0xa0, 0x99, 0x59, 0x67, 0x66, 0x83, 0xf9, 0x12, 0xe2, 0xc7, 0xea,
+	0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xf2, 0x45, 0x64, 0x77, 0xa7, 0xd9, 0x8d, 0x66, 0x89, 0x78, 0x5b,
+	0xfe, 0xf3, 0xde, 0xef, 0xbd, 0xf9, 0x2f, 0x83, 0x76, 0x79, 0xc0, 0x28, 0x93, 0x0a, 0x28, 0x93,
+	0x42, 0x00, 0x33, 0x5c, 0x0a, 0x3a, 0x3e, 0xa0, 0x11, 0x08, 0xd0, 0x5c, 0x7b, 0xb1, 0x92, 0x46,
+	0xe2, 0x0e, 0x0f, 0x98, 0x97, 0xaa, 0xbc, 0x42, 0xe5, 0x8d, 0x0f, 0xb6, 0x37, 0x23, 0x19, 0xc9,
+	0x4c, 0x42, 0xd3, 0xaf, 0x5c, 0xbd, 0xbd, 0x57, 0xc1, 0x2c, 0x79, 0x73, 0x61, 0x55, 0x78, 0x12,
+	0x47, 0xca, 0x0f, 0x21, 0x57, 0xf5, 0xbe, 0xd7, 0x51, 0xeb, 0x75, 0x5e, 0xe7, 0xc2, 0xf8, 0x06,
+	0xf0, 0x3b, 0xd4, 0x2c, 0xf4, 0x9a, 0x38, 0xdd, 0xf5, 0x7e, 0xf3, 0xf0, 0x99, 0xb7, 0xbc, 0xa3,
+	0x77, 0x16, 0x82, 0x30, 0xfc, 0x13, 0x87, 0xf0, 0xe5, 0x7c, 0x7e, 0x5a, 0xbf, 0xf9, 0xb9, 0x53,
+	0x1b, 0x94, 0x31, 0x18, 0xd0, 0x63, 0x76, 0xcd, 0x41, 0x98, 0x61, 0x31, 0x1d, 0xc6, 0xbe, 0xb9,
+	0xd2, 0x64, 0x2d, 0x4b, 0xd8, 0xab, 0x4a, 0x28, 0xb8, 0xe7, 0xa9, 0xdc, 0xc2, 0xb7, 0x72, 0xda,
+	0x1f, 0x87, 0xf8, 0x18, 0x11, 0x01, 0x5f, 0x16, 0x42, 0x34, 0x7c, 0x4e, 0x40, 0x30, 0x20, 0xeb,
+	0x5d, 0xa7, 0x5f, 0x1f, 0x74, 0xd2, 0xf3, 0xc2, 0x76, 0x61, 0x4f, 0xf1, 0x09, 0x6a, 0xc4, 0xbe,
+	0xf2, 0x47, 0x9a, 0xd4, 0xbb, 0x4e, 0xbf, 0x79, 0xe8, 0x56, 0xf5, 0x39, 0xcf, 0x54, 0xb6, 0x86,
+	0xf5, 0xe0, 0x37, 0xe8, 0xbe, 0x5d, 0xab, 0x26, 0xf7, 0xb2, 0xfb, 0x3c, 0x5d, 0xbd, 0xb1, 0xcb,
+	0xdc, 0x61, 0x51, 0x73, 0x00, 0x0e, 0xd1, 0x16, 0x93, 0x89, 0x30, 0xa0, 0x62, 0x5f, 0x99, 0xc9,
+	0x70, 0x4e, 0x6e, 0xfc, 0x1f, 0x79, 0xb3, 0x4c, 0xbb, 0xbc, 0x4b, 0xf9, 0x88, 0x1e, 0x82, 0x52,
+	0x52, 0x0d, 0x15, 0x30, 0xe0, 0xb1, 0xd1, 0x64, 0x23, 0xc3, 0x7b, 0xab, 0xf1, 0xaf, 0x52, 0xdf,
+	0x20, 0xb7, 0xd9, 0x8c, 0x36, 0x94, 0x66, 0xba, 0x37, 0x41, 0x8f, 0xfe, 0x6a, 0x83, 0x9f, 0xa0,
+	0x76, 0xe9, 0xbf, 0xf0, 0x90, 0x38, 0x5d, 0xa7, 0xff, 0x60, 0xd0, 0x2a, 0x86, 0x67, 0x21, 0x7e,
+	0x81, 0x36, 0xec, 0x7d, 0xc9, 0x5a, 0xf6, 0x23, 0x76, 0xaa, 0xfa, 0x2c, 0x5e, 0xf2, 0xce, 0xd5,
+	0xfb, 0xea, 0xa0, 0xce, 0xf2, 0xaa, 0xff, 0x56, 0xe0, 0x2d, 0x6a, 0x2f, 0xec, 0xc5, 0xd6, 0xd8,
+	0xad, 0xaa, 0xb1, 0x64, 0x19, 0xad, 0xf2, 0x32, 0x4e, 0xdf, 0xdf, 0x4c, 0x5d, 0xe7, 0x76, 0xea,
+	0x3a, 0xbf, 0xa6, 0xae, 0xf3, 0x6d, 0xe6, 0xd6, 0x6e, 0x67, 0x6e, 0xed, 0xc7, 0xcc, 0xad, 0x7d,
+	0x38, 0x89, 0xb8, 0xb9, 0x4a, 0x02, 0x8f, 0xc9, 0x11, 0x65, 0x52, 0x8f, 0xa4, 0xa6, 0x3c, 0x60,
+	0xfb, 0x91, 0xa4, 0xe3, 0x63, 0x3a, 0x92, 0x61, 0x72, 0x0d, 0x3a, 0x7f, 0xc1, 0xcf, 0x8f, 0xf6,
+	0x4b, 0x8f, 0xd8, 0x4c, 0x62, 0xd0, 0x41, 0x23, 0x7b, 0xc0, 0x47, 0xbf, 0x07, 0x00, 0xbd, 0xd7,
+	0xc7, 0x8a, 0x65, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -146,6 +292,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ErrorReceipts) > 0 {
+		for iNdEx := len(m.ErrorReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ErrorReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CounterpartyUpgrades) > 0 {
+		for iNdEx := len(m.CounterpartyUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -192,6 +380,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedErrorReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedErrorReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -226,6 +494,54 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CounterpartyUpgrades) > 0 {
+		for _, e := range m.CounterpartyUpgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ErrorReceipts) > 0 {
+		for _, e := range m.ErrorReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IdentifiedErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ErrorReceipt.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -384,6 +700,338 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrades = append(m.Upgrades, IdentifiedUpgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyUpgrades = append(m.CounterpartyUpgrades, IdentifiedUpgrade{})
+			if err := m.CounterpartyUpgrades[len(m.CounterpartyUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorReceipts = append(m.ErrorReceipts, IdentifiedErrorReceipt{})
+			if err := m.ErrorReceipts[len(m.ErrorReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
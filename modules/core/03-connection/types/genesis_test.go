@@ -11,6 +11,28 @@ import (
 )
 
 func TestValidateGenesis(t *testing.T) {
+	// upgradeGenesis returns a genesis state for a connection at upgrade sequence 1 with the provided upgrade state
+	upgradeGenesis := func(upgrades []types.IdentifiedUpgrade, errorReceipts []types.IdentifiedErrorReceipt) types.GenesisState {
+		connection := types.NewConnectionEnd(types.OPEN, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)
+		connection.UpgradeSequence = 1
+
+		genState := types.NewGenesisState(
+			[]types.IdentifiedConnection{types.NewIdentifiedConnection(connectionID, connection)},
+			[]types.ConnectionPaths{
+				{clientID, []string{connectionID}},
+			},
+			0,
+			types.DefaultParams(),
+		)
+		genState.Upgrades = upgrades
+		genState.CounterpartyUpgrades = upgrades
+		genState.ErrorReceipts = errorReceipts
+
+		return genState
+	}
+
+	upgrade := types.NewUpgrade(types.NewUpgradeFields(clientID, []*types.Version{ibctesting.ConnectionVersion}, 500), 100)
+
 	testCases := []struct {
 		name     string
 		genState types.GenesisState
@@ -119,6 +141,46 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "valid genesis with connection upgrade state",
+			genState: upgradeGenesis(
+				[]types.IdentifiedUpgrade{types.NewIdentifiedUpgrade(connectionID, upgrade)},
+				[]types.IdentifiedErrorReceipt{types.NewIdentifiedErrorReceipt(connectionID, types.ErrorReceipt{Sequence: 1, Message: "upgrade aborted"})},
+			),
+			expPass: true,
+		},
+		{
+			name: "invalid upgrade fields",
+			genState: upgradeGenesis(
+				[]types.IdentifiedUpgrade{types.NewIdentifiedUpgrade(connectionID, types.NewUpgrade(types.UpgradeFields{}, 100))},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			name: "upgrade references unknown connection",
+			genState: upgradeGenesis(
+				[]types.IdentifiedUpgrade{types.NewIdentifiedUpgrade(connectionID2, upgrade)},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			name: "error receipt sequence is zero",
+			genState: upgradeGenesis(
+				nil,
+				[]types.IdentifiedErrorReceipt{types.NewIdentifiedErrorReceipt(connectionID, types.ErrorReceipt{Message: "upgrade aborted"})},
+			),
+			expPass: false,
+		},
+		{
+			name: "error receipt sequence is greater than connection upgrade sequence",
+			genState: upgradeGenesis(
+				nil,
+				[]types.IdentifiedErrorReceipt{types.NewIdentifiedErrorReceipt(connectionID, types.ErrorReceipt{Sequence: 2, Message: "upgrade aborted"})},
+			),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
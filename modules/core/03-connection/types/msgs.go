@@ -19,15 +19,29 @@ var (
 	_ sdk.Msg = (*MsgConnectionOpenAck)(nil)
 	_ sdk.Msg = (*MsgConnectionOpenTry)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTimeout)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeCancel)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenConfirm)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenAck)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenTry)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTimeout)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeCancel)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionOpenTry)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionOpenAck)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionUpgradeTry)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionUpgradeAck)(nil)
 )
 
 // NewMsgConnectionOpenInit creates a new MsgConnectionOpenInit instance. It sets the
@@ -269,3 +283,286 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgConnectionUpgradeInit constructs a new MsgConnectionUpgradeInit
+// nolint:interfacer
+func NewMsgConnectionUpgradeInit(connectionID string, upgradeFields UpgradeFields, signer string) *MsgConnectionUpgradeInit {
+	return &MsgConnectionUpgradeInit{
+		ConnectionId: connectionID,
+		Fields:       upgradeFields,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeInit) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if err := msg.Fields.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeTry constructs a new MsgConnectionUpgradeTry
+// nolint:interfacer
+func NewMsgConnectionUpgradeTry(
+	connectionID string,
+	counterpartyUpgradeFields UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	counterpartyClient exported.ClientState,
+	connectionProof, upgradeProof, clientProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeTry {
+	return &MsgConnectionUpgradeTry{
+		ConnectionId:                connectionID,
+		CounterpartyUpgradeFields:   counterpartyUpgradeFields,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		CounterpartyClientState:     packUpgradeClientState(counterpartyClient),
+		ProofConnection:             connectionProof,
+		ProofUpgrade:                upgradeProof,
+		ProofClient:                 clientProof,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgConnectionUpgradeTry) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(msg.CounterpartyClientState, new(exported.ClientState))
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTry) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if err := msg.CounterpartyUpgradeFields.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "counterparty upgrade fields are invalid")
+	}
+
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "counterparty sequence cannot be 0")
+	}
+
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+
+	if err := validateUpgradeClientState(msg.CounterpartyClientState, msg.ProofClient); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeAck constructs a new MsgConnectionUpgradeAck
+// nolint:interfacer
+func NewMsgConnectionUpgradeAck(
+	connectionID string,
+	counterpartyUpgrade Upgrade,
+	counterpartyClient exported.ClientState,
+	connectionProof, upgradeProof, clientProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeAck {
+	return &MsgConnectionUpgradeAck{
+		ConnectionId:            connectionID,
+		CounterpartyUpgrade:     counterpartyUpgrade,
+		CounterpartyClientState: packUpgradeClientState(counterpartyClient),
+		ProofConnection:         connectionProof,
+		ProofUpgrade:            upgradeProof,
+		ProofClient:             clientProof,
+		ProofHeight:             proofHeight,
+		Signer:                  signer,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgConnectionUpgradeAck) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(msg.CounterpartyClientState, new(exported.ClientState))
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeAck) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "counterparty upgrade is invalid")
+	}
+
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+
+	if len(msg.ProofUpgrade) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+
+	if err := validateUpgradeClientState(msg.CounterpartyClientState, msg.ProofClient); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeConfirm constructs a new MsgConnectionUpgradeConfirm
+// nolint:interfacer
+func NewMsgConnectionUpgradeConfirm(
+	connectionID string,
+	connectionProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeConfirm {
+	return &MsgConnectionUpgradeConfirm{
+		ConnectionId:    connectionID,
+		ProofConnection: connectionProof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeConfirm) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeTimeout constructs a new MsgConnectionUpgradeTimeout
+// nolint:interfacer
+func NewMsgConnectionUpgradeTimeout(
+	connectionID string,
+	counterpartyConnection ConnectionEnd,
+	connectionProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeTimeout {
+	return &MsgConnectionUpgradeTimeout{
+		ConnectionId:           connectionID,
+		CounterpartyConnection: counterpartyConnection,
+		ProofConnection:        connectionProof,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTimeout) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+
+	if msg.CounterpartyConnection.State != OPEN {
+		return errorsmod.Wrapf(ErrInvalidConnectionState, "expected counterparty connection state to be %s, got: %s", OPEN, msg.CounterpartyConnection.State)
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeCancel constructs a new MsgConnectionUpgradeCancel
+// nolint:interfacer
+func NewMsgConnectionUpgradeCancel(
+	connectionID string,
+	errorReceipt ErrorReceipt,
+	errorReceiptProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeCancel {
+	return &MsgConnectionUpgradeCancel{
+		ConnectionId:      connectionID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: errorReceiptProof,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. No checks are done for ErrorReceipt and ProofErrorReceipt
+// since they are not required if the upgrade has not yet been accepted by the counterparty and
+// the signer is the designated authority (e.g. the governance module).
+func (msg MsgConnectionUpgradeCancel) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// packUpgradeClientState packs the optional client state of a counterparty upgrade client.
+func packUpgradeClientState(clientState exported.ClientState) *codectypes.Any {
+	if clientState == nil {
+		return nil
+	}
+
+	protoAny, _ := clienttypes.PackClientState(clientState)
+	return protoAny
+}
+
+// validateUpgradeClientState validates the optional client state of a counterparty upgrade client.
+// If a client state is provided it must be valid and a proof of it must be provided.
+func validateUpgradeClientState(clientState *codectypes.Any, clientProof []byte) error {
+	if clientState == nil {
+		return nil
+	}
+
+	counterpartyClient, err := clienttypes.UnpackClientState(clientState)
+	if err != nil {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "unpack err: %v", err)
+	}
+
+	if err := counterpartyClient.Validate(); err != nil {
+		return errorsmod.Wrap(err, "counterparty client is invalid")
+	}
+
+	if len(clientProof) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty client state proof")
+	}
+
+	return nil
+}
@@ -246,6 +246,186 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 	}
 }
 
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeInit() {
+	upgradeFields := types.NewUpgradeFields("clienttotest", []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeInit("test/conn1", upgradeFields, signer), false},
+		{"invalid upgrade client ID", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgradeFields("test/iris", upgradeFields.Versions, 500), signer), false},
+		{"empty upgrade versions", types.NewMsgConnectionUpgradeInit(connectionID, types.NewUpgradeFields("clienttotest", nil, 500), signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, ""), false},
+		{"success", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTry() {
+	upgradeFields := types.NewUpgradeFields("clienttotest", []*types.Version{ibctesting.ConnectionVersion}, 500)
+	clientState := ibctm.NewClientState(
+		chainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+	invalidClient := ibctm.NewClientState(
+		chainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clienttypes.ZeroHeight(), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTry
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTry("test/conn1", upgradeFields, 1, nil, suite.proof, suite.proof, nil, clientHeight, signer), false},
+		{"invalid counterparty upgrade fields", types.NewMsgConnectionUpgradeTry(connectionID, types.UpgradeFields{}, 1, nil, suite.proof, suite.proof, nil, clientHeight, signer), false},
+		{"zero counterparty upgrade sequence", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 0, nil, suite.proof, suite.proof, nil, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, nil, emptyProof, suite.proof, nil, clientHeight, signer), false},
+		{"empty upgrade proof", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, nil, suite.proof, emptyProof, nil, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, nil, suite.proof, suite.proof, nil, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, nil, suite.proof, suite.proof, nil, clientHeight, signer), true},
+		{"success: counterparty client state provided", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, clientState, suite.proof, suite.proof, suite.proof, clientHeight, signer), true},
+		{"invalid counterparty client state", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, invalidClient, suite.proof, suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty client proof", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, clientState, suite.proof, suite.proof, emptyProof, clientHeight, signer), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeAck() {
+	upgrade := types.NewUpgrade(types.NewUpgradeFields("clienttotest", []*types.Version{ibctesting.ConnectionVersion}, 500), 100)
+	clientState := ibctm.NewClientState(
+		chainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+	invalidClient := ibctm.NewClientState(
+		chainID, ibctm.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clienttypes.ZeroHeight(), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeAck
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeAck("test/conn1", upgrade, nil, suite.proof, suite.proof, nil, clientHeight, signer), false},
+		{"zero upgrade timeout", types.NewMsgConnectionUpgradeAck(connectionID, types.NewUpgrade(upgrade.Fields, 0), nil, suite.proof, suite.proof, nil, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, nil, emptyProof, suite.proof, nil, clientHeight, signer), false},
+		{"empty upgrade proof", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, nil, suite.proof, emptyProof, nil, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, nil, suite.proof, suite.proof, nil, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, nil, suite.proof, suite.proof, nil, clientHeight, signer), true},
+		{"success: counterparty client state provided", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, clientState, suite.proof, suite.proof, suite.proof, clientHeight, signer), true},
+		{"invalid counterparty client state", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, invalidClient, suite.proof, suite.proof, suite.proof, clientHeight, signer), false},
+		{"empty client proof", types.NewMsgConnectionUpgradeAck(connectionID, upgrade, clientState, suite.proof, suite.proof, emptyProof, clientHeight, signer), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeConfirm("test/conn1", suite.proof, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeConfirm(connectionID, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTimeout() {
+	counterparty := types.NewCounterparty("clienttotest", connectionID, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey")))
+	connection := types.NewConnectionEnd(types.OPEN, "clienttotest", counterparty, []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTimeout
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTimeout("test/conn1", connection, suite.proof, clientHeight, signer), false},
+		{"counterparty connection not open", types.NewMsgConnectionUpgradeTimeout(connectionID, types.NewConnectionEnd(types.TRYOPEN, "clienttotest", counterparty, connection.Versions, 500), suite.proof, clientHeight, signer), false},
+		{"empty connection proof", types.NewMsgConnectionUpgradeTimeout(connectionID, connection, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTimeout(connectionID, connection, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTimeout(connectionID, connection, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeCancel() {
+	errorReceipt := types.NewUpgradeError(1, types.ErrInvalidUpgrade).GetErrorReceipt()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeCancel
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeCancel("test/conn1", errorReceipt, suite.proof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, suite.proof, clientHeight, signer), true},
+		{"success: empty proof", types.NewMsgConnectionUpgradeCancel(connectionID, errorReceipt, emptyProof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func (suite *MsgTestSuite) TestMsgUpdateParamsValidateBasic() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -5,19 +5,34 @@ import (
 	"time"
 )
 
-// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
-const DefaultTimePerBlock = 30 * time.Second
+const (
+	// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
+	DefaultTimePerBlock = 30 * time.Second
 
-// NewParams creates a new parameter configuration for the ibc connection module
+	// DefaultUpgradeTimeout is the default value for the relative connection upgrade timeout (in nanoseconds).
+	DefaultUpgradeTimeout = 10 * time.Minute
+)
+
+// NewParams creates a new parameter configuration for the ibc connection module.
+// The upgrade timeout is left unset, in which case DefaultUpgradeTimeout is used.
 func NewParams(timePerBlock uint64) Params {
 	return Params{
 		MaxExpectedTimePerBlock: timePerBlock,
 	}
 }
 
+// NewParamsWithUpgradeTimeout creates a new parameter configuration for the ibc connection module
+// with the provided relative connection upgrade timeout.
+func NewParamsWithUpgradeTimeout(timePerBlock, upgradeTimeout uint64) Params {
+	return Params{
+		MaxExpectedTimePerBlock: timePerBlock,
+		UpgradeTimeout:          upgradeTimeout,
+	}
+}
+
 // DefaultParams is the default parameter configuration for the ibc connection module
 func DefaultParams() Params {
-	return NewParams(uint64(DefaultTimePerBlock))
+	return NewParamsWithUpgradeTimeout(uint64(DefaultTimePerBlock), uint64(DefaultUpgradeTimeout))
 }
 
 // Validate ensures MaxExpectedTimePerBlock is non-zero
@@ -27,3 +42,11 @@ func (p Params) Validate() error {
 	}
 	return nil
 }
+
+// GetUpgradeTimeoutOrDefault returns the relative connection upgrade timeout, or DefaultUpgradeTimeout if it is unset.
+func (p Params) GetUpgradeTimeoutOrDefault() uint64 {
+	if p.UpgradeTimeout == 0 {
+		return uint64(DefaultUpgradeTimeout)
+	}
+	return p.UpgradeTimeout
+}
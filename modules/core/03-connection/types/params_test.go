@@ -17,6 +17,7 @@ func TestValidateParams(t *testing.T) {
 		{"default params", types.DefaultParams(), true},
 		{"custom params", types.NewParams(10), true},
 		{"blank client", types.NewParams(0), false},
+		{"custom upgrade timeout", types.NewParamsWithUpgradeTimeout(10, 10), true},
 	}
 
 	for _, tc := range testCases {
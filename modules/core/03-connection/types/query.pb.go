@@ -700,6 +700,224 @@ func (m *QueryConnectionParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryConnectionUpgradeErrorRequest is the request type for the Query/ConnectionUpgradeError RPC method
+type QueryConnectionUpgradeErrorRequest struct {
+	// connection unique identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryConnectionUpgradeErrorRequest) Reset()         { *m = QueryConnectionUpgradeErrorRequest{} }
+func (m *QueryConnectionUpgradeErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionUpgradeErrorRequest) ProtoMessage()    {}
+func (*QueryConnectionUpgradeErrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{12}
+}
+func (m *QueryConnectionUpgradeErrorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionUpgradeErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionUpgradeErrorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionUpgradeErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionUpgradeErrorRequest.Merge(m, src)
+}
+func (m *QueryConnectionUpgradeErrorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionUpgradeErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionUpgradeErrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionUpgradeErrorRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionUpgradeErrorRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryConnectionUpgradeErrorResponse is the response type for the Query/ConnectionUpgradeError RPC method
+type QueryConnectionUpgradeErrorResponse struct {
+	ErrorReceipt ErrorReceipt `protobuf:"bytes,1,opt,name=error_receipt,json=errorReceipt,proto3" json:"error_receipt"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryConnectionUpgradeErrorResponse) Reset()         { *m = QueryConnectionUpgradeErrorResponse{} }
+func (m *QueryConnectionUpgradeErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionUpgradeErrorResponse) ProtoMessage()    {}
+func (*QueryConnectionUpgradeErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{13}
+}
+func (m *QueryConnectionUpgradeErrorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionUpgradeErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionUpgradeErrorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionUpgradeErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionUpgradeErrorResponse.Merge(m, src)
+}
+func (m *QueryConnectionUpgradeErrorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionUpgradeErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionUpgradeErrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionUpgradeErrorResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionUpgradeErrorResponse) GetErrorReceipt() ErrorReceipt {
+	if m != nil {
+		return m.ErrorReceipt
+	}
+	return ErrorReceipt{}
+}
+
+func (m *QueryConnectionUpgradeErrorResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryConnectionUpgradeErrorResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+// QueryConnectionUpgradeRequest is the request type for the Query/ConnectionUpgrade RPC method
+type QueryConnectionUpgradeRequest struct {
+	// connection unique identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryConnectionUpgradeRequest) Reset()         { *m = QueryConnectionUpgradeRequest{} }
+func (m *QueryConnectionUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionUpgradeRequest) ProtoMessage()    {}
+func (*QueryConnectionUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{14}
+}
+func (m *QueryConnectionUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionUpgradeRequest.Merge(m, src)
+}
+func (m *QueryConnectionUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionUpgradeRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionUpgradeRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryConnectionUpgradeResponse is the response type for the Query/ConnectionUpgrade RPC method
+type QueryConnectionUpgradeResponse struct {
+	Upgrade Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryConnectionUpgradeResponse) Reset()         { *m = QueryConnectionUpgradeResponse{} }
+func (m *QueryConnectionUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionUpgradeResponse) ProtoMessage()    {}
+func (*QueryConnectionUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{15}
+}
+func (m *QueryConnectionUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionUpgradeResponse.Merge(m, src)
+}
+func (m *QueryConnectionUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionUpgradeResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionUpgradeResponse) GetUpgrade() Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return Upgrade{}
+}
+
+func (m *QueryConnectionUpgradeResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryConnectionUpgradeResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
 func init() {
 	proto.RegisterType((*QueryConnectionRequest)(nil), "ibc.core.connection.v1.QueryConnectionRequest")
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.core.connection.v1.QueryConnectionResponse")
@@ -713,6 +931,10 @@ func init() {
 	proto.RegisterType((*QueryConnectionConsensusStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateResponse")
 	proto.RegisterType((*QueryConnectionParamsRequest)(nil), "ibc.core.connection.v1.QueryConnectionParamsRequest")
 	proto.RegisterType((*QueryConnectionParamsResponse)(nil), "ibc.core.connection.v1.QueryConnectionParamsResponse")
+	proto.RegisterType((*QueryConnectionUpgradeErrorRequest)(nil), "ibc.core.connection.v1.QueryConnectionUpgradeErrorRequest")
+	proto.RegisterType((*QueryConnectionUpgradeErrorResponse)(nil), "ibc.core.connection.v1.QueryConnectionUpgradeErrorResponse")
+	proto.RegisterType((*QueryConnectionUpgradeRequest)(nil), "ibc.core.connection.v1.QueryConnectionUpgradeRequest")
+	proto.RegisterType((*QueryConnectionUpgradeResponse)(nil), "ibc.core.connection.v1.QueryConnectionUpgradeResponse")
 }
 
 func init() {
@@ -720,66 +942,75 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xce, 0x6c, 0xd2, 0x88, 0xbc, 0x0d, 0x6d, 0x19, 0xa5, 0xed, 0x62, 0x8a, 0x1b, 0x5c, 0xd2,
-	0xa4, 0x40, 0x67, 0xba, 0x09, 0x89, 0x02, 0x24, 0x08, 0x52, 0x15, 0x92, 0x4b, 0x15, 0x8c, 0x04,
-	0x12, 0x97, 0xc8, 0xf6, 0x4e, 0x1c, 0x4b, 0x59, 0xcf, 0x76, 0xc7, 0xbb, 0x28, 0xaa, 0x22, 0x24,
-	0x7e, 0x01, 0x12, 0x17, 0x2e, 0xbd, 0x82, 0xc4, 0x3f, 0x40, 0xdc, 0x38, 0xf5, 0x58, 0x89, 0x4b,
-	0x4f, 0x15, 0x4a, 0xb8, 0xf2, 0x1f, 0x90, 0x67, 0xc6, 0xf5, 0x78, 0x77, 0x9d, 0xec, 0xae, 0x94,
-	0x9b, 0xf7, 0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0x7b, 0xcf, 0x9f, 0x17, 0x9c, 0xc8, 0x0f, 0x68, 0xc0,
-	0xdb, 0x8c, 0x06, 0x3c, 0x8e, 0x59, 0x90, 0x44, 0x3c, 0xa6, 0xdd, 0x3a, 0x7d, 0xdc, 0x61, 0xed,
-	0x23, 0xd2, 0x6a, 0xf3, 0x84, 0xe3, 0xeb, 0x91, 0x1f, 0x90, 0x34, 0x87, 0xe4, 0x39, 0xa4, 0x5b,
-	0xb7, 0xe6, 0x42, 0x1e, 0x72, 0x99, 0x42, 0xd3, 0x27, 0x95, 0x6d, 0xbd, 0x17, 0x70, 0xd1, 0xe4,
-	0x82, 0xfa, 0x9e, 0x60, 0xaa, 0x0d, 0xed, 0xd6, 0x7d, 0x96, 0x78, 0x75, 0xda, 0xf2, 0xc2, 0x28,
-	0xf6, 0x64, 0xb9, 0xca, 0xbd, 0x95, 0xdf, 0x7e, 0x18, 0xb1, 0x38, 0x49, 0x6f, 0x56, 0x4f, 0x3a,
-	0x61, 0xb1, 0x04, 0x9e, 0x01, 0x44, 0x25, 0xde, 0x0c, 0x39, 0x0f, 0x0f, 0x19, 0xf5, 0x5a, 0x11,
-	0xf5, 0xe2, 0x98, 0x27, 0xf2, 0x1a, 0xa1, 0x4f, 0xdf, 0xd4, 0xa7, 0xf2, 0x97, 0xdf, 0xd9, 0xa7,
-	0x5e, 0xac, 0xc9, 0x39, 0x9b, 0x70, 0xfd, 0xab, 0x14, 0xe4, 0x83, 0x57, 0x1d, 0x5d, 0xf6, 0xb8,
-	0xc3, 0x44, 0x82, 0x6f, 0xc3, 0xeb, 0xf9, 0x35, 0x7b, 0x51, 0xa3, 0x86, 0xe6, 0xd1, 0xd2, 0x8c,
-	0x3b, 0x9b, 0x07, 0x77, 0x1a, 0xce, 0x9f, 0x08, 0x6e, 0xf4, 0xd5, 0x8b, 0x16, 0x8f, 0x05, 0xc3,
-	0x0f, 0x01, 0xf2, 0x5c, 0x59, 0x5d, 0x5d, 0x5e, 0x20, 0x83, 0xc5, 0x24, 0x79, 0xfd, 0xc3, 0xb8,
-	0xe1, 0x1a, 0x85, 0x78, 0x0e, 0x2e, 0xb5, 0xda, 0x9c, 0xef, 0xd7, 0x2a, 0xf3, 0x68, 0x69, 0xd6,
-	0x55, 0x3f, 0xf0, 0x03, 0x98, 0x95, 0x0f, 0x7b, 0x07, 0x2c, 0x0a, 0x0f, 0x92, 0xda, 0xa4, 0x6c,
-	0x6f, 0x19, 0xed, 0x95, 0x8e, 0xdd, 0x3a, 0xd9, 0x96, 0x19, 0x5b, 0x53, 0xcf, 0x5e, 0xde, 0x9a,
-	0x70, 0xab, 0xb2, 0x4a, 0x85, 0x1c, 0xaf, 0x0f, 0xbc, 0xc8, 0xd8, 0x7f, 0x01, 0x90, 0x8f, 0x4b,
-	0x83, 0xbf, 0x43, 0xd4, 0x6c, 0x49, 0x3a, 0x5b, 0xa2, 0x56, 0x44, 0xcf, 0x96, 0xec, 0x7a, 0x21,
-	0xd3, 0xb5, 0xae, 0x51, 0xe9, 0xfc, 0x87, 0xa0, 0xd6, 0x7f, 0x87, 0x56, 0xe8, 0x11, 0x54, 0x73,
-	0xa2, 0xa2, 0x86, 0xe6, 0x27, 0x97, 0xaa, 0xcb, 0x1f, 0x94, 0x49, 0xb4, 0xd3, 0x60, 0x71, 0x12,
-	0xed, 0x47, 0xac, 0x61, 0x88, 0x6d, 0x36, 0xc0, 0x5f, 0x16, 0x40, 0x57, 0x24, 0xe8, 0xc5, 0x73,
-	0x41, 0x2b, 0x30, 0x26, 0x6a, 0xbc, 0x0e, 0xd3, 0x23, 0xea, 0xaa, 0xf3, 0x9d, 0x0d, 0x78, 0x5b,
-	0xd1, 0x95, 0x69, 0x03, 0x84, 0x7d, 0x0b, 0x66, 0x54, 0x8b, 0x7c, 0xa5, 0x5e, 0x53, 0x81, 0x9d,
-	0x86, 0xf3, 0x2b, 0x02, 0xbb, 0xac, 0x5c, 0x6b, 0x76, 0x17, 0xae, 0x1a, 0x6b, 0xd9, 0xf2, 0x92,
-	0x03, 0x25, 0xdc, 0x8c, 0x7b, 0x25, 0x8f, 0xef, 0xa6, 0xe1, 0x8b, 0xdc, 0x9c, 0x6d, 0x78, 0xa7,
-	0x67, 0xaa, 0x0a, 0xf1, 0xd7, 0x89, 0x97, 0xb0, 0x91, 0xde, 0xa0, 0x13, 0x04, 0xce, 0x59, 0xad,
-	0x34, 0x6d, 0x0f, 0x6e, 0x44, 0xaf, 0xe6, 0xbf, 0xa7, 0x15, 0x14, 0x69, 0x8a, 0x5e, 0xce, 0xbb,
-	0x83, 0x08, 0x18, 0x2b, 0x63, 0xf4, 0xbc, 0x16, 0x0d, 0x0a, 0x5f, 0xa4, 0x5c, 0x4f, 0x11, 0xbc,
-	0xdb, 0x4b, 0x32, 0xa5, 0x15, 0x8b, 0x8e, 0x18, 0x59, 0x32, 0xbc, 0x08, 0x57, 0xda, 0xac, 0x1b,
-	0x89, 0x34, 0x25, 0xee, 0x34, 0x7d, 0xd6, 0x96, 0x90, 0xa7, 0xdc, 0xcb, 0x59, 0xf8, 0x91, 0x8c,
-	0x16, 0x12, 0x0d, 0xf8, 0x46, 0xa2, 0xc6, 0xf7, 0x12, 0xc1, 0xc2, 0x39, 0xf8, 0xf4, 0x1c, 0x36,
-	0x21, 0x5d, 0x33, 0x75, 0x52, 0xd0, 0x7f, 0x8e, 0x28, 0x93, 0x25, 0x99, 0xc9, 0x92, 0xcf, 0xe3,
-	0x23, 0xf7, 0x72, 0x50, 0x68, 0x53, 0xdc, 0xfe, 0x4a, 0x71, 0xfb, 0xf3, 0x01, 0x4c, 0x9e, 0x35,
-	0x80, 0xa9, 0x71, 0x06, 0x60, 0xc3, 0xcd, 0x1e, 0x7e, 0xbb, 0x5e, 0xdb, 0x6b, 0x66, 0x6f, 0xa5,
-	0xf3, 0x6d, 0xf6, 0xda, 0xf6, 0x9d, 0x6b, 0xde, 0x6b, 0x30, 0xdd, 0x92, 0x11, 0x4d, 0xd7, 0x2e,
-	0x73, 0x29, 0x5d, 0xa7, 0xb3, 0x97, 0xff, 0x98, 0x81, 0x4b, 0xb2, 0x33, 0xfe, 0x1d, 0x01, 0xe4,
-	0xed, 0x31, 0x29, 0x6b, 0x30, 0xf8, 0x73, 0x64, 0xd1, 0xa1, 0xf3, 0x15, 0x62, 0xe7, 0x93, 0x1f,
-	0xff, 0xfe, 0xf7, 0xe7, 0xca, 0x2a, 0x5e, 0xa1, 0xe7, 0x7e, 0x44, 0x05, 0x7d, 0x52, 0xd8, 0xba,
-	0x63, 0xfc, 0x14, 0x41, 0xd5, 0x70, 0x1f, 0x3c, 0xec, 0xed, 0x99, 0xa0, 0xd6, 0xfd, 0xe1, 0x0b,
-	0x34, 0xde, 0xf7, 0x25, 0xde, 0x05, 0x7c, 0x7b, 0x08, 0xbc, 0xf8, 0x2f, 0x04, 0x6f, 0xf4, 0x79,
-	0x24, 0x5e, 0x3d, 0xfb, 0xd2, 0x12, 0x4b, 0xb6, 0xd6, 0x46, 0x2d, 0xd3, 0x88, 0x3f, 0x95, 0x88,
-	0xd7, 0xf1, 0x5a, 0x29, 0x62, 0xb5, 0xea, 0x45, 0xa1, 0xb3, 0xf5, 0x3f, 0xc6, 0x2f, 0x10, 0x5c,
-	0x1b, 0xe8, 0x7a, 0xf8, 0xa3, 0x21, 0xd5, 0xeb, 0x37, 0x5d, 0xeb, 0xe3, 0x71, 0x4a, 0x35, 0xa1,
-	0x6d, 0x49, 0x68, 0x0b, 0x7f, 0x36, 0xc6, 0xca, 0x50, 0xd3, 0x93, 0xf1, 0x2f, 0x15, 0xa8, 0x95,
-	0x79, 0x09, 0xde, 0x18, 0x16, 0xe2, 0x20, 0x8b, 0xb4, 0x36, 0xc7, 0xac, 0xd6, 0x1c, 0x7f, 0x90,
-	0x1c, 0x8f, 0xf0, 0xf7, 0x63, 0x71, 0x2c, 0x5a, 0x1f, 0xcd, 0x6c, 0x94, 0x3e, 0xe9, 0x31, 0xe4,
-	0x63, 0xaa, 0xdc, 0xca, 0x38, 0x50, 0x81, 0x63, 0xfc, 0x1b, 0x82, 0xab, 0xbd, 0x36, 0x83, 0x3f,
-	0x1c, 0x92, 0x54, 0xc1, 0xb5, 0xac, 0xd5, 0x11, 0xab, 0xb4, 0x04, 0x77, 0xa4, 0x04, 0xf3, 0xd8,
-	0x2e, 0x93, 0x40, 0x79, 0xd7, 0xd6, 0x37, 0xcf, 0x4e, 0x6c, 0xf4, 0xfc, 0xc4, 0x46, 0xff, 0x9c,
-	0xd8, 0xe8, 0xa7, 0x53, 0x7b, 0xe2, 0xf9, 0xa9, 0x3d, 0xf1, 0xe2, 0xd4, 0x9e, 0xf8, 0x6e, 0x23,
-	0x8c, 0x92, 0x83, 0x8e, 0x4f, 0x02, 0xde, 0xa4, 0xfa, 0xff, 0x7e, 0xe4, 0x07, 0xf7, 0x42, 0x4e,
-	0xbb, 0xeb, 0xb4, 0xc9, 0x1b, 0x9d, 0x43, 0x26, 0x54, 0xe3, 0xfb, 0x2b, 0xf7, 0x8c, 0xde, 0xc9,
-	0x51, 0x8b, 0x09, 0x7f, 0x5a, 0x7e, 0x22, 0x56, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x46, 0x93,
-	0x88, 0xce, 0x7d, 0x0c, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xee, 0x64, 0xbb, 0x85, 0xbe, 0x64, 0xb7, 0xcb, 0xa8, 0xdb, 0x0d, 0x66, 0xeb, 0x16, 0xb7,
+	0xdd, 0x76, 0x81, 0xf5, 0x6c, 0x5a, 0x5a, 0x15, 0xda, 0x02, 0xdb, 0x52, 0x68, 0x2e, 0x4b, 0x09,
+	0x02, 0x24, 0x2e, 0x91, 0xe3, 0x4c, 0x5d, 0x4b, 0x8d, 0xc7, 0x6b, 0x3b, 0x41, 0xd5, 0xaa, 0x42,
+	0xe2, 0x17, 0x20, 0x71, 0xe1, 0xb2, 0x57, 0x90, 0xf8, 0x0b, 0x70, 0xe2, 0xc2, 0x1e, 0x17, 0x71,
+	0xd9, 0x03, 0x5a, 0xa1, 0x94, 0x2b, 0xff, 0x01, 0x79, 0x66, 0x52, 0xdb, 0x89, 0xdd, 0x26, 0x41,
+	0xbd, 0xc5, 0xcf, 0xdf, 0x7b, 0xf3, 0x7d, 0xdf, 0xbc, 0x99, 0xe7, 0x80, 0x66, 0xd7, 0x4c, 0x62,
+	0x32, 0x8f, 0x12, 0x93, 0x39, 0x0e, 0x35, 0x03, 0x9b, 0x39, 0xa4, 0x55, 0x22, 0x8f, 0x9a, 0xd4,
+	0x3b, 0xd6, 0x5d, 0x8f, 0x05, 0x0c, 0x4f, 0xd9, 0x35, 0x53, 0x0f, 0x31, 0x7a, 0x84, 0xd1, 0x5b,
+	0x25, 0x65, 0xd2, 0x62, 0x16, 0xe3, 0x10, 0x12, 0xfe, 0x12, 0x68, 0xe5, 0x0d, 0x93, 0xf9, 0x0d,
+	0xe6, 0x93, 0x9a, 0xe1, 0x53, 0x51, 0x86, 0xb4, 0x4a, 0x35, 0x1a, 0x18, 0x25, 0xe2, 0x1a, 0x96,
+	0xed, 0x18, 0x3c, 0x5d, 0x60, 0x67, 0xa2, 0xd5, 0x8f, 0x6c, 0xea, 0x04, 0xe1, 0xca, 0xe2, 0x97,
+	0x04, 0x2c, 0x66, 0xd0, 0x8b, 0x9e, 0x24, 0x70, 0x3e, 0x03, 0xd8, 0x74, 0x2d, 0xcf, 0xa8, 0x53,
+	0x89, 0xba, 0x6d, 0x31, 0x66, 0x1d, 0x51, 0x62, 0xb8, 0x36, 0x31, 0x1c, 0x87, 0x05, 0x9c, 0x8c,
+	0x2f, 0xdf, 0xbe, 0x2a, 0xdf, 0xf2, 0xa7, 0x5a, 0xf3, 0x80, 0x18, 0x8e, 0xb4, 0x40, 0xdb, 0x82,
+	0xa9, 0x4f, 0x43, 0x29, 0x3b, 0x67, 0xc5, 0x2b, 0xf4, 0x51, 0x93, 0xfa, 0x01, 0x9e, 0x83, 0x6b,
+	0xd1, 0x8a, 0x55, 0xbb, 0x5e, 0x44, 0xb3, 0x68, 0x69, 0xbc, 0x52, 0x88, 0x82, 0xe5, 0xba, 0xf6,
+	0x0b, 0x82, 0x5b, 0x3d, 0xf9, 0xbe, 0xcb, 0x1c, 0x9f, 0xe2, 0x5d, 0x80, 0x08, 0xcb, 0xb3, 0xf3,
+	0xcb, 0x0b, 0x7a, 0xba, 0xe5, 0x7a, 0x94, 0xbf, 0xeb, 0xd4, 0x2b, 0xb1, 0x44, 0x3c, 0x09, 0x57,
+	0x5d, 0x8f, 0xb1, 0x83, 0x62, 0x6e, 0x16, 0x2d, 0x15, 0x2a, 0xe2, 0x01, 0xef, 0x40, 0x81, 0xff,
+	0xa8, 0x1e, 0x52, 0xdb, 0x3a, 0x0c, 0x8a, 0x57, 0x78, 0x79, 0x25, 0x56, 0x5e, 0xb8, 0xdd, 0x2a,
+	0xe9, 0x7b, 0x1c, 0xb1, 0x3d, 0xfa, 0xf4, 0xc5, 0xcc, 0x48, 0x25, 0xcf, 0xb3, 0x44, 0x48, 0x33,
+	0x7a, 0xc8, 0xfb, 0x1d, 0xf5, 0x1f, 0x01, 0x44, 0x9b, 0x2a, 0xc9, 0xdf, 0xd1, 0x45, 0x07, 0xe8,
+	0x61, 0x07, 0xe8, 0xa2, 0x91, 0x64, 0x07, 0xe8, 0xfb, 0x86, 0x45, 0x65, 0x6e, 0x25, 0x96, 0xa9,
+	0xfd, 0x8b, 0xa0, 0xd8, 0xbb, 0x86, 0x74, 0xe8, 0x21, 0xe4, 0x23, 0xa1, 0x7e, 0x11, 0xcd, 0x5e,
+	0x59, 0xca, 0x2f, 0xbf, 0x95, 0x65, 0x51, 0xb9, 0x4e, 0x9d, 0xc0, 0x3e, 0xb0, 0x69, 0x3d, 0x66,
+	0x76, 0xbc, 0x00, 0xfe, 0x38, 0x41, 0x3a, 0xc7, 0x49, 0x2f, 0x5e, 0x48, 0x5a, 0x90, 0x89, 0xb3,
+	0xc6, 0xeb, 0x30, 0x36, 0xa0, 0xaf, 0x12, 0xaf, 0x6d, 0xc2, 0xb4, 0x90, 0xcb, 0x61, 0x29, 0xc6,
+	0xbe, 0x06, 0xe3, 0xa2, 0x44, 0xd4, 0x52, 0x2f, 0x8b, 0x40, 0xb9, 0xae, 0xfd, 0x88, 0x40, 0xcd,
+	0x4a, 0x97, 0x9e, 0xdd, 0x85, 0x1b, 0xb1, 0xb6, 0x74, 0x8d, 0xe0, 0x50, 0x18, 0x37, 0x5e, 0x99,
+	0x88, 0xe2, 0xfb, 0x61, 0xf8, 0x32, 0x3b, 0x67, 0x0f, 0x5e, 0xef, 0xda, 0x55, 0xc1, 0xf8, 0xb3,
+	0xc0, 0x08, 0xe8, 0x40, 0x27, 0xa8, 0x8d, 0x40, 0x3b, 0xaf, 0x94, 0x94, 0x6d, 0xc0, 0x2d, 0xfb,
+	0x6c, 0xff, 0xab, 0xd2, 0x41, 0x3f, 0x84, 0xc8, 0xe6, 0xbc, 0x9b, 0x26, 0x20, 0xd6, 0x32, 0xb1,
+	0x9a, 0x37, 0xed, 0xb4, 0xf0, 0x65, 0xda, 0xf5, 0x04, 0xc1, 0x7c, 0xb7, 0xc8, 0x50, 0x96, 0xe3,
+	0x37, 0xfd, 0x81, 0x2d, 0xc3, 0x8b, 0x30, 0xe1, 0xd1, 0x96, 0xed, 0x87, 0x10, 0xa7, 0xd9, 0xa8,
+	0x51, 0x8f, 0x53, 0x1e, 0xad, 0x5c, 0xef, 0x84, 0x1f, 0xf2, 0x68, 0x02, 0x18, 0xa3, 0x1f, 0x03,
+	0x4a, 0x7e, 0x2f, 0x10, 0x2c, 0x5c, 0xc0, 0x4f, 0xee, 0xc3, 0x16, 0x4c, 0x98, 0x9d, 0x37, 0x09,
+	0xff, 0x27, 0x75, 0x71, 0xc9, 0xea, 0x9d, 0x4b, 0x56, 0x7f, 0xe0, 0x1c, 0x57, 0xae, 0x9b, 0x89,
+	0x32, 0xc9, 0xee, 0xcf, 0x25, 0xbb, 0x3f, 0xda, 0x80, 0x2b, 0xe7, 0x6d, 0xc0, 0xe8, 0x30, 0x1b,
+	0xa0, 0xc2, 0xed, 0x2e, 0x7d, 0xfb, 0x86, 0x67, 0x34, 0x3a, 0xa7, 0x52, 0xfb, 0x12, 0xa6, 0x33,
+	0xde, 0x4b, 0xdd, 0x6b, 0x30, 0xe6, 0xf2, 0x88, 0x94, 0xab, 0x66, 0xdd, 0x52, 0x32, 0x4f, 0xa2,
+	0xb5, 0x72, 0x4f, 0x77, 0x7f, 0x2e, 0x06, 0xd7, 0xae, 0xe7, 0x31, 0x6f, 0xa0, 0x93, 0xf2, 0x07,
+	0x82, 0xb9, 0x73, 0x6b, 0x49, 0xaa, 0x9f, 0xc0, 0x35, 0x1a, 0x06, 0xaa, 0x1e, 0x35, 0xa9, 0xed,
+	0x06, 0x92, 0xf1, 0x7c, 0x16, 0x63, 0x99, 0xcd, 0xb1, 0xd2, 0xbb, 0x02, 0x8d, 0xc5, 0x2e, 0xf3,
+	0x60, 0x7c, 0x08, 0xd3, 0xe9, 0x92, 0x06, 0x72, 0xe6, 0xd7, 0xb3, 0x6b, 0xb3, 0xb7, 0x8c, 0x34,
+	0xe5, 0x7d, 0x78, 0x49, 0x7e, 0x31, 0x48, 0x3b, 0x66, 0xb2, 0xec, 0x90, 0x99, 0x92, 0x6d, 0x27,
+	0xeb, 0x12, 0x4d, 0x58, 0x6e, 0x17, 0xe0, 0x2a, 0xa7, 0x8f, 0x7f, 0x46, 0x00, 0x91, 0x06, 0xac,
+	0x67, 0x71, 0x4c, 0xff, 0x64, 0x51, 0x48, 0xdf, 0x78, 0xe1, 0x8a, 0xb6, 0xf1, 0xed, 0x9f, 0xff,
+	0x7c, 0x9f, 0x5b, 0xc5, 0x2b, 0xe4, 0xc2, 0xcf, 0x31, 0x9f, 0x3c, 0x4e, 0x6c, 0xc4, 0x09, 0x7e,
+	0x82, 0x20, 0x1f, 0xd5, 0xf4, 0x71, 0xbf, 0xab, 0x77, 0x0e, 0x9d, 0x72, 0xbf, 0xff, 0x04, 0xc9,
+	0xf7, 0x4d, 0xce, 0x77, 0x01, 0xcf, 0xf5, 0xc1, 0x17, 0xff, 0x86, 0xe0, 0x95, 0x9e, 0x39, 0x8a,
+	0x57, 0xcf, 0x5f, 0x34, 0x63, 0x6c, 0x2b, 0x6b, 0x83, 0xa6, 0x49, 0xc6, 0xef, 0x71, 0xc6, 0xeb,
+	0x78, 0x2d, 0x93, 0xb1, 0xb8, 0x0e, 0x93, 0x46, 0x77, 0xae, 0xc8, 0x13, 0xfc, 0x1c, 0xc1, 0xcd,
+	0xd4, 0xc9, 0x88, 0xdf, 0xe9, 0xd3, 0xbd, 0xde, 0xc1, 0xac, 0xbc, 0x3b, 0x4c, 0xaa, 0x14, 0xb4,
+	0xc7, 0x05, 0x6d, 0xe3, 0x0f, 0x86, 0x68, 0x19, 0x12, 0x9f, 0xdb, 0xf8, 0x87, 0x1c, 0x14, 0xb3,
+	0xe6, 0x0d, 0xde, 0xec, 0x97, 0x62, 0xda, 0x18, 0x55, 0xb6, 0x86, 0xcc, 0x96, 0x1a, 0xbf, 0xe1,
+	0x1a, 0x8f, 0xf1, 0xd7, 0x43, 0x69, 0x4c, 0x8e, 0x47, 0xd2, 0x19, 0xb5, 0xe4, 0x71, 0xd7, 0xd0,
+	0x3e, 0x21, 0xe2, 0xd2, 0x88, 0xbd, 0x10, 0x81, 0x13, 0xfc, 0x13, 0x82, 0x1b, 0xdd, 0xa3, 0x08,
+	0xbf, 0xdd, 0xa7, 0xa8, 0xc4, 0x64, 0x53, 0x56, 0x07, 0xcc, 0x92, 0x16, 0xdc, 0xe1, 0x16, 0xcc,
+	0x62, 0x35, 0xcb, 0x02, 0x31, 0xdf, 0xf0, 0x5f, 0x08, 0xa6, 0xd2, 0xe7, 0x11, 0xee, 0xb7, 0xcb,
+	0x52, 0x06, 0xa2, 0xb2, 0x31, 0x54, 0xae, 0xe4, 0x5e, 0xe6, 0xdc, 0x77, 0xf0, 0x83, 0x61, 0xb6,
+	0x4f, 0xde, 0xf7, 0x55, 0x3e, 0x01, 0xf1, 0xef, 0xe1, 0x1d, 0xd2, 0xbd, 0x1a, 0x5e, 0x1d, 0x8c,
+	0x5d, 0x9f, 0x77, 0x48, 0xd6, 0xec, 0xd2, 0x76, 0xb8, 0x9e, 0x2d, 0xbc, 0xf1, 0x3f, 0xf4, 0x6c,
+	0x7f, 0xf1, 0xb4, 0xad, 0xa2, 0x67, 0x6d, 0x15, 0xfd, 0xdd, 0x56, 0xd1, 0x77, 0xa7, 0xea, 0xc8,
+	0xb3, 0x53, 0x75, 0xe4, 0xf9, 0xa9, 0x3a, 0xf2, 0xd5, 0xa6, 0x65, 0x07, 0x87, 0xcd, 0x9a, 0x6e,
+	0xb2, 0x06, 0x91, 0x7f, 0xf1, 0xed, 0x9a, 0x79, 0xcf, 0x62, 0xa4, 0xb5, 0x4e, 0x1a, 0xac, 0xde,
+	0x3c, 0xa2, 0xbe, 0x58, 0xf5, 0xfe, 0xca, 0xbd, 0xd8, 0xc2, 0xc1, 0xb1, 0x4b, 0xfd, 0xda, 0x18,
+	0xff, 0xde, 0x5b, 0xf9, 0x6f, 0x00, 0x8e, 0xd8, 0xe8, 0xd5, 0x70, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -809,6 +1040,10 @@ type QueryClient interface {
 	ConnectionConsensusState(ctx context.Context, in *QueryConnectionConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionConsensusStateResponse, error)
 	// ConnectionParams queries all parameters of the ibc connection submodule.
 	ConnectionParams(ctx context.Context, in *QueryConnectionParamsRequest, opts ...grpc.CallOption) (*QueryConnectionParamsResponse, error)
+	// ConnectionUpgradeError returns the error receipt if the upgrade handshake failed.
+	ConnectionUpgradeError(ctx context.Context, in *QueryConnectionUpgradeErrorRequest, opts ...grpc.CallOption) (*QueryConnectionUpgradeErrorResponse, error)
+	// ConnectionUpgrade returns the upgrade for a given connection.
+	ConnectionUpgrade(ctx context.Context, in *QueryConnectionUpgradeRequest, opts ...grpc.CallOption) (*QueryConnectionUpgradeResponse, error)
 }
 
 type queryClient struct {
@@ -873,6 +1108,24 @@ func (c *queryClient) ConnectionParams(ctx context.Context, in *QueryConnectionP
 	return out, nil
 }
 
+func (c *queryClient) ConnectionUpgradeError(ctx context.Context, in *QueryConnectionUpgradeErrorRequest, opts ...grpc.CallOption) (*QueryConnectionUpgradeErrorResponse, error) {
+	out := new(QueryConnectionUpgradeErrorResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionUpgradeError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConnectionUpgrade(ctx context.Context, in *QueryConnectionUpgradeRequest, opts ...grpc.CallOption) (*QueryConnectionUpgradeResponse, error) {
+	out := new(QueryConnectionUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Connection queries an IBC connection end.
@@ -890,6 +1143,10 @@ type QueryServer interface {
 	ConnectionConsensusState(context.Context, *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error)
 	// ConnectionParams queries all parameters of the ibc connection submodule.
 	ConnectionParams(context.Context, *QueryConnectionParamsRequest) (*QueryConnectionParamsResponse, error)
+	// ConnectionUpgradeError returns the error receipt if the upgrade handshake failed.
+	ConnectionUpgradeError(context.Context, *QueryConnectionUpgradeErrorRequest) (*QueryConnectionUpgradeErrorResponse, error)
+	// ConnectionUpgrade returns the upgrade for a given connection.
+	ConnectionUpgrade(context.Context, *QueryConnectionUpgradeRequest) (*QueryConnectionUpgradeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -914,6 +1171,12 @@ func (*UnimplementedQueryServer) ConnectionConsensusState(ctx context.Context, r
 func (*UnimplementedQueryServer) ConnectionParams(ctx context.Context, req *QueryConnectionParamsRequest) (*QueryConnectionParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionParams not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionUpgradeError(ctx context.Context, req *QueryConnectionUpgradeErrorRequest) (*QueryConnectionUpgradeErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeError not implemented")
+}
+func (*UnimplementedQueryServer) ConnectionUpgrade(ctx context.Context, req *QueryConnectionUpgradeRequest) (*QueryConnectionUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgrade not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1027,6 +1290,42 @@ func _Query_ConnectionParams_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionUpgradeError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionUpgradeErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionUpgradeError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionUpgradeError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionUpgradeError(ctx, req.(*QueryConnectionUpgradeErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConnectionUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionUpgrade(ctx, req.(*QueryConnectionUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.connection.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1055,6 +1354,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionParams",
 			Handler:    _Query_ConnectionParams_Handler,
 		},
+		{
+			MethodName: "ConnectionUpgradeError",
+			Handler:    _Query_ConnectionUpgradeError_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgrade",
+			Handler:    _Query_ConnectionUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/connection/v1/query.proto",
@@ -1554,66 +1861,226 @@ func (m *QueryConnectionParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryConnectionUpgradeErrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConnectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryConnectionUpgradeErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConnectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConnectionUpgradeErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Connection != nil {
-		l = m.Connection.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConnectionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConnectionUpgradeErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryConnectionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConnectionUpgradeErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionUpgradeErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionUpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConnectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Connection != nil {
+		l = m.Connection.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConnectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Connections) > 0 {
@@ -1761,6 +2228,66 @@ func (m *QueryConnectionParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConnectionUpgradeErrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionUpgradeErrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ErrorReceipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConnectionUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3148,6 +3675,470 @@ func (m *QueryConnectionParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeErrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,6 +31,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ResponseResultType defines the possible outcomes of the execution of a connection upgrade message
+type ResponseResultType int32
+
+const (
+	// Default zero value enumeration
+	UNSPECIFIED ResponseResultType = 0
+	// The message was executed successfully
+	SUCCESS ResponseResultType = 1
+	// The message was executed unsuccessfully and an error receipt was written
+	FAILURE ResponseResultType = 2
+)
+
+var ResponseResultType_name = map[int32]string{
+	0: "RESPONSE_RESULT_TYPE_UNSPECIFIED",
+	1: "RESPONSE_RESULT_TYPE_SUCCESS",
+	2: "RESPONSE_RESULT_TYPE_FAILURE",
+}
+
+var ResponseResultType_value = map[string]int32{
+	"RESPONSE_RESULT_TYPE_UNSPECIFIED": 0,
+	"RESPONSE_RESULT_TYPE_SUCCESS":     1,
+	"RESPONSE_RESULT_TYPE_FAILURE":     2,
+}
+
+func (x ResponseResultType) String() string {
+	return proto.EnumName(ResponseResultType_name, int32(x))
+}
+
+func (ResponseResultType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{0}
+}
+
 // MsgConnectionOpenInit defines the msg sent by an account on Chain A to
 // initialize a connection with Chain B.
 type MsgConnectionOpenInit struct {
@@ -458,781 +490,1101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
-	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
-	proto.RegisterType((*MsgConnectionOpenTry)(nil), "ibc.core.connection.v1.MsgConnectionOpenTry")
-	proto.RegisterType((*MsgConnectionOpenTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenTryResponse")
-	proto.RegisterType((*MsgConnectionOpenAck)(nil), "ibc.core.connection.v1.MsgConnectionOpenAck")
-	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
-	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
-	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.connection.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.connection.v1.MsgUpdateParamsResponse")
+// MsgConnectionUpgradeInit defines the request type for the ConnectionUpgradeInit rpc
+type MsgConnectionUpgradeInit struct {
+	ConnectionId string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Fields       UpgradeFields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields"`
+	Signer       string        `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
-
-var fileDescriptor_5d00fde5fc97399e = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0xd6, 0x49, 0x9b, 0x97, 0x2c, 0x85, 0x51, 0xda, 0x7a, 0xbd, 0xac, 0x93, 0x2d,
-	0x48, 0xad, 0x56, 0xd4, 0xde, 0xee, 0x82, 0x28, 0xd0, 0x4b, 0x9b, 0x0b, 0x15, 0x2a, 0xac, 0x4c,
-	0xd9, 0x03, 0x97, 0x28, 0x71, 0xa6, 0xee, 0xa8, 0x8d, 0xc7, 0xf2, 0x38, 0x61, 0xcd, 0x09, 0xc1,
-	0x05, 0x89, 0x0b, 0x1f, 0x81, 0x8f, 0xb0, 0x1f, 0x63, 0xc5, 0x01, 0xad, 0x38, 0x71, 0x42, 0xa8,
-	0x3d, 0xec, 0x17, 0xe0, 0x03, 0x20, 0xcf, 0x8c, 0x1d, 0x27, 0x71, 0x2a, 0x67, 0xcb, 0x2d, 0x79,
-	0xfe, 0xbf, 0x37, 0xff, 0x79, 0xef, 0x37, 0xf6, 0x40, 0x93, 0xf4, 0x1c, 0xcb, 0xa1, 0x01, 0xb6,
-	0x1c, 0xea, 0x79, 0xd8, 0x09, 0x09, 0xf5, 0xac, 0xd1, 0x9e, 0x15, 0x3e, 0x37, 0xfd, 0x80, 0x86,
-	0x14, 0x6d, 0x90, 0x9e, 0x63, 0xc6, 0x02, 0x73, 0x2c, 0x30, 0x47, 0x7b, 0x7a, 0xc3, 0xa5, 0x2e,
-	0xe5, 0x12, 0x2b, 0xfe, 0x25, 0xd4, 0xfa, 0xa6, 0x43, 0xd9, 0x80, 0x32, 0x6b, 0xc0, 0xdc, 0xb8,
-	0xca, 0x80, 0xb9, 0xf2, 0xc1, 0x5d, 0x97, 0x52, 0xf7, 0x12, 0x5b, 0xfc, 0x5f, 0x6f, 0x78, 0x66,
-	0x75, 0xbd, 0x48, 0x3e, 0xca, 0x58, 0xb8, 0x24, 0xd8, 0x0b, 0xe3, 0x44, 0xf1, 0x4b, 0x0a, 0xb6,
-	0xe7, 0x78, 0xcc, 0x18, 0xe2, 0xc2, 0xad, 0x5f, 0x96, 0x60, 0xfd, 0x84, 0xb9, 0xed, 0x34, 0xfe,
-	0x95, 0x8f, 0xbd, 0x63, 0x8f, 0x84, 0xe8, 0x1e, 0x54, 0x45, 0xc9, 0x0e, 0xe9, 0x6b, 0x4a, 0x4b,
-	0xd9, 0xa9, 0xda, 0xab, 0x22, 0x70, 0xdc, 0x47, 0x5f, 0x42, 0xdd, 0xa1, 0x43, 0x2f, 0xc4, 0x81,
-	0xdf, 0x0d, 0xc2, 0x48, 0x5b, 0x6a, 0x29, 0x3b, 0xb5, 0xc7, 0xef, 0x9b, 0xf9, 0x3b, 0x37, 0xdb,
-	0x19, 0xed, 0x91, 0xfa, 0xf2, 0xef, 0x66, 0xc9, 0x9e, 0xc8, 0x47, 0x9f, 0xc0, 0xca, 0x08, 0x07,
-	0x8c, 0x50, 0x4f, 0x5b, 0xe6, 0xa5, 0x9a, 0xf3, 0x4a, 0x3d, 0x13, 0x32, 0x3b, 0xd1, 0xa3, 0x07,
-	0x50, 0xef, 0xe3, 0xcb, 0x6e, 0xd4, 0xf1, 0x71, 0x40, 0x68, 0x5f, 0x53, 0x5b, 0xca, 0x8e, 0x6a,
-	0xd7, 0x78, 0xec, 0x29, 0x0f, 0xa1, 0x0d, 0xa8, 0x30, 0xe2, 0x7a, 0x38, 0xd0, 0xca, 0x7c, 0x1f,
-	0xf2, 0xdf, 0xa7, 0x6b, 0x3f, 0xff, 0xd6, 0x2c, 0xfd, 0xf8, 0xfa, 0xc5, 0x43, 0x19, 0xd8, 0x6a,
-	0xc2, 0xfd, 0xdc, 0x66, 0xd8, 0x98, 0xf9, 0xd4, 0x63, 0x78, 0xeb, 0xcf, 0x32, 0x34, 0x66, 0x14,
-	0xa7, 0x41, 0x74, 0x73, 0xb7, 0xf6, 0x61, 0xc3, 0x0f, 0xf0, 0x88, 0xd0, 0x21, 0xeb, 0x8c, 0x77,
-	0x13, 0x2b, 0xe3, 0xbe, 0x55, 0x8f, 0x96, 0x34, 0xc5, 0x6e, 0x24, 0x8a, 0x71, 0xed, 0xe3, 0x3e,
-	0xfa, 0x18, 0xea, 0xb2, 0x2c, 0x0b, 0xbb, 0x21, 0x96, 0xcd, 0x69, 0x98, 0x02, 0x0d, 0x33, 0x41,
-	0xc3, 0x3c, 0xf4, 0x22, 0xbb, 0x26, 0x94, 0x5f, 0xc7, 0xc2, 0x99, 0x01, 0xa9, 0xb7, 0x1c, 0xd0,
-	0x74, 0x97, 0xcb, 0xb3, 0x5d, 0x3e, 0x85, 0xf5, 0x6c, 0x4a, 0x47, 0x0e, 0x88, 0x69, 0x95, 0xd6,
-	0x72, 0x91, 0x89, 0x36, 0xb2, 0xd9, 0x32, 0xc8, 0x50, 0x1b, 0xea, 0x7e, 0x40, 0xe9, 0x59, 0xe7,
-	0x1c, 0x13, 0xf7, 0x3c, 0xd4, 0x56, 0xf8, 0x46, 0xf4, 0x4c, 0x31, 0xc1, 0xfd, 0x68, 0xcf, 0xfc,
-	0x9c, 0x2b, 0xa4, 0xfd, 0x1a, 0xcf, 0x12, 0x21, 0x74, 0x1f, 0x40, 0x14, 0x21, 0x1e, 0x09, 0xb5,
-	0xd5, 0x96, 0xb2, 0x53, 0xb7, 0xab, 0x3c, 0xc2, 0x51, 0x7f, 0x90, 0xac, 0x21, 0x6a, 0x69, 0x55,
-	0x2e, 0x10, 0x15, 0xda, 0x3c, 0x84, 0xb6, 0x61, 0x4d, 0x4a, 0x62, 0x0e, 0x3c, 0x36, 0x64, 0x1a,
-	0x70, 0xd5, 0x5b, 0x42, 0x95, 0x44, 0xd1, 0x17, 0xf0, 0x76, 0x2a, 0x49, 0x3c, 0xd7, 0x0a, 0x7a,
-	0x5e, 0x4b, 0x33, 0xa5, 0xef, 0x31, 0xb8, 0xf5, 0x2c, 0xb8, 0xe8, 0x33, 0xd0, 0xcf, 0x29, 0x0b,
-	0xc7, 0x66, 0x04, 0x1e, 0x1d, 0xee, 0x45, 0xbb, 0xc3, 0x8d, 0x6d, 0xc6, 0x8a, 0xd4, 0x17, 0xa7,
-	0xe2, 0x69, 0xfc, 0x78, 0x96, 0x7a, 0x03, 0xde, 0xcd, 0x63, 0x3a, 0x85, 0xfe, 0x0f, 0x35, 0x07,
-	0xfa, 0x43, 0xe7, 0x02, 0xbd, 0x07, 0x77, 0x26, 0x71, 0x16, 0xe0, 0xd7, 0x9d, 0x2c, 0xc2, 0x07,
-	0xa0, 0x4f, 0x60, 0x91, 0x73, 0x00, 0x6c, 0x2d, 0xab, 0x98, 0x38, 0x00, 0xb7, 0x78, 0x31, 0x4c,
-	0x9f, 0x1d, 0xb5, 0xe8, 0xd9, 0x99, 0x46, 0xae, 0xfc, 0x26, 0xc8, 0xdd, 0x03, 0x01, 0x58, 0x27,
-	0x0c, 0x22, 0xad, 0xc2, 0x27, 0xb2, 0xca, 0x03, 0xf1, 0xdb, 0x62, 0x1a, 0xb8, 0x95, 0x42, 0xc0,
-	0xad, 0x16, 0x06, 0xae, 0x7a, 0x7b, 0xe0, 0x60, 0x01, 0xe0, 0x6a, 0xff, 0x03, 0x70, 0x87, 0xce,
-	0x45, 0x0a, 0xdc, 0xef, 0x0a, 0x68, 0x33, 0x82, 0x36, 0xf5, 0xce, 0x48, 0x30, 0x28, 0x06, 0x5d,
-	0xda, 0xfd, 0xae, 0x73, 0xc1, 0x19, 0x4b, 0xba, 0x1f, 0x63, 0x3b, 0x3d, 0xdf, 0xe5, 0x37, 0x99,
-	0xef, 0xb8, 0x53, 0xea, 0xcd, 0xdf, 0x94, 0x2d, 0x68, 0xcd, 0xdb, 0x4b, 0xba, 0xe1, 0xe7, 0xb0,
-	0x76, 0xc2, 0xdc, 0x6f, 0xfc, 0x7e, 0xdc, 0xb3, 0x6e, 0xd0, 0x1d, 0xb0, 0x4c, 0x7d, 0x65, 0x62,
-	0x12, 0x07, 0x50, 0xf1, 0xb9, 0x42, 0x7e, 0x73, 0x8d, 0x79, 0xe7, 0x41, 0xd4, 0x91, 0xd6, 0x65,
-	0xce, 0xac, 0xbb, 0xbb, 0xb0, 0x39, 0xb5, 0x72, 0x62, 0xea, 0xf1, 0xbf, 0x2a, 0x2c, 0x9f, 0x30,
-	0x17, 0x7d, 0x0f, 0x28, 0xe7, 0x7a, 0xb0, 0x3b, 0x6f, 0xdd, 0xdc, 0x0f, 0xa8, 0xfe, 0xd1, 0x42,
-	0xf2, 0xc4, 0x03, 0xfa, 0x0e, 0xde, 0x99, 0xfd, 0xd6, 0x7e, 0x50, 0xb8, 0xd6, 0x69, 0x10, 0xe9,
-	0x1f, 0x2e, 0xa2, 0x9e, 0xbf, 0x70, 0x0c, 0x4e, 0xf1, 0x85, 0x0f, 0x9d, 0x8b, 0x05, 0x16, 0xce,
-	0xb0, 0x8f, 0x7e, 0x52, 0x60, 0x3d, 0x1f, 0xfc, 0x47, 0x85, 0xeb, 0xc9, 0x0c, 0x7d, 0x7f, 0xd1,
-	0x8c, 0xd4, 0x45, 0x00, 0x1b, 0x82, 0x89, 0xb1, 0x4c, 0x72, 0xb9, 0x7d, 0x43, 0xcd, 0x2c, 0x46,
-	0xba, 0x55, 0x50, 0x98, 0xac, 0xa9, 0x97, 0x7f, 0x78, 0xfd, 0xe2, 0xa1, 0x72, 0xf4, 0xec, 0xe5,
-	0x95, 0xa1, 0xbc, 0xba, 0x32, 0x94, 0x7f, 0xae, 0x0c, 0xe5, 0xd7, 0x6b, 0xa3, 0xf4, 0xea, 0xda,
-	0x28, 0xfd, 0x75, 0x6d, 0x94, 0xbe, 0x3d, 0x70, 0x49, 0x78, 0x3e, 0xec, 0x99, 0x0e, 0x1d, 0x58,
-	0xf2, 0xd2, 0x4c, 0x7a, 0xce, 0xae, 0x4b, 0xad, 0xd1, 0xbe, 0x35, 0xa0, 0xfd, 0xe1, 0x25, 0x66,
-	0xe2, 0xd2, 0xfb, 0xe8, 0xc9, 0x6e, 0xe6, 0xde, 0x1b, 0x46, 0x3e, 0x66, 0xbd, 0x0a, 0x7f, 0xe1,
-	0x3f, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x92, 0xe1, 0xc5, 0x47, 0xbf, 0x0b, 0x00, 0x00,
+func (m *MsgConnectionUpgradeInit) Reset()         { *m = MsgConnectionUpgradeInit{} }
+func (m *MsgConnectionUpgradeInit) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeInit) ProtoMessage()    {}
+func (*MsgConnectionUpgradeInit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{10}
+}
+func (m *MsgConnectionUpgradeInit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeInit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeInit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionUpgradeInit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeInit.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeInit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeInit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeInit.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgConnectionUpgradeInit proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
-	ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error)
-	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
-	ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error)
-	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
-	ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error)
-	// ConnectionOpenConfirm defines a rpc handler method for
-	// MsgConnectionOpenConfirm.
-	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
-	// UpdateConnectionParams defines a rpc handler method for
-	// MsgUpdateParams.
-	UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgConnectionUpgradeInitResponse defines the MsgConnectionUpgradeInit response type
+type MsgConnectionUpgradeInitResponse struct {
+	Upgrade         Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+	UpgradeSequence uint64  `protobuf:"varint,2,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgConnectionUpgradeInitResponse) Reset()         { *m = MsgConnectionUpgradeInitResponse{} }
+func (m *MsgConnectionUpgradeInitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeInitResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeInitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{11}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgConnectionUpgradeInitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error) {
-	out := new(MsgConnectionOpenInitResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenInit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeInitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgConnectionUpgradeInitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeInitResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeInitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeInitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeInitResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error) {
-	out := new(MsgConnectionOpenTryResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenTry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgConnectionUpgradeInitResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeTry defines the request type for the ConnectionUpgradeTry rpc
+type MsgConnectionUpgradeTry struct {
+	ConnectionId                string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CounterpartyUpgradeFields   UpgradeFields `protobuf:"bytes,2,opt,name=counterparty_upgrade_fields,json=counterpartyUpgradeFields,proto3" json:"counterparty_upgrade_fields"`
+	CounterpartyUpgradeSequence uint64        `protobuf:"varint,3,opt,name=counterparty_upgrade_sequence,json=counterpartyUpgradeSequence,proto3" json:"counterparty_upgrade_sequence,omitempty"`
+	ProofConnection             []byte        `protobuf:"bytes,4,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofUpgrade                []byte        `protobuf:"bytes,5,opt,name=proof_upgrade,json=proofUpgrade,proto3" json:"proof_upgrade,omitempty"`
+	ProofHeight                 types1.Height `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer                      string        `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	// client state of the client the counterparty connection end is upgraded to, required if the
+	// counterparty upgrade changes its client
+	CounterpartyClientState *types.Any `protobuf:"bytes,8,opt,name=counterparty_client_state,json=counterpartyClientState,proto3" json:"counterparty_client_state,omitempty"`
+	// proof of the counterparty client state included in message
+	ProofClient []byte `protobuf:"bytes,9,opt,name=proof_client,json=proofClient,proto3" json:"proof_client,omitempty"`
 }
 
-func (c *msgClient) ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error) {
-	out := new(MsgConnectionOpenAckResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenAck", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeTry) Reset()         { *m = MsgConnectionUpgradeTry{} }
+func (m *MsgConnectionUpgradeTry) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTry) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{12}
+}
+func (m *MsgConnectionUpgradeTry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgConnectionUpgradeTry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTry.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTry.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error) {
-	out := new(MsgConnectionOpenConfirmResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgConnectionUpgradeTry proto.InternalMessageInfo
+
+// MsgConnectionUpgradeTryResponse defines the MsgConnectionUpgradeTry response type
+type MsgConnectionUpgradeTryResponse struct {
+	Upgrade         Upgrade            `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+	UpgradeSequence uint64             `protobuf:"varint,2,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	Result          ResponseResultType `protobuf:"varint,3,opt,name=result,proto3,enum=ibc.core.connection.v1.ResponseResultType" json:"result,omitempty"`
 }
 
-func (c *msgClient) UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/UpdateConnectionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeTryResponse) Reset()         { *m = MsgConnectionUpgradeTryResponse{} }
+func (m *MsgConnectionUpgradeTryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTryResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{13}
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
-	ConnectionOpenInit(context.Context, *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error)
-	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
-	ConnectionOpenTry(context.Context, *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error)
-	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
-	ConnectionOpenAck(context.Context, *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error)
-	// ConnectionOpenConfirm defines a rpc handler method for
-	// MsgConnectionOpenConfirm.
-	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
-	// UpdateConnectionParams defines a rpc handler method for
-	// MsgUpdateParams.
-	UpdateConnectionParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgConnectionUpgradeTryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTryResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTryResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgConnectionUpgradeTryResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeAck defines the request type for the ConnectionUpgradeAck rpc
+type MsgConnectionUpgradeAck struct {
+	ConnectionId        string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CounterpartyUpgrade Upgrade       `protobuf:"bytes,2,opt,name=counterparty_upgrade,json=counterpartyUpgrade,proto3" json:"counterparty_upgrade"`
+	ProofConnection     []byte        `protobuf:"bytes,3,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofUpgrade        []byte        `protobuf:"bytes,4,opt,name=proof_upgrade,json=proofUpgrade,proto3" json:"proof_upgrade,omitempty"`
+	ProofHeight         types1.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer              string        `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+	// client state of the client the counterparty connection end is upgraded to, required if the
+	// counterparty upgrade changes its client
+	CounterpartyClientState *types.Any `protobuf:"bytes,7,opt,name=counterparty_client_state,json=counterpartyClientState,proto3" json:"counterparty_client_state,omitempty"`
+	// proof of the counterparty client state included in message
+	ProofClient []byte `protobuf:"bytes,8,opt,name=proof_client,json=proofClient,proto3" json:"proof_client,omitempty"`
 }
 
-func (*UnimplementedMsgServer) ConnectionOpenInit(ctx context.Context, req *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenInit not implemented")
+func (m *MsgConnectionUpgradeAck) Reset()         { *m = MsgConnectionUpgradeAck{} }
+func (m *MsgConnectionUpgradeAck) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeAck) ProtoMessage()    {}
+func (*MsgConnectionUpgradeAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{14}
 }
-func (*UnimplementedMsgServer) ConnectionOpenTry(ctx context.Context, req *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenTry not implemented")
+func (m *MsgConnectionUpgradeAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenAck not implemented")
+func (m *MsgConnectionUpgradeAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
+func (m *MsgConnectionUpgradeAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeAck.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateConnectionParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionParams not implemented")
+func (m *MsgConnectionUpgradeAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeAck.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgConnectionUpgradeAck proto.InternalMessageInfo
+
+// MsgConnectionUpgradeAckResponse defines MsgConnectionUpgradeAck response type
+type MsgConnectionUpgradeAckResponse struct {
+	Result ResponseResultType `protobuf:"varint,1,opt,name=result,proto3,enum=ibc.core.connection.v1.ResponseResultType" json:"result,omitempty"`
 }
 
-func _Msg_ConnectionOpenInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenInit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenInit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenInit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenInit(ctx, req.(*MsgConnectionOpenInit))
+func (m *MsgConnectionUpgradeAckResponse) Reset()         { *m = MsgConnectionUpgradeAckResponse{} }
+func (m *MsgConnectionUpgradeAckResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeAckResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{15}
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeAckResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeAckResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenTry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenTry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenTry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenTry(ctx, req.(*MsgConnectionOpenTry))
+var xxx_messageInfo_MsgConnectionUpgradeAckResponse proto.InternalMessageInfo
+
+func (m *MsgConnectionUpgradeAckResponse) GetResult() ResponseResultType {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return UNSPECIFIED
 }
 
-func _Msg_ConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenAck)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenAck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenAck",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenAck(ctx, req.(*MsgConnectionOpenAck))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgConnectionUpgradeConfirm defines the request type for the ConnectionUpgradeConfirm rpc
+type MsgConnectionUpgradeConfirm struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// proof of the upgraded connection end on the counterparty chain
+	ProofConnection []byte        `protobuf:"bytes,2,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight     types1.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string        `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenConfirm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenConfirm(ctx, req.(*MsgConnectionOpenConfirm))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConnectionUpgradeConfirm) Reset()         { *m = MsgConnectionUpgradeConfirm{} }
+func (m *MsgConnectionUpgradeConfirm) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeConfirm) ProtoMessage()    {}
+func (*MsgConnectionUpgradeConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{16}
 }
-
-func _Msg_UpdateConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateConnectionParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/UpdateConnectionParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateConnectionParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgConnectionUpgradeConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeConfirm.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeConfirm.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.core.connection.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConnectionOpenInit",
-			Handler:    _Msg_ConnectionOpenInit_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenTry",
-			Handler:    _Msg_ConnectionOpenTry_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenAck",
-			Handler:    _Msg_ConnectionOpenAck_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenConfirm",
-			Handler:    _Msg_ConnectionOpenConfirm_Handler,
-		},
-		{
-			MethodName: "UpdateConnectionParams",
-			Handler:    _Msg_UpdateConnectionParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/core/connection/v1/tx.proto",
+var xxx_messageInfo_MsgConnectionUpgradeConfirm proto.InternalMessageInfo
+
+// MsgConnectionUpgradeConfirmResponse defines MsgConnectionUpgradeConfirm response type
+type MsgConnectionUpgradeConfirmResponse struct {
 }
 
-func (m *MsgConnectionOpenInit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeConfirmResponse) Reset()         { *m = MsgConnectionUpgradeConfirmResponse{} }
+func (m *MsgConnectionUpgradeConfirmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeConfirmResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeConfirmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{17}
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenInit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgConnectionUpgradeConfirmResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeTimeout defines the request type for the ConnectionUpgradeTimeout rpc
+type MsgConnectionUpgradeTimeout struct {
+	ConnectionId           string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CounterpartyConnection ConnectionEnd `protobuf:"bytes,2,opt,name=counterparty_connection,json=counterpartyConnection,proto3" json:"counterparty_connection"`
+	ProofConnection        []byte        `protobuf:"bytes,3,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight            types1.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer                 string        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgConnectionOpenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DelayPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Version != nil {
-		{
-			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgConnectionUpgradeTimeout) Reset()         { *m = MsgConnectionUpgradeTimeout{} }
+func (m *MsgConnectionUpgradeTimeout) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTimeout) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{18}
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTimeout.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTimeout.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenInitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgConnectionUpgradeTimeout proto.InternalMessageInfo
+
+// MsgConnectionUpgradeTimeoutResponse defines the MsgConnectionUpgradeTimeout response type
+type MsgConnectionUpgradeTimeoutResponse struct {
 }
 
-func (m *MsgConnectionOpenInitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConnectionUpgradeTimeoutResponse) Reset()         { *m = MsgConnectionUpgradeTimeoutResponse{} }
+func (m *MsgConnectionUpgradeTimeoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTimeoutResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTimeoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{19}
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeCancel defines the request type for the ConnectionUpgradeCancel rpc
+type MsgConnectionUpgradeCancel struct {
+	ConnectionId      string        `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ErrorReceipt      ErrorReceipt  `protobuf:"bytes,2,opt,name=error_receipt,json=errorReceipt,proto3" json:"error_receipt"`
+	ProofErrorReceipt []byte        `protobuf:"bytes,3,opt,name=proof_error_receipt,json=proofErrorReceipt,proto3" json:"proof_error_receipt,omitempty"`
+	ProofHeight       types1.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer            string        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgConnectionOpenTry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeCancel) Reset()         { *m = MsgConnectionUpgradeCancel{} }
+func (m *MsgConnectionUpgradeCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeCancel) ProtoMessage()    {}
+func (*MsgConnectionUpgradeCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{20}
+}
+func (m *MsgConnectionUpgradeCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgConnectionUpgradeCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeCancel.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeCancel.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgConnectionUpgradeCancel proto.InternalMessageInfo
+
+// MsgConnectionUpgradeCancelResponse defines the MsgConnectionUpgradeCancel response type
+type MsgConnectionUpgradeCancelResponse struct {
 }
 
-func (m *MsgConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostConsensusStateProof) > 0 {
-		i -= len(m.HostConsensusStateProof)
-		copy(dAtA[i:], m.HostConsensusStateProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x62
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgConnectionUpgradeCancelResponse) Reset()         { *m = MsgConnectionUpgradeCancelResponse{} }
+func (m *MsgConnectionUpgradeCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeCancelResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{21}
+}
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProofInit) > 0 {
-		i -= len(m.ProofInit)
-		copy(dAtA[i:], m.ProofInit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
-		i--
-		dAtA[i] = 0x42
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeCancelResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeCancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConnectionUpgradeCancelResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("ibc.core.connection.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
+	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
+	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
+	proto.RegisterType((*MsgConnectionOpenTry)(nil), "ibc.core.connection.v1.MsgConnectionOpenTry")
+	proto.RegisterType((*MsgConnectionOpenTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenTryResponse")
+	proto.RegisterType((*MsgConnectionOpenAck)(nil), "ibc.core.connection.v1.MsgConnectionOpenAck")
+	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
+	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
+	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.connection.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.connection.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgConnectionUpgradeInit)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeInit")
+	proto.RegisterType((*MsgConnectionUpgradeInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeInitResponse")
+	proto.RegisterType((*MsgConnectionUpgradeTry)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTry")
+	proto.RegisterType((*MsgConnectionUpgradeTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTryResponse")
+	proto.RegisterType((*MsgConnectionUpgradeAck)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeAck")
+	proto.RegisterType((*MsgConnectionUpgradeAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeAckResponse")
+	proto.RegisterType((*MsgConnectionUpgradeConfirm)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeConfirm")
+	proto.RegisterType((*MsgConnectionUpgradeConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeConfirmResponse")
+	proto.RegisterType((*MsgConnectionUpgradeTimeout)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTimeout")
+	proto.RegisterType((*MsgConnectionUpgradeTimeoutResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTimeoutResponse")
+	proto.RegisterType((*MsgConnectionUpgradeCancel)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeCancel")
+	proto.RegisterType((*MsgConnectionUpgradeCancelResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeCancelResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
+
+var fileDescriptor_5d00fde5fc97399e = []byte{
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xc6,
+	0x16, 0x15, 0xf5, 0x69, 0x5f, 0x29, 0x91, 0xc3, 0x28, 0x16, 0x4d, 0x27, 0xb2, 0xa2, 0x24, 0x88,
+	0x9f, 0xf1, 0x2c, 0x25, 0xce, 0x0b, 0xe2, 0x97, 0x04, 0x78, 0xb0, 0xf5, 0x14, 0xd4, 0x68, 0x3e,
+	0x0c, 0x4a, 0x0e, 0xda, 0x6e, 0x04, 0x99, 0x1a, 0xd3, 0x84, 0x25, 0x92, 0x25, 0x29, 0x37, 0xea,
+	0x2a, 0x68, 0x37, 0x69, 0xda, 0x45, 0x17, 0xdd, 0x06, 0x28, 0xda, 0x5d, 0x81, 0xa2, 0xf9, 0x0b,
+	0xdd, 0x05, 0x2d, 0x50, 0x04, 0xdd, 0xb4, 0x40, 0x81, 0xa2, 0x48, 0x16, 0xf9, 0x1b, 0x05, 0x67,
+	0x86, 0x14, 0x25, 0x91, 0x0a, 0x29, 0x07, 0xe9, 0x4e, 0x1c, 0x9d, 0x7b, 0xe7, 0xcc, 0xb9, 0x67,
+	0xe6, 0x0e, 0x25, 0x58, 0x92, 0x77, 0xc5, 0x8a, 0xa8, 0xea, 0xa8, 0x22, 0xaa, 0x8a, 0x82, 0x44,
+	0x53, 0x56, 0x95, 0xca, 0xe1, 0xe5, 0x8a, 0xf9, 0xa0, 0xac, 0xe9, 0xaa, 0xa9, 0xb2, 0xf3, 0xf2,
+	0xae, 0x58, 0xb6, 0x00, 0xe5, 0x01, 0xa0, 0x7c, 0x78, 0x99, 0xcf, 0x49, 0xaa, 0xa4, 0x62, 0x48,
+	0xc5, 0xfa, 0x44, 0xd0, 0x7c, 0x5e, 0x54, 0x8d, 0xae, 0x6a, 0x54, 0xba, 0x86, 0x64, 0x65, 0xe9,
+	0x1a, 0x12, 0xfd, 0x62, 0x41, 0x52, 0x55, 0xa9, 0x83, 0x2a, 0xf8, 0x69, 0xb7, 0xb7, 0x57, 0x69,
+	0x29, 0x7d, 0xfa, 0x95, 0x8b, 0x42, 0x47, 0x46, 0x8a, 0x69, 0x05, 0x92, 0x4f, 0x14, 0x70, 0xd1,
+	0x87, 0xe3, 0xe0, 0x89, 0x02, 0xcf, 0xfb, 0x00, 0x7b, 0x9a, 0xa4, 0xb7, 0xda, 0x88, 0xa0, 0x4a,
+	0x9f, 0x47, 0xe1, 0xd4, 0x1d, 0x43, 0xaa, 0x3a, 0x90, 0x7b, 0x1a, 0x52, 0xb6, 0x14, 0xd9, 0x64,
+	0x17, 0x61, 0x96, 0x4c, 0xdc, 0x94, 0xdb, 0x1c, 0x53, 0x64, 0x96, 0x67, 0x85, 0x19, 0x32, 0xb0,
+	0xd5, 0x66, 0xef, 0x42, 0x46, 0x54, 0x7b, 0x8a, 0x89, 0x74, 0xad, 0xa5, 0x9b, 0x7d, 0x2e, 0x5a,
+	0x64, 0x96, 0xd3, 0x6b, 0xe7, 0xcb, 0xde, 0xfa, 0x94, 0xab, 0x2e, 0xec, 0x66, 0xfc, 0xd9, 0x9f,
+	0x4b, 0x11, 0x61, 0x28, 0x9e, 0xfd, 0x2f, 0xa4, 0x0e, 0x91, 0x6e, 0xc8, 0xaa, 0xc2, 0xc5, 0x70,
+	0xaa, 0x25, 0xbf, 0x54, 0xf7, 0x09, 0x4c, 0xb0, 0xf1, 0xec, 0x59, 0xc8, 0xb4, 0x51, 0xa7, 0xd5,
+	0x6f, 0x6a, 0x48, 0x97, 0xd5, 0x36, 0x17, 0x2f, 0x32, 0xcb, 0x71, 0x21, 0x8d, 0xc7, 0xb6, 0xf1,
+	0x10, 0x3b, 0x0f, 0x49, 0x43, 0x96, 0x14, 0xa4, 0x73, 0x09, 0xbc, 0x0e, 0xfa, 0x74, 0x3d, 0xfb,
+	0xe8, 0xeb, 0xa5, 0xc8, 0x27, 0xaf, 0x9e, 0xae, 0xd0, 0x81, 0xd2, 0x12, 0x9c, 0xf1, 0x14, 0x43,
+	0x40, 0x86, 0xa6, 0x2a, 0x06, 0x2a, 0xfd, 0x9a, 0x80, 0xdc, 0x18, 0xa2, 0xa1, 0xf7, 0x27, 0xab,
+	0xb5, 0x0e, 0xf3, 0x9a, 0x8e, 0x0e, 0x65, 0xb5, 0x67, 0x34, 0x07, 0xab, 0xb1, 0x90, 0x96, 0x6e,
+	0xb3, 0x9b, 0x51, 0x8e, 0x11, 0x72, 0x36, 0x62, 0x90, 0x7b, 0xab, 0xcd, 0x5e, 0x83, 0x0c, 0x4d,
+	0x6b, 0x98, 0x2d, 0x13, 0x51, 0x71, 0x72, 0x65, 0x62, 0xa0, 0xb2, 0x6d, 0xa0, 0xf2, 0x86, 0xd2,
+	0x17, 0xd2, 0x04, 0x59, 0xb7, 0x80, 0x63, 0x05, 0x8a, 0x1f, 0xb1, 0x40, 0xa3, 0x2a, 0x27, 0xc6,
+	0x55, 0x6e, 0xc0, 0x29, 0x77, 0x48, 0x93, 0x16, 0xc8, 0xe0, 0x92, 0xc5, 0x58, 0x90, 0x8a, 0xe6,
+	0xdc, 0xd1, 0x74, 0xd0, 0x60, 0xab, 0x90, 0xd1, 0x74, 0x55, 0xdd, 0x6b, 0xee, 0x23, 0x59, 0xda,
+	0x37, 0xb9, 0x14, 0x5e, 0x08, 0xef, 0x4a, 0x46, 0x76, 0xc7, 0xe1, 0xe5, 0xf2, 0x3b, 0x18, 0x41,
+	0xe9, 0xa7, 0x71, 0x14, 0x19, 0x62, 0xcf, 0x00, 0x90, 0x24, 0xb2, 0x22, 0x9b, 0xdc, 0x4c, 0x91,
+	0x59, 0xce, 0x08, 0xb3, 0x78, 0x04, 0x5b, 0xfd, 0xac, 0x3d, 0x07, 0xc9, 0xc5, 0xcd, 0x62, 0x00,
+	0xc9, 0x50, 0xc5, 0x43, 0xec, 0x45, 0xc8, 0x52, 0x88, 0xe5, 0x03, 0xc5, 0xe8, 0x19, 0x1c, 0x60,
+	0xd4, 0x71, 0x82, 0xb2, 0x47, 0xd9, 0x77, 0x61, 0xce, 0x81, 0xd8, 0x9c, 0xd3, 0x01, 0x39, 0x67,
+	0x9d, 0x48, 0xca, 0x7b, 0x60, 0xdc, 0x8c, 0xdb, 0xb8, 0xec, 0x0d, 0xe0, 0xf7, 0x55, 0xc3, 0x1c,
+	0x90, 0x21, 0xf6, 0x68, 0x62, 0x2e, 0xdc, 0x31, 0x4c, 0x2c, 0x6f, 0x21, 0x1c, 0x5e, 0xd8, 0x15,
+	0xdb, 0xd6, 0xd7, 0xe3, 0xae, 0x2f, 0xc0, 0x69, 0x2f, 0x4f, 0x3b, 0xa6, 0xff, 0x25, 0xee, 0x61,
+	0xfa, 0x0d, 0xf1, 0x80, 0x3d, 0x07, 0xc7, 0x86, 0xed, 0x4c, 0x8c, 0x9f, 0x11, 0xdd, 0x16, 0xbe,
+	0x09, 0xfc, 0x90, 0x2d, 0x3c, 0x36, 0x80, 0xc0, 0xb9, 0x11, 0x43, 0x1b, 0xe0, 0x08, 0x07, 0xc3,
+	0xe8, 0xde, 0x89, 0x07, 0xdd, 0x3b, 0xa3, 0x96, 0x4b, 0x4c, 0x63, 0xb9, 0x45, 0x20, 0x06, 0x6b,
+	0x9a, 0x7a, 0x9f, 0x4b, 0xe2, 0x8a, 0xcc, 0xe0, 0x01, 0xeb, 0xb4, 0x18, 0x35, 0x5c, 0x2a, 0x90,
+	0xe1, 0x66, 0x02, 0x1b, 0x6e, 0xf6, 0xe8, 0x86, 0x83, 0x10, 0x86, 0x4b, 0xbf, 0x01, 0xc3, 0x6d,
+	0x88, 0x07, 0x8e, 0xe1, 0x7e, 0x62, 0x80, 0x1b, 0x03, 0x54, 0x55, 0x65, 0x4f, 0xd6, 0xbb, 0xc1,
+	0x4c, 0xe7, 0xa8, 0xdf, 0x12, 0x0f, 0xb8, 0xa8, 0x4b, 0x7d, 0xcb, 0xb6, 0xa3, 0xf5, 0x8d, 0x4d,
+	0x53, 0xdf, 0x81, 0x52, 0xf1, 0xc9, 0x3d, 0xa5, 0x04, 0x45, 0xbf, 0xb5, 0x38, 0x0b, 0x7e, 0x00,
+	0xd9, 0x3b, 0x86, 0xb4, 0xa3, 0xb5, 0x2d, 0xcd, 0x5a, 0x7a, 0xab, 0x6b, 0xb8, 0xf2, 0x33, 0x43,
+	0x95, 0xb8, 0x09, 0x49, 0x0d, 0x23, 0x68, 0xcf, 0x2d, 0xf8, 0xed, 0x07, 0x92, 0x87, 0x52, 0xa7,
+	0x31, 0xe3, 0xec, 0x16, 0x20, 0x3f, 0x32, 0xb3, 0x43, 0xea, 0xfb, 0xd1, 0x2a, 0xec, 0x90, 0x9b,
+	0x03, 0x3e, 0x32, 0x03, 0x55, 0xa1, 0x0a, 0xc9, 0x3d, 0x19, 0x75, 0xda, 0x36, 0xd7, 0x0b, 0x7e,
+	0x5c, 0x69, 0xe6, 0x5b, 0x18, 0x6c, 0x53, 0x26, 0xa1, 0x2e, 0x21, 0x62, 0x93, 0x85, 0xfe, 0x8a,
+	0x19, 0x51, 0xda, 0xc5, 0xd7, 0x5e, 0x14, 0xfb, 0x3f, 0x48, 0xd1, 0x0b, 0x10, 0xc7, 0x4c, 0x3e,
+	0x4f, 0x68, 0x34, 0x65, 0x63, 0x47, 0xb1, 0xff, 0x82, 0x39, 0xfa, 0xb1, 0x69, 0xa0, 0x0f, 0x7b,
+	0x48, 0x11, 0x11, 0x5e, 0x5d, 0x5c, 0xc8, 0xd2, 0xf1, 0x3a, 0x1d, 0xbe, 0x1e, 0xb7, 0x18, 0x96,
+	0xbe, 0x89, 0x43, 0xde, 0x8b, 0x96, 0x75, 0x0e, 0x04, 0x52, 0xf1, 0x00, 0x16, 0x87, 0x0e, 0x50,
+	0x7b, 0xfa, 0xe9, 0xa5, 0x5d, 0x70, 0xe7, 0x1b, 0x02, 0xb0, 0x9b, 0x70, 0xc6, 0x73, 0x32, 0x67,
+	0xad, 0x31, 0xbc, 0xd6, 0x45, 0x8f, 0x0c, 0xf6, 0xba, 0x2d, 0x89, 0x9c, 0xa3, 0x8b, 0x32, 0xc1,
+	0x9b, 0x24, 0x23, 0x64, 0xed, 0xb3, 0x8b, 0x0e, 0x5b, 0x02, 0x10, 0xa8, 0x5d, 0x94, 0x04, 0xc6,
+	0x91, 0xfd, 0x49, 0xf3, 0x8e, 0xed, 0xd7, 0xe4, 0xd1, 0xf6, 0x6b, 0x6a, 0x68, 0x3f, 0x6d, 0xc3,
+	0xc2, 0x70, 0x7b, 0x72, 0xb7, 0x8c, 0x99, 0x09, 0x2d, 0x23, 0x3f, 0xd4, 0xb3, 0x5c, 0xed, 0xe3,
+	0xf5, 0xb7, 0x89, 0x71, 0xef, 0xfe, 0xc6, 0xc0, 0x92, 0x8f, 0x49, 0xfe, 0x09, 0xeb, 0xb2, 0x9b,
+	0x90, 0xd4, 0x91, 0xd1, 0xeb, 0x90, 0xc3, 0xf1, 0xf8, 0xda, 0x8a, 0xdf, 0x54, 0x36, 0x3b, 0x01,
+	0xa3, 0x1b, 0x7d, 0x0d, 0x09, 0x34, 0x92, 0xda, 0xff, 0xe7, 0x98, 0xb7, 0xfd, 0x03, 0xdf, 0x1f,
+	0xde, 0x83, 0x9c, 0x97, 0x23, 0xb9, 0x68, 0x18, 0x0d, 0x4e, 0x7a, 0xf8, 0xd5, 0xd3, 0xa7, 0xb1,
+	0x80, 0x3e, 0x8d, 0x07, 0xf0, 0x69, 0xe2, 0x68, 0x3e, 0x4d, 0x06, 0xf7, 0x69, 0xea, 0x4d, 0xf8,
+	0x74, 0x26, 0x80, 0x4f, 0x91, 0xb7, 0x4d, 0x5d, 0xcd, 0xdb, 0x65, 0x1d, 0x66, 0x5a, 0xeb, 0x94,
+	0xfe, 0x60, 0x60, 0xd1, 0x6b, 0x9e, 0x50, 0x77, 0x00, 0xaf, 0xf2, 0x46, 0xbd, 0xcb, 0xfb, 0x76,
+	0x6f, 0x04, 0x17, 0xe0, 0xdc, 0x84, 0xc5, 0x39, 0xfd, 0xf7, 0xc7, 0xa8, 0xb7, 0x08, 0x0d, 0xb9,
+	0x8b, 0xd4, 0x5e, 0xc0, 0x16, 0xdc, 0x86, 0xbc, 0xcf, 0xed, 0xfb, 0x75, 0x8d, 0x63, 0x30, 0x6f,
+	0x4d, 0x69, 0xd3, 0xf5, 0xce, 0x7b, 0xdf, 0xd3, 0xc3, 0xec, 0xa4, 0x51, 0xa9, 0xe3, 0x47, 0x93,
+	0x3a, 0x31, 0x95, 0xd4, 0x54, 0x42, 0x47, 0xea, 0xa7, 0x51, 0xe0, 0x3d, 0x4b, 0xd2, 0x52, 0x44,
+	0xd4, 0x09, 0xa6, 0xf4, 0x3d, 0x38, 0x86, 0x74, 0x5d, 0xd5, 0x9b, 0x3a, 0x12, 0x91, 0xac, 0x99,
+	0xaf, 0xfb, 0x4d, 0xa4, 0x66, 0x81, 0x05, 0x82, 0xb5, 0x5f, 0xb9, 0x91, 0x6b, 0x8c, 0x2d, 0xc3,
+	0x49, 0xa2, 0xd4, 0x70, 0x5a, 0xa2, 0xeb, 0x09, 0xfc, 0x95, 0x3b, 0xc7, 0x5b, 0x56, 0xf6, 0x3c,
+	0x94, 0xfc, 0x15, 0xb3, 0x85, 0x5d, 0xf9, 0x81, 0x01, 0x76, 0x7c, 0x9f, 0xb3, 0x57, 0xa1, 0x28,
+	0xd4, 0xea, 0xdb, 0xf7, 0xee, 0xd6, 0x6b, 0x4d, 0xa1, 0x56, 0xdf, 0xb9, 0xdd, 0x68, 0x36, 0xde,
+	0xdf, 0xae, 0x35, 0x77, 0xee, 0xd6, 0xb7, 0x6b, 0xd5, 0xad, 0x5b, 0x5b, 0xb5, 0xff, 0xcf, 0x45,
+	0xf8, 0xec, 0xe3, 0x27, 0xc5, 0xb4, 0x6b, 0x88, 0x5d, 0x85, 0xd3, 0x9e, 0x61, 0xf5, 0x9d, 0x6a,
+	0xb5, 0x56, 0xaf, 0xcf, 0x31, 0x7c, 0xfa, 0xf1, 0x93, 0x62, 0x8a, 0x3e, 0xfa, 0xc2, 0x6f, 0x6d,
+	0x6c, 0xdd, 0xde, 0x11, 0x6a, 0x73, 0x51, 0x02, 0xa7, 0x8f, 0x7c, 0xfc, 0xd1, 0xb7, 0x85, 0xc8,
+	0xda, 0x77, 0x69, 0x88, 0xdd, 0x31, 0x24, 0xf6, 0x63, 0x60, 0x3d, 0x7e, 0x14, 0x5b, 0xf5, 0xab,
+	0xa6, 0xe7, 0xcf, 0x46, 0xfc, 0xd5, 0x50, 0x70, 0xe7, 0x08, 0xfd, 0x08, 0x4e, 0x8c, 0xff, 0xc2,
+	0xf4, 0xef, 0xc0, 0xb9, 0x1a, 0x7a, 0x9f, 0xff, 0x4f, 0x18, 0xb4, 0xff, 0xc4, 0x56, 0x97, 0x0e,
+	0x3e, 0xf1, 0x86, 0x78, 0x10, 0x62, 0x62, 0x77, 0xd3, 0xf8, 0x94, 0x81, 0x53, 0xde, 0xaf, 0x7b,
+	0x97, 0x02, 0xe7, 0xa3, 0x11, 0xfc, 0x7a, 0xd8, 0x08, 0x87, 0x85, 0x0e, 0xf3, 0xe4, 0x4d, 0x68,
+	0x00, 0xa3, 0x6f, 0x63, 0x17, 0x27, 0xe4, 0x74, 0xbf, 0x3c, 0xf1, 0x95, 0x80, 0x40, 0x9f, 0x95,
+	0xbb, 0x5f, 0xb1, 0x82, 0xad, 0xdc, 0x15, 0xc1, 0xaf, 0x87, 0x8d, 0x70, 0x58, 0x3c, 0x64, 0x20,
+	0xe7, 0xf9, 0x86, 0x52, 0x09, 0x93, 0xd2, 0x32, 0xde, 0xb5, 0x90, 0x01, 0x93, 0x29, 0x58, 0xfe,
+	0x0b, 0x45, 0xc1, 0xb2, 0xe0, 0xb5, 0x90, 0x01, 0x0e, 0x85, 0x2f, 0x18, 0xe0, 0x7c, 0xef, 0x1c,
+	0x57, 0xc2, 0x64, 0xb5, 0xbd, 0x78, 0x63, 0x8a, 0xa0, 0xc9, 0x74, 0xec, 0xee, 0x1f, 0x8a, 0x0e,
+	0x0d, 0xe2, 0x6f, 0x4c, 0x11, 0xe4, 0xd0, 0xf9, 0x8c, 0x81, 0xbc, 0x5f, 0x87, 0x5c, 0x0b, 0xb5,
+	0x4e, 0x1c, 0xc3, 0x5f, 0x0f, 0x1f, 0x63, 0x73, 0xe1, 0x13, 0x0f, 0x5f, 0x3d, 0x5d, 0x61, 0x36,
+	0xef, 0x3f, 0x7b, 0x51, 0x60, 0x9e, 0xbf, 0x28, 0x30, 0x7f, 0xbd, 0x28, 0x30, 0x5f, 0xbe, 0x2c,
+	0x44, 0x9e, 0xbf, 0x2c, 0x44, 0x7e, 0x7f, 0x59, 0x88, 0x7c, 0x70, 0x53, 0x92, 0xcd, 0xfd, 0xde,
+	0x6e, 0x59, 0x54, 0xbb, 0x15, 0xfa, 0x37, 0x8c, 0xbc, 0x2b, 0xae, 0x4a, 0x6a, 0xe5, 0x70, 0xbd,
+	0xd2, 0x55, 0xdb, 0xbd, 0x0e, 0x32, 0xc8, 0xbf, 0x23, 0x97, 0xae, 0xac, 0xba, 0xfe, 0x20, 0x31,
+	0xfb, 0x1a, 0x32, 0x76, 0x93, 0xf8, 0x06, 0x7d, 0xe5, 0xef, 0x01, 0x00, 0xae, 0x29, 0x0e, 0xb1,
+	0x11, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
+	ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error)
+	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
+	ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error)
+	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
+	ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error)
+	// ConnectionOpenConfirm defines a rpc handler method for
+	// MsgConnectionOpenConfirm.
+	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateParams.
+	UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+	ConnectionUpgradeInit(ctx context.Context, in *MsgConnectionUpgradeInit, opts ...grpc.CallOption) (*MsgConnectionUpgradeInitResponse, error)
+	// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+	ConnectionUpgradeTry(ctx context.Context, in *MsgConnectionUpgradeTry, opts ...grpc.CallOption) (*MsgConnectionUpgradeTryResponse, error)
+	// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+	ConnectionUpgradeAck(ctx context.Context, in *MsgConnectionUpgradeAck, opts ...grpc.CallOption) (*MsgConnectionUpgradeAckResponse, error)
+	// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+	ConnectionUpgradeConfirm(ctx context.Context, in *MsgConnectionUpgradeConfirm, opts ...grpc.CallOption) (*MsgConnectionUpgradeConfirmResponse, error)
+	// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+	ConnectionUpgradeTimeout(ctx context.Context, in *MsgConnectionUpgradeTimeout, opts ...grpc.CallOption) (*MsgConnectionUpgradeTimeoutResponse, error)
+	// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+	ConnectionUpgradeCancel(ctx context.Context, in *MsgConnectionUpgradeCancel, opts ...grpc.CallOption) (*MsgConnectionUpgradeCancelResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error) {
+	out := new(MsgConnectionOpenInitResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenInit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.CounterpartyVersions) > 0 {
-		for iNdEx := len(m.CounterpartyVersions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CounterpartyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return out, nil
+}
+
+func (c *msgClient) ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error) {
+	out := new(MsgConnectionOpenTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenTry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DelayPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
-		i--
-		dAtA[i] = 0x28
+	return out, nil
+}
+
+func (c *msgClient) ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error) {
+	out := new(MsgConnectionOpenAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenAck", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error) {
+	out := new(MsgConnectionOpenConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/UpdateConnectionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PreviousConnectionId) > 0 {
-		i -= len(m.PreviousConnectionId)
-		copy(dAtA[i:], m.PreviousConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousConnectionId)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) ConnectionUpgradeInit(ctx context.Context, in *MsgConnectionUpgradeInit, opts ...grpc.CallOption) (*MsgConnectionUpgradeInitResponse, error) {
+	out := new(MsgConnectionUpgradeInitResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeInit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ConnectionUpgradeTry(ctx context.Context, in *MsgConnectionUpgradeTry, opts ...grpc.CallOption) (*MsgConnectionUpgradeTryResponse, error) {
+	out := new(MsgConnectionUpgradeTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeTry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeAck(ctx context.Context, in *MsgConnectionUpgradeAck, opts ...grpc.CallOption) (*MsgConnectionUpgradeAckResponse, error) {
+	out := new(MsgConnectionUpgradeAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeAck", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeConfirm(ctx context.Context, in *MsgConnectionUpgradeConfirm, opts ...grpc.CallOption) (*MsgConnectionUpgradeConfirmResponse, error) {
+	out := new(MsgConnectionUpgradeConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConnectionUpgradeTimeout(ctx context.Context, in *MsgConnectionUpgradeTimeout, opts ...grpc.CallOption) (*MsgConnectionUpgradeTimeoutResponse, error) {
+	out := new(MsgConnectionUpgradeTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeCancel(ctx context.Context, in *MsgConnectionUpgradeCancel, opts ...grpc.CallOption) (*MsgConnectionUpgradeCancelResponse, error) {
+	out := new(MsgConnectionUpgradeCancelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeCancel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
+	ConnectionOpenInit(context.Context, *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error)
+	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
+	ConnectionOpenTry(context.Context, *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error)
+	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
+	ConnectionOpenAck(context.Context, *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error)
+	// ConnectionOpenConfirm defines a rpc handler method for
+	// MsgConnectionOpenConfirm.
+	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateParams.
+	UpdateConnectionParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+	ConnectionUpgradeInit(context.Context, *MsgConnectionUpgradeInit) (*MsgConnectionUpgradeInitResponse, error)
+	// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+	ConnectionUpgradeTry(context.Context, *MsgConnectionUpgradeTry) (*MsgConnectionUpgradeTryResponse, error)
+	// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+	ConnectionUpgradeAck(context.Context, *MsgConnectionUpgradeAck) (*MsgConnectionUpgradeAckResponse, error)
+	// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+	ConnectionUpgradeConfirm(context.Context, *MsgConnectionUpgradeConfirm) (*MsgConnectionUpgradeConfirmResponse, error)
+	// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+	ConnectionUpgradeTimeout(context.Context, *MsgConnectionUpgradeTimeout) (*MsgConnectionUpgradeTimeoutResponse, error)
+	// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+	ConnectionUpgradeCancel(context.Context, *MsgConnectionUpgradeCancel) (*MsgConnectionUpgradeCancelResponse, error)
 }
 
-func (m *MsgConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostConsensusStateProof) > 0 {
-		i -= len(m.HostConsensusStateProof)
-		copy(dAtA[i:], m.HostConsensusStateProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x52
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x42
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConnectionOpenInit(ctx context.Context, req *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenInit not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionOpenTry(ctx context.Context, req *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenTry not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenAck not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
+}
+func (*UnimplementedMsgServer) UpdateConnectionParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionParams not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeInit(ctx context.Context, req *MsgConnectionUpgradeInit) (*MsgConnectionUpgradeInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeInit not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeTry(ctx context.Context, req *MsgConnectionUpgradeTry) (*MsgConnectionUpgradeTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeTry not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeAck(ctx context.Context, req *MsgConnectionUpgradeAck) (*MsgConnectionUpgradeAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeAck not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeConfirm(ctx context.Context, req *MsgConnectionUpgradeConfirm) (*MsgConnectionUpgradeConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeTimeout(ctx context.Context, req *MsgConnectionUpgradeTimeout) (*MsgConnectionUpgradeTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeTimeout not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeCancel(ctx context.Context, req *MsgConnectionUpgradeCancel) (*MsgConnectionUpgradeCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeCancel not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConnectionOpenInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenInit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenInit(ctx, in)
 	}
-	if len(m.ProofTry) > 0 {
-		i -= len(m.ProofTry)
-		copy(dAtA[i:], m.ProofTry)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenInit",
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenInit(ctx, req.(*MsgConnectionOpenInit))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenTry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Version != nil {
-		{
-			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenTry(ctx, in)
 	}
-	if len(m.CounterpartyConnectionId) > 0 {
-		i -= len(m.CounterpartyConnectionId)
-		copy(dAtA[i:], m.CounterpartyConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenTry",
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenTry(ctx, req.(*MsgConnectionOpenTry))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenAck)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenAck(ctx, req.(*MsgConnectionOpenAck))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenConfirm(ctx, req.(*MsgConnectionOpenConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/UpdateConnectionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ConnectionUpgradeInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeInit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeInit(ctx, req.(*MsgConnectionUpgradeInit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_ConnectionUpgradeTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeTry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeTry(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ProofAck) > 0 {
-		i -= len(m.ProofAck)
-		copy(dAtA[i:], m.ProofAck)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeTry",
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeTry(ctx, req.(*MsgConnectionUpgradeTry))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ConnectionUpgradeAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeAck)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeAck(ctx, req.(*MsgConnectionUpgradeAck))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ConnectionUpgradeConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeConfirm(ctx, req.(*MsgConnectionUpgradeConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ConnectionUpgradeTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeTimeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeTimeout(ctx, req.(*MsgConnectionUpgradeTimeout))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func _Msg_ConnectionUpgradeCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeCancel(ctx, req.(*MsgConnectionUpgradeCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.connection.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConnectionOpenInit",
+			Handler:    _Msg_ConnectionOpenInit_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenTry",
+			Handler:    _Msg_ConnectionOpenTry_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenAck",
+			Handler:    _Msg_ConnectionOpenAck_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenConfirm",
+			Handler:    _Msg_ConnectionOpenConfirm_Handler,
+		},
+		{
+			MethodName: "UpdateConnectionParams",
+			Handler:    _Msg_UpdateConnectionParams_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeInit",
+			Handler:    _Msg_ConnectionUpgradeInit_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeTry",
+			Handler:    _Msg_ConnectionUpgradeTry_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeAck",
+			Handler:    _Msg_ConnectionUpgradeAck_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeConfirm",
+			Handler:    _Msg_ConnectionUpgradeConfirm_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeTimeout",
+			Handler:    _Msg_ConnectionUpgradeTimeout_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeCancel",
+			Handler:    _Msg_ConnectionUpgradeCancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/connection/v1/tx.proto",
+}
+
+func (m *MsgConnectionOpenInit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1242,18 +1594,42 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1262,17 +1638,17 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConnectionOpenInitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1282,12 +1658,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1295,238 +1671,2746 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgConnectionOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelayPeriod != 0 {
-		n += 1 + sovTx(uint64(m.DelayPeriod))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConnectionOpenTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConnectionOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConnectionOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PreviousConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HostConsensusStateProof) > 0 {
+		i -= len(m.HostConsensusStateProof)
+		copy(dAtA[i:], m.HostConsensusStateProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DelayPeriod != 0 {
-		n += 1 + sovTx(uint64(m.DelayPeriod))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.CounterpartyVersions) > 0 {
-		for _, e := range m.CounterpartyVersions {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofInit) > 0 {
+		i -= len(m.ProofInit)
+		copy(dAtA[i:], m.ProofInit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.HostConsensusStateProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.CounterpartyVersions) > 0 {
+		for iNdEx := len(m.CounterpartyVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
+	if m.DelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousConnectionId) > 0 {
+		i -= len(m.PreviousConnectionId)
+		copy(dAtA[i:], m.PreviousConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HostConsensusStateProof) > 0 {
+		i -= len(m.HostConsensusStateProof)
+		copy(dAtA[i:], m.HostConsensusStateProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.CounterpartyConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofTry) > 0 {
+		i -= len(m.ProofTry)
+		copy(dAtA[i:], m.ProofTry)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.HostConsensusStateProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.CounterpartyConnectionId) > 0 {
+		i -= len(m.CounterpartyConnectionId)
+		copy(dAtA[i:], m.CounterpartyConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofAck) > 0 {
+		i -= len(m.ProofAck)
+		copy(dAtA[i:], m.ProofAck)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeInit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeInitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeInitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CounterpartyClientState != nil {
+		{
+			size, err := m.CounterpartyClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofUpgrade) > 0 {
+		i -= len(m.ProofUpgrade)
+		copy(dAtA[i:], m.ProofUpgrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.CounterpartyUpgradeFields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CounterpartyClientState != nil {
+		{
+			size, err := m.CounterpartyClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofUpgrade) > 0 {
+		i -= len(m.ProofUpgrade)
+		copy(dAtA[i:], m.ProofUpgrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CounterpartyUpgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CounterpartyConnection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofErrorReceipt) > 0 {
+		i -= len(m.ProofErrorReceipt)
+		copy(dAtA[i:], m.ProofErrorReceipt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofErrorReceipt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConnectionOpenInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DelayPeriod))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DelayPeriod))
+	}
+	if len(m.CounterpartyVersions) > 0 {
+		for _, e := range m.CounterpartyVersions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostConsensusStateProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofTry)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostConsensusStateProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgradeFields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyClientState != nil {
+		l = m.CounterpartyClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyClientState != nil {
+		l = m.CounterpartyClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyConnection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ErrorReceipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofErrorReceipt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyVersions = append(m.CounterpartyVersions, &Version{})
+			if err := m.CounterpartyVersions[len(m.CounterpartyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostConsensusStateProof == nil {
+				m.HostConsensusStateProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostConsensusStateProof == nil {
+				m.HostConsensusStateProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,15 +4433,15 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionOpenConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1585,13 +4469,13 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1601,28 +4485,29 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1649,18 +4534,15 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
-			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.DelayPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1670,12 +4552,125 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1707,6 +4702,39 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1728,7 +4756,7 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1751,10 +4779,10 @@ func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1778,7 +4806,7 @@ func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeInit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,15 +4829,15 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeInit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1837,13 +4865,13 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1853,29 +4881,30 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1885,31 +4914,77 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1936,15 +5011,15 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.DelayPeriod = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1954,16 +5029,66 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayPeriod |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1973,29 +5098,27 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersions = append(m.CounterpartyVersions, &Version{})
-			if err := m.CounterpartyVersions[len(m.CounterpartyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2022,15 +5145,15 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
 			}
-			var byteLen int
+			m.CounterpartyUpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2040,29 +5163,14 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,14 +5197,14 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2123,14 +5231,14 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,11 +5265,11 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2193,9 +5301,45 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CounterpartyClientState == nil {
+				m.CounterpartyClientState = &types.Any{}
+			}
+			if err := m.CounterpartyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2222,9 +5366,9 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostConsensusStateProof == nil {
-				m.HostConsensusStateProof = []byte{}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2248,7 +5392,7 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2258,25 +5402,96 @@ func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2298,7 +5513,7 @@ func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +5536,10 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2361,9 +5576,9 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2373,29 +5588,30 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2405,31 +5621,63 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
-			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2456,16 +5704,45 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2492,13 +5769,16 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CounterpartyClientState == nil {
+				m.CounterpartyClientState = &types.Any{}
+			}
+			if err := m.CounterpartyClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2522,19 +5802,138 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2544,29 +5943,27 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2593,14 +5990,14 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2627,11 +6024,11 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2663,40 +6060,6 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostConsensusStateProof == nil {
-				m.HostConsensusStateProof = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2718,7 +6081,7 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,10 +6104,10 @@ func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2768,7 +6131,7 @@ func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2791,10 +6154,10 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2831,7 +6194,40 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyConnection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2858,12 +6254,12 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -2896,7 +6292,7 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2949,7 +6345,7 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,10 +6368,10 @@ func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2999,7 +6395,7 @@ func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3022,15 +6418,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3058,11 +6454,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3089,10 +6485,109 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofErrorReceipt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofErrorReceipt = append(m.ProofErrorReceipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofErrorReceipt == nil {
+				m.ProofErrorReceipt = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3114,7 +6609,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3137,10 +6632,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
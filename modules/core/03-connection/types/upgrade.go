@@ -0,0 +1,136 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(upgradeFields UpgradeFields, timeoutTimestamp uint64) Upgrade {
+	return Upgrade{
+		Fields:           upgradeFields,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// NewUpgradeFields returns a new UpgradeFields instance.
+func NewUpgradeFields(clientID string, versions []*Version, delayPeriod uint64) UpgradeFields {
+	return UpgradeFields{
+		ClientId:    clientID,
+		Versions:    versions,
+		DelayPeriod: delayPeriod,
+	}
+}
+
+// ValidateBasic performs a basic validation of the upgrade
+func (u Upgrade) ValidateBasic() error {
+	if err := u.Fields.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "proposed upgrade fields are invalid")
+	}
+
+	if u.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgrade, "upgrade timeout timestamp cannot be 0")
+	}
+
+	return nil
+}
+
+// IsTimedOut returns true if the upgrade timeout timestamp has been set and the given timestamp
+// is greater than or equal to it.
+func (u Upgrade) IsTimedOut(timestamp uint64) bool {
+	return u.TimeoutTimestamp != 0 && timestamp >= u.TimeoutTimestamp
+}
+
+// ValidateBasic performs a basic validation of the proposed upgrade fields
+func (uf UpgradeFields) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(uf.ClientId); err != nil {
+		return errorsmod.Wrap(err, "invalid client ID")
+	}
+
+	// a single version must be negotiated on an open connection
+	if len(uf.Versions) != 1 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidVersion, "expected a single connection version, got %d", len(uf.Versions))
+	}
+
+	return ValidateVersion(uf.Versions[0])
+}
+
+// UpgradeError defines an error that occurs during a connection upgrade.
+type UpgradeError struct {
+	// err is the underlying error that caused the upgrade to fail.
+	// this error should not be written to state.
+	err error
+	// sequence is the upgrade sequence number of the upgrade that failed.
+	sequence uint64
+}
+
+var _ error = &UpgradeError{}
+
+// NewUpgradeError returns a new UpgradeError instance.
+func NewUpgradeError(upgradeSequence uint64, err error) *UpgradeError {
+	return &UpgradeError{
+		err:      err,
+		sequence: upgradeSequence,
+	}
+}
+
+// Error implements the error interface, returning the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Error() string {
+	return u.err.Error()
+}
+
+// Is returns true if the underlying error is of the given err type.
+func (u *UpgradeError) Is(err error) bool {
+	return errors.Is(u.err, err)
+}
+
+// Unwrap returns the base error that caused the upgrade to fail.
+func (u *UpgradeError) Unwrap() error {
+	baseError := u.err
+	for {
+		if err := errors.Unwrap(baseError); err != nil {
+			baseError = err
+		} else {
+			return baseError
+		}
+	}
+}
+
+// Cause implements the sdk error interface which uses this function to unwrap the error in various functions such as `wrappedError.Is()`.
+// Cause returns the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Cause() error {
+	return u.err
+}
+
+// GetErrorReceipt returns an error receipt with the code from the underlying error type stripped.
+func (u *UpgradeError) GetErrorReceipt() ErrorReceipt {
+	// restoreErrorString defines a string constant included in error receipts.
+	// NOTE: Changing this const is state machine breaking as it is written into state.
+	const restoreErrorString = "restored connection to pre-upgrade state"
+
+	_, code, _ := errorsmod.ABCIInfo(u, false) // discard non-determinstic codespace and log values
+	return ErrorReceipt{
+		Sequence: u.sequence,
+		Message:  fmt.Sprintf("ABCI code: %d: %s", code, restoreErrorString),
+	}
+}
+
+// IsUpgradeError returns true if err is of type UpgradeError or contained
+// in the error chain of err and false otherwise.
+func IsUpgradeError(err error) bool {
+	for {
+		_, ok := err.(*UpgradeError)
+		if ok {
+			return true
+		}
+
+		if err = errors.Unwrap(err); err == nil {
+			return false
+		}
+	}
+}
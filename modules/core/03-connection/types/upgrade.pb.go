@@ -0,0 +1,810 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/connection/v1/upgrade.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Upgrade is a verifiable type which contains the relevant information
+// for an attempted connection upgrade. It provides the proposed changes to the
+// connection end and the timeout for this upgrade attempt.
+type Upgrade struct {
+	Fields UpgradeFields `protobuf:"bytes,1,opt,name=fields,proto3" json:"fields"`
+	// block timestamp (in nanoseconds) after which the upgrade times out. It remains
+	// unset until the upgrade has been accepted in the TRY step.
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{0}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+// UpgradeFields are the fields in a connection end which may be changed
+// during a connection upgrade. Changing the client of a connection end changes
+// the counterparty client identifier of the opposite connection end.
+type UpgradeFields struct {
+	// client associated with the connection end once upgraded.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// IBC version which can be utilised to determine encodings or protocols for
+	// channels or packets utilising the connection once upgraded.
+	Versions []*Version `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	// delay period that must pass before a consensus state can be used for
+	// packet-verification once upgraded.
+	DelayPeriod uint64 `protobuf:"varint,3,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+}
+
+func (m *UpgradeFields) Reset()         { *m = UpgradeFields{} }
+func (m *UpgradeFields) String() string { return proto.CompactTextString(m) }
+func (*UpgradeFields) ProtoMessage()    {}
+func (*UpgradeFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{1}
+}
+func (m *UpgradeFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeFields.Merge(m, src)
+}
+func (m *UpgradeFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeFields proto.InternalMessageInfo
+
+// ErrorReceipt defines a type which encapsulates the upgrade sequence and error associated with the
+// upgrade handshake failure. When a connection upgrade handshake is aborted both chains are expected to increment to the
+// next sequence.
+type ErrorReceipt struct {
+	// the connection upgrade sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the error message detailing the cause of failure
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ErrorReceipt) Reset()         { *m = ErrorReceipt{} }
+func (m *ErrorReceipt) String() string { return proto.CompactTextString(m) }
+func (*ErrorReceipt) ProtoMessage()    {}
+func (*ErrorReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2662dd11fb8ae2, []int{2}
+}
+func (m *ErrorReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorReceipt.Merge(m, src)
+}
+func (m *ErrorReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Upgrade)(nil), "ibc.core.connection.v1.Upgrade")
+	proto.RegisterType((*UpgradeFields)(nil), "ibc.core.connection.v1.UpgradeFields")
+	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.connection.v1.ErrorReceipt")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/connection/v1/upgrade.proto", fileDescriptor_5d2662dd11fb8ae2)
+}
+
+var fileDescriptor_5d2662dd11fb8ae2 = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xbb, 0x61, 0xb7, 0x9d, 0xae, 0xa0, 0x83, 0x48, 0xa8, 0x90, 0xd6, 0x45, 0xb1,
+	0x20, 0x9b, 0x71, 0xdb, 0x8b, 0xa8, 0xa7, 0x8a, 0x82, 0x9e, 0x24, 0x68, 0x0f, 0x5e, 0x4a, 0x32,
+	0x79, 0xc6, 0x81, 0x24, 0x2f, 0xce, 0x4c, 0x02, 0x3d, 0x7a, 0xf3, 0x28, 0x7e, 0x02, 0x3f, 0x4e,
+	0x8f, 0x3d, 0x7a, 0x12, 0x69, 0xbf, 0x88, 0x74, 0x92, 0xd6, 0x0a, 0xf6, 0x34, 0xf3, 0xfe, 0xf3,
+	0xfb, 0xbf, 0xf7, 0x1f, 0x1e, 0xbd, 0x2f, 0x63, 0xc1, 0x05, 0x2a, 0xe0, 0x02, 0x8b, 0x02, 0x84,
+	0x91, 0x58, 0xf0, 0xfa, 0x9a, 0x57, 0x65, 0xaa, 0xa2, 0x04, 0x82, 0x52, 0xa1, 0x41, 0x76, 0x47,
+	0xc6, 0x22, 0xd8, 0x52, 0xc1, 0x5f, 0x2a, 0xa8, 0xaf, 0xfb, 0xb7, 0x53, 0x4c, 0xd1, 0x22, 0x7c,
+	0x7b, 0x6b, 0xe8, 0xfe, 0xc3, 0x23, 0x3d, 0x0f, 0xbc, 0x16, 0xbc, 0xfc, 0x42, 0xe8, 0xf9, 0xfb,
+	0x66, 0x10, 0x7b, 0x41, 0xcf, 0x3e, 0x4a, 0xc8, 0x12, 0xed, 0x91, 0x21, 0x19, 0xf5, 0xc6, 0x0f,
+	0x82, 0xff, 0xcf, 0x0c, 0x5a, 0xc3, 0x2b, 0x0b, 0x4f, 0xdd, 0xe5, 0xaf, 0x81, 0x13, 0xb6, 0x56,
+	0xf6, 0x88, 0xde, 0x32, 0x32, 0x07, 0xac, 0xcc, 0x7c, 0x7b, 0x6a, 0x13, 0xe5, 0xa5, 0x77, 0x32,
+	0x24, 0x23, 0x37, 0xbc, 0xd9, 0x3e, 0xbc, 0xdb, 0xe9, 0x4f, 0xdd, 0xaf, 0x3f, 0x06, 0xce, 0xe5,
+	0x77, 0x42, 0x6f, 0xfc, 0xd3, 0x92, 0xdd, 0xa5, 0x5d, 0x91, 0x49, 0x28, 0xcc, 0x5c, 0x26, 0x36,
+	0x4c, 0x37, 0xec, 0x34, 0xc2, 0xeb, 0x84, 0x3d, 0xa3, 0x9d, 0x1a, 0x94, 0x96, 0x58, 0x68, 0xef,
+	0x64, 0x78, 0x3a, 0xea, 0x8d, 0x07, 0xc7, 0x82, 0xce, 0x1a, 0x2e, 0xdc, 0x1b, 0xd8, 0x3d, 0x7a,
+	0x91, 0x40, 0x16, 0x2d, 0xe6, 0x25, 0x28, 0x89, 0x89, 0x77, 0x6a, 0x93, 0xf5, 0xac, 0xf6, 0xd6,
+	0x4a, 0x6d, 0xa8, 0x37, 0xf4, 0xe2, 0xa5, 0x52, 0xa8, 0x42, 0x10, 0x20, 0x4b, 0xc3, 0xfa, 0xb4,
+	0xa3, 0xe1, 0x73, 0x05, 0x85, 0x00, 0x9b, 0xc8, 0x0d, 0xf7, 0x35, 0xf3, 0xe8, 0x79, 0x0e, 0x5a,
+	0x47, 0x29, 0xd8, 0x9f, 0x76, 0xc3, 0x5d, 0xd9, 0xf4, 0x9a, 0xce, 0x96, 0x6b, 0x9f, 0xac, 0xd6,
+	0x3e, 0xf9, 0xbd, 0xf6, 0xc9, 0xb7, 0x8d, 0xef, 0xac, 0x36, 0xbe, 0xf3, 0x73, 0xe3, 0x3b, 0x1f,
+	0x9e, 0xa7, 0xd2, 0x7c, 0xaa, 0xe2, 0x40, 0x60, 0xce, 0x05, 0xea, 0x1c, 0x35, 0x97, 0xb1, 0xb8,
+	0x4a, 0x91, 0xd7, 0x4f, 0x78, 0x8e, 0x49, 0x95, 0x81, 0x6e, 0xf6, 0xf8, 0x78, 0x72, 0x75, 0xb0,
+	0x4a, 0xb3, 0x28, 0x41, 0xc7, 0x67, 0x76, 0x87, 0x93, 0x3f, 0x03, 0x00, 0x19, 0x69, 0x3a, 0x79,
+	0x42, 0x02, 0x00, 0x00,
+}
+
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayPeriod != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpgrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fields.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovUpgrade(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *UpgradeFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovUpgrade(uint64(m.DelayPeriod))
+	}
+	return n
+}
+
+func (m *ErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovUpgrade(uint64(m.Sequence))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func sovUpgrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpgrade(x uint64) (n int) {
+	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Version{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUpgrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUpgrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUpgrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUpgrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUpgrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUpgrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUpgrade = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,14 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+)
+
+// BeginBlocker is used to prune the packet receipts and acknowledgements, and the records of packets timed out,
+// whose retention period has elapsed
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.PruneExpiredReceipts(ctx)
+	k.PruneExpiredTimedOutPackets(ctx)
+}
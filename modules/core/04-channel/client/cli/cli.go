@@ -31,8 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
 		GetCmdQueryNextSequenceSend(),
+		GetCmdQueryPacketStatus(),
+		GetCmdQueryPacketStatuses(),
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
+		GetCmdQueryUpgradeStatus(),
 		GetCmdChannelParams(),
 	)
 
@@ -51,6 +54,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
+		newUpgradeInitTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
 	)
 
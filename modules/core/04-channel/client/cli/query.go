@@ -1,24 +1,42 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/client/utils"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences              = "sequences"
+	flagStatus                 = "status"
+	flagCounterpartyNode       = "counterparty-node"
+	flagPacketData             = "packet-data"
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 )
 
+// packetStatuses maps the values of the status flag to the packet statuses they filter by
+var packetStatuses = map[string]types.PacketStatus{
+	"all":          types.UNSPECIFIED_PACKET_STATUS,
+	"in-flight":    types.IN_FLIGHT,
+	"timed-out":    types.TIMED_OUT,
+	"acknowledged": types.ACKNOWLEDGED,
+	"completed":    types.COMPLETED,
+}
+
 // GetCmdQueryChannels defines the command to query all the channels ends
 // that this chain maintains.
 func GetCmdQueryChannels() *cobra.Command {
@@ -560,6 +578,189 @@ func GetCmdQueryUpgrade() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryUpgradeStatus defines the command to query the progress of a channel upgrade
+func GetCmdQueryUpgradeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-status [port-id] [channel-id]",
+		Short: "Query the progress of a channel upgrade",
+		Long: `Query the channel upgrade handshake step a channel end is at and the message which should be submitted next,
+and on which chain. The RPC endpoint of the counterparty chain may be provided to determine the next message using
+the state of both channel ends, otherwise the next message is determined using the state of the queried channel end.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-status [port-id] [channel-id] --%s tcp://localhost:36657", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagCounterpartyNode,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+
+			end, err := queryChannelUpgradeEnd(cmd.Context(), clientCtx, portID, channelID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyNode, err := cmd.Flags().GetString(flagCounterpartyNode)
+			if err != nil {
+				return err
+			}
+
+			var counterpartyEnd *channelUpgradeEnd
+			if counterpartyNode != "" {
+				rpcClient, err := client.NewClientFromNode(counterpartyNode)
+				if err != nil {
+					return err
+				}
+
+				counterpartyCtx := clientCtx.WithNodeURI(counterpartyNode).WithClient(rpcClient).WithGRPCClient(nil).WithHeight(0)
+				counterparty := end.channel.Counterparty
+
+				res, err := queryChannelUpgradeEnd(cmd.Context(), counterpartyCtx, counterparty.PortId, counterparty.ChannelId)
+				if err != nil {
+					return errorsmod.Wrap(err, "failed to query counterparty channel end")
+				}
+
+				counterpartyEnd = &res
+			}
+
+			return clientCtx.PrintObjectLegacy(getChannelUpgradeStatus(end, counterpartyEnd))
+		},
+	}
+
+	cmd.Flags().String(flagCounterpartyNode, "", "<host>:<port> to the CometBFT RPC interface of the counterparty chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketStatus defines the command to query the lifecycle state of a packet sequence
+func GetCmdQueryPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-status [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle state of a packet sequence",
+		Long:  "Query the status, commitment, timeout and upgrade flush state of the packet sent with the given sequence, and whether the packet with the given sequence sent by the counterparty has been received, acknowledged or pruned. The timeout of a packet in flight is only returned if the hex encoded data and the timeout of the packet are provided",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-status [port-id] [channel-id] [sequence] --%s [hex-data] --%s 1-1000 --%s 0", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagPacketData, flagPacketTimeoutHeight, flagPacketTimeoutTimestamp,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			dataHex, err := cmd.Flags().GetString(flagPacketData)
+			if err != nil {
+				return err
+			}
+
+			data, err := hex.DecodeString(dataHex)
+			if err != nil {
+				return errorsmod.Wrap(err, "packet data must be hex encoded")
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStatusRequest{
+				PortId:           args[0],
+				ChannelId:        args[1],
+				Sequence:         seq,
+				Data:             data,
+				TimeoutHeight:    timeoutHeight,
+				TimeoutTimestamp: timeoutTimestamp,
+			}
+
+			res, err := queryClient.PacketStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPacketData, "", "hex encoded data of the packet sent, used to return the timeout of a packet in flight")
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "timeout height of the packet sent in the format {revision}-{height}")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, 0, "timeout timestamp in nanoseconds of the packet sent")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketStatuses defines the command to query the lifecycle state of the packets sent on a channel
+func GetCmdQueryPacketStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-statuses [port-id] [channel-id]",
+		Short: "Query the lifecycle state of the packets sent on a channel",
+		Long:  "Query the lifecycle state of the packets sent on a channel, optionally filtered by the status of the packets",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-statuses [port-id] [channel-id] --%s=in-flight", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagStatus,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var packetStatus types.PacketStatus
+			if statusFlag, _ := cmd.Flags().GetString(flagStatus); statusFlag != "" {
+				var found bool
+				if packetStatus, found = packetStatuses[statusFlag]; !found {
+					return fmt.Errorf("invalid packet status %s, expected one of all, in-flight, timed-out, acknowledged or completed", statusFlag)
+				}
+			}
+
+			req := &types.QueryPacketStatusesRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Status:     packetStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PacketStatuses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "all", "filter the packets by status, one of all, in-flight, timed-out, acknowledged or completed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets sent on a channel")
+
+	return cmd
+}
+
 // GetCmdChannelParams returns the command handler for ibc channel parameter querying.
 func GetCmdChannelParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"slices"
 	"strconv"
@@ -9,22 +10,33 @@ import (
 
 	"github.com/spf13/cobra"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 const (
-	flagJSON        = "json"
-	flagPortPattern = "port-pattern"
-	flagExpedited   = "expedited"
-	flagChannelIDs  = "channel-ids"
+	flagJSON               = "json"
+	flagPortPattern        = "port-pattern"
+	flagExpedited          = "expedited"
+	flagChannelIDs         = "channel-ids"
+	flagVersion            = "version"
+	flagOrdering           = "ordering"
+	flagConnectionHops     = "connection-hops"
+	flagGovProposal        = "gov-proposal"
+	flagCounterpartyFields = "counterparty-fields"
+	flagAuthority          = "authority"
 )
 
 // newPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
@@ -149,6 +161,208 @@ func newUpgradeChannelsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newUpgradeInitTxCmd returns the command to create a new MsgChannelUpgradeInit transaction
+func newUpgradeInitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-init [port-id] [channel-id]",
+		Short: "Initialize a channel upgrade",
+		Long: `Initialize an upgrade of an open channel end. The proposed upgrade fields are built from the current channel end,
+overridden by the version, ordering and connection hops flags. The MsgChannelUpgradeInit must be signed by the IBC
+authority, it may be wrapped in a governance proposal using the gov-proposal flag in which case the authority flag must
+be set if the IBC authority is not the governance module account. The upgrade fields the counterparty
+is expected to propose in its MsgChannelUpgradeTry may be printed using the counterparty-fields flag.`,
+		Example: fmt.Sprintf(`%s tx %s %s upgrade-init transfer channel-0 --version "{\"fee_version\":\"ics29-1\",\"app_version\":\"ics20-1\"}" --gov-proposal --deposit 10stake`, version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			res, err := types.NewQueryClient(clientCtx).Channel(cmd.Context(), &types.QueryChannelRequest{PortId: portID, ChannelId: channelID})
+			if err != nil {
+				return err
+			}
+
+			fields, err := readUpgradeFieldsFlags(cmd, *res.Channel)
+			if err != nil {
+				return err
+			}
+
+			printCounterpartyFields, err := cmd.Flags().GetBool(flagCounterpartyFields)
+			if err != nil {
+				return err
+			}
+
+			if printCounterpartyFields {
+				counterpartyFields, err := getCounterpartyUpgradeFields(cmd, clientCtx, fields)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(&counterpartyFields)
+			}
+
+			useGovProposal, err := cmd.Flags().GetBool(flagGovProposal)
+			if err != nil {
+				return err
+			}
+
+			displayJSON, err := cmd.Flags().GetBool(flagJSON)
+			if err != nil {
+				return err
+			}
+
+			dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun)
+
+			if !useGovProposal {
+				msg := types.NewMsgChannelUpgradeInit(portID, channelID, fields, clientCtx.GetFromAddress().String())
+				if displayJSON {
+					out, err := clientCtx.Codec.MarshalJSON(msg)
+					if err != nil {
+						return err
+					}
+					return clientCtx.PrintBytes(out)
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			if authority == "" {
+				authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			} else if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+				return errorsmod.Wrapf(err, "invalid authority address %s", authority)
+			}
+
+			msg := types.NewMsgChannelUpgradeInit(portID, channelID, fields, authority)
+
+			msgSubmitProposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msgSubmitProposal.Expedited, err = cmd.Flags().GetBool(flagExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err := msgSubmitProposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return err
+			}
+
+			if displayJSON || dryRun {
+				out, err := clientCtx.Codec.MarshalJSON(msgSubmitProposal)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(out)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgSubmitProposal)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	cmd.Flags().String(flagVersion, "", "The proposed channel version, defaults to the current channel version.")
+	cmd.Flags().String(flagOrdering, "", "The proposed channel ordering (unordered, ordered or ordered-allow-timeout), defaults to the current channel ordering.")
+	cmd.Flags().String(flagConnectionHops, "", "A comma separated list of the proposed connection hops, defaults to the current connection hops.")
+	cmd.Flags().Bool(flagGovProposal, false, "specify true to submit the upgrade as a governance proposal signed by the IBC authority.")
+	cmd.Flags().String(flagAuthority, "", "The address of the IBC authority which signs the upgrade submitted as a governance proposal, defaults to the governance module account.")
+	cmd.Flags().Bool(flagCounterpartyFields, false, "specify true to print the upgrade fields the counterparty is expected to propose, instead of submitting the upgrade.")
+	cmd.Flags().Bool(flagJSON, false, "specify true to output the message or proposal JSON, instead of submitting the upgrade.")
+	cmd.Flags().Bool(flagExpedited, false, "set the expedited value for the governance proposal.")
+
+	return cmd
+}
+
+// readUpgradeFieldsFlags returns the upgrade fields for the provided channel end with the version, ordering and
+// connection hops overridden by the values of their flags.
+func readUpgradeFieldsFlags(cmd *cobra.Command, channel types.Channel) (types.UpgradeFields, error) {
+	fields := types.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, channel.Version)
+
+	versionStr, err := cmd.Flags().GetString(flagVersion)
+	if err != nil {
+		return types.UpgradeFields{}, err
+	}
+
+	if versionStr != "" {
+		fields.Version = versionStr
+	}
+
+	orderingStr, err := cmd.Flags().GetString(flagOrdering)
+	if err != nil {
+		return types.UpgradeFields{}, err
+	}
+
+	if orderingStr != "" {
+		fields.Ordering, err = parseOrder(orderingStr)
+		if err != nil {
+			return types.UpgradeFields{}, err
+		}
+	}
+
+	connectionHops, err := cmd.Flags().GetString(flagConnectionHops)
+	if err != nil {
+		return types.UpgradeFields{}, err
+	}
+
+	if strings.TrimSpace(connectionHops) != "" {
+		fields.ConnectionHops = strings.Split(connectionHops, ",")
+	}
+
+	if err := fields.ValidateBasic(); err != nil {
+		return types.UpgradeFields{}, err
+	}
+
+	if reflect.DeepEqual(fields, types.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, channel.Version)) {
+		return types.UpgradeFields{}, errorsmod.Wrap(types.ErrInvalidUpgrade, "proposed upgrade fields are identical to the current channel end")
+	}
+
+	return fields, nil
+}
+
+// getCounterpartyUpgradeFields returns the upgrade fields the counterparty is expected to propose for the provided
+// upgrade fields. The ordering and version must be equal, the connection hops are the counterparty connections
+// of the proposed connection hops.
+func getCounterpartyUpgradeFields(cmd *cobra.Command, clientCtx client.Context, fields types.UpgradeFields) (types.UpgradeFields, error) {
+	queryClient := connectiontypes.NewQueryClient(clientCtx)
+
+	counterpartyConnectionHops := make([]string, len(fields.ConnectionHops))
+	for i, connectionID := range fields.ConnectionHops {
+		res, err := queryClient.Connection(cmd.Context(), &connectiontypes.QueryConnectionRequest{ConnectionId: connectionID})
+		if err != nil {
+			return types.UpgradeFields{}, err
+		}
+
+		counterpartyConnectionHops[i] = res.Connection.Counterparty.ConnectionId
+	}
+
+	return types.NewUpgradeFields(fields.Ordering, counterpartyConnectionHops, fields.Version), nil
+}
+
+// parseOrder parses a channel ordering from either its short form, e.g. ordered, or its enum name, e.g. ORDER_ORDERED.
+func parseOrder(orderingStr string) (types.Order, error) {
+	name := strings.ToUpper(strings.ReplaceAll(orderingStr, "-", "_"))
+	if !strings.HasPrefix(name, "ORDER_") {
+		name = "ORDER_" + name
+	}
+
+	order, ok := types.Order_value[name]
+	if !ok || types.Order(order) == types.NONE {
+		return types.NONE, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "invalid channel ordering: %s", orderingStr)
+	}
+
+	return types.Order(order), nil
+}
+
 // getChannelIDs returns a slice of channel IDs based on a comma separated string of channel IDs.
 func getChannelIDs(commaSeparatedList string) []string {
 	if strings.TrimSpace(commaSeparatedList) == "" {
@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// channel upgrade handshake steps reported by the upgrade-status command
+const (
+	// upgradeStepNone indicates that no upgrade is in progress on the channel end.
+	upgradeStepNone = "NONE"
+	// upgradeStepCancelled indicates that the last upgrade attempt was cancelled or timed out on the channel end.
+	upgradeStepCancelled = "CANCELLED"
+	// upgradeStepInit indicates that an upgrade has been initialized on the channel end.
+	upgradeStepInit = "INIT"
+	// upgradeStepTry indicates that the channel end has accepted an upgrade proposed by the counterparty.
+	upgradeStepTry = "TRY"
+	// upgradeStepFlushing indicates that the upgrade has been acknowledged or confirmed on the channel end
+	// and that the packets in flight are being flushed.
+	upgradeStepFlushing = "FLUSHING"
+	// upgradeStepFlushComplete indicates that the channel end has no packets in flight left and may be opened.
+	upgradeStepFlushComplete = "FLUSHCOMPLETE"
+)
+
+// chains on which the next message of a channel upgrade should be submitted
+const (
+	nextMsgChainSelf         = "self"
+	nextMsgChainCounterparty = "counterparty"
+	nextMsgChainEither       = "either"
+)
+
+// channelUpgradeStatus reports the progress of a channel upgrade and the message which should be submitted next.
+type channelUpgradeStatus struct {
+	State            string `json:"state" yaml:"state"`
+	UpgradeSequence  uint64 `json:"upgrade_sequence" yaml:"upgrade_sequence"`
+	Step             string `json:"step" yaml:"step"`
+	CounterpartyStep string `json:"counterparty_step,omitempty" yaml:"counterparty_step,omitempty"`
+	InflightPackets  uint64 `json:"inflight_packets" yaml:"inflight_packets"`
+	NextMsg          string `json:"next_msg,omitempty" yaml:"next_msg,omitempty"`
+	NextMsgChain     string `json:"next_msg_chain,omitempty" yaml:"next_msg_chain,omitempty"`
+	Description      string `json:"description" yaml:"description"`
+}
+
+// channelUpgradeEnd holds the upgrade related state of a channel end.
+type channelUpgradeEnd struct {
+	channel             types.Channel
+	upgrade             *types.Upgrade
+	counterpartyUpgrade *types.Upgrade
+	errorReceipt        *types.ErrorReceipt
+	inflightPackets     uint64
+}
+
+// step returns the channel upgrade handshake step the channel end is at. The state of the channel end
+// is returned for channel ends which cannot be upgraded.
+func (end channelUpgradeEnd) step() string {
+	switch end.channel.State {
+	case types.OPEN:
+		if end.upgrade != nil {
+			return upgradeStepInit
+		}
+
+		if end.errorReceipt != nil && end.channel.UpgradeSequence != 0 && end.errorReceipt.Sequence == end.channel.UpgradeSequence {
+			return upgradeStepCancelled
+		}
+
+		return upgradeStepNone
+	case types.FLUSHING:
+		// the counterparty upgrade is only stored once the upgrade is acknowledged or confirmed
+		if end.counterpartyUpgrade == nil {
+			return upgradeStepTry
+		}
+
+		return upgradeStepFlushing
+	case types.FLUSHCOMPLETE:
+		return upgradeStepFlushComplete
+	default:
+		return end.channel.State.String()
+	}
+}
+
+// getChannelUpgradeStatus returns the upgrade status of the provided channel end. The upgrade state of the
+// counterparty channel end may be provided to determine the next message without ambiguity, otherwise the
+// next message is determined from the state of the provided channel end alone.
+func getChannelUpgradeStatus(end channelUpgradeEnd, counterparty *channelUpgradeEnd) channelUpgradeStatus {
+	status := channelUpgradeStatus{
+		State:           end.channel.State.String(),
+		UpgradeSequence: end.channel.UpgradeSequence,
+		Step:            end.step(),
+		InflightPackets: end.inflightPackets,
+	}
+
+	counterpartyStep := ""
+	if counterparty != nil {
+		counterpartyStep = counterparty.step()
+		status.CounterpartyStep = counterpartyStep
+	}
+
+	setNextMsg := func(msg proto.Message, chain, description string) {
+		status.NextMsg = sdk.MsgTypeURL(msg)
+		status.NextMsgChain = chain
+		status.Description = description
+	}
+
+	switch status.Step {
+	case upgradeStepNone:
+		if counterpartyStep == upgradeStepInit {
+			setNextMsg(&types.MsgChannelUpgradeTry{}, nextMsgChainSelf, "the counterparty has initialized an upgrade which must be accepted on this chain")
+			break
+		}
+
+		if counterpartyStep == upgradeStepFlushComplete {
+			setNextMsg(&types.MsgChannelUpgradeOpen{}, nextMsgChainCounterparty, "the channel end has been upgraded, the counterparty channel end must be opened")
+			break
+		}
+
+		setNextMsg(&types.MsgChannelUpgradeInit{}, nextMsgChainEither, "no upgrade is in progress, an upgrade may be initialized by the authority of either chain")
+	case upgradeStepCancelled:
+		if counterparty != nil && !isUpgrading(counterpartyStep) {
+			setNextMsg(&types.MsgChannelUpgradeInit{}, nextMsgChainEither, "the last upgrade attempt was cancelled on both channel ends, a new upgrade may be initialized by the authority of either chain")
+			break
+		}
+
+		description := fmt.Sprintf("upgrade attempt %d was cancelled on this channel end, the counterparty upgrade must be cancelled with a proof of the error receipt", end.errorReceipt.Sequence)
+		if counterparty == nil {
+			description += " if the counterparty channel end is still upgrading"
+		}
+
+		setNextMsg(&types.MsgChannelUpgradeCancel{}, nextMsgChainCounterparty, description)
+	case upgradeStepInit:
+		switch counterpartyStep {
+		case upgradeStepTry:
+			setNextMsg(&types.MsgChannelUpgradeAck{}, nextMsgChainSelf, "the counterparty has accepted the upgrade which must be acknowledged on this chain")
+		case upgradeStepCancelled:
+			setNextMsg(&types.MsgChannelUpgradeCancel{}, nextMsgChainSelf, "the counterparty has rejected the upgrade which must be cancelled on this chain")
+		default:
+			setNextMsg(&types.MsgChannelUpgradeTry{}, nextMsgChainCounterparty, "the upgrade has been initialized and must be accepted on the counterparty")
+		}
+	case upgradeStepTry:
+		if counterpartyStep == upgradeStepCancelled {
+			setNextMsg(&types.MsgChannelUpgradeCancel{}, nextMsgChainSelf, "the counterparty has rejected the upgrade which must be cancelled on this chain")
+			break
+		}
+
+		setNextMsg(&types.MsgChannelUpgradeAck{}, nextMsgChainCounterparty, "the upgrade has been accepted and must be acknowledged on the counterparty")
+	case upgradeStepFlushing:
+		switch counterpartyStep {
+		case upgradeStepTry:
+			setNextMsg(&types.MsgChannelUpgradeConfirm{}, nextMsgChainCounterparty, "the upgrade has been acknowledged and must be confirmed on the counterparty")
+		case upgradeStepCancelled:
+			setNextMsg(&types.MsgChannelUpgradeCancel{}, nextMsgChainSelf, "the counterparty has cancelled the upgrade which must be cancelled on this chain")
+		default:
+			description := fmt.Sprintf("the %d packets in flight on this channel end must be relayed to the counterparty and acknowledged or timed out", end.inflightPackets)
+			if counterparty == nil {
+				description = "if the counterparty has not yet confirmed the upgrade, MsgChannelUpgradeConfirm must be submitted on the counterparty, otherwise " + description
+			}
+
+			setNextMsg(&types.MsgRecvPacket{}, nextMsgChainCounterparty, withUpgradeTimeout(description, end))
+		}
+	case upgradeStepFlushComplete:
+		switch counterpartyStep {
+		case upgradeStepTry:
+			setNextMsg(&types.MsgChannelUpgradeConfirm{}, nextMsgChainCounterparty, "the upgrade has been acknowledged and must be confirmed on the counterparty")
+		case upgradeStepFlushing:
+			description := fmt.Sprintf("the %d packets in flight on the counterparty channel end must be relayed to this chain and acknowledged or timed out", counterparty.inflightPackets)
+			setNextMsg(&types.MsgRecvPacket{}, nextMsgChainSelf, withUpgradeTimeout(description, end))
+		case upgradeStepCancelled:
+			setNextMsg(&types.MsgChannelUpgradeCancel{}, nextMsgChainSelf, "the counterparty has cancelled the upgrade which must be cancelled on this chain")
+		case upgradeStepFlushComplete:
+			setNextMsg(&types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, "both channel ends have flushed their packets in flight, the upgrade must be opened on both chains")
+		case upgradeStepNone:
+			setNextMsg(&types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, "the counterparty channel end has been upgraded, this channel end must be opened")
+		default:
+			description := "this channel end has flushed its packets in flight and may be opened once the counterparty channel end is FLUSHCOMPLETE or OPEN. " +
+				"If the counterparty has not yet confirmed the upgrade, MsgChannelUpgradeConfirm must be submitted on the counterparty first"
+			setNextMsg(&types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, withUpgradeTimeout(description, end))
+		}
+	default:
+		status.Description = fmt.Sprintf("the channel end is %s, only OPEN channel ends may be upgraded", end.channel.State)
+	}
+
+	return status
+}
+
+// isUpgrading returns true if a channel end at the provided upgrade step has an upgrade in progress.
+func isUpgrading(step string) bool {
+	switch step {
+	case upgradeStepInit, upgradeStepTry, upgradeStepFlushing, upgradeStepFlushComplete:
+		return true
+	default:
+		return false
+	}
+}
+
+// withUpgradeTimeout appends the upgrade timeout of the counterparty to the provided description. Once the
+// counterparty upgrade has timed out without the counterparty channel end completing its flush, the upgrade
+// may be timed out on the channel end.
+func withUpgradeTimeout(description string, end channelUpgradeEnd) string {
+	if end.counterpartyUpgrade == nil || !end.counterpartyUpgrade.Timeout.IsValid() {
+		return description
+	}
+
+	timeout := end.counterpartyUpgrade.Timeout
+	return fmt.Sprintf(
+		"%s. MsgChannelUpgradeTimeout may be submitted on this chain if the counterparty channel end has not flushed its packets in flight before the upgrade timeout (height %s, timestamp %d)",
+		description, timeout.Height, timeout.Timestamp,
+	)
+}
+
+// queryChannelUpgradeEnd queries the upgrade related state of the channel end with the provided identifiers.
+func queryChannelUpgradeEnd(ctx context.Context, clientCtx client.Context, portID, channelID string) (channelUpgradeEnd, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	channelRes, err := queryClient.Channel(ctx, &types.QueryChannelRequest{PortId: portID, ChannelId: channelID})
+	if err != nil {
+		return channelUpgradeEnd{}, err
+	}
+
+	end := channelUpgradeEnd{channel: *channelRes.Channel}
+
+	upgradeRes, err := queryClient.Upgrade(ctx, &types.QueryUpgradeRequest{PortId: portID, ChannelId: channelID})
+	if err != nil && !isNotFound(err) {
+		return channelUpgradeEnd{}, err
+	} else if err == nil {
+		end.upgrade = &upgradeRes.Upgrade
+	}
+
+	counterpartyUpgradeRes, err := queryClient.CounterpartyUpgrade(ctx, &types.QueryCounterpartyUpgradeRequest{PortId: portID, ChannelId: channelID})
+	if err != nil && !isNotFound(err) {
+		return channelUpgradeEnd{}, err
+	} else if err == nil {
+		end.counterpartyUpgrade = &counterpartyUpgradeRes.Upgrade
+	}
+
+	upgradeErrorRes, err := queryClient.UpgradeError(ctx, &types.QueryUpgradeErrorRequest{PortId: portID, ChannelId: channelID})
+	if err != nil && !isNotFound(err) {
+		return channelUpgradeEnd{}, err
+	} else if err == nil {
+		end.errorReceipt = &upgradeErrorRes.ErrorReceipt
+	}
+
+	commitmentsRes, err := queryClient.PacketCommitments(ctx, &types.QueryPacketCommitmentsRequest{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	if err != nil {
+		return channelUpgradeEnd{}, err
+	}
+
+	end.inflightPackets = commitmentsRes.Pagination.Total
+
+	return end, nil
+}
+
+// isNotFound returns true if the provided gRPC query error indicates that the queried state does not exist.
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
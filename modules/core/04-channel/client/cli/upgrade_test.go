@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func TestGetChannelUpgradeStatus(t *testing.T) {
+	upgrade := &types.Upgrade{}
+	errorReceipt := &types.ErrorReceipt{Sequence: 1}
+
+	newEnd := func(state types.State, upgrade, counterpartyUpgrade *types.Upgrade, errorReceipt *types.ErrorReceipt) *channelUpgradeEnd {
+		return &channelUpgradeEnd{
+			channel:             types.Channel{State: state, UpgradeSequence: 1},
+			upgrade:             upgrade,
+			counterpartyUpgrade: counterpartyUpgrade,
+			errorReceipt:        errorReceipt,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		end              *channelUpgradeEnd
+		counterparty     *channelUpgradeEnd
+		expStep          string
+		expNextMsg       sdk.Msg
+		expNextMsgChain  string
+		expNoNextMessage bool
+	}{
+		{
+			"no upgrade in progress",
+			newEnd(types.OPEN, nil, nil, nil), nil,
+			upgradeStepNone, &types.MsgChannelUpgradeInit{}, nextMsgChainEither, false,
+		},
+		{
+			"no upgrade in progress, counterparty initialized upgrade",
+			newEnd(types.OPEN, nil, nil, nil), newEnd(types.OPEN, upgrade, nil, nil),
+			upgradeStepNone, &types.MsgChannelUpgradeTry{}, nextMsgChainSelf, false,
+		},
+		{
+			"upgraded, counterparty flush complete",
+			newEnd(types.OPEN, nil, nil, nil), newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil),
+			upgradeStepNone, &types.MsgChannelUpgradeOpen{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"upgrade cancelled",
+			newEnd(types.OPEN, nil, nil, errorReceipt), nil,
+			upgradeStepCancelled, &types.MsgChannelUpgradeCancel{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"upgrade cancelled on both channel ends",
+			newEnd(types.OPEN, nil, nil, errorReceipt), newEnd(types.OPEN, nil, nil, errorReceipt),
+			upgradeStepCancelled, &types.MsgChannelUpgradeInit{}, nextMsgChainEither, false,
+		},
+		{
+			"upgrade initialized",
+			newEnd(types.OPEN, upgrade, nil, nil), nil,
+			upgradeStepInit, &types.MsgChannelUpgradeTry{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"upgrade initialized, counterparty accepted upgrade",
+			newEnd(types.OPEN, upgrade, nil, nil), newEnd(types.FLUSHING, upgrade, nil, nil),
+			upgradeStepInit, &types.MsgChannelUpgradeAck{}, nextMsgChainSelf, false,
+		},
+		{
+			"upgrade initialized, counterparty rejected upgrade",
+			newEnd(types.OPEN, upgrade, nil, nil), newEnd(types.OPEN, nil, nil, errorReceipt),
+			upgradeStepInit, &types.MsgChannelUpgradeCancel{}, nextMsgChainSelf, false,
+		},
+		{
+			"upgrade accepted",
+			newEnd(types.FLUSHING, upgrade, nil, nil), nil,
+			upgradeStepTry, &types.MsgChannelUpgradeAck{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"upgrade acknowledged, packets in flight",
+			newEnd(types.FLUSHING, upgrade, upgrade, nil), nil,
+			upgradeStepFlushing, &types.MsgRecvPacket{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"upgrade acknowledged, counterparty has not confirmed",
+			newEnd(types.FLUSHING, upgrade, upgrade, nil), newEnd(types.FLUSHING, upgrade, nil, nil),
+			upgradeStepFlushing, &types.MsgChannelUpgradeConfirm{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"flush complete",
+			newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil), nil,
+			upgradeStepFlushComplete, &types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, false,
+		},
+		{
+			"flush complete, counterparty has not confirmed",
+			newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil), newEnd(types.FLUSHING, upgrade, nil, nil),
+			upgradeStepFlushComplete, &types.MsgChannelUpgradeConfirm{}, nextMsgChainCounterparty, false,
+		},
+		{
+			"flush complete, counterparty packets in flight",
+			newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil), newEnd(types.FLUSHING, upgrade, upgrade, nil),
+			upgradeStepFlushComplete, &types.MsgRecvPacket{}, nextMsgChainSelf, false,
+		},
+		{
+			"flush complete on both channel ends",
+			newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil), newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil),
+			upgradeStepFlushComplete, &types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, false,
+		},
+		{
+			"flush complete, counterparty upgraded",
+			newEnd(types.FLUSHCOMPLETE, upgrade, upgrade, nil), newEnd(types.OPEN, nil, nil, nil),
+			upgradeStepFlushComplete, &types.MsgChannelUpgradeOpen{}, nextMsgChainSelf, false,
+		},
+		{
+			"channel closed",
+			newEnd(types.CLOSED, nil, nil, nil), nil,
+			types.CLOSED.String(), nil, "", true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			status := getChannelUpgradeStatus(*tc.end, tc.counterparty)
+
+			require.Equal(t, tc.expStep, status.Step)
+			require.NotEmpty(t, status.Description)
+
+			if tc.expNoNextMessage {
+				require.Empty(t, status.NextMsg)
+				require.Empty(t, status.NextMsgChain)
+			} else {
+				require.Equal(t, sdk.MsgTypeURL(tc.expNextMsg), status.NextMsg)
+				require.Equal(t, tc.expNextMsgChain, status.NextMsgChain)
+			}
+		})
+	}
+}
+
+func TestParseOrder(t *testing.T) {
+	testCases := []struct {
+		orderingStr string
+		expOrder    types.Order
+		expPass     bool
+	}{
+		{"ordered", types.ORDERED, true},
+		{"UNORDERED", types.UNORDERED, true},
+		{"ordered-allow-timeout", types.ORDERED_ALLOW_TIMEOUT, true},
+		{"ORDER_ORDERED", types.ORDERED, true},
+		{"none_unspecified", types.NONE, false},
+		{"invalid", types.NONE, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.orderingStr, func(t *testing.T) {
+			order, err := parseOrder(tc.orderingStr)
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expOrder, order)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidChannelOrdering)
+			}
+		})
+	}
+}
@@ -38,20 +38,45 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, sr := range gs.ScheduledReceipts {
+		k.SetScheduledReceipt(ctx, sr)
+	}
+	// the progress is only stored once receipts have been scheduled, as empty values cannot be proven
+	if gs.ReceiptPruningProgress != (types.ReceiptPruningProgress{}) {
+		k.SetReceiptPruningProgress(ctx, gs.ReceiptPruningProgress)
+	}
+	for _, pt := range gs.TimedOutPackets {
+		k.SetTimedOutPacket(ctx, pt.PortId, pt.ChannelId, pt.Sequence, pt.Timeout)
+	}
+	for _, sp := range gs.ScheduledTimedOutPackets {
+		k.SetScheduledTimedOutPacket(ctx, sp)
+	}
+	for _, ss := range gs.PacketStatusStartSequences {
+		k.SetPacketStatusStartSequence(ctx, ss.PortId, ss.ChannelId, ss.Sequence)
+	}
+	for _, re := range gs.ReceiptExpiries {
+		k.SetReceiptExpiry(ctx, re)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:                   k.GetAllChannels(ctx),
+		Acknowledgements:           k.GetAllPacketAcks(ctx),
+		Commitments:                k.GetAllPacketCommitments(ctx),
+		Receipts:                   k.GetAllPacketReceipts(ctx),
+		SendSequences:              k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:              k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:               k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:        k.GetNextChannelSequence(ctx),
+		Params:                     k.GetParams(ctx),
+		ScheduledReceipts:          k.GetAllScheduledReceipts(ctx),
+		ReceiptPruningProgress:     k.GetReceiptPruningProgress(ctx),
+		TimedOutPackets:            k.GetAllTimedOutPackets(ctx),
+		ScheduledTimedOutPackets:   k.GetAllScheduledTimedOutPackets(ctx),
+		PacketStatusStartSequences: k.GetAllPacketStatusStartSequences(ctx),
+		ReceiptExpiries:            k.GetAllReceiptExpiries(ctx),
 	}
 }
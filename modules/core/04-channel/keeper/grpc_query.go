@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 	"strings"
@@ -18,6 +19,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -422,7 +424,7 @@ func (k Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -628,13 +630,306 @@ func (k Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*typ
 	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
 }
 
+// CounterpartyUpgrade implements the Query/CounterpartyUpgrade gRPC method
+func (k Keeper) CounterpartyUpgrade(c context.Context, req *types.QueryCounterpartyUpgradeRequest) (*types.QueryCounterpartyUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	found := k.HasChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	upgrade, found := k.GetCounterpartyUpgrade(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeNotFound, "counterparty upgrade not found for port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return types.NewQueryCounterpartyUpgradeResponse(upgrade), nil
+}
+
+// PacketStatus implements the Query/PacketStatus gRPC method
+func (k Keeper) PacketStatus(c context.Context, req *types.QueryPacketStatusRequest) (*types.QueryPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	counterpartyHeight, counterpartyTimestamp, err := k.counterpartyLatestHeight(ctx, channel)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the timeout of a packet in flight is derived from the packet data and timeout supplied in the
+	// request once they have been checked against the packet commitment
+	var timeout *types.Timeout
+	if commitment := k.GetPacketCommitment(ctx, req.PortId, req.ChannelId, req.Sequence); len(commitment) != 0 && len(req.Data) != 0 {
+		packet := types.NewPacket(req.Data, req.Sequence, req.PortId, req.ChannelId, channel.Counterparty.PortId, channel.Counterparty.ChannelId, req.TimeoutHeight, req.TimeoutTimestamp)
+		if !bytes.Equal(types.CommitPacket(k.cdc, packet), commitment) {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				errorsmod.Wrap(types.ErrInvalidPacket, "packet data and timeout do not match the packet commitment").Error(),
+			)
+		}
+
+		packetTimeout := types.NewTimeout(req.TimeoutHeight, req.TimeoutTimestamp)
+		timeout = &packetTimeout
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketStatusResponse{
+		Packet: k.packetLifecycle(ctx, req.PortId, req.ChannelId, channel, req.Sequence, counterpartyHeight, counterpartyTimestamp, timeout),
+		Height: selfHeight,
+	}, nil
+}
+
+// PacketStatuses implements the Query/PacketStatuses gRPC method. In flight packets are paginated over
+// the packet commitments and timed out packets over the record of packets timed out, while acknowledged
+// packets, completed packets and packets of any status are paginated over the sequences of the packets sent.
+func (k Keeper) PacketStatuses(c context.Context, req *types.QueryPacketStatusesRequest) (*types.QueryPacketStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	counterpartyHeight, counterpartyTimestamp, err := k.counterpartyLatestHeight(ctx, channel)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	var (
+		packets []types.PacketLifecycle
+		pageRes *query.PageResponse
+	)
+
+	switch req.Status {
+	case types.IN_FLIGHT:
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PacketCommitmentPrefixPath(req.PortId, req.ChannelId)))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+			keySplit := strings.Split(string(key), "/")
+
+			sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packets = append(packets, k.packetLifecycle(ctx, req.PortId, req.ChannelId, channel, sequence, counterpartyHeight, counterpartyTimestamp, nil))
+			return nil
+		})
+	case types.TIMED_OUT:
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TimedOutPacketPrefixPath(req.PortId, req.ChannelId)))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+			sequence, err := strconv.ParseUint(string(key), 10, 64)
+			if err != nil {
+				return err
+			}
+
+			packets = append(packets, k.packetLifecycle(ctx, req.PortId, req.ChannelId, channel, sequence, counterpartyHeight, counterpartyTimestamp, nil))
+			return nil
+		})
+	case types.UNSPECIFIED_PACKET_STATUS, types.ACKNOWLEDGED, types.COMPLETED:
+		packets, pageRes, err = k.paginatePacketSequences(ctx, req, channel, counterpartyHeight, counterpartyTimestamp)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid packet status %s", req.Status)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketStatusesResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// paginatePacketSequences returns the lifecycle state of the packets sent on the channel end in order of their sequence,
+// starting at the sequence given by the pagination key or offset. At most limit packet sequences are inspected. All packets
+// inspected are returned if the status of the request is unspecified, otherwise only the packets with the status of the
+// request are returned, in which case fewer packets than the limit may be returned along with the next key.
+func (k Keeper) paginatePacketSequences(
+	ctx sdk.Context,
+	req *types.QueryPacketStatusesRequest,
+	channel types.Channel,
+	counterpartyHeight clienttypes.Height,
+	counterpartyTimestamp uint64,
+) ([]types.PacketLifecycle, *query.PageResponse, error) {
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	sequence := pageReq.Offset + 1
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid pagination key length, expected 8, got %d", len(pageReq.Key))
+		}
+		sequence = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	nextSequenceSend, _ := k.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+
+	var packets []types.PacketLifecycle
+	for end := sequence + limit; sequence < nextSequenceSend && sequence < end; sequence++ {
+		packet := k.packetLifecycle(ctx, req.PortId, req.ChannelId, channel, sequence, counterpartyHeight, counterpartyTimestamp, nil)
+		if req.Status != types.UNSPECIFIED_PACKET_STATUS && packet.Status != req.Status {
+			continue
+		}
+
+		packets = append(packets, packet)
+	}
+
+	pageRes := &query.PageResponse{}
+	if sequence < nextSequenceSend {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(sequence)
+	}
+
+	if pageReq.CountTotal && req.Status == types.UNSPECIFIED_PACKET_STATUS && nextSequenceSend > 0 {
+		pageRes.Total = nextSequenceSend - 1
+	}
+
+	return packets, pageRes, nil
+}
+
+// packetLifecycle returns the lifecycle state of a packet sequence on the given channel end. The timeout of a packet
+// in flight is only known if it is provided, in which case it is compared against the given latest height and timestamp
+// of the counterparty chain.
+func (k Keeper) packetLifecycle(
+	ctx sdk.Context,
+	portID, channelID string,
+	channel types.Channel,
+	sequence uint64,
+	counterpartyHeight clienttypes.Height,
+	counterpartyTimestamp uint64,
+	inFlightTimeout *types.Timeout,
+) types.PacketLifecycle {
+	packet := types.PacketLifecycle{Sequence: sequence, Status: types.NOT_SENT}
+
+	nextSequenceSend, _ := k.GetNextSequenceSend(ctx, portID, channelID)
+	if commitment := k.GetPacketCommitment(ctx, portID, channelID, sequence); len(commitment) != 0 {
+		packet.Status = types.IN_FLIGHT
+		packet.Commitment = commitment
+		packet.Timeout = inFlightTimeout
+		packet.TimeoutElapsed = inFlightTimeout != nil && inFlightTimeout.Elapsed(counterpartyHeight, counterpartyTimestamp)
+		packet.Flushing = channel.State == types.FLUSHING
+	} else if timeout, timedOut := k.GetTimedOutPacket(ctx, portID, channelID, sequence); timedOut {
+		packet.Status = types.TIMED_OUT
+		packet.Timeout = &timeout
+	} else if sequence < nextSequenceSend {
+		// the outcome of packets sent before the packet status start sequence has not been recorded
+		if startSequence, found := k.GetPacketStatusStartSequence(ctx, portID, channelID); found && sequence >= startSequence {
+			packet.Status = types.ACKNOWLEDGED
+		} else {
+			packet.Status = types.COMPLETED
+		}
+	}
+
+	receipt, hasReceipt := k.GetPacketReceipt(ctx, portID, channelID, sequence)
+	if channel.Ordering.IsOrdered() {
+		nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID)
+		// packets skipped on ORDERED_ALLOW_TIMEOUT channels have a timeout receipt written
+		packet.Received = sequence < nextSequenceRecv && !(hasReceipt && receipt == string(types.TimeoutReceipt))
+	} else {
+		packet.Received = hasReceipt
+	}
+
+	packet.AcknowledgementWritten = k.HasPacketAcknowledgement(ctx, portID, channelID, sequence)
+
+	// only the receipts pruned after a channel upgrade are reported, as no record is kept of the receipts
+	// pruned once their retention period has elapsed
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	packet.Pruned = found && sequence < pruningSequenceStart
+
+	return packet
+}
+
+// counterpartyLatestHeight returns the latest height of the counterparty chain of the given channel
+// according to the counterparty client, along with the timestamp of the consensus state at that height.
+func (k Keeper) counterpartyLatestHeight(ctx sdk.Context, channel types.Channel) (clienttypes.Height, uint64, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return clienttypes.Height{}, 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return clienttypes.Height{}, 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, connection.ClientId)
+	}
+
+	latestHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return clienttypes.Height{}, 0, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, clientState.GetLatestHeight())
+	}
+
+	latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connection, latestHeight)
+	if err != nil {
+		return clienttypes.Height{}, 0, err
+	}
+
+	return latestHeight, latestTimestamp, nil
+}
+
 // ChannelParams implements the Query/ChannelParams gRPC method.
 func (k Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 
 	return &types.QueryChannelParamsResponse{
-		Params: &params,
+		Params:                 &params,
+		ReceiptPruningProgress: k.GetReceiptPruningProgress(ctx),
 	}, nil
 }
 
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -1889,9 +1891,542 @@ func (suite *KeeperTestSuite) TestQueryUpgrade() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryCounterpartyUpgrade() {
+	var (
+		req             *types.QueryCounterpartyUpgradeRequest
+		expectedUpgrade types.Upgrade
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryCounterpartyUpgradeRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryCounterpartyUpgradeRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryCounterpartyUpgradeRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"counterparty upgrade not found",
+			func() {
+				storeKey := suite.chainA.GetSimApp().GetKey(exported.StoreKey)
+				kvStore := suite.chainA.GetContext().KVStore(storeKey)
+				kvStore.Delete(host.ChannelCounterpartyUpgradeKey(req.PortId, req.ChannelId))
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			expectedUpgrade = types.NewUpgrade(
+				types.NewUpgradeFields(types.UNORDERED, []string{ibctesting.FirstConnectionID}, mock.Version),
+				types.NewTimeout(clienttypes.ZeroHeight(), 1000000),
+				0,
+			)
+
+			suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetCounterpartyUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expectedUpgrade)
+
+			req = &types.QueryCounterpartyUpgradeRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.CounterpartyUpgrade(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expectedUpgrade, res.Upgrade)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
 	res, _ := suite.chainA.QueryServer.ChannelParams(ctx, &types.QueryChannelParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
+	suite.Require().Equal(types.ReceiptPruningProgress{}, res.ReceiptPruningProgress)
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketStatus() {
+	var (
+		path      *ibctesting.Path
+		req       *types.QueryPacketStatusRequest
+		expPacket types.PacketLifecycle
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req.Sequence = 0
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"success: packet not sent",
+			func() {
+				expPacket = types.PacketLifecycle{Sequence: 1, Status: types.NOT_SENT}
+			},
+			true,
+		},
+		{
+			"success: packet in flight",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.IN_FLIGHT, Commitment: commitment}
+			},
+			true,
+		},
+		{
+			"success: packet in flight with packet data and timeout",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req.Data = ibctesting.MockPacketData
+				req.TimeoutHeight = timeout.Height
+				req.TimeoutTimestamp = timeout.Timestamp
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.IN_FLIGHT, Commitment: commitment, Timeout: &timeout}
+			},
+			true,
+		},
+		{
+			"packet data and timeout do not match the packet commitment",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				_, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				req.Data = ibctesting.MockPacketData
+				req.TimeoutHeight = timeout.Height
+				req.TimeoutTimestamp = timeout.Timestamp + 1
+			},
+			false,
+		},
+		{
+			"success: packet in flight with elapsed timeout on flushing channel",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				channel := path.EndpointA.GetChannel()
+				channel.State = types.FLUSHING
+				path.EndpointA.SetChannel(channel)
+
+				req.Data = ibctesting.MockPacketData
+				req.TimeoutHeight = timeout.Height
+				req.TimeoutTimestamp = timeout.Timestamp
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.IN_FLIGHT, Commitment: commitment, Timeout: &timeout, TimeoutElapsed: true, Flushing: true}
+			},
+			true,
+		},
+		{
+			"success: packet acknowledged",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeout.Height, timeout.Timestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.ACKNOWLEDGED}
+			},
+			true,
+		},
+		{
+			"success: packet timed out",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeout.Height, timeout.Timestamp)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.TIMED_OUT, Timeout: &timeout}
+			},
+			true,
+		},
+		{
+			"success: packet sent before packet statuses were recorded timed out",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				// remove the packet status start sequence of the channel, as if the channel was opened before packet statuses were recorded
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(types.PacketStatusStartSequenceKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeout.Height, timeout.Timestamp)
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.TIMED_OUT, Timeout: &timeout}
+			},
+			true,
+		},
+		{
+			"success: packet sent before packet statuses were recorded completed",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				sequence, err := path.EndpointA.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				// remove the packet status start sequence of the channel, as if the channel was opened before packet statuses were recorded
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				store.Delete(types.PacketStatusStartSequenceKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeout.Height, timeout.Timestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.COMPLETED}
+			},
+			true,
+		},
+		{
+			"success: packet sent by counterparty received and acknowledged",
+			func() {
+				timeout := types.NewTimeout(clienttypes.ZeroHeight(), uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+				sequence, err := path.EndpointB.SendPacket(timeout.Height, timeout.Timestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeout.Height, timeout.Timestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expPacket = types.PacketLifecycle{Sequence: sequence, Status: types.NOT_SENT, Received: true, AcknowledgementWritten: true}
+			},
+			true,
+		},
+		{
+			"success: acknowledgement pruned",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+
+				expPacket = types.PacketLifecycle{Sequence: 1, Status: types.NOT_SENT, Pruned: true}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QueryPacketStatusRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.PacketStatus(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPacket, res.Packet)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketStatuses() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryPacketStatusesRequest
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expSequences []uint64
+		expNextKey   []byte
+		expPass      bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"invalid packet status",
+			func() {
+				req.Status = types.PacketStatus(10)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"packets not sent cannot be queried",
+			func() {
+				req.Status = types.NOT_SENT
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"success: all packets sent",
+			func() {},
+			[]uint64{1, 2, 3, 4},
+			nil,
+			true,
+		},
+		{
+			"success: all packets sent with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 3}
+			},
+			[]uint64{1, 2, 3},
+			sdk.Uint64ToBigEndian(4),
+			true,
+		},
+		{
+			"success: all packets sent with pagination key",
+			func() {
+				req.Pagination = &query.PageRequest{Key: sdk.Uint64ToBigEndian(3)}
+			},
+			[]uint64{3, 4},
+			nil,
+			true,
+		},
+		{
+			"success: packets in flight",
+			func() {
+				req.Status = types.IN_FLIGHT
+			},
+			[]uint64{3},
+			nil,
+			true,
+		},
+		{
+			"success: packets timed out",
+			func() {
+				req.Status = types.TIMED_OUT
+			},
+			[]uint64{4},
+			nil,
+			true,
+		},
+		{
+			"success: packets acknowledged",
+			func() {
+				req.Status = types.ACKNOWLEDGED
+			},
+			[]uint64{1, 2},
+			nil,
+			true,
+		},
+		{
+			"success: packets acknowledged with pagination, only the limit of sequences are inspected",
+			func() {
+				req.Status = types.ACKNOWLEDGED
+				req.Pagination = &query.PageRequest{Key: sdk.Uint64ToBigEndian(2), Limit: 2}
+			},
+			[]uint64{2},
+			sdk.Uint64ToBigEndian(4),
+			true,
+		},
+		{
+			"success: packets timed out with pagination",
+			func() {
+				req.Status = types.TIMED_OUT
+				req.Pagination = &query.PageRequest{Limit: 1}
+			},
+			[]uint64{4},
+			nil,
+			true,
+		},
+		{
+			"success: no packets completed",
+			func() {
+				req.Status = types.COMPLETED
+			},
+			nil,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// packets 1 and 2 are acknowledged, packet 3 is in flight and packet 4 is timed out
+			for i := 0; i < 2; i++ {
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+				sequence, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+			}
+
+			_, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()), ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+			sequence, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+			req = &types.QueryPacketStatusesRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.PacketStatuses(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				var sequences []uint64
+				for _, packet := range res.Packets {
+					sequences = append(sequences, packet.Sequence)
+					if req.Status != types.UNSPECIFIED_PACKET_STATUS {
+						suite.Require().Equal(req.Status, packet.Status)
+					}
+				}
+				suite.Require().Equal(tc.expSequences, sequences)
+				suite.Require().Equal(tc.expNextKey, res.Pagination.NextKey)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
 }
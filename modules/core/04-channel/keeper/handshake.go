@@ -93,6 +93,7 @@ func (k Keeper) WriteOpenInitChannel(
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
+	k.SetPacketStatusStartSequence(ctx, portID, channelID, 1)
 
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", types.UNINITIALIZED.String(), "new-state", types.INIT.String())
 
@@ -200,6 +201,7 @@ func (k Keeper) WriteOpenTryChannel(
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
+	k.SetPacketStatusStartSequence(ctx, portID, channelID, 1)
 
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
 
@@ -491,3 +493,34 @@ func (k Keeper) ChanCloseConfirm(
 
 	return nil
 }
+
+// ChanForceClose is called by the IBC authority to close a channel end without proof of the counterparty
+// channel state, for example when the counterparty chain has permanently halted. Any channel upgrade in
+// progress is cancelled. Outstanding packet commitments are kept so that the packets may be refunded
+// to their senders using ForceTimeoutPacket.
+func (k Keeper) ChanForceClose(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.CLOSED {
+		return errorsmod.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	previousState := channel.State
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", types.CLOSED.String())
+
+	defer telemetry.IncrCounter(1, "ibc", "channel", "force-close")
+
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+
+	channel.State = types.CLOSED
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	pendingPacketCommitments := len(k.GetAllPacketCommitmentsAtChannel(ctx, portID, channelID))
+	emitChannelForceCloseEvent(ctx, portID, channelID, previousState, channel, pendingPacketCommitments)
+
+	return nil
+}
@@ -843,6 +843,85 @@ func (suite *KeeperTestSuite) TestChanCloseConfirm() {
 	}
 }
 
+// TestChanForceClose tests closing a channel end on chainA without counterparty proofs.
+func (suite *KeeperTestSuite) TestChanForceClose() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: channel in INIT", func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				err := path.EndpointA.ChanOpenInit()
+				suite.Require().NoError(err)
+			}, nil,
+		},
+		{
+			"success: upgrade in progress is cancelled", func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+				err := path.EndpointA.ChanUpgradeInit()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.ChanUpgradeTry()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.ChanUpgradeAck()
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+			}, nil,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			}, types.ErrChannelNotFound,
+		},
+		{
+			"channel is already CLOSED", func() {
+				err := path.EndpointA.SetChannelState(types.CLOSED)
+				suite.Require().NoError(err)
+			}, types.ErrInvalidChannelState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanForceClose(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.CLOSED, channel.State)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func malleateHeight(height exported.Height, diff uint64) exported.Height {
 	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+diff)
 }
@@ -23,7 +23,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ porttypes.ICS4Wrapper = (*Keeper)(nil)
+var (
+	_ porttypes.ICS4Wrapper                 = (*Keeper)(nil)
+	_ porttypes.RelativeTimeoutPacketSender = (*Keeper)(nil)
+)
 
 // Keeper defines the IBC channel keeper
 type Keeper struct {
@@ -194,6 +197,12 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets the timeout receipt of a packet which timed out on an ORDERED_ALLOW_TIMEOUT channel to the store
+func (k Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
 // deletePacketReceipt deletes a packet receipt from the store
 func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -224,6 +233,65 @@ func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
 }
 
+// SetTimedOutPacket records that a packet has been timed out, along with its timeout, to the store
+func (k Keeper) SetTimedOutPacket(ctx sdk.Context, portID, channelID string, sequence uint64, timeout types.Timeout) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&timeout)
+	store.Set(types.TimedOutPacketKey(portID, channelID, sequence), bz)
+}
+
+// GetTimedOutPacket gets the timeout of a packet timed out from the store. False is returned if
+// the packet has not been timed out.
+func (k Keeper) GetTimedOutPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Timeout, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TimedOutPacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.Timeout{}, false
+	}
+
+	var timeout types.Timeout
+	k.cdc.MustUnmarshal(bz, &timeout)
+	return timeout, true
+}
+
+// deleteTimedOutPacket deletes the record of a packet timed out from the store
+func (k Keeper) deleteTimedOutPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TimedOutPacketKey(portID, channelID, sequence))
+}
+
+// GetPacketStatusStartSequence gets the sequence of the first packet sent on the channel whose
+// acknowledgement or timeout is recorded. The outcome of packets sent before this sequence is unknown.
+func (k Keeper) GetPacketStatusStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketStatusStartSequenceKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPacketStatusStartSequence sets the sequence of the first packet sent on the channel whose
+// acknowledgement or timeout is recorded to the store.
+func (k Keeper) SetPacketStatusStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketStatusStartSequenceKey(portID, channelID), sdk.Uint64ToBigEndian(sequence))
+}
+
+// initPacketStatusStartSequence sets the packet status start sequence of a channel opened before the outcome
+// of packets was recorded to its next send sequence, so that the outcome of the packets sent from then on is
+// recorded. It is called on the acknowledgement or timeout of a packet, as channels opened since then have
+// their packet status start sequence set during the channel handshake.
+func (k Keeper) initPacketStatusStartSequence(ctx sdk.Context, portID, channelID string) {
+	if _, found := k.GetPacketStatusStartSequence(ctx, portID, channelID); found {
+		return
+	}
+
+	nextSequenceSend, _ := k.GetNextSequenceSend(ctx, portID, channelID)
+	k.SetPacketStatusStartSequence(ctx, portID, channelID, nextSequenceSend)
+}
+
 // SetPacketAcknowledgement sets the packet ack hash to the store
 func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -385,6 +453,32 @@ func (k Keeper) GetAllPacketAcks(ctx sdk.Context) (acks []types.PacketState) {
 	return acks
 }
 
+// GetAllTimedOutPackets returns the timeouts of all packets timed out.
+func (k Keeper) GetAllTimedOutPackets(ctx sdk.Context) (timeouts []types.PacketTimeoutState) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyTimedOutPacketPrefix+"/"))
+	k.iterateHashes(ctx, iterator, func(portID, channelID string, sequence uint64, bz []byte) bool {
+		var timeout types.Timeout
+		k.cdc.MustUnmarshal(bz, &timeout)
+
+		timeouts = append(timeouts, types.NewPacketTimeoutState(portID, channelID, sequence, timeout))
+		return false
+	})
+	return timeouts
+}
+
+// GetAllPacketStatusStartSequences returns the packet status start sequences of all channels.
+func (k Keeper) GetAllPacketStatusStartSequences(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyPacketStatusStartSequencePrefix+"/"))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, startSeq uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, startSeq)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
 // IterateChannels provides an iterator over all Channel objects. For each
 // Channel, cb will be called. If the cb returns true, the iterator will close
 // and stop.
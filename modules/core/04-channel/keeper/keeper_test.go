@@ -506,9 +506,9 @@ func (suite *KeeperTestSuite) TestParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000)), true},
-		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0)), false},
-		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0)), false},
+		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000), types.DefaultReceiptRetention), true},
+		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0), types.DefaultReceiptRetention), false},
+		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0), types.DefaultReceiptRetention), false},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
 	"slices"
 	"strconv"
 
@@ -14,6 +15,7 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -29,60 +31,105 @@ func (k Keeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
+	sequence, _, err := k.sendPacket(ctx, channelCap, sourcePort, sourceChannel, types.NewTimeout(timeoutHeight, timeoutTimestamp), nil, data)
+	return sequence, err
+}
+
+// SendPacketWithRelativeTimeout is called by a module in order to send an IBC packet on a channel
+// with a timeout relative to the latest height of the counterparty client and to the later of the block
+// time and the latest consensus timestamp of the counterparty client. The packet sequence generated for
+// the packet to be sent and the resolved absolute timeout, which is committed to in the packet commitment,
+// are returned. An error is returned if one occurs.
+func (k Keeper) SendPacketWithRelativeTimeout(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	relativeTimeout types.RelativeTimeout,
+	data []byte,
+) (uint64, types.Timeout, error) {
+	if !relativeTimeout.IsValid() {
+		return 0, types.Timeout{}, errorsmod.Wrap(types.ErrInvalidTimeout, "relative timeout height and timestamp cannot both be 0")
+	}
+
+	return k.sendPacket(ctx, channelCap, sourcePort, sourceChannel, types.Timeout{}, &relativeTimeout, data)
+}
+
+// sendPacket sends an IBC packet on a channel. If a relative timeout is provided, the packet timeout
+// is resolved against the latest height and consensus timestamp of the counterparty client, otherwise
+// the provided absolute timeout is used.
+func (k Keeper) sendPacket(
+	ctx sdk.Context,
+	channelCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeout types.Timeout,
+	relativeTimeout *types.RelativeTimeout,
+	data []byte,
+) (uint64, types.Timeout, error) {
 	channel, found := k.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
-		return 0, errorsmod.Wrap(types.ErrChannelNotFound, sourceChannel)
+		return 0, types.Timeout{}, errorsmod.Wrap(types.ErrChannelNotFound, sourceChannel)
 	}
 
 	if channel.State != types.OPEN {
-		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
+		return 0, types.Timeout{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
 	}
 
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(sourcePort, sourceChannel)) {
-		return 0, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+		return 0, types.Timeout{}, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
 	sequence, found := k.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
-		return 0, errorsmod.Wrapf(
+		return 0, types.Timeout{}, errorsmod.Wrapf(
 			types.ErrSequenceSendNotFound,
 			"source port: %s, source channel: %s", sourcePort, sourceChannel,
 		)
 	}
 
-	// construct packet from given fields and channel state
-	packet := types.NewPacket(data, sequence, sourcePort, sourceChannel,
-		channel.Counterparty.PortId, channel.Counterparty.ChannelId, timeoutHeight, timeoutTimestamp)
-
-	if err := packet.ValidateBasic(); err != nil {
-		return 0, errorsmod.Wrap(err, "constructed packet failed basic validation")
-	}
-
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
-		return 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		return 0, types.Timeout{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
 	}
 
 	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
 	if !found {
-		return 0, clienttypes.ErrClientNotFound
+		return 0, types.Timeout{}, clienttypes.ErrClientNotFound
 	}
 
 	// prevent accidental sends with clients that cannot be updated
 	if status := k.clientKeeper.GetClientStatus(ctx, clientState, connectionEnd.GetClientID()); status != exported.Active {
-		return 0, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
+		return 0, types.Timeout{}, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
+	}
+
+	latestHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return 0, types.Timeout{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, clientState.GetLatestHeight())
 	}
 
-	latestHeight := clientState.GetLatestHeight()
 	latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
 	if err != nil {
-		return 0, err
+		return 0, types.Timeout{}, err
+	}
+
+	if relativeTimeout != nil {
+		// the block time is used as the reference time if it is later than the latest consensus timestamp,
+		// so that a counterparty client which has not been updated recently does not shorten the timeout
+		timeout = relativeTimeout.Resolve(latestHeight, max(uint64(ctx.BlockTime().UnixNano()), latestTimestamp))
+	}
+
+	// construct packet from given fields and channel state
+	packet := types.NewPacket(data, sequence, sourcePort, sourceChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, timeout.Height, timeout.Timestamp)
+
+	if err := packet.ValidateBasic(); err != nil {
+		return 0, types.Timeout{}, errorsmod.Wrap(err, "constructed packet failed basic validation")
 	}
 
 	// check if packet is timed out on the receiving chain
-	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(latestHeight.(clienttypes.Height), latestTimestamp) {
-		return 0, errorsmod.Wrap(timeout.ErrTimeoutElapsed(latestHeight.(clienttypes.Height), latestTimestamp), "invalid packet timeout")
+	if timeout.Elapsed(latestHeight, latestTimestamp) {
+		return 0, types.Timeout{}, errorsmod.Wrap(timeout.ErrTimeoutElapsed(latestHeight, latestTimestamp), "invalid packet timeout")
 	}
 
 	commitment := types.CommitPacket(k.cdc, packet)
@@ -90,7 +137,7 @@ func (k Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
 
-	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
+	emitSendPacketEvent(ctx, packet, channel, timeout.Height, relativeTimeout)
 
 	k.Logger(ctx).Info(
 		"packet sent",
@@ -101,17 +148,113 @@ func (k Keeper) SendPacket(
 		"dst_channel", packet.GetDestChannel(),
 	)
 
-	return packet.GetSequence(), nil
+	return packet.GetSequence(), timeout, nil
 }
 
 // RecvPacket is called by a module in order to receive & process an IBC packet
 // sent on the corresponding channel end on the counterparty chain.
+// If the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, a timeout receipt is written
+// and ErrTimeoutReceiptWritten is returned to indicate that the packet must not be processed
+// by the application. The state changes must still be committed in this case.
 func (k Keeper) RecvPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
 	packet exported.PacketI,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.recvPacket(ctx, chanCap, packet, func(connectionEnd connectiontypes.ConnectionEnd, commitment []byte) error {
+		return k.connectionKeeper.VerifyPacketCommitment(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			commitment,
+		)
+	})
+}
+
+// RecvPackets is called by core IBC in order to receive a batch of IBC packets sent on the same
+// channel end on the counterparty chain. The commitments are proven either by a single batch proof,
+// which requires the client to support batch proof verification, or by a proof for each packet at the
+// same index. The proofs are verified for the entire batch before any packet is received and an error
+// is returned if the verification fails, in which case no packet is received. Each packet is then received in its own cached context without further
+// proof verification, and the result of receiving the packet at each index is returned at the same
+// index, following the semantics of RecvPacket.
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	proofs [][]byte,
+	proofHeight exported.Height,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrChannelNotFound, channelID)
+	}
+
+	sequences := make([]uint64, len(packets))
+	commitments := make([][]byte, len(packets))
+	for i, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"all packets of a batch must be received on the same channel, expected (%s, %s), got (%s, %s) at index %d",
+				portID, channelID, packet.GetDestPort(), packet.GetDestChannel(), i,
+			)
+		}
+
+		if packet.GetSourcePort() != channel.Counterparty.PortId || packet.GetSourceChannel() != channel.Counterparty.ChannelId {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"packet source (%s, %s) doesn't match the counterparty (%s, %s) at index %d",
+				packet.GetSourcePort(), packet.GetSourceChannel(), channel.Counterparty.PortId, channel.Counterparty.ChannelId, i,
+			)
+		}
+
+		sequences[i] = packet.GetSequence()
+		commitments[i] = types.CommitPacket(k.cdc, packet)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	// verify that the counterparty did commit to sending every packet of the batch
+	if err := k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight, proofs,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+		commitments,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	results := make([]error, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+		// the packet commitment has been verified above as part of the batch
+		err := k.recvPacket(cacheCtx, chanCap, packet, func(connectiontypes.ConnectionEnd, []byte) error { return nil })
+		if err == nil || errors.Is(err, types.ErrTimeoutReceiptWritten) {
+			writeFn()
+		}
+
+		results[i] = err
+	}
+
+	return results, nil
+}
+
+// recvPacket receives the provided packet, using verifyCommitment to verify that the
+// counterparty committed to sending the packet.
+func (k Keeper) recvPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyCommitment func(connectionEnd connectiontypes.ConnectionEnd, commitment []byte) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
@@ -179,21 +322,20 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timed out by comparing it with the latest height of the chain
+	// check if packet timed out by comparing it with the latest height of the chain.
+	// A timed out packet may only be received on an ORDERED_ALLOW_TIMEOUT channel, in which
+	// case it is skipped by writing a timeout receipt instead of executing the application callback.
 	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
+	timedOut := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timedOut && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
 		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
 	}
 
 	commitment := types.CommitPacket(k.cdc, packet)
 
 	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
+	if err := verifyCommitment(connectionEnd, commitment); err != nil {
 		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
 	}
 
@@ -210,7 +352,9 @@ func (k Keeper) RecvPacket(
 	case types.UNORDERED:
 		// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 		// on unordered channels. Packet receipts must not be pruned, unless it has been marked stale
-		// by the increase of the recvStartSequence.
+		// by the increase of the recvStartSequence, or the packet can no longer be received as its
+		// timeout has elapsed, the retention period of the receipt retention policy has passed and
+		// the deletion of the packet commitment on the counterparty has been proven.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
 			emitRecvPacketEvent(ctx, packet, channel)
@@ -225,8 +369,9 @@ func (k Keeper) RecvPacket(
 		// This receipt does not contain any data, since the packet has not yet been processed,
 		// it's just a single store key set to a single byte to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		k.setReceiptExpiry(ctx, packet)
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -262,6 +407,28 @@ func (k Keeper) RecvPacket(
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
 	}
 
+	if timedOut {
+		// The packet timed out on an ORDERED_ALLOW_TIMEOUT channel. The next sequence receive has been
+		// incremented past the packet, and the timeout receipt allows the sending end to time out the
+		// packet without closing the channel.
+		k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+		k.Logger(ctx).Info(
+			"timeout receipt written",
+			"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+			"src_port", packet.GetSourcePort(),
+			"src_channel", packet.GetSourceChannel(),
+			"dst_port", packet.GetDestPort(),
+			"dst_channel", packet.GetDestChannel(),
+		)
+
+		emitWriteTimeoutReceiptEvent(ctx, packet, channel)
+
+		// This error indicates that the packet timed out and was skipped. Core IBC will
+		// commit the timeout receipt without executing the application callback.
+		return types.ErrTimeoutReceiptWritten
+	}
+
 	// log that a packet has been received & executed
 	k.Logger(ctx).Info(
 		"packet received",
@@ -355,7 +522,7 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED and ORDERED_ALLOW_TIMEOUT channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -363,6 +530,107 @@ func (k Keeper) AcknowledgePacket(
 	acknowledgement []byte,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.acknowledgePacket(ctx, chanCap, packet, func(connectionEnd connectiontypes.ConnectionEnd) error {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(
+			ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+			packet.GetSequence(), acknowledgement,
+		)
+	})
+}
+
+// AcknowledgePackets is called by core IBC in order to process the acknowledgements of a batch of
+// packets sent on the same channel. The acknowledgements are proven either by a single batch proof,
+// which requires the client to support batch proof verification, or by a proof for each packet at the
+// same index. The proofs are verified for the entire batch before any acknowledgement is processed and
+// an error is returned if the verification fails, in which case no acknowledgement is processed. Each acknowledgement is then processed in its own cached
+// context without further proof verification, following the semantics of AcknowledgePacket, and
+// onAcknowledgePacket, if not nil, is executed in the same cached context once the acknowledgement
+// has been processed. The state changes of a packet are only written if both steps succeed, and the
+// first error returned by either step for the packet at each index is returned at the same index.
+func (k Keeper) AcknowledgePackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	acknowledgements [][]byte,
+	proofs [][]byte,
+	proofHeight exported.Height,
+	onAcknowledgePacket func(ctx sdk.Context, packet types.Packet, acknowledgement []byte) error,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	if len(packets) != len(acknowledgements) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements (%d) must equal number of packets (%d)", len(acknowledgements), len(packets))
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	sequences := make([]uint64, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"all packets of a batch must be sent on the same channel, expected (%s, %s), got (%s, %s) at index %d",
+				portID, channelID, packet.GetSourcePort(), packet.GetSourceChannel(), i,
+			)
+		}
+
+		if packet.GetDestPort() != channel.Counterparty.PortId || packet.GetDestChannel() != channel.Counterparty.ChannelId {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"packet destination (%s, %s) doesn't match the counterparty (%s, %s) at index %d",
+				packet.GetDestPort(), packet.GetDestChannel(), channel.Counterparty.PortId, channel.Counterparty.ChannelId, i,
+			)
+		}
+
+		sequences[i] = packet.GetSequence()
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if err := k.connectionKeeper.VerifyPacketAcknowledgements(
+		ctx, connectionEnd, proofHeight, proofs,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+		acknowledgements,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet acknowledgements")
+	}
+
+	results := make([]error, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+		// the acknowledgement has been verified above as part of the batch
+		err := k.acknowledgePacket(cacheCtx, chanCap, packet, func(connectiontypes.ConnectionEnd) error { return nil })
+		if err == nil && onAcknowledgePacket != nil {
+			err = onAcknowledgePacket(cacheCtx, packet, acknowledgements[i])
+		}
+
+		if err == nil {
+			writeFn()
+		}
+
+		results[i] = err
+	}
+
+	return results, nil
+}
+
+// acknowledgePacket processes the acknowledgement of the provided packet, using
+// verifyAcknowledgement to verify that the counterparty wrote the acknowledgement.
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyAcknowledgement func(connectionEnd connectiontypes.ConnectionEnd) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -430,15 +698,12 @@ func (k Keeper) AcknowledgePacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
-		packet.GetSequence(), acknowledgement,
-	); err != nil {
+	if err := verifyAcknowledgement(connectionEnd); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering.IsOrdered() {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -465,6 +730,7 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.initPacketStatusStartSequence(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 
@@ -283,6 +284,138 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 	}
 }
 
+// TestSendPacketWithRelativeTimeout tests SendPacketWithRelativeTimeout from chainA to chainB
+func (suite *KeeperTestSuite) TestSendPacketWithRelativeTimeout() {
+	var (
+		path            *ibctesting.Path
+		relativeTimeout types.RelativeTimeout
+		channelCap      *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: relative timeout height and timestamp",
+			func() {},
+			nil,
+		},
+		{
+			"success: relative timeout height only",
+			func() {
+				relativeTimeout = types.NewRelativeTimeout(10, 0)
+			},
+			nil,
+		},
+		{
+			"success: relative timeout timestamp only",
+			func() {
+				relativeTimeout = types.NewRelativeTimeout(0, uint64(time.Hour.Nanoseconds()))
+			},
+			nil,
+		},
+		{
+			"success: latest consensus timestamp of the counterparty client is later than the block time",
+			func() {
+				suite.chainA.ProposedHeader.Time = suite.chainA.ProposedHeader.Time.Add(-time.Hour)
+			},
+			nil,
+		},
+		{
+			"failure: relative timeout height and timestamp are zero",
+			func() {
+				relativeTimeout = types.NewRelativeTimeout(0, 0)
+			},
+			types.ErrInvalidTimeout,
+		},
+		{
+			"failure: channel is not OPEN",
+			func() {
+				err := path.EndpointA.SetChannelState(types.CLOSED)
+				suite.Require().NoError(err)
+			},
+			types.ErrInvalidChannelState,
+		},
+		{
+			"failure: channel capability not found",
+			func() {
+				channelCap = capabilitytypes.NewCapability(5)
+			},
+			types.ErrChannelCapabilityNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			relativeTimeout = types.NewRelativeTimeout(10, uint64(time.Hour.Nanoseconds()))
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			sequence, timeout, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacketWithRelativeTimeout(ctx, channelCap,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, relativeTimeout, ibctesting.MockPacketData)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				// the timeout is resolved against the latest height of the counterparty client and the later of
+				// the block time and the latest consensus timestamp of the counterparty client
+				clientState := path.EndpointA.GetClientState()
+				latestHeight := clientState.GetLatestHeight().(clienttypes.Height)
+				latestTimestamp, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetTimestampAtHeight(ctx, path.EndpointA.GetConnection(), latestHeight)
+				suite.Require().NoError(err)
+				referenceTimestamp := max(uint64(ctx.BlockTime().UnixNano()), latestTimestamp)
+				suite.Require().Equal(relativeTimeout.Resolve(latestHeight, referenceTimestamp), timeout)
+
+				// the packet commitment commits to the resolved timeout
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeout.Height, timeout.Timestamp)
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(types.CommitPacket(suite.chainA.App.AppCodec(), packet), commitment)
+
+				// the send packet event contains both the resolved and relative timeout values
+				expAttributes := map[string]string{
+					types.AttributeKeyTimeoutHeight:            timeout.Height.String(),
+					types.AttributeKeyTimeoutTimestamp:         fmt.Sprintf("%d", timeout.Timestamp),
+					types.AttributeKeyRelativeTimeoutHeight:    fmt.Sprintf("%d", relativeTimeout.RevisionHeight),
+					types.AttributeKeyRelativeTimeoutTimestamp: fmt.Sprintf("%d", relativeTimeout.Timestamp),
+				}
+
+				var found bool
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type != types.EventTypeSendPacket {
+						continue
+					}
+
+					found = true
+					for _, attr := range event.Attributes {
+						if expValue, ok := expAttributes[attr.Key]; ok {
+							suite.Require().Equal(expValue, attr.Value, attr.Key)
+							delete(expAttributes, attr.Key)
+						}
+					}
+				}
+
+				suite.Require().True(found)
+				suite.Require().Empty(expAttributes)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Zero(sequence)
+				suite.Require().Equal(types.Timeout{}, timeout)
+			}
+		})
+	}
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -700,6 +833,207 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 	}
 }
 
+// TestRecvPacketOrderedAllowTimeout tests the RecvPacket call on chainB for packets sent on an
+// ORDERED_ALLOW_TIMEOUT channel. A timed out packet is skipped by writing a timeout receipt.
+func (suite *KeeperTestSuite) TestRecvPacketOrderedAllowTimeout() {
+	var (
+		path          *ibctesting.Path
+		packet        types.Packet
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expTimeout bool
+		expError   error
+	}{
+		{
+			"success: packet received", func() {
+				timeoutHeight = defaultTimeoutHeight
+			}, false, nil,
+		},
+		{
+			"success: timeout receipt written", func() {}, true, types.ErrTimeoutReceiptWritten,
+		},
+		{
+			"packet already received", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+			}, false, types.ErrNoOpMsg,
+		},
+		{
+			"packet sequence out of order", func() {
+				// a skipped packet must be received in order to advance the next sequence receive
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 0)
+			}, false, types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			// the packet times out on chainB once chainB's client on chainA is updated after sending
+			timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+
+			tc.malleate()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainB.GetContext(), channelCap, packet, proof, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			// the next sequence receive is incremented for both received and skipped packets
+			if tc.expError == nil || tc.expTimeout {
+				nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented in ORDERED_ALLOW_TIMEOUT channel")
+
+				receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().Equal(tc.expTimeout, receiptStored)
+				if tc.expTimeout {
+					suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		packetKeys [][]byte
+		proofs     [][]byte
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expErrors []error
+		expError  error
+	}{
+		{
+			"success", func() {}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: proof of each packet", func() {
+				proofs = nil
+				for _, key := range packetKeys {
+					proof, _ := path.EndpointA.QueryProof(key)
+					proofs = append(proofs, proof)
+				}
+			}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: packet already received", func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packets[1].GetSequence())
+			}, []error{nil, types.ErrNoOpMsg, nil}, nil,
+		},
+		{
+			"success: packet sequence greater than counterparty next sequence send", func() {
+				upgrade := types.Upgrade{NextSequenceSend: packets[2].GetSequence()}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, upgrade)
+			}, []error{nil, nil, types.ErrInvalidPacket}, nil,
+		},
+		{
+			"channel not found", func() {
+				for i := range packets {
+					packets[i].DestinationChannel = ibctesting.InvalidID
+				}
+			}, nil, types.ErrChannelNotFound,
+		},
+		{
+			"packets received on different channels", func() {
+				packets[1].DestinationChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"packet source channel does not match the counterparty", func() {
+				packets[1].SourceChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"invalid proof", func() {
+				proofs = [][]byte{[]byte("invalid proof")}
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"number of proofs does not match number of packets", func() {
+				proofs = append(proofs, proofs[0])
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"packet data does not match commitment", func() {
+				packets[0].Data = []byte("invalid packet data")
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, packetKeys = nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				packets = append(packets, packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			proof, proofHeight := path.EndpointA.QueryBatchProof(packetKeys...)
+			proofs = [][]byte{proof}
+
+			tc.malleate()
+
+			channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			results, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPackets(suite.chainB.GetContext(), channelCap, packets, proofs, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, len(packets))
+				for i, expErr := range tc.expErrors {
+					if expErr == nil {
+						suite.Require().NoError(results[i])
+					} else {
+						suite.Require().ErrorIs(results[i], expErr)
+					}
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(results)
+			}
+
+			for i, packet := range packets {
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence())
+				expReceipt := tc.expError == nil && (tc.expErrors[i] == nil || tc.expErrors[i] == types.ErrNoOpMsg)
+				suite.Require().Equal(expReceipt, found)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	var (
 		path       *ibctesting.Path
@@ -1352,3 +1686,131 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAcknowledgePackets() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+		acks    [][]byte
+		ackKeys [][]byte
+		proofs  [][]byte
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expErrors []error
+		expError  error
+	}{
+		{
+			"success", func() {}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: proof of each acknowledgement", func() {
+				proofs = nil
+				for _, key := range ackKeys {
+					proof, _ := path.EndpointB.QueryProof(key)
+					proofs = append(proofs, proof)
+				}
+			}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: packet already acknowledged", func() {
+				err := path.EndpointA.AcknowledgePacket(packets[1], ibctesting.MockAcknowledgement)
+				suite.Require().NoError(err)
+			}, []error{nil, types.ErrNoOpMsg, nil}, nil,
+		},
+		{
+			"success: packet does not match commitment", func() {
+				packets[2].Data = []byte("invalid packet data")
+			}, []error{nil, nil, types.ErrInvalidPacket}, nil,
+		},
+		{
+			"channel not found", func() {
+				for i := range packets {
+					packets[i].SourceChannel = ibctesting.InvalidID
+				}
+			}, nil, types.ErrChannelNotFound,
+		},
+		{
+			"packets sent on different channels", func() {
+				packets[1].SourceChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"packet destination channel does not match the counterparty", func() {
+				packets[1].DestinationChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"number of acknowledgements does not match number of packets", func() {
+				acks = acks[1:]
+			}, nil, types.ErrInvalidAcknowledgement,
+		},
+		{
+			"invalid proof", func() {
+				proofs = [][]byte{[]byte("invalid proof")}
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"acknowledgement does not match proof", func() {
+				acks[0] = []byte("invalid acknowledgement")
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, acks = nil, nil
+			ackKeys = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+				acks = append(acks, ibctesting.MockAcknowledgement)
+				ackKeys = append(ackKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(ackKeys...)
+			proofs = [][]byte{proof}
+
+			tc.malleate()
+
+			channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			results, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePackets(suite.chainA.GetContext(), channelCap, packets, acks, proofs, proofHeight, nil)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, len(packets))
+				for i, expErr := range tc.expErrors {
+					if expErr == nil {
+						suite.Require().NoError(results[i])
+					} else {
+						suite.Require().ErrorIs(results[i], expErr)
+					}
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(results)
+			}
+
+			for i, packet := range packets {
+				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				expAcknowledged := tc.expError == nil && (tc.expErrors[i] == nil || tc.expErrors[i] == types.ErrNoOpMsg)
+				suite.Require().Equal(!expAcknowledged, has)
+			}
+		})
+	}
+}
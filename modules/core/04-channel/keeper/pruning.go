@@ -0,0 +1,359 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetReceiptPruningProgress returns the progress of the scheduled pruning of packet receipts.
+func (k Keeper) GetReceiptPruningProgress(ctx sdk.Context) types.ReceiptPruningProgress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyReceiptPruningProgress))
+	if len(bz) == 0 {
+		return types.ReceiptPruningProgress{}
+	}
+
+	var progress types.ReceiptPruningProgress
+	k.cdc.MustUnmarshal(bz, &progress)
+	return progress
+}
+
+// SetReceiptPruningProgress sets the progress of the scheduled pruning of packet receipts.
+func (k Keeper) SetReceiptPruningProgress(ctx sdk.Context, progress types.ReceiptPruningProgress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&progress)
+	store.Set([]byte(types.KeyReceiptPruningProgress), bz)
+}
+
+// setReceiptExpiry records the expiry of the receipt of a packet received on an unordered channel, past which
+// the receipt, along with its acknowledgement, may be pruned once it is scheduled to be pruned. The timeout
+// timestamp of the packet is used if it is set, otherwise the timeout height is used. The expiry is not
+// recorded while scheduled pruning is disabled.
+func (k Keeper) setReceiptExpiry(ctx sdk.Context, packet exported.PacketI) {
+	retention := k.GetParams(ctx).ReceiptRetention
+	if !retention.IsEnabled() {
+		return
+	}
+
+	expiry := types.NewScheduledReceipt(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), 0, clienttypes.ZeroHeight())
+	if timeoutTimestamp := packet.GetTimeoutTimestamp(); timeoutTimestamp != 0 {
+		expiry.Timestamp = saturatingAdd(timeoutTimestamp, retention.TimePeriod)
+	} else {
+		timeoutHeight := packet.GetTimeoutHeight()
+		expiry.Height = clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), saturatingAdd(timeoutHeight.GetRevisionHeight(), retention.HeightPeriod))
+	}
+
+	k.SetReceiptExpiry(ctx, expiry)
+}
+
+// SetReceiptExpiry sets the expiry of a packet receipt which is not yet scheduled to be pruned.
+func (k Keeper) SetReceiptExpiry(ctx sdk.Context, expiry types.ScheduledReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&expiry)
+	store.Set(types.ReceiptExpiryKey(expiry.PortId, expiry.ChannelId, expiry.Sequence), bz)
+}
+
+// GetReceiptExpiry gets the expiry of a packet receipt which is not yet scheduled to be pruned. False is
+// returned if no expiry is recorded for the receipt.
+func (k Keeper) GetReceiptExpiry(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ScheduledReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReceiptExpiryKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.ScheduledReceipt{}, false
+	}
+
+	var expiry types.ScheduledReceipt
+	k.cdc.MustUnmarshal(bz, &expiry)
+	return expiry, true
+}
+
+// deleteReceiptExpiry deletes the expiry of a packet receipt from the store.
+func (k Keeper) deleteReceiptExpiry(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReceiptExpiryKey(portID, channelID, sequence))
+}
+
+// GetAllReceiptExpiries returns the expiries of all packet receipts which are not yet scheduled to be pruned.
+func (k Keeper) GetAllReceiptExpiries(ctx sdk.Context) []types.ScheduledReceipt {
+	store := ctx.KVStore(k.storeKey)
+
+	var expiries []types.ScheduledReceipt
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyReceiptExpiryPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var expiry types.ScheduledReceipt
+		k.cdc.MustUnmarshal(iterator.Value(), &expiry)
+		expiries = append(expiries, expiry)
+	}
+
+	return expiries
+}
+
+// ScheduleReceiptPruning schedules the receipts of packets received on an UNORDERED channel, along with their
+// acknowledgements, to be pruned at their recorded expiry. The receipt of a packet which was received may only
+// be pruned once the packet commitment has been deleted on the counterparty, as the absence of the receipt
+// would otherwise allow the packet to be timed out on the sending chain after it was received. The absence of
+// the packet commitments of all sequences on the counterparty is proven either by a single batch proof, which
+// requires the client to support batch proof verification, or by a proof for each sequence at the same index.
+// Sequences whose receipt is already scheduled to be pruned, or which were not received, are skipped. The
+// number of receipts scheduled to be pruned is returned.
+func (k Keeper) ScheduleReceiptPruning(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	sequences []uint64,
+	proofs [][]byte,
+	proofHeight exported.Height,
+) (uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "packet receipts are only written on %s channels, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	// verify that the counterparty deleted the packet commitments of all sequences
+	if err := k.connectionKeeper.VerifyPacketCommitmentAbsences(
+		ctx, connectionEnd, proofHeight, proofs,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+	); err != nil {
+		return 0, err
+	}
+
+	var scheduled uint64
+	for _, sequence := range sequences {
+		expiry, found := k.GetReceiptExpiry(ctx, portID, channelID, sequence)
+		if !found {
+			continue
+		}
+
+		k.deleteReceiptExpiry(ctx, portID, channelID, sequence)
+
+		// the receipt may have been pruned since its expiry was recorded, for example after a channel upgrade
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); !found {
+			continue
+		}
+
+		k.SetScheduledReceipt(ctx, expiry)
+		scheduled++
+	}
+
+	if scheduled > 0 {
+		progress := k.GetReceiptPruningProgress(ctx)
+		progress.Pending += scheduled
+		k.SetReceiptPruningProgress(ctx, progress)
+	}
+
+	return scheduled, nil
+}
+
+// SetScheduledReceipt schedules the packet receipt to be pruned at the timestamp of the scheduled receipt
+// if it is set, and at its height otherwise. The progress of the scheduled pruning is not updated.
+func (k Keeper) SetScheduledReceipt(ctx sdk.Context, receipt types.ScheduledReceipt) {
+	packetID := types.NewPacketID(receipt.PortId, receipt.ChannelId, receipt.Sequence)
+	bz := k.cdc.MustMarshal(&packetID)
+
+	store := ctx.KVStore(k.storeKey)
+	if receipt.Timestamp != 0 {
+		store.Set(types.ReceiptPruningTimeQueueKey(receipt.Timestamp, receipt.PortId, receipt.ChannelId, receipt.Sequence), bz)
+	} else {
+		store.Set(types.ReceiptPruningHeightQueueKey(receipt.Height, receipt.PortId, receipt.ChannelId, receipt.Sequence), bz)
+	}
+}
+
+// GetAllScheduledReceipts returns all packet receipts scheduled to be pruned, those scheduled by
+// timestamp first, each in the order in which they are pruned.
+func (k Keeper) GetAllScheduledReceipts(ctx sdk.Context) []types.ScheduledReceipt {
+	store := ctx.KVStore(k.storeKey)
+
+	var receipts []types.ScheduledReceipt
+	timeQueuePrefix := []byte(types.KeyReceiptPruningTimeQueue + "/")
+	iterator := storetypes.KVStorePrefixIterator(store, timeQueuePrefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packetID types.PacketId
+		k.cdc.MustUnmarshal(iterator.Value(), &packetID)
+
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(timeQueuePrefix) : len(timeQueuePrefix)+8])
+		receipts = append(receipts, types.NewScheduledReceipt(packetID.PortId, packetID.ChannelId, packetID.Sequence, timestamp, clienttypes.ZeroHeight()))
+	}
+
+	heightQueuePrefix := []byte(types.KeyReceiptPruningHeightQueue + "/")
+	heightIterator := storetypes.KVStorePrefixIterator(store, heightQueuePrefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return heightIterator.Close() })
+
+	for ; heightIterator.Valid(); heightIterator.Next() {
+		var packetID types.PacketId
+		k.cdc.MustUnmarshal(heightIterator.Value(), &packetID)
+
+		key := heightIterator.Key()[len(heightQueuePrefix):]
+		height := clienttypes.NewHeight(sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:16]))
+		receipts = append(receipts, types.NewScheduledReceipt(packetID.PortId, packetID.ChannelId, packetID.Sequence, 0, height))
+	}
+
+	return receipts
+}
+
+// PruneExpiredReceipts prunes the packet receipts and acknowledgements whose retention period has elapsed.
+// The number of receipts pruned is bounded by the max pruned per block parameter of the receipt retention
+// policy. Receipts scheduled by timestamp are pruned before those scheduled by height. The number of
+// receipts pruned is returned.
+func (k Keeper) PruneExpiredReceipts(ctx sdk.Context) uint64 {
+	limit := k.GetParams(ctx).ReceiptRetention.MaxPrunedPerBlock
+	if limit == 0 {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// the end keys are exclusive, all receipts due at or before the current block time and height are iterated over
+	timeEnd := storetypes.PrefixEndBytes(types.ReceiptPruningTimeQueuePrefix(uint64(ctx.BlockTime().UnixNano())))
+	queueKeys := k.expiredReceiptKeys(ctx, []byte(types.KeyReceiptPruningTimeQueue+"/"), timeEnd, limit)
+
+	if remaining := limit - uint64(len(queueKeys)); remaining > 0 {
+		heightEnd := storetypes.PrefixEndBytes(types.ReceiptPruningHeightQueuePrefix(clienttypes.GetSelfHeight(ctx)))
+		queueKeys = append(queueKeys, k.expiredReceiptKeys(ctx, []byte(types.KeyReceiptPruningHeightQueue+"/"), heightEnd, remaining)...)
+	}
+
+	if len(queueKeys) == 0 {
+		return 0
+	}
+
+	for _, queueKey := range queueKeys {
+		var packetID types.PacketId
+		k.cdc.MustUnmarshal(store.Get(queueKey), &packetID)
+
+		k.deletePacketAcknowledgement(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		k.deletePacketReceipt(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		store.Delete(queueKey)
+	}
+
+	pruned := uint64(len(queueKeys))
+
+	progress := k.GetReceiptPruningProgress(ctx)
+	if progress.Pending > pruned {
+		progress.Pending -= pruned
+	} else {
+		progress.Pending = 0
+	}
+	progress.TotalPruned += pruned
+	progress.LastPrunedHeight = ctx.BlockHeight()
+	k.SetReceiptPruningProgress(ctx, progress)
+
+	k.Logger(ctx).Info("pruned expired packet receipts", "pruned", pruned, "pending", progress.Pending)
+
+	return pruned
+}
+
+// scheduleTimedOutPacketPruning schedules the record of a packet timed out to be pruned once the time period
+// of the receipt retention policy has elapsed. Records are not scheduled while scheduled pruning is disabled.
+func (k Keeper) scheduleTimedOutPacketPruning(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	retention := k.GetParams(ctx).ReceiptRetention
+	if !retention.IsEnabled() {
+		return
+	}
+
+	expiry := saturatingAdd(uint64(ctx.BlockTime().UnixNano()), retention.TimePeriod)
+	k.SetScheduledTimedOutPacket(ctx, types.NewScheduledReceipt(portID, channelID, sequence, expiry, clienttypes.ZeroHeight()))
+}
+
+// SetScheduledTimedOutPacket schedules the record of a packet timed out to be pruned at the timestamp of the
+// given scheduled receipt.
+func (k Keeper) SetScheduledTimedOutPacket(ctx sdk.Context, scheduled types.ScheduledReceipt) {
+	packetID := types.NewPacketID(scheduled.PortId, scheduled.ChannelId, scheduled.Sequence)
+	bz := k.cdc.MustMarshal(&packetID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TimedOutPacketPruningQueueKey(scheduled.Timestamp, scheduled.PortId, scheduled.ChannelId, scheduled.Sequence), bz)
+}
+
+// GetAllScheduledTimedOutPackets returns the records of all packets timed out scheduled to be pruned,
+// in the order in which they are pruned.
+func (k Keeper) GetAllScheduledTimedOutPackets(ctx sdk.Context) []types.ScheduledReceipt {
+	store := ctx.KVStore(k.storeKey)
+
+	var scheduled []types.ScheduledReceipt
+	queuePrefix := []byte(types.KeyTimedOutPacketPruningQueue + "/")
+	iterator := storetypes.KVStorePrefixIterator(store, queuePrefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packetID types.PacketId
+		k.cdc.MustUnmarshal(iterator.Value(), &packetID)
+
+		timestamp := sdk.BigEndianToUint64(iterator.Key()[len(queuePrefix) : len(queuePrefix)+8])
+		scheduled = append(scheduled, types.NewScheduledReceipt(packetID.PortId, packetID.ChannelId, packetID.Sequence, timestamp, clienttypes.ZeroHeight()))
+	}
+
+	return scheduled
+}
+
+// PruneExpiredTimedOutPackets prunes the records of packets timed out whose retention period has elapsed.
+// The number of records pruned is bounded by the max pruned per block parameter of the receipt retention
+// policy. The packet status start sequence of the channel is moved past each pruned packet, as the outcome
+// of the packets sent before it is no longer known. The number of records pruned is returned.
+func (k Keeper) PruneExpiredTimedOutPackets(ctx sdk.Context) uint64 {
+	limit := k.GetParams(ctx).ReceiptRetention.MaxPrunedPerBlock
+	if limit == 0 {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	end := storetypes.PrefixEndBytes(types.TimedOutPacketPruningQueuePrefix(uint64(ctx.BlockTime().UnixNano())))
+	queueKeys := k.expiredReceiptKeys(ctx, []byte(types.KeyTimedOutPacketPruningQueue+"/"), end, limit)
+
+	for _, queueKey := range queueKeys {
+		var packetID types.PacketId
+		k.cdc.MustUnmarshal(store.Get(queueKey), &packetID)
+
+		k.deleteTimedOutPacket(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence)
+		if startSequence, found := k.GetPacketStatusStartSequence(ctx, packetID.PortId, packetID.ChannelId); !found || startSequence <= packetID.Sequence {
+			k.SetPacketStatusStartSequence(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence+1)
+		}
+		store.Delete(queueKey)
+	}
+
+	return uint64(len(queueKeys))
+}
+
+// expiredReceiptKeys returns at most limit keys of the pruning queue in the range [start, end).
+// The keys are collected before any of them is deleted, as the store must not be written to while it is iterated over.
+func (k Keeper) expiredReceiptKeys(ctx sdk.Context, start, end []byte, limit uint64) [][]byte {
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	return keys
+}
+
+// saturatingAdd returns the sum of a and b, or the maximum uint64 value if the sum overflows.
+func saturatingAdd(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+
+	return a + b
+}
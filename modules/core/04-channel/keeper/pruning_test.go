@@ -0,0 +1,406 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestPruneExpiredReceipts() {
+	var (
+		path      *ibctesting.Path
+		retention types.ReceiptRetention
+		sequences []uint64
+	)
+
+	setRetention := func() {
+		channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+		params := channelKeeper.GetParams(suite.chainA.GetContext())
+		params.ReceiptRetention = retention
+		channelKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	// sendPackets relays packets from B to A, creating packet receipts and acks on A.
+	sendPackets := func(numPackets int, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) {
+		for i := 0; i < numPackets; i++ {
+			sequence, err := path.EndpointB.SendPacket(timeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, timeoutTimestamp)
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			sequences = append(sequences, sequence)
+		}
+	}
+
+	// scheduleReceipts schedules the receipts on A to be pruned with a proof of the deletion of the packet commitments on B.
+	scheduleReceipts := func() {
+		suite.coordinator.CommitBlock(suite.chainB)
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+
+		var keys [][]byte
+		for _, sequence := range sequences {
+			keys = append(keys, host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+		}
+		proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+
+		_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ScheduleReceiptPruning(
+			suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, [][]byte{proof}, proofHeight,
+		)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPruned   uint64
+		expProgress types.ReceiptPruningProgress
+	}{
+		{
+			"success: receipts scheduled by timestamp pruned up to limit",
+			func() {
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(10 * time.Minute).UnixNano())
+				sendPackets(3, clienttypes.ZeroHeight(), timeoutTimestamp)
+				scheduleReceipts()
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			2,
+			types.ReceiptPruningProgress{Pending: 1, TotalPruned: 2},
+		},
+		{
+			"success: receipts scheduled by height pruned",
+			func() {
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext()).Increment().(clienttypes.Height)
+				timeoutHeight.RevisionHeight += 10
+				sendPackets(2, timeoutHeight, 0)
+				scheduleReceipts()
+
+				suite.coordinator.CommitNBlocks(suite.chainA, 20)
+			},
+			2,
+			types.ReceiptPruningProgress{Pending: 0, TotalPruned: 2},
+		},
+		{
+			"success: receipts retained until retention period elapsed",
+			func() {
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(10 * time.Minute).UnixNano())
+				sendPackets(2, clienttypes.ZeroHeight(), timeoutTimestamp)
+				scheduleReceipts()
+
+				suite.coordinator.IncrementTimeBy(10 * time.Minute)
+			},
+			0,
+			types.ReceiptPruningProgress{Pending: 2},
+		},
+		{
+			"success: receipts retained until the deletion of the counterparty packet commitments is proven",
+			func() {
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(10 * time.Minute).UnixNano())
+				sendPackets(2, clienttypes.ZeroHeight(), timeoutTimestamp)
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			0,
+			types.ReceiptPruningProgress{},
+		},
+		{
+			"success: receipts not scheduled while pruning is disabled",
+			func() {
+				retention.MaxPrunedPerBlock = 0
+				setRetention()
+
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(10 * time.Minute).UnixNano())
+				sendPackets(2, clienttypes.ZeroHeight(), timeoutTimestamp)
+				scheduleReceipts()
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			0,
+			types.ReceiptPruningProgress{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			sequences = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			retention = types.NewReceiptRetention(15, uint64((30 * time.Minute).Nanoseconds()), 2)
+			setRetention()
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.PruneExpiredReceipts(suite.chainA.GetContext())
+
+			progress := channelKeeper.GetReceiptPruningProgress(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expProgress.Pending, progress.Pending)
+			suite.Require().Equal(tc.expProgress.TotalPruned, progress.TotalPruned)
+
+			for i, sequence := range sequences {
+				_, found := channelKeeper.GetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(uint64(i) >= tc.expPruned, found)
+
+				found = channelKeeper.HasPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(uint64(i) >= tc.expPruned, found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestScheduleReceiptPruning() {
+	var (
+		path      *ibctesting.Path
+		portID    string
+		sequences []uint64
+	)
+
+	// recvPacket sends a packet from B to A and receives it on A, optionally acknowledging it on B.
+	recvPacket := func(acknowledge bool) uint64 {
+		timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(10 * time.Minute).UnixNano())
+		sequence, err := path.EndpointB.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+		if acknowledge {
+			suite.Require().NoError(path.RelayPacket(packet))
+		} else {
+			suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+		}
+
+		return sequence
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expScheduled uint64
+		expError     error
+	}{
+		{
+			"success",
+			func() {},
+			2,
+			nil,
+		},
+		{
+			"success: sequences which were not received are skipped",
+			func() {
+				sequences = append(sequences, sequences[len(sequences)-1]+1)
+			},
+			2,
+			nil,
+		},
+		{
+			"success: receipts whose expiry is not recorded are skipped",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				channelKeeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+
+				sequences = append(sequences, recvPacket(true))
+			},
+			2,
+			nil,
+		},
+		{
+			"failure: packet commitment has not been deleted on the counterparty",
+			func() {
+				sequences = append(sequences, recvPacket(false))
+			},
+			0,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				portID = ibctesting.InvalidID
+			},
+			0,
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is not UNORDERED",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointA.SetChannel(channel)
+			},
+			0,
+			types.ErrInvalidChannelOrdering,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainA.GetContext())
+			params.ReceiptRetention = types.NewReceiptRetention(15, uint64((30 * time.Minute).Nanoseconds()), 2)
+			channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			portID = path.EndpointA.ChannelConfig.PortID
+			sequences = []uint64{recvPacket(true), recvPacket(true)}
+
+			tc.malleate()
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			var keys [][]byte
+			for _, sequence := range sequences {
+				keys = append(keys, host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+			}
+			proof, proofHeight := path.EndpointB.QueryBatchProof(keys...)
+
+			scheduled, err := channelKeeper.ScheduleReceiptPruning(suite.chainA.GetContext(), portID, path.EndpointA.ChannelID, sequences, [][]byte{proof}, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expScheduled, scheduled)
+				suite.Require().Len(channelKeeper.GetAllScheduledReceipts(suite.chainA.GetContext()), int(tc.expScheduled))
+				suite.Require().Empty(channelKeeper.GetAllReceiptExpiries(suite.chainA.GetContext()))
+				suite.Require().Equal(tc.expScheduled, channelKeeper.GetReceiptPruningProgress(suite.chainA.GetContext()).Pending)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Empty(channelKeeper.GetAllScheduledReceipts(suite.chainA.GetContext()))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredTimedOutPackets() {
+	var (
+		path      *ibctesting.Path
+		retention types.ReceiptRetention
+		sequences []uint64
+	)
+
+	setRetention := func() {
+		channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+		params := channelKeeper.GetParams(suite.chainA.GetContext())
+		params.ReceiptRetention = retention
+		channelKeeper.SetParams(suite.chainA.GetContext(), params)
+	}
+
+	// timeoutPackets sends packets from A to B and times them out on A, recording them as timed out.
+	timeoutPackets := func(numPackets int) {
+		for i := 0; i < numPackets; i++ {
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+			sequence, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+			sequences = append(sequences, sequence)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned bool
+	}{
+		{
+			"success: records pruned once retention period elapsed",
+			func() {
+				timeoutPackets(2)
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			true,
+		},
+		{
+			"success: records retained until retention period elapsed",
+			func() {
+				timeoutPackets(2)
+
+				suite.coordinator.IncrementTimeBy(10 * time.Minute)
+			},
+			false,
+		},
+		{
+			"success: records not scheduled while pruning is disabled",
+			func() {
+				retention.MaxPrunedPerBlock = 0
+				setRetention()
+
+				timeoutPackets(2)
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			sequences = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			retention = types.NewReceiptRetention(15, uint64((30 * time.Minute).Nanoseconds()), 2)
+			setRetention()
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.PruneExpiredTimedOutPackets(suite.chainA.GetContext())
+
+			for _, sequence := range sequences {
+				_, found := channelKeeper.GetTimedOutPacket(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(!tc.expPruned, found)
+			}
+
+			// the outcome of the packets whose records have been pruned is no longer known
+			expStartSequence := sequences[0]
+			if tc.expPruned {
+				expStartSequence = sequences[len(sequences)-1] + 1
+			}
+
+			startSequence, found := channelKeeper.GetPacketStatusStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(expStartSequence, startSequence)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllScheduledReceipts() {
+	suite.SetupTest()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	ctx := suite.chainA.GetContext()
+
+	expReceipts := []types.ScheduledReceipt{
+		types.NewScheduledReceipt(ibctesting.MockPort, ibctesting.FirstChannelID, 2, 100, clienttypes.ZeroHeight()),
+		types.NewScheduledReceipt(ibctesting.MockPort, ibctesting.FirstChannelID, 1, 200, clienttypes.ZeroHeight()),
+		types.NewScheduledReceipt(ibctesting.MockPort, ibctesting.FirstChannelID, 3, 0, clienttypes.NewHeight(1, 100)),
+		types.NewScheduledReceipt(ibctesting.MockPort, ibctesting.FirstChannelID, 4, 0, clienttypes.NewHeight(2, 1)),
+	}
+
+	for i := len(expReceipts) - 1; i >= 0; i-- {
+		channelKeeper.SetScheduledReceipt(ctx, expReceipts[i])
+	}
+
+	suite.Require().Equal(expReceipts, channelKeeper.GetAllScheduledReceipts(ctx))
+}
@@ -28,6 +28,141 @@ func (k Keeper) TimeoutPacket(
 	proof []byte,
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
+) error {
+	return k.timeoutPacket(ctx, packet, proofHeight, func(connectionEnd connectiontypes.ConnectionEnd, ordering types.Order) error {
+		switch ordering {
+		case types.ORDERED:
+			// check that packet has not been received
+			if nextSequenceRecv > packet.GetSequence() {
+				return errorsmod.Wrapf(
+					types.ErrPacketReceived,
+					"packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.GetSequence(),
+				)
+			}
+
+			// check that the recv sequence is as claimed
+			return k.connectionKeeper.VerifyNextSequenceRecv(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		case types.UNORDERED:
+			return k.connectionKeeper.VerifyPacketReceiptAbsence(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			)
+		case types.ORDERED_ALLOW_TIMEOUT:
+			// packets are timed out in the same order as they are acknowledged
+			if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+				return err
+			}
+
+			// check that the counterparty skipped the packet by writing a timeout receipt
+			return k.connectionKeeper.VerifyPacketTimeoutReceipt(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			)
+		default:
+			panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, ordering.String()))
+		}
+	})
+}
+
+// TimeoutPackets is called by core IBC in order to time out a batch of packets sent on the same
+// UNORDERED channel. The absence of the packet receipts on the counterparty is proven either by a
+// single batch proof, which requires the client to support batch proof verification, or by a proof
+// for each packet at the same index. The proofs are verified for the entire batch before any packet
+// is timed out and an error is returned if the verification fails, in which case no packet is timed
+// out. Each packet is then timed out in its own cached context without further proof verification,
+// following the semantics of TimeoutPacket, and onTimeoutPacket, if not nil, is executed in the same
+// cached context before TimeoutExecuted. The state changes of a packet are only written if every step
+// succeeds, and the first error returned for the packet at each index is returned at the same index.
+func (k Keeper) TimeoutPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	proofs [][]byte,
+	proofHeight exported.Height,
+	onTimeoutPacket func(ctx sdk.Context, packet types.Packet) error,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "batches of timeouts are only supported on %s channels, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	sequences := make([]uint64, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"all packets of a batch must be sent on the same channel, expected (%s, %s), got (%s, %s) at index %d",
+				portID, channelID, packet.GetSourcePort(), packet.GetSourceChannel(), i,
+			)
+		}
+
+		if packet.GetDestPort() != channel.Counterparty.PortId || packet.GetDestChannel() != channel.Counterparty.ChannelId {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPacket,
+				"packet destination (%s, %s) doesn't match the counterparty (%s, %s) at index %d",
+				packet.GetDestPort(), packet.GetDestChannel(), channel.Counterparty.PortId, channel.Counterparty.ChannelId, i,
+			)
+		}
+
+		sequences[i] = packet.GetSequence()
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	// verify that the counterparty did not receive any packet of the batch
+	if err := k.connectionKeeper.VerifyPacketReceiptAbsences(
+		ctx, connectionEnd, proofHeight, proofs,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "couldn't verify counterparty packet receipt absences")
+	}
+
+	results := make([]error, len(packets))
+	for i, packet := range packets {
+		cacheCtx, writeFn := ctx.CacheContext()
+		// the absence of the packet receipt has been verified above as part of the batch
+		err := k.timeoutPacket(cacheCtx, packet, proofHeight, func(connectiontypes.ConnectionEnd, types.Order) error { return nil })
+		if err == nil && onTimeoutPacket != nil {
+			err = onTimeoutPacket(cacheCtx, packet)
+		}
+
+		if err == nil {
+			err = k.TimeoutExecuted(cacheCtx, chanCap, packet)
+		}
+
+		if err == nil {
+			writeFn()
+		}
+
+		results[i] = err
+	}
+
+	return results, nil
+}
+
+// timeoutPacket verifies that the provided packet has timed out at the proof height, using
+// verifyUnreceived to verify that the counterparty did not receive the packet on a channel
+// of the given ordering.
+func (k Keeper) timeoutPacket(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	proofHeight exported.Height,
+	verifyUnreceived func(connectionEnd connectiontypes.ConnectionEnd, ordering types.Order) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -91,31 +226,7 @@ func (k Keeper) TimeoutPacket(
 		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	switch channel.Ordering {
-	case types.ORDERED:
-		// check that packet has not been received
-		if nextSequenceRecv > packet.GetSequence() {
-			return errorsmod.Wrapf(
-				types.ErrPacketReceived,
-				"packet already received, next sequence receive > packet sequence (%d > %d)", nextSequenceRecv, packet.GetSequence(),
-			)
-		}
-
-		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
-		)
-	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
-			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
-		)
-	default:
-		panic(errorsmod.Wrapf(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
-	}
-
-	if err != nil {
+	if err := verifyUnreceived(connectionEnd, channel.Ordering); err != nil {
 		return err
 	}
 
@@ -125,6 +236,8 @@ func (k Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the next sequence
+// acknowledgement is incremented and the channel remains open.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -149,9 +262,10 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.recordPacketTimedOut(ctx, packet)
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		// once we have received the counterparty timeout in the channel UpgradeAck or UpgradeConfirm handshake steps
 		// then we can move to flushing complete if the timeout has not passed and there are no in-flight packets
@@ -172,6 +286,12 @@ func (k Keeper) TimeoutExecuted(
 		}
 	}
 
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		// the packet sequence has been validated against the next sequence acknowledgement
+		// during timeout verification
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+	}
+
 	if channel.Ordering == types.ORDERED {
 		// NOTE: if the channel is ORDERED and a packet is timed out in FLUSHING state then
 		// the upgrade is aborted and the channel is set to CLOSED.
@@ -200,6 +320,68 @@ func (k Keeper) TimeoutExecuted(
 	return nil
 }
 
+// ForceTimeoutPacket is called by the IBC authority to process a packet sent on a CLOSED channel end as though
+// it had timed out, without proof of the counterparty state. It is intended to refund the senders of packets
+// which will never be received or acknowledged because the counterparty chain has permanently halted. The
+// provided packet must match an outstanding packet commitment, which is deleted, and the packet is recorded as
+// timed out.
+//
+// WARNING: the counterparty state is not verified. If the packet was, or is later, received on the counterparty
+// chain it is processed on both chains, which for token transfers results in a double spend. It is the
+// responsibility of the authority to ensure the counterparty chain can no longer receive the packet.
+//
+// CONTRACT: the application OnTimeoutPacket callback must be executed by the caller.
+func (k Keeper) ForceTimeoutPacket(ctx sdk.Context, packet exported.PacketI) error {
+	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
+	if channel.State != types.CLOSED {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "packets may only be force timed out on a CLOSED channel, got %s", channel.State)
+	}
+
+	if packet.GetDestPort() != channel.Counterparty.PortId {
+		return errorsmod.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination port doesn't match the counterparty's port (%s ≠ %s)", packet.GetDestPort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetDestChannel() != channel.Counterparty.ChannelId {
+		return errorsmod.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetDestChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		return errorsmod.Wrapf(types.ErrPacketCommitmentNotFound, "packet with sequence (%d) has no outstanding commitment", packet.GetSequence())
+	}
+
+	packetCommitment := types.CommitPacket(k.cdc, packet)
+	if !bytes.Equal(commitment, packetCommitment) {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+	}
+
+	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.recordPacketTimedOut(ctx, packet)
+
+	k.Logger(ctx).Info(
+		"packet force timed-out",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"src_port", packet.GetSourcePort(),
+		"src_channel", packet.GetSourceChannel(),
+		"dst_port", packet.GetDestPort(),
+		"dst_channel", packet.GetDestChannel(),
+	)
+
+	emitForceTimeoutPacketEvent(ctx, packet, channel)
+
+	return nil
+}
+
 // TimeoutOnClose is called by a module in order to prove that the channel to
 // which an unreceived packet was addressed has been closed, so the packet will
 // never be received (even if the timeoutHeight has not yet been reached).
@@ -286,7 +468,14 @@ func (k Keeper) TimeoutOnClose(
 
 	var err error
 	switch channel.Ordering {
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		// packets are timed out in the same order as they are acknowledged on ORDERED_ALLOW_TIMEOUT channels
+		if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+			if err := k.validateNextSequenceAck(ctx, packet); err != nil {
+				return err
+			}
+		}
+
 		// check that packet has not been received
 		if nextSequenceRecv > packet.GetSequence() {
 			return errorsmod.Wrapf(types.ErrInvalidPacket, "packet already received, next sequence receive > packet sequence (%d > %d", nextSequenceRecv, packet.GetSequence())
@@ -313,3 +502,34 @@ func (k Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// validateNextSequenceAck returns an error if the packet sequence is not equal to the next
+// sequence acknowledgement of its source channel.
+func (k Keeper) validateNextSequenceAck(ctx sdk.Context, packet exported.PacketI) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
+
+// recordPacketTimedOut records the timeout of a packet timed out, which is scheduled to be pruned.
+func (k Keeper) recordPacketTimedOut(ctx sdk.Context, packet exported.PacketI) {
+	timeoutHeight := packet.GetTimeoutHeight()
+	timeout := types.NewTimeout(clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), timeoutHeight.GetRevisionHeight()), packet.GetTimeoutTimestamp())
+
+	k.initPacketStatusStartSequence(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	k.SetTimedOutPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), timeout)
+	k.scheduleTimedOutPacketPruning(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
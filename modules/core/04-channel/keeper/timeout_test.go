@@ -12,6 +12,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -59,6 +60,59 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			err = path.EndpointA.UpdateClient()
 			suite.Require().NoError(err)
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// receiving the timed out packet on chainB writes a timeout receipt
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			// need to update chainA's client representing chainB to prove the timeout receipt
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+		}, true},
+		{"timeout receipt not written: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+		}, false},
+		{"packet timed out out of order: ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence+1)
+		}, false},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -233,6 +287,145 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTimeoutPackets() {
+	var (
+		path            *ibctesting.Path
+		packets         []types.Packet
+		receiptKeys     [][]byte
+		proofs          [][]byte
+		onTimeoutPacket func(sdk.Context, types.Packet) error
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expErrors []error
+		expError  error
+	}{
+		{
+			"success", func() {}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: proof of each packet", func() {
+				proofs = nil
+				for _, key := range receiptKeys {
+					proof, _ := path.EndpointB.QueryProof(key)
+					proofs = append(proofs, proof)
+				}
+			}, []error{nil, nil, nil}, nil,
+		},
+		{
+			"success: packet already timed out", func() {
+				channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutExecuted(suite.chainA.GetContext(), channelCap, packets[1])
+				suite.Require().NoError(err)
+			}, []error{nil, types.ErrNoOpMsg, nil}, nil,
+		},
+		{
+			"success: packet does not match commitment", func() {
+				packets[2].Data = []byte("invalid packet data")
+			}, []error{nil, nil, types.ErrInvalidPacket}, nil,
+		},
+		{
+			"success: timeout callback fails", func() {
+				onTimeoutPacket = func(_ sdk.Context, packet types.Packet) error {
+					if packet.GetSequence() == packets[0].GetSequence() {
+						return mock.MockApplicationCallbackError
+					}
+					return nil
+				}
+			}, []error{mock.MockApplicationCallbackError, nil, nil}, nil,
+		},
+		{
+			"channel not found", func() {
+				for i := range packets {
+					packets[i].SourceChannel = ibctesting.InvalidID
+				}
+			}, nil, types.ErrChannelNotFound,
+		},
+		{
+			"channel is not UNORDERED", func() {
+				channel := path.EndpointA.GetChannel()
+				channel.Ordering = types.ORDERED
+				path.EndpointA.SetChannel(channel)
+			}, nil, types.ErrInvalidChannelOrdering,
+		},
+		{
+			"packets sent on different channels", func() {
+				packets[1].SourceChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"packet destination channel does not match the counterparty", func() {
+				packets[1].DestinationChannel = ibctesting.InvalidID
+			}, nil, types.ErrInvalidPacket,
+		},
+		{
+			"invalid proof", func() {
+				proofs = [][]byte{[]byte("invalid proof")}
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"number of proofs does not match number of packets", func() {
+				proofs = [][]byte{proofs[0], proofs[0]}
+			}, nil, commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			packets, receiptKeys, onTimeoutPacket = nil, nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				packets = append(packets, packet)
+				receiptKeys = append(receiptKeys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			// need to update chainA's client representing chainB to prove the missing receipts
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight := path.EndpointB.QueryBatchProof(receiptKeys...)
+			proofs = [][]byte{proof}
+
+			tc.malleate()
+
+			channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			results, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPackets(suite.chainA.GetContext(), channelCap, packets, proofs, proofHeight, onTimeoutPacket)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, len(packets))
+				for i, expErr := range tc.expErrors {
+					if expErr == nil {
+						suite.Require().NoError(results[i])
+					} else {
+						suite.Require().ErrorIs(results[i], expErr)
+					}
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(results)
+			}
+
+			for i, packet := range packets {
+				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				expTimedOut := tc.expError == nil && (tc.expErrors[i] == nil || tc.expErrors[i] == types.ErrNoOpMsg)
+				suite.Require().Equal(!expTimedOut, has)
+			}
+		})
+	}
+}
+
 // TestTimeoutExecuted verifies that packet commitments are deleted on chainA after the
 // channel capabilities are verified. In addition, the test verifies that the channel state
 // after a timeout is updated accordingly.
@@ -274,6 +467,34 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 			},
 			nil,
 		},
+		{
+			"success ORDERED_ALLOW_TIMEOUT",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				suite.coordinator.Setup(path)
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			func(packetCommitment []byte, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Nil(packetCommitment)
+
+				// Check channel remains open and the next sequence ack is incremented
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.OPEN, channel.State)
+
+				nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+			},
+			nil,
+		},
 		{
 			"channel not found",
 			func() {
@@ -528,6 +749,11 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 			pc := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 			tc.expResult(pc, err)
+			if err == nil {
+				timeout, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetTimedOutPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().True(found, "packet should be recorded as timed out")
+				suite.Require().Equal(types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp()), timeout)
+			}
 			if tc.expEvents != nil {
 				events := ctx.EventManager().ABCIEvents()
 
@@ -539,6 +765,88 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 	}
 }
 
+// TestForceTimeoutPacket tests the ForceTimeoutPacket call on chainA for a packet sent
+// on a channel which has been force closed.
+func (suite *KeeperTestSuite) TestForceTimeoutPacket() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"channel not found", func() {
+				packet.SourceChannel = ibctesting.InvalidID
+			}, types.ErrChannelNotFound,
+		},
+		{
+			"channel is not CLOSED", func() {
+				err := path.EndpointA.SetChannelState(types.OPEN)
+				suite.Require().NoError(err)
+			}, types.ErrInvalidChannelState,
+		},
+		{
+			"packet destination port doesn't match the counterparty's port", func() {
+				packet.DestinationPort = ibctesting.InvalidID
+			}, types.ErrInvalidPacket,
+		},
+		{
+			"packet destination channel doesn't match the counterparty's channel", func() {
+				packet.DestinationChannel = ibctesting.InvalidID
+			}, types.ErrInvalidPacket,
+		},
+		{
+			"packet commitment not found", func() {
+				packet.Sequence++
+			}, types.ErrPacketCommitmentNotFound,
+		},
+		{
+			"packet does not match commitment", func() {
+				packet.Data = []byte("invalid packet data")
+			}, types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanForceClose(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ForceTimeoutPacket(suite.chainA.GetContext(), packet)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Nil(commitment)
+
+				_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetTimedOutPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestTimeoutOnClose tests the call TimeoutOnClose on chainA by closing the corresponding
 // channel on chainB after the packet commitment has been created.
 func (suite *KeeperTestSuite) TestTimeoutOnClose() {
@@ -578,15 +578,16 @@ func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID strin
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
 	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	if channel.Ordering.IsOrdered() && upgrade.Fields.Ordering == types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
 
-	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
+	// next seq recv and ack should updated when moving from UNORDERED to ORDERED or ORDERED_ALLOW_TIMEOUT using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	// the next seq recv and ack remain unchanged when moving between ORDERED and ORDERED_ALLOW_TIMEOUT.
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering.IsOrdered() {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
@@ -893,6 +894,7 @@ func (k Keeper) checkForUpgradeCompatibility(ctx sdk.Context, upgradeFields, cou
 // - the proposed connection hops do not exist
 // - the proposed version is non-empty (checked in UpgradeFields.ValidateBasic())
 // - the proposed connection hops are not open
+// - the proposed connection hops are not connected to the same counterparty chain as the existing connection hops
 func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types.UpgradeFields, currentChannel types.Channel) error {
 	currentFields := extractUpgradeFields(currentChannel)
 
@@ -913,6 +915,12 @@ func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types
 		)
 	}
 
+	if connectionID != currentChannel.ConnectionHops[0] {
+		if err := k.validateConnectionMigration(ctx, currentChannel.ConnectionHops[0], connection); err != nil {
+			return err
+		}
+	}
+
 	getVersions := connection.GetVersions()
 	if len(getVersions) != 1 {
 		return errorsmod.Wrapf(
@@ -933,6 +941,32 @@ func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types
 	return nil
 }
 
+// validateConnectionMigration asserts that the client of the proposed connection tracks the same counterparty chain
+// as the client of the channel's current connection, by comparing the chain IDs of both client states. This ensures
+// that a channel may only be migrated onto a connection which reaches the same counterparty chain.
+func (k Keeper) validateConnectionMigration(ctx sdk.Context, currentConnectionID string, proposedConnection connectiontypes.ConnectionEnd) error {
+	currentConnection, found := k.connectionKeeper.GetConnection(ctx, currentConnectionID)
+	if !found {
+		return errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "failed to retrieve connection: %s", currentConnectionID)
+	}
+
+	currentClientState, found := k.clientKeeper.GetClientState(ctx, currentConnection.ClientId)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", currentConnection.ClientId)
+	}
+
+	proposedClientState, found := k.clientKeeper.GetClientState(ctx, proposedConnection.ClientId)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", proposedConnection.ClientId)
+	}
+
+	if err := clienttypes.ValidateSameChain(currentClientState, proposedClientState); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionMigration, "proposed connection client must track the same chain as the current connection client: %s", err)
+	}
+
+	return nil
+}
+
 // extractUpgradeFields returns the upgrade fields from the provided channel.
 func extractUpgradeFields(channel types.Channel) types.UpgradeFields {
 	return types.UpgradeFields{
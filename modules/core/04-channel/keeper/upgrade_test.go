@@ -13,6 +13,7 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -2304,6 +2305,33 @@ func (suite *KeeperTestSuite) TestValidateUpgradeFields() {
 			},
 			expPass: true,
 		},
+		{
+			name: "fails when proposed connection tracks a different counterparty chain",
+			malleate: func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				clientState.ChainId = "different-chain"
+				path.EndpointA.SetClientState(clientState)
+
+				proposedUpgrade.ConnectionHops = []string{path.EndpointA.ConnectionID}
+			},
+			expPass: false,
+		},
+		{
+			name: "fails when current connection client does not exist",
+			malleate: func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+				proposedUpgrade.ConnectionHops = []string{path.EndpointA.ConnectionID}
+
+				storeKey := suite.chainA.GetSimApp().GetKey(exported.StoreKey)
+				kvStore := suite.chainA.GetContext().KVStore(storeKey)
+				kvStore.Delete(host.FullClientStateKey(ibctesting.FirstClientID))
+			},
+			expPass: false,
+		},
 		{
 			name:     "fails with unmodified fields",
 			malleate: func() {},
@@ -2743,3 +2771,83 @@ func (suite *KeeperTestSuite) TestWriteErrorReceipt() {
 		})
 	}
 }
+
+// TestChanUpgrade_MigrateConnection performs a channel upgrade which moves the channel onto a new connection
+// while packets are in flight and asserts that packets can be relayed over the new connection afterwards.
+func (suite *KeeperTestSuite) TestChanUpgrade_MigrateConnection() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	connectionPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(connectionPath)
+
+	timeoutHeight := suite.chainB.GetTimeoutHeight()
+
+	var inFlightPackets []types.Packet
+	for i := 0; i < 3; i++ {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		inFlightPackets = append(inFlightPackets, packet)
+	}
+
+	err := path.MigrateConnection(connectionPath, inFlightPackets...)
+	suite.Require().NoError(err)
+
+	channelA, channelB := path.EndpointA.GetChannel(), path.EndpointB.GetChannel()
+	suite.Require().Equal(types.OPEN, channelA.State)
+	suite.Require().Equal(types.OPEN, channelB.State)
+	suite.Require().Equal([]string{connectionPath.EndpointA.ConnectionID}, channelA.ConnectionHops)
+	suite.Require().Equal([]string{connectionPath.EndpointB.ConnectionID}, channelB.ConnectionHops)
+	suite.Require().Equal(ibctesting.FirstChannelID, path.EndpointA.ChannelID)
+	suite.Require().Equal(ibctesting.FirstChannelID, path.EndpointB.ChannelID)
+
+	for _, packet := range inFlightPackets {
+		commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		suite.Require().Empty(commitment)
+	}
+
+	// relay a packet over the new connection
+	timeoutHeight = suite.chainB.GetTimeoutHeight()
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.RelayPacket(packet))
+}
+
+// TestChanUpgrade_UpgradeChannel performs a full channel upgrade of the channel version and ordering while
+// packets are in flight and asserts that the channel ends are OPEN with the upgraded fields afterwards.
+func (suite *KeeperTestSuite) TestChanUpgrade_UpgradeChannel() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	timeoutHeight := suite.chainB.GetTimeoutHeight()
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+		endpoint.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED
+	}
+
+	err = path.UpgradeChannel(packet)
+	suite.Require().NoError(err)
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(types.OPEN, channel.State)
+		suite.Require().Equal(mock.UpgradeVersion, channel.Version)
+		suite.Require().Equal(types.ORDERED, channel.Ordering)
+		suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+		suite.Require().Equal(mock.UpgradeVersion, endpoint.ChannelConfig.Version)
+		suite.Require().Equal(types.ORDERED, endpoint.ChannelConfig.Order)
+	}
+
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Empty(commitment)
+}
@@ -60,7 +60,7 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !slices.Contains([]Order{ORDERED, UNORDERED}, ch.Ordering) {
+	if !slices.Contains([]Order{ORDERED, UNORDERED, ORDERED_ALLOW_TIMEOUT}, ch.Ordering) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
 	if len(ch.ConnectionHops) != 1 {
@@ -106,6 +106,12 @@ func (c Counterparty) ValidateBasic() error {
 	return nil
 }
 
+// IsOrdered returns true if packets on a channel with the given ordering are received
+// and acknowledged in the order in which they were sent.
+func (o Order) IsOrdered() bool {
+	return o == ORDERED || o == ORDERED_ALLOW_TIMEOUT
+}
+
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
@@ -86,18 +86,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered in the order which they were sent, but a packet which
+	// timed out is skipped on receive instead of closing the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -108,6 +113,51 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{1}
 }
 
+// PacketStatus defines the lifecycle status of a packet sent on a channel end.
+type PacketStatus int32
+
+const (
+	// Default zero value enumeration, used to query packets of any status
+	UNSPECIFIED_PACKET_STATUS PacketStatus = 0
+	// the packet commitment is stored and the packet awaits to be acknowledged or timed out
+	IN_FLIGHT PacketStatus = 1
+	// the packet has been timed out and the packet commitment has been deleted
+	TIMED_OUT PacketStatus = 2
+	// the packet has been acknowledged and the packet commitment has been deleted
+	ACKNOWLEDGED PacketStatus = 3
+	// the packet has not been sent
+	NOT_SENT PacketStatus = 4
+	// the packet commitment has been deleted, but the packet was sent before the channel keeper
+	// recorded whether packets are acknowledged or timed out, or before a record of a packet timed out was pruned
+	COMPLETED PacketStatus = 5
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_IN_FLIGHT",
+	2: "PACKET_STATUS_TIMED_OUT",
+	3: "PACKET_STATUS_ACKNOWLEDGED",
+	4: "PACKET_STATUS_NOT_SENT",
+	5: "PACKET_STATUS_COMPLETED",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED":  0,
+	"PACKET_STATUS_IN_FLIGHT":    1,
+	"PACKET_STATUS_TIMED_OUT":    2,
+	"PACKET_STATUS_ACKNOWLEDGED": 3,
+	"PACKET_STATUS_NOT_SENT":     4,
+	"PACKET_STATUS_COMPLETED":    5,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{2}
+}
+
 // Channel defines pipeline for exactly-once packet delivery between specific
 // modules on separate blockchains, which has at least one end capable of
 // sending packets and one end capable of receiving packets.
@@ -406,6 +456,68 @@ func (m *PacketId) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketId proto.InternalMessageInfo
 
+// PacketLifecycle defines the state of a packet sequence on a channel end. The status, commitment,
+// timeout and flushing fields refer to the packet sent on the channel end with the given sequence.
+// The received, acknowledgement written and pruned fields refer to the packet with the given sequence
+// sent by the counterparty and received on the channel end.
+type PacketLifecycle struct {
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// lifecycle status of the packet sent
+	Status PacketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ibc.core.channel.v1.PacketStatus" json:"status,omitempty"`
+	// packet commitment, empty unless the packet is in flight
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// timeout of the packet sent, nil unless the packet timed out or the packet is in flight and
+	// its data and timeout were supplied in the query and match the packet commitment
+	Timeout *Timeout `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// true if the packet is in flight and its timeout has elapsed according to the latest
+	// consensus state of the counterparty client
+	TimeoutElapsed bool `protobuf:"varint,5,opt,name=timeout_elapsed,json=timeoutElapsed,proto3" json:"timeout_elapsed,omitempty"`
+	// true if the packet is in flight on a channel which is flushing for an upgrade
+	Flushing bool `protobuf:"varint,6,opt,name=flushing,proto3" json:"flushing,omitempty"`
+	// true if the packet sent by the counterparty has been received
+	Received bool `protobuf:"varint,7,opt,name=received,proto3" json:"received,omitempty"`
+	// true if an acknowledgement has been written for the packet sent by the counterparty
+	AcknowledgementWritten bool `protobuf:"varint,8,opt,name=acknowledgement_written,json=acknowledgementWritten,proto3" json:"acknowledgement_written,omitempty"`
+	// true if the receipt and acknowledgement of the packet sent by the counterparty have been pruned
+	// after a channel upgrade. Receipts pruned once their retention period has elapsed are not reported,
+	// such packets are reported as not received.
+	Pruned bool `protobuf:"varint,9,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *PacketLifecycle) Reset()         { *m = PacketLifecycle{} }
+func (m *PacketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*PacketLifecycle) ProtoMessage()    {}
+func (*PacketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{6}
+}
+func (m *PacketLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketLifecycle.Merge(m, src)
+}
+func (m *PacketLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketLifecycle proto.InternalMessageInfo
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -426,7 +538,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{6}
+	return fileDescriptor_c3a07336710636a0, []int{7}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +626,7 @@ func (m *Timeout) Reset()         { *m = Timeout{} }
 func (m *Timeout) String() string { return proto.CompactTextString(m) }
 func (*Timeout) ProtoMessage()    {}
 func (*Timeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{7}
+	return fileDescriptor_c3a07336710636a0, []int{8}
 }
 func (m *Timeout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,13 +673,15 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the retention policy of packet receipts and acknowledgements on unordered channels.
+	ReceiptRetention ReceiptRetention `protobuf:"bytes,2,opt,name=receipt_retention,json=receiptRetention,proto3" json:"receipt_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{8}
+	return fileDescriptor_c3a07336710636a0, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,83 +717,337 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetReceiptRetention() ReceiptRetention {
+	if m != nil {
+		return m.ReceiptRetention
+	}
+	return ReceiptRetention{}
+}
+
+// ReceiptRetention defines the policy by which the packet receipts and acknowledgements written
+// on unordered channels are pruned once the packet they were written for can no longer be received.
+// A packet can no longer be received once its timeout has elapsed. As the absence of the receipt would
+// otherwise allow the packet to be timed out on the sending chain, a receipt is only scheduled to be pruned
+// once the deletion of the packet commitment on the counterparty has been proven by MsgScheduleReceiptPruning.
+type ReceiptRetention struct {
+	// the number of blocks past the packet timeout height for which the receipt is retained.
+	HeightPeriod uint64 `protobuf:"varint,1,opt,name=height_period,json=heightPeriod,proto3" json:"height_period,omitempty"`
+	// the duration (in nanoseconds) past the packet timeout timestamp for which the receipt is retained.
+	TimePeriod uint64 `protobuf:"varint,2,opt,name=time_period,json=timePeriod,proto3" json:"time_period,omitempty"`
+	// the maximum number of receipts pruned at the beginning of each block. Scheduled pruning is disabled if zero.
+	MaxPrunedPerBlock uint64 `protobuf:"varint,3,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+}
+
+func (m *ReceiptRetention) Reset()         { *m = ReceiptRetention{} }
+func (m *ReceiptRetention) String() string { return proto.CompactTextString(m) }
+func (*ReceiptRetention) ProtoMessage()    {}
+func (*ReceiptRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{10}
+}
+func (m *ReceiptRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptRetention.Merge(m, src)
+}
+func (m *ReceiptRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptRetention proto.InternalMessageInfo
+
+func (m *ReceiptRetention) GetHeightPeriod() uint64 {
+	if m != nil {
+		return m.HeightPeriod
+	}
+	return 0
+}
+
+func (m *ReceiptRetention) GetTimePeriod() uint64 {
+	if m != nil {
+		return m.TimePeriod
+	}
+	return 0
+}
+
+func (m *ReceiptRetention) GetMaxPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+// ScheduledReceipt defines a packet receipt scheduled to be pruned, along with its acknowledgement,
+// once the given timestamp or height has been reached.
+type ScheduledReceipt struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the timestamp (in nanoseconds) at which the receipt is pruned. The height is used if the timestamp is zero.
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the height at which the receipt is pruned.
+	Height types.Height `protobuf:"bytes,5,opt,name=height,proto3" json:"height"`
+}
+
+func (m *ScheduledReceipt) Reset()         { *m = ScheduledReceipt{} }
+func (m *ScheduledReceipt) String() string { return proto.CompactTextString(m) }
+func (*ScheduledReceipt) ProtoMessage()    {}
+func (*ScheduledReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{11}
+}
+func (m *ScheduledReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledReceipt.Merge(m, src)
+}
+func (m *ScheduledReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledReceipt proto.InternalMessageInfo
+
+func (m *ScheduledReceipt) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ScheduledReceipt) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ScheduledReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ScheduledReceipt) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ScheduledReceipt) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+// ReceiptPruningProgress defines the progress of the scheduled pruning of packet receipts and acknowledgements.
+type ReceiptPruningProgress struct {
+	// the number of receipts which are scheduled to be pruned.
+	Pending uint64 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	// the total number of receipts which have been pruned.
+	TotalPruned uint64 `protobuf:"varint,2,opt,name=total_pruned,json=totalPruned,proto3" json:"total_pruned,omitempty"`
+	// the block height at which receipts were last pruned.
+	LastPrunedHeight int64 `protobuf:"varint,3,opt,name=last_pruned_height,json=lastPrunedHeight,proto3" json:"last_pruned_height,omitempty"`
+}
+
+func (m *ReceiptPruningProgress) Reset()         { *m = ReceiptPruningProgress{} }
+func (m *ReceiptPruningProgress) String() string { return proto.CompactTextString(m) }
+func (*ReceiptPruningProgress) ProtoMessage()    {}
+func (*ReceiptPruningProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{12}
+}
+func (m *ReceiptPruningProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptPruningProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptPruningProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptPruningProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptPruningProgress.Merge(m, src)
+}
+func (m *ReceiptPruningProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptPruningProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptPruningProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptPruningProgress proto.InternalMessageInfo
+
+func (m *ReceiptPruningProgress) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *ReceiptPruningProgress) GetTotalPruned() uint64 {
+	if m != nil {
+		return m.TotalPruned
+	}
+	return 0
+}
+
+func (m *ReceiptPruningProgress) GetLastPrunedHeight() int64 {
+	if m != nil {
+		return m.LastPrunedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
+	proto.RegisterEnum("ibc.core.channel.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
 	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
 	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
+	proto.RegisterType((*PacketLifecycle)(nil), "ibc.core.channel.v1.PacketLifecycle")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
 	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*ReceiptRetention)(nil), "ibc.core.channel.v1.ReceiptRetention")
+	proto.RegisterType((*ScheduledReceipt)(nil), "ibc.core.channel.v1.ScheduledReceipt")
+	proto.RegisterType((*ReceiptPruningProgress)(nil), "ibc.core.channel.v1.ReceiptPruningProgress")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0xd5, 0xb6, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x4f,
-	0x21, 0x2d, 0x56, 0x26, 0xfd, 0xcd, 0x95, 0x09, 0x3e, 0x3a, 0x85, 0xdc, 0x66, 0x47, 0x49, 0x56,
-	0xf8, 0x06, 0x28, 0x0f, 0xa2, 0x0b, 0x1f, 0xd8, 0xb3, 0x10, 0xbd, 0x80, 0xf8, 0x2f, 0x36, 0x14,
-	0x2b, 0x14, 0x52, 0xa7, 0x77, 0xbe, 0x22, 0xa2, 0x30, 0x21, 0x96, 0x17, 0xa1, 0x02, 0x3d, 0xfb,
-	0x29, 0x09, 0xa9, 0x9e, 0x78, 0xd1, 0x4a, 0xbd, 0xfe, 0x45, 0xdf, 0x18, 0x0e, 0xda, 0x66, 0xdb,
-	0xec, 0x9b, 0x17, 0x2d, 0xf3, 0xa5, 0xd1, 0x18, 0x0e, 0xda, 0xbd, 0xae, 0x51, 0x37, 0x9f, 0x99,
-	0x46, 0x43, 0x49, 0x68, 0x47, 0xab, 0xb5, 0x7e, 0xb8, 0x43, 0x40, 0x2a, 0x00, 0x8f, 0x8b, 0x40,
-	0x45, 0xd2, 0xb2, 0xab, 0xb5, 0x2e, 0x47, 0x67, 0x54, 0x84, 0x43, 0xee, 0xe9, 0x5b, 0xdf, 0x75,
-	0xba, 0x46, 0x5b, 0x49, 0x6a, 0xfb, 0xab, 0xb5, 0x9e, 0x11, 0xe6, 0x26, 0x92, 0x39, 0xf7, 0x78,
-	0x24, 0xf3, 0x9c, 0xc2, 0x01, 0xf7, 0xd4, 0x5b, 0x9d, 0x9e, 0xd1, 0x50, 0x64, 0x0d, 0x56, 0x6b,
-	0x3d, 0xcd, 0x2d, 0xa4, 0x43, 0x9e, 0x7b, 0x9f, 0xb5, 0x06, 0xbd, 0xa6, 0xd9, 0x7e, 0xae, 0xa4,
-	0xb4, 0x83, 0xd5, 0x5a, 0xcf, 0xc6, 0x36, 0x3a, 0x83, 0xe3, 0x2d, 0x46, 0xbd, 0xf3, 0x6d, 0xb7,
-	0x65, 0xf4, 0x0d, 0x25, 0xcd, 0xeb, 0xdf, 0x01, 0x35, 0xf9, 0xed, 0xaf, 0xc5, 0xc4, 0xd9, 0x6b,
-	0x48, 0xb1, 0xa7, 0x1a, 0x7d, 0x02, 0x27, 0x1d, 0xab, 0x61, 0x58, 0xc3, 0x76, 0xa7, 0x6d, 0xdc,
-	0xea, 0x9e, 0x15, 0x18, 0xe1, 0xa8, 0x0c, 0x05, 0xce, 0x1a, 0xb4, 0xd9, 0xaf, 0xd1, 0x50, 0x24,
-	0xed, 0x70, 0xb5, 0xd6, 0x73, 0x37, 0x40, 0xd4, 0x3e, 0xe7, 0xc4, 0x0c, 0xd1, 0xbe, 0x30, 0xb9,
-	0xf0, 0x65, 0xef, 0xdd, 0x55, 0x51, 0x7a, 0x7f, 0x55, 0x94, 0xfe, 0xb8, 0x2a, 0x4a, 0x3f, 0x5f,
-	0x17, 0x13, 0xef, 0xaf, 0x8b, 0x89, 0x0f, 0xd7, 0xc5, 0xc4, 0xcb, 0xaf, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0xd1, 0xb4, 0xca, 0x94, 0x08, 0x00, 0x00,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x13, 0xd7,
+	0x16, 0xf7, 0x38, 0xb6, 0x63, 0x1f, 0x3b, 0xc9, 0xe4, 0x02, 0xc1, 0xf8, 0x81, 0x33, 0xf8, 0x3d,
+	0xf4, 0x42, 0xde, 0x23, 0x86, 0xb4, 0xa2, 0x80, 0xba, 0x49, 0xe2, 0x21, 0x19, 0xc5, 0xd8, 0xd6,
+	0xd8, 0x11, 0x2d, 0x9b, 0xd1, 0x64, 0xe6, 0xe2, 0x8c, 0xb0, 0xe7, 0x4e, 0x67, 0xae, 0x03, 0xa8,
+	0xcb, 0xaa, 0x12, 0xf5, 0xaa, 0x5f, 0xc0, 0x52, 0xa5, 0x7e, 0x80, 0x4a, 0x6d, 0xd7, 0x5d, 0xb3,
+	0x64, 0xc9, 0xaa, 0xaa, 0xe0, 0x3b, 0x54, 0x5d, 0x56, 0xf7, 0xcf, 0x38, 0xb6, 0x89, 0xd2, 0x3f,
+	0x12, 0xbb, 0xae, 0x3c, 0xe7, 0x77, 0x7e, 0xe7, 0xff, 0xb9, 0x77, 0x3c, 0x70, 0xd5, 0x3b, 0x74,
+	0xaa, 0x0e, 0x09, 0x71, 0xd5, 0x39, 0xb2, 0x7d, 0x1f, 0xf7, 0xaa, 0xc7, 0xb7, 0xe2, 0xc7, 0x8d,
+	0x20, 0x24, 0x94, 0xa0, 0x73, 0xde, 0xa1, 0xb3, 0xc1, 0x28, 0x1b, 0x31, 0x7e, 0x7c, 0xab, 0x74,
+	0xbe, 0x4b, 0xba, 0x84, 0xeb, 0xab, 0xec, 0x49, 0x50, 0x4b, 0xab, 0x27, 0xde, 0x7a, 0x1e, 0xf6,
+	0x29, 0x77, 0xc6, 0x9f, 0x04, 0xa1, 0xf2, 0x63, 0x12, 0xe6, 0x77, 0x84, 0x17, 0x74, 0x13, 0xd2,
+	0x11, 0xb5, 0x29, 0x2e, 0x2a, 0x9a, 0xb2, 0xb6, 0xb8, 0x59, 0xda, 0x38, 0x25, 0xce, 0x46, 0x9b,
+	0x31, 0x4c, 0x41, 0x44, 0xb7, 0x21, 0x4b, 0x42, 0x17, 0x87, 0x9e, 0xdf, 0x2d, 0x26, 0xcf, 0x30,
+	0x6a, 0x32, 0x92, 0x39, 0xe6, 0xa2, 0x7d, 0x28, 0x38, 0x64, 0xe0, 0x53, 0x1c, 0x06, 0x76, 0x48,
+	0x9f, 0x17, 0xe7, 0x34, 0x65, 0x2d, 0xbf, 0x79, 0xf5, 0x54, 0xdb, 0x9d, 0x09, 0xe2, 0x76, 0xea,
+	0xe5, 0xcf, 0xab, 0x09, 0x73, 0xca, 0x18, 0xfd, 0x17, 0x96, 0x1c, 0xe2, 0xfb, 0xd8, 0xa1, 0x1e,
+	0xf1, 0xad, 0x23, 0x12, 0x44, 0xc5, 0x94, 0x36, 0xb7, 0x96, 0x33, 0x17, 0x4f, 0xe0, 0x3d, 0x12,
+	0x44, 0xa8, 0x08, 0xf3, 0xc7, 0x38, 0x8c, 0x3c, 0xe2, 0x17, 0xd3, 0x9a, 0xb2, 0x96, 0x33, 0x63,
+	0x11, 0x5d, 0x07, 0x75, 0x10, 0x74, 0x43, 0xdb, 0xc5, 0x56, 0x84, 0x3f, 0x1b, 0x60, 0xdf, 0xc1,
+	0xc5, 0x8c, 0xa6, 0xac, 0xa5, 0xcc, 0x25, 0x89, 0xb7, 0x25, 0x7c, 0x2f, 0xf5, 0xe2, 0x9b, 0xd5,
+	0x44, 0xe5, 0xd7, 0x24, 0x2c, 0x1b, 0x2e, 0xf6, 0xa9, 0xf7, 0xd8, 0xc3, 0xee, 0x3f, 0x0d, 0xbc,
+	0x08, 0xf3, 0x01, 0x09, 0xa9, 0xe5, 0xb9, 0xbc, 0x6f, 0x39, 0x33, 0xc3, 0x44, 0xc3, 0x45, 0x57,
+	0x00, 0x64, 0x2a, 0x4c, 0x37, 0xcf, 0x75, 0x39, 0x89, 0x18, 0xee, 0xa9, 0x8d, 0xcf, 0x9e, 0xd5,
+	0xf8, 0x3a, 0x14, 0x26, 0xeb, 0x99, 0x0c, 0xac, 0x9c, 0x11, 0x38, 0x39, 0x13, 0x58, 0x7a, 0x7b,
+	0x9d, 0x84, 0x4c, 0xcb, 0x76, 0x9e, 0x60, 0x8a, 0x4a, 0x90, 0x1d, 0x67, 0xa0, 0xf0, 0x0c, 0xc6,
+	0x32, 0x5a, 0x85, 0x7c, 0x44, 0x06, 0xa1, 0x83, 0x2d, 0xe6, 0x5c, 0x3a, 0x03, 0x01, 0xb5, 0x48,
+	0x48, 0xd1, 0x35, 0x58, 0x94, 0x04, 0x19, 0x81, 0x0f, 0x24, 0x67, 0x2e, 0x08, 0x34, 0xde, 0x8f,
+	0xeb, 0xa0, 0xba, 0x38, 0xa2, 0x9e, 0x6f, 0xf3, 0x4e, 0x73, 0x67, 0x29, 0x4e, 0x5c, 0x9a, 0xc0,
+	0xb9, 0xc7, 0x2a, 0x9c, 0x9b, 0xa4, 0xc6, 0x6e, 0x45, 0xdb, 0xd1, 0x84, 0x2a, 0xf6, 0x8d, 0x20,
+	0xe5, 0xda, 0xd4, 0xe6, 0xed, 0x2f, 0x98, 0xfc, 0x19, 0xed, 0xc2, 0x22, 0xf5, 0xfa, 0x98, 0x0c,
+	0xa8, 0x75, 0x84, 0xbd, 0xee, 0x11, 0xe5, 0x03, 0xc8, 0x4f, 0xed, 0x98, 0xb8, 0x0c, 0x8e, 0x6f,
+	0x6d, 0xec, 0x71, 0x86, 0x5c, 0x90, 0x05, 0x69, 0x27, 0x40, 0xf4, 0x3f, 0x58, 0x8e, 0x1d, 0xb1,
+	0xdf, 0x88, 0xda, 0xfd, 0x40, 0xce, 0x49, 0x95, 0x8a, 0x4e, 0x8c, 0xcb, 0xd6, 0x7e, 0x0e, 0x79,
+	0xd1, 0x59, 0xbe, 0xef, 0x7f, 0x77, 0x4e, 0x53, 0x63, 0x99, 0x9b, 0x19, 0x4b, 0x5c, 0x72, 0xea,
+	0xa4, 0x64, 0x19, 0xdc, 0x85, 0xac, 0x08, 0x6e, 0xb8, 0xef, 0x23, 0xb2, 0x8c, 0xf2, 0x5b, 0x12,
+	0x96, 0x44, 0x98, 0xba, 0xf7, 0x18, 0x3b, 0xcf, 0x9d, 0x1e, 0x3e, 0x73, 0x8d, 0xee, 0x42, 0x86,
+	0x9d, 0xfa, 0x41, 0x24, 0x8f, 0xfa, 0xe9, 0xc7, 0xf5, 0xa4, 0x6b, 0x83, 0xc8, 0x94, 0x06, 0xa8,
+	0x0c, 0xe0, 0x90, 0x7e, 0xdf, 0xa3, 0x7d, 0xec, 0x53, 0x9e, 0x4e, 0xc1, 0x9c, 0x40, 0xd0, 0x6d,
+	0x98, 0x97, 0x73, 0xe0, 0xdd, 0xc8, 0x6f, 0x5e, 0x3e, 0xd5, 0x77, 0x47, 0x70, 0xcc, 0x98, 0xcc,
+	0x8e, 0x7e, 0x3c, 0x58, 0xdc, 0xb3, 0x83, 0x08, 0xbb, 0x7c, 0xc5, 0xb2, 0x66, 0xbc, 0x38, 0xba,
+	0x40, 0x59, 0x5d, 0x8f, 0x7b, 0x83, 0xe8, 0x88, 0x5d, 0x54, 0x19, 0xce, 0x18, 0xcb, 0x4c, 0x17,
+	0x62, 0x07, 0x7b, 0xc7, 0x58, 0x9c, 0xf0, 0xac, 0x39, 0x96, 0xd1, 0x47, 0x70, 0xd1, 0x76, 0x9e,
+	0xf8, 0xe4, 0x69, 0x0f, 0xbb, 0x5d, 0xcc, 0x72, 0xb5, 0x9e, 0x86, 0x1e, 0xa5, 0xd8, 0xe7, 0xfb,
+	0x93, 0x35, 0x57, 0x66, 0xd4, 0x0f, 0x85, 0x16, 0xad, 0x40, 0x26, 0x08, 0x07, 0x3e, 0x76, 0x8b,
+	0x39, 0xce, 0x93, 0x92, 0x6c, 0x7d, 0x13, 0x96, 0xb6, 0xa6, 0xed, 0x50, 0x11, 0x32, 0x21, 0x8e,
+	0x06, 0x3d, 0x5a, 0xbc, 0xc0, 0xda, 0xb3, 0x97, 0x30, 0xa5, 0x8c, 0x56, 0x20, 0x8d, 0xc3, 0x90,
+	0x84, 0xc5, 0x15, 0x36, 0xe3, 0xbd, 0x84, 0x29, 0xc4, 0x6d, 0x60, 0x79, 0x47, 0x01, 0xf1, 0x23,
+	0x5c, 0xb1, 0x61, 0x5e, 0x36, 0x07, 0xdd, 0x81, 0x8c, 0x3c, 0x2d, 0xca, 0x9f, 0x3c, 0x2d, 0x92,
+	0x8f, 0x2e, 0x43, 0xee, 0xe4, 0x78, 0x24, 0xf9, 0xf4, 0x4f, 0x80, 0xca, 0x77, 0x0a, 0xbb, 0x6c,
+	0x42, 0xbb, 0x1f, 0xa1, 0x7d, 0x88, 0xaf, 0x37, 0x2b, 0x1e, 0x9b, 0xf2, 0xc7, 0x63, 0x93, 0xd1,
+	0x16, 0xa5, 0x69, 0x9c, 0xef, 0x27, 0xb0, 0xcc, 0xdb, 0x1d, 0x50, 0x2b, 0xc4, 0x94, 0xbd, 0x93,
+	0x88, 0xcf, 0xa3, 0xe7, 0x37, 0xaf, 0x9d, 0xea, 0xce, 0x14, 0x6c, 0x33, 0x26, 0x4b, 0xbf, 0x6a,
+	0x38, 0x83, 0x57, 0xbe, 0x52, 0x40, 0x9d, 0x25, 0xa3, 0x7f, 0xc3, 0x82, 0x28, 0xd7, 0x0a, 0x70,
+	0xe8, 0x11, 0x57, 0xae, 0x79, 0x41, 0x80, 0x2d, 0x8e, 0xb1, 0x1b, 0x93, 0x15, 0x16, 0x53, 0x44,
+	0x2f, 0x80, 0x41, 0x92, 0x50, 0x85, 0xf3, 0x7d, 0xfb, 0x99, 0x25, 0x86, 0xca, 0x68, 0xd6, 0x61,
+	0x8f, 0x38, 0x4f, 0xe4, 0x49, 0x5b, 0xee, 0xdb, 0xcf, 0x5a, 0x5c, 0xd5, 0xc2, 0xe1, 0x36, 0x53,
+	0x54, 0x7e, 0x52, 0x40, 0x6d, 0x3b, 0x47, 0xd8, 0x1d, 0xf4, 0xb0, 0x2b, 0x93, 0x7a, 0x2f, 0xb7,
+	0xca, 0xd4, 0x10, 0x53, 0x33, 0x43, 0x9c, 0x58, 0x8e, 0xf4, 0x5f, 0x5b, 0x8e, 0xca, 0x17, 0x0a,
+	0xac, 0xc8, 0xbc, 0x59, 0x69, 0x9e, 0xdf, 0x6d, 0x85, 0xa4, 0x1b, 0xe2, 0x88, 0xbf, 0x57, 0x03,
+	0xec, 0xbb, 0xec, 0x6c, 0x89, 0x66, 0xc6, 0x22, 0xba, 0x0a, 0x05, 0x4a, 0xa8, 0xdd, 0x93, 0x8d,
+	0x92, 0x8d, 0xcc, 0x73, 0x4c, 0x34, 0x08, 0xfd, 0x1f, 0x50, 0xcf, 0x8e, 0x68, 0xdc, 0x4a, 0x99,
+	0x1d, 0xab, 0x6a, 0xce, 0x54, 0x99, 0x46, 0xf0, 0x44, 0x4e, 0xeb, 0x5f, 0x26, 0x21, 0xdd, 0x96,
+	0x7f, 0x3d, 0x56, 0xdb, 0x9d, 0xad, 0x8e, 0x6e, 0x1d, 0x34, 0x8c, 0x86, 0xd1, 0x31, 0xb6, 0xea,
+	0xc6, 0x23, 0xbd, 0x66, 0x1d, 0x34, 0xda, 0x2d, 0x7d, 0xc7, 0xb8, 0x6f, 0xe8, 0x35, 0x35, 0x51,
+	0x5a, 0x1e, 0x8e, 0xb4, 0x85, 0x29, 0x02, 0x2a, 0x02, 0x08, 0x3b, 0x06, 0xaa, 0x4a, 0x29, 0x3b,
+	0x1c, 0x69, 0x29, 0xf6, 0x8c, 0xca, 0xb0, 0x20, 0x34, 0x1d, 0xf3, 0xd3, 0x66, 0x4b, 0x6f, 0xa8,
+	0xc9, 0x52, 0x7e, 0x38, 0xd2, 0xe6, 0xa5, 0x78, 0x62, 0xc9, 0x95, 0x73, 0xc2, 0x92, 0x6b, 0x2e,
+	0x43, 0x41, 0x68, 0x76, 0xea, 0xcd, 0xb6, 0x5e, 0x53, 0x53, 0x25, 0x18, 0x8e, 0xb4, 0x8c, 0x90,
+	0x90, 0x06, 0x8b, 0x42, 0x7b, 0xbf, 0x7e, 0xd0, 0xde, 0x33, 0x1a, 0xbb, 0x6a, 0xba, 0x54, 0x18,
+	0x8e, 0xb4, 0x6c, 0x2c, 0xa3, 0x75, 0x38, 0x37, 0xc1, 0xd8, 0x69, 0x3e, 0x68, 0xd5, 0xf5, 0x8e,
+	0xae, 0x66, 0x44, 0xfe, 0x53, 0x60, 0x29, 0xf5, 0xe2, 0xdb, 0x72, 0x62, 0xfd, 0x07, 0x05, 0xd2,
+	0xfc, 0x4f, 0x15, 0xfa, 0x0f, 0xac, 0x34, 0xcd, 0x9a, 0x6e, 0x5a, 0x8d, 0x66, 0x43, 0x9f, 0x29,
+	0x9f, 0x67, 0xc8, 0x70, 0x54, 0x81, 0x25, 0xc1, 0x3a, 0x68, 0xf0, 0x5f, 0xbd, 0xa6, 0x2a, 0xa5,
+	0x85, 0xe1, 0x48, 0xcb, 0x8d, 0x01, 0x56, 0xbf, 0xe0, 0xc4, 0x0c, 0x59, 0x7f, 0xac, 0xbf, 0x07,
+	0xff, 0x9a, 0xd2, 0x5b, 0x5b, 0xf5, 0x7a, 0xf3, 0xa1, 0xd5, 0x31, 0x1e, 0xe8, 0xcd, 0x83, 0x8e,
+	0x3a, 0x57, 0xba, 0x34, 0x1c, 0x69, 0x17, 0x4e, 0x55, 0xca, 0xac, 0xbf, 0x4f, 0x42, 0x61, 0xf2,
+	0xfd, 0x80, 0x3e, 0x86, 0x4b, 0xad, 0xad, 0x9d, 0x7d, 0xbd, 0x63, 0xb1, 0xfa, 0x0f, 0xda, 0x33,
+	0xf9, 0x5f, 0x19, 0x8e, 0xb4, 0x4b, 0x13, 0x90, 0x35, 0x45, 0x46, 0xeb, 0x70, 0x71, 0xda, 0xda,
+	0x68, 0x58, 0xf7, 0xeb, 0xc6, 0xee, 0x5e, 0x27, 0x2e, 0x6e, 0x0c, 0xbc, 0xcb, 0x65, 0x99, 0xd5,
+	0x2c, 0x96, 0x78, 0x52, 0x70, 0xc7, 0x00, 0xba, 0x09, 0xa5, 0x69, 0xee, 0xd6, 0xce, 0x7e, 0xa3,
+	0xf9, 0xb0, 0xae, 0xd7, 0x76, 0xf5, 0x9a, 0x3a, 0x57, 0x52, 0x87, 0x23, 0xad, 0x30, 0x89, 0xa1,
+	0x35, 0x58, 0x99, 0xb6, 0x68, 0x34, 0x3b, 0x56, 0x5b, 0x6f, 0x74, 0xd4, 0x94, 0x18, 0x75, 0x2c,
+	0xbf, 0x9b, 0x47, 0x3c, 0xd8, 0x9a, 0x9a, 0x16, 0x79, 0x8c, 0x01, 0xd1, 0xb4, 0xed, 0xf6, 0xcb,
+	0x37, 0x65, 0xe5, 0xd5, 0x9b, 0xb2, 0xf2, 0xcb, 0x9b, 0xb2, 0xf2, 0xf5, 0xdb, 0x72, 0xe2, 0xd5,
+	0xdb, 0x72, 0xe2, 0xf5, 0xdb, 0x72, 0xe2, 0xd1, 0xdd, 0xae, 0x47, 0x8f, 0x06, 0x87, 0x1b, 0x0e,
+	0xe9, 0x57, 0x1d, 0x12, 0xf5, 0x49, 0x54, 0xf5, 0x0e, 0x9d, 0x1b, 0x5d, 0x52, 0x3d, 0xbe, 0x53,
+	0xed, 0x13, 0x76, 0xd5, 0x44, 0xe2, 0xeb, 0xe9, 0xe6, 0x87, 0x37, 0xe2, 0xcf, 0x31, 0xfa, 0x3c,
+	0xc0, 0xd1, 0x61, 0x86, 0x7f, 0x3e, 0x7d, 0xf0, 0xfb, 0x00, 0xac, 0xc3, 0xf3, 0xe2, 0xaf, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -1030,6 +1398,108 @@ func (m *PacketId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pruned {
+		i--
+		if m.Pruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AcknowledgementWritten {
+		i--
+		if m.AcknowledgementWritten {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Received {
+		i--
+		if m.Received {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Flushing {
+		i--
+		if m.Flushing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutElapsed {
+		i--
+		if m.TimeoutElapsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintChannel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1154,6 +1624,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ReceiptRetention.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1167,21 +1647,154 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovChannel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReceiptRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Channel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ReceiptRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimePeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HeightPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.HeightPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Timestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptPruningProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptPruningProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptPruningProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPrunedHeight != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.LastPrunedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalPruned != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TotalPruned))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pending != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.State != 0 {
@@ -1344,6 +1957,44 @@ func (m *PacketId) Size() (n int) {
 	return n
 }
 
+func (m *PacketLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovChannel(uint64(m.Status))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.TimeoutElapsed {
+		n += 2
+	}
+	if m.Flushing {
+		n += 2
+	}
+	if m.Received {
+		n += 2
+	}
+	if m.AcknowledgementWritten {
+		n += 2
+	}
+	if m.Pruned {
+		n += 2
+	}
+	return n
+}
+
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1400,6 +2051,69 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	l = m.ReceiptRetention.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	return n
+}
+
+func (m *ReceiptRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeightPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.HeightPeriod))
+	}
+	if m.TimePeriod != 0 {
+		n += 1 + sovChannel(uint64(m.TimePeriod))
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
+func (m *ScheduledReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovChannel(uint64(m.Timestamp))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	return n
+}
+
+func (m *ReceiptPruningProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		n += 1 + sovChannel(uint64(m.Pending))
+	}
+	if m.TotalPruned != 0 {
+		n += 1 + sovChannel(uint64(m.TotalPruned))
+	}
+	if m.LastPrunedHeight != 0 {
+		n += 1 + sovChannel(uint64(m.LastPrunedHeight))
+	}
 	return n
 }
 
@@ -2578,7 +3292,7 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+func (m *PacketLifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,15 +3315,53 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketLifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 21:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2636,15 +3388,16 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Response = &Acknowledgement_Result{v}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
 			iNdEx = postIndex
-		case 22:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2654,34 +3407,253 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Response = &Acknowledgement_Error{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipChannel(dAtA[iNdEx:])
-			if err != nil {
+			if m.Timeout == nil {
+				m.Timeout = &Timeout{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthChannel
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutElapsed", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutElapsed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flushing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Flushing = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Received = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementWritten", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcknowledgementWritten = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pruned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Response = &Acknowledgement_Result{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = &Acknowledgement_Error{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2857,6 +3829,438 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightPeriod", wireType)
+			}
+			m.HeightPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimePeriod", wireType)
+			}
+			m.TimePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptPruningProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptPruningProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptPruningProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPruned", wireType)
+			}
+			m.TotalPruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrunedHeight", wireType)
+			}
+			m.LastPrunedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPrunedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
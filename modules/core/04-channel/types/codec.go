@@ -39,6 +39,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
+		&MsgTimeouts{},
 		&MsgChannelUpgradeInit{},
 		&MsgChannelUpgradeTry{},
 		&MsgChannelUpgradeAck{},
@@ -46,7 +49,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeOpen{},
 		&MsgChannelUpgradeTimeout{},
 		&MsgChannelUpgradeCancel{},
+		&MsgChannelUpgradeMigrateConnection{},
 		&MsgPruneAcknowledgements{},
+		&MsgScheduleReceiptPruning{},
+		&MsgChannelForceClose{},
+		&MsgChannelForceTimeout{},
 		&MsgUpdateParams{},
 	)
 
@@ -56,4 +56,7 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+	ErrTimeoutReceiptWritten           = errorsmod.Register(SubModuleName, 43, "packet timed out, timeout receipt written")
+	ErrInvalidConnectionMigration      = errorsmod.Register(SubModuleName, 44, "invalid connection migration")
+	ErrInvalidReceiptRetention         = errorsmod.Register(SubModuleName, 45, "invalid receipt retention")
 )
@@ -22,14 +22,19 @@ const (
 	AttributeKeyUpgradeSequence         = "upgrade_sequence"
 	AttributeKeyErrorReceipt            = "error_receipt"
 
+	// force close specific keys
+	AttributeKeyPendingPacketCommitments = "pending_packet_commitments"
+
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
 
-	EventTypeSendPacket        = "send_packet"
-	EventTypeRecvPacket        = "recv_packet"
-	EventTypeWriteAck          = "write_acknowledgement"
-	EventTypeAcknowledgePacket = "acknowledge_packet"
-	EventTypeTimeoutPacket     = "timeout_packet"
+	EventTypeSendPacket          = "send_packet"
+	EventTypeRecvPacket          = "recv_packet"
+	EventTypeWriteAck            = "write_acknowledgement"
+	EventTypeAcknowledgePacket   = "acknowledge_packet"
+	EventTypeTimeoutPacket       = "timeout_packet"
+	EventTypeWriteTimeoutReceipt = "write_timeout_receipt"
+	EventTypeForceTimeoutPacket  = "force_timeout_packet"
 
 	// Deprecated: in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -47,6 +52,10 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+
+	// send packet relative timeout specific keys
+	AttributeKeyRelativeTimeoutHeight    = "packet_relative_timeout_height"
+	AttributeKeyRelativeTimeoutTimestamp = "packet_relative_timeout_timestamp"
 )
 
 // IBC channel events vars
@@ -58,6 +67,7 @@ var (
 	EventTypeChannelCloseInit      = "channel_close_init"
 	EventTypeChannelCloseConfirm   = "channel_close_confirm"
 	EventTypeChannelClosed         = "channel_close"
+	EventTypeChannelForceClose     = "channel_force_close"
 	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
 	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
 	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
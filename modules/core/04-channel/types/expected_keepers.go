@@ -45,6 +45,16 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		commitmentBytes []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proofs [][]byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+		commitments [][]byte,
+	) error
 	VerifyPacketAcknowledgement(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -55,6 +65,16 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proofs [][]byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+		acknowledgements [][]byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -64,6 +84,33 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketReceiptAbsences(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proofs [][]byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+	) error
+	VerifyPacketCommitmentAbsences(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proofs [][]byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+	) error
+	VerifyPacketTimeoutReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -41,6 +42,25 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewPacketTimeoutState creates a new PacketTimeoutState instance.
+func NewPacketTimeoutState(portID, channelID string, seq uint64, timeout Timeout) PacketTimeoutState {
+	return PacketTimeoutState{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+		Timeout:   timeout,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pt PacketTimeoutState) Validate() error {
+	if !pt.Timeout.IsValid() {
+		return ErrInvalidTimeout
+	}
+	return validateGenFields(pt.PortId, pt.ChannelId, pt.Sequence)
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
@@ -59,6 +79,28 @@ func NewGenesisState(
 	}
 }
 
+// NewScheduledReceipt creates a new ScheduledReceipt instance.
+func NewScheduledReceipt(portID, channelID string, sequence, timestamp uint64, height clienttypes.Height) ScheduledReceipt {
+	return ScheduledReceipt{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Timestamp: timestamp,
+		Height:    height,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (sr ScheduledReceipt) Validate() error {
+	if err := validateGenFields(sr.PortId, sr.ChannelId, sr.Sequence); err != nil {
+		return err
+	}
+	if sr.Timestamp == 0 && sr.Height.IsZero() {
+		return errors.New("timestamp and height cannot both be zero")
+	}
+	return nil
+}
+
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
@@ -71,6 +113,7 @@ func DefaultGenesisState() GenesisState {
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
 		Params:              DefaultParams(),
+		ScheduledReceipts:   []ScheduledReceipt{},
 	}
 }
 
@@ -142,6 +185,39 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, sr := range gs.ScheduledReceipts {
+		if err := sr.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled receipt %v index %d: %w", sr, i, err)
+		}
+	}
+
+	for i, pt := range gs.TimedOutPackets {
+		if err := pt.Validate(); err != nil {
+			return fmt.Errorf("invalid timed out packet %v index %d: %w", pt, i, err)
+		}
+	}
+
+	for i, sp := range gs.ScheduledTimedOutPackets {
+		if err := sp.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled timed out packet %v index %d: %w", sp, i, err)
+		}
+		if sp.Timestamp == 0 {
+			return fmt.Errorf("invalid scheduled timed out packet %v index %d: timestamp cannot be zero", sp, i)
+		}
+	}
+
+	for i, ss := range gs.PacketStatusStartSequences {
+		if err := ss.Validate(); err != nil {
+			return fmt.Errorf("invalid packet status start sequence %v index %d: %w", ss, i, err)
+		}
+	}
+
+	for i, re := range gs.ReceiptExpiries {
+		if err := re.Validate(); err != nil {
+			return fmt.Errorf("invalid receipt expiry %v index %d: %w", re, i, err)
+		}
+	}
+
 	return nil
 }
 
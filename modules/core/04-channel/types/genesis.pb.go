@@ -35,6 +35,18 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the packet receipts scheduled to be pruned
+	ScheduledReceipts []ScheduledReceipt `protobuf:"bytes,10,rep,name=scheduled_receipts,json=scheduledReceipts,proto3" json:"scheduled_receipts"`
+	// the progress of the scheduled pruning of packet receipts
+	ReceiptPruningProgress ReceiptPruningProgress `protobuf:"bytes,11,opt,name=receipt_pruning_progress,json=receiptPruningProgress,proto3" json:"receipt_pruning_progress"`
+	// the timeouts of the packets timed out
+	TimedOutPackets []PacketTimeoutState `protobuf:"bytes,12,rep,name=timed_out_packets,json=timedOutPackets,proto3" json:"timed_out_packets"`
+	// the records of packets timed out scheduled to be pruned
+	ScheduledTimedOutPackets []ScheduledReceipt `protobuf:"bytes,13,rep,name=scheduled_timed_out_packets,json=scheduledTimedOutPackets,proto3" json:"scheduled_timed_out_packets"`
+	// the sequences of the first packets sent on each channel whose acknowledgement or timeout is recorded
+	PacketStatusStartSequences []PacketSequence `protobuf:"bytes,14,rep,name=packet_status_start_sequences,json=packetStatusStartSequences,proto3" json:"packet_status_start_sequences"`
+	// the expiries of the packet receipts which are not yet scheduled to be pruned
+	ReceiptExpiries []ScheduledReceipt `protobuf:"bytes,15,rep,name=receipt_expiries,json=receiptExpiries,proto3" json:"receipt_expiries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +145,48 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledReceipts() []ScheduledReceipt {
+	if m != nil {
+		return m.ScheduledReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptPruningProgress() ReceiptPruningProgress {
+	if m != nil {
+		return m.ReceiptPruningProgress
+	}
+	return ReceiptPruningProgress{}
+}
+
+func (m *GenesisState) GetTimedOutPackets() []PacketTimeoutState {
+	if m != nil {
+		return m.TimedOutPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledTimedOutPackets() []ScheduledReceipt {
+	if m != nil {
+		return m.ScheduledTimedOutPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPacketStatusStartSequences() []PacketSequence {
+	if m != nil {
+		return m.PacketStatusStartSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptExpiries() []ScheduledReceipt {
+	if m != nil {
+		return m.ReceiptExpiries
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,45 +249,129 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// PacketTimeoutState defines the genesis type necessary to retrieve and store
+// the timeout of a packet in flight or timed out.
+type PacketTimeoutState struct {
+	PortId    string  `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string  `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timeout   Timeout `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout"`
+}
+
+func (m *PacketTimeoutState) Reset()         { *m = PacketTimeoutState{} }
+func (m *PacketTimeoutState) String() string { return proto.CompactTextString(m) }
+func (*PacketTimeoutState) ProtoMessage()    {}
+func (*PacketTimeoutState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *PacketTimeoutState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketTimeoutState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketTimeoutState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketTimeoutState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketTimeoutState.Merge(m, src)
+}
+func (m *PacketTimeoutState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketTimeoutState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketTimeoutState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketTimeoutState proto.InternalMessageInfo
+
+func (m *PacketTimeoutState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PacketTimeoutState) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketTimeoutState) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketTimeoutState) GetTimeout() Timeout {
+	if m != nil {
+		return m.Timeout
+	}
+	return Timeout{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*PacketTimeoutState)(nil), "ibc.core.channel.v1.PacketTimeoutState")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xc0, 0xae, 0xe5, 0x00, 0xbd, 0xa0, 0x29, 0xbb, 0x21, 0xa1, 0x2a, 0xb1,
-	0x3f, 0x32, 0xab, 0x8d, 0x1d, 0x62, 0xb7, 0xc0, 0xbf, 0xe0, 0xb7, 0xf0, 0x2b, 0x76, 0xdc, 0x91,
-	0xd3, 0x84, 0xda, 0x7f, 0xc1, 0x09, 0xc5, 0x71, 0xb2, 0xa2, 0x95, 0x49, 0xbd, 0xc5, 0xdf, 0xf7,
-	0xbe, 0xcf, 0xfb, 0x1e, 0xf2, 0xa1, 0x67, 0x3c, 0xa2, 0x84, 0xca, 0x0c, 0x08, 0xbd, 0x08, 0x85,
-	0x80, 0x29, 0x99, 0x9f, 0x90, 0x18, 0x04, 0x28, 0xae, 0xfc, 0x34, 0x93, 0x5a, 0xe2, 0x03, 0x1e,
-	0x51, 0x3f, 0x97, 0xf8, 0x56, 0xe2, 0xcf, 0x4f, 0xba, 0x0f, 0x62, 0x19, 0x4b, 0xb3, 0x27, 0xf9,
-	0x57, 0x21, 0xed, 0xae, 0xa5, 0x95, 0x2e, 0x23, 0x39, 0xfa, 0xb9, 0x8d, 0x76, 0xdf, 0x15, 0xfc,
-	0x73, 0x1d, 0x6a, 0xc0, 0x9f, 0x50, 0xcb, 0x2a, 0x94, 0xeb, 0xf4, 0xea, 0xfd, 0xce, 0xe0, 0x85,
-	0xbf, 0x26, 0xd1, 0x1f, 0x31, 0x10, 0x9a, 0x7f, 0xe6, 0xc0, 0xde, 0x16, 0xc3, 0xe1, 0xe3, 0xcb,
-	0xeb, 0xc3, 0xda, 0x9f, 0xeb, 0xc3, 0xfb, 0xb7, 0x56, 0x41, 0x85, 0xc4, 0x01, 0xba, 0x17, 0xd2,
-	0x89, 0x90, 0x5f, 0xa7, 0xc0, 0x62, 0x48, 0x40, 0x68, 0xe5, 0x6e, 0x99, 0x98, 0xde, 0xda, 0x98,
-	0xb3, 0x90, 0x4e, 0x40, 0x9b, 0x6a, 0xc3, 0x46, 0x1e, 0x10, 0xdc, 0xf2, 0xe3, 0xf7, 0xa8, 0x43,
-	0x65, 0x92, 0x70, 0x5d, 0xe0, 0xea, 0x1b, 0xe1, 0x56, 0xad, 0x78, 0x88, 0x5a, 0x19, 0x50, 0xe0,
-	0xa9, 0x56, 0x6e, 0x63, 0x23, 0x4c, 0xe5, 0xc3, 0x67, 0x68, 0x5f, 0x81, 0x60, 0x63, 0x05, 0x5f,
-	0x66, 0x20, 0x28, 0x28, 0x77, 0xdb, 0x90, 0x9e, 0xdf, 0x45, 0xb2, 0x5a, 0x0b, 0xdb, 0xcb, 0x01,
-	0xe5, 0xcc, 0x10, 0x33, 0xa0, 0xf3, 0x15, 0x62, 0x73, 0x63, 0x62, 0x0e, 0xb8, 0x21, 0x7e, 0x40,
-	0x7b, 0x21, 0x9d, 0xac, 0x00, 0x77, 0x36, 0x05, 0xee, 0x86, 0x74, 0x72, 0xc3, 0x1b, 0xa0, 0x87,
-	0x02, 0xbe, 0xe9, 0xb1, 0x75, 0x55, 0x60, 0xb7, 0xd5, 0x73, 0xfa, 0x8d, 0xe0, 0x20, 0x5f, 0xda,
-	0x7f, 0xa1, 0x34, 0xe1, 0x53, 0xd4, 0x4c, 0xc3, 0x2c, 0x4c, 0x94, 0xdb, 0xee, 0x39, 0xfd, 0xce,
-	0xe0, 0xc9, 0x7f, 0xc2, 0x73, 0x89, 0x0d, 0xb5, 0x86, 0x23, 0x86, 0xf6, 0xff, 0x2d, 0x85, 0x1f,
-	0xa1, 0x9d, 0x54, 0x66, 0x7a, 0xcc, 0x99, 0xeb, 0xf4, 0x9c, 0x7e, 0x3b, 0x68, 0xe6, 0xcf, 0x11,
-	0xc3, 0x4f, 0x11, 0x2a, 0x4b, 0x71, 0xe6, 0x6e, 0x99, 0x5d, 0xdb, 0x4e, 0x46, 0x0c, 0x77, 0x51,
-	0xab, 0xea, 0x5a, 0x37, 0x5d, 0xab, 0xf7, 0xf0, 0xfc, 0x72, 0xe1, 0x39, 0x57, 0x0b, 0xcf, 0xf9,
-	0xbd, 0xf0, 0x9c, 0x1f, 0x4b, 0xaf, 0x76, 0xb5, 0xf4, 0x6a, 0xbf, 0x96, 0x5e, 0xed, 0xe3, 0x69,
-	0xcc, 0xf5, 0xc5, 0x2c, 0xf2, 0xa9, 0x4c, 0x08, 0x95, 0x2a, 0x91, 0x8a, 0xf0, 0x88, 0x1e, 0xc7,
-	0x92, 0xcc, 0xdf, 0x90, 0x44, 0xb2, 0xd9, 0x14, 0x54, 0x71, 0x77, 0x2f, 0x5f, 0x1f, 0x97, 0xa7,
-	0xa7, 0xbf, 0xa7, 0xa0, 0xa2, 0xa6, 0x39, 0xbb, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf,
-	0xa9, 0x60, 0xe8, 0xe9, 0x03, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6f, 0xd3, 0x3c,
+	0x1c, 0xc7, 0x9b, 0xad, 0x4f, 0xd7, 0xb9, 0xfb, 0xeb, 0x3d, 0x7f, 0xfc, 0x74, 0xac, 0x2b, 0x43,
+	0x40, 0x25, 0xb4, 0x86, 0x0d, 0x0e, 0x4c, 0xe2, 0x54, 0x84, 0x60, 0x17, 0xa8, 0xba, 0x09, 0x89,
+	0x49, 0x28, 0x4a, 0x9d, 0x1f, 0x99, 0x69, 0x13, 0x87, 0xd8, 0x29, 0xe3, 0x1d, 0x70, 0xe4, 0x55,
+	0xf0, 0x5a, 0x76, 0xdc, 0x91, 0xd3, 0x84, 0xb6, 0x77, 0xc1, 0x09, 0xc5, 0x71, 0xd2, 0x6e, 0x0d,
+	0x93, 0x72, 0xe0, 0xb4, 0xc5, 0xfe, 0x7e, 0x3f, 0x5f, 0xfb, 0xe7, 0x9f, 0x6b, 0x74, 0x9b, 0xf5,
+	0xa9, 0x49, 0x79, 0x08, 0x26, 0x3d, 0xb6, 0x7d, 0x1f, 0x86, 0xe6, 0x68, 0xc7, 0x74, 0xc1, 0x07,
+	0xc1, 0x44, 0x3b, 0x08, 0xb9, 0xe4, 0x78, 0x8d, 0xf5, 0x69, 0x3b, 0x96, 0xb4, 0xb5, 0xa4, 0x3d,
+	0xda, 0xa9, 0xff, 0xed, 0x72, 0x97, 0xab, 0x79, 0x33, 0xfe, 0x2f, 0x91, 0xd6, 0x73, 0x69, 0xa9,
+	0x4b, 0x49, 0xb6, 0xbe, 0x20, 0xb4, 0xf0, 0x22, 0xe1, 0x1f, 0x48, 0x5b, 0x02, 0x7e, 0x87, 0xaa,
+	0x5a, 0x21, 0x88, 0xd1, 0x9c, 0x6d, 0xd5, 0x76, 0xef, 0xb5, 0x73, 0x12, 0xdb, 0xfb, 0x0e, 0xf8,
+	0x92, 0xbd, 0x67, 0xe0, 0x3c, 0x4b, 0x06, 0x3b, 0xff, 0x9f, 0x9e, 0x6f, 0x96, 0x7e, 0x9e, 0x6f,
+	0xae, 0x4e, 0x4d, 0xf5, 0x32, 0x24, 0xee, 0xa1, 0x15, 0x9b, 0x0e, 0x7c, 0xfe, 0x69, 0x08, 0x8e,
+	0x0b, 0x1e, 0xf8, 0x52, 0x90, 0x19, 0x15, 0xd3, 0xcc, 0x8d, 0xe9, 0xda, 0x74, 0x00, 0x52, 0x2d,
+	0xad, 0x53, 0x8e, 0x03, 0x7a, 0x53, 0x7e, 0xfc, 0x12, 0xd5, 0x28, 0xf7, 0x3c, 0x26, 0x13, 0xdc,
+	0x6c, 0x21, 0xdc, 0xa4, 0x15, 0x77, 0x50, 0x35, 0x04, 0x0a, 0x2c, 0x90, 0x82, 0x94, 0x0b, 0x61,
+	0x32, 0x1f, 0xee, 0xa2, 0x25, 0x01, 0xbe, 0x63, 0x09, 0xf8, 0x18, 0x81, 0x4f, 0x41, 0x90, 0xbf,
+	0x14, 0xe9, 0xce, 0x4d, 0x24, 0xad, 0xd5, 0xb0, 0xc5, 0x18, 0x90, 0x8e, 0x29, 0x62, 0x08, 0x74,
+	0x34, 0x41, 0xac, 0x14, 0x26, 0xc6, 0x80, 0x31, 0xf1, 0x15, 0x5a, 0xb4, 0xe9, 0x60, 0x02, 0x38,
+	0x57, 0x14, 0xb8, 0x60, 0xd3, 0xc1, 0x98, 0xb7, 0x8b, 0xfe, 0xf1, 0xe1, 0x44, 0x5a, 0xda, 0x95,
+	0x81, 0x49, 0xb5, 0x69, 0xb4, 0xca, 0xbd, 0xb5, 0x78, 0x52, 0xf7, 0x42, 0x6a, 0xc2, 0x7b, 0xa8,
+	0x12, 0xd8, 0xa1, 0xed, 0x09, 0x32, 0xdf, 0x34, 0x5a, 0xb5, 0xdd, 0xf5, 0xdf, 0x84, 0xc7, 0x12,
+	0x1d, 0xaa, 0x0d, 0xf8, 0x08, 0x61, 0x41, 0x8f, 0xc1, 0x89, 0x86, 0xe0, 0x58, 0xd9, 0x81, 0x21,
+	0xb5, 0x87, 0xbb, 0xb9, 0x98, 0x83, 0x54, 0xde, 0x4b, 0xd4, 0x1a, 0xb8, 0x2a, 0xae, 0x8d, 0x0b,
+	0x3c, 0x40, 0x44, 0x13, 0xad, 0x20, 0x8c, 0x7c, 0xe6, 0xbb, 0x56, 0x10, 0x72, 0x37, 0x04, 0x21,
+	0x48, 0x4d, 0x2d, 0xf4, 0x41, 0x6e, 0x82, 0x06, 0x74, 0x13, 0x4f, 0x57, 0x5b, 0x74, 0xce, 0xbf,
+	0x61, 0xee, 0x2c, 0x7e, 0x8b, 0x56, 0x25, 0xf3, 0xc0, 0xb1, 0x78, 0x24, 0xad, 0x40, 0xd5, 0x59,
+	0x90, 0x05, 0xb5, 0x8f, 0xfb, 0x37, 0x9c, 0xc5, 0x21, 0xf3, 0x80, 0x47, 0x57, 0xfa, 0x6f, 0x59,
+	0x71, 0x5e, 0x47, 0x32, 0x51, 0x08, 0xfc, 0x01, 0xad, 0x8f, 0x6b, 0x34, 0x1d, 0xb2, 0x58, 0xbc,
+	0x58, 0x24, 0xe3, 0x1d, 0x5e, 0xcb, 0x1a, 0xa2, 0x8d, 0x84, 0x6b, 0x09, 0x69, 0xcb, 0x48, 0xc4,
+	0x7f, 0x42, 0x39, 0xd1, 0x5e, 0x4b, 0x45, 0xdb, 0xab, 0x1e, 0x64, 0x37, 0x2c, 0x8a, 0x7f, 0x98,
+	0x42, 0x39, 0x6e, 0xb6, 0x37, 0x68, 0x25, 0x3d, 0x21, 0x38, 0x09, 0x58, 0xc8, 0x40, 0x90, 0xe5,
+	0xe2, 0xdb, 0x59, 0xd6, 0x90, 0xe7, 0x9a, 0xb1, 0xe5, 0xa0, 0xa5, 0xab, 0x6b, 0xc1, 0xff, 0xa1,
+	0xb9, 0x80, 0x87, 0xd2, 0x62, 0x0e, 0x31, 0x9a, 0x46, 0x6b, 0xbe, 0x57, 0x89, 0x3f, 0xf7, 0x1d,
+	0xbc, 0x81, 0x50, 0xda, 0xea, 0xcc, 0x21, 0x33, 0x6a, 0x6e, 0x5e, 0x8f, 0xec, 0x3b, 0xb8, 0x8e,
+	0xaa, 0xd9, 0x0d, 0x98, 0x55, 0x37, 0x20, 0xfb, 0xde, 0xfa, 0x66, 0x20, 0x3c, 0x7d, 0x8a, 0x7f,
+	0x22, 0x0a, 0x3f, 0x45, 0x73, 0x32, 0xc9, 0x20, 0x65, 0xd5, 0xb9, 0xb7, 0x72, 0xeb, 0xa3, 0xd7,
+	0xa1, 0xcb, 0x92, 0x5a, 0x3a, 0x07, 0xa7, 0x17, 0x0d, 0xe3, 0xec, 0xa2, 0x61, 0xfc, 0xb8, 0x68,
+	0x18, 0x5f, 0x2f, 0x1b, 0xa5, 0xb3, 0xcb, 0x46, 0xe9, 0xfb, 0x65, 0xa3, 0x74, 0xb4, 0xe7, 0x32,
+	0x79, 0x1c, 0xf5, 0xdb, 0x94, 0x7b, 0x26, 0xe5, 0xc2, 0xe3, 0xc2, 0x64, 0x7d, 0xba, 0xed, 0x72,
+	0x73, 0xf4, 0xc4, 0xf4, 0x78, 0x5c, 0x6b, 0x91, 0x3c, 0x3b, 0x0f, 0x1f, 0x6f, 0xa7, 0x2f, 0x8f,
+	0xfc, 0x1c, 0x80, 0xe8, 0x57, 0xd4, 0xab, 0xf3, 0xe8, 0xd7, 0x00, 0x27, 0xd5, 0x55, 0x55, 0xe8,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +394,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiptExpiries) > 0 {
+		for iNdEx := len(m.ReceiptExpiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptExpiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PacketStatusStartSequences) > 0 {
+		for iNdEx := len(m.PacketStatusStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketStatusStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ScheduledTimedOutPackets) > 0 {
+		for iNdEx := len(m.ScheduledTimedOutPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTimedOutPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TimedOutPackets) > 0 {
+		for iNdEx := len(m.TimedOutPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedOutPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.ReceiptPruningProgress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ScheduledReceipts) > 0 {
+		for iNdEx := len(m.ScheduledReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -414,6 +632,58 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketTimeoutState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketTimeoutState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketTimeoutState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -478,6 +748,38 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledReceipts) > 0 {
+		for _, e := range m.ScheduledReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ReceiptPruningProgress.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TimedOutPackets) > 0 {
+		for _, e := range m.TimedOutPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTimedOutPackets) > 0 {
+		for _, e := range m.ScheduledTimedOutPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PacketStatusStartSequences) > 0 {
+		for _, e := range m.PacketStatusStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptExpiries) > 0 {
+		for _, e := range m.ReceiptExpiries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +803,28 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *PacketTimeoutState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Timeout.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,27 +1150,230 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledReceipts", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			m.ScheduledReceipts = append(m.ScheduledReceipts, ScheduledReceipt{})
+			if err := m.ScheduledReceipts[len(m.ScheduledReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptPruningProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptPruningProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedOutPackets = append(m.TimedOutPackets, PacketTimeoutState{})
+			if err := m.TimedOutPackets[len(m.TimedOutPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimedOutPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTimedOutPackets = append(m.ScheduledTimedOutPackets, ScheduledReceipt{})
+			if err := m.ScheduledTimedOutPackets[len(m.ScheduledTimedOutPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatusStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatusStartSequences = append(m.PacketStatusStartSequences, PacketSequence{})
+			if err := m.PacketStatusStartSequences[len(m.PacketStatusStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptExpiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptExpiries = append(m.ReceiptExpiries, ScheduledReceipt{})
+			if err := m.ReceiptExpiries[len(m.ReceiptExpiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -980,6 +1507,172 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketTimeoutState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketTimeoutState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketTimeoutState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
@@ -138,6 +139,104 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid scheduled receipts",
+			genState: types.GenesisState{
+				ScheduledReceipts: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 100, clienttypes.ZeroHeight()),
+					types.NewScheduledReceipt(testPort1, testChannel1, 2, 0, clienttypes.NewHeight(1, 100)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid scheduled receipt",
+			genState: types.GenesisState{
+				ScheduledReceipts: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, "(testChannel1)", 1, 100, clienttypes.ZeroHeight()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid scheduled receipt: zero timestamp and height",
+			genState: types.GenesisState{
+				ScheduledReceipts: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 0, clienttypes.ZeroHeight()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid receipt expiries",
+			genState: types.GenesisState{
+				ReceiptExpiries: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 100, clienttypes.ZeroHeight()),
+					types.NewScheduledReceipt(testPort1, testChannel1, 2, 0, clienttypes.NewHeight(1, 100)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid receipt expiry: zero timestamp and height",
+			genState: types.GenesisState{
+				ReceiptExpiries: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 0, clienttypes.ZeroHeight()),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid timed out packets and packet status start sequences",
+			genState: types.GenesisState{
+				TimedOutPackets: []types.PacketTimeoutState{
+					types.NewPacketTimeoutState(testPort1, testChannel1, 1, types.NewTimeout(clienttypes.ZeroHeight(), 100)),
+				},
+				ScheduledTimedOutPackets: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 100, clienttypes.ZeroHeight()),
+				},
+				PacketStatusStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid timed out packet: empty timeout",
+			genState: types.GenesisState{
+				TimedOutPackets: []types.PacketTimeoutState{
+					types.NewPacketTimeoutState(testPort1, testChannel1, 1, types.Timeout{}),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid timed out packet",
+			genState: types.GenesisState{
+				TimedOutPackets: []types.PacketTimeoutState{
+					types.NewPacketTimeoutState(testPort1, testChannel1, 0, types.NewTimeout(clienttypes.ZeroHeight(), 100)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid scheduled timed out packet: zero timestamp",
+			genState: types.GenesisState{
+				ScheduledTimedOutPackets: []types.ScheduledReceipt{
+					types.NewScheduledReceipt(testPort1, testChannel1, 1, 0, clienttypes.NewHeight(1, 100)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid packet status start sequence",
+			genState: types.GenesisState{
+				PacketStatusStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
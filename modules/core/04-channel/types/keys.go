@@ -6,6 +6,9 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -31,6 +34,32 @@ const (
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyReceiptPruningTimeQueue defines the key prefix under which the packet receipts scheduled
+	// to be pruned at a timestamp are stored.
+	KeyReceiptPruningTimeQueue = "receiptPruningTimeQueue"
+
+	// KeyReceiptPruningHeightQueue defines the key prefix under which the packet receipts scheduled
+	// to be pruned at a height are stored.
+	KeyReceiptPruningHeightQueue = "receiptPruningHeightQueue"
+
+	// KeyReceiptExpiryPrefix defines the key prefix under which the expiries of the packet receipts which
+	// are not yet scheduled to be pruned are stored.
+	KeyReceiptExpiryPrefix = "receiptExpiries"
+
+	// KeyReceiptPruningProgress defines the key to store the progress of the scheduled pruning of packet receipts.
+	KeyReceiptPruningProgress = "receiptPruningProgress"
+
+	// KeyTimedOutPacketPrefix defines the key prefix under which the timeouts of the packets timed out are stored.
+	KeyTimedOutPacketPrefix = "timedOutPackets"
+
+	// KeyTimedOutPacketPruningQueue defines the key prefix under which the records of packets timed out
+	// scheduled to be pruned at a timestamp are stored.
+	KeyTimedOutPacketPruningQueue = "timedOutPacketPruningQueue"
+
+	// KeyPacketStatusStartSequencePrefix defines the key prefix under which the sequence of the first packet
+	// sent on a channel whose acknowledgement or timeout is recorded is stored.
+	KeyPacketStatusStartSequencePrefix = "packetStatusStartSequence"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -68,3 +97,55 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
 }
+
+// ReceiptPruningTimeQueuePrefix returns the key prefix of the packet receipts scheduled to be pruned at the given timestamp.
+func ReceiptPruningTimeQueuePrefix(timestamp uint64) []byte {
+	return append([]byte(KeyReceiptPruningTimeQueue+"/"), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
+// ReceiptPruningTimeQueueKey returns the store key of a packet receipt scheduled to be pruned at the given timestamp.
+func ReceiptPruningTimeQueueKey(timestamp uint64, portID, channelID string, sequence uint64) []byte {
+	return append(ReceiptPruningTimeQueuePrefix(timestamp), []byte("/"+host.PacketReceiptPath(portID, channelID, sequence))...)
+}
+
+// ReceiptPruningHeightQueuePrefix returns the key prefix of the packet receipts scheduled to be pruned at the given height.
+func ReceiptPruningHeightQueuePrefix(height clienttypes.Height) []byte {
+	bz := append([]byte(KeyReceiptPruningHeightQueue+"/"), sdk.Uint64ToBigEndian(height.RevisionNumber)...)
+	return append(bz, sdk.Uint64ToBigEndian(height.RevisionHeight)...)
+}
+
+// ReceiptPruningHeightQueueKey returns the store key of a packet receipt scheduled to be pruned at the given height.
+func ReceiptPruningHeightQueueKey(height clienttypes.Height, portID, channelID string, sequence uint64) []byte {
+	return append(ReceiptPruningHeightQueuePrefix(height), []byte("/"+host.PacketReceiptPath(portID, channelID, sequence))...)
+}
+
+// ReceiptExpiryKey returns the store key under which the expiry of a packet receipt which is not yet scheduled to be pruned is stored.
+func ReceiptExpiryKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyReceiptExpiryPrefix, host.PacketReceiptPath(portID, channelID, sequence)))
+}
+
+// TimedOutPacketPrefixPath returns the path prefix under which the timeouts of the packets timed out on a channel are stored.
+func TimedOutPacketPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/", KeyTimedOutPacketPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID, host.KeySequencePrefix)
+}
+
+// TimedOutPacketKey returns the store key under which the timeout of a packet timed out is stored.
+func TimedOutPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", TimedOutPacketPrefixPath(portID, channelID), sequence))
+}
+
+// TimedOutPacketPruningQueuePrefix returns the key prefix of the records of packets timed out scheduled to be pruned at the given timestamp.
+func TimedOutPacketPruningQueuePrefix(timestamp uint64) []byte {
+	return append([]byte(KeyTimedOutPacketPruningQueue+"/"), sdk.Uint64ToBigEndian(timestamp)...)
+}
+
+// TimedOutPacketPruningQueueKey returns the store key of a record of a packet timed out scheduled to be pruned at the given timestamp.
+func TimedOutPacketPruningQueueKey(timestamp uint64, portID, channelID string, sequence uint64) []byte {
+	return append(TimedOutPacketPruningQueuePrefix(timestamp), append([]byte("/"), TimedOutPacketKey(portID, channelID, sequence)...)...)
+}
+
+// PacketStatusStartSequenceKey returns the store key under which the sequence of the first packet sent on
+// a channel whose acknowledgement or timeout is recorded is stored.
+func PacketStatusStartSequenceKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeyPacketStatusStartSequencePrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
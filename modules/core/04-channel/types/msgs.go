@@ -25,13 +25,20 @@ var (
 	_ sdk.Msg = (*MsgAcknowledgement)(nil)
 	_ sdk.Msg = (*MsgTimeout)(nil)
 	_ sdk.Msg = (*MsgTimeoutOnClose)(nil)
+	_ sdk.Msg = (*MsgRecvPackets)(nil)
+	_ sdk.Msg = (*MsgAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgTimeouts)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeAck)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeConfirm)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeMigrateConnection)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgScheduleReceiptPruning)(nil)
+	_ sdk.Msg = (*MsgChannelForceClose)(nil)
+	_ sdk.Msg = (*MsgChannelForceTimeout)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -43,15 +50,27 @@ var (
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgTimeoutOnClose)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgTimeouts)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTry)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeAck)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeConfirm)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelUpgradeMigrateConnection)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleReceiptPruning)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelForceClose)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelForceTimeout)(nil)
 )
 
+// MaxBatchSize is the maximum number of packets which may be relayed in a single
+// MsgRecvPackets, MsgAcknowledgements or MsgTimeouts, timed out in a single MsgChannelForceTimeout,
+// or whose receipts are scheduled to be pruned in a single MsgScheduleReceiptPruning.
+const MaxBatchSize = 500
+
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
 // identifier to be empty.
 func NewMsgChannelOpenInit(
@@ -393,6 +412,175 @@ func (msg MsgAcknowledgement) ValidateBasic() error {
 	return msg.Packet.ValidateBasic()
 }
 
+// NewMsgRecvPackets constructs a new MsgRecvPackets
+func NewMsgRecvPackets(
+	packets []Packet, commitmentProof []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:         packets,
+		ProofCommitment: commitmentProof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if err := validateBatchSize(len(msg.Packets)); err != nil {
+		return err
+	}
+	if err := validateBatchProofs(msg.ProofCommitment, msg.ProofsCommitment, len(msg.Packets)); err != nil {
+		return errorsmod.Wrap(err, "invalid commitment proofs")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+	return nil
+}
+
+// CommitmentProofs returns the proofs of the packet commitments to be verified for the batch, which
+// is either the single batch proof or the proof of each packet.
+func (msg MsgRecvPackets) CommitmentProofs() [][]byte {
+	if len(msg.ProofsCommitment) != 0 {
+		return msg.ProofsCommitment
+	}
+	return [][]byte{msg.ProofCommitment}
+}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks [][]byte, ackedProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofAcked:       ackedProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if err := validateBatchSize(len(msg.Packets)); err != nil {
+		return err
+	}
+	if err := validateBatchProofs(msg.ProofAcked, msg.ProofsAcked, len(msg.Packets)); err != nil {
+		return errorsmod.Wrap(err, "invalid acknowledgement proofs")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements (%d) must equal number of packets (%d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if len(msg.Acknowledgements[i]) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgement, "ack bytes cannot be empty at index %d", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+	return nil
+}
+
+// AcknowledgementProofs returns the proofs of the acknowledgements to be verified for the batch, which
+// is either the single batch proof or the proof of each packet.
+func (msg MsgAcknowledgements) AcknowledgementProofs() [][]byte {
+	if len(msg.ProofsAcked) != 0 {
+		return msg.ProofsAcked
+	}
+	return [][]byte{msg.ProofAcked}
+}
+
+// NewMsgTimeouts constructs a new MsgTimeouts
+func NewMsgTimeouts(
+	packets []Packet, unreceivedProof []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgTimeouts {
+	return &MsgTimeouts{
+		Packets:         packets,
+		ProofUnreceived: unreceivedProof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTimeouts) ValidateBasic() error {
+	if err := validateBatchSize(len(msg.Packets)); err != nil {
+		return err
+	}
+	if err := validateBatchProofs(msg.ProofUnreceived, msg.ProofsUnreceived, len(msg.Packets)); err != nil {
+		return errorsmod.Wrap(err, "invalid unreceived proofs")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+	}
+	return nil
+}
+
+// UnreceivedProofs returns the proofs of the absence of the packet receipts to be verified for the
+// batch, which is either the single batch proof or the proof of each packet.
+func (msg MsgTimeouts) UnreceivedProofs() [][]byte {
+	if len(msg.ProofsUnreceived) != 0 {
+		return msg.ProofsUnreceived
+	}
+	return [][]byte{msg.ProofUnreceived}
+}
+
+// validateBatchProofs returns an error unless exactly one of a single batch proof or a non-empty proof
+// for each of the packets of a batch is provided.
+func validateBatchProofs(proof []byte, proofs [][]byte, numPackets int) error {
+	if len(proofs) == 0 {
+		if len(proof) == 0 {
+			return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+		}
+		return nil
+	}
+	if len(proof) != 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit both a batch proof and a proof for each packet")
+	}
+	if len(proofs) != numPackets {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs (%d) must equal number of packets (%d)", len(proofs), numPackets)
+	}
+	for i, proof := range proofs {
+		if len(proof) == 0 {
+			return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof at index %d", i)
+		}
+	}
+	return nil
+}
+
+// validateBatchSize returns an error if the number of packets of a batch is zero or exceeds MaxBatchSize.
+func validateBatchSize(numPackets int) error {
+	if numPackets == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "batch must contain at least one packet")
+	}
+	if numPackets > MaxBatchSize {
+		return errorsmod.Wrapf(ErrInvalidPacket, "batch cannot contain more than %d packets, got %d", MaxBatchSize, numPackets)
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgChannelUpgradeInit{}
 
 // NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
@@ -727,6 +915,37 @@ func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgChannelUpgradeMigrateConnection constructs a new MsgChannelUpgradeMigrateConnection
+// nolint:interfacer
+func NewMsgChannelUpgradeMigrateConnection(portID, channelID, connectionID, signer string) *MsgChannelUpgradeMigrateConnection {
+	return &MsgChannelUpgradeMigrateConnection{
+		PortId:       portID,
+		ChannelId:    channelID,
+		ConnectionId: connectionID,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeMigrateConnection) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
 // NewMsgUpdateChannelParams creates a new instance of MsgUpdateParams.
 func NewMsgUpdateChannelParams(authority string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
@@ -774,3 +993,129 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgScheduleReceiptPruning creates a new instance of MsgScheduleReceiptPruning.
+func NewMsgScheduleReceiptPruning(
+	portID, channelID string, sequences []uint64, uncommittedProof []byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgScheduleReceiptPruning {
+	return &MsgScheduleReceiptPruning{
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequences:        sequences,
+		ProofUncommitted: uncommittedProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgScheduleReceiptPruning.
+func (msg *MsgScheduleReceiptPruning) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if err := validateBatchSize(len(msg.Sequences)); err != nil {
+		return err
+	}
+
+	for i, sequence := range msg.Sequences {
+		if sequence == 0 {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packet sequence cannot be 0 at index %d", i)
+		}
+	}
+
+	if err := validateBatchProofs(msg.ProofUncommitted, msg.ProofsUncommitted, len(msg.Sequences)); err != nil {
+		return errorsmod.Wrap(err, "invalid uncommitted proofs")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// UncommittedProofs returns the proofs of the absence of the counterparty packet commitments to be
+// verified, which is either the single batch proof or the proof of each sequence.
+func (msg *MsgScheduleReceiptPruning) UncommittedProofs() [][]byte {
+	if len(msg.ProofsUncommitted) != 0 {
+		return msg.ProofsUncommitted
+	}
+	return [][]byte{msg.ProofUncommitted}
+}
+
+// NewMsgChannelForceClose creates a new instance of MsgChannelForceClose.
+func NewMsgChannelForceClose(portID, channelID, signer string) *MsgChannelForceClose {
+	return &MsgChannelForceClose{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgChannelForceClose.
+func (msg *MsgChannelForceClose) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgChannelForceTimeout creates a new instance of MsgChannelForceTimeout.
+func NewMsgChannelForceTimeout(portID, channelID string, packets []Packet, signer string) *MsgChannelForceTimeout {
+	return &MsgChannelForceTimeout{
+		PortId:    portID,
+		ChannelId: channelID,
+		Packets:   packets,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgChannelForceTimeout.
+// All packets must have been sent on the port and channel of the message.
+func (msg *MsgChannelForceTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := validateBatchSize(len(msg.Packets)); err != nil {
+		return err
+	}
+
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+
+		if packet.SourcePort != msg.PortId || packet.SourceChannel != msg.ChannelId {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packet at index %d was not sent on port ID (%s) channel ID (%s)", i, msg.PortId, msg.ChannelId)
+		}
+	}
+
+	return nil
+}
@@ -175,9 +175,9 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenInit(portid, version, types.Order(3),
+			types.NewMsgChannelOpenInit(portid, version, types.Order(4),
 				connHops, cpportid, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(3).String()),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
 		},
 		{
 			"connection hops more than 1 ",
@@ -1091,6 +1091,216 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementGetSigners() {
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRecvPackets
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgRecvPackets([]types.Packet{packet, packet}, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"missing signer address",
+			types.NewMsgRecvPackets([]types.Packet{packet}, suite.proof, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"success: proof of each packet",
+			&types.MsgRecvPackets{Packets: []types.Packet{packet, packet}, ProofsCommitment: [][]byte{suite.proof, suite.proof}, ProofHeight: height, Signer: addr},
+			nil,
+		},
+		{
+			"empty proof",
+			types.NewMsgRecvPackets([]types.Packet{packet}, emptyProof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof"), "invalid commitment proofs"),
+		},
+		{
+			"both batch proof and proof of each packet",
+			&types.MsgRecvPackets{Packets: []types.Packet{packet}, ProofCommitment: suite.proof, ProofsCommitment: [][]byte{suite.proof}, ProofHeight: height, Signer: addr},
+			errorsmod.Wrap(errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit both a batch proof and a proof for each packet"), "invalid commitment proofs"),
+		},
+		{
+			"number of proofs does not match number of packets",
+			&types.MsgRecvPackets{Packets: []types.Packet{packet, packet}, ProofsCommitment: [][]byte{suite.proof}, ProofHeight: height, Signer: addr},
+			errorsmod.Wrap(errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs (%d) must equal number of packets (%d)", 1, 2), "invalid commitment proofs"),
+		},
+		{
+			"empty proof of a packet",
+			&types.MsgRecvPackets{Packets: []types.Packet{packet, packet}, ProofsCommitment: [][]byte{suite.proof, emptyProof}, ProofHeight: height, Signer: addr},
+			errorsmod.Wrap(errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof at index %d", 1), "invalid commitment proofs"),
+		},
+		{
+			"no packets",
+			types.NewMsgRecvPackets(nil, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet"),
+		},
+		{
+			"too many packets",
+			types.NewMsgRecvPackets(make([]types.Packet, types.MaxBatchSize+1), suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidPacket, "batch cannot contain more than %d packets, got %d", types.MaxBatchSize, types.MaxBatchSize+1),
+		},
+		{
+			"invalid packet",
+			types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet at index 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAcknowledgements
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgAcknowledgements([]types.Packet{packet, packet}, [][]byte{packet.GetData(), packet.GetData()}, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"missing signer address",
+			types.NewMsgAcknowledgements([]types.Packet{packet}, [][]byte{packet.GetData()}, suite.proof, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"success: proof of each acknowledgement",
+			&types.MsgAcknowledgements{Packets: []types.Packet{packet, packet}, Acknowledgements: [][]byte{packet.GetData(), packet.GetData()}, ProofsAcked: [][]byte{suite.proof, suite.proof}, ProofHeight: height, Signer: addr},
+			nil,
+		},
+		{
+			"empty proof",
+			types.NewMsgAcknowledgements([]types.Packet{packet}, [][]byte{packet.GetData()}, emptyProof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof"), "invalid acknowledgement proofs"),
+		},
+		{
+			"number of proofs does not match number of packets",
+			&types.MsgAcknowledgements{Packets: []types.Packet{packet, packet}, Acknowledgements: [][]byte{packet.GetData(), packet.GetData()}, ProofsAcked: [][]byte{suite.proof}, ProofHeight: height, Signer: addr},
+			errorsmod.Wrap(errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs (%d) must equal number of packets (%d)", 1, 2), "invalid acknowledgement proofs"),
+		},
+		{
+			"no packets",
+			types.NewMsgAcknowledgements(nil, nil, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet"),
+		},
+		{
+			"number of acknowledgements does not match number of packets",
+			types.NewMsgAcknowledgements([]types.Packet{packet, packet}, [][]byte{packet.GetData()}, suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements (%d) must equal number of packets (%d)", 1, 2),
+		},
+		{
+			"empty acknowledgement",
+			types.NewMsgAcknowledgements([]types.Packet{packet, packet}, [][]byte{packet.GetData(), {}}, suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "ack bytes cannot be empty at index %d", 1),
+		},
+		{
+			"invalid packet",
+			types.NewMsgAcknowledgements([]types.Packet{invalidPacket}, [][]byte{packet.GetData()}, suite.proof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet at index 0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgTimeoutsValidateBasic() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTimeouts
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgTimeouts([]types.Packet{packet, packet}, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"success: proof of each packet",
+			&types.MsgTimeouts{Packets: []types.Packet{packet, packet}, ProofsUnreceived: [][]byte{suite.proof, suite.proof}, ProofHeight: height, Signer: addr},
+			nil,
+		},
+		{
+			"missing signer address",
+			types.NewMsgTimeouts([]types.Packet{packet}, suite.proof, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"empty proof",
+			types.NewMsgTimeouts([]types.Packet{packet}, emptyProof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof"), "invalid unreceived proofs"),
+		},
+		{
+			"number of proofs does not match number of packets",
+			&types.MsgTimeouts{Packets: []types.Packet{packet, packet}, ProofsUnreceived: [][]byte{suite.proof}, ProofHeight: height, Signer: addr},
+			errorsmod.Wrap(errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of proofs (%d) must equal number of packets (%d)", 1, 2), "invalid unreceived proofs"),
+		},
+		{
+			"no packets",
+			types.NewMsgTimeouts(nil, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet"),
+		},
+		{
+			"too many packets",
+			types.NewMsgTimeouts(make([]types.Packet, types.MaxBatchSize+1), suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidPacket, "batch cannot contain more than %d packets, got %d", types.MaxBatchSize, types.MaxBatchSize+1),
+		},
+		{
+			"invalid packet",
+			types.NewMsgTimeouts([]types.Packet{packet, invalidPacket}, suite.proof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet at index 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
 	var msg *types.MsgChannelUpgradeInit
 
@@ -1799,6 +2009,260 @@ func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelGetSigners() {
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
 
+func (suite *TypesTestSuite) TestMsgChannelUpgradeMigrateConnectionValidateBasic() {
+	var msg *types.MsgChannelUpgradeMigrateConnection
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			errorsmod.Wrap(
+				errorsmod.Wrapf(
+					host.ErrInvalidID,
+					"identifier %s must contain only alphanumeric or the following characters: '.', '_', '+', '-', '#', '[', ']', '<', '>'",
+					invalidPort,
+				), "invalid port ID",
+			),
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.ConnectionId = invalidConnection
+			},
+			errorsmod.Wrap(host.ConnectionIdentifierValidator(invalidConnection), "invalid connection ID"),
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelUpgradeMigrateConnection(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.FirstConnectionID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeMigrateConnectionGetSigners() {
+	expSigner, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgChannelUpgradeMigrateConnection(ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.FirstConnectionID, addr)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ibc.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSigner.Bytes(), signers[0])
+}
+
+func (suite *TypesTestSuite) TestMsgChannelForceCloseValidateBasic() {
+	var msg *types.MsgChannelForceClose
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			errorsmod.Wrap(
+				errorsmod.Wrapf(
+					host.ErrInvalidID,
+					"identifier %s must contain only alphanumeric or the following characters: '.', '_', '+', '-', '#', '[', ']', '<', '>'",
+					invalidPort,
+				), "invalid port ID",
+			),
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelForceClose(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelForceCloseGetSigners() {
+	expSigner, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgChannelForceClose(ibctesting.MockPort, ibctesting.FirstChannelID, addr)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ibc.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSigner.Bytes(), signers[0])
+}
+
+func (suite *TypesTestSuite) TestMsgChannelForceTimeoutValidateBasic() {
+	var msg *types.MsgChannelForceTimeout
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			errorsmod.Wrap(
+				errorsmod.Wrapf(
+					host.ErrInvalidID,
+					"identifier %s must contain only alphanumeric or the following characters: '.', '_', '+', '-', '#', '[', ']', '<', '>'",
+					invalidPort,
+				), "invalid port ID",
+			),
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"missing signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"no packets",
+			func() {
+				msg.Packets = nil
+			},
+			errorsmod.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet"),
+		},
+		{
+			"too many packets",
+			func() {
+				msg.Packets = make([]types.Packet, types.MaxBatchSize+1)
+			},
+			errorsmod.Wrapf(types.ErrInvalidPacket, "batch cannot contain more than %d packets, got %d", types.MaxBatchSize, types.MaxBatchSize+1),
+		},
+		{
+			"invalid packet",
+			func() {
+				msg.Packets = []types.Packet{packet, invalidPacket}
+			},
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet at index 1"),
+		},
+		{
+			"packet was not sent on the channel",
+			func() {
+				msg.ChannelId = "channel-1"
+			},
+			errorsmod.Wrapf(types.ErrInvalidPacket, "packet at index %d was not sent on port ID (%s) channel ID (%s)", 0, portid, "channel-1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgChannelForceTimeout(portid, chanid, []types.Packet{packet}, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelForceTimeoutGetSigners() {
+	expSigner, err := sdk.AccAddressFromBech32(addr)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgChannelForceTimeout(portid, chanid, []types.Packet{packet}, addr)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ibc.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSigner.Bytes(), signers[0])
+}
+
 func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	var msg *types.MsgPruneAcknowledgements
 
@@ -1860,6 +2324,104 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgScheduleReceiptPruningValidateBasic() {
+	var msg *types.MsgScheduleReceiptPruning
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: proof of each sequence",
+			func() {
+				msg.ProofUncommitted = nil
+				msg.ProofsUncommitted = [][]byte{suite.proof, suite.proof}
+			},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"no sequences",
+			func() {
+				msg.Sequences = nil
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"too many sequences",
+			func() {
+				msg.Sequences = make([]uint64, types.MaxBatchSize+1)
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"zero sequence",
+			func() {
+				msg.Sequences = []uint64{1, 0}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"empty proof",
+			func() {
+				msg.ProofUncommitted = emptyProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"number of proofs does not match number of sequences",
+			func() {
+				msg.ProofUncommitted = nil
+				msg.ProofsUncommitted = [][]byte{suite.proof}
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgScheduleReceiptPruning(ibctesting.MockPort, ibctesting.FirstChannelID, []uint64{1, 2}, suite.proof, height, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	var msg *types.MsgUpdateParams
 
@@ -1884,23 +2446,44 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			"invalid params: non zero height",
 			func() {
 				newHeight := clienttypes.NewHeight(1, 1000)
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000)), types.DefaultReceiptRetention))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
 		{
 			"invalid params: zero timestamp",
 			func() {
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0)), types.DefaultReceiptRetention))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
+		{
+			"success: receipt retention periods below minimum while pruning is disabled",
+			func() {
+				msg.Params.ReceiptRetention = types.NewReceiptRetention(1, 1, 0)
+			},
+			nil,
+		},
+		{
+			"invalid params: receipt retention height period below minimum",
+			func() {
+				msg.Params.ReceiptRetention = types.NewReceiptRetention(types.MinReceiptRetentionHeightPeriod-1, types.MinReceiptRetentionTimePeriod, 10)
+			},
+			types.ErrInvalidReceiptRetention,
+		},
+		{
+			"invalid params: receipt retention time period below minimum",
+			func() {
+				msg.Params.ReceiptRetention = types.NewReceiptRetention(types.MinReceiptRetentionHeightPeriod, types.MinReceiptRetentionTimePeriod-1, 10)
+			},
+			types.ErrInvalidReceiptRetention,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000))))
+			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000)), types.DefaultReceiptRetention))
 
 			tc.malleate()
 			err := msg.ValidateBasic()
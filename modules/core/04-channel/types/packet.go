@@ -41,6 +41,11 @@ func CommitAcknowledgement(data []byte) []byte {
 	return hash[:]
 }
 
+// TimeoutReceipt is the packet receipt written by the receiving end of an ORDERED_ALLOW_TIMEOUT
+// channel for a packet which timed out before it was received. It proves to the sending end
+// that the packet was skipped and will never be received.
+var TimeoutReceipt = []byte{byte(2)}
+
 var _ exported.PacketI = (*Packet)(nil)
 
 // NewPacket creates a new Packet instance. It panics if the provided
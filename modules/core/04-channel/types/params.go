@@ -13,16 +13,30 @@ import (
 // This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
 var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
 
+// DefaultReceiptRetention defines the default retention policy of packet receipts on unordered channels.
+// Receipts are retained for a week past the packet timeout, but scheduled pruning is disabled by default.
+// This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
+var DefaultReceiptRetention = NewReceiptRetention(100_000, uint64((7 * 24 * time.Hour).Nanoseconds()), 0)
+
+// MinReceiptRetentionHeightPeriod and MinReceiptRetentionTimePeriod define the minimum retention periods of
+// packet receipts past the packet timeout. A receipt which is pruned before the counterparty has processed the
+// acknowledgement allows the packet to be timed out on the sending chain, even though it has been received.
+const (
+	MinReceiptRetentionHeightPeriod = 10_000
+	MinReceiptRetentionTimePeriod   = uint64(24 * time.Hour)
+)
+
 // NewParams creates a new parameter configuration for the channel submodule
-func NewParams(upgradeTimeout Timeout) Params {
+func NewParams(upgradeTimeout Timeout, receiptRetention ReceiptRetention) Params {
 	return Params{
-		UpgradeTimeout: upgradeTimeout,
+		UpgradeTimeout:   upgradeTimeout,
+		ReceiptRetention: receiptRetention,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the channel submodule
 func DefaultParams() Params {
-	return NewParams(DefaultTimeout)
+	return NewParams(DefaultTimeout, DefaultReceiptRetention)
 }
 
 // Validate the params.
@@ -33,5 +47,34 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+	return p.ReceiptRetention.Validate()
+}
+
+// NewReceiptRetention creates a new ReceiptRetention instance.
+func NewReceiptRetention(heightPeriod, timePeriod, maxPrunedPerBlock uint64) ReceiptRetention {
+	return ReceiptRetention{
+		HeightPeriod:      heightPeriod,
+		TimePeriod:        timePeriod,
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+}
+
+// IsEnabled returns true if receipts are scheduled to be pruned, and false otherwise.
+func (r ReceiptRetention) IsEnabled() bool {
+	return r.MaxPrunedPerBlock > 0
+}
+
+// Validate returns an error if scheduled pruning is enabled and the retention periods are shorter than
+// the minimum retention periods. Receipts are neither scheduled nor pruned while pruning is disabled.
+func (r ReceiptRetention) Validate() error {
+	if !r.IsEnabled() {
+		return nil
+	}
+	if r.HeightPeriod < MinReceiptRetentionHeightPeriod {
+		return errorsmod.Wrapf(ErrInvalidReceiptRetention, "height period must be at least %d, got %d", MinReceiptRetentionHeightPeriod, r.HeightPeriod)
+	}
+	if r.TimePeriod < MinReceiptRetentionTimePeriod {
+		return errorsmod.Wrapf(ErrInvalidReceiptRetention, "time period must be at least %d, got %d", MinReceiptRetentionTimePeriod, r.TimePeriod)
+	}
 	return nil
 }
@@ -114,6 +114,13 @@ func NewQueryUpgradeErrorResponse(errorReceipt ErrorReceipt, proof []byte, heigh
 	}
 }
 
+// NewQueryCounterpartyUpgradeResponse creates a new QueryCounterpartyUpgradeResponse instance
+func NewQueryCounterpartyUpgradeResponse(upgrade Upgrade) *QueryCounterpartyUpgradeResponse {
+	return &QueryCounterpartyUpgradeResponse{
+		Upgrade: upgrade,
+	}
+}
+
 // NewQueryUpgradeResponse creates a new QueryUpgradeResponse instance
 func NewQueryUpgradeResponse(upgrade Upgrade, proof []byte, height clienttypes.Height) *QueryUpgradeResponse {
 	return &QueryUpgradeResponse{
@@ -2029,6 +2029,390 @@ func (m *QueryUpgradeResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryCounterpartyUpgradeRequest is the request type for the Query/CounterpartyUpgrade RPC method
+type QueryCounterpartyUpgradeRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryCounterpartyUpgradeRequest) Reset()         { *m = QueryCounterpartyUpgradeRequest{} }
+func (m *QueryCounterpartyUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCounterpartyUpgradeRequest) ProtoMessage()    {}
+func (*QueryCounterpartyUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{32}
+}
+func (m *QueryCounterpartyUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCounterpartyUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCounterpartyUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCounterpartyUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCounterpartyUpgradeRequest.Merge(m, src)
+}
+func (m *QueryCounterpartyUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCounterpartyUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCounterpartyUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCounterpartyUpgradeRequest proto.InternalMessageInfo
+
+func (m *QueryCounterpartyUpgradeRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryCounterpartyUpgradeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryCounterpartyUpgradeResponse is the response type for the Query/CounterpartyUpgrade RPC method
+type QueryCounterpartyUpgradeResponse struct {
+	Upgrade Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+}
+
+func (m *QueryCounterpartyUpgradeResponse) Reset()         { *m = QueryCounterpartyUpgradeResponse{} }
+func (m *QueryCounterpartyUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCounterpartyUpgradeResponse) ProtoMessage()    {}
+func (*QueryCounterpartyUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{33}
+}
+func (m *QueryCounterpartyUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCounterpartyUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCounterpartyUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCounterpartyUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCounterpartyUpgradeResponse.Merge(m, src)
+}
+func (m *QueryCounterpartyUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCounterpartyUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCounterpartyUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCounterpartyUpgradeResponse proto.InternalMessageInfo
+
+func (m *QueryCounterpartyUpgradeResponse) GetUpgrade() Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return Upgrade{}
+}
+
+// QueryPacketStatusRequest is the request type for the Query/PacketStatus RPC method
+type QueryPacketStatusRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// optional data of the packet sent, used together with its timeout height and timestamp to derive the
+	// timeout of a packet in flight from its commitment
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// optional timeout height of the packet sent
+	TimeoutHeight types.Height `protobuf:"bytes,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// optional timeout timestamp of the packet sent
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *QueryPacketStatusRequest) Reset()         { *m = QueryPacketStatusRequest{} }
+func (m *QueryPacketStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusRequest) ProtoMessage()    {}
+func (*QueryPacketStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{34}
+}
+func (m *QueryPacketStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusRequest.Merge(m, src)
+}
+func (m *QueryPacketStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusRequest proto.InternalMessageInfo
+
+func (m *QueryPacketStatusRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryPacketStatusRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QueryPacketStatusRequest) GetTimeoutHeight() types.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *QueryPacketStatusRequest) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// QueryPacketStatusResponse is the response type for the Query/PacketStatus RPC method
+type QueryPacketStatusResponse struct {
+	// lifecycle state of the packet sequence
+	Packet PacketLifecycle `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// query block height
+	Height types.Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryPacketStatusResponse) Reset()         { *m = QueryPacketStatusResponse{} }
+func (m *QueryPacketStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusResponse) ProtoMessage()    {}
+func (*QueryPacketStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{35}
+}
+func (m *QueryPacketStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusResponse.Merge(m, src)
+}
+func (m *QueryPacketStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPacketStatusResponse) GetPacket() PacketLifecycle {
+	if m != nil {
+		return m.Packet
+	}
+	return PacketLifecycle{}
+}
+
+func (m *QueryPacketStatusResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+// QueryPacketStatusesRequest is the request type for the Query/PacketStatuses RPC method
+type QueryPacketStatusesRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// status of the packets to return, all packets sent are returned if unspecified. Packets which
+	// are not sent cannot be queried. Packets with the acknowledged or completed status are not indexed,
+	// at most the pagination limit of packet sequences are inspected per page when querying them.
+	Status PacketStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ibc.core.channel.v1.PacketStatus" json:"status,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPacketStatusesRequest) Reset()         { *m = QueryPacketStatusesRequest{} }
+func (m *QueryPacketStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusesRequest) ProtoMessage()    {}
+func (*QueryPacketStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{36}
+}
+func (m *QueryPacketStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusesRequest.Merge(m, src)
+}
+func (m *QueryPacketStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusesRequest proto.InternalMessageInfo
+
+func (m *QueryPacketStatusesRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusesRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPacketStatusesRequest) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UNSPECIFIED_PACKET_STATUS
+}
+
+func (m *QueryPacketStatusesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPacketStatusesResponse is the response type for the Query/PacketStatuses RPC method
+type QueryPacketStatusesResponse struct {
+	// lifecycle state of the packets
+	Packets []PacketLifecycle `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryPacketStatusesResponse) Reset()         { *m = QueryPacketStatusesResponse{} }
+func (m *QueryPacketStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketStatusesResponse) ProtoMessage()    {}
+func (*QueryPacketStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{37}
+}
+func (m *QueryPacketStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketStatusesResponse.Merge(m, src)
+}
+func (m *QueryPacketStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryPacketStatusesResponse) GetPackets() []PacketLifecycle {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPacketStatusesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryPacketStatusesResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
 // QueryChannelParamsRequest is the request type for the Query/ChannelParams RPC method.
 type QueryChannelParamsRequest struct {
 }
@@ -2037,7 +2421,7 @@ func (m *QueryChannelParamsRequest) Reset()         { *m = QueryChannelParamsReq
 func (m *QueryChannelParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelParamsRequest) ProtoMessage()    {}
 func (*QueryChannelParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{32}
+	return fileDescriptor_1034a1e9abc4cca1, []int{38}
 }
 func (m *QueryChannelParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2070,13 +2454,15 @@ var xxx_messageInfo_QueryChannelParamsRequest proto.InternalMessageInfo
 type QueryChannelParamsResponse struct {
 	// params defines the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// receipt_pruning_progress defines the progress of the scheduled pruning of packet receipts.
+	ReceiptPruningProgress ReceiptPruningProgress `protobuf:"bytes,2,opt,name=receipt_pruning_progress,json=receiptPruningProgress,proto3" json:"receipt_pruning_progress"`
 }
 
 func (m *QueryChannelParamsResponse) Reset()         { *m = QueryChannelParamsResponse{} }
 func (m *QueryChannelParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelParamsResponse) ProtoMessage()    {}
 func (*QueryChannelParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{33}
+	return fileDescriptor_1034a1e9abc4cca1, []int{39}
 }
 func (m *QueryChannelParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2112,6 +2498,13 @@ func (m *QueryChannelParamsResponse) GetParams() *Params {
 	return nil
 }
 
+func (m *QueryChannelParamsResponse) GetReceiptPruningProgress() ReceiptPruningProgress {
+	if m != nil {
+		return m.ReceiptPruningProgress
+	}
+	return ReceiptPruningProgress{}
+}
+
 func init() {
 	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
 	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
@@ -2145,6 +2538,12 @@ func init() {
 	proto.RegisterType((*QueryUpgradeErrorResponse)(nil), "ibc.core.channel.v1.QueryUpgradeErrorResponse")
 	proto.RegisterType((*QueryUpgradeRequest)(nil), "ibc.core.channel.v1.QueryUpgradeRequest")
 	proto.RegisterType((*QueryUpgradeResponse)(nil), "ibc.core.channel.v1.QueryUpgradeResponse")
+	proto.RegisterType((*QueryCounterpartyUpgradeRequest)(nil), "ibc.core.channel.v1.QueryCounterpartyUpgradeRequest")
+	proto.RegisterType((*QueryCounterpartyUpgradeResponse)(nil), "ibc.core.channel.v1.QueryCounterpartyUpgradeResponse")
+	proto.RegisterType((*QueryPacketStatusRequest)(nil), "ibc.core.channel.v1.QueryPacketStatusRequest")
+	proto.RegisterType((*QueryPacketStatusResponse)(nil), "ibc.core.channel.v1.QueryPacketStatusResponse")
+	proto.RegisterType((*QueryPacketStatusesRequest)(nil), "ibc.core.channel.v1.QueryPacketStatusesRequest")
+	proto.RegisterType((*QueryPacketStatusesResponse)(nil), "ibc.core.channel.v1.QueryPacketStatusesResponse")
 	proto.RegisterType((*QueryChannelParamsRequest)(nil), "ibc.core.channel.v1.QueryChannelParamsRequest")
 	proto.RegisterType((*QueryChannelParamsResponse)(nil), "ibc.core.channel.v1.QueryChannelParamsResponse")
 }
@@ -2152,117 +2551,136 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
 
 var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1757 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x6f, 0x14, 0x47,
-	0x16, 0x76, 0xd9, 0xc6, 0x3f, 0x0a, 0x03, 0xa6, 0x6c, 0x2f, 0xa6, 0x6d, 0x8f, 0xcd, 0xa0, 0x5d,
-	0x0c, 0x5a, 0xba, 0xb1, 0xcd, 0xb2, 0xde, 0x15, 0x8b, 0x84, 0xbd, 0xbb, 0x60, 0xb4, 0x80, 0x69,
-	0x2f, 0x09, 0x20, 0x25, 0x93, 0x9e, 0x9e, 0x62, 0xdc, 0xb2, 0xa7, 0xbb, 0x99, 0xee, 0x19, 0x40,
-	0x8e, 0xa3, 0x28, 0x07, 0xc2, 0x31, 0x0a, 0x8a, 0x22, 0xe5, 0x12, 0x29, 0xa7, 0x10, 0x29, 0x8a,
-	0xf2, 0x17, 0xe4, 0x92, 0x03, 0xb7, 0x20, 0x91, 0x43, 0x24, 0x24, 0x12, 0x61, 0x22, 0x72, 0xcd,
-	0x25, 0xe7, 0xa8, 0xab, 0x5e, 0xf5, 0x74, 0xcf, 0x74, 0xb7, 0x3d, 0x6e, 0x8f, 0x84, 0x72, 0x9b,
-	0xae, 0xae, 0xf7, 0xea, 0xfb, 0xbe, 0x57, 0xf5, 0xba, 0xde, 0xb3, 0xf1, 0xb8, 0x91, 0xd7, 0x15,
-	0xdd, 0x2a, 0x53, 0x45, 0x5f, 0xd6, 0x4c, 0x93, 0xae, 0x2a, 0xd5, 0x29, 0xe5, 0x56, 0x85, 0x96,
-	0xef, 0xca, 0x76, 0xd9, 0x72, 0x2d, 0x32, 0x60, 0xe4, 0x75, 0xd9, 0x9b, 0x20, 0xc3, 0x04, 0xb9,
-	0x3a, 0x25, 0x05, 0xac, 0x56, 0x0d, 0x6a, 0xba, 0x9e, 0x11, 0xff, 0xc5, 0xad, 0xa4, 0x63, 0xba,
-	0xe5, 0x94, 0x2c, 0x47, 0xc9, 0x6b, 0x0e, 0xe5, 0xee, 0x94, 0xea, 0x54, 0x9e, 0xba, 0xda, 0x94,
-	0x62, 0x6b, 0x45, 0xc3, 0xd4, 0x5c, 0xc3, 0x32, 0x61, 0xee, 0xa1, 0x28, 0x08, 0x62, 0x31, 0x3e,
-	0x65, 0xb4, 0x68, 0x59, 0xc5, 0x55, 0xaa, 0x68, 0xb6, 0xa1, 0x68, 0xa6, 0x69, 0xb9, 0xcc, 0xde,
-	0x81, 0xb7, 0x07, 0xe1, 0x2d, 0x7b, 0xca, 0x57, 0x6e, 0x2a, 0x9a, 0x09, 0xe8, 0xa5, 0xc1, 0xa2,
-	0x55, 0xb4, 0xd8, 0x4f, 0xc5, 0xfb, 0x95, 0xb4, 0x62, 0xc5, 0x2e, 0x96, 0xb5, 0x02, 0xe5, 0x53,
-	0xb2, 0x17, 0xf1, 0xc0, 0x15, 0x0f, 0xf6, 0x3c, 0x9f, 0xa0, 0xd2, 0x5b, 0x15, 0xea, 0xb8, 0xe4,
-	0x00, 0xee, 0xb6, 0xad, 0xb2, 0x9b, 0x33, 0x0a, 0xc3, 0x68, 0x02, 0x4d, 0xf6, 0xaa, 0x5d, 0xde,
-	0xe3, 0x42, 0x81, 0x8c, 0x61, 0x0c, 0xbe, 0xbc, 0x77, 0xed, 0xec, 0x5d, 0x2f, 0x8c, 0x2c, 0x14,
-	0xb2, 0x0f, 0x11, 0x1e, 0x0c, 0xfb, 0x73, 0x6c, 0xcb, 0x74, 0x28, 0x39, 0x85, 0xbb, 0x61, 0x16,
-	0x73, 0xb8, 0x7b, 0x7a, 0x54, 0x8e, 0x10, 0x5c, 0x16, 0x66, 0x62, 0x32, 0x19, 0xc4, 0xbb, 0xec,
-	0xb2, 0x65, 0xdd, 0x64, 0x4b, 0xf5, 0xa9, 0xfc, 0x81, 0xcc, 0xe3, 0x3e, 0xf6, 0x23, 0xb7, 0x4c,
-	0x8d, 0xe2, 0xb2, 0x3b, 0xdc, 0xc1, 0x5c, 0x4a, 0x01, 0x97, 0x3c, 0x48, 0xd5, 0x29, 0xf9, 0x3c,
-	0x9b, 0x31, 0xd7, 0xf9, 0xe8, 0xd9, 0x78, 0x9b, 0xba, 0x9b, 0x59, 0xf1, 0xa1, 0xec, 0x9b, 0x61,
-	0xa8, 0x8e, 0xe0, 0xfe, 0x5f, 0x8c, 0x6b, 0xb1, 0x03, 0xb4, 0x7f, 0x91, 0x79, 0xa0, 0x65, 0x2f,
-	0xd0, 0x32, 0xdf, 0x37, 0x10, 0x68, 0x79, 0x51, 0x2b, 0x52, 0xb0, 0x55, 0x03, 0x96, 0xd9, 0x67,
-	0x08, 0x0f, 0xd5, 0x2d, 0x00, 0x62, 0xcc, 0xe1, 0x1e, 0xe0, 0xe7, 0x0c, 0xa3, 0x89, 0x0e, 0xe6,
-	0x3f, 0x4a, 0x8d, 0x85, 0x02, 0x35, 0x5d, 0xe3, 0xa6, 0x41, 0x0b, 0x42, 0x17, 0xdf, 0x8e, 0x9c,
-	0x0b, 0xa1, 0x6c, 0x67, 0x28, 0x8f, 0x6c, 0x8a, 0x92, 0x03, 0x08, 0xc2, 0x24, 0xb3, 0xb8, 0xab,
-	0x49, 0x15, 0x61, 0x7e, 0xf6, 0x3e, 0xc2, 0x19, 0x4e, 0xd0, 0x32, 0x4d, 0xaa, 0x7b, 0xde, 0xea,
-	0xb5, 0xcc, 0x60, 0xac, 0xfb, 0x2f, 0x61, 0x2b, 0x05, 0x46, 0xea, 0xb4, 0x6e, 0xdf, 0xb6, 0xd6,
-	0xbf, 0x20, 0x3c, 0x1e, 0x0b, 0xe5, 0x8f, 0xa5, 0xfa, 0x35, 0x21, 0x3a, 0xc7, 0x34, 0xcf, 0x66,
-	0x2f, 0xb9, 0x9a, 0x4b, 0xd3, 0x1e, 0xde, 0x1f, 0x7d, 0x11, 0x23, 0x5c, 0x83, 0x88, 0x1a, 0x3e,
-	0x60, 0xf8, 0xfa, 0xe4, 0x38, 0xd4, 0x9c, 0xe3, 0x4d, 0x81, 0x93, 0x72, 0x34, 0x8a, 0x48, 0x40,
-	0xd2, 0x80, 0xcf, 0x21, 0x23, 0x6a, 0xb8, 0x95, 0x47, 0xfe, 0x4b, 0x84, 0x0f, 0x85, 0x18, 0x7a,
-	0x9c, 0x4c, 0xa7, 0xe2, 0xec, 0x84, 0x7e, 0xe4, 0x08, 0xde, 0x57, 0xa6, 0x55, 0xc3, 0x31, 0x2c,
-	0x33, 0x67, 0x56, 0x4a, 0x79, 0x5a, 0x66, 0x28, 0x3b, 0xd5, 0xbd, 0x62, 0xf8, 0x12, 0x1b, 0x0d,
-	0x4d, 0x04, 0x3a, 0x9d, 0xe1, 0x89, 0x80, 0xf7, 0x29, 0xc2, 0xd9, 0x24, 0xbc, 0x10, 0x94, 0x7f,
-	0xe1, 0x7d, 0xba, 0x78, 0x13, 0x0a, 0xc6, 0xa0, 0xcc, 0x3f, 0x19, 0xb2, 0xf8, 0x64, 0xc8, 0x67,
-	0xcd, 0xbb, 0xea, 0x5e, 0x3d, 0xe4, 0x86, 0x8c, 0xe0, 0x5e, 0x08, 0xa4, 0xcf, 0xaa, 0x87, 0x0f,
-	0x2c, 0x14, 0x6a, 0xd1, 0xe8, 0x48, 0x8a, 0x46, 0xe7, 0x76, 0xa2, 0x51, 0xc6, 0xa3, 0x8c, 0xdc,
-	0xa2, 0xa6, 0xaf, 0x50, 0x77, 0xde, 0x2a, 0x95, 0x0c, 0xb7, 0x44, 0x4d, 0x37, 0x6d, 0x1c, 0x24,
-	0xdc, 0xe3, 0x78, 0x2e, 0x4c, 0x9d, 0x42, 0x00, 0xfc, 0xe7, 0xec, 0x27, 0x08, 0x8f, 0xc5, 0x2c,
-	0x0a, 0x62, 0xb2, 0x94, 0x25, 0x46, 0xd9, 0xc2, 0x7d, 0x6a, 0x60, 0xa4, 0x95, 0xdb, 0xf3, 0xd3,
-	0x38, 0x70, 0x4e, 0x5a, 0x49, 0xc2, 0x79, 0xb6, 0x63, 0xdb, 0x79, 0xf6, 0xa5, 0x48, 0xf9, 0x11,
-	0x08, 0xfd, 0x34, 0xbb, 0xbb, 0xa6, 0x96, 0xc8, 0xb4, 0x13, 0x91, 0x99, 0x96, 0x3b, 0xe1, 0x7b,
-	0x39, 0x68, 0xf4, 0x2a, 0xa4, 0x59, 0x0b, 0x1f, 0x0c, 0x10, 0x55, 0xa9, 0x4e, 0x0d, 0xbb, 0xa5,
-	0x3b, 0xf3, 0x01, 0xc2, 0x52, 0xd4, 0x8a, 0x20, 0xab, 0x84, 0x7b, 0xca, 0xde, 0x50, 0x95, 0x72,
-	0xbf, 0x3d, 0xaa, 0xff, 0xdc, 0xca, 0x33, 0x7a, 0x1b, 0x12, 0x26, 0x07, 0x75, 0x56, 0x5f, 0x31,
-	0xad, 0xdb, 0xab, 0xb4, 0x50, 0xa4, 0xad, 0x3e, 0xa8, 0x0f, 0x45, 0xea, 0x8b, 0x59, 0x19, 0x64,
-	0x99, 0xc4, 0xfb, 0xb4, 0xf0, 0x2b, 0x38, 0xb2, 0xf5, 0xc3, 0xad, 0x3c, 0xb7, 0x2f, 0x12, 0xb1,
-	0xbe, 0x2a, 0x87, 0x97, 0x9c, 0xc1, 0x23, 0x36, 0x03, 0x98, 0xab, 0x9d, 0xb5, 0x9c, 0x10, 0xdc,
-	0x19, 0xee, 0x9c, 0xe8, 0x98, 0xec, 0x54, 0x0f, 0xda, 0x75, 0x27, 0x7b, 0x49, 0x4c, 0xc8, 0xfe,
-	0x86, 0xf0, 0xe1, 0x44, 0x9a, 0x10, 0x93, 0xff, 0xe1, 0xfe, 0x3a, 0xf1, 0xb7, 0x9e, 0x06, 0x1a,
-	0x2c, 0x5f, 0x85, 0x5c, 0xf0, 0xb1, 0xc8, 0xcb, 0x57, 0x4d, 0x71, 0xe6, 0x38, 0xe6, 0xd4, 0xa1,
-	0xdd, 0x24, 0x24, 0x1d, 0x9b, 0x85, 0xe4, 0x0e, 0xa4, 0xe3, 0x08, 0x60, 0x10, 0x8c, 0x51, 0xdc,
-	0x5b, 0xf3, 0x87, 0x98, 0xbf, 0xda, 0x40, 0x40, 0x93, 0xf6, 0x26, 0x35, 0xb9, 0x27, 0xd2, 0x55,
-	0x6d, 0xe9, 0xb3, 0xfa, 0x4a, 0x6a, 0x41, 0x4e, 0xe0, 0x41, 0x10, 0x44, 0xd3, 0x57, 0x1a, 0x94,
-	0x20, 0xb6, 0xd8, 0x79, 0x35, 0x09, 0x2a, 0x78, 0x24, 0x12, 0x47, 0x8b, 0xf9, 0x5f, 0x87, 0xbb,
-	0xf2, 0x25, 0x7a, 0xc7, 0x8f, 0x87, 0xca, 0x01, 0xa4, 0xbd, 0x87, 0x7f, 0x8d, 0xf0, 0x44, 0xbc,
-	0x6f, 0xe0, 0x35, 0x8d, 0x87, 0x4c, 0x7a, 0xa7, 0xb6, 0x59, 0x72, 0xc0, 0x9e, 0x2d, 0xd5, 0xa9,
-	0x0e, 0x98, 0x8d, 0xb6, 0xad, 0x4c, 0x81, 0xaf, 0xc1, 0x5d, 0x2e, 0x08, 0x79, 0x89, 0x9a, 0x85,
-	0xb4, 0x5a, 0x7c, 0x2e, 0x8e, 0x5e, 0xa3, 0x63, 0x10, 0xe2, 0xaf, 0x98, 0x84, 0x85, 0x70, 0xa8,
-	0x59, 0x00, 0x15, 0xfa, 0xcd, 0x3a, 0xab, 0x56, 0x4a, 0xa0, 0xe2, 0x61, 0xbe, 0x11, 0x79, 0x83,
-	0xe5, 0x3f, 0xe5, 0xb2, 0x55, 0x4e, 0x4b, 0xff, 0x5b, 0x04, 0xd7, 0x90, 0xb0, 0x53, 0x3f, 0xd1,
-	0xee, 0xa1, 0xde, 0x00, 0x8f, 0xbd, 0xed, 0xc2, 0xad, 0xff, 0x50, 0x64, 0x96, 0x05, 0x53, 0x36,
-	0x11, 0xe0, 0xf7, 0xd1, 0xc0, 0x58, 0x2b, 0xa5, 0x11, 0x5d, 0x26, 0x60, 0x91, 0x56, 0x95, 0xaf,
-	0x44, 0x97, 0xc9, 0xf7, 0x07, 0x82, 0x9c, 0xc6, 0xdd, 0xd0, 0xde, 0x4a, 0xec, 0x32, 0x81, 0x19,
-	0x20, 0x15, 0x26, 0xad, 0x14, 0x60, 0x04, 0xc2, 0x08, 0x75, 0xdc, 0xa2, 0x56, 0xd6, 0x4a, 0x22,
-	0x57, 0x66, 0xaf, 0x40, 0x26, 0xad, 0x7b, 0x09, 0x9c, 0x66, 0x70, 0x97, 0xcd, 0x46, 0x80, 0xd2,
-	0x48, 0xcc, 0x37, 0x94, 0x19, 0xc1, 0xd4, 0xe9, 0x9f, 0x47, 0xf0, 0x2e, 0xe6, 0x93, 0x7c, 0x86,
-	0x70, 0x37, 0x38, 0x26, 0x93, 0x91, 0xa6, 0x11, 0xfd, 0x3f, 0xe9, 0xe8, 0x16, 0x66, 0x72, 0x7c,
-	0xd9, 0xb9, 0xf7, 0x9e, 0xbc, 0x78, 0xd0, 0x7e, 0x9a, 0xfc, 0x53, 0x49, 0xe8, 0x6f, 0x3a, 0xca,
-	0x5a, 0x2d, 0xa0, 0xeb, 0x8a, 0x17, 0x66, 0x47, 0x59, 0x83, 0xe0, 0xaf, 0x93, 0xfb, 0x08, 0xf7,
-	0x88, 0x7e, 0x0d, 0xd9, 0x7c, 0x6d, 0xa1, 0x9c, 0x74, 0x6c, 0x2b, 0x53, 0x01, 0xe7, 0x9f, 0x19,
-	0xce, 0x71, 0x32, 0x96, 0x88, 0x93, 0x7c, 0x83, 0x30, 0x69, 0x6c, 0x22, 0x91, 0x99, 0x84, 0x95,
-	0xe2, 0xba, 0x5f, 0xd2, 0xc9, 0xe6, 0x8c, 0x00, 0xe8, 0x19, 0x06, 0x74, 0x96, 0x9c, 0x8a, 0x06,
-	0xea, 0x1b, 0x7a, 0x9a, 0xfa, 0x0f, 0xeb, 0x35, 0x06, 0x8f, 0x3d, 0x06, 0x0d, 0x1d, 0x9c, 0x44,
-	0x06, 0x71, 0xad, 0xa4, 0x44, 0x06, 0xb1, 0x4d, 0xa2, 0xec, 0x65, 0xc6, 0x60, 0x81, 0x9c, 0xdb,
-	0xfe, 0x96, 0x50, 0x82, 0xad, 0x25, 0xf2, 0x61, 0x3b, 0x1e, 0x8a, 0x6c, 0x81, 0x90, 0x53, 0x9b,
-	0x03, 0x8c, 0xea, 0xf1, 0x48, 0x7f, 0x6f, 0xda, 0x0e, 0xb8, 0xbd, 0x8f, 0x18, 0xb9, 0x77, 0x11,
-	0x79, 0x27, 0x0d, 0xbb, 0x70, 0xbb, 0x46, 0x11, 0x7d, 0x1f, 0x65, 0xad, 0xae, 0x83, 0xb4, 0xae,
-	0xf0, 0xb4, 0x13, 0x78, 0xc1, 0x07, 0xd6, 0xc9, 0x53, 0x84, 0xfb, 0xeb, 0xcb, 0x70, 0x32, 0x15,
-	0xcf, 0x2b, 0xa6, 0xcd, 0x22, 0x4d, 0x37, 0x63, 0x02, 0x2a, 0xbc, 0xc5, 0x44, 0xb8, 0x41, 0xae,
-	0xa5, 0xd0, 0xa0, 0xe1, 0xe2, 0xeb, 0x28, 0x6b, 0xe2, 0x23, 0xbe, 0x4e, 0x9e, 0x20, 0xbc, 0xbf,
-	0xa1, 0xc9, 0x40, 0x9a, 0xc0, 0xea, 0x9f, 0xc2, 0x99, 0xa6, 0x6c, 0x80, 0xe0, 0x55, 0x46, 0xf0,
-	0x32, 0xb9, 0xb8, 0xa3, 0x04, 0xc9, 0x77, 0x08, 0xef, 0x09, 0xd5, 0xf7, 0x44, 0xde, 0x0c, 0x5d,
-	0xb8, 0xf5, 0x20, 0x29, 0x5b, 0x9e, 0x0f, 0x4c, 0xde, 0x60, 0x4c, 0x5e, 0x27, 0x57, 0xd3, 0x33,
-	0x81, 0x6b, 0x46, 0x28, 0x4e, 0x1b, 0x08, 0x0f, 0x45, 0xd6, 0x83, 0x49, 0x47, 0x33, 0xa9, 0x9b,
-	0x90, 0x74, 0x34, 0x13, 0x7b, 0x01, 0xd9, 0xeb, 0x8c, 0xe9, 0x12, 0xb9, 0x92, 0x9e, 0xa9, 0xa6,
-	0xaf, 0x84, 0x58, 0xbe, 0x44, 0xf8, 0x4f, 0xd1, 0x55, 0x2f, 0x69, 0x16, 0xae, 0xbf, 0x2f, 0x67,
-	0x9b, 0x37, 0x04, 0xa2, 0x37, 0x18, 0xd1, 0xff, 0x13, 0x75, 0x47, 0x88, 0x86, 0xe9, 0xdc, 0x6b,
-	0xc7, 0xfb, 0x1b, 0xaa, 0xc9, 0xa4, 0x73, 0x17, 0x57, 0x13, 0x27, 0x9d, 0xbb, 0xd8, 0x72, 0x75,
-	0x87, 0xd2, 0x6b, 0x54, 0x6a, 0x49, 0xa8, 0xb3, 0xd7, 0x95, 0x8a, 0x0f, 0x28, 0x67, 0x03, 0xe5,
-	0x5f, 0x11, 0xde, 0x1b, 0xae, 0x29, 0x89, 0xb2, 0x15, 0x46, 0x81, 0x2a, 0x58, 0x3a, 0xb1, 0x75,
-	0x03, 0xe0, 0xff, 0x36, 0xa3, 0x5f, 0x25, 0x6e, 0x6b, 0xd8, 0x87, 0x8a, 0xea, 0x10, 0x6d, 0x6f,
-	0xc7, 0x93, 0xef, 0x11, 0x1e, 0x88, 0x28, 0x3a, 0x49, 0xc2, 0x35, 0x20, 0xbe, 0xfe, 0x95, 0xfe,
-	0xd6, 0xa4, 0x15, 0x48, 0xb0, 0xc8, 0x24, 0xb8, 0x40, 0xce, 0xa7, 0x90, 0x20, 0x54, 0x11, 0x7a,
-	0x37, 0xa2, 0xfe, 0xfa, 0xfa, 0x31, 0xe9, 0x4b, 0x19, 0x53, 0xc4, 0x26, 0x7d, 0x29, 0xe3, 0xca,
-	0xd3, 0x1d, 0xf9, 0x90, 0x34, 0xd6, 0xb7, 0xde, 0x35, 0xb5, 0x2f, 0x58, 0x13, 0x92, 0xe3, 0x09,
-	0x5b, 0xad, 0xb1, 0x20, 0x95, 0xe4, 0xad, 0x4e, 0xdf, 0xc1, 0xa0, 0x40, 0x9d, 0x95, 0x63, 0x55,
-	0x27, 0xf9, 0x02, 0xe1, 0x6e, 0x58, 0x2a, 0xa9, 0x30, 0x09, 0x97, 0x8c, 0x49, 0x85, 0x49, 0x5d,
-	0x31, 0x98, 0xbd, 0xc0, 0x20, 0xff, 0x9b, 0xcc, 0xa5, 0x87, 0x4c, 0x3e, 0x42, 0x78, 0x4f, 0xa8,
-	0x3c, 0x4b, 0xfa, 0x6e, 0x47, 0x15, 0x79, 0x49, 0xdf, 0xed, 0xc8, 0xba, 0x2f, 0x7b, 0x98, 0xc1,
-	0x1f, 0x23, 0x23, 0x91, 0xf0, 0x79, 0x9d, 0x37, 0xb7, 0xf4, 0xe8, 0x79, 0x06, 0x3d, 0x7e, 0x9e,
-	0x41, 0x3f, 0x3d, 0xcf, 0xa0, 0x0f, 0x36, 0x32, 0x6d, 0x8f, 0x37, 0x32, 0x6d, 0x3f, 0x6c, 0x64,
-	0xda, 0x6e, 0xfc, 0xa3, 0x68, 0xb8, 0xcb, 0x95, 0xbc, 0xac, 0x5b, 0x25, 0x05, 0xfe, 0x49, 0xc5,
-	0xc8, 0xeb, 0xc7, 0x8b, 0x96, 0x52, 0x9d, 0x55, 0x4a, 0x56, 0xa1, 0xb2, 0x4a, 0x1d, 0xee, 0xf5,
-	0xc4, 0xc9, 0xe3, 0xc2, 0xb1, 0x7b, 0xd7, 0xa6, 0x4e, 0xbe, 0x8b, 0xfd, 0xb5, 0x70, 0xe6, 0xf7,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x50, 0xcd, 0xd6, 0x34, 0x23, 0x00, 0x00,
+	// 2052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xd1, 0x6f, 0x1c, 0x47,
+	0x19, 0xcf, 0xd8, 0x17, 0xdb, 0xf9, 0xe2, 0x38, 0xc9, 0xc4, 0x6e, 0xed, 0xb5, 0x73, 0x71, 0xae,
+	0x40, 0x93, 0x96, 0xec, 0xc6, 0x76, 0x9a, 0xa6, 0xa8, 0x54, 0x8a, 0x53, 0x9a, 0x3a, 0x4a, 0x1b,
+	0x67, 0xdd, 0xd0, 0x26, 0x08, 0xae, 0x7b, 0x7b, 0x93, 0xcb, 0xca, 0xbe, 0xdd, 0xed, 0xee, 0x9e,
+	0x9b, 0x28, 0x18, 0x21, 0x1e, 0x4a, 0x1f, 0x11, 0x15, 0x42, 0xf0, 0x82, 0xc4, 0x13, 0x45, 0x42,
+	0x88, 0x7f, 0x00, 0x5e, 0x90, 0xa8, 0x78, 0x21, 0x52, 0x91, 0x40, 0x54, 0x2a, 0x28, 0xa9, 0x28,
+	0xaf, 0xbc, 0x20, 0x1e, 0xd1, 0xce, 0x7c, 0xb3, 0xb7, 0x7b, 0xb7, 0xbb, 0xbe, 0xf3, 0xde, 0x49,
+	0x11, 0x4f, 0xb9, 0x9d, 0xfd, 0xbe, 0x6f, 0xbe, 0xdf, 0xef, 0x9b, 0xf9, 0x66, 0xf6, 0x17, 0xc3,
+	0x09, 0xab, 0x66, 0x6a, 0xa6, 0xe3, 0x31, 0xcd, 0xbc, 0x63, 0xd8, 0x36, 0xdb, 0xd2, 0xb6, 0x97,
+	0xb4, 0x77, 0x5a, 0xcc, 0xbb, 0xa7, 0xba, 0x9e, 0x13, 0x38, 0xf4, 0x98, 0x55, 0x33, 0xd5, 0xd0,
+	0x40, 0x45, 0x03, 0x75, 0x7b, 0x49, 0x89, 0x79, 0x6d, 0x59, 0xcc, 0x0e, 0x42, 0x27, 0xf1, 0x4b,
+	0x78, 0x29, 0xcf, 0x98, 0x8e, 0xdf, 0x74, 0x7c, 0xad, 0x66, 0xf8, 0x4c, 0x84, 0xd3, 0xb6, 0x97,
+	0x6a, 0x2c, 0x30, 0x96, 0x34, 0xd7, 0x68, 0x58, 0xb6, 0x11, 0x58, 0x8e, 0x8d, 0xb6, 0x27, 0xd3,
+	0x52, 0x90, 0x93, 0x09, 0x93, 0x85, 0x86, 0xe3, 0x34, 0xb6, 0x98, 0x66, 0xb8, 0x96, 0x66, 0xd8,
+	0xb6, 0x13, 0x70, 0x7f, 0x1f, 0xdf, 0xce, 0xe1, 0x5b, 0xfe, 0x54, 0x6b, 0xdd, 0xd6, 0x0c, 0x1b,
+	0xb3, 0x57, 0xa6, 0x1b, 0x4e, 0xc3, 0xe1, 0x3f, 0xb5, 0xf0, 0x57, 0xde, 0x8c, 0x2d, 0xb7, 0xe1,
+	0x19, 0x75, 0x26, 0x4c, 0x2a, 0xaf, 0xc1, 0xb1, 0xeb, 0x61, 0xda, 0x97, 0x84, 0x81, 0xce, 0xde,
+	0x69, 0x31, 0x3f, 0xa0, 0x4f, 0xc2, 0xb8, 0xeb, 0x78, 0x41, 0xd5, 0xaa, 0xcf, 0x92, 0x45, 0x72,
+	0xea, 0x80, 0x3e, 0x16, 0x3e, 0xae, 0xd5, 0xe9, 0x71, 0x00, 0x8c, 0x15, 0xbe, 0x1b, 0xe1, 0xef,
+	0x0e, 0xe0, 0xc8, 0x5a, 0xbd, 0xf2, 0x21, 0x81, 0xe9, 0x64, 0x3c, 0xdf, 0x75, 0x6c, 0x9f, 0xd1,
+	0xf3, 0x30, 0x8e, 0x56, 0x3c, 0xe0, 0xc1, 0xe5, 0x05, 0x35, 0x85, 0x70, 0x55, 0xba, 0x49, 0x63,
+	0x3a, 0x0d, 0xfb, 0x5d, 0xcf, 0x71, 0x6e, 0xf3, 0xa9, 0x26, 0x75, 0xf1, 0x40, 0x2f, 0xc1, 0x24,
+	0xff, 0x51, 0xbd, 0xc3, 0xac, 0xc6, 0x9d, 0x60, 0x76, 0x94, 0x87, 0x54, 0x62, 0x21, 0x45, 0x91,
+	0xb6, 0x97, 0xd4, 0x57, 0xb9, 0xc5, 0x6a, 0xe9, 0xa3, 0x4f, 0x4f, 0xec, 0xd3, 0x0f, 0x72, 0x2f,
+	0x31, 0x54, 0xf9, 0x56, 0x32, 0x55, 0x5f, 0x62, 0x7f, 0x05, 0xa0, 0x5d, 0x3b, 0xcc, 0xf6, 0x4b,
+	0xaa, 0x28, 0xb4, 0x1a, 0x16, 0x5a, 0x15, 0xeb, 0x06, 0x0b, 0xad, 0xae, 0x1b, 0x0d, 0x86, 0xbe,
+	0x7a, 0xcc, 0xb3, 0xf2, 0x29, 0x81, 0x99, 0x8e, 0x09, 0x90, 0x8c, 0x55, 0x98, 0x40, 0x7c, 0xfe,
+	0x2c, 0x59, 0x1c, 0xe5, 0xf1, 0xd3, 0xd8, 0x58, 0xab, 0x33, 0x3b, 0xb0, 0x6e, 0x5b, 0xac, 0x2e,
+	0x79, 0x89, 0xfc, 0xe8, 0xe5, 0x44, 0x96, 0x23, 0x3c, 0xcb, 0xa7, 0x77, 0xcd, 0x52, 0x24, 0x10,
+	0x4f, 0x93, 0x5e, 0x80, 0xb1, 0x3e, 0x59, 0x44, 0xfb, 0xca, 0xfb, 0x04, 0xca, 0x02, 0xa0, 0x63,
+	0xdb, 0xcc, 0x0c, 0xa3, 0x75, 0x72, 0x59, 0x06, 0x30, 0xa3, 0x97, 0xb8, 0x94, 0x62, 0x23, 0xf4,
+	0x95, 0x14, 0x14, 0x7b, 0xe1, 0xfa, 0x5f, 0x04, 0x4e, 0x64, 0xa6, 0xf2, 0xff, 0xc5, 0xfa, 0x5b,
+	0x92, 0x74, 0x91, 0xd3, 0x25, 0x6e, 0xbd, 0x11, 0x18, 0x01, 0x2b, 0xba, 0x79, 0xff, 0x1e, 0x91,
+	0x98, 0x12, 0x1a, 0x49, 0x34, 0xe0, 0x49, 0x2b, 0xe2, 0xa7, 0x2a, 0x52, 0xad, 0xfa, 0xa1, 0x09,
+	0xee, 0x94, 0xd3, 0x69, 0x40, 0x62, 0x94, 0xc6, 0x62, 0xce, 0x58, 0x69, 0xc3, 0xc3, 0xdc, 0xf2,
+	0xbf, 0x22, 0x70, 0x32, 0x81, 0x30, 0xc4, 0x64, 0xfb, 0x2d, 0x7f, 0x10, 0xfc, 0xd1, 0xa7, 0xe1,
+	0xb0, 0xc7, 0xb6, 0x2d, 0xdf, 0x72, 0xec, 0xaa, 0xdd, 0x6a, 0xd6, 0x98, 0xc7, 0xb3, 0x2c, 0xe9,
+	0x53, 0x72, 0xf8, 0x75, 0x3e, 0x9a, 0x30, 0x44, 0x38, 0xa5, 0xa4, 0x21, 0xe6, 0xfb, 0x09, 0x81,
+	0x4a, 0x5e, 0xbe, 0x58, 0x94, 0xaf, 0xc2, 0x61, 0x53, 0xbe, 0x49, 0x14, 0x63, 0x5a, 0x15, 0x47,
+	0x86, 0x2a, 0x8f, 0x0c, 0xf5, 0xa2, 0x7d, 0x4f, 0x9f, 0x32, 0x13, 0x61, 0xe8, 0x3c, 0x1c, 0xc0,
+	0x42, 0x46, 0xa8, 0x26, 0xc4, 0xc0, 0x5a, 0xbd, 0x5d, 0x8d, 0xd1, 0xbc, 0x6a, 0x94, 0xf6, 0x52,
+	0x0d, 0x0f, 0x16, 0x38, 0xb8, 0x75, 0xc3, 0xdc, 0x64, 0xc1, 0x25, 0xa7, 0xd9, 0xb4, 0x82, 0x26,
+	0xb3, 0x83, 0xa2, 0x75, 0x50, 0x60, 0xc2, 0x0f, 0x43, 0xd8, 0x26, 0xc3, 0x02, 0x44, 0xcf, 0x95,
+	0x9f, 0x12, 0x38, 0x9e, 0x31, 0x29, 0x92, 0xc9, 0x5b, 0x96, 0x1c, 0xe5, 0x13, 0x4f, 0xea, 0xb1,
+	0x91, 0x61, 0x2e, 0xcf, 0x9f, 0x65, 0x25, 0xe7, 0x17, 0xa5, 0x24, 0xd9, 0x67, 0x47, 0xf7, 0xdc,
+	0x67, 0x3f, 0x97, 0x2d, 0x3f, 0x25, 0xc3, 0xa8, 0xcd, 0x1e, 0x6c, 0xb3, 0x25, 0x3b, 0xed, 0x62,
+	0x6a, 0xa7, 0x15, 0x41, 0xc4, 0x5a, 0x8e, 0x3b, 0x3d, 0x0e, 0x6d, 0xd6, 0x81, 0xb9, 0x18, 0x50,
+	0x9d, 0x99, 0xcc, 0x72, 0x87, 0xba, 0x32, 0x3f, 0x20, 0xa0, 0xa4, 0xcd, 0x88, 0xb4, 0x2a, 0x30,
+	0xe1, 0x85, 0x43, 0xdb, 0x4c, 0xc4, 0x9d, 0xd0, 0xa3, 0xe7, 0x61, 0xee, 0xd1, 0x77, 0xe1, 0x64,
+	0x2c, 0xa9, 0x8b, 0xe6, 0xa6, 0xed, 0xbc, 0xbb, 0xc5, 0xea, 0x0d, 0x36, 0xec, 0x8d, 0xfa, 0xa1,
+	0x6c, 0x7d, 0x19, 0x33, 0x23, 0x2d, 0xa7, 0xe0, 0xb0, 0x91, 0x7c, 0x85, 0x5b, 0xb6, 0x73, 0x78,
+	0x98, 0xfb, 0xf6, 0xb3, 0xdc, 0x5c, 0x1f, 0x97, 0xcd, 0x4b, 0x5f, 0x82, 0x79, 0x97, 0x27, 0x58,
+	0x6d, 0xef, 0xb5, 0xaa, 0x24, 0xdc, 0x9f, 0x2d, 0x2d, 0x8e, 0x9e, 0x2a, 0xe9, 0x73, 0x6e, 0xc7,
+	0xce, 0xde, 0x90, 0x06, 0x95, 0xff, 0x10, 0x78, 0x2a, 0x17, 0x26, 0xd6, 0xe4, 0x2a, 0x1c, 0xe9,
+	0x20, 0xbf, 0xf7, 0x36, 0xd0, 0xe5, 0xf9, 0x38, 0xf4, 0x82, 0x1f, 0xcb, 0xbe, 0x7c, 0xc3, 0x96,
+	0x7b, 0x4e, 0xe4, 0x5c, 0xb8, 0xb4, 0xbb, 0x94, 0x64, 0x74, 0xb7, 0x92, 0xdc, 0x85, 0x72, 0x56,
+	0x62, 0x58, 0x8c, 0x05, 0x38, 0xd0, 0x8e, 0x47, 0x78, 0xbc, 0xf6, 0x40, 0x8c, 0x93, 0x91, 0x3e,
+	0x39, 0x79, 0x4f, 0xb6, 0xab, 0xf6, 0xd4, 0x17, 0xcd, 0xcd, 0xc2, 0x84, 0x9c, 0x85, 0x69, 0x24,
+	0xc4, 0x30, 0x37, 0xbb, 0x98, 0xa0, 0xae, 0x5c, 0x79, 0x6d, 0x0a, 0x5a, 0x30, 0x9f, 0x9a, 0xc7,
+	0x90, 0xf1, 0xdf, 0xc4, 0xbb, 0xf2, 0xeb, 0xec, 0x6e, 0x54, 0x0f, 0x5d, 0x24, 0x50, 0xf4, 0x1e,
+	0xfe, 0x1b, 0x02, 0x8b, 0xd9, 0xb1, 0x11, 0xd7, 0x32, 0xcc, 0xd8, 0xec, 0x6e, 0x7b, 0xb1, 0x54,
+	0x11, 0x3d, 0x9f, 0xaa, 0xa4, 0x1f, 0xb3, 0xbb, 0x7d, 0x87, 0xd9, 0x02, 0xbf, 0x0e, 0x0b, 0x5d,
+	0x29, 0x6f, 0x30, 0xbb, 0x5e, 0x94, 0x8b, 0x5f, 0xc8, 0xad, 0xd7, 0x1d, 0x18, 0x89, 0xf8, 0x32,
+	0xd0, 0x24, 0x11, 0x3e, 0xb3, 0xeb, 0xc8, 0xc2, 0x11, 0xbb, 0xc3, 0x6b, 0x98, 0x14, 0xe8, 0x30,
+	0x2b, 0x16, 0xa2, 0x10, 0x58, 0xbe, 0xe6, 0x79, 0x8e, 0x57, 0x14, 0xfe, 0xef, 0x09, 0xcc, 0xa5,
+	0x04, 0x8d, 0x1a, 0xed, 0x21, 0x16, 0x0e, 0x88, 0xda, 0xbb, 0x01, 0xde, 0xfa, 0x4f, 0xa6, 0x76,
+	0x59, 0x74, 0xe5, 0x86, 0x98, 0xfe, 0x24, 0x8b, 0x8d, 0x0d, 0x93, 0x1a, 0xa9, 0x32, 0x21, 0x8a,
+	0xa2, 0xac, 0xfc, 0x5a, 0xaa, 0x4c, 0x51, 0x3c, 0x24, 0xe4, 0x45, 0x18, 0x47, 0x79, 0x2b, 0x57,
+	0x65, 0x42, 0x37, 0xcc, 0x54, 0xba, 0x0c, 0x93, 0x80, 0x9b, 0x91, 0x3c, 0xd1, 0xb2, 0x03, 0xe6,
+	0xb9, 0x86, 0x17, 0x0c, 0x8a, 0x8c, 0xb7, 0x61, 0x31, 0x3b, 0xf4, 0x20, 0x78, 0xa9, 0xfc, 0x97,
+	0xe0, 0xca, 0x6e, 0x1f, 0xd4, 0x2d, 0x7f, 0x88, 0x77, 0x3f, 0x4a, 0xa1, 0x54, 0x37, 0x02, 0x83,
+	0xdf, 0x58, 0x27, 0x75, 0xfe, 0x9b, 0x5e, 0x86, 0xa9, 0xc0, 0x6a, 0x32, 0xa7, 0x15, 0xc8, 0x42,
+	0xec, 0xef, 0xb1, 0x10, 0x87, 0xd0, 0x4f, 0x0c, 0xd2, 0x67, 0xe1, 0xa8, 0x0c, 0x14, 0xfe, 0xeb,
+	0x07, 0x46, 0xd3, 0x9d, 0x1d, 0x13, 0xed, 0x02, 0x5f, 0xbc, 0x21, 0xc7, 0x2b, 0x3f, 0x21, 0x30,
+	0x97, 0x02, 0x3d, 0xfa, 0xd4, 0x19, 0x13, 0x07, 0x12, 0xb2, 0xfa, 0x85, 0x9c, 0xeb, 0xcd, 0x55,
+	0xeb, 0x36, 0x33, 0xef, 0x99, 0x5b, 0x92, 0x5d, 0xf4, 0x2c, 0x70, 0x02, 0xfd, 0x25, 0xf9, 0xc1,
+	0x20, 0x72, 0x63, 0x85, 0x0b, 0xf3, 0x02, 0x8c, 0xf9, 0x3c, 0x14, 0x2f, 0xcb, 0x54, 0x46, 0x37,
+	0x49, 0xf0, 0x81, 0x0e, 0x1d, 0x17, 0xd5, 0xd2, 0x9e, 0xbf, 0x32, 0xff, 0x49, 0xf0, 0x4c, 0xef,
+	0x44, 0x86, 0xbc, 0xbf, 0x0c, 0xe3, 0x82, 0x3d, 0x79, 0xaf, 0xec, 0x87, 0x78, 0xe9, 0xfa, 0x38,
+	0x5c, 0x2c, 0xe7, 0x61, 0x2e, 0x2e, 0xef, 0xac, 0x1b, 0x9e, 0xd1, 0x94, 0x05, 0xac, 0xfc, 0x56,
+	0xd6, 0xb7, 0xe3, 0x2d, 0x92, 0xb0, 0x12, 0x2e, 0xbe, 0x70, 0x04, 0x17, 0xdf, 0x7c, 0x06, 0x07,
+	0xdc, 0x09, 0x4d, 0xe9, 0x26, 0xcc, 0xe2, 0x59, 0x51, 0x75, 0xbd, 0x96, 0x6d, 0xd9, 0x8d, 0xaa,
+	0xeb, 0x39, 0x0d, 0x8f, 0xf9, 0x3e, 0x32, 0xf0, 0x6c, 0x6a, 0x18, 0x3c, 0x23, 0xd6, 0x85, 0xcf,
+	0x3a, 0xba, 0x20, 0x9a, 0x27, 0xbc, 0xd4, 0xb7, 0xcb, 0x7f, 0x5b, 0x84, 0xfd, 0x1c, 0x00, 0xfd,
+	0x39, 0x81, 0x71, 0x44, 0x41, 0x4f, 0xa5, 0x4e, 0x90, 0xf2, 0x9f, 0x10, 0xca, 0xe9, 0x1e, 0x2c,
+	0x05, 0x19, 0x95, 0xd5, 0xef, 0x7d, 0xfc, 0xd9, 0x07, 0x23, 0x2f, 0xd2, 0xaf, 0x68, 0x39, 0xff,
+	0xc9, 0xe2, 0x6b, 0xf7, 0xdb, 0x0b, 0x7f, 0x47, 0x0b, 0xb7, 0x83, 0xaf, 0xdd, 0xc7, 0x4d, 0xb2,
+	0x43, 0xdf, 0x27, 0x30, 0x81, 0x71, 0x7d, 0xba, 0xfb, 0xdc, 0xb2, 0x4e, 0xca, 0x33, 0xbd, 0x98,
+	0x62, 0x9e, 0x5f, 0xe4, 0x79, 0x9e, 0xa0, 0xc7, 0x73, 0xf3, 0xa4, 0xbf, 0x23, 0x40, 0xbb, 0x95,
+	0x6c, 0xba, 0x92, 0x33, 0x53, 0x96, 0x04, 0xaf, 0x9c, 0xeb, 0xcf, 0x09, 0x13, 0x7d, 0x89, 0x27,
+	0x7a, 0x81, 0x9e, 0x4f, 0x4f, 0x34, 0x72, 0x0c, 0x39, 0x8d, 0x1e, 0x76, 0xda, 0x08, 0x1e, 0x84,
+	0x08, 0xba, 0x64, 0xe4, 0x5c, 0x04, 0x59, 0x7a, 0xb6, 0x72, 0xae, 0x3f, 0x27, 0x44, 0x70, 0x8d,
+	0x23, 0x58, 0xa3, 0x97, 0xf7, 0xbe, 0x24, 0xb4, 0xb8, 0xbe, 0x4d, 0x7f, 0x38, 0x02, 0x33, 0xa9,
+	0x3a, 0x2c, 0x3d, 0xbf, 0x7b, 0x82, 0x69, 0x42, 0xb3, 0xf2, 0x7c, 0xdf, 0x7e, 0x88, 0xed, 0xfb,
+	0x84, 0x83, 0xfb, 0x2e, 0xa1, 0xdf, 0x29, 0x82, 0x2e, 0xa9, 0x19, 0x6b, 0x52, 0x7c, 0xd6, 0xee,
+	0x77, 0xc8, 0xd8, 0x3b, 0x9a, 0xe8, 0x57, 0xb1, 0x17, 0x62, 0x60, 0x87, 0x7e, 0x42, 0xe0, 0x48,
+	0xa7, 0x16, 0x48, 0x97, 0xb2, 0x71, 0x65, 0x68, 0xbd, 0xca, 0x72, 0x3f, 0x2e, 0xc8, 0xc2, 0xdb,
+	0x9c, 0x84, 0x5b, 0xf4, 0xad, 0x02, 0x1c, 0x74, 0x7d, 0x7d, 0xfb, 0xda, 0x7d, 0x79, 0x0f, 0xd9,
+	0xa1, 0x1f, 0x13, 0x38, 0xda, 0x39, 0xbd, 0x4f, 0xfb, 0xc8, 0x35, 0xda, 0x85, 0x2b, 0x7d, 0xf9,
+	0x20, 0xc0, 0x1b, 0x1c, 0xe0, 0x35, 0xfa, 0xda, 0x40, 0x01, 0xd2, 0x3f, 0x11, 0x38, 0x94, 0x10,
+	0x19, 0xa9, 0xba, 0x5b, 0x76, 0x49, 0xfd, 0x53, 0xd1, 0x7a, 0xb6, 0x47, 0x24, 0xdf, 0xe4, 0x48,
+	0xde, 0xa4, 0x37, 0x8a, 0x23, 0xc1, 0xc3, 0x26, 0x51, 0xa7, 0x47, 0x04, 0x66, 0x52, 0x45, 0xa9,
+	0xbc, 0xad, 0x99, 0x27, 0x69, 0x2a, 0xcf, 0xf7, 0xed, 0x87, 0x48, 0x6f, 0x72, 0xa4, 0x1b, 0xf4,
+	0x7a, 0x71, 0xa4, 0x86, 0xb9, 0x99, 0x40, 0xf9, 0x39, 0x81, 0x27, 0x52, 0x27, 0xf7, 0x69, 0xbf,
+	0xe9, 0x46, 0xeb, 0xf2, 0x42, 0xff, 0x8e, 0x08, 0xf4, 0x16, 0x07, 0xfa, 0x06, 0xd5, 0x07, 0x02,
+	0x34, 0x09, 0xe7, 0xbd, 0x11, 0x38, 0xda, 0x25, 0x69, 0xe5, 0xed, 0xbb, 0x2c, 0x61, 0x4e, 0x59,
+	0xe9, 0xcb, 0x67, 0xa0, 0xed, 0x35, 0xad, 0xb5, 0xe4, 0x88, 0x7d, 0x3b, 0x5a, 0x2b, 0x4a, 0xa8,
+	0x2a, 0xef, 0xa8, 0xff, 0x26, 0x30, 0x95, 0x14, 0xb6, 0xa8, 0xd6, 0x0b, 0xa2, 0x98, 0x14, 0xa7,
+	0x9c, 0xed, 0xdd, 0x01, 0xf1, 0x7f, 0x9b, 0xc3, 0xdf, 0xa6, 0xc1, 0x70, 0xd0, 0x27, 0x94, 0xbd,
+	0x04, 0xec, 0x70, 0xc5, 0xd3, 0x3f, 0x13, 0x38, 0x96, 0xa2, 0x7c, 0xd1, 0x9c, 0x6b, 0x40, 0xb6,
+	0x08, 0xa7, 0x3c, 0xd7, 0xa7, 0x17, 0x52, 0xb0, 0xce, 0x29, 0xb8, 0x42, 0x5f, 0x2d, 0x40, 0x41,
+	0x42, 0x96, 0x0a, 0x6f, 0x44, 0x47, 0x3a, 0x45, 0xac, 0xbc, 0x93, 0x32, 0x43, 0x49, 0x53, 0x96,
+	0xfb, 0x71, 0x19, 0xe0, 0x41, 0xd2, 0x2d, 0xb2, 0x85, 0xd7, 0xd4, 0xc9, 0xb8, 0x30, 0x45, 0xcf,
+	0xe4, 0x2c, 0xb5, 0x6e, 0x55, 0x4c, 0x51, 0x7b, 0x35, 0x1f, 0x60, 0x51, 0x50, 0xd4, 0xa8, 0x72,
+	0xe9, 0x8b, 0xfe, 0x92, 0xc0, 0x38, 0x4e, 0x95, 0xf7, 0x61, 0x92, 0x94, 0x6a, 0x94, 0xd3, 0x3d,
+	0x58, 0x62, 0xca, 0x57, 0x78, 0xca, 0x2f, 0xd3, 0xd5, 0xe2, 0x29, 0x87, 0x77, 0xad, 0x63, 0x29,
+	0x2a, 0x0f, 0xcd, 0xbd, 0xe1, 0x67, 0xe9, 0x4d, 0xca, 0x73, 0x7d, 0x7a, 0x21, 0xa0, 0x37, 0x39,
+	0xa0, 0xeb, 0xf4, 0x5a, 0xa1, 0x8b, 0x67, 0x3b, 0x7e, 0x55, 0xa2, 0xfb, 0x23, 0x81, 0xc9, 0xf8,
+	0xf7, 0x7e, 0xde, 0x62, 0x4a, 0x11, 0xa2, 0x14, 0xb5, 0x57, 0x73, 0x04, 0xf2, 0x0d, 0x0e, 0xe4,
+	0x06, 0xdd, 0x28, 0xde, 0xe4, 0x84, 0xfc, 0x11, 0x3f, 0xaa, 0xff, 0x40, 0x60, 0x2a, 0x29, 0x5e,
+	0x50, 0xad, 0xb7, 0xfc, 0x58, 0x2f, 0x7d, 0x3b, 0x5d, 0x17, 0xa9, 0xe8, 0x1c, 0xd2, 0x55, 0x7a,
+	0x65, 0x50, 0x90, 0x98, 0x4f, 0x7f, 0x44, 0xe0, 0x50, 0x42, 0x80, 0xc8, 0xbb, 0x2c, 0xa6, 0xe9,
+	0x18, 0x8a, 0xd6, 0xb3, 0x3d, 0xc2, 0x78, 0x8a, 0xc3, 0x38, 0x4e, 0xe7, 0x53, 0x61, 0x08, 0x25,
+	0x63, 0x75, 0xe3, 0xa3, 0x87, 0x65, 0xf2, 0xe0, 0x61, 0x99, 0xfc, 0xe3, 0x61, 0x99, 0xfc, 0xe0,
+	0x51, 0x79, 0xdf, 0x83, 0x47, 0xe5, 0x7d, 0x7f, 0x7d, 0x54, 0xde, 0x77, 0xeb, 0x85, 0x86, 0x15,
+	0xdc, 0x69, 0xd5, 0x54, 0xd3, 0x69, 0x6a, 0xf8, 0xe7, 0x99, 0x56, 0xcd, 0x3c, 0xd3, 0x70, 0xb4,
+	0xed, 0x0b, 0x5a, 0xd3, 0xa9, 0xb7, 0xb6, 0x98, 0x2f, 0xa2, 0x9e, 0x3d, 0x77, 0x46, 0x06, 0x0e,
+	0xee, 0xb9, 0xcc, 0xaf, 0x8d, 0xf1, 0xbf, 0x93, 0x59, 0xf9, 0xdf, 0x00, 0x71, 0x79, 0xc6, 0x7d,
+	0x2e, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2317,6 +2735,14 @@ type QueryClient interface {
 	UpgradeError(ctx context.Context, in *QueryUpgradeErrorRequest, opts ...grpc.CallOption) (*QueryUpgradeErrorResponse, error)
 	// Upgrade returns the upgrade for a given port and channel id.
 	Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts ...grpc.CallOption) (*QueryUpgradeResponse, error)
+	// CounterpartyUpgrade returns the counterparty upgrade stored for a given port and channel id once the
+	// upgrade has been acknowledged or confirmed on the channel end.
+	CounterpartyUpgrade(ctx context.Context, in *QueryCounterpartyUpgradeRequest, opts ...grpc.CallOption) (*QueryCounterpartyUpgradeResponse, error)
+	// PacketStatus queries the lifecycle state of a packet sequence on a channel end.
+	PacketStatus(ctx context.Context, in *QueryPacketStatusRequest, opts ...grpc.CallOption) (*QueryPacketStatusResponse, error)
+	// PacketStatuses queries the lifecycle state of the packets sent on a channel end,
+	// optionally filtered by the status of the packets.
+	PacketStatuses(ctx context.Context, in *QueryPacketStatusesRequest, opts ...grpc.CallOption) (*QueryPacketStatusesResponse, error)
 	// ChannelParams queries all parameters of the ibc channel submodule.
 	ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error)
 }
@@ -2473,17 +2899,44 @@ func (c *queryClient) Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts
 	return out, nil
 }
 
-func (c *queryClient) ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error) {
-	out := new(QueryChannelParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ChannelParams", in, out, opts...)
+func (c *queryClient) CounterpartyUpgrade(ctx context.Context, in *QueryCounterpartyUpgradeRequest, opts ...grpc.CallOption) (*QueryCounterpartyUpgradeResponse, error) {
+	out := new(QueryCounterpartyUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/CounterpartyUpgrade", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+func (c *queryClient) PacketStatus(ctx context.Context, in *QueryPacketStatusRequest, opts ...grpc.CallOption) (*QueryPacketStatusResponse, error) {
+	out := new(QueryPacketStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PacketStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PacketStatuses(ctx context.Context, in *QueryPacketStatusesRequest, opts ...grpc.CallOption) (*QueryPacketStatusesResponse, error) {
+	out := new(QueryPacketStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PacketStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error) {
+	out := new(QueryChannelParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ChannelParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
 	// Channel queries an IBC Channel.
 	Channel(context.Context, *QueryChannelRequest) (*QueryChannelResponse, error)
 	// Channels queries all the IBC channels of a chain.
@@ -2524,6 +2977,14 @@ type QueryServer interface {
 	UpgradeError(context.Context, *QueryUpgradeErrorRequest) (*QueryUpgradeErrorResponse, error)
 	// Upgrade returns the upgrade for a given port and channel id.
 	Upgrade(context.Context, *QueryUpgradeRequest) (*QueryUpgradeResponse, error)
+	// CounterpartyUpgrade returns the counterparty upgrade stored for a given port and channel id once the
+	// upgrade has been acknowledged or confirmed on the channel end.
+	CounterpartyUpgrade(context.Context, *QueryCounterpartyUpgradeRequest) (*QueryCounterpartyUpgradeResponse, error)
+	// PacketStatus queries the lifecycle state of a packet sequence on a channel end.
+	PacketStatus(context.Context, *QueryPacketStatusRequest) (*QueryPacketStatusResponse, error)
+	// PacketStatuses queries the lifecycle state of the packets sent on a channel end,
+	// optionally filtered by the status of the packets.
+	PacketStatuses(context.Context, *QueryPacketStatusesRequest) (*QueryPacketStatusesResponse, error)
 	// ChannelParams queries all parameters of the ibc channel submodule.
 	ChannelParams(context.Context, *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error)
 }
@@ -2580,6 +3041,15 @@ func (*UnimplementedQueryServer) UpgradeError(ctx context.Context, req *QueryUpg
 func (*UnimplementedQueryServer) Upgrade(ctx context.Context, req *QueryUpgradeRequest) (*QueryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
 }
+func (*UnimplementedQueryServer) CounterpartyUpgrade(ctx context.Context, req *QueryCounterpartyUpgradeRequest) (*QueryCounterpartyUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterpartyUpgrade not implemented")
+}
+func (*UnimplementedQueryServer) PacketStatus(ctx context.Context, req *QueryPacketStatusRequest) (*QueryPacketStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketStatus not implemented")
+}
+func (*UnimplementedQueryServer) PacketStatuses(ctx context.Context, req *QueryPacketStatusesRequest) (*QueryPacketStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketStatuses not implemented")
+}
 func (*UnimplementedQueryServer) ChannelParams(ctx context.Context, req *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChannelParams not implemented")
 }
@@ -2876,6 +3346,60 @@ func _Query_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CounterpartyUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCounterpartyUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CounterpartyUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/CounterpartyUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CounterpartyUpgrade(ctx, req.(*QueryCounterpartyUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PacketStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketStatus(ctx, req.(*QueryPacketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PacketStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PacketStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketStatuses(ctx, req.(*QueryPacketStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryChannelParamsRequest)
 	if err := dec(in); err != nil {
@@ -2962,6 +3486,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Upgrade",
 			Handler:    _Query_Upgrade_Handler,
 		},
+		{
+			MethodName: "CounterpartyUpgrade",
+			Handler:    _Query_CounterpartyUpgrade_Handler,
+		},
+		{
+			MethodName: "PacketStatus",
+			Handler:    _Query_PacketStatus_Handler,
+		},
+		{
+			MethodName: "PacketStatuses",
+			Handler:    _Query_PacketStatuses_Handler,
+		},
 		{
 			MethodName: "ChannelParams",
 			Handler:    _Query_ChannelParams_Handler,
@@ -4504,7 +5040,7 @@ func (m *QueryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCounterpartyUpgradeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4514,20 +5050,34 @@ func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryChannelParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCounterpartyUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChannelParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCounterpartyUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCounterpartyUpgradeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4537,115 +5087,401 @@ func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryChannelParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCounterpartyUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCounterpartyUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPacketStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPacketStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Channel != nil {
-		l = m.Channel.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryChannelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPacketStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryChannelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPacketStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.Height.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConnectionChannelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReceiptPruningProgress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConnectionChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -5192,29 +6028,146 @@ func (m *QueryUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryChannelParamsRequest) Size() (n int) {
+func (m *QueryCounterpartyUpgradeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryChannelParamsResponse) Size() (n int) {
+func (m *QueryCounterpartyUpgradeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryPacketStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *QueryPacketStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPacketStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChannelParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ReceiptPruningProgress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -8636,9 +9589,782 @@ func (m *QueryUnreceivedAcksRequest) Unmarshal(dAtA []byte) error {
 					}
 					m.PacketAckSequences = append(m.PacketAckSequences, v)
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketAckSequences", wireType)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketAckSequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnreceivedAcksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnreceivedAcksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			}
+			m.NextSequenceReceive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceSendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeErrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8660,7 +10386,7 @@ func (m *QueryUnreceivedAcksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8683,91 +10409,82 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnreceivedAcksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeErrorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnreceivedAcksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.Sequences = append(m.Sequences, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Sequences) == 0 {
-					m.Sequences = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Sequences = append(m.Sequences, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8794,7 +10511,7 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8819,7 +10536,7 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8842,10 +10559,10 @@ func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8933,7 +10650,7 @@ func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8956,17 +10673,17 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
-			m.NextSequenceReceive = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8976,11 +10693,25 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
@@ -9069,7 +10800,7 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyUpgradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9092,10 +10823,10 @@ func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceSendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyUpgradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9183,7 +10914,7 @@ func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCounterpartyUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9206,68 +10937,15 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCounterpartyUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCounterpartyUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
-			}
-			m.NextSequenceSend = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextSequenceSend |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9294,7 +10972,7 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9319,7 +10997,7 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9342,10 +11020,10 @@ func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9412,6 +11090,111 @@ func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9433,7 +11216,7 @@ func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9456,15 +11239,15 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9491,47 +11274,13 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9558,7 +11307,7 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9583,7 +11332,7 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9606,10 +11355,10 @@ func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9676,6 +11425,61 @@ func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9697,7 +11501,7 @@ func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9720,15 +11524,15 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPacketStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPacketStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9755,15 +11559,16 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, PacketLifecycle{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9773,29 +11578,31 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9822,7 +11629,7 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9962,6 +11769,39 @@ func (m *QueryChannelParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptPruningProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptPruningProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -58,3 +58,41 @@ func (t Timeout) heightElapsed(height clienttypes.Height) bool {
 func (t Timeout) timestampElapsed(timestamp uint64) bool {
 	return t.Timestamp != 0 && timestamp >= t.Timestamp
 }
+
+// RelativeTimeout defines a packet timeout relative to the latest height of the counterparty client and to
+// the later of the block time and the latest consensus timestamp of the counterparty client at the time the
+// packet is sent. A zero value disables the respective timeout.
+type RelativeTimeout struct {
+	// the revision height offset added to the latest height of the counterparty client
+	RevisionHeight uint64
+	// the offset in nanoseconds added to the later of the block time and the latest consensus timestamp of the counterparty client
+	Timestamp uint64
+}
+
+// NewRelativeTimeout returns a new RelativeTimeout instance.
+func NewRelativeTimeout(revisionHeight, timestamp uint64) RelativeTimeout {
+	return RelativeTimeout{
+		RevisionHeight: revisionHeight,
+		Timestamp:      timestamp,
+	}
+}
+
+// IsValid returns true if either the revision height or timestamp offset is non-zero.
+func (rt RelativeTimeout) IsValid() bool {
+	return rt.RevisionHeight != 0 || rt.Timestamp != 0
+}
+
+// Resolve returns the absolute timeout for the provided latest height of the counterparty client and reference
+// timestamp. The timeout height keeps the revision number of the latest height.
+func (rt RelativeTimeout) Resolve(latestHeight clienttypes.Height, referenceTimestamp uint64) Timeout {
+	var timeout Timeout
+	if rt.RevisionHeight != 0 {
+		timeout.Height = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+rt.RevisionHeight)
+	}
+
+	if rt.Timestamp != 0 {
+		timeout.Timestamp = referenceTimestamp + rt.Timestamp
+	}
+
+	return timeout
+}
@@ -236,3 +236,50 @@ func (suite *TypesTestSuite) TestErrTimeoutNotReached() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestRelativeTimeoutResolve() {
+	var (
+		latestHeight    = clienttypes.NewHeight(1, 100)
+		latestTimestamp = uint64(1000)
+	)
+
+	testCases := []struct {
+		name            string
+		relativeTimeout types.RelativeTimeout
+		expValid        bool
+		expTimeout      types.Timeout
+	}{
+		{
+			"relative timeout height and timestamp",
+			types.NewRelativeTimeout(10, 100),
+			true,
+			types.NewTimeout(clienttypes.NewHeight(1, 110), 1100),
+		},
+		{
+			"relative timeout height and zero timestamp",
+			types.NewRelativeTimeout(10, 0),
+			true,
+			types.NewTimeout(clienttypes.NewHeight(1, 110), 0),
+		},
+		{
+			"relative timeout timestamp and zero height",
+			types.NewRelativeTimeout(0, 100),
+			true,
+			types.NewTimeout(clienttypes.ZeroHeight(), 1100),
+		},
+		{
+			"zero relative timeout height and timestamp",
+			types.NewRelativeTimeout(0, 0),
+			false,
+			types.Timeout{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expValid, tc.relativeTimeout.IsValid())
+			suite.Require().Equal(tc.expTimeout, tc.relativeTimeout.Resolve(latestHeight, latestTimestamp))
+		})
+	}
+}
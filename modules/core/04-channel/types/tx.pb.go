@@ -880,6 +880,269 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgRecvPackets receives a batch of incoming IBC packets. The commitments of all packets
+// are proven at a single proof height, either by a single proof whose lowest subtree proof
+// may be an ICS-23 batch or compressed batch proof, or by a proof for each packet.
+type MsgRecvPackets struct {
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// the single proof of the commitments of all packets, only supported by clients which
+	// implement batch proof verification. Must be empty if proofs_commitment is set.
+	ProofCommitment []byte       `protobuf:"bytes,2,opt,name=proof_commitment,json=proofCommitment,proto3" json:"proof_commitment,omitempty"`
+	ProofHeight     types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the proof of the commitment of each packet, in the same order as the packets, which is
+	// supported by every client. Must be empty if proof_commitment is set.
+	ProofsCommitment [][]byte `protobuf:"bytes,5,rep,name=proofs_commitment,json=proofsCommitment,proto3" json:"proofs_commitment,omitempty"`
+}
+
+func (m *MsgRecvPackets) Reset()         { *m = MsgRecvPackets{} }
+func (m *MsgRecvPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPackets) ProtoMessage()    {}
+func (*MsgRecvPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{20}
+}
+func (m *MsgRecvPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPackets.Merge(m, src)
+}
+func (m *MsgRecvPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPackets proto.InternalMessageInfo
+
+// MsgRecvPacketsResponse defines the Msg/RecvPackets response type.
+type MsgRecvPacketsResponse struct {
+	// the result of each packet, in the order in which the packets were provided
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgRecvPacketsResponse) Reset()         { *m = MsgRecvPacketsResponse{} }
+func (m *MsgRecvPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecvPacketsResponse) ProtoMessage()    {}
+func (*MsgRecvPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{21}
+}
+func (m *MsgRecvPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecvPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecvPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecvPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecvPacketsResponse.Merge(m, src)
+}
+func (m *MsgRecvPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecvPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecvPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecvPacketsResponse proto.InternalMessageInfo
+
+// MsgAcknowledgements receives a batch of incoming IBC acknowledgements. The acknowledgements
+// of all packets are proven at a single proof height, either by a single proof whose lowest
+// subtree proof may be an ICS-23 batch or compressed batch proof, or by a proof for each packet.
+type MsgAcknowledgements struct {
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// the acknowledgements of the packets, in the same order as the packets
+	Acknowledgements [][]byte `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements,omitempty"`
+	// the single proof of the acknowledgements of all packets, only supported by clients which
+	// implement batch proof verification. Must be empty if proofs_acked is set.
+	ProofAcked  []byte       `protobuf:"bytes,3,opt,name=proof_acked,json=proofAcked,proto3" json:"proof_acked,omitempty"`
+	ProofHeight types.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer      string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the proof of the acknowledgement of each packet, in the same order as the packets, which
+	// is supported by every client. Must be empty if proof_acked is set.
+	ProofsAcked [][]byte `protobuf:"bytes,6,rep,name=proofs_acked,json=proofsAcked,proto3" json:"proofs_acked,omitempty"`
+}
+
+func (m *MsgAcknowledgements) Reset()         { *m = MsgAcknowledgements{} }
+func (m *MsgAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgements) ProtoMessage()    {}
+func (*MsgAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{22}
+}
+func (m *MsgAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgements.Merge(m, src)
+}
+func (m *MsgAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgements proto.InternalMessageInfo
+
+// MsgAcknowledgementsResponse defines the Msg/Acknowledgements response type.
+type MsgAcknowledgementsResponse struct {
+	// the result of each acknowledgement, in the order in which the packets were provided
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgAcknowledgementsResponse) Reset()         { *m = MsgAcknowledgementsResponse{} }
+func (m *MsgAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{23}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcknowledgementsResponse proto.InternalMessageInfo
+
+// MsgTimeouts times out a batch of packets sent on the same UNORDERED channel. The absence of
+// the receipts of all packets is proven at a single proof height, either by a single proof whose
+// lowest subtree proof may be an ICS-23 batch or compressed batch proof, or by a proof for each packet.
+type MsgTimeouts struct {
+	Packets []Packet `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// the single proof of the absence of the receipts of all packets, only supported by clients
+	// which implement batch proof verification. Must be empty if proofs_unreceived is set.
+	ProofUnreceived []byte       `protobuf:"bytes,2,opt,name=proof_unreceived,json=proofUnreceived,proto3" json:"proof_unreceived,omitempty"`
+	ProofHeight     types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string       `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the proof of the absence of the receipt of each packet, in the same order as the packets,
+	// which is supported by every client. Must be empty if proof_unreceived is set.
+	ProofsUnreceived [][]byte `protobuf:"bytes,5,rep,name=proofs_unreceived,json=proofsUnreceived,proto3" json:"proofs_unreceived,omitempty"`
+}
+
+func (m *MsgTimeouts) Reset()         { *m = MsgTimeouts{} }
+func (m *MsgTimeouts) String() string { return proto.CompactTextString(m) }
+func (*MsgTimeouts) ProtoMessage()    {}
+func (*MsgTimeouts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{24}
+}
+func (m *MsgTimeouts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimeouts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimeouts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTimeouts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimeouts.Merge(m, src)
+}
+func (m *MsgTimeouts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimeouts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimeouts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTimeouts proto.InternalMessageInfo
+
+// MsgTimeoutsResponse defines the Msg/Timeouts response type.
+type MsgTimeoutsResponse struct {
+	// the result of each timeout, in the order in which the packets were provided
+	Results []ResponseResultType `protobuf:"varint,1,rep,packed,name=results,proto3,enum=ibc.core.channel.v1.ResponseResultType" json:"results,omitempty"`
+}
+
+func (m *MsgTimeoutsResponse) Reset()         { *m = MsgTimeoutsResponse{} }
+func (m *MsgTimeoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTimeoutsResponse) ProtoMessage()    {}
+func (*MsgTimeoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{25}
+}
+func (m *MsgTimeoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimeoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimeoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTimeoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimeoutsResponse.Merge(m, src)
+}
+func (m *MsgTimeoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimeoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimeoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTimeoutsResponse proto.InternalMessageInfo
+
 // MsgChannelUpgradeInit defines the request type for the ChannelUpgradeInit rpc
 // WARNING: Initializing a channel upgrade in the same block as opening the channel
 // may result in the counterparty being incapable of opening.
@@ -894,7 +1157,7 @@ func (m *MsgChannelUpgradeInit) Reset()         { *m = MsgChannelUpgradeInit{} }
 func (m *MsgChannelUpgradeInit) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeInit) ProtoMessage()    {}
 func (*MsgChannelUpgradeInit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{20}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{26}
 }
 func (m *MsgChannelUpgradeInit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1196,7 @@ func (m *MsgChannelUpgradeInitResponse) Reset()         { *m = MsgChannelUpgrade
 func (m *MsgChannelUpgradeInitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeInitResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeInitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{21}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{27}
 }
 func (m *MsgChannelUpgradeInitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1242,7 @@ func (m *MsgChannelUpgradeTry) Reset()         { *m = MsgChannelUpgradeTry{} }
 func (m *MsgChannelUpgradeTry) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeTry) ProtoMessage()    {}
 func (*MsgChannelUpgradeTry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{22}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{28}
 }
 func (m *MsgChannelUpgradeTry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1282,7 @@ func (m *MsgChannelUpgradeTryResponse) Reset()         { *m = MsgChannelUpgradeT
 func (m *MsgChannelUpgradeTryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeTryResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeTryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{23}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{29}
 }
 func (m *MsgChannelUpgradeTryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1326,7 @@ func (m *MsgChannelUpgradeAck) Reset()         { *m = MsgChannelUpgradeAck{} }
 func (m *MsgChannelUpgradeAck) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeAck) ProtoMessage()    {}
 func (*MsgChannelUpgradeAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{24}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{30}
 }
 func (m *MsgChannelUpgradeAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,7 +1364,7 @@ func (m *MsgChannelUpgradeAckResponse) Reset()         { *m = MsgChannelUpgradeA
 func (m *MsgChannelUpgradeAckResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeAckResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeAckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{25}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{31}
 }
 func (m *MsgChannelUpgradeAckResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1409,7 @@ func (m *MsgChannelUpgradeConfirm) Reset()         { *m = MsgChannelUpgradeConfi
 func (m *MsgChannelUpgradeConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeConfirm) ProtoMessage()    {}
 func (*MsgChannelUpgradeConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{26}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{32}
 }
 func (m *MsgChannelUpgradeConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,7 +1447,7 @@ func (m *MsgChannelUpgradeConfirmResponse) Reset()         { *m = MsgChannelUpgr
 func (m *MsgChannelUpgradeConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeConfirmResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{27}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{33}
 }
 func (m *MsgChannelUpgradeConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,7 +1491,7 @@ func (m *MsgChannelUpgradeOpen) Reset()         { *m = MsgChannelUpgradeOpen{} }
 func (m *MsgChannelUpgradeOpen) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeOpen) ProtoMessage()    {}
 func (*MsgChannelUpgradeOpen) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{28}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{34}
 }
 func (m *MsgChannelUpgradeOpen) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1528,7 @@ func (m *MsgChannelUpgradeOpenResponse) Reset()         { *m = MsgChannelUpgrade
 func (m *MsgChannelUpgradeOpenResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeOpenResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeOpenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{29}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{35}
 }
 func (m *MsgChannelUpgradeOpenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1308,7 +1571,7 @@ func (m *MsgChannelUpgradeTimeout) Reset()         { *m = MsgChannelUpgradeTimeo
 func (m *MsgChannelUpgradeTimeout) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeTimeout) ProtoMessage()    {}
 func (*MsgChannelUpgradeTimeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{30}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{36}
 }
 func (m *MsgChannelUpgradeTimeout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1345,7 +1608,7 @@ func (m *MsgChannelUpgradeTimeoutResponse) Reset()         { *m = MsgChannelUpgr
 func (m *MsgChannelUpgradeTimeoutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeTimeoutResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeTimeoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{31}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{37}
 }
 func (m *MsgChannelUpgradeTimeoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1651,7 @@ func (m *MsgChannelUpgradeCancel) Reset()         { *m = MsgChannelUpgradeCancel
 func (m *MsgChannelUpgradeCancel) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeCancel) ProtoMessage()    {}
 func (*MsgChannelUpgradeCancel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{32}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{38}
 }
 func (m *MsgChannelUpgradeCancel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1688,7 @@ func (m *MsgChannelUpgradeCancelResponse) Reset()         { *m = MsgChannelUpgra
 func (m *MsgChannelUpgradeCancelResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChannelUpgradeCancelResponse) ProtoMessage()    {}
 func (*MsgChannelUpgradeCancelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{33}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{39}
 }
 func (m *MsgChannelUpgradeCancelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,6 +1717,93 @@ func (m *MsgChannelUpgradeCancelResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChannelUpgradeCancelResponse proto.InternalMessageInfo
 
+// MsgChannelUpgradeMigrateConnection defines the request type for the ChannelUpgradeMigrateConnection rpc.
+// It initializes a channel upgrade which only changes the connection hops of the channel, keeping the
+// existing channel ordering and version. The proposed connection must be connected to the same counterparty
+// chain as the current connection of the channel.
+type MsgChannelUpgradeMigrateConnection struct {
+	PortId       string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Signer       string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgChannelUpgradeMigrateConnection) Reset()         { *m = MsgChannelUpgradeMigrateConnection{} }
+func (m *MsgChannelUpgradeMigrateConnection) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelUpgradeMigrateConnection) ProtoMessage()    {}
+func (*MsgChannelUpgradeMigrateConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{40}
+}
+func (m *MsgChannelUpgradeMigrateConnection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelUpgradeMigrateConnection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelUpgradeMigrateConnection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelUpgradeMigrateConnection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelUpgradeMigrateConnection.Merge(m, src)
+}
+func (m *MsgChannelUpgradeMigrateConnection) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelUpgradeMigrateConnection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelUpgradeMigrateConnection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelUpgradeMigrateConnection proto.InternalMessageInfo
+
+// MsgChannelUpgradeMigrateConnectionResponse defines the MsgChannelUpgradeMigrateConnection response type
+type MsgChannelUpgradeMigrateConnectionResponse struct {
+	Upgrade         Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+	UpgradeSequence uint64  `protobuf:"varint,2,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+}
+
+func (m *MsgChannelUpgradeMigrateConnectionResponse) Reset() {
+	*m = MsgChannelUpgradeMigrateConnectionResponse{}
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgChannelUpgradeMigrateConnectionResponse) ProtoMessage() {}
+func (*MsgChannelUpgradeMigrateConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{41}
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelUpgradeMigrateConnectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelUpgradeMigrateConnectionResponse.Merge(m, src)
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelUpgradeMigrateConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelUpgradeMigrateConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelUpgradeMigrateConnectionResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the MsgUpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -1468,7 +1818,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{34}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{42}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,7 +1855,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{35}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{43}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1546,7 +1896,7 @@ func (m *MsgPruneAcknowledgements) Reset()         { *m = MsgPruneAcknowledgemen
 func (m *MsgPruneAcknowledgements) String() string { return proto.CompactTextString(m) }
 func (*MsgPruneAcknowledgements) ProtoMessage()    {}
 func (*MsgPruneAcknowledgements) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{36}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{44}
 }
 func (m *MsgPruneAcknowledgements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,7 +1937,7 @@ func (m *MsgPruneAcknowledgementsResponse) Reset()         { *m = MsgPruneAcknow
 func (m *MsgPruneAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPruneAcknowledgementsResponse) ProtoMessage()    {}
 func (*MsgPruneAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bc4637e0ac3fc7b7, []int{37}
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{45}
 }
 func (m *MsgPruneAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1630,176 +1980,491 @@ func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
-	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
-	proto.RegisterType((*MsgChannelOpenInitResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenInitResponse")
-	proto.RegisterType((*MsgChannelOpenTry)(nil), "ibc.core.channel.v1.MsgChannelOpenTry")
-	proto.RegisterType((*MsgChannelOpenTryResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenTryResponse")
-	proto.RegisterType((*MsgChannelOpenAck)(nil), "ibc.core.channel.v1.MsgChannelOpenAck")
-	proto.RegisterType((*MsgChannelOpenAckResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenAckResponse")
-	proto.RegisterType((*MsgChannelOpenConfirm)(nil), "ibc.core.channel.v1.MsgChannelOpenConfirm")
-	proto.RegisterType((*MsgChannelOpenConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenConfirmResponse")
-	proto.RegisterType((*MsgChannelCloseInit)(nil), "ibc.core.channel.v1.MsgChannelCloseInit")
-	proto.RegisterType((*MsgChannelCloseInitResponse)(nil), "ibc.core.channel.v1.MsgChannelCloseInitResponse")
-	proto.RegisterType((*MsgChannelCloseConfirm)(nil), "ibc.core.channel.v1.MsgChannelCloseConfirm")
-	proto.RegisterType((*MsgChannelCloseConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelCloseConfirmResponse")
-	proto.RegisterType((*MsgRecvPacket)(nil), "ibc.core.channel.v1.MsgRecvPacket")
-	proto.RegisterType((*MsgRecvPacketResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketResponse")
-	proto.RegisterType((*MsgTimeout)(nil), "ibc.core.channel.v1.MsgTimeout")
-	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v1.MsgTimeoutResponse")
-	proto.RegisterType((*MsgTimeoutOnClose)(nil), "ibc.core.channel.v1.MsgTimeoutOnClose")
-	proto.RegisterType((*MsgTimeoutOnCloseResponse)(nil), "ibc.core.channel.v1.MsgTimeoutOnCloseResponse")
-	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v1.MsgAcknowledgement")
-	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementResponse")
-	proto.RegisterType((*MsgChannelUpgradeInit)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInit")
-	proto.RegisterType((*MsgChannelUpgradeInitResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInitResponse")
-	proto.RegisterType((*MsgChannelUpgradeTry)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTry")
-	proto.RegisterType((*MsgChannelUpgradeTryResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTryResponse")
-	proto.RegisterType((*MsgChannelUpgradeAck)(nil), "ibc.core.channel.v1.MsgChannelUpgradeAck")
-	proto.RegisterType((*MsgChannelUpgradeAckResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeAckResponse")
-	proto.RegisterType((*MsgChannelUpgradeConfirm)(nil), "ibc.core.channel.v1.MsgChannelUpgradeConfirm")
-	proto.RegisterType((*MsgChannelUpgradeConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeConfirmResponse")
-	proto.RegisterType((*MsgChannelUpgradeOpen)(nil), "ibc.core.channel.v1.MsgChannelUpgradeOpen")
-	proto.RegisterType((*MsgChannelUpgradeOpenResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeOpenResponse")
-	proto.RegisterType((*MsgChannelUpgradeTimeout)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTimeout")
-	proto.RegisterType((*MsgChannelUpgradeTimeoutResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTimeoutResponse")
-	proto.RegisterType((*MsgChannelUpgradeCancel)(nil), "ibc.core.channel.v1.MsgChannelUpgradeCancel")
-	proto.RegisterType((*MsgChannelUpgradeCancelResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeCancelResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.channel.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
-	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+// MsgScheduleReceiptPruning schedules the packet receipts and acknowledgements of packets received on an
+// UNORDERED channel to be pruned once their retention period has elapsed. The absence of the packet
+// commitments on the counterparty is proven at a single proof height, either by a single proof whose
+// lowest subtree proof may be an ICS-23 batch or compressed batch proof, or by a proof for each sequence.
+type MsgScheduleReceiptPruning struct {
+	PortId    string   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string   `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequences []uint64 `protobuf:"varint,3,rep,packed,name=sequences,proto3" json:"sequences,omitempty"`
+	// the single proof of the absence of the counterparty packet commitments of all sequences, only supported
+	// by clients which implement batch proof verification. Must be empty if proofs_uncommitted is set.
+	ProofUncommitted []byte       `protobuf:"bytes,4,opt,name=proof_uncommitted,json=proofUncommitted,proto3" json:"proof_uncommitted,omitempty"`
+	ProofHeight      types.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer           string       `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the proof of the absence of the counterparty packet commitment of each sequence, in the same order as
+	// the sequences. Must be empty if proof_uncommitted is set.
+	ProofsUncommitted [][]byte `protobuf:"bytes,7,rep,name=proofs_uncommitted,json=proofsUncommitted,proto3" json:"proofs_uncommitted,omitempty"`
+}
+
+func (m *MsgScheduleReceiptPruning) Reset()         { *m = MsgScheduleReceiptPruning{} }
+func (m *MsgScheduleReceiptPruning) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleReceiptPruning) ProtoMessage()    {}
+func (*MsgScheduleReceiptPruning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{46}
+}
+func (m *MsgScheduleReceiptPruning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleReceiptPruning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleReceiptPruning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleReceiptPruning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleReceiptPruning.Merge(m, src)
+}
+func (m *MsgScheduleReceiptPruning) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleReceiptPruning) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleReceiptPruning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleReceiptPruning proto.InternalMessageInfo
+
+// MsgScheduleReceiptPruningResponse defines the response type for the ScheduleReceiptPruning rpc.
+type MsgScheduleReceiptPruningResponse struct {
+	// Number of packet receipts scheduled to be pruned.
+	TotalScheduledSequences uint64 `protobuf:"varint,1,opt,name=total_scheduled_sequences,json=totalScheduledSequences,proto3" json:"total_scheduled_sequences,omitempty"`
+}
+
+func (m *MsgScheduleReceiptPruningResponse) Reset()         { *m = MsgScheduleReceiptPruningResponse{} }
+func (m *MsgScheduleReceiptPruningResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleReceiptPruningResponse) ProtoMessage()    {}
+func (*MsgScheduleReceiptPruningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{47}
+}
+func (m *MsgScheduleReceiptPruningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleReceiptPruningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleReceiptPruningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleReceiptPruningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleReceiptPruningResponse.Merge(m, src)
+}
+func (m *MsgScheduleReceiptPruningResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleReceiptPruningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleReceiptPruningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleReceiptPruningResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleReceiptPruningResponse) GetTotalScheduledSequences() uint64 {
+	if m != nil {
+		return m.TotalScheduledSequences
+	}
+	return 0
+}
+
+// MsgChannelForceClose defines the request type for the ChannelForceClose rpc.
+// It allows the authority to close a channel end without counterparty proofs, for example
+// when the counterparty chain has permanently halted. The OnChanCloseConfirm callback of
+// the application is executed before the channel is closed, and may veto the closure by
+// returning an error.
+type MsgChannelForceClose struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgChannelForceClose) Reset()         { *m = MsgChannelForceClose{} }
+func (m *MsgChannelForceClose) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelForceClose) ProtoMessage()    {}
+func (*MsgChannelForceClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{48}
+}
+func (m *MsgChannelForceClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelForceClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelForceClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelForceClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelForceClose.Merge(m, src)
+}
+func (m *MsgChannelForceClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelForceClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelForceClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelForceClose proto.InternalMessageInfo
+
+// MsgChannelForceCloseResponse defines the MsgChannelForceClose response type.
+type MsgChannelForceCloseResponse struct {
+}
+
+func (m *MsgChannelForceCloseResponse) Reset()         { *m = MsgChannelForceCloseResponse{} }
+func (m *MsgChannelForceCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelForceCloseResponse) ProtoMessage()    {}
+func (*MsgChannelForceCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{49}
+}
+func (m *MsgChannelForceCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelForceCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelForceCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelForceCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelForceCloseResponse.Merge(m, src)
+}
+func (m *MsgChannelForceCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelForceCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelForceCloseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelForceCloseResponse proto.InternalMessageInfo
+
+// MsgChannelForceTimeout defines the request type for the ChannelForceTimeout rpc.
+// It allows the authority to process the provided packets sent on a force closed channel end as
+// though they had timed out, without counterparty proofs. Each packet must match an outstanding
+// packet commitment. The OnTimeoutPacket callback of the application is executed for every packet
+// without a relayer, and may veto the refund by returning an error.
+// WARNING: the counterparty state is not verified. If a packet was, or is later, received on the
+// counterparty chain the packet will be processed on both chains, e.g. tokens are refunded to the
+// sender while also being credited to the receiver. The authority must ensure the counterparty
+// chain can no longer receive the packets before force timing them out.
+type MsgChannelForceTimeout struct {
+	PortId    string   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string   `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Packets   []Packet `protobuf:"bytes,3,rep,name=packets,proto3" json:"packets"`
+	Signer    string   `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgChannelForceTimeout) Reset()         { *m = MsgChannelForceTimeout{} }
+func (m *MsgChannelForceTimeout) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelForceTimeout) ProtoMessage()    {}
+func (*MsgChannelForceTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{50}
+}
+func (m *MsgChannelForceTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelForceTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelForceTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelForceTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelForceTimeout.Merge(m, src)
+}
+func (m *MsgChannelForceTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelForceTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelForceTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelForceTimeout proto.InternalMessageInfo
+
+// MsgChannelForceTimeoutResponse defines the MsgChannelForceTimeout response type.
+type MsgChannelForceTimeoutResponse struct {
+	// number of packet commitments remaining on the channel end after processing the provided packets
+	RemainingPacketCommitments uint64 `protobuf:"varint,1,opt,name=remaining_packet_commitments,json=remainingPacketCommitments,proto3" json:"remaining_packet_commitments,omitempty"`
+}
+
+func (m *MsgChannelForceTimeoutResponse) Reset()         { *m = MsgChannelForceTimeoutResponse{} }
+func (m *MsgChannelForceTimeoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelForceTimeoutResponse) ProtoMessage()    {}
+func (*MsgChannelForceTimeoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{51}
+}
+func (m *MsgChannelForceTimeoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelForceTimeoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelForceTimeoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelForceTimeoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelForceTimeoutResponse.Merge(m, src)
+}
+func (m *MsgChannelForceTimeoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelForceTimeoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelForceTimeoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelForceTimeoutResponse proto.InternalMessageInfo
+
+func (m *MsgChannelForceTimeoutResponse) GetRemainingPacketCommitments() uint64 {
+	if m != nil {
+		return m.RemainingPacketCommitments
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
+	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
+	proto.RegisterType((*MsgChannelOpenInitResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenInitResponse")
+	proto.RegisterType((*MsgChannelOpenTry)(nil), "ibc.core.channel.v1.MsgChannelOpenTry")
+	proto.RegisterType((*MsgChannelOpenTryResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenTryResponse")
+	proto.RegisterType((*MsgChannelOpenAck)(nil), "ibc.core.channel.v1.MsgChannelOpenAck")
+	proto.RegisterType((*MsgChannelOpenAckResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenAckResponse")
+	proto.RegisterType((*MsgChannelOpenConfirm)(nil), "ibc.core.channel.v1.MsgChannelOpenConfirm")
+	proto.RegisterType((*MsgChannelOpenConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelOpenConfirmResponse")
+	proto.RegisterType((*MsgChannelCloseInit)(nil), "ibc.core.channel.v1.MsgChannelCloseInit")
+	proto.RegisterType((*MsgChannelCloseInitResponse)(nil), "ibc.core.channel.v1.MsgChannelCloseInitResponse")
+	proto.RegisterType((*MsgChannelCloseConfirm)(nil), "ibc.core.channel.v1.MsgChannelCloseConfirm")
+	proto.RegisterType((*MsgChannelCloseConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelCloseConfirmResponse")
+	proto.RegisterType((*MsgRecvPacket)(nil), "ibc.core.channel.v1.MsgRecvPacket")
+	proto.RegisterType((*MsgRecvPacketResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketResponse")
+	proto.RegisterType((*MsgTimeout)(nil), "ibc.core.channel.v1.MsgTimeout")
+	proto.RegisterType((*MsgTimeoutResponse)(nil), "ibc.core.channel.v1.MsgTimeoutResponse")
+	proto.RegisterType((*MsgTimeoutOnClose)(nil), "ibc.core.channel.v1.MsgTimeoutOnClose")
+	proto.RegisterType((*MsgTimeoutOnCloseResponse)(nil), "ibc.core.channel.v1.MsgTimeoutOnCloseResponse")
+	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v1.MsgAcknowledgement")
+	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgRecvPackets)(nil), "ibc.core.channel.v1.MsgRecvPackets")
+	proto.RegisterType((*MsgRecvPacketsResponse)(nil), "ibc.core.channel.v1.MsgRecvPacketsResponse")
+	proto.RegisterType((*MsgAcknowledgements)(nil), "ibc.core.channel.v1.MsgAcknowledgements")
+	proto.RegisterType((*MsgAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementsResponse")
+	proto.RegisterType((*MsgTimeouts)(nil), "ibc.core.channel.v1.MsgTimeouts")
+	proto.RegisterType((*MsgTimeoutsResponse)(nil), "ibc.core.channel.v1.MsgTimeoutsResponse")
+	proto.RegisterType((*MsgChannelUpgradeInit)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInit")
+	proto.RegisterType((*MsgChannelUpgradeInitResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInitResponse")
+	proto.RegisterType((*MsgChannelUpgradeTry)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTry")
+	proto.RegisterType((*MsgChannelUpgradeTryResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTryResponse")
+	proto.RegisterType((*MsgChannelUpgradeAck)(nil), "ibc.core.channel.v1.MsgChannelUpgradeAck")
+	proto.RegisterType((*MsgChannelUpgradeAckResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeAckResponse")
+	proto.RegisterType((*MsgChannelUpgradeConfirm)(nil), "ibc.core.channel.v1.MsgChannelUpgradeConfirm")
+	proto.RegisterType((*MsgChannelUpgradeConfirmResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeConfirmResponse")
+	proto.RegisterType((*MsgChannelUpgradeOpen)(nil), "ibc.core.channel.v1.MsgChannelUpgradeOpen")
+	proto.RegisterType((*MsgChannelUpgradeOpenResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeOpenResponse")
+	proto.RegisterType((*MsgChannelUpgradeTimeout)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTimeout")
+	proto.RegisterType((*MsgChannelUpgradeTimeoutResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeTimeoutResponse")
+	proto.RegisterType((*MsgChannelUpgradeCancel)(nil), "ibc.core.channel.v1.MsgChannelUpgradeCancel")
+	proto.RegisterType((*MsgChannelUpgradeCancelResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeCancelResponse")
+	proto.RegisterType((*MsgChannelUpgradeMigrateConnection)(nil), "ibc.core.channel.v1.MsgChannelUpgradeMigrateConnection")
+	proto.RegisterType((*MsgChannelUpgradeMigrateConnectionResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeMigrateConnectionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.channel.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
+	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgScheduleReceiptPruning)(nil), "ibc.core.channel.v1.MsgScheduleReceiptPruning")
+	proto.RegisterType((*MsgScheduleReceiptPruningResponse)(nil), "ibc.core.channel.v1.MsgScheduleReceiptPruningResponse")
+	proto.RegisterType((*MsgChannelForceClose)(nil), "ibc.core.channel.v1.MsgChannelForceClose")
+	proto.RegisterType((*MsgChannelForceCloseResponse)(nil), "ibc.core.channel.v1.MsgChannelForceCloseResponse")
+	proto.RegisterType((*MsgChannelForceTimeout)(nil), "ibc.core.channel.v1.MsgChannelForceTimeout")
+	proto.RegisterType((*MsgChannelForceTimeoutResponse)(nil), "ibc.core.channel.v1.MsgChannelForceTimeoutResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xf5, 0x33, 0x7e, 0x4e, 0xd6, 0x0a, 0xe5, 0xc4, 0x32, 0x6d, 0x4b, 0x8a, 0x5a, 0x6c,
-	0xbc, 0x6e, 0x22, 0xad, 0xbd, 0x49, 0xd1, 0x0d, 0x16, 0x68, 0x1d, 0x55, 0xe9, 0x1a, 0x88, 0x63,
-	0x83, 0xb2, 0x8a, 0x76, 0xb7, 0xa8, 0x20, 0x53, 0x13, 0x8a, 0x90, 0x44, 0x72, 0x49, 0x4a, 0xbb,
-	0x2e, 0xd0, 0x62, 0xd1, 0x53, 0x90, 0xc3, 0xa2, 0x05, 0xf6, 0x1a, 0xa0, 0x45, 0xff, 0x81, 0x3d,
-	0xf7, 0xc7, 0xa1, 0xb7, 0x3d, 0x15, 0x7b, 0x5c, 0x14, 0xe8, 0xa2, 0x48, 0x0e, 0xb9, 0xf4, 0x2f,
-	0x28, 0x50, 0xa0, 0xe0, 0xcc, 0x90, 0xa2, 0xc8, 0xa1, 0x44, 0x59, 0xaa, 0xd1, 0x9b, 0x38, 0xf3,
-	0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0xcd, 0xe3, 0xcc, 0x50, 0xb0, 0xa5, 0x9c, 0x49, 0x15, 0x49, 0x33,
-	0x50, 0x45, 0xea, 0xb4, 0x54, 0x15, 0xf5, 0x2a, 0xc3, 0xbd, 0x8a, 0xf5, 0x49, 0x59, 0x37, 0x34,
-	0x4b, 0xe3, 0xb3, 0xca, 0x99, 0x54, 0xb6, 0x7b, 0xcb, 0xb4, 0xb7, 0x3c, 0xdc, 0x13, 0xd6, 0x64,
-	0x4d, 0xd6, 0x70, 0x7f, 0xc5, 0xfe, 0x45, 0xa0, 0xc2, 0xba, 0xa4, 0x99, 0x7d, 0xcd, 0xac, 0xf4,
-	0x4d, 0xd9, 0x36, 0xd1, 0x37, 0x65, 0xda, 0x51, 0x18, 0x79, 0xe8, 0x29, 0x48, 0xb5, 0xec, 0x5e,
-	0xf2, 0x8b, 0x02, 0x6e, 0xb1, 0x42, 0x70, 0xfc, 0x4d, 0x80, 0x0c, 0x74, 0xd9, 0x68, 0xb5, 0x11,
-	0x81, 0x94, 0x3e, 0xe7, 0x80, 0x3f, 0x32, 0xe5, 0x2a, 0xe9, 0x3f, 0xd6, 0x91, 0x7a, 0xa8, 0x2a,
-	0x16, 0xbf, 0x0e, 0x69, 0x5d, 0x33, 0xac, 0xa6, 0xd2, 0xce, 0x71, 0x45, 0x6e, 0x67, 0x59, 0x4c,
-	0xd9, 0x8f, 0x87, 0x6d, 0xfe, 0x3d, 0x48, 0x53, 0x5b, 0xb9, 0x58, 0x91, 0xdb, 0x59, 0xd9, 0xdf,
-	0x2a, 0x33, 0x26, 0x5b, 0xa6, 0xf6, 0x1e, 0x26, 0xbe, 0xfc, 0xa6, 0xb0, 0x24, 0x3a, 0x43, 0xf8,
-	0x9b, 0x90, 0x32, 0x15, 0x59, 0x45, 0x46, 0x2e, 0x4e, 0xac, 0x92, 0xa7, 0x07, 0xab, 0xcf, 0x7e,
-	0x57, 0x58, 0xfa, 0xf5, 0xeb, 0x2f, 0x76, 0x69, 0x43, 0xe9, 0x43, 0x10, 0x82, 0x51, 0x89, 0xc8,
-	0xd4, 0x35, 0xd5, 0x44, 0xfc, 0x36, 0x00, 0xb5, 0x38, 0x0a, 0x70, 0x99, 0xb6, 0x1c, 0xb6, 0xf9,
-	0x1c, 0xa4, 0x87, 0xc8, 0x30, 0x15, 0x4d, 0xc5, 0x31, 0x2e, 0x8b, 0xce, 0xe3, 0x83, 0x84, 0xed,
-	0xa7, 0xf4, 0x4d, 0x0c, 0xae, 0x8f, 0x5b, 0x3f, 0x35, 0xce, 0xc3, 0xa7, 0xbc, 0x0f, 0x59, 0xdd,
-	0x40, 0x43, 0x45, 0x1b, 0x98, 0x4d, 0x8f, 0x5b, 0x6c, 0xfa, 0x61, 0x2c, 0xc7, 0x89, 0xd7, 0x9d,
-	0xee, 0xaa, 0x1b, 0x82, 0x87, 0xa6, 0xf8, 0xec, 0x34, 0xed, 0xc1, 0x9a, 0xa4, 0x0d, 0x54, 0x0b,
-	0x19, 0x7a, 0xcb, 0xb0, 0xce, 0x9b, 0xce, 0x6c, 0x12, 0x38, 0xae, 0xac, 0xb7, 0xef, 0xc7, 0xa4,
-	0xcb, 0xa6, 0x44, 0x37, 0x34, 0xed, 0x69, 0x53, 0x51, 0x15, 0x2b, 0x97, 0x2c, 0x72, 0x3b, 0x57,
-	0xc5, 0x65, 0xdc, 0x82, 0xf5, 0xac, 0xc2, 0x55, 0xd2, 0xdd, 0x41, 0x8a, 0xdc, 0xb1, 0x72, 0x29,
-	0x1c, 0x94, 0xe0, 0x09, 0x8a, 0xa4, 0xd6, 0x70, 0xaf, 0xfc, 0x3e, 0x46, 0xd0, 0x90, 0x56, 0xf0,
-	0x28, 0xd2, 0xe4, 0x51, 0x2f, 0x3d, 0x59, 0xbd, 0x0f, 0x60, 0x23, 0xc0, 0xaf, 0x2b, 0x9e, 0x47,
-	0x1d, 0x6e, 0x4c, 0x1d, 0x9f, 0xac, 0x31, 0x9f, 0xac, 0x54, 0xbc, 0xbf, 0x06, 0xc4, 0x3b, 0x90,
-	0xba, 0xe1, 0xe2, 0x4d, 0xb6, 0xc9, 0x7f, 0x17, 0xd6, 0xc7, 0x98, 0xf6, 0x60, 0x49, 0x86, 0xde,
-	0xf0, 0x76, 0x8f, 0xf4, 0xbd, 0x80, 0x42, 0x9b, 0x40, 0xf4, 0x68, 0x5a, 0xc6, 0x39, 0x15, 0xe8,
-	0x0a, 0x6e, 0xb0, 0x93, 0xef, 0x72, 0xf5, 0xd9, 0xf4, 0xeb, 0x73, 0x20, 0x75, 0x1d, 0x7d, 0x4a,
-	0x7f, 0xe7, 0xe0, 0xc6, 0x78, 0x6f, 0x55, 0x53, 0x9f, 0x2a, 0x46, 0xff, 0xc2, 0x24, 0xbb, 0x33,
-	0x6f, 0x49, 0x5d, 0x4c, 0xab, 0x33, 0x73, 0x5b, 0x39, 0xff, 0xcc, 0x13, 0xf3, 0xcd, 0x3c, 0x39,
-	0x79, 0xe6, 0x05, 0xd8, 0x66, 0xce, 0xcd, 0x9d, 0xfd, 0x10, 0xb2, 0x23, 0x40, 0xb5, 0xa7, 0x99,
-	0x68, 0x72, 0x3d, 0x9c, 0x32, 0xf5, 0xc8, 0x05, 0x6f, 0x1b, 0x36, 0x19, 0x7e, 0xdd, 0xb0, 0x7e,
-	0x1f, 0x83, 0x9b, 0xbe, 0xfe, 0x79, 0x55, 0x19, 0xaf, 0x18, 0xf1, 0x69, 0x15, 0x63, 0x91, 0xba,
-	0xf0, 0x0f, 0x61, 0x7b, 0x6c, 0xf9, 0xd0, 0x77, 0x52, 0xd3, 0x44, 0x1f, 0x0d, 0x90, 0x2a, 0x21,
-	0x9c, 0xff, 0x09, 0x71, 0xd3, 0x0b, 0x6a, 0x10, 0x4c, 0x9d, 0x42, 0x82, 0x14, 0x16, 0x21, 0xcf,
-	0xa6, 0xc8, 0x65, 0xf1, 0x15, 0x07, 0xd7, 0x8e, 0x4c, 0x59, 0x44, 0xd2, 0xf0, 0xa4, 0x25, 0x75,
-	0x91, 0xc5, 0xbf, 0x0b, 0x29, 0x1d, 0xff, 0xc2, 0xdc, 0xad, 0xec, 0x6f, 0x32, 0xcb, 0x34, 0x01,
-	0xd3, 0x09, 0xd2, 0x01, 0xfc, 0x5b, 0x90, 0x21, 0x04, 0x49, 0x5a, 0xbf, 0xaf, 0x58, 0x7d, 0xa4,
-	0x5a, 0x98, 0xe4, 0xab, 0xe2, 0x2a, 0x6e, 0xaf, 0xba, 0xcd, 0x01, 0x2e, 0xe3, 0xf3, 0x71, 0x99,
-	0x98, 0x9c, 0x4a, 0x3f, 0xc7, 0xeb, 0x77, 0x34, 0x49, 0xb7, 0xf2, 0x7e, 0x1f, 0x52, 0x06, 0x32,
-	0x07, 0x3d, 0x32, 0xd9, 0x37, 0xf6, 0x6f, 0x33, 0x27, 0xeb, 0xc0, 0x45, 0x0c, 0x3d, 0x3d, 0xd7,
-	0x91, 0x48, 0x87, 0xd1, 0x0a, 0xfc, 0x59, 0x0c, 0xe0, 0xc8, 0x94, 0x4f, 0x95, 0x3e, 0xd2, 0x06,
-	0x8b, 0xa1, 0x70, 0xa0, 0x1a, 0x48, 0x42, 0xca, 0x10, 0xb5, 0xc7, 0x28, 0x6c, 0xb8, 0xcd, 0x8b,
-	0xa1, 0xf0, 0x0e, 0xf0, 0x2a, 0xfa, 0xc4, 0x72, 0xd3, 0xac, 0x69, 0x20, 0x69, 0x88, 0xe9, 0x4c,
-	0x88, 0x19, 0xbb, 0xc7, 0x49, 0x2e, 0x9b, 0xbc, 0xe8, 0x45, 0xe5, 0x43, 0xbc, 0x85, 0xa2, 0x7c,
-	0x2c, 0x9a, 0xed, 0x7f, 0x93, 0xf7, 0x1d, 0xb5, 0x7e, 0xac, 0xe2, 0xc4, 0xbe, 0x24, 0xd2, 0x0b,
-	0xb0, 0x42, 0x53, 0xdc, 0x76, 0x4a, 0x6b, 0x04, 0xa9, 0x1a, 0x24, 0x8c, 0x85, 0x14, 0x09, 0xb6,
-	0x2a, 0xc9, 0xa9, 0xaa, 0xa4, 0x66, 0x2b, 0x29, 0xe9, 0x0b, 0x94, 0x94, 0x33, 0xfc, 0xa2, 0x1c,
-	0xe7, 0x7e, 0xd1, 0x02, 0x3f, 0x8b, 0xe1, 0xf4, 0x39, 0x90, 0xba, 0xaa, 0xf6, 0x71, 0x0f, 0xb5,
-	0x65, 0x84, 0x6b, 0xc6, 0x1c, 0x0a, 0xef, 0xc0, 0x6a, 0x6b, 0xdc, 0x9a, 0x23, 0xb0, 0xaf, 0x79,
-	0x24, 0xb0, 0x3d, 0xb0, 0x3d, 0x26, 0xf0, 0x81, 0xdd, 0x72, 0xc9, 0x6f, 0x67, 0x09, 0xef, 0xfa,
-	0x7d, 0x4c, 0x2c, 0x9a, 0xef, 0x3f, 0x8e, 0xed, 0x6f, 0x68, 0x0a, 0xcc, 0xf5, 0x92, 0xff, 0x01,
-	0xa4, 0x9e, 0x2a, 0xa8, 0xd7, 0x36, 0x69, 0x55, 0x2a, 0x31, 0x03, 0xa3, 0x9e, 0x1e, 0x61, 0xa4,
-	0xa3, 0x18, 0x19, 0x17, 0xbd, 0xb6, 0x7f, 0xc6, 0x79, 0x37, 0x30, 0x9e, 0xe0, 0x5d, 0x96, 0xde,
-	0x83, 0x34, 0x4d, 0x7d, 0x9a, 0x38, 0x5b, 0x93, 0xa2, 0x71, 0x4e, 0x1e, 0x74, 0x88, 0x5d, 0x1c,
-	0x02, 0x0b, 0x27, 0x86, 0x17, 0xce, 0xea, 0xc0, 0xb7, 0x58, 0x08, 0x9b, 0xff, 0x89, 0xc3, 0x5a,
-	0x20, 0xa0, 0x89, 0xc7, 0xa9, 0x29, 0x64, 0xfe, 0x08, 0x8a, 0xba, 0xa1, 0xe9, 0x9a, 0x89, 0xda,
-	0xee, 0x1a, 0x96, 0x34, 0x55, 0x45, 0x92, 0xa5, 0x68, 0x6a, 0xb3, 0xa3, 0xe9, 0x36, 0xcd, 0xf1,
-	0x9d, 0x65, 0x71, 0xdb, 0xc1, 0x51, 0xaf, 0x55, 0x17, 0xf5, 0xbe, 0xa6, 0x9b, 0x7c, 0x07, 0x36,
-	0x99, 0x05, 0x81, 0x4a, 0x95, 0x98, 0x51, 0xaa, 0x0d, 0x46, 0xe1, 0x20, 0x80, 0xe9, 0xa5, 0x27,
-	0x39, 0xb5, 0xf4, 0xf0, 0xdf, 0x82, 0x6b, 0xb4, 0xd4, 0xd2, 0x63, 0x63, 0x0a, 0xaf, 0x45, 0xb2,
-	0xfa, 0x28, 0xbb, 0x23, 0x90, 0xa3, 0x70, 0xda, 0x03, 0xa2, 0x16, 0x03, 0x4b, 0xf6, 0xca, 0x7c,
-	0x4b, 0x76, 0x79, 0x72, 0x42, 0xfe, 0x8d, 0x83, 0x2d, 0x96, 0xfe, 0x97, 0x9e, 0x8f, 0x9e, 0xf2,
-	0x10, 0x9f, 0xa7, 0x3c, 0xfc, 0x23, 0xc6, 0x48, 0xe8, 0x79, 0x8e, 0x98, 0x0d, 0xdf, 0x51, 0xd1,
-	0x61, 0x23, 0x1e, 0x99, 0x8d, 0x2c, 0x23, 0x71, 0x82, 0x09, 0x93, 0x88, 0x92, 0x30, 0xc9, 0x08,
-	0x09, 0xf3, 0xbf, 0x3d, 0x7b, 0x22, 0x46, 0xbe, 0x78, 0x8e, 0x9f, 0x8b, 0xaa, 0xf2, 0x7f, 0x8a,
-	0x43, 0x2e, 0xe0, 0x67, 0xde, 0x23, 0xd3, 0x4f, 0x40, 0x60, 0xde, 0x16, 0x98, 0x56, 0xcb, 0x42,
-	0x34, 0xed, 0x04, 0x66, 0xbc, 0x75, 0x1b, 0x21, 0xe6, 0x18, 0x97, 0x09, 0xb8, 0x27, 0x34, 0x49,
-	0x12, 0x0b, 0x4e, 0x92, 0x64, 0x94, 0x24, 0x49, 0x45, 0x48, 0x92, 0xf4, 0x7c, 0x49, 0x72, 0x65,
-	0x72, 0x92, 0x28, 0x50, 0x0c, 0x13, 0x6f, 0xd1, 0x89, 0xf2, 0x69, 0x9c, 0xb1, 0x1d, 0x38, 0xd6,
-	0x91, 0xfa, 0x7f, 0x98, 0x25, 0x53, 0x5f, 0x34, 0x89, 0x0b, 0xbc, 0x68, 0x58, 0x29, 0x71, 0xb9,
-	0x25, 0xa1, 0xc0, 0xd8, 0xd3, 0xd8, 0x0a, 0xb8, 0xe7, 0xf6, 0x3f, 0xc7, 0x18, 0x8b, 0xd9, 0x39,
-	0x7f, 0x2e, 0xaa, 0x2e, 0xcf, 0x7e, 0x5f, 0x9b, 0x65, 0x08, 0x15, 0xad, 0x2e, 0xfb, 0xf9, 0x4d,
-	0xce, 0xc7, 0x6f, 0x6a, 0x32, 0xbf, 0x25, 0xc6, 0x6a, 0xf2, 0x9d, 0x56, 0x4b, 0x7f, 0x89, 0xc1,
-	0x7a, 0x70, 0xc9, 0xb5, 0x54, 0x09, 0xf5, 0x2e, 0xcc, 0xf0, 0x63, 0xb8, 0x86, 0x0c, 0x43, 0x33,
-	0x9a, 0xf8, 0x40, 0xa9, 0x3b, 0x87, 0xf6, 0x5b, 0x4c, 0x6a, 0x6b, 0x36, 0x52, 0x24, 0x40, 0x3a,
-	0xdb, 0xab, 0xc8, 0xd3, 0xc6, 0x97, 0x21, 0x4b, 0x38, 0x1b, 0xb7, 0x49, 0xe8, 0xbd, 0x8e, 0xbb,
-	0xbc, 0x36, 0x2e, 0x99, 0xe3, 0x5b, 0x50, 0x08, 0xa1, 0xcf, 0xa5, 0xf8, 0x57, 0xb0, 0x7a, 0x64,
-	0xca, 0x0d, 0xbd, 0xdd, 0xb2, 0xd0, 0x49, 0xcb, 0x68, 0xf5, 0x4d, 0x7e, 0x0b, 0x96, 0x5b, 0x03,
-	0xab, 0xa3, 0x19, 0x8a, 0x75, 0xee, 0x7c, 0xc7, 0x70, 0x1b, 0xc8, 0x11, 0xd0, 0xc6, 0xd1, 0x4f,
-	0x2d, 0x61, 0x47, 0x40, 0x1b, 0x32, 0x3a, 0x02, 0xda, 0x4f, 0x0f, 0x78, 0x27, 0xbe, 0x91, 0xb9,
-	0xd2, 0x06, 0x56, 0xd8, 0xeb, 0xdf, 0x0d, 0xed, 0xb7, 0x1c, 0x5e, 0x60, 0x27, 0xc6, 0x40, 0x45,
-	0xbe, 0xe3, 0x97, 0x79, 0x61, 0xf9, 0xd7, 0x20, 0xd9, 0x53, 0xfa, 0xf4, 0x6e, 0x31, 0x21, 0x92,
-	0x87, 0xe8, 0x47, 0x9d, 0xcf, 0x39, 0x9c, 0xb6, 0xcc, 0x98, 0xdc, 0x97, 0xc0, 0x3d, 0xb8, 0x69,
-	0x69, 0x56, 0xab, 0xd7, 0xd4, 0x6d, 0x58, 0xdb, 0xad, 0x84, 0x26, 0x0e, 0x35, 0x21, 0xae, 0xe1,
-	0x5e, 0x6c, 0xa3, 0xed, 0x94, 0x40, 0x93, 0x7f, 0x00, 0x1b, 0x64, 0x94, 0x81, 0xfa, 0x2d, 0x45,
-	0x55, 0x54, 0xd9, 0x33, 0x90, 0x6c, 0x2f, 0xd7, 0x31, 0x40, 0x74, 0xfa, 0xdd, 0xb1, 0xbb, 0x5f,
-	0x73, 0xc0, 0x07, 0x5f, 0x2a, 0xfc, 0x7d, 0x28, 0x8a, 0xb5, 0xfa, 0xc9, 0xf1, 0x93, 0x7a, 0xad,
-	0x29, 0xd6, 0xea, 0x8d, 0xc7, 0xa7, 0xcd, 0xd3, 0x9f, 0x9e, 0xd4, 0x9a, 0x8d, 0x27, 0xf5, 0x93,
-	0x5a, 0xf5, 0xf0, 0xd1, 0x61, 0xed, 0x87, 0x99, 0x25, 0x61, 0xf5, 0xf9, 0x8b, 0xe2, 0x8a, 0xa7,
-	0x89, 0xbf, 0x0d, 0x1b, 0xcc, 0x61, 0x4f, 0x8e, 0x8f, 0x4f, 0x32, 0x9c, 0x70, 0xe5, 0xf9, 0x8b,
-	0x62, 0xc2, 0xfe, 0xcd, 0xdf, 0x85, 0x2d, 0x26, 0xb0, 0xde, 0xa8, 0x56, 0x6b, 0xf5, 0x7a, 0x26,
-	0x26, 0xac, 0x3c, 0x7f, 0x51, 0x4c, 0xd3, 0xc7, 0x50, 0xf8, 0xa3, 0x83, 0xc3, 0xc7, 0x0d, 0xb1,
-	0x96, 0x89, 0x13, 0x38, 0x7d, 0x14, 0x12, 0xcf, 0xfe, 0x90, 0x5f, 0xda, 0xff, 0x57, 0x06, 0xe2,
-	0x47, 0xa6, 0xcc, 0x77, 0x61, 0xd5, 0xff, 0x3d, 0x90, 0xfd, 0x72, 0x0d, 0x7e, 0xa2, 0x13, 0x2a,
-	0x11, 0x81, 0xae, 0x82, 0x1d, 0x78, 0xc3, 0xf7, 0x21, 0xee, 0xcd, 0x08, 0x26, 0x4e, 0x8d, 0x73,
-	0xa1, 0x1c, 0x0d, 0x17, 0xe2, 0xc9, 0xde, 0xd2, 0x47, 0xf1, 0x74, 0x20, 0x75, 0x23, 0x79, 0xf2,
-	0xee, 0x61, 0x2d, 0xe0, 0x19, 0x9f, 0x4f, 0x76, 0x23, 0x58, 0xa1, 0x58, 0x61, 0x3f, 0x3a, 0xd6,
-	0xf5, 0xaa, 0x42, 0x26, 0xf0, 0xdd, 0x62, 0x67, 0x8a, 0x1d, 0x17, 0x29, 0xbc, 0x1d, 0x15, 0xe9,
-	0xfa, 0xfb, 0x18, 0xb2, 0xac, 0xef, 0x11, 0xdf, 0x89, 0x62, 0xc8, 0x99, 0xe7, 0x3b, 0x33, 0x80,
-	0x5d, 0xc7, 0x3f, 0x03, 0xf0, 0x5c, 0xe1, 0x97, 0xc2, 0x4c, 0x8c, 0x30, 0xc2, 0xee, 0x74, 0x8c,
-	0x6b, 0xbd, 0x0e, 0x69, 0x67, 0x6b, 0x51, 0x08, 0x1b, 0x46, 0x01, 0xc2, 0xed, 0x29, 0x00, 0x6f,
-	0xee, 0xf9, 0x6e, 0x70, 0xdf, 0x9c, 0x32, 0x94, 0xe2, 0xc2, 0x73, 0x2f, 0xe4, 0x56, 0xb2, 0x0b,
-	0xab, 0xfe, 0xab, 0xc4, 0xd0, 0x28, 0x7d, 0xc0, 0xf0, 0xc5, 0x1b, 0x76, 0x25, 0x37, 0x4a, 0x74,
-	0xef, 0x3d, 0xda, 0xb4, 0x44, 0xf7, 0x60, 0xa7, 0x26, 0x3a, 0xeb, 0x8a, 0xeb, 0x23, 0xb8, 0x1e,
-	0xbc, 0x6f, 0x7a, 0x2b, 0x9a, 0x21, 0xbb, 0x70, 0xec, 0x45, 0x86, 0x86, 0xbb, 0xb4, 0xcb, 0x47,
-	0x44, 0x97, 0x76, 0x05, 0xd9, 0x8b, 0x0c, 0x75, 0x5d, 0xfe, 0x12, 0x6e, 0xb0, 0x4f, 0xaf, 0x77,
-	0xa3, 0xd9, 0x72, 0x96, 0xd8, 0xfd, 0x99, 0xe0, 0xe1, 0xd2, 0xe2, 0x33, 0x51, 0x44, 0x69, 0x6d,
-	0x6c, 0x54, 0x69, 0xbd, 0x3b, 0xfd, 0xe0, 0xa4, 0x9d, 0xa5, 0x18, 0x71, 0xd2, 0xce, 0xc2, 0xbc,
-	0x3f, 0x13, 0xdc, 0x75, 0xff, 0x0b, 0x58, 0x63, 0xee, 0x80, 0xef, 0x44, 0xe4, 0x10, 0xa3, 0x85,
-	0x7b, 0xb3, 0xa0, 0x5d, 0xdf, 0x0a, 0x64, 0xc9, 0xde, 0x8c, 0xa2, 0xe8, 0x16, 0xf1, 0xdb, 0x61,
-	0xc6, 0xbc, 0x1b, 0x39, 0xe1, 0x4e, 0x14, 0x94, 0x97, 0x65, 0xf6, 0x56, 0x2f, 0x94, 0x65, 0x26,
-	0x3c, 0x9c, 0xe5, 0x89, 0x9b, 0x36, 0x21, 0xf9, 0xe9, 0xeb, 0x2f, 0x76, 0xb9, 0x87, 0xf5, 0x2f,
-	0x5f, 0xe6, 0xb9, 0xaf, 0x5e, 0xe6, 0xb9, 0x7f, 0xbe, 0xcc, 0x73, 0xbf, 0x79, 0x95, 0x5f, 0xfa,
-	0xea, 0x55, 0x7e, 0xe9, 0xeb, 0x57, 0xf9, 0xa5, 0x0f, 0xde, 0x95, 0x15, 0xab, 0x33, 0x38, 0x2b,
-	0x4b, 0x5a, 0xbf, 0x42, 0xff, 0x1f, 0xa5, 0x9c, 0x49, 0x77, 0x65, 0xad, 0x32, 0xfc, 0x5e, 0xa5,
-	0xaf, 0xb5, 0x07, 0x3d, 0x64, 0x92, 0xff, 0x35, 0xbd, 0x7d, 0xef, 0xae, 0xf3, 0xd7, 0x26, 0xeb,
-	0x5c, 0x47, 0xe6, 0x59, 0x0a, 0xff, 0xad, 0xe9, 0x9d, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x6f, 0x97, 0x8a, 0xa1, 0x25, 0x00, 0x00,
+	// 2417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x69, 0x3d, 0xc9, 0x96, 0xbc, 0x94, 0x25, 0x6a, 0x25, 0x51, 0x34, 0x5d,
+	0xc4, 0x8a, 0x6c, 0x93, 0x96, 0x6c, 0xa7, 0x8d, 0x1b, 0x20, 0x91, 0x59, 0xb9, 0x11, 0x60, 0xd9,
+	0xc2, 0x52, 0x0a, 0xda, 0xa4, 0x28, 0x41, 0x2d, 0xc7, 0xd4, 0x42, 0xe4, 0x2e, 0xb3, 0xbb, 0xa4,
+	0xa3, 0x02, 0x2d, 0x8c, 0x9e, 0x0c, 0x1f, 0x82, 0x14, 0xcd, 0x2d, 0x30, 0xd0, 0x1f, 0xb7, 0x9e,
+	0x7c, 0xea, 0xa1, 0x69, 0x0f, 0xbd, 0xe5, 0x54, 0xe4, 0x18, 0x14, 0x68, 0x50, 0xd8, 0x87, 0xfc,
+	0x0f, 0x05, 0x0a, 0x04, 0xbb, 0x33, 0x3b, 0xbb, 0xe4, 0xce, 0x72, 0x87, 0x22, 0xad, 0xe4, 0x46,
+	0xce, 0x7c, 0xf3, 0xde, 0x9b, 0xef, 0xbd, 0x79, 0x33, 0xf3, 0x86, 0x84, 0x25, 0xf5, 0x40, 0x29,
+	0x2a, 0xba, 0x81, 0x8a, 0xca, 0x61, 0x55, 0xd3, 0x50, 0xa3, 0xd8, 0x59, 0x2f, 0x5a, 0x1f, 0x15,
+	0x5a, 0x86, 0x6e, 0xe9, 0x62, 0x5a, 0x3d, 0x50, 0x0a, 0x76, 0x6f, 0x81, 0xf4, 0x16, 0x3a, 0xeb,
+	0xd2, 0x6c, 0x5d, 0xaf, 0xeb, 0x4e, 0x7f, 0xd1, 0xfe, 0x84, 0xa1, 0xd2, 0xbc, 0xa2, 0x9b, 0x4d,
+	0xdd, 0x2c, 0x36, 0xcd, 0xba, 0x2d, 0xa2, 0x69, 0xd6, 0x49, 0xc7, 0x8a, 0xa7, 0xa1, 0xa1, 0x22,
+	0xcd, 0xb2, 0x7b, 0xf1, 0x27, 0x02, 0xb8, 0xc8, 0x32, 0xc1, 0xd5, 0xd7, 0x07, 0xd2, 0x6e, 0xd5,
+	0x8d, 0x6a, 0x0d, 0x61, 0x48, 0xfe, 0x53, 0x01, 0xc4, 0x1d, 0xb3, 0x5e, 0xc2, 0xfd, 0x0f, 0x5a,
+	0x48, 0xdb, 0xd6, 0x54, 0x4b, 0x9c, 0x87, 0x54, 0x4b, 0x37, 0xac, 0x8a, 0x5a, 0xcb, 0x08, 0x39,
+	0x61, 0x75, 0x42, 0x4e, 0xda, 0x5f, 0xb7, 0x6b, 0xe2, 0x5b, 0x90, 0x22, 0xb2, 0x32, 0xb1, 0x9c,
+	0xb0, 0x3a, 0xb9, 0xb1, 0x54, 0x60, 0x4c, 0xb6, 0x40, 0xe4, 0xdd, 0x49, 0x7c, 0xf1, 0xf5, 0xca,
+	0x98, 0xec, 0x0e, 0x11, 0xe7, 0x20, 0x69, 0xaa, 0x75, 0x0d, 0x19, 0x99, 0x38, 0x96, 0x8a, 0xbf,
+	0xdd, 0x9e, 0x7e, 0xf2, 0x87, 0x95, 0xb1, 0xdf, 0x7e, 0xf3, 0x7c, 0x8d, 0x34, 0xe4, 0x3f, 0x00,
+	0x29, 0x68, 0x95, 0x8c, 0xcc, 0x96, 0xae, 0x99, 0x48, 0x5c, 0x06, 0x20, 0x12, 0x3d, 0x03, 0x27,
+	0x48, 0xcb, 0x76, 0x4d, 0xcc, 0x40, 0xaa, 0x83, 0x0c, 0x53, 0xd5, 0x35, 0xc7, 0xc6, 0x09, 0xd9,
+	0xfd, 0x7a, 0x3b, 0x61, 0xeb, 0xc9, 0x7f, 0x1d, 0x83, 0xf3, 0xdd, 0xd2, 0xf7, 0x8c, 0xe3, 0xf0,
+	0x29, 0x6f, 0x40, 0xba, 0x65, 0xa0, 0x8e, 0xaa, 0xb7, 0xcd, 0x8a, 0x4f, 0xad, 0x23, 0xfa, 0x4e,
+	0x2c, 0x23, 0xc8, 0xe7, 0xdd, 0xee, 0x12, 0x35, 0xc1, 0x47, 0x53, 0x7c, 0x70, 0x9a, 0xd6, 0x61,
+	0x56, 0xd1, 0xdb, 0x9a, 0x85, 0x8c, 0x56, 0xd5, 0xb0, 0x8e, 0x2b, 0xee, 0x6c, 0x12, 0x8e, 0x5d,
+	0x69, 0x7f, 0xdf, 0x7b, 0xb8, 0xcb, 0xa6, 0xa4, 0x65, 0xe8, 0xfa, 0xc3, 0x8a, 0xaa, 0xa9, 0x56,
+	0x66, 0x3c, 0x27, 0xac, 0x4e, 0xc9, 0x13, 0x4e, 0x8b, 0xe3, 0xcf, 0x12, 0x4c, 0xe1, 0xee, 0x43,
+	0xa4, 0xd6, 0x0f, 0xad, 0x4c, 0xd2, 0x31, 0x4a, 0xf2, 0x19, 0x85, 0x43, 0xab, 0xb3, 0x5e, 0x78,
+	0xd7, 0x41, 0x10, 0x93, 0x26, 0x9d, 0x51, 0xb8, 0xc9, 0xe7, 0xbd, 0x54, 0x7f, 0xef, 0xbd, 0x0f,
+	0x0b, 0x01, 0x7e, 0xa9, 0xf3, 0x7c, 0xde, 0x11, 0xba, 0xbc, 0xd3, 0xe3, 0xd6, 0x58, 0x8f, 0x5b,
+	0x89, 0xf3, 0xfe, 0x19, 0x70, 0xde, 0xa6, 0x72, 0x14, 0xee, 0xbc, 0xfe, 0x32, 0xc5, 0x37, 0x60,
+	0xbe, 0x8b, 0x69, 0x1f, 0x16, 0x47, 0xe8, 0x05, 0x7f, 0xb7, 0xe7, 0xdf, 0x13, 0x78, 0x68, 0x11,
+	0xb0, 0x3f, 0x2a, 0x96, 0x71, 0x4c, 0x1c, 0x74, 0xc6, 0x69, 0xb0, 0x83, 0xef, 0x74, 0xfd, 0xb3,
+	0xd8, 0xeb, 0x9f, 0x4d, 0xe5, 0xc8, 0xf5, 0x4f, 0xfe, 0xdf, 0x02, 0x5c, 0xe8, 0xee, 0x2d, 0xe9,
+	0xda, 0x43, 0xd5, 0x68, 0x9e, 0x98, 0x64, 0x3a, 0xf3, 0xaa, 0x72, 0x94, 0x89, 0xfb, 0x66, 0x6e,
+	0x7b, 0xae, 0x77, 0xe6, 0x89, 0xe1, 0x66, 0x3e, 0xde, 0x7f, 0xe6, 0x2b, 0xb0, 0xcc, 0x9c, 0x1b,
+	0x9d, 0x7d, 0x07, 0xd2, 0x1e, 0xa0, 0xd4, 0xd0, 0x4d, 0xd4, 0x3f, 0x1f, 0x46, 0x4c, 0x9d, 0x3b,
+	0xe1, 0x2d, 0xc3, 0x22, 0x43, 0x2f, 0x35, 0xeb, 0x8f, 0x31, 0x98, 0xeb, 0xe9, 0x1f, 0xd6, 0x2b,
+	0xdd, 0x19, 0x23, 0x1e, 0x95, 0x31, 0x46, 0xe9, 0x17, 0xf1, 0x0e, 0x2c, 0x77, 0x2d, 0x1f, 0xb2,
+	0x27, 0x55, 0x4c, 0xf4, 0x61, 0x1b, 0x69, 0x0a, 0x72, 0xe2, 0x3f, 0x21, 0x2f, 0xfa, 0x41, 0xfb,
+	0x18, 0x53, 0x26, 0x90, 0x20, 0x85, 0x39, 0xc8, 0xb2, 0x29, 0xa2, 0x2c, 0xbe, 0x14, 0xe0, 0xec,
+	0x8e, 0x59, 0x97, 0x91, 0xd2, 0xd9, 0xad, 0x2a, 0x47, 0xc8, 0x12, 0xdf, 0x84, 0x64, 0xcb, 0xf9,
+	0xe4, 0x70, 0x37, 0xb9, 0xb1, 0xc8, 0x4c, 0xd3, 0x18, 0x4c, 0x26, 0x48, 0x06, 0x88, 0xaf, 0xc3,
+	0x0c, 0x26, 0x48, 0xd1, 0x9b, 0x4d, 0xd5, 0x6a, 0x22, 0xcd, 0x72, 0x48, 0x9e, 0x92, 0xa7, 0x9d,
+	0xf6, 0x12, 0x6d, 0x0e, 0x70, 0x19, 0x1f, 0x8e, 0xcb, 0x44, 0xff, 0x50, 0xfa, 0x25, 0x5c, 0xe8,
+	0x9a, 0x24, 0xcd, 0xbc, 0x6f, 0x43, 0xd2, 0x40, 0x66, 0xbb, 0x81, 0x27, 0x7b, 0x6e, 0xe3, 0x32,
+	0x73, 0xb2, 0x2e, 0x5c, 0x76, 0xa0, 0x7b, 0xc7, 0x2d, 0x24, 0x93, 0x61, 0x24, 0x03, 0x7f, 0x1c,
+	0x03, 0xd8, 0x31, 0xeb, 0x7b, 0x6a, 0x13, 0xe9, 0xed, 0xd1, 0x50, 0xd8, 0xd6, 0x0c, 0xa4, 0x20,
+	0xb5, 0x83, 0x6a, 0x5d, 0x14, 0xee, 0xd3, 0xe6, 0xd1, 0x50, 0x78, 0x15, 0x44, 0x0d, 0x7d, 0x64,
+	0xd1, 0x30, 0xab, 0x18, 0x48, 0xe9, 0x38, 0x74, 0x26, 0xe4, 0x19, 0xbb, 0xc7, 0x0d, 0x2e, 0x9b,
+	0x3c, 0xfe, 0xa4, 0xf2, 0x01, 0x88, 0x1e, 0x1f, 0xa3, 0x66, 0xfb, 0x7f, 0x78, 0xbf, 0x23, 0xd2,
+	0x1f, 0x68, 0x4e, 0x60, 0x9f, 0x12, 0xe9, 0x2b, 0x30, 0x49, 0x42, 0xdc, 0x56, 0x4a, 0x72, 0x04,
+	0xce, 0x1a, 0xd8, 0x8c, 0x91, 0x24, 0x09, 0xb6, 0x57, 0xc6, 0x23, 0xbd, 0x92, 0x1c, 0x2c, 0xa5,
+	0xa4, 0x4e, 0x90, 0x52, 0x0e, 0x60, 0x21, 0xc0, 0xfd, 0xa8, 0x1d, 0xfc, 0x24, 0xe6, 0x84, 0xcf,
+	0xa6, 0x72, 0xa4, 0xe9, 0x8f, 0x1a, 0xa8, 0x56, 0x47, 0x4e, 0xce, 0x18, 0xc2, 0xc3, 0xab, 0x30,
+	0x5d, 0xed, 0x96, 0xe6, 0x3a, 0xb8, 0xa7, 0xd9, 0x73, 0xb0, 0x3d, 0xb0, 0xd6, 0xe5, 0xe0, 0x4d,
+	0xbb, 0xe5, 0x94, 0x77, 0x67, 0x05, 0xa4, 0x20, 0x13, 0xa3, 0xe6, 0xfb, 0xf7, 0x31, 0x38, 0xd7,
+	0x95, 0x1f, 0x4d, 0xf1, 0xc7, 0x90, 0xc2, 0xd4, 0x99, 0x19, 0x21, 0x17, 0xe7, 0x23, 0xdb, 0x1d,
+	0xf1, 0x7d, 0xd9, 0x07, 0xc4, 0x2b, 0x70, 0xde, 0x81, 0x99, 0x7e, 0x43, 0xc6, 0x73, 0xf1, 0xd5,
+	0x29, 0x19, 0x1b, 0x68, 0x7a, 0x96, 0x04, 0xa9, 0xaf, 0xc2, 0x5c, 0x37, 0x29, 0x94, 0xf6, 0x4d,
+	0x48, 0x61, 0xfe, 0x30, 0x39, 0x03, 0xf0, 0xee, 0x8e, 0x23, 0xc4, 0x3f, 0x8f, 0x41, 0x3a, 0xe8,
+	0xde, 0x21, 0xd9, 0x5f, 0x83, 0x99, 0x9e, 0xa0, 0x36, 0x33, 0x31, 0x3c, 0xe9, 0xde, 0xf6, 0xef,
+	0x36, 0xda, 0xc5, 0x8b, 0x44, 0xb8, 0x49, 0xd4, 0x27, 0x1d, 0x2b, 0xf1, 0x50, 0xd3, 0xd1, 0x1f,
+	0xf4, 0xca, 0x43, 0x58, 0x64, 0x30, 0x36, 0x7a, 0xd7, 0x7c, 0x12, 0x83, 0x49, 0x2f, 0xd1, 0x8d,
+	0x6a, 0x41, 0x9c, 0xd2, 0xae, 0x1e, 0xbd, 0x20, 0x7c, 0x86, 0x74, 0x2d, 0x08, 0xcf, 0x12, 0xd6,
+	0x29, 0x2a, 0xed, 0x63, 0x64, 0xf4, 0x94, 0xff, 0xad, 0xeb, 0x9a, 0x45, 0x76, 0xa2, 0xa1, 0xee,
+	0x1a, 0xef, 0x40, 0xf2, 0xa1, 0x8a, 0x1a, 0x35, 0x93, 0xd0, 0x98, 0x67, 0x5a, 0x46, 0x34, 0xdd,
+	0x75, 0x90, 0xee, 0xc6, 0x81, 0xc7, 0xf1, 0x1f, 0x31, 0x3f, 0x16, 0xfc, 0xf7, 0x28, 0x9f, 0xf1,
+	0x94, 0xa7, 0xb7, 0x20, 0x45, 0x76, 0xe0, 0x8c, 0xd0, 0xa7, 0x00, 0x42, 0x86, 0xba, 0x21, 0x44,
+	0x86, 0xd8, 0x21, 0x14, 0xd8, 0xbf, 0x63, 0xce, 0xfe, 0x3d, 0xdd, 0xee, 0xd9, 0xb3, 0x31, 0x9b,
+	0xff, 0x8f, 0xc3, 0x6c, 0xc0, 0xa0, 0xbe, 0x55, 0x9d, 0x08, 0x32, 0x7f, 0x0a, 0xb9, 0x96, 0xa1,
+	0xb7, 0x74, 0x13, 0xd5, 0xe8, 0x51, 0x42, 0xd1, 0x35, 0x0d, 0x29, 0x96, 0xaa, 0x6b, 0x95, 0x43,
+	0xbd, 0x65, 0xd3, 0x1c, 0x5f, 0x9d, 0x90, 0x97, 0x5d, 0x1c, 0xd1, 0x5a, 0xa2, 0xa8, 0x77, 0xf5,
+	0x96, 0x29, 0x1e, 0xc2, 0x22, 0xf3, 0x5c, 0x42, 0x5c, 0x95, 0x18, 0xd0, 0x55, 0x0b, 0x8c, 0xf3,
+	0x0b, 0x06, 0x44, 0x9f, 0x80, 0xc6, 0x23, 0x4f, 0x40, 0xe2, 0x25, 0x38, 0x4b, 0x36, 0x33, 0x52,
+	0xbd, 0x4a, 0x3a, 0x0b, 0x17, 0xaf, 0x52, 0xc2, 0xae, 0x07, 0x72, 0x3d, 0x9c, 0xf2, 0x81, 0x88,
+	0xc4, 0xc0, 0xd2, 0x3e, 0x33, 0xdc, 0xd2, 0x9e, 0xe8, 0x1f, 0x90, 0xff, 0x12, 0x60, 0x89, 0xe5,
+	0xff, 0x53, 0x8f, 0x47, 0xdf, 0x29, 0x25, 0x3e, 0xcc, 0x29, 0xe5, 0x3f, 0x31, 0x46, 0x40, 0x0f,
+	0x53, 0xe9, 0xda, 0xef, 0xa9, 0x58, 0xb9, 0x6c, 0xc4, 0xb9, 0xd9, 0x48, 0x33, 0x02, 0x27, 0x18,
+	0x30, 0x09, 0x9e, 0x80, 0x19, 0xe7, 0x08, 0x98, 0x57, 0x5b, 0x02, 0x43, 0x8c, 0x78, 0xf1, 0x55,
+	0xc1, 0x46, 0x75, 0xd8, 0xfc, 0x3c, 0x0e, 0x99, 0x80, 0x9e, 0x61, 0x2b, 0x37, 0x3f, 0x03, 0x89,
+	0x59, 0xb4, 0x34, 0xad, 0xaa, 0x85, 0x48, 0xd8, 0x49, 0x4c, 0x7b, 0xcb, 0x36, 0x42, 0xce, 0x30,
+	0x6a, 0x9a, 0x4e, 0x4f, 0x68, 0x90, 0x24, 0x46, 0x1c, 0x24, 0xe3, 0x3c, 0x41, 0x92, 0xe4, 0x08,
+	0x92, 0xd4, 0x70, 0x41, 0x72, 0xa6, 0x7f, 0x90, 0xa8, 0x90, 0x0b, 0x73, 0xde, 0xa8, 0x03, 0xe5,
+	0x71, 0x9c, 0x71, 0x1c, 0xb0, 0x0b, 0x94, 0xdf, 0xc3, 0x28, 0x89, 0xdc, 0x68, 0x12, 0x27, 0xd8,
+	0x68, 0x58, 0x21, 0x71, 0xba, 0x29, 0x61, 0x05, 0x96, 0x99, 0x1e, 0xa0, 0xe5, 0xc3, 0xbf, 0xc7,
+	0x18, 0x8b, 0xd9, 0x2d, 0x83, 0x8d, 0x2a, 0x2f, 0x0f, 0xfe, 0x6c, 0x94, 0x66, 0x38, 0x8a, 0x2f,
+	0x2f, 0xf7, 0xf2, 0x3b, 0x3e, 0x1c, 0xbf, 0xc9, 0xfe, 0xfc, 0xe6, 0x21, 0x17, 0xc6, 0x1e, 0xa5,
+	0xf8, 0x1f, 0x31, 0x98, 0x0f, 0x2e, 0xb9, 0xaa, 0xa6, 0xa0, 0xc6, 0x89, 0x19, 0xbe, 0x07, 0x67,
+	0x91, 0x61, 0xe8, 0x46, 0xc5, 0x39, 0xec, 0xb7, 0xdc, 0x5b, 0xc6, 0x45, 0x26, 0xb5, 0x5b, 0x36,
+	0x52, 0xc6, 0x40, 0x32, 0xdb, 0x29, 0xe4, 0x6b, 0x13, 0x0b, 0x90, 0xc6, 0x9c, 0x75, 0xcb, 0xc4,
+	0xf4, 0xe2, 0x0b, 0x87, 0x5f, 0xc6, 0x29, 0x73, 0x7c, 0x11, 0x56, 0x42, 0xe8, 0xa3, 0x14, 0xff,
+	0x45, 0x80, 0x7c, 0x00, 0xb3, 0xa3, 0xd6, 0x8d, 0xaa, 0xe5, 0x3b, 0xbb, 0x9e, 0x98, 0xed, 0x4b,
+	0x70, 0xd6, 0x77, 0x4e, 0xa6, 0xef, 0x68, 0x53, 0x5e, 0x63, 0xd7, 0xb3, 0x48, 0xc4, 0x45, 0xe3,
+	0x33, 0x01, 0xd6, 0xa2, 0x8d, 0xfd, 0xae, 0x6e, 0x1d, 0xbf, 0x81, 0xe9, 0x1d, 0xb3, 0xbe, 0xdf,
+	0xaa, 0x55, 0x2d, 0xb4, 0x5b, 0x35, 0xaa, 0x4d, 0x53, 0x5c, 0x82, 0x89, 0x6a, 0xdb, 0x3a, 0xd4,
+	0x0d, 0xd5, 0x3a, 0x76, 0x5f, 0xa6, 0x69, 0x03, 0x2e, 0xea, 0xd9, 0x38, 0xf2, 0x78, 0x1e, 0x76,
+	0xad, 0xb6, 0x21, 0x5e, 0x51, 0xcf, 0xfe, 0x76, 0x5b, 0x74, 0xa9, 0xf1, 0xc4, 0xe5, 0x17, 0x60,
+	0xbe, 0x47, 0x3f, 0xf5, 0xf2, 0xef, 0x04, 0x27, 0x57, 0xed, 0x1a, 0x6d, 0x0d, 0x05, 0x2a, 0x2e,
+	0x27, 0xf5, 0xed, 0x2c, 0x8c, 0x37, 0xd4, 0x26, 0x79, 0x2d, 0x4a, 0xc8, 0xf8, 0x0b, 0xbf, 0x33,
+	0x3f, 0x15, 0x20, 0x17, 0x66, 0x13, 0x75, 0xe1, 0x4d, 0x98, 0xb3, 0x74, 0xab, 0xda, 0xa8, 0xb4,
+	0x6c, 0x58, 0x8d, 0x7a, 0xc2, 0x74, 0x4c, 0x4d, 0xc8, 0xb3, 0x4e, 0xaf, 0x23, 0xa3, 0xe6, 0xba,
+	0xc3, 0x14, 0x6f, 0xc3, 0x02, 0x1e, 0x65, 0xa0, 0x66, 0x55, 0xd5, 0x54, 0xad, 0xee, 0x1b, 0x88,
+	0x7d, 0x38, 0xef, 0x00, 0x64, 0xb7, 0x9f, 0x8e, 0xcd, 0x7f, 0x1e, 0x73, 0xaa, 0xbc, 0x65, 0xe5,
+	0x10, 0xd5, 0xda, 0x0d, 0x44, 0x16, 0xae, 0xad, 0x41, 0xd5, 0xea, 0x27, 0xe6, 0x6a, 0x09, 0x26,
+	0x3c, 0x0b, 0xec, 0x9b, 0x62, 0x42, 0xf6, 0x1a, 0x68, 0x6d, 0xa2, 0xd2, 0xd6, 0x70, 0xb5, 0xce,
+	0x42, 0x35, 0x92, 0x43, 0x66, 0x48, 0x91, 0x84, 0xb6, 0xbf, 0xd2, 0x14, 0x22, 0x5e, 0x03, 0x91,
+	0x56, 0x49, 0x3c, 0x53, 0x52, 0x4e, 0x99, 0xe4, 0xbc, 0x5b, 0x26, 0xa1, 0x1d, 0x41, 0xa7, 0x56,
+	0xe0, 0x62, 0x28, 0x79, 0xd4, 0xa9, 0xd4, 0x3d, 0x26, 0xc1, 0x05, 0xfd, 0x8a, 0xdd, 0xe3, 0xca,
+	0xf1, 0x5c, 0x9b, 0x7f, 0xe4, 0xbf, 0x08, 0xdd, 0xd5, 0x0d, 0x05, 0xe1, 0xb7, 0x87, 0x57, 0xfe,
+	0x24, 0x9b, 0x85, 0x25, 0x96, 0x62, 0xba, 0xc4, 0xfe, 0x2a, 0xc0, 0x5c, 0x0f, 0x60, 0xd8, 0xc3,
+	0x80, 0xaf, 0xee, 0x16, 0x1f, 0xb8, 0xee, 0xc6, 0xbd, 0x0e, 0x0f, 0x20, 0xcb, 0xb6, 0x9b, 0xfa,
+	0xeb, 0x1d, 0x58, 0xf2, 0x16, 0x12, 0x96, 0xef, 0xab, 0x2a, 0xbb, 0x2e, 0x93, 0x28, 0x06, 0x1b,
+	0xe4, 0xd5, 0x97, 0xcd, 0xb5, 0xaf, 0x04, 0x10, 0x83, 0x87, 0x5e, 0xf1, 0x16, 0xe4, 0xe4, 0xad,
+	0xf2, 0xee, 0x83, 0xfb, 0xe5, 0xad, 0x8a, 0xbc, 0x55, 0xde, 0xbf, 0xb7, 0x57, 0xd9, 0xfb, 0xf9,
+	0xee, 0x56, 0x65, 0xff, 0x7e, 0x79, 0x77, 0xab, 0xb4, 0x7d, 0x77, 0x7b, 0xeb, 0x27, 0x33, 0x63,
+	0xd2, 0xf4, 0xd3, 0x67, 0xb9, 0x49, 0x5f, 0x93, 0x78, 0x19, 0x16, 0x98, 0xc3, 0xee, 0x3f, 0x78,
+	0xb0, 0x3b, 0x23, 0x48, 0x67, 0x9e, 0x3e, 0xcb, 0x25, 0xec, 0xcf, 0xe2, 0x35, 0x58, 0x62, 0x02,
+	0xcb, 0xfb, 0xa5, 0xd2, 0x56, 0xb9, 0x3c, 0x13, 0x93, 0x26, 0x9f, 0x3e, 0xcb, 0xa5, 0xc8, 0xd7,
+	0x50, 0xf8, 0xdd, 0xcd, 0xed, 0x7b, 0xfb, 0xf2, 0xd6, 0x4c, 0x1c, 0xc3, 0xc9, 0x57, 0x29, 0xf1,
+	0xe4, 0xcf, 0xd9, 0xb1, 0x8d, 0x3f, 0xcd, 0x43, 0x7c, 0xc7, 0xac, 0x8b, 0x47, 0x30, 0xdd, 0xfb,
+	0xb3, 0x29, 0xf6, 0xe1, 0x3f, 0xf8, 0x4b, 0x26, 0xa9, 0xc8, 0x09, 0xa4, 0x1e, 0x39, 0x84, 0x73,
+	0x3d, 0xbf, 0x57, 0x7a, 0x8d, 0x43, 0xc4, 0x9e, 0x71, 0x2c, 0x15, 0xf8, 0x70, 0x21, 0x9a, 0xec,
+	0x92, 0x03, 0x8f, 0xa6, 0x4d, 0xe5, 0x88, 0x4b, 0x93, 0xff, 0x8e, 0x6d, 0x81, 0xc8, 0xf8, 0x95,
+	0xc9, 0x1a, 0x87, 0x14, 0x82, 0x95, 0x36, 0xf8, 0xb1, 0x54, 0xab, 0x06, 0x33, 0x81, 0x9f, 0x77,
+	0xac, 0x46, 0xc8, 0xa1, 0x48, 0xe9, 0x3a, 0x2f, 0x92, 0xea, 0x7b, 0x04, 0x69, 0xd6, 0xcf, 0x36,
+	0xae, 0xf0, 0x08, 0x72, 0xe7, 0x79, 0x63, 0x00, 0x30, 0x55, 0xfc, 0x0b, 0x00, 0xdf, 0x2f, 0x1d,
+	0xf2, 0x61, 0x22, 0x3c, 0x8c, 0xb4, 0x16, 0x8d, 0xa1, 0xd2, 0xcb, 0x90, 0x72, 0xb3, 0xdd, 0x4a,
+	0xd8, 0x30, 0x02, 0x90, 0x2e, 0x47, 0x00, 0xfc, 0xb1, 0xd7, 0xf3, 0xd0, 0xfd, 0x5a, 0xc4, 0x50,
+	0x82, 0x93, 0x0a, 0x7c, 0x38, 0xaa, 0xe9, 0x08, 0xa6, 0x7b, 0x5f, 0x5c, 0x43, 0xad, 0xec, 0x01,
+	0x4a, 0x45, 0x4e, 0x20, 0x55, 0x56, 0x81, 0x49, 0xff, 0x73, 0xe3, 0xa5, 0x68, 0x9a, 0x4d, 0xe9,
+	0x0a, 0x07, 0xc8, 0x1f, 0xd3, 0x81, 0x43, 0xde, 0x2a, 0xa7, 0x95, 0xa6, 0x74, 0x9d, 0x17, 0x49,
+	0xf5, 0xbd, 0x07, 0x67, 0xe8, 0x5b, 0x51, 0x2e, 0x82, 0x79, 0x53, 0x5a, 0x8d, 0x42, 0x30, 0x32,
+	0x82, 0xff, 0x41, 0x24, 0x2a, 0x23, 0xf8, 0xb0, 0xd2, 0x06, 0x3f, 0x96, 0x6a, 0xfd, 0x10, 0xce,
+	0x07, 0x1f, 0x0e, 0x5e, 0xe7, 0x13, 0x64, 0x67, 0xd8, 0x75, 0x6e, 0x68, 0xb8, 0x4a, 0x3b, 0xcf,
+	0x72, 0xaa, 0xb4, 0x53, 0xed, 0x3a, 0x37, 0x94, 0xaa, 0xfc, 0x35, 0x5c, 0x60, 0x97, 0x21, 0xaf,
+	0xf1, 0xc9, 0x72, 0x73, 0xd1, 0xad, 0x81, 0xe0, 0xe1, 0xae, 0x75, 0x8a, 0x5b, 0x9c, 0xae, 0xb5,
+	0xb1, 0xd2, 0x06, 0x3f, 0x36, 0x7c, 0xd2, 0x6e, 0xce, 0xe2, 0x9c, 0xb4, 0x9b, 0xc1, 0x6e, 0x0d,
+	0x04, 0xa7, 0xea, 0x7f, 0x05, 0xb3, 0xcc, 0x52, 0xc6, 0x55, 0x4e, 0x0e, 0x1d, 0xb4, 0x74, 0x73,
+	0x10, 0x34, 0xd5, 0xfd, 0x99, 0x00, 0x2b, 0x51, 0x97, 0xfc, 0x1f, 0xf2, 0x49, 0x0e, 0x0c, 0x94,
+	0xde, 0x3e, 0xe1, 0x40, 0x6a, 0x9d, 0x0a, 0x69, 0x7c, 0x6f, 0x25, 0x03, 0xc8, 0xf5, 0xf9, 0x07,
+	0x61, 0x72, 0xfd, 0x97, 0x5c, 0xe9, 0x2a, 0x0f, 0xca, 0x1f, 0x03, 0xec, 0x6b, 0x70, 0x68, 0x0c,
+	0x30, 0xe1, 0xd2, 0xad, 0x81, 0xe0, 0x54, 0xfd, 0x63, 0x01, 0xe6, 0x42, 0xee, 0x96, 0xa1, 0x9b,
+	0x16, 0x1b, 0x2f, 0xbd, 0x31, 0x18, 0x9e, 0x91, 0x6d, 0x7c, 0xf7, 0xa7, 0xa8, 0x6c, 0xe3, 0x41,
+	0xa5, 0x75, 0x6e, 0x28, 0xe3, 0xd4, 0xd3, 0x75, 0x31, 0xba, 0xc2, 0x23, 0xc9, 0x5d, 0x74, 0x37,
+	0x06, 0x00, 0xbb, 0x8a, 0xa5, 0xf1, 0xc7, 0xdf, 0x3c, 0x5f, 0x13, 0xee, 0x94, 0xbf, 0x78, 0x91,
+	0x15, 0xbe, 0x7c, 0x91, 0x15, 0xfe, 0xfb, 0x22, 0x2b, 0x7c, 0xf2, 0x32, 0x3b, 0xf6, 0xe5, 0xcb,
+	0xec, 0xd8, 0x57, 0x2f, 0xb3, 0x63, 0xef, 0xbf, 0x59, 0x57, 0xad, 0xc3, 0xf6, 0x41, 0x41, 0xd1,
+	0x9b, 0x45, 0xf2, 0xe7, 0x0b, 0xf5, 0x40, 0xb9, 0x56, 0xd7, 0x8b, 0x9d, 0x1f, 0x15, 0x9b, 0xba,
+	0x4d, 0xa3, 0x89, 0xff, 0x34, 0x71, 0xfd, 0xe6, 0x35, 0xf7, 0x7f, 0x13, 0xd6, 0x71, 0x0b, 0x99,
+	0x07, 0x49, 0xe7, 0x3f, 0x13, 0x37, 0xbe, 0x1d, 0x00, 0x17, 0x79, 0xf0, 0xcf, 0xfe, 0x31, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1835,6 +2500,12 @@ type MsgClient interface {
 	TimeoutOnClose(ctx context.Context, in *MsgTimeoutOnClose, opts ...grpc.CallOption) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error)
+	// Timeouts defines a rpc handler method for MsgTimeouts.
+	Timeouts(ctx context.Context, in *MsgTimeouts, opts ...grpc.CallOption) (*MsgTimeoutsResponse, error)
 	// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
 	ChannelUpgradeInit(ctx context.Context, in *MsgChannelUpgradeInit, opts ...grpc.CallOption) (*MsgChannelUpgradeInitResponse, error)
 	// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
@@ -1849,10 +2520,18 @@ type MsgClient interface {
 	ChannelUpgradeTimeout(ctx context.Context, in *MsgChannelUpgradeTimeout, opts ...grpc.CallOption) (*MsgChannelUpgradeTimeoutResponse, error)
 	// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
 	ChannelUpgradeCancel(ctx context.Context, in *MsgChannelUpgradeCancel, opts ...grpc.CallOption) (*MsgChannelUpgradeCancelResponse, error)
+	// ChannelUpgradeMigrateConnection defines a rpc handler method for MsgChannelUpgradeMigrateConnection.
+	ChannelUpgradeMigrateConnection(ctx context.Context, in *MsgChannelUpgradeMigrateConnection, opts ...grpc.CallOption) (*MsgChannelUpgradeMigrateConnectionResponse, error)
 	// UpdateChannelParams defines a rpc handler method for MsgUpdateParams.
 	UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// ScheduleReceiptPruning defines a rpc handler method for MsgScheduleReceiptPruning.
+	ScheduleReceiptPruning(ctx context.Context, in *MsgScheduleReceiptPruning, opts ...grpc.CallOption) (*MsgScheduleReceiptPruningResponse, error)
+	// ChannelForceClose defines a rpc handler method for MsgChannelForceClose.
+	ChannelForceClose(ctx context.Context, in *MsgChannelForceClose, opts ...grpc.CallOption) (*MsgChannelForceCloseResponse, error)
+	// ChannelForceTimeout defines a rpc handler method for MsgChannelForceTimeout.
+	ChannelForceTimeout(ctx context.Context, in *MsgChannelForceTimeout, opts ...grpc.CallOption) (*MsgChannelForceTimeoutResponse, error)
 }
 
 type msgClient struct {
@@ -1953,6 +2632,33 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
+func (c *msgClient) RecvPackets(ctx context.Context, in *MsgRecvPackets, opts ...grpc.CallOption) (*MsgRecvPacketsResponse, error) {
+	out := new(MsgRecvPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/RecvPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Acknowledgements(ctx context.Context, in *MsgAcknowledgements, opts ...grpc.CallOption) (*MsgAcknowledgementsResponse, error) {
+	out := new(MsgAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/Acknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Timeouts(ctx context.Context, in *MsgTimeouts, opts ...grpc.CallOption) (*MsgTimeoutsResponse, error) {
+	out := new(MsgTimeoutsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/Timeouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ChannelUpgradeInit(ctx context.Context, in *MsgChannelUpgradeInit, opts ...grpc.CallOption) (*MsgChannelUpgradeInitResponse, error) {
 	out := new(MsgChannelUpgradeInitResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ChannelUpgradeInit", in, out, opts...)
@@ -2016,6 +2722,15 @@ func (c *msgClient) ChannelUpgradeCancel(ctx context.Context, in *MsgChannelUpgr
 	return out, nil
 }
 
+func (c *msgClient) ChannelUpgradeMigrateConnection(ctx context.Context, in *MsgChannelUpgradeMigrateConnection, opts ...grpc.CallOption) (*MsgChannelUpgradeMigrateConnectionResponse, error) {
+	out := new(MsgChannelUpgradeMigrateConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ChannelUpgradeMigrateConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/UpdateChannelParams", in, out, opts...)
@@ -2034,6 +2749,33 @@ func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAckno
 	return out, nil
 }
 
+func (c *msgClient) ScheduleReceiptPruning(ctx context.Context, in *MsgScheduleReceiptPruning, opts ...grpc.CallOption) (*MsgScheduleReceiptPruningResponse, error) {
+	out := new(MsgScheduleReceiptPruningResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ScheduleReceiptPruning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChannelForceClose(ctx context.Context, in *MsgChannelForceClose, opts ...grpc.CallOption) (*MsgChannelForceCloseResponse, error) {
+	out := new(MsgChannelForceCloseResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ChannelForceClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChannelForceTimeout(ctx context.Context, in *MsgChannelForceTimeout, opts ...grpc.CallOption) (*MsgChannelForceTimeoutResponse, error) {
+	out := new(MsgChannelForceTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ChannelForceTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -2057,6 +2799,12 @@ type MsgServer interface {
 	TimeoutOnClose(context.Context, *MsgTimeoutOnClose) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// RecvPackets defines a rpc handler method for MsgRecvPackets.
+	RecvPackets(context.Context, *MsgRecvPackets) (*MsgRecvPacketsResponse, error)
+	// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+	Acknowledgements(context.Context, *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error)
+	// Timeouts defines a rpc handler method for MsgTimeouts.
+	Timeouts(context.Context, *MsgTimeouts) (*MsgTimeoutsResponse, error)
 	// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
 	ChannelUpgradeInit(context.Context, *MsgChannelUpgradeInit) (*MsgChannelUpgradeInitResponse, error)
 	// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
@@ -2071,10 +2819,18 @@ type MsgServer interface {
 	ChannelUpgradeTimeout(context.Context, *MsgChannelUpgradeTimeout) (*MsgChannelUpgradeTimeoutResponse, error)
 	// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
 	ChannelUpgradeCancel(context.Context, *MsgChannelUpgradeCancel) (*MsgChannelUpgradeCancelResponse, error)
+	// ChannelUpgradeMigrateConnection defines a rpc handler method for MsgChannelUpgradeMigrateConnection.
+	ChannelUpgradeMigrateConnection(context.Context, *MsgChannelUpgradeMigrateConnection) (*MsgChannelUpgradeMigrateConnectionResponse, error)
 	// UpdateChannelParams defines a rpc handler method for MsgUpdateParams.
 	UpdateChannelParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// ScheduleReceiptPruning defines a rpc handler method for MsgScheduleReceiptPruning.
+	ScheduleReceiptPruning(context.Context, *MsgScheduleReceiptPruning) (*MsgScheduleReceiptPruningResponse, error)
+	// ChannelForceClose defines a rpc handler method for MsgChannelForceClose.
+	ChannelForceClose(context.Context, *MsgChannelForceClose) (*MsgChannelForceCloseResponse, error)
+	// ChannelForceTimeout defines a rpc handler method for MsgChannelForceTimeout.
+	ChannelForceTimeout(context.Context, *MsgChannelForceTimeout) (*MsgChannelForceTimeoutResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2111,6 +2867,15 @@ func (*UnimplementedMsgServer) TimeoutOnClose(ctx context.Context, req *MsgTimeo
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) RecvPackets(ctx context.Context, req *MsgRecvPackets) (*MsgRecvPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecvPackets not implemented")
+}
+func (*UnimplementedMsgServer) Acknowledgements(ctx context.Context, req *MsgAcknowledgements) (*MsgAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgements not implemented")
+}
+func (*UnimplementedMsgServer) Timeouts(ctx context.Context, req *MsgTimeouts) (*MsgTimeoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timeouts not implemented")
+}
 func (*UnimplementedMsgServer) ChannelUpgradeInit(ctx context.Context, req *MsgChannelUpgradeInit) (*MsgChannelUpgradeInitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChannelUpgradeInit not implemented")
 }
@@ -2132,12 +2897,24 @@ func (*UnimplementedMsgServer) ChannelUpgradeTimeout(ctx context.Context, req *M
 func (*UnimplementedMsgServer) ChannelUpgradeCancel(ctx context.Context, req *MsgChannelUpgradeCancel) (*MsgChannelUpgradeCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChannelUpgradeCancel not implemented")
 }
+func (*UnimplementedMsgServer) ChannelUpgradeMigrateConnection(ctx context.Context, req *MsgChannelUpgradeMigrateConnection) (*MsgChannelUpgradeMigrateConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelUpgradeMigrateConnection not implemented")
+}
 func (*UnimplementedMsgServer) UpdateChannelParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelParams not implemented")
 }
 func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleReceiptPruning(ctx context.Context, req *MsgScheduleReceiptPruning) (*MsgScheduleReceiptPruningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleReceiptPruning not implemented")
+}
+func (*UnimplementedMsgServer) ChannelForceClose(ctx context.Context, req *MsgChannelForceClose) (*MsgChannelForceCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelForceClose not implemented")
+}
+func (*UnimplementedMsgServer) ChannelForceTimeout(ctx context.Context, req *MsgChannelForceTimeout) (*MsgChannelForceTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelForceTimeout not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2323,89 +3100,143 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ChannelUpgradeInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChannelUpgradeInit)
+func _Msg_RecvPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecvPackets)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ChannelUpgradeInit(ctx, in)
+		return srv.(MsgServer).RecvPackets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeInit",
+		FullMethod: "/ibc.core.channel.v1.Msg/RecvPackets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChannelUpgradeInit(ctx, req.(*MsgChannelUpgradeInit))
+		return srv.(MsgServer).RecvPackets(ctx, req.(*MsgRecvPackets))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ChannelUpgradeTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChannelUpgradeTry)
+func _Msg_Acknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgements)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ChannelUpgradeTry(ctx, in)
+		return srv.(MsgServer).Acknowledgements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeTry",
+		FullMethod: "/ibc.core.channel.v1.Msg/Acknowledgements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChannelUpgradeTry(ctx, req.(*MsgChannelUpgradeTry))
+		return srv.(MsgServer).Acknowledgements(ctx, req.(*MsgAcknowledgements))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ChannelUpgradeAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChannelUpgradeAck)
+func _Msg_Timeouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTimeouts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ChannelUpgradeAck(ctx, in)
+		return srv.(MsgServer).Timeouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeAck",
+		FullMethod: "/ibc.core.channel.v1.Msg/Timeouts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChannelUpgradeAck(ctx, req.(*MsgChannelUpgradeAck))
+		return srv.(MsgServer).Timeouts(ctx, req.(*MsgTimeouts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ChannelUpgradeConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChannelUpgradeConfirm)
+func _Msg_ChannelUpgradeInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeInit)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ChannelUpgradeConfirm(ctx, in)
+		return srv.(MsgServer).ChannelUpgradeInit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeConfirm",
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeInit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChannelUpgradeConfirm(ctx, req.(*MsgChannelUpgradeConfirm))
+		return srv.(MsgServer).ChannelUpgradeInit(ctx, req.(*MsgChannelUpgradeInit))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ChannelUpgradeOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChannelUpgradeOpen)
+func _Msg_ChannelUpgradeTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeTry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ChannelUpgradeOpen(ctx, in)
+		return srv.(MsgServer).ChannelUpgradeTry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeOpen",
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeTry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelUpgradeTry(ctx, req.(*MsgChannelUpgradeTry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChannelUpgradeAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelUpgradeAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelUpgradeAck(ctx, req.(*MsgChannelUpgradeAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChannelUpgradeConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelUpgradeConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelUpgradeConfirm(ctx, req.(*MsgChannelUpgradeConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChannelUpgradeOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeOpen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelUpgradeOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeOpen",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MsgServer).ChannelUpgradeOpen(ctx, req.(*MsgChannelUpgradeOpen))
@@ -2449,6 +3280,24 @@ func _Msg_ChannelUpgradeCancel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChannelUpgradeMigrateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeMigrateConnection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelUpgradeMigrateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeMigrateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelUpgradeMigrateConnection(ctx, req.(*MsgChannelUpgradeMigrateConnection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -2485,6 +3334,60 @@ func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleReceiptPruning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleReceiptPruning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleReceiptPruning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ScheduleReceiptPruning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleReceiptPruning(ctx, req.(*MsgScheduleReceiptPruning))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChannelForceClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelForceClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelForceClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelForceClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelForceClose(ctx, req.(*MsgChannelForceClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChannelForceTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelForceTimeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelForceTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelForceTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelForceTimeout(ctx, req.(*MsgChannelForceTimeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2529,6 +3432,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
+		{
+			MethodName: "RecvPackets",
+			Handler:    _Msg_RecvPackets_Handler,
+		},
+		{
+			MethodName: "Acknowledgements",
+			Handler:    _Msg_Acknowledgements_Handler,
+		},
+		{
+			MethodName: "Timeouts",
+			Handler:    _Msg_Timeouts_Handler,
+		},
 		{
 			MethodName: "ChannelUpgradeInit",
 			Handler:    _Msg_ChannelUpgradeInit_Handler,
@@ -2557,6 +3472,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChannelUpgradeCancel",
 			Handler:    _Msg_ChannelUpgradeCancel_Handler,
 		},
+		{
+			MethodName: "ChannelUpgradeMigrateConnection",
+			Handler:    _Msg_ChannelUpgradeMigrateConnection_Handler,
+		},
 		{
 			MethodName: "UpdateChannelParams",
 			Handler:    _Msg_UpdateChannelParams_Handler,
@@ -2565,6 +3484,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAcknowledgements",
 			Handler:    _Msg_PruneAcknowledgements_Handler,
 		},
+		{
+			MethodName: "ScheduleReceiptPruning",
+			Handler:    _Msg_ScheduleReceiptPruning_Handler,
+		},
+		{
+			MethodName: "ChannelForceClose",
+			Handler:    _Msg_ChannelForceClose_Handler,
+		},
+		{
+			MethodName: "ChannelForceTimeout",
+			Handler:    _Msg_ChannelForceTimeout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -3476,7 +4407,7 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeInit) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecvPackets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3486,16 +4417,25 @@ func (m *MsgChannelUpgradeInit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeInit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecvPackets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecvPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofsCommitment) > 0 {
+		for iNdEx := len(m.ProofsCommitment) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsCommitment[iNdEx])
+			copy(dAtA[i:], m.ProofsCommitment[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsCommitment[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -3504,7 +4444,7 @@ func (m *MsgChannelUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	{
-		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3513,24 +4453,31 @@ func (m *MsgChannelUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+	if len(m.ProofCommitment) > 0 {
+		i -= len(m.ProofCommitment)
+		copy(dAtA[i:], m.ProofCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofCommitment)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeInitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRecvPacketsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3540,35 +4487,38 @@ func (m *MsgChannelUpgradeInitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeInitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRecvPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRecvPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradeSequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+	if len(m.Results) > 0 {
+		dAtA17 := make([]byte, len(m.Results)*10)
+		var j16 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeTry) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcknowledgements) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3578,22 +4528,31 @@ func (m *MsgChannelUpgradeTry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeTry) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofsAcked) > 0 {
+		for iNdEx := len(m.ProofsAcked) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsAcked[iNdEx])
+			copy(dAtA[i:], m.ProofsAcked[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsAcked[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x2a
 	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -3604,63 +4563,41 @@ func (m *MsgChannelUpgradeTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
-	if len(m.ProofUpgrade) > 0 {
-		i -= len(m.ProofUpgrade)
-		copy(dAtA[i:], m.ProofUpgrade)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ProofChannel) > 0 {
-		i -= len(m.ProofChannel)
-		copy(dAtA[i:], m.ProofChannel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
+	dAtA[i] = 0x22
+	if len(m.ProofAcked) > 0 {
+		i -= len(m.ProofAcked)
+		copy(dAtA[i:], m.ProofAcked)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAcked)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x1a
 	}
-	{
-		size, err := m.CounterpartyUpgradeFields.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Acknowledgements) > 0 {
+		for iNdEx := len(m.Acknowledgements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Acknowledgements[iNdEx])
+			copy(dAtA[i:], m.Acknowledgements[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Acknowledgements[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ProposedUpgradeConnectionHops) > 0 {
-		for iNdEx := len(m.ProposedUpgradeConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ProposedUpgradeConnectionHops[iNdEx])
-			copy(dAtA[i:], m.ProposedUpgradeConnectionHops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedUpgradeConnectionHops[iNdEx])))
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeTryResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3670,40 +4607,38 @@ func (m *MsgChannelUpgradeTryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeTryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+	if len(m.Results) > 0 {
+		dAtA20 := make([]byte, len(m.Results)*10)
+		var j19 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.UpgradeSequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeAck) Marshal() (dAtA []byte, err error) {
+func (m *MsgTimeouts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3713,22 +4648,31 @@ func (m *MsgChannelUpgradeAck) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeAck) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTimeouts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTimeouts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProofsUnreceived) > 0 {
+		for iNdEx := len(m.ProofsUnreceived) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsUnreceived[iNdEx])
+			copy(dAtA[i:], m.ProofsUnreceived[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsUnreceived[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x22
 	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -3739,23 +4683,101 @@ func (m *MsgChannelUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.ProofUpgrade) > 0 {
-		i -= len(m.ProofUpgrade)
-		copy(dAtA[i:], m.ProofUpgrade)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
+	dAtA[i] = 0x1a
+	if len(m.ProofUnreceived) > 0 {
+		i -= len(m.ProofUnreceived)
+		copy(dAtA[i:], m.ProofUnreceived)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUnreceived)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	if len(m.ProofChannel) > 0 {
-		i -= len(m.ProofChannel)
-		copy(dAtA[i:], m.ProofChannel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTimeoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTimeoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTimeoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		dAtA23 := make([]byte, len(m.Results)*10)
+		var j22 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintTx(dAtA, i, uint64(j22))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChannelUpgradeInit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelUpgradeInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0x22
 	}
 	{
-		size, err := m.CounterpartyUpgrade.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3781,7 +4803,7 @@ func (m *MsgChannelUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeAckResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeInitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3791,25 +4813,35 @@ func (m *MsgChannelUpgradeAckResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeAckResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeInitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeConfirm) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeTry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3819,12 +4851,12 @@ func (m *MsgChannelUpgradeConfirm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeConfirm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3834,7 +4866,7 @@ func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x4a
 	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -3845,23 +4877,28 @@ func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x42
 	if len(m.ProofUpgrade) > 0 {
 		i -= len(m.ProofUpgrade)
 		copy(dAtA[i:], m.ProofUpgrade)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x3a
 	}
 	if len(m.ProofChannel) > 0 {
 		i -= len(m.ProofChannel)
 		copy(dAtA[i:], m.ProofChannel)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
+	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
+		i--
+		dAtA[i] = 0x28
 	}
 	{
-		size, err := m.CounterpartyUpgrade.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.CounterpartyUpgradeFields.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3870,10 +4907,14 @@ func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x22
-	if m.CounterpartyChannelState != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyChannelState))
-		i--
-		dAtA[i] = 0x18
+	if len(m.ProposedUpgradeConnectionHops) > 0 {
+		for iNdEx := len(m.ProposedUpgradeConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposedUpgradeConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ProposedUpgradeConnectionHops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedUpgradeConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
@@ -3892,7 +4933,7 @@ func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeConfirmResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeTryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3902,12 +4943,12 @@ func (m *MsgChannelUpgradeConfirmResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3915,12 +4956,27 @@ func (m *MsgChannelUpgradeConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	if m.Result != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Result))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeOpen) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeAck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3930,12 +4986,12 @@ func (m *MsgChannelUpgradeOpen) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeOpen) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeAck) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3957,23 +5013,30 @@ func (m *MsgChannelUpgradeOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
+	if len(m.ProofUpgrade) > 0 {
+		i -= len(m.ProofUpgrade)
+		copy(dAtA[i:], m.ProofUpgrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ProofChannel) > 0 {
 		i -= len(m.ProofChannel)
 		copy(dAtA[i:], m.ProofChannel)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
-		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.CounterpartyChannelState != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyChannelState))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.CounterpartyUpgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -3991,7 +5054,7 @@ func (m *MsgChannelUpgradeOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeOpenResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeAckResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4001,20 +5064,25 @@ func (m *MsgChannelUpgradeOpenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeOpenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeAckResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeOpenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeTimeout) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4024,12 +5092,12 @@ func (m *MsgChannelUpgradeTimeout) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeTimeout) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeConfirm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4039,7 +5107,7 @@ func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -4050,16 +5118,23 @@ func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x3a
+	if len(m.ProofUpgrade) > 0 {
+		i -= len(m.ProofUpgrade)
+		copy(dAtA[i:], m.ProofUpgrade)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUpgrade)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.ProofChannel) > 0 {
 		i -= len(m.ProofChannel)
 		copy(dAtA[i:], m.ProofChannel)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
 	{
-		size, err := m.CounterpartyChannel.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.CounterpartyUpgrade.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4067,7 +5142,12 @@ func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
+	if m.CounterpartyChannelState != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyChannelState))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -4085,7 +5165,7 @@ func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeTimeoutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeConfirmResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4095,20 +5175,25 @@ func (m *MsgChannelUpgradeTimeoutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeCancel) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeOpen) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4118,12 +5203,12 @@ func (m *MsgChannelUpgradeCancel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeCancel) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeOpen) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4133,7 +5218,7 @@ func (m *MsgChannelUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x3a
 	}
 	{
 		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
@@ -4144,16 +5229,110 @@ func (m *MsgChannelUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.ProofErrorReceipt) > 0 {
-		i -= len(m.ProofErrorReceipt)
-		copy(dAtA[i:], m.ProofErrorReceipt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofErrorReceipt)))
+	dAtA[i] = 0x32
+	if len(m.ProofChannel) > 0 {
+		i -= len(m.ProofChannel)
+		copy(dAtA[i:], m.ProofChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	{
-		size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+	if m.CounterpartyUpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CounterpartyChannelState != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyChannelState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChannelUpgradeOpenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelUpgradeOpenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelUpgradeOpenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChannelUpgradeTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelUpgradeTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofChannel) > 0 {
+		i -= len(m.ProofChannel)
+		copy(dAtA[i:], m.ProofChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.CounterpartyChannel.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4179,7 +5358,7 @@ func (m *MsgChannelUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelUpgradeCancelResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeTimeoutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4189,12 +5368,12 @@ func (m *MsgChannelUpgradeCancelResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChannelUpgradeCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelUpgradeCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4202,7 +5381,7 @@ func (m *MsgChannelUpgradeCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeCancel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4212,18 +5391,25 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeCancel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4231,18 +5417,42 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x2a
+	if len(m.ProofErrorReceipt) > 0 {
+		i -= len(m.ProofErrorReceipt)
+		copy(dAtA[i:], m.ProofErrorReceipt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofErrorReceipt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeCancelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4252,12 +5462,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeCancelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4265,7 +5475,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeMigrateConnection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4275,12 +5485,12 @@ func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPruneAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeMigrateConnection) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeMigrateConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4292,10 +5502,12 @@ func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
@@ -4314,7 +5526,7 @@ func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgChannelUpgradeMigrateConnectionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4324,400 +5536,516 @@ func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPruneAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeMigrateConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgChannelUpgradeMigrateConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TotalRemainingSequences != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TotalRemainingSequences))
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.TotalPrunedSequences != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TotalPrunedSequences))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgChannelOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PreviousChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChannelOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPruneAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelCloseInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgPruneAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TotalRemainingSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalRemainingSequences))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TotalPrunedSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPrunedSequences))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelCloseInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleReceiptPruning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChannelCloseConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleReceiptPruning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleReceiptPruning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofsUncommitted) > 0 {
+		for iNdEx := len(m.ProofsUncommitted) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProofsUncommitted[iNdEx])
+			copy(dAtA[i:], m.ProofsUncommitted[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProofsUncommitted[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofUncommitted) > 0 {
+		i -= len(m.ProofUncommitted)
+		copy(dAtA[i:], m.ProofUncommitted)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUncommitted)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	if len(m.Sequences) > 0 {
+		dAtA42 := make([]byte, len(m.Sequences)*10)
+		var j41 int
+		for _, num := range m.Sequences {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTx(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *MsgChannelCloseConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleReceiptPruningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleReceiptPruningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleReceiptPruningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.TotalScheduledSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalScheduledSequences))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChannelForceClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelForceClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelForceClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofCommitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRecvPacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChannelForceCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelForceCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelForceCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeout) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChannelForceTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelForceTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelForceTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.NextSequenceRecv != 0 {
-		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeoutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChannelForceTimeoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelForceTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelForceTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	if m.RemainingPacketCommitments != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemainingPacketCommitments))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTimeoutOnClose) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChannelOpenInit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofUnreceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofClose)
+	l = len(m.PortId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.ProofHeight.Size()
+	l = m.Channel.Size()
 	n += 1 + l + sovTx(uint64(l))
-	if m.NextSequenceRecv != 0 {
-		n += 1 + sovTx(uint64(m.NextSequenceRecv))
-	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
-	}
 	return n
 }
 
-func (m *MsgTimeoutOnCloseResponse) Size() (n int) {
+func (m *MsgChannelOpenInitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAcknowledgement) Size() (n int) {
+func (m *MsgChannelOpenTry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Packet.Size()
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Acknowledgement)
+	l = len(m.CounterpartyVersion)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofAcked)
+	l = len(m.ProofInit)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4730,19 +6058,24 @@ func (m *MsgAcknowledgement) Size() (n int) {
 	return n
 }
 
-func (m *MsgAcknowledgementResponse) Size() (n int) {
+func (m *MsgChannelOpenTryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgChannelUpgradeInit) Size() (n int) {
+func (m *MsgChannelOpenAck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4756,7 +6089,19 @@ func (m *MsgChannelUpgradeInit) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Fields.Size()
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofTry)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Signer)
 	if l > 0 {
@@ -4765,21 +6110,16 @@ func (m *MsgChannelUpgradeInit) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeInitResponse) Size() (n int) {
+func (m *MsgChannelOpenAckResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Upgrade.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.UpgradeSequence))
-	}
 	return n
 }
 
-func (m *MsgChannelUpgradeTry) Size() (n int) {
+func (m *MsgChannelOpenConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4793,27 +6133,42 @@ func (m *MsgChannelUpgradeTry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.ProposedUpgradeConnectionHops) > 0 {
-		for _, s := range m.ProposedUpgradeConnectionHops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.ProofAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.CounterpartyUpgradeFields.Size()
+	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofChannel)
+	return n
+}
+
+func (m *MsgChannelOpenConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChannelCloseInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofUpgrade)
+	l = len(m.ChannelId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -4821,24 +6176,16 @@ func (m *MsgChannelUpgradeTry) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeTryResponse) Size() (n int) {
+func (m *MsgChannelCloseInitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Upgrade.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.UpgradeSequence))
-	}
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
 	return n
 }
 
-func (m *MsgChannelUpgradeAck) Size() (n int) {
+func (m *MsgChannelCloseConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4852,13 +6199,40 @@ func (m *MsgChannelUpgradeAck) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.CounterpartyUpgrade.Size()
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofChannel)
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofUpgrade)
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgChannelCloseConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecvPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofCommitment)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4871,7 +6245,7 @@ func (m *MsgChannelUpgradeAck) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
+func (m *MsgRecvPacketResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4883,43 +6257,74 @@ func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeConfirm) Size() (n int) {
+func (m *MsgTimeout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofUnreceived)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChannelId)
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CounterpartyChannelState != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
+	return n
+}
+
+func (m *MsgTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = m.CounterpartyUpgrade.Size()
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgTimeoutOnClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofChannel)
+	l = len(m.ProofUnreceived)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ProofUpgrade)
+	l = len(m.ProofClose)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.NextSequenceRecv != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceRecv))
+	}
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeConfirmResponse) Size() (n int) {
+func (m *MsgTimeoutOnCloseResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4931,27 +6336,19 @@ func (m *MsgChannelUpgradeConfirmResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeOpen) Size() (n int) {
+func (m *MsgAcknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
+	l = m.Packet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Acknowledgement)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CounterpartyChannelState != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
-	}
-	if m.CounterpartyUpgradeSequence != 0 {
-		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
-	}
-	l = len(m.ProofChannel)
+	l = len(m.ProofAcked)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4964,32 +6361,31 @@ func (m *MsgChannelUpgradeOpen) Size() (n int) {
 	return n
 }
 
-func (m *MsgChannelUpgradeOpenResponse) Size() (n int) {
+func (m *MsgAcknowledgementResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeTimeout) Size() (n int) {
+func (m *MsgRecvPackets) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	l = m.CounterpartyChannel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofChannel)
+	l = len(m.ProofCommitment)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -4999,35 +6395,50 @@ func (m *MsgChannelUpgradeTimeout) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ProofsCommitment) > 0 {
+		for _, b := range m.ProofsCommitment {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeTimeoutResponse) Size() (n int) {
+func (m *MsgRecvPacketsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeCancel) Size() (n int) {
+func (m *MsgAcknowledgements) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Acknowledgements) > 0 {
+		for _, b := range m.Acknowledgements {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	l = m.ErrorReceipt.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofErrorReceipt)
+	l = len(m.ProofAcked)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -5037,43 +6448,79 @@ func (m *MsgChannelUpgradeCancel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ProofsAcked) > 0 {
+		for _, b := range m.ProofsAcked {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgChannelUpgradeCancelResponse) Size() (n int) {
+func (m *MsgAcknowledgementsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgTimeouts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ProofUnreceived)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
+	l = m.ProofHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProofsUnreceived) > 0 {
+		for _, b := range m.ProofsUnreceived {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgTimeoutsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
-func (m *MsgPruneAcknowledgements) Size() (n int) {
+func (m *MsgChannelUpgradeInit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5087,38 +6534,2771 @@ func (m *MsgPruneAcknowledgements) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
-	}
+	l = m.Fields.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgChannelUpgradeInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProposedUpgradeConnectionHops) > 0 {
+		for _, s := range m.ProposedUpgradeConnectionHops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.CounterpartyUpgradeFields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyChannelState != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
+	}
+	l = m.CounterpartyUpgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofUpgrade)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeOpen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CounterpartyChannelState != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyChannelState))
+	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeOpenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChannelUpgradeTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyChannel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChannelUpgradeCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ErrorReceipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofErrorReceipt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChannelUpgradeMigrateConnection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelUpgradeMigrateConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPrunedSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalPrunedSequences))
+	}
+	if m.TotalRemainingSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalRemainingSequences))
+	}
+	return n
+}
+
+func (m *MsgScheduleReceiptPruning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sequences) > 0 {
+		l = 0
+		for _, e := range m.Sequences {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.ProofUncommitted)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProofsUncommitted) > 0 {
+		for _, b := range m.ProofsUncommitted {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleReceiptPruningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalScheduledSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalScheduledSequences))
+	}
+	return n
+}
+
+func (m *MsgChannelForceClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelForceCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChannelForceTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelForceTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingPacketCommitments != 0 {
+		n += 1 + sovTx(uint64(m.RemainingPacketCommitments))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ResponseResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalPrunedSequences != 0 {
-		n += 1 + sovTx(uint64(m.TotalPrunedSequences))
-	}
-	if m.TotalRemainingSequences != 0 {
-		n += 1 + sovTx(uint64(m.TotalRemainingSequences))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5141,17 +9321,17 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutOnClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutOnClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5161,29 +9341,30 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5193,30 +9374,31 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClose", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5226,79 +9408,31 @@ func (m *MsgChannelOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProofClose = append(m.ProofClose[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClose == nil {
+				m.ProofClose = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenInitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5308,27 +9442,47 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+			}
+			m.NextSequenceRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5356,8 +9510,27 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5379,7 +9552,7 @@ func (m *MsgChannelOpenInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5402,17 +9575,17 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5422,59 +9595,64 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Result |= ResponseResultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5501,15 +9679,15 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5519,27 +9697,29 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5566,12 +9746,12 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5604,7 +9784,7 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -5657,121 +9837,7 @@ func (m *MsgChannelOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenTryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenTryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5794,81 +9860,17 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5878,29 +9880,66 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Result |= ResponseResultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecvPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecvPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecvPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5910,27 +9949,29 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersion = string(dAtA[iNdEx:postIndex])
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5957,12 +9998,12 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
+			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofCommitment == nil {
+				m.ProofCommitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -5995,7 +10036,7 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -6027,6 +10068,38 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsCommitment = append(m.ProofsCommitment, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsCommitment[len(m.ProofsCommitment)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6048,7 +10121,7 @@ func (m *MsgChannelOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecvPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6071,12 +10144,81 @@ func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecvPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6098,7 +10240,7 @@ func (m *MsgChannelOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6121,17 +10263,17 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6141,29 +10283,31 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6173,27 +10317,27 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Acknowledgements = append(m.Acknowledgements, make([]byte, postIndex-iNdEx))
+			copy(m.Acknowledgements[len(m.Acknowledgements)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6220,9 +10364,9 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAcked == nil {
+				m.ProofAcked = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
@@ -6290,6 +10434,38 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsAcked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsAcked = append(m.ProofsAcked, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsAcked[len(m.ProofsAcked)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6311,7 +10487,7 @@ func (m *MsgChannelOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6334,12 +10510,81 @@ func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6361,7 +10606,7 @@ func (m *MsgChannelOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeouts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6384,17 +10629,85 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeouts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeouts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6404,27 +10717,28 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6452,13 +10766,13 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsUnreceived", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6468,23 +10782,23 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ProofsUnreceived = append(m.ProofsUnreceived, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsUnreceived[len(m.ProofsUnreceived)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6507,7 +10821,7 @@ func (m *MsgChannelCloseInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTimeoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6530,12 +10844,81 @@ func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTimeoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTimeoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v ResponseResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ResponseResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]ResponseResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ResponseResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ResponseResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6557,7 +10940,7 @@ func (m *MsgChannelCloseInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6580,10 +10963,10 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeInit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6652,9 +11035,9 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6664,31 +11047,30 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6698,30 +11080,79 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6731,29 +11162,30 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.CounterpartyUpgradeSequence = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6763,7 +11195,7 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6789,7 +11221,7 @@ func (m *MsgChannelCloseConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6812,65 +11244,111 @@ func (m *MsgChannelCloseConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelCloseConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgradeConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedUpgradeConnectionHops = append(m.ProposedUpgradeConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6897,13 +11375,32 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6930,16 +11427,16 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofCommitment = append(m.ProofCommitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofCommitment == nil {
-				m.ProofCommitment = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6949,30 +11446,31 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6982,79 +11480,30 @@ func (m *MsgRecvPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecvPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.Result = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7064,11 +11513,24 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7090,7 +11552,7 @@ func (m *MsgRecvPacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7113,15 +11575,15 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7148,82 +11610,15 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.NextSequenceRecv = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7233,16 +11628,16 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceRecv |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7252,24 +11647,11 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Result |= ResponseResultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7291,7 +11673,7 @@ func (m *MsgTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7314,17 +11696,17 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			m.Result = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7334,64 +11716,59 @@ func (m *MsgTimeoutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutOnClose: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutOnClose: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7418,13 +11795,13 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7451,14 +11828,14 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUnreceived == nil {
-				m.ProofUnreceived = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClose", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7485,12 +11862,12 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClose = append(m.ProofClose[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClose == nil {
-				m.ProofClose = []byte{}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -7523,26 +11900,7 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceRecv", wireType)
-			}
-			m.NextSequenceRecv = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextSequenceRecv |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7574,11 +11932,61 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.CounterpartyUpgradeSequence = 0
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7588,7 +11996,7 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				m.Result |= ResponseResultType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7614,7 +12022,7 @@ func (m *MsgTimeoutOnClose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7637,17 +12045,49 @@ func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTimeoutOnCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Result = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7657,64 +12097,46 @@ func (m *MsgTimeoutOnCloseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CounterpartyChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyChannelState |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7741,13 +12163,13 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7774,14 +12196,14 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
-			if m.Acknowledgement == nil {
-				m.Acknowledgement = []byte{}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAcked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7808,12 +12230,12 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAcked = append(m.ProofAcked[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAcked == nil {
-				m.ProofAcked = []byte{}
+			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUpgrade == nil {
+				m.ProofUpgrade = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -7846,7 +12268,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -7899,7 +12321,7 @@ func (m *MsgAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7922,10 +12344,10 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcknowledgementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7968,7 +12390,7 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7991,10 +12413,10 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeOpen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeOpen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8062,8 +12484,80 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
+			}
+			m.CounterpartyChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyChannelState |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			}
+			m.CounterpartyUpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofChannel == nil {
+				m.ProofChannel = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8090,11 +12584,11 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8147,87 +12641,35 @@ func (m *MsgChannelUpgradeInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8249,7 +12691,7 @@ func (m *MsgChannelUpgradeInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8272,10 +12714,10 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8344,39 +12786,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgradeConnectionHops", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposedUpgradeConnectionHops = append(m.ProposedUpgradeConnectionHops, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8403,30 +12813,11 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CounterpartyChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
-			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
 			}
@@ -8460,41 +12851,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 				m.ProofChannel = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -8527,7 +12884,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8580,7 +12937,7 @@ func (m *MsgChannelUpgradeTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8603,83 +12960,12 @@ func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
-			}
-			m.UpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8701,7 +12987,7 @@ func (m *MsgChannelUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8724,10 +13010,10 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeCancel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8796,7 +13082,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8823,47 +13109,13 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofErrorReceipt", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8890,12 +13142,12 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
+			m.ProofErrorReceipt = append(m.ProofErrorReceipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofErrorReceipt == nil {
+				m.ProofErrorReceipt = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -8928,7 +13180,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -8981,7 +13233,7 @@ func (m *MsgChannelUpgradeAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9004,31 +13256,12 @@ func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9050,7 +13283,7 @@ func (m *MsgChannelUpgradeAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeMigrateConnection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9073,10 +13306,10 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeMigrateConnection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeMigrateConnection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9144,130 +13377,10 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
-			}
-			m.CounterpartyChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyChannelState |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgrade", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CounterpartyUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofUpgrade", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofUpgrade = append(m.ProofUpgrade[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofUpgrade == nil {
-				m.ProofUpgrade = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9277,26 +13390,25 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -9323,80 +13435,11 @@ func (m *MsgChannelUpgradeConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ResponseResultType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9418,7 +13461,7 @@ func (m *MsgChannelUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelUpgradeMigrateConnectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9441,17 +13484,17 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelUpgradeMigrateConnectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelUpgradeMigrateConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9461,29 +13504,30 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			var stringLen uint64
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9493,67 +13537,66 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelState", wireType)
-			}
-			m.CounterpartyChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyChannelState |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyUpgradeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9563,29 +13606,27 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9612,42 +13653,10 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9669,7 +13678,7 @@ func (m *MsgChannelUpgradeOpen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9692,10 +13701,10 @@ func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9719,7 +13728,7 @@ func (m *MsgChannelUpgradeOpenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9742,10 +13751,10 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9810,80 +13819,13 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CounterpartyChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofChannel", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofChannel = append(m.ProofChannel[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofChannel == nil {
-				m.ProofChannel = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9893,26 +13835,12 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -9965,7 +13893,7 @@ func (m *MsgChannelUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9988,12 +13916,50 @@ func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
+			}
+			m.TotalPrunedSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
+			}
+			m.TotalRemainingSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10015,7 +13981,7 @@ func (m *MsgChannelUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleReceiptPruning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10038,10 +14004,10 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleReceiptPruning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleReceiptPruning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10109,41 +14075,84 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sequences = append(m.Sequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Sequences) == 0 {
+					m.Sequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sequences = append(m.Sequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
 			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofErrorReceipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUncommitted", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10170,9 +14179,9 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofErrorReceipt = append(m.ProofErrorReceipt[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofErrorReceipt == nil {
-				m.ProofErrorReceipt = []byte{}
+			m.ProofUncommitted = append(m.ProofUncommitted[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUncommitted == nil {
+				m.ProofUncommitted = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
@@ -10240,6 +14249,38 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsUncommitted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofsUncommitted = append(m.ProofsUncommitted, make([]byte, postIndex-iNdEx))
+			copy(m.ProofsUncommitted[len(m.ProofsUncommitted)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10261,7 +14302,7 @@ func (m *MsgChannelUpgradeCancel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleReceiptPruningResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10284,12 +14325,31 @@ func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleReceiptPruningResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChannelUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleReceiptPruningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalScheduledSequences", wireType)
+			}
+			m.TotalScheduledSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalScheduledSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10311,7 +14371,7 @@ func (m *MsgChannelUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelForceClose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10334,15 +14394,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelForceClose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelForceClose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10370,13 +14430,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10386,24 +14446,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10426,7 +14517,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelForceCloseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10449,10 +14540,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelForceCloseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelForceCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10476,7 +14567,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelForceTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10499,10 +14590,10 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelForceTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelForceTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10570,10 +14661,10 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10583,11 +14674,26 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -10641,7 +14747,7 @@ func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChannelForceTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10664,36 +14770,17 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChannelForceTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChannelForceTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
-			}
-			m.TotalPrunedSequences = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPacketCommitments", wireType)
 			}
-			m.TotalRemainingSequences = 0
+			m.RemainingPacketCommitments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10703,7 +14790,7 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
+				m.RemainingPacketCommitments |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
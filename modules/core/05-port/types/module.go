@@ -191,6 +191,23 @@ type ICS4Wrapper interface {
 	) (string, bool)
 }
 
+// RelativeTimeoutPacketSender defines an optional interface which allows an ICS4Wrapper to send packets with
+// a timeout resolved by core IBC. Middleware implementing this interface must forward the call to the underlying
+// ICS4Wrapper if it also implements this interface.
+type RelativeTimeoutPacketSender interface {
+	// SendPacketWithRelativeTimeout sends a packet with a timeout relative to the latest height of the counterparty
+	// client and to the later of the block time and the latest consensus timestamp of the counterparty client. The
+	// timeout is resolved by core IBC and the resolved absolute timeout is returned alongside the packet sequence.
+	SendPacketWithRelativeTimeout(
+		ctx sdk.Context,
+		chanCap *capabilitytypes.Capability,
+		sourcePort string,
+		sourceChannel string,
+		relativeTimeout channeltypes.RelativeTimeout,
+		data []byte,
+	) (sequence uint64, timeout channeltypes.Timeout, err error)
+}
+
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
 // and ICS4Wrapper to wrap communication from underlying application to core IBC.
 type Middleware interface {
@@ -204,3 +221,13 @@ type PacketDataUnmarshaler interface {
 	// UnmarshalPacketData unmarshals the packet data into a concrete type
 	UnmarshalPacketData([]byte) (interface{}, error)
 }
+
+// PacketSenderRetriever defines an optional interface which allows a middleware to
+// request the sender of a packet from the base application, for applications which
+// cannot derive the packet sender from the packet data and source port alone.
+type PacketSenderRetriever interface {
+	// GetPacketSender returns the sender of the packet sent on the provided source port and
+	// channel with the provided unmarshaled packet data. An empty string is returned if the
+	// sender is unknown.
+	GetPacketSender(ctx sdk.Context, packetData interface{}, sourcePortID, sourceChannelID string) string
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/gogoproto/proto"
 	ics23 "github.com/cosmos/ics23/go"
@@ -144,7 +145,8 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	}
 
 	switch proof.Proofs[0].Proof.(type) {
-	case *ics23.CommitmentProof_Nonexist:
+	// batch and compressed batch proofs may contain the non-existence proofs of many keys in the same subtree
+	case *ics23.CommitmentProof_Nonexist, *ics23.CommitmentProof_Batch, *ics23.CommitmentProof_Compressed:
 		// VerifyNonMembership will verify the absence of key in lowest subtree, and then chain inclusion proofs
 		// of all subroots up to final root
 		subroot, err := proof.Proofs[0].Calculate()
@@ -173,16 +175,118 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
-func (MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return errorsmod.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+// VerifyMembershipBatch verifies the membership of many values against the given root from a single merkle proof.
+// The lowest proof of the chain is expected to be an ICS-23 batch or compressed batch proof containing an existence
+// proof for the key of each path. All paths must share the same keys above the lowest subtree, so that the remaining
+// proofs of the chain are only verified once.
+func (proof MerkleProof) VerifyMembershipBatch(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path, values [][]byte) error {
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ErrInvalidProof, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	mpaths, err := validateBatchPaths(specs, paths)
+	if err != nil {
+		return err
+	}
+
+	subroot, err := proof.Proofs[0].Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree may be empty. %v", err)
+	}
+
+	// decompress the lowest proof once for all keys, this is a no-op if the proof is not compressed
+	lowestProof := ics23.Decompress(proof.Proofs[0])
+	for i, mpath := range mpaths {
+		if len(values[i]) == 0 {
+			return errorsmod.Wrapf(ErrInvalidProof, "empty value in membership proof at index %d", i)
+		}
+
+		key, err := mpath.GetKey(uint64(len(mpath.KeyPath) - 1))
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key: %s", mpath.KeyPath[len(mpath.KeyPath)-1])
+		}
+
+		if ok := ics23.VerifyMembership(specs[0], subroot, lowestProof, key, values[i]); !ok {
+			return errorsmod.Wrapf(ErrInvalidProof, "batch proof failed to verify membership of value: %X for key %s at index %d", values[i], string(key), i)
+		}
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpaths[0], subroot, 1)
+}
+
+// VerifyNonMembershipBatch verifies the absence of many paths against the given root from a single merkle proof.
+// The lowest proof of the chain is expected to be an ICS-23 batch or compressed batch proof containing a
+// non-existence proof for the key of each path. All paths must share the same keys above the lowest subtree,
+// so that the remaining proofs of the chain are only verified once.
+func (proof MerkleProof) VerifyNonMembershipBatch(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path) error {
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	mpaths, err := validateBatchPaths(specs, paths)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := proof.Proofs[0].Proof.(*ics23.CommitmentProof_Exist); ok {
+		return errorsmod.Wrapf(ErrInvalidProof,
+			"got ExistenceProof in VerifyNonMembershipBatch. If this is unexpected, please ensure that proof was queried with the correct keys.")
+	}
+
+	subroot, err := proof.Proofs[0].Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree is likely empty. %v", err)
+	}
+
+	// decompress the lowest proof once for all keys, this is a no-op if the proof is not compressed
+	lowestProof := ics23.Decompress(proof.Proofs[0])
+	for i, mpath := range mpaths {
+		key, err := mpath.GetKey(uint64(len(mpath.KeyPath) - 1))
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key: %s", mpath.KeyPath[len(mpath.KeyPath)-1])
+		}
+
+		if ok := ics23.VerifyNonMembership(specs[0], subroot, lowestProof, key); !ok {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not verify absence of key %s at index %d. Please ensure that the path is correct.", string(key), i)
+		}
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpaths[0], subroot, 1)
 }
 
-// BatchVerifyNonMembership verifies absence of a group of keys against the given root
-// NOTE: Currently left unimplemented as it is unused
-func (MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items [][]byte) error {
-	return errorsmod.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+// validateBatchPaths ensures that the given paths are non-empty merkle paths of the same length as the specs
+// which share the same keys above the lowest subtree, and returns them as merkle paths.
+func validateBatchPaths(specs []*ics23.ProofSpec, paths []exported.Path) ([]MerklePath, error) {
+	if len(paths) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "paths cannot be empty")
+	}
+
+	mpaths := make([]MerklePath, len(paths))
+	for i, path := range paths {
+		mpath, ok := path.(MerklePath)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+		}
+		if len(mpath.KeyPath) != len(specs) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "path length %d not same as proof %d",
+				len(mpath.KeyPath), len(specs))
+		}
+
+		// the keys above the lowest subtree are proven by the same proofs for all paths
+		if i > 0 && !slices.Equal(mpath.KeyPath[:len(mpath.KeyPath)-1], mpaths[0].KeyPath[:len(mpath.KeyPath)-1]) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "path %s does not share the same prefix as path %s", mpath, mpaths[0])
+		}
+
+		mpaths[i] = mpath
+	}
+
+	return mpaths, nil
 }
 
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"testing"
 
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/require"
 
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 func (suite *MerkleTestSuite) TestVerifyMembership() {
@@ -140,6 +142,170 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestVerifyMembershipBatch() {
+	for _, key := range []string{"MYKEY1", "MYKEY2", "MYKEY3"} {
+		suite.iavlStore.Set([]byte(key), []byte("MYVALUE"))
+	}
+	cid := suite.store.Commit()
+
+	proof := suite.queryBatchProof("MYKEY1", "MYKEY2", "MYKEY3")
+
+	var (
+		paths  []exported.Path
+		values [][]byte
+		root   []byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: subset of batch", func() {
+			paths = paths[1:]
+			values = values[1:]
+		}, true},
+		{"wrong value", func() {
+			values[1] = []byte("WRONGVALUE")
+		}, false},
+		{"empty value", func() {
+			values[1] = nil
+		}, false},
+		{"key not in batch", func() {
+			paths[1] = types.NewMerklePath(suite.storeKey.Name(), "NOTMYKEY")
+		}, false},
+		{"paths do not share the same prefix", func() {
+			paths[1] = types.NewMerklePath("otherStoreKey", "MYKEY2")
+		}, false},
+		{"wrong path length", func() {
+			paths[1] = types.NewMerklePath(suite.storeKey.Name(), "MYKEY2", "MYKEY3")
+		}, false},
+		{"mismatched number of paths and values", func() {
+			values = values[1:]
+		}, false},
+		{"empty paths", func() {
+			paths = nil
+			values = nil
+		}, false},
+		{"wrong root", func() {
+			root = []byte("WRONGROOT")
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			paths = []exported.Path{
+				types.NewMerklePath(suite.storeKey.Name(), "MYKEY1"),
+				types.NewMerklePath(suite.storeKey.Name(), "MYKEY2"),
+				types.NewMerklePath(suite.storeKey.Name(), "MYKEY3"),
+			}
+			values = [][]byte{[]byte("MYVALUE"), []byte("MYVALUE"), []byte("MYVALUE")}
+			root = cid.Hash
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err := proof.VerifyMembershipBatch(types.GetSDKSpecs(), &merkleRoot, paths, values)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestVerifyNonMembershipBatch() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := suite.store.Commit()
+
+	var (
+		proof types.MerkleProof
+		paths []exported.Path
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"existent key", func() {
+			paths[1] = types.NewMerklePath(suite.storeKey.Name(), "MYKEY")
+		}, false},
+		{"key not in batch", func() {
+			paths[1] = types.NewMerklePath(suite.storeKey.Name(), "NOTINBATCH")
+		}, false},
+		{"paths do not share the same prefix", func() {
+			paths[1] = types.NewMerklePath("otherStoreKey", "MYABSENTKEY2")
+		}, false},
+		{"empty paths", func() {
+			paths = nil
+		}, false},
+		{"existence proof", func() {
+			proof = suite.queryBatchProof("MYKEY")
+			paths = []exported.Path{types.NewMerklePath(suite.storeKey.Name(), "MYKEY")}
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			proof = suite.queryBatchProof("MYABSENTKEY1", "MYABSENTKEY2")
+			paths = []exported.Path{
+				types.NewMerklePath(suite.storeKey.Name(), "MYABSENTKEY1"),
+				types.NewMerklePath(suite.storeKey.Name(), "MYABSENTKEY2"),
+			}
+
+			tc.malleate()
+
+			root := types.NewMerkleRoot(cid.Hash)
+			err := proof.VerifyNonMembershipBatch(types.GetSDKSpecs(), &root, paths)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// queryBatchProof queries the proofs of the given keys in the iavl store and combines the proofs of the
+// lowest subtree into a single compressed batch proof.
+func (suite *MerkleTestSuite) queryBatchProof(keys ...string) types.MerkleProof {
+	var (
+		commitmentProofs []*ics23.CommitmentProof
+		storeProof       *ics23.CommitmentProof
+	)
+	for _, key := range keys {
+		res, err := suite.store.Query(&storetypes.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		suite.Require().NoError(err)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		suite.Require().NoError(err)
+
+		commitmentProofs = append(commitmentProofs, proof.Proofs[0])
+		storeProof = proof.Proofs[1]
+	}
+
+	if len(commitmentProofs) == 1 {
+		return types.MerkleProof{Proofs: []*ics23.CommitmentProof{commitmentProofs[0], storeProof}}
+	}
+
+	batchProof, err := ics23.CombineProofs(commitmentProofs)
+	suite.Require().NoError(err)
+
+	return types.MerkleProof{Proofs: []*ics23.CommitmentProof{batchProof, storeProof}}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
@@ -69,6 +69,42 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgRecvPackets:
+				response, err := rrd.k.RecvPackets(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := rrd.k.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+
+			case *channeltypes.MsgTimeouts:
+				response, err := rrd.k.Timeouts(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+
 			case *clienttypes.MsgUpdateClient:
 				_, err := rrd.k.UpdateClient(ctx, msg)
 				if err != nil {
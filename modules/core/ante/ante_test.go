@@ -69,6 +69,39 @@ func (suite *AnteTestSuite) createRecvPacketMessage(isRedundant bool) sdk.Msg {
 	return channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for packets sent from chain A to chain B.
+// A packet is created for each entry of isRedundant, and is received before the message is created if the entry is true.
+func (suite *AnteTestSuite) createRecvPacketsMessage(isRedundant ...bool) sdk.Msg {
+	var (
+		packets    []channeltypes.Packet
+		packetKeys [][]byte
+	)
+	for _, redundant := range isRedundant {
+		sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+
+		if redundant {
+			err = suite.path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}
+
+		packets = append(packets, packet)
+		packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.path.EndpointA.QueryBatchProof(packetKeys...)
+
+	return channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createAcknowledgementMessage creates an Acknowledgement message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createAcknowledgementMessage(isRedundant bool) sdk.Msg {
 	sequence, err := suite.path.EndpointB.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
@@ -121,6 +154,43 @@ func (suite *AnteTestSuite) createTimeoutMessage(isRedundant bool) sdk.Msg {
 	return channeltypes.NewMsgTimeout(packet, sequence, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createTimeoutsMessage creates a Timeouts message for packets sent from chain B to chain A.
+// A packet is created for each entry of isRedundant, and is timed out before the message is created if the entry is true.
+func (suite *AnteTestSuite) createTimeoutsMessage(isRedundant ...bool) sdk.Msg {
+	height := suite.chainA.LatestCommittedHeader.GetHeight()
+	timeoutHeight := clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+1)
+
+	var packets []channeltypes.Packet
+	for range isRedundant {
+		sequence, err := suite.path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			timeoutHeight, 0))
+	}
+
+	suite.coordinator.CommitNBlocks(suite.chainA, 3)
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	var packetKeys [][]byte
+	for i, packet := range packets {
+		if isRedundant[i] {
+			err = suite.path.EndpointB.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+		}
+
+		packetKeys = append(packetKeys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+
+	proof, proofHeight := suite.path.EndpointA.QueryBatchProof(packetKeys...)
+
+	return channeltypes.NewMsgTimeouts(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createTimeoutOnCloseMessage creates an TimeoutOnClose message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createTimeoutOnCloseMessage(isRedundant bool) sdk.Msg {
 	height := suite.chainA.LatestCommittedHeader.GetHeight()
@@ -342,6 +412,34 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one RecvPackets message with one new and two redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false, true)}
+			},
+			true,
+		},
+		{
+			"no success on one RecvPackets message with three redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true, true)}
+			},
+			false,
+		},
+		{
+			"success on one Timeouts message with one new and two redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createTimeoutsMessage(true, false, true)}
+			},
+			true,
+		},
+		{
+			"no success on one Timeouts message with three redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createTimeoutsMessage(true, true, true)}
+			},
+			false,
+		},
 		{
 			"no success on one redundant RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -141,6 +141,39 @@ type ClientState interface {
 	) error
 }
 
+// MembershipBatchVerifier is an optional interface which may be implemented by a ClientState to natively
+// verify the membership or non-membership of many paths at the specified height from a single proof.
+// If a ClientState does not implement it, a proof must be provided for each path of a batch, which is
+// verified using VerifyMembership or VerifyNonMembership.
+type MembershipBatchVerifier interface {
+	// VerifyMembershipBatch verifies a proof of the existence of each value at the CommitmentPath with the same index at the specified height.
+	// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and standardized paths (as defined in ICS 24).
+	VerifyMembershipBatch(
+		ctx sdk.Context,
+		clientStore storetypes.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+		values [][]byte,
+	) error
+
+	// VerifyNonMembershipBatch verifies a proof of the absence of each of the given CommitmentPaths at the specified height.
+	// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and standardized paths (as defined in ICS 24).
+	VerifyNonMembershipBatch(
+		ctx sdk.Context,
+		clientStore storetypes.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+	) error
+}
+
 // ConsensusState is the state of the consensus process
 type ConsensusState interface {
 	proto.Message
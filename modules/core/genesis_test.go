@@ -3,6 +3,7 @@ package ibc_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
@@ -11,6 +12,7 @@ import (
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channel "github.com/cosmos/ibc-go/v8/modules/core/04-channel"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -333,6 +335,25 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 				suite.coordinator.SetupClients(ibctesting.NewPath(suite.chainA, suite.chainB))
 			},
 		},
+		{
+			"success: connection upgrade in progress",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path)
+
+				clientPath := ibctesting.NewPath(suite.chainB, suite.chainA)
+				suite.Require().NoError(clientPath.EndpointA.CreateClient())
+
+				// initialise the upgrade on chainB and accept it on chainA
+				path.EndpointB.ConnectionConfig.ProposedUpgrade = connectiontypes.UpgradeFields{ClientId: clientPath.EndpointA.ClientID}
+				suite.Require().NoError(path.EndpointB.ConnUpgradeInit())
+				suite.Require().NoError(path.EndpointA.ConnUpgradeTry())
+
+				// write an error receipt for a previous upgrade attempt
+				errorReceipt := connectiontypes.ErrorReceipt{Sequence: 1, Message: "previous upgrade aborted"}
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ConnectionID, errorReceipt)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -353,6 +374,8 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 				ibc.InitGenesis(suite.chainA.GetContext(), *suite.chainA.App.GetIBCKeeper(), gs)
 			})
 
+			suite.Require().Equal(gs.ConnectionGenesis, ibc.ExportGenesis(suite.chainA.GetContext(), *suite.chainA.App.GetIBCKeeper()).ConnectionGenesis)
+
 			suite.NotPanics(func() {
 				cdc := codec.NewProtoCodec(suite.chainA.GetSimApp().InterfaceRegistry())
 				genState := cdc.MustMarshalJSON(gs)
@@ -366,3 +389,46 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 		})
 	}
 }
+
+func (suite *IBCTestSuite) TestExportGenesisPacketStatuses() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	params := channelKeeper.GetParams(suite.chainA.GetContext())
+	params.ReceiptRetention = channeltypes.NewReceiptRetention(channeltypes.MinReceiptRetentionHeightPeriod, channeltypes.MinReceiptRetentionTimePeriod, 2)
+	channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// time out a packet and leave another packet in flight
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+	sequence, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	_, err = path.EndpointA.SendPacket(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()), ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	gs := ibc.ExportGenesis(suite.chainA.GetContext(), *suite.chainA.App.GetIBCKeeper()).ChannelGenesis
+	suite.Require().Len(gs.TimedOutPackets, 1)
+	suite.Require().Len(gs.ScheduledTimedOutPackets, 1)
+	suite.Require().Len(gs.PacketStatusStartSequences, 1)
+	suite.Require().NoError(gs.Validate())
+
+	// init genesis on a new chain and export it again
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false)
+	suite.Require().NotPanics(func() {
+		channel.InitGenesis(ctx, app.IBCKeeper.ChannelKeeper, gs)
+	})
+
+	exportedGenesis := channel.ExportGenesis(ctx, app.IBCKeeper.ChannelKeeper)
+	suite.Require().Equal(gs.TimedOutPackets, exportedGenesis.TimedOutPackets)
+	suite.Require().Equal(gs.ScheduledTimedOutPackets, exportedGenesis.ScheduledTimedOutPackets)
+	suite.Require().Equal(gs.PacketStatusStartSequences, exportedGenesis.PacketStatusStartSequences)
+}
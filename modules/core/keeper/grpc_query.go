@@ -83,6 +83,16 @@ func (k Keeper) ConnectionParams(c context.Context, req *connectiontypes.QueryCo
 	return k.ConnectionKeeper.ConnectionParams(c, req)
 }
 
+// ConnectionUpgradeError implements the IBC QueryServer interface
+func (k Keeper) ConnectionUpgradeError(c context.Context, req *connectiontypes.QueryConnectionUpgradeErrorRequest) (*connectiontypes.QueryConnectionUpgradeErrorResponse, error) {
+	return k.ConnectionKeeper.ConnectionUpgradeError(c, req)
+}
+
+// ConnectionUpgrade implements the IBC QueryServer interface
+func (k Keeper) ConnectionUpgrade(c context.Context, req *connectiontypes.QueryConnectionUpgradeRequest) (*connectiontypes.QueryConnectionUpgradeResponse, error) {
+	return k.ConnectionKeeper.ConnectionUpgrade(c, req)
+}
+
 // Channel implements the IBC QueryServer interface
 func (k Keeper) Channel(c context.Context, req *channeltypes.QueryChannelRequest) (*channeltypes.QueryChannelResponse, error) {
 	return k.ChannelKeeper.Channel(c, req)
@@ -163,6 +173,21 @@ func (k Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeRequest
 	return k.ChannelKeeper.Upgrade(c, req)
 }
 
+// CounterpartyUpgrade implements the IBC QueryServer interface
+func (k Keeper) CounterpartyUpgrade(c context.Context, req *channeltypes.QueryCounterpartyUpgradeRequest) (*channeltypes.QueryCounterpartyUpgradeResponse, error) {
+	return k.ChannelKeeper.CounterpartyUpgrade(c, req)
+}
+
+// PacketStatus implements the IBC QueryServer interface
+func (k Keeper) PacketStatus(c context.Context, req *channeltypes.QueryPacketStatusRequest) (*channeltypes.QueryPacketStatusResponse, error) {
+	return k.ChannelKeeper.PacketStatus(c, req)
+}
+
+// PacketStatuses implements the IBC QueryServer interface
+func (k Keeper) PacketStatuses(c context.Context, req *channeltypes.QueryPacketStatusesRequest) (*channeltypes.QueryPacketStatusesResponse, error) {
+	return k.ChannelKeeper.PacketStatuses(c, req)
+}
+
 // ChannelParams implements the IBC QueryServer interface
 func (k Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
 	return k.ChannelKeeper.ChannelParams(c, req)
@@ -7,6 +7,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
@@ -198,6 +200,163 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+// The signer must be the designated authority (e.g. the governance module).
+func (k Keeper) ConnectionUpgradeInit(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeInit) (*connectiontypes.MsgConnectionUpgradeInitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	upgrade, err := k.ConnectionKeeper.ConnUpgradeInit(ctx, msg.ConnectionId, msg.Fields)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade init failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade init failed")
+	}
+
+	connection, upgrade := k.ConnectionKeeper.WriteUpgradeInitConnection(ctx, msg.ConnectionId, upgrade)
+
+	ctx.Logger().Info("connection upgrade init succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeInitResponse{
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+func (k Keeper) ConnectionUpgradeTry(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTry) (*connectiontypes.MsgConnectionUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyClient, err := unpackUpgradeClientState(msg.CounterpartyClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	_, upgrade, err := k.ConnectionKeeper.ConnUpgradeTry(ctx, msg.ConnectionId, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence, counterpartyClient, msg.ProofConnection, msg.ProofUpgrade, msg.ProofClient, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("connection upgrade try failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			k.ConnectionKeeper.WriteErrorReceipt(ctx, msg.ConnectionId, err.(*connectiontypes.UpgradeError))
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+			return &connectiontypes.MsgConnectionUpgradeTryResponse{Result: connectiontypes.FAILURE}, nil
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade try failed")
+	}
+
+	connection, upgrade := k.ConnectionKeeper.WriteUpgradeTryConnection(ctx, msg.ConnectionId, upgrade, msg.CounterpartyUpgradeFields)
+
+	ctx.Logger().Info("connection upgrade try succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeTryResponse{
+		Result:          connectiontypes.SUCCESS,
+		Upgrade:         upgrade,
+		UpgradeSequence: connection.UpgradeSequence,
+	}, nil
+}
+
+// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+func (k Keeper) ConnectionUpgradeAck(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeAck) (*connectiontypes.MsgConnectionUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyClient, err := unpackUpgradeClientState(msg.CounterpartyClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ConnectionKeeper.ConnUpgradeAck(ctx, msg.ConnectionId, msg.CounterpartyUpgrade, counterpartyClient, msg.ProofConnection, msg.ProofUpgrade, msg.ProofClient, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade ack failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		if connectiontypes.IsUpgradeError(err) {
+			k.ConnectionKeeper.MustAbortUpgrade(ctx, msg.ConnectionId, err)
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subprotocol.
+			return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.FAILURE}, nil
+		}
+
+		// NOTE: an error is returned to baseapp and transaction state is not committed.
+		return nil, errorsmod.Wrap(err, "connection upgrade ack failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeAckConnection(ctx, msg.ConnectionId, msg.CounterpartyUpgrade)
+
+	ctx.Logger().Info("connection upgrade ack succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeAckResponse{Result: connectiontypes.SUCCESS}, nil
+}
+
+// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+func (k Keeper) ConnectionUpgradeConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeConfirm) (*connectiontypes.MsgConnectionUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeConfirm(ctx, msg.ConnectionId, msg.ProofConnection, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade confirm failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade confirm failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeConfirmConnection(ctx, msg.ConnectionId)
+
+	ctx.Logger().Info("connection upgrade confirm succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+func (k Keeper) ConnectionUpgradeTimeout(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTimeout) (*connectiontypes.MsgConnectionUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeTimeout(ctx, msg.ConnectionId, msg.CounterpartyConnection, msg.ProofConnection, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade timeout failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade timeout failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeTimeoutConnection(ctx, msg.ConnectionId)
+
+	ctx.Logger().Info("connection upgrade timeout succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeTimeoutResponse{}, nil
+}
+
+// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+func (k Keeper) ConnectionUpgradeCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeCancel) (*connectiontypes.MsgConnectionUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	connection, found := k.ConnectionKeeper.GetConnection(ctx, msg.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, msg.ConnectionId)
+	}
+
+	upgrade, found := k.ConnectionKeeper.GetUpgrade(ctx, msg.ConnectionId)
+	if !found {
+		return nil, errorsmod.Wrapf(connectiontypes.ErrUpgradeNotFound, "failed to retrieve connection upgrade: connection ID (%s)", msg.ConnectionId)
+	}
+
+	// if the msgSender is authorized to make and cancel upgrades AND the upgrade has not yet been accepted
+	// in the TRY step, then we can restore immediately without any additional checks
+	isAuthority := k.GetAuthority() == msg.Signer
+	if isAuthority && upgrade.TimeoutTimestamp == 0 {
+		k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, connection.UpgradeSequence)
+
+		ctx.Logger().Info("connection upgrade cancel succeeded", "connection-id", msg.ConnectionId)
+
+		return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+	}
+
+	if err := k.ConnectionKeeper.ConnUpgradeCancel(ctx, msg.ConnectionId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
+		ctx.Logger().Error("connection upgrade cancel failed", "connection-id", msg.ConnectionId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "connection upgrade cancel failed")
+	}
+
+	k.ConnectionKeeper.WriteUpgradeCancelConnection(ctx, msg.ConnectionId, msg.ErrorReceipt.Sequence)
+
+	ctx.Logger().Info("connection upgrade cancel succeeded", "connection-id", msg.ConnectionId)
+
+	return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -481,6 +640,12 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		// no-ops do not need event emission as they will be ignored
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is
+		// committed without executing the application callback
+		writeFn()
+		ctx.Logger().Info("timeout receipt written", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
@@ -737,6 +902,287 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
 
+// RecvPackets defines a rpc handler method for MsgRecvPackets.
+// The commitment proofs of the batch are verified before any packet is received, and the entire
+// message is rejected if the verification fails. Each packet is then received in its own cached context. A packet which fails
+// to be received does not affect the other packets of the batch and is reported with a FAILURE result.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	// Lookup module by channel capability, all packets of the batch are received on the same channel
+	portID, channelID := msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification
+	//
+	// The proofs are verified for the entire batch, each packet is then received in its
+	// own cached context and its result is returned at the same index.
+	recvErrs, err := k.ChannelKeeper.RecvPackets(ctx, capability, msg.Packets, msg.CommitmentProofs(), msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch recvErrs[i] {
+		case nil:
+		case channeltypes.ErrNoOpMsg:
+			// no-ops do not need event emission as they will be ignored
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.NOOP
+			continue
+		case channeltypes.ErrTimeoutReceiptWritten:
+			// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is
+			// committed without executing the application callback
+			ctx.Logger().Info("timeout receipt written", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.SUCCESS
+			continue
+		default:
+			ctx.Logger().Error("receive packets failed for packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", errorsmod.Wrap(recvErrs[i], "receive packet verification failed"))
+			results[i] = channeltypes.FAILURE
+			continue
+		}
+
+		// Perform application logic callback
+		//
+		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+		cacheCtx, writeFn := ctx.CacheContext()
+		ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
+		if ack == nil || ack.Success() {
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+		} else {
+			// Modify events in cached context to reflect unsuccessful acknowledgement
+			ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+		}
+
+		// Set packet acknowledgement only if the acknowledgement is not nil.
+		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
+		// acknowledgement is nil.
+		if ack != nil {
+			if err := k.ChannelKeeper.WriteAcknowledgement(ctx, capability, packet, ack); err != nil {
+				return nil, err
+			}
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+			},
+		)
+
+		results[i] = channeltypes.SUCCESS
+	}
+
+	ctx.Logger().Info("receive packets succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+// The acknowledgement proofs of the batch are verified before any acknowledgement is processed, and
+// the entire message is rejected if the verification fails. Each acknowledgement is then processed by core IBC and by the application
+// callback in its own cached context, whose state changes are only written if both succeed. An
+// acknowledgement which fails to be processed does not affect the other acknowledgements of the
+// batch and is reported with a FAILURE result, in which case its packet commitment is kept.
+func (k Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	// Lookup module by channel capability, all packets of the batch are sent on the same channel
+	portID, channelID := msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification
+	//
+	// The proofs are verified for the entire batch, each acknowledgement is then processed
+	// in its own cached context and its result is returned at the same index. The application
+	// callback is performed in the cached context of the packet, so that the acknowledgement of
+	// the packet is discarded by core IBC if the callback fails.
+	onAcknowledgePacket := func(cacheCtx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+		if err := cbs.OnAcknowledgementPacket(cacheCtx, packet, acknowledgement, relayer); err != nil {
+			return errorsmod.Wrap(err, "acknowledge packet callback failed")
+		}
+
+		return nil
+	}
+
+	ackErrs, err := k.ChannelKeeper.AcknowledgePackets(ctx, capability, msg.Packets, msg.Acknowledgements, msg.AcknowledgementProofs(), msg.ProofHeight, onAcknowledgePacket)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch ackErrs[i] {
+		case nil:
+		case channeltypes.ErrNoOpMsg:
+			// no-ops do not need event emission as they will be ignored
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.NOOP
+			continue
+		default:
+			ctx.Logger().Error("acknowledgements failed for packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", ackErrs[i])
+			results[i] = channeltypes.FAILURE
+			continue
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+			},
+		)
+
+		results[i] = channeltypes.SUCCESS
+	}
+
+	ctx.Logger().Info("acknowledgements succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// Timeouts defines a rpc handler method for MsgTimeouts.
+// The proofs of the absence of the packet receipts are verified before any packet is timed out, and
+// the entire message is rejected if the verification fails. Each packet is then timed out by core IBC
+// and by the application callback in its own cached context, whose state changes are only written if
+// both succeed. A packet which fails to be timed out does not affect the other packets of the batch
+// and is reported with a FAILURE result, in which case its packet commitment is kept.
+func (k Keeper) Timeouts(goCtx context.Context, msg *channeltypes.MsgTimeouts) (*channeltypes.MsgTimeoutsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, errorsmod.Wrap(channeltypes.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	// Lookup module by channel capability, all packets of the batch are sent on the same channel
+	portID, channelID := msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel
+	module, capability, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform TAO verification
+	//
+	// The proofs are verified for the entire batch, each packet is then timed out in its own
+	// cached context and its result is returned at the same index. The application callback is
+	// performed in the cached context of the packet, so that the timeout of the packet is
+	// discarded by core IBC if the callback fails.
+	onTimeoutPacket := func(cacheCtx sdk.Context, packet channeltypes.Packet) error {
+		if err := cbs.OnTimeoutPacket(cacheCtx, packet, relayer); err != nil {
+			return errorsmod.Wrap(err, "timeout packet callback failed")
+		}
+
+		return nil
+	}
+
+	timeoutErrs, err := k.ChannelKeeper.TimeoutPackets(ctx, capability, msg.Packets, msg.UnreceivedProofs(), msg.ProofHeight, onTimeoutPacket)
+	if err != nil {
+		ctx.Logger().Error("timeouts failed", "port-id", portID, "channel-id", channelID, "error", errorsmod.Wrap(err, "timeout packets verification failed"))
+		return nil, errorsmod.Wrap(err, "timeout packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch timeoutErrs[i] {
+		case nil:
+		case channeltypes.ErrNoOpMsg:
+			// no-ops do not need event emission as they will be ignored
+			ctx.Logger().Debug("no-op on redundant relay", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			results[i] = channeltypes.NOOP
+			continue
+		default:
+			ctx.Logger().Error("timeouts failed for packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", timeoutErrs[i])
+			results[i] = channeltypes.FAILURE
+			continue
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "timeout", "packet"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelTimeoutType, "height"),
+			},
+		)
+
+		results[i] = channeltypes.SUCCESS
+	}
+
+	ctx.Logger().Info("timeouts succeeded", "port-id", portID, "channel-id", channelID, "packets", len(msg.Packets))
+
+	return &channeltypes.MsgTimeoutsResponse{Results: results}, nil
+}
+
 // ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
 func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -1122,6 +1568,35 @@ func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.Ms
 	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
 }
 
+// ChannelUpgradeMigrateConnection defines a rpc handler method for MsgChannelUpgradeMigrateConnection.
+// It initializes a channel upgrade which moves the channel onto the provided connection, keeping the
+// existing channel ordering and version.
+func (k Keeper) ChannelUpgradeMigrateConnection(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeMigrateConnection) (*channeltypes.MsgChannelUpgradeMigrateConnectionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	channel, found := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	upgradeFields := channeltypes.NewUpgradeFields(channel.Ordering, []string{msg.ConnectionId}, channel.Version)
+	res, err := k.ChannelUpgradeInit(goCtx, channeltypes.NewMsgChannelUpgradeInit(msg.PortId, msg.ChannelId, upgradeFields, msg.Signer))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Logger().Info("channel connection migration initialized", "port-id", msg.PortId, "channel-id", msg.ChannelId, "connection-id", msg.ConnectionId)
+
+	return &channeltypes.MsgChannelUpgradeMigrateConnectionResponse{
+		Upgrade:         res.Upgrade,
+		UpgradeSequence: res.UpgradeSequence,
+	}, nil
+}
+
 // PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -1137,6 +1612,109 @@ func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.M
 	}, nil
 }
 
+// ScheduleReceiptPruning defines a rpc handler method for MsgScheduleReceiptPruning.
+// The receipts of the given sequences are scheduled to be pruned once the deletion of the packet
+// commitments on the counterparty is proven.
+func (k Keeper) ScheduleReceiptPruning(goCtx context.Context, msg *channeltypes.MsgScheduleReceiptPruning) (*channeltypes.MsgScheduleReceiptPruningResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduled, err := k.ChannelKeeper.ScheduleReceiptPruning(ctx, msg.PortId, msg.ChannelId, msg.Sequences, msg.UncommittedProofs(), msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("schedule receipt pruning failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "schedule receipt pruning failed")
+	}
+
+	ctx.Logger().Info("receipt pruning scheduled", "port-id", msg.PortId, "channel-id", msg.ChannelId, "scheduled", scheduled)
+
+	return &channeltypes.MsgScheduleReceiptPruningResponse{
+		TotalScheduledSequences: scheduled,
+	}, nil
+}
+
+// ChannelForceClose defines a rpc handler method for MsgChannelForceClose.
+// The channel end is closed without counterparty proofs. The application OnChanCloseConfirm callback is
+// executed before the channel is closed, as though the counterparty had closed the channel, and the
+// application may veto the closure by returning an error.
+func (k Keeper) ChannelForceClose(goCtx context.Context, msg *channeltypes.MsgChannelForceClose) (*channeltypes.MsgChannelForceCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if err := cbs.OnChanCloseConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
+		ctx.Logger().Error("channel force close callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrapf(err, "channel force close callback failed for port ID: %s, channel ID: %s", msg.PortId, msg.ChannelId)
+	}
+
+	if err := k.ChannelKeeper.ChanForceClose(ctx, msg.PortId, msg.ChannelId); err != nil {
+		ctx.Logger().Error("channel force close failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		return nil, errorsmod.Wrap(err, "channel force close failed")
+	}
+
+	ctx.Logger().Info("channel force close succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &channeltypes.MsgChannelForceCloseResponse{}, nil
+}
+
+// ChannelForceTimeout defines a rpc handler method for MsgChannelForceTimeout.
+// Each packet is processed as though it had timed out without counterparty proofs. The application
+// OnTimeoutPacket callback is executed for every packet with an empty relayer address, the application
+// may veto the refund by returning an error in which case no packets are processed.
+// See ForceTimeoutPacket for the risks of processing packets without counterparty proofs.
+func (k Keeper) ChannelForceTimeout(goCtx context.Context, msg *channeltypes.MsgChannelForceTimeout) (*channeltypes.MsgChannelForceTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		ctx.Logger().Error("channel force timeout failed", "port-id", msg.PortId, "error", errorsmod.Wrap(err, "could not retrieve module from port-id"))
+		return nil, errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		ctx.Logger().Error("channel force timeout failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	for _, packet := range msg.Packets {
+		if err := k.ChannelKeeper.ForceTimeoutPacket(ctx, packet); err != nil {
+			ctx.Logger().Error("channel force timeout failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "sequence", packet.Sequence, "error", err.Error())
+			return nil, errorsmod.Wrapf(err, "force timeout failed for packet with sequence %d", packet.Sequence)
+		}
+
+		// the packets are not relayed, no relayer is provided so that relayer incentives are not paid out
+		if err := cbs.OnTimeoutPacket(ctx, packet, nil); err != nil {
+			ctx.Logger().Error("channel force timeout callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "sequence", packet.Sequence, "error", err.Error())
+			return nil, errorsmod.Wrapf(err, "force timeout callback failed for packet with sequence %d", packet.Sequence)
+		}
+	}
+
+	remaining := len(k.ChannelKeeper.GetAllPacketCommitmentsAtChannel(ctx, msg.PortId, msg.ChannelId))
+
+	ctx.Logger().Info("channel force timeout succeeded", "port-id", msg.PortId, "channel-id", msg.ChannelId, "packets", len(msg.Packets), "remaining-packet-commitments", remaining)
+
+	return &channeltypes.MsgChannelForceTimeoutResponse{
+		RemainingPacketCommitments: uint64(remaining),
+	}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1192,3 +1770,12 @@ func convertToErrorEvents(events sdk.Events) sdk.Events {
 
 	return newEvents
 }
+
+// unpackUpgradeClientState unpacks the optional client state of a counterparty connection upgrade client.
+func unpackUpgradeClientState(protoAny *codectypes.Any) (exported.ClientState, error) {
+	if protoAny == nil {
+		return nil, nil
+	}
+
+	return clienttypes.UnpackClientState(protoAny)
+}
@@ -222,6 +222,195 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets on an unordered channel
+// using a single batch proof of the packet commitments.
+// TestHandleRecvPacketOrderedAllowTimeout tests that a timed out packet on an ORDERED_ALLOW_TIMEOUT
+// channel is skipped on receive, timed out on the sending chain without closing the channel and that
+// the following packet is relayed successfully.
+func (suite *KeeperTestSuite) TestHandleRecvPacketOrderedAllowTimeout() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	expiredTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(expiredTimeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, expiredTimeoutHeight, 0)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainB.GetContext()
+	res, err := keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	// the application callback is not executed and no acknowledgement is written
+	suite.Require().NotContains(ctx.EventManager().Events(), ibcmock.NewMockRecvPacketEvent())
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(string(channeltypes.TimeoutReceipt), receipt)
+
+	// replay is treated as a no-op
+	res, err = keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, res.Result)
+
+	// commit the timeout receipt and time out the packet on chainA
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointB.GetChannel().State)
+
+	// the next packet is received and acknowledged in order
+	sequence, err = path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSeqAck)
+}
+
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets    []channeltypes.Packet
+		packetKeys [][]byte
+		proof      []byte
+		proofs     [][]byte
+		path       *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []channeltypes.ResponseResultType
+		expErr     error
+	}{
+		{
+			"success",
+			func() {},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: proof of each packet",
+			func() {
+				proof = nil
+				for _, key := range packetKeys {
+					packetProof, _ := path.EndpointA.QueryProof(key)
+					proofs = append(proofs, packetProof)
+				}
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet already received",
+			func() {
+				err := path.EndpointB.RecvPacket(packets[1])
+				suite.Require().NoError(err)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet fails to be received",
+			func() {
+				// packets at sequences greater than or equal to the counterparty next sequence send cannot be received
+				upgrade := channeltypes.Upgrade{NextSequenceSend: packets[2].GetSequence()}
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, upgrade)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.FAILURE},
+			nil,
+		},
+		{
+			"failure: packet commitment not contained in batch proof",
+			func() {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets[2] = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packets received on different channels",
+			func() {
+				packets[1].DestinationChannel = ibctesting.InvalidID
+			},
+			nil,
+			channeltypes.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, packetKeys, proofs = nil, nil, nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				packets = append(packets, packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			// get a single proof of all packet commitments from chainA
+			var proofHeight clienttypes.Height
+			proof, proofHeight = path.EndpointA.QueryBatchProof(packetKeys...)
+
+			tc.malleate()
+
+			msg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			msg.ProofsCommitment = proofs
+
+			res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+
+			for i, packet := range packets {
+				_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().Equal(expPass && tc.expResults[i] != channeltypes.FAILURE, found)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -454,6 +643,261 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler acknowledging a batch of packets on an unordered channel
+// using a single batch proof of the packet acknowledgements.
+func (suite *KeeperTestSuite) TestHandleAcknowledgements() {
+	var (
+		packets []channeltypes.Packet
+		acks    [][]byte
+		proof   []byte
+		path    *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []channeltypes.ResponseResultType
+		expErr     error
+	}{
+		{
+			"success",
+			func() {},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet already acknowledged",
+			func() {
+				err := path.EndpointA.AcknowledgePacket(packets[0], ibctesting.MockAcknowledgement)
+				suite.Require().NoError(err)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: acknowledgement fails to be processed",
+			func() {
+				// the packet does not match the stored packet commitment
+				packets[1].Data = []byte("invalid packet data")
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.FAILURE, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: application callback fails for one packet",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnAcknowledgementPacket = func(ctx sdk.Context, packet channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+					if packet.GetSequence() == packets[1].GetSequence() {
+						return ibcmock.MockApplicationCallbackError
+					}
+
+					ctx.EventManager().EmitEvent(ibcmock.NewMockAckPacketEvent())
+					return nil
+				}
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.FAILURE, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"failure: acknowledgement does not match proof",
+			func() {
+				acks[1] = []byte("invalid acknowledgement")
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packets sent on different channels",
+			func() {
+				packets[1].SourceChannel = ibctesting.InvalidID
+			},
+			nil,
+			channeltypes.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets, acks = nil, nil
+			var ackKeys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+				acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+				ackKeys = append(ackKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			// get a single proof of all packet acknowledgements from chainB
+			var proofHeight clienttypes.Height
+			proof, proofHeight = path.EndpointB.QueryBatchProof(ackKeys...)
+
+			tc.malleate()
+
+			msg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+
+			for i, packet := range packets {
+				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				suite.Require().Equal(!expPass || tc.expResults[i] == channeltypes.FAILURE, has)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleTimeouts() {
+	var (
+		packets []channeltypes.Packet
+		proof   []byte
+		path    *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []channeltypes.ResponseResultType
+		expErr     error
+	}{
+		{
+			"success",
+			func() {},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet already timed out",
+			func() {
+				err := path.EndpointA.TimeoutPacket(packets[0])
+				suite.Require().NoError(err)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet fails to be timed out",
+			func() {
+				// the packet does not match the stored packet commitment
+				packets[1].Data = []byte("invalid packet data")
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.FAILURE, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: application callback fails for one packet",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+					if packet.GetSequence() == packets[1].GetSequence() {
+						return ibcmock.MockApplicationCallbackError
+					}
+
+					ctx.EventManager().EmitEvent(ibcmock.NewMockTimeoutPacketEvent())
+					return nil
+				}
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.FAILURE, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packets sent on different channels",
+			func() {
+				packets[1].SourceChannel = ibctesting.InvalidID
+			},
+			nil,
+			channeltypes.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			packets = nil
+			var receiptKeys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				packets = append(packets, packet)
+				receiptKeys = append(receiptKeys, host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			// get a single proof of the absence of all packet receipts from chainB
+			var proofHeight clienttypes.Height
+			proof, proofHeight = path.EndpointB.QueryBatchProof(receiptKeys...)
+
+			tc.malleate()
+
+			msg := channeltypes.NewMsgTimeouts(packets, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.Timeouts(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+
+			for i, packet := range packets {
+				has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				suite.Require().Equal(!expPass || tc.expResults[i] == channeltypes.FAILURE, has)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -2511,6 +2955,92 @@ func (suite *KeeperTestSuite) TestChannelUpgradeTimeout() {
 }
 
 // TestIBCSoftwareUpgrade tests the IBCSoftwareUpgrade rpc handler
+func (suite *KeeperTestSuite) TestChannelUpgradeMigrateConnection() {
+	var (
+		path           *ibctesting.Path
+		connectionPath *ibctesting.Path
+		msg            *channeltypes.MsgChannelUpgradeMigrateConnection
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"authority is not signer of the migrate connection msg",
+			func() {
+				msg.Signer = path.EndpointA.Chain.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"proposed connection is identical to the current connection",
+			func() {
+				msg.ConnectionId = path.EndpointA.ConnectionID
+			},
+			channeltypes.ErrInvalidUpgrade,
+		},
+		{
+			"proposed connection not found",
+			func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			connectionPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(connectionPath)
+
+			msg = channeltypes.NewMsgChannelUpgradeMigrateConnection(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				connectionPath.EndpointA.ConnectionID,
+				suite.chainA.GetSimApp().IBCKeeper.GetAuthority(),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeMigrateConnection(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+
+				channel := path.EndpointA.GetChannel()
+				expFields := channeltypes.NewUpgradeFields(channel.Ordering, []string{connectionPath.EndpointA.ConnectionID}, channel.Version)
+				suite.Require().Equal(expFields, res.Upgrade.Fields)
+				suite.Require().Equal(expFields, path.EndpointA.GetChannelUpgrade().Fields)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
 	var msg *clienttypes.MsgIBCSoftwareUpgrade
 	testCases := []struct {
@@ -2824,3 +3354,310 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestScheduleReceiptPruning() {
+	var msg *channeltypes.MsgScheduleReceiptPruning
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: core keeper function fails, channel not found",
+			func() {
+				msg.PortId = "portidone"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			channelKeeper := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainA.GetContext())
+			params.ReceiptRetention = channeltypes.NewReceiptRetention(channeltypes.MinReceiptRetentionHeightPeriod, channeltypes.MinReceiptRetentionTimePeriod, 10)
+			channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			sequence, err := path.EndpointB.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			proof, proofHeight := path.EndpointB.QueryProof(host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+
+			msg = channeltypes.NewMsgScheduleReceiptPruning(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]uint64{sequence},
+				proof,
+				proofHeight,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().ScheduleReceiptPruning(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Equal(uint64(1), resp.TotalScheduledSequences)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelForceClose() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgChannelForceClose
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"authority is not signer of the force close msg",
+			func() {
+				msg.Signer = path.EndpointA.Chain.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+		},
+		{
+			"channel is already CLOSED",
+			func() {
+				err := path.EndpointA.SetChannelState(channeltypes.CLOSED)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"success: application callback is executed before the channel is closed",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseConfirm = func(ctx sdk.Context, portID, channelID string) error {
+					channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+					suite.Require().True(found)
+					suite.Require().Equal(channeltypes.OPEN, channel.State)
+					return nil
+				}
+			},
+			nil,
+		},
+		{
+			"application callback vetoes the channel closure",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanCloseConfirm = func(ctx sdk.Context, portID, channelID string) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			msg = channeltypes.NewMsgChannelForceClose(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.GetSimApp().IBCKeeper.GetAuthority(),
+			)
+
+			tc.malleate()
+
+			expChannel := path.EndpointA.GetChannel()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelForceClose(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().Equal(expChannel, path.EndpointA.GetChannel())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelForceTimeout() {
+	var (
+		path    *ibctesting.Path
+		packets []channeltypes.Packet
+		msg     *channeltypes.MsgChannelForceTimeout
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expError     error
+		expRemaining uint64
+	}{
+		{
+			"success: all packets refunded",
+			func() {},
+			nil,
+			0,
+		},
+		{
+			"success: subset of packets refunded",
+			func() {
+				msg.Packets = packets[:1]
+			},
+			nil,
+			2,
+		},
+		{
+			"success: no relayer is provided to the application callback",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+					if !relayer.Empty() {
+						return fmt.Errorf("expected empty relayer, got %s", relayer)
+					}
+					return nil
+				}
+			},
+			nil,
+			0,
+		},
+		{
+			"authority is not signer of the force timeout msg",
+			func() {
+				msg.Signer = path.EndpointA.Chain.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+			0,
+		},
+		{
+			"channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			capabilitytypes.ErrCapabilityNotFound,
+			0,
+		},
+		{
+			"channel is not CLOSED",
+			func() {
+				err := path.EndpointA.SetChannelState(channeltypes.OPEN)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrInvalidChannelState,
+			0,
+		},
+		{
+			"packet commitment not found",
+			func() {
+				msg.Packets = append(msg.Packets, packets[0])
+			},
+			channeltypes.ErrPacketCommitmentNotFound,
+			0,
+		},
+		{
+			"application callback vetoes the packet refund",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets = nil
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+			}
+
+			_, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelForceClose(suite.chainA.GetContext(), channeltypes.NewMsgChannelForceClose(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				suite.chainA.GetSimApp().IBCKeeper.GetAuthority(),
+			))
+			suite.Require().NoError(err)
+
+			msg = channeltypes.NewMsgChannelForceTimeout(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				packets,
+				suite.chainA.GetSimApp().IBCKeeper.GetAuthority(),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelForceTimeout(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRemaining, res.RemainingPacketCommitments)
+
+				for _, packet := range msg.Packets {
+					commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().Nil(commitment)
+
+					_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetTimedOutPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectionkeeper "github.com/cosmos/ibc-go/v8/modules/core/03-connection/keeper"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v8/modules/core/04-channel"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/client/cli"
@@ -164,6 +165,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 6, connectionMigrator.MigrateUpgradeTimeout); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -184,11 +189,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	ibcclient.BeginBlocker(sdk.UnwrapSDKContext(ctx), am.keeper.ClientKeeper)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ibcclient.BeginBlocker(sdkCtx, am.keeper.ClientKeeper)
+	ibcchannel.BeginBlocker(sdkCtx, am.keeper.ChannelKeeper)
 	return nil
 }
 
@@ -21,7 +21,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState             = (*ClientState)(nil)
+	_ exported.MembershipBatchVerifier = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -288,6 +291,86 @@ func (cs ClientState) VerifyNonMembership(
 	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
 }
 
+// VerifyMembershipBatch is a generic proof verification method which verifies a single proof of the existence of many values
+// at the given CommitmentPaths at the specified height. The value at each index is verified against the path with the same index.
+// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and standardized paths (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyMembershipBatch(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	merkleProof, consensusState, err := cs.getBatchVerificationState(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyMembershipBatch(cs.ProofSpecs, consensusState.GetRoot(), paths, values)
+}
+
+// VerifyNonMembershipBatch is a generic proof verification method which verifies a single proof of the absence of many
+// CommitmentPaths at the specified height.
+// The caller is expected to construct the full CommitmentPaths from a CommitmentPrefix and standardized paths (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyNonMembershipBatch(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	merkleProof, consensusState, err := cs.getBatchVerificationState(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyNonMembershipBatch(cs.ProofSpecs, consensusState.GetRoot(), paths)
+}
+
+// getBatchVerificationState performs the checks shared by batch proof verification and returns the
+// unmarshalled merkle proof along with the consensus state at the proof height.
+func (cs ClientState) getBatchVerificationState(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+) (commitmenttypes.MerkleProof, *ConsensusState, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return commitmenttypes.MerkleProof{}, nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof, consensusState, nil
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
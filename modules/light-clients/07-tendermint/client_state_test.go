@@ -765,3 +765,206 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyMembershipBatch() {
+	var (
+		testingpath      *ibctesting.Path
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		paths            []exported.Path
+		values           [][]byte
+		proof            []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification of client and connection state", func() {},
+			true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			false,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 1000
+			},
+			false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = testingpath.EndpointA.GetClientState().GetLatestHeight().Increment()
+			}, false,
+		},
+		{
+			"failed to unmarshal merkle proof", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"invalid value", func() {
+				values[1] = []byte("invalid value")
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				paths[1] = ibcmock.KeyPath{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(testingpath)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+
+			// create default proof, merklePaths, and values which pass
+			// may be overwritten by malleate()
+			keys := [][]byte{
+				host.FullClientStateKey(testingpath.EndpointB.ClientID),
+				host.ConnectionKey(testingpath.EndpointB.ConnectionID),
+			}
+
+			paths = nil
+			for _, key := range keys {
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+				suite.Require().NoError(err)
+
+				paths = append(paths, merklePath)
+			}
+
+			proof, proofHeight = testingpath.EndpointB.QueryBatchProof(keys...)
+
+			clientStateBz, err := suite.chainB.Codec.MarshalInterface(testingpath.EndpointB.GetClientState())
+			suite.Require().NoError(err)
+
+			connection := testingpath.EndpointB.GetConnection()
+			connectionBz, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			values = [][]byte{clientStateBz, connectionBz}
+
+			tc.malleate() // make changes as necessary
+
+			clientState := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
+
+			err = clientState.VerifyMembershipBatch(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, paths, values,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyNonMembershipBatch() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+		paths       []exported.Path
+		proof       []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification of non membership", func() {},
+			true,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = testingpath.EndpointA.GetClientState().GetLatestHeight().Increment()
+			}, false,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"verify non membership fails as path exists", func() {
+				key := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+				suite.Require().NoError(err)
+
+				paths = []exported.Path{merklePath}
+				proof, proofHeight = testingpath.EndpointB.QueryBatchProof(key)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(testingpath)
+
+			// create default proof and merklePaths which pass
+			// may be overwritten by malleate()
+			keys := [][]byte{
+				host.FullClientStateKey("invalid-client-id"),
+				host.ConnectionKey("connection-100"),
+			}
+
+			paths = nil
+			for _, key := range keys {
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+				suite.Require().NoError(err)
+
+				paths = append(paths, merklePath)
+			}
+
+			proof, proofHeight = testingpath.EndpointB.QueryBatchProof(keys...)
+
+			tc.malleate() // make changes as necessary
+
+			clientState := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, testingpath.EndpointA.ClientID)
+
+			err := clientState.VerifyNonMembershipBatch(
+				ctx, store, suite.chainA.Codec, proofHeight, 0, 0,
+				proof, paths,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
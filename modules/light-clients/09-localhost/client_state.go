@@ -16,7 +16,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState             = (*ClientState)(nil)
+	_ exported.MembershipBatchVerifier = (*ClientState)(nil)
+)
 
 // NewClientState creates a new 09-localhost ClientState instance.
 func NewClientState(height clienttypes.Height) exported.ClientState {
@@ -151,6 +154,53 @@ func (ClientState) VerifyNonMembership(
 	return nil
 }
 
+// VerifyMembershipBatch verifies the existence of each value at the CommitmentPath with the same index within the IBC store.
+// The sentinel localhost client proof covers every path, which is verified against the IBC store using VerifyMembership.
+func (cs ClientState) VerifyMembershipBatch(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "number of paths (%d) must equal number of values (%d)", len(paths), len(values))
+	}
+
+	for i, path := range paths {
+		if err := cs.VerifyMembership(ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, values[i]); err != nil {
+			return errorsmod.Wrapf(err, "failed verification at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyNonMembershipBatch verifies the absence of each of the given CommitmentPaths within the IBC store.
+// The sentinel localhost client proof covers every path, which is verified against the IBC store using VerifyNonMembership.
+func (cs ClientState) VerifyNonMembershipBatch(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	for i, path := range paths {
+		if err := cs.VerifyNonMembership(ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path); err != nil {
+			return errorsmod.Wrapf(err, "failed verification at index %d", i)
+		}
+	}
+
+	return nil
+}
+
 // VerifyClientMessage is unsupported by the 09-localhost client type and returns an error.
 func (ClientState) VerifyClientMessage(_ sdk.Context, _ codec.BinaryCodec, _ storetypes.KVStore, _ exported.ClientMessage) error {
 	return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "client message verification is unsupported by the localhost client")
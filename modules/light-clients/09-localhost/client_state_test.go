@@ -398,6 +398,104 @@ func (suite *LocalhostTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *LocalhostTestSuite) TestVerifyMembershipBatch() {
+	var (
+		paths  []exported.Path
+		values [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet receipts verification",
+			func() {},
+			true,
+		},
+		{
+			"packet receipt verification fails for one path",
+			func() {
+				values[1] = []byte("invalid value")
+			},
+			false,
+		},
+		{
+			"number of paths does not match number of values",
+			func() {
+				values = values[1:]
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			paths, values = nil, nil
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				suite.chain.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chain.GetContext(), mock.PortID, ibctesting.FirstChannelID, sequence)
+
+				merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(mock.PortID, ibctesting.FirstChannelID, sequence))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+				suite.Require().NoError(err)
+
+				paths = append(paths, merklePath)
+				values = append(values, []byte{byte(1)})
+			}
+
+			tc.malleate()
+
+			clientState := suite.chain.GetClientState(exported.LocalhostClientID).(*localhost.ClientState)
+			store := suite.chain.GetContext().KVStore(suite.chain.GetSimApp().GetKey(exported.StoreKey))
+
+			err := clientState.VerifyMembershipBatch(
+				suite.chain.GetContext(),
+				store,
+				suite.chain.Codec,
+				clienttypes.ZeroHeight(),
+				0, 0, // use zero values for delay periods
+				localhost.SentinelProof,
+				paths,
+				values,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyNonMembershipBatch() {
+	clientState := suite.chain.GetClientState(exported.LocalhostClientID).(*localhost.ClientState)
+	store := suite.chain.GetContext().KVStore(suite.chain.GetSimApp().GetKey(exported.StoreKey))
+
+	var paths []exported.Path
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(mock.PortID, ibctesting.FirstChannelID, sequence))
+		merklePath, err := commitmenttypes.ApplyPrefix(suite.chain.GetPrefix(), merklePath)
+		suite.Require().NoError(err)
+
+		paths = append(paths, merklePath)
+	}
+
+	err := clientState.VerifyNonMembershipBatch(suite.chain.GetContext(), store, suite.chain.Codec, clienttypes.ZeroHeight(), 0, 0, localhost.SentinelProof, paths)
+	suite.Require().NoError(err)
+
+	// the absence verification fails if any path is present
+	suite.chain.GetSimApp().GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chain.GetContext(), mock.PortID, ibctesting.FirstChannelID, 2)
+
+	err = clientState.VerifyNonMembershipBatch(suite.chain.GetContext(), store, suite.chain.Codec, clienttypes.ZeroHeight(), 0, 0, localhost.SentinelProof, paths)
+	suite.Require().Error(err)
+}
+
 func (suite *LocalhostTestSuite) TestVerifyClientMessage() {
 	clientState := localhost.NewClientState(clienttypes.NewHeight(1, 10))
 	suite.Require().Error(clientState.VerifyClientMessage(suite.chain.GetContext(), nil, nil, nil))
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/require"
 
 	errorsmod "cosmossdk.io/errors"
@@ -245,6 +246,42 @@ func (chain *TestChain) QueryProofForStore(storeKey string, key []byte, height i
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryBatchProofAtHeight performs an abci query for each of the given keys in the IBC store and returns
+// the proto encoded merkle proof combining the existence proofs of all keys into a single compressed
+// ICS-23 batch proof, along with the height at which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryBatchProofAtHeight(keys [][]byte, height int64) ([]byte, clienttypes.Height) {
+	require.NotEmpty(chain.TB, keys)
+
+	var (
+		proofHeight      clienttypes.Height
+		storeProof       *ics23.CommitmentProof
+		commitmentProofs []*ics23.CommitmentProof
+	)
+	for _, key := range keys {
+		var proofBz []byte
+		proofBz, proofHeight = chain.QueryProofAtHeight(key, height)
+
+		var merkleProof commitmenttypes.MerkleProof
+		require.NoError(chain.TB, chain.App.AppCodec().Unmarshal(proofBz, &merkleProof))
+
+		// the proof of the IBC store root in the multistore is the same for all keys
+		commitmentProofs = append(commitmentProofs, merkleProof.Proofs[0])
+		storeProof = merkleProof.Proofs[1]
+	}
+
+	batchProof, err := ics23.CombineProofs(commitmentProofs)
+	require.NoError(chain.TB, err)
+
+	merkleProof := commitmenttypes.MerkleProof{
+		Proofs: []*ics23.CommitmentProof{batchProof, storeProof},
+	}
+
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	require.NoError(chain.TB, err)
+
+	return proof, proofHeight
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
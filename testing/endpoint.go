@@ -79,6 +79,16 @@ func (endpoint *Endpoint) QueryProofAtHeight(key []byte, height uint64) ([]byte,
 	return endpoint.Chain.QueryProofAtHeight(key, int64(height))
 }
 
+// QueryBatchProof queries a single batch proof of the given keys associated with this endpoint
+// using the latest client state height on the counterparty chain.
+func (endpoint *Endpoint) QueryBatchProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	// obtain the counterparty client representing the chain associated with the endpoint
+	clientState := endpoint.Counterparty.Chain.GetClientState(endpoint.Counterparty.ClientID)
+
+	// query proof on the counterparty using the latest height of the IBC client
+	return endpoint.Chain.QueryBatchProofAtHeight(keys, int64(clientState.GetLatestHeight().GetRevisionHeight()))
+}
+
 // CreateClient creates an IBC client on the endpoint. It will update the
 // clientID for the endpoint if the message is successfully executed.
 // NOTE: a solo machine client will be created with an empty diversifier.
@@ -316,6 +326,173 @@ func (endpoint *Endpoint) QueryConnectionHandshakeProof() (
 	return clientState, clientProof, consensusProof, consensusHeight, connectioProof, proofHeight
 }
 
+// QueryConnectionUpgradeProof returns all the proofs necessary to execute ConnUpgradeTry/ConnUpgradeAck.
+// It returns the proof for the connection on the endpoint's chain, the proof for the upgrade attempt on the
+// endpoint's chain, and the height at which the proof was queried.
+func (endpoint *Endpoint) QueryConnectionUpgradeProof() ([]byte, []byte, clienttypes.Height) {
+	connectionKey := host.ConnectionKey(endpoint.ConnectionID)
+	connectionProof, height := endpoint.QueryProof(connectionKey)
+
+	upgradeKey := host.ConnectionUpgradeKey(endpoint.ConnectionID)
+	upgradeProof, _ := endpoint.QueryProof(upgradeKey)
+
+	return connectionProof, upgradeProof, height
+}
+
+// QueryConnectionUpgradeClientProof returns the client state of the provided client on the endpoint's chain,
+// which the endpoint's connection is upgraded to, along with a proof of it at the provided height.
+func (endpoint *Endpoint) QueryConnectionUpgradeClientProof(clientID string, height clienttypes.Height) (exported.ClientState, []byte) {
+	clientState := endpoint.Chain.GetClientState(clientID)
+
+	clientProof, _ := endpoint.QueryProofAtHeight(host.FullClientStateKey(clientID), height.GetRevisionHeight())
+
+	return clientState, clientProof
+}
+
+// ConnUpgradeInit sends a MsgConnectionUpgradeInit on the associated endpoint.
+// The upgrade fields returned by GetProposedConnectionUpgradeFields are used,
+// and submitted via governance proposal
+func (endpoint *Endpoint) ConnUpgradeInit() error {
+	msg := connectiontypes.NewMsgConnectionUpgradeInit(
+		endpoint.ConnectionID,
+		endpoint.GetProposedConnectionUpgradeFields(),
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	proposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypesv1.DefaultMinDepositTokens)),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.ConnectionID,
+		"upgrade-init",
+		fmt.Sprintf("gov proposal for initialising connection upgrade: %s", endpoint.ConnectionID),
+		false,
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	res, err := endpoint.Chain.SendMsgs(proposal)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := ParseProposalIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return VoteAndCheckProposalStatus(endpoint, proposalID)
+}
+
+// ConnUpgradeTry sends a MsgConnectionUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	counterpartyClient, clientProof := endpoint.Counterparty.QueryConnectionUpgradeClientProof(counterpartyUpgrade.Fields.ClientId, height)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTry(
+		endpoint.ConnectionID,
+		counterpartyUpgrade.Fields,
+		endpoint.Counterparty.GetConnection().UpgradeSequence,
+		counterpartyClient,
+		connectionProof,
+		upgradeProof,
+		clientProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeAck sends a MsgConnectionUpgradeAck on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, upgradeProof, height := endpoint.Counterparty.QueryConnectionUpgradeProof()
+
+	counterpartyUpgrade, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgrade(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	counterpartyClient, clientProof := endpoint.Counterparty.QueryConnectionUpgradeClientProof(counterpartyUpgrade.Fields.ClientId, height)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeAck(
+		endpoint.ConnectionID,
+		counterpartyUpgrade,
+		counterpartyClient,
+		connectionProof,
+		upgradeProof,
+		clientProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeConfirm sends a MsgConnectionUpgradeConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	connectionProof, height := endpoint.Counterparty.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeConfirm(
+		endpoint.ConnectionID,
+		connectionProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeTimeout sends a MsgConnectionUpgradeTimeout on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionKey := host.ConnectionKey(endpoint.Counterparty.ConnectionID)
+	connectionProof, height := endpoint.Counterparty.QueryProof(connectionKey)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTimeout(
+		endpoint.ConnectionID,
+		endpoint.Counterparty.GetConnection(),
+		connectionProof,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeCancel sends a MsgConnectionUpgradeCancel on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeCancel() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	errorReceiptKey := host.ConnectionUpgradeErrorKey(endpoint.Counterparty.ConnectionID)
+	proofErrorReceipt, height := endpoint.Counterparty.QueryProof(errorReceiptKey)
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ConnectionKeeper.GetUpgradeErrorReceipt(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ConnectionID)
+	require.True(endpoint.Chain.TB, found)
+
+	msg := connectiontypes.NewMsgConnectionUpgradeCancel(
+		endpoint.ConnectionID,
+		errorReceipt,
+		proofErrorReceipt,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
 // ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
 func (endpoint *Endpoint) ChanOpenInit() error {
 	msg := channeltypes.NewMsgChannelOpenInit(
@@ -519,7 +696,8 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
+		// the timeout receipt of an ORDERED_ALLOW_TIMEOUT channel is written at the packet receipt path
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
@@ -544,7 +722,7 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	var packetKey []byte
 
 	switch endpoint.ChannelConfig.Order {
-	case channeltypes.ORDERED:
+	case channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
@@ -760,6 +938,68 @@ func (endpoint *Endpoint) ChanUpgradeCancel() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ChanUpgradeMigrateConnection sends a MsgChannelUpgradeMigrateConnection on the associated endpoint via
+// governance proposal. The channel upgrade is initialized with the provided connection as the proposed
+// connection hop while the existing channel ordering and version are kept.
+func (endpoint *Endpoint) ChanUpgradeMigrateConnection(connectionID string) error {
+	msg := channeltypes.NewMsgChannelUpgradeMigrateConnection(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		connectionID,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	return endpoint.submitAuthorityProposal(msg, "upgrade-migrate-connection", fmt.Sprintf("gov proposal for migrating channel %s to connection %s", endpoint.ChannelID, connectionID))
+}
+
+// ChanForceClose sends a MsgChannelForceClose on the associated endpoint via governance proposal.
+func (endpoint *Endpoint) ChanForceClose() error {
+	msg := channeltypes.NewMsgChannelForceClose(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	return endpoint.submitAuthorityProposal(msg, "channel-force-close", fmt.Sprintf("gov proposal for force closing channel %s", endpoint.ChannelID))
+}
+
+// ForceTimeoutPackets sends a MsgChannelForceTimeout for the provided packets on the associated endpoint
+// via governance proposal.
+func (endpoint *Endpoint) ForceTimeoutPackets(packets ...channeltypes.Packet) error {
+	msg := channeltypes.NewMsgChannelForceTimeout(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		packets,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	return endpoint.submitAuthorityProposal(msg, "channel-force-timeout", fmt.Sprintf("gov proposal for force timing out %d packets on channel %s", len(packets), endpoint.ChannelID))
+}
+
+// submitAuthorityProposal submits a governance proposal executing the provided msg and votes for it to pass.
+func (endpoint *Endpoint) submitAuthorityProposal(msg sdk.Msg, title, summary string) error {
+	proposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypesv1.DefaultMinDepositTokens)),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.ChannelID,
+		title,
+		summary,
+		false,
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	res, err := endpoint.Chain.SendMsgs(proposal)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := ParseProposalIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return VoteAndCheckProposalStatus(endpoint, proposalID)
+}
+
 // SetChannelState sets a channel state
 func (endpoint *Endpoint) SetChannelState(state channeltypes.State) error {
 	channel := endpoint.GetChannel()
@@ -892,3 +1132,27 @@ func (endpoint *Endpoint) GetProposedUpgrade() channeltypes.Upgrade {
 
 	return upgrade
 }
+
+// GetProposedConnectionUpgradeFields returns valid upgrade fields which can be used for ConnUpgradeInit.
+// By default, the endpoint's existing connection fields will be used for the upgrade fields.
+// If any non-empty values are specified in the ConnectionConfig's ProposedUpgrade,
+// those values will be used in the returned upgrade fields.
+func (endpoint *Endpoint) GetProposedConnectionUpgradeFields() connectiontypes.UpgradeFields {
+	connection := endpoint.GetConnection()
+	upgradeFields := connectiontypes.NewUpgradeFields(connection.ClientId, connection.Versions, connection.DelayPeriod)
+
+	override := endpoint.ConnectionConfig.ProposedUpgrade
+	if override.ClientId != "" {
+		upgradeFields.ClientId = override.ClientId
+	}
+
+	if len(override.Versions) != 0 {
+		upgradeFields.Versions = override.Versions
+	}
+
+	if override.DelayPeriod != 0 {
+		upgradeFields.DelayPeriod = override.DelayPeriod
+	}
+
+	return upgradeFields
+}
@@ -50,6 +50,12 @@ func (path *Path) SetChannelOrdered() {
 	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 }
 
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *Path) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
 // RelayPacket attempts to relay the packet first on EndpointA and then on EndpointB
 // if EndpointA does not contain a packet commitment for that packet. An error is returned
 // if a relay step fails or the packet commitment does not exist on either endpoint.
@@ -115,3 +121,79 @@ func (path *Path) RelayPacketWithResults(packet channeltypes.Packet) (*abci.Exec
 
 	return nil, nil, fmt.Errorf("packet commitment does not exist on either endpoint for provided packet")
 }
+
+// UpgradeChannel performs a full channel upgrade handshake which is initialized on EndpointA. The upgrade
+// fields proposed by each endpoint are taken from the ProposedUpgrade of its ChannelConfig. The provided
+// packets in flight on the channel are relayed while the channel ends are flushing. Once the channel ends
+// are OPEN, the channel configs of the endpoints are updated to the upgraded version and ordering.
+func (path *Path) UpgradeChannel(inFlightPackets ...channeltypes.Packet) error {
+	if err := path.EndpointA.ChanUpgradeInit(); err != nil {
+		return err
+	}
+
+	return path.relayChannelUpgrade(inFlightPackets...)
+}
+
+// MigrateConnection performs a full channel upgrade handshake which moves the channel of the path onto the
+// connections of the provided connection path. The connections of the connection path are expected to be OPEN.
+// The provided packets in flight on the channel are relayed while the channel ends are flushing. Once the
+// channel ends are OPEN, the endpoints of the path are updated to use the clients and connections of the
+// connection path.
+func (path *Path) MigrateConnection(connectionPath *Path, inFlightPackets ...channeltypes.Packet) error {
+	if err := path.EndpointA.ChanUpgradeMigrateConnection(connectionPath.EndpointA.ConnectionID); err != nil {
+		return err
+	}
+
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{connectionPath.EndpointB.ConnectionID}
+	defer func() {
+		path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = nil
+	}()
+
+	if err := path.relayChannelUpgrade(inFlightPackets...); err != nil {
+		return err
+	}
+
+	path.EndpointA.ClientID, path.EndpointA.ConnectionID = connectionPath.EndpointA.ClientID, connectionPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID, path.EndpointB.ConnectionID = connectionPath.EndpointB.ClientID, connectionPath.EndpointB.ConnectionID
+
+	return nil
+}
+
+// relayChannelUpgrade completes a channel upgrade handshake which has been initialized on EndpointA by
+// relaying the remaining handshake messages and the provided packets in flight on the channel.
+func (path *Path) relayChannelUpgrade(inFlightPackets ...channeltypes.Packet) error {
+	if err := path.EndpointB.ChanUpgradeTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanUpgradeAck(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointB.ChanUpgradeConfirm(); err != nil {
+		return err
+	}
+
+	for _, packet := range inFlightPackets {
+		if err := path.RelayPacket(packet); err != nil {
+			return err
+		}
+	}
+
+	// flushing is complete on both channel ends, open the channel ends which have not yet moved to OPEN
+	for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+		if endpoint.GetChannel().State == channeltypes.FLUSHCOMPLETE {
+			if err := endpoint.ChanUpgradeOpen(); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		endpoint.ChannelConfig.Version = channel.Version
+		endpoint.ChannelConfig.Order = channel.Ordering
+	}
+
+	return nil
+}